@@ -115,6 +115,159 @@ func (x *_GenesisState_4_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_GenesisState_6_list)(nil)
+
+type _GenesisState_6_list struct {
+	list *[]*OrderGroup
+}
+
+func (x *_GenesisState_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*OrderGroup)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*OrderGroup)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_6_list) AppendMutable() protoreflect.Value {
+	v := new(OrderGroup)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_6_list) NewElement() protoreflect.Value {
+	v := new(OrderGroup)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_8_list)(nil)
+
+type _GenesisState_8_list struct {
+	list *[]*ScheduledOrder
+}
+
+func (x *_GenesisState_8_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_8_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_8_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ScheduledOrder)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_8_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ScheduledOrder)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_8_list) AppendMutable() protoreflect.Value {
+	v := new(ScheduledOrder)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_8_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_8_list) NewElement() protoreflect.Value {
+	v := new(ScheduledOrder)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_8_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_10_list)(nil)
+
+type _GenesisState_10_list struct {
+	list *[]*ScheduledOrderSlice
+}
+
+func (x *_GenesisState_10_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_10_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ScheduledOrderSlice)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_10_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ScheduledOrderSlice)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_10_list) AppendMutable() protoreflect.Value {
+	v := new(ScheduledOrderSlice)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_10_list) NewElement() protoreflect.Value {
+	v := new(ScheduledOrderSlice)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_GenesisState                               protoreflect.MessageDescriptor
 	fd_GenesisState_params                        protoreflect.FieldDescriptor
@@ -122,6 +275,11 @@ var (
 	fd_GenesisState_pending_spot_order_count      protoreflect.FieldDescriptor
 	fd_GenesisState_pending_perpetual_order_list  protoreflect.FieldDescriptor
 	fd_GenesisState_pending_perpetual_order_count protoreflect.FieldDescriptor
+	fd_GenesisState_order_group_list              protoreflect.FieldDescriptor
+	fd_GenesisState_order_group_count             protoreflect.FieldDescriptor
+	fd_GenesisState_scheduled_order_list          protoreflect.FieldDescriptor
+	fd_GenesisState_scheduled_order_count         protoreflect.FieldDescriptor
+	fd_GenesisState_scheduled_order_slice_list    protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -132,6 +290,11 @@ func init() {
 	fd_GenesisState_pending_spot_order_count = md_GenesisState.Fields().ByName("pending_spot_order_count")
 	fd_GenesisState_pending_perpetual_order_list = md_GenesisState.Fields().ByName("pending_perpetual_order_list")
 	fd_GenesisState_pending_perpetual_order_count = md_GenesisState.Fields().ByName("pending_perpetual_order_count")
+	fd_GenesisState_order_group_list = md_GenesisState.Fields().ByName("order_group_list")
+	fd_GenesisState_order_group_count = md_GenesisState.Fields().ByName("order_group_count")
+	fd_GenesisState_scheduled_order_list = md_GenesisState.Fields().ByName("scheduled_order_list")
+	fd_GenesisState_scheduled_order_count = md_GenesisState.Fields().ByName("scheduled_order_count")
+	fd_GenesisState_scheduled_order_slice_list = md_GenesisState.Fields().ByName("scheduled_order_slice_list")
 }
 
 var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
@@ -229,6 +392,36 @@ func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if len(x.OrderGroupList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_6_list{list: &x.OrderGroupList})
+		if !f(fd_GenesisState_order_group_list, value) {
+			return
+		}
+	}
+	if x.OrderGroupCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.OrderGroupCount)
+		if !f(fd_GenesisState_order_group_count, value) {
+			return
+		}
+	}
+	if len(x.ScheduledOrderList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_8_list{list: &x.ScheduledOrderList})
+		if !f(fd_GenesisState_scheduled_order_list, value) {
+			return
+		}
+	}
+	if x.ScheduledOrderCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ScheduledOrderCount)
+		if !f(fd_GenesisState_scheduled_order_count, value) {
+			return
+		}
+	}
+	if len(x.ScheduledOrderSliceList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_10_list{list: &x.ScheduledOrderSliceList})
+		if !f(fd_GenesisState_scheduled_order_slice_list, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -254,6 +447,16 @@ func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool
 		return len(x.PendingPerpetualOrderList) != 0
 	case "elys.tradeshield.GenesisState.pending_perpetual_order_count":
 		return x.PendingPerpetualOrderCount != uint64(0)
+	case "elys.tradeshield.GenesisState.order_group_list":
+		return len(x.OrderGroupList) != 0
+	case "elys.tradeshield.GenesisState.order_group_count":
+		return x.OrderGroupCount != uint64(0)
+	case "elys.tradeshield.GenesisState.scheduled_order_list":
+		return len(x.ScheduledOrderList) != 0
+	case "elys.tradeshield.GenesisState.scheduled_order_count":
+		return x.ScheduledOrderCount != uint64(0)
+	case "elys.tradeshield.GenesisState.scheduled_order_slice_list":
+		return len(x.ScheduledOrderSliceList) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.GenesisState"))
@@ -280,6 +483,16 @@ func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
 		x.PendingPerpetualOrderList = nil
 	case "elys.tradeshield.GenesisState.pending_perpetual_order_count":
 		x.PendingPerpetualOrderCount = uint64(0)
+	case "elys.tradeshield.GenesisState.order_group_list":
+		x.OrderGroupList = nil
+	case "elys.tradeshield.GenesisState.order_group_count":
+		x.OrderGroupCount = uint64(0)
+	case "elys.tradeshield.GenesisState.scheduled_order_list":
+		x.ScheduledOrderList = nil
+	case "elys.tradeshield.GenesisState.scheduled_order_count":
+		x.ScheduledOrderCount = uint64(0)
+	case "elys.tradeshield.GenesisState.scheduled_order_slice_list":
+		x.ScheduledOrderSliceList = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.GenesisState"))
@@ -317,6 +530,30 @@ func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescripto
 	case "elys.tradeshield.GenesisState.pending_perpetual_order_count":
 		value := x.PendingPerpetualOrderCount
 		return protoreflect.ValueOfUint64(value)
+	case "elys.tradeshield.GenesisState.order_group_list":
+		if len(x.OrderGroupList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_6_list{})
+		}
+		listValue := &_GenesisState_6_list{list: &x.OrderGroupList}
+		return protoreflect.ValueOfList(listValue)
+	case "elys.tradeshield.GenesisState.order_group_count":
+		value := x.OrderGroupCount
+		return protoreflect.ValueOfUint64(value)
+	case "elys.tradeshield.GenesisState.scheduled_order_list":
+		if len(x.ScheduledOrderList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_8_list{})
+		}
+		listValue := &_GenesisState_8_list{list: &x.ScheduledOrderList}
+		return protoreflect.ValueOfList(listValue)
+	case "elys.tradeshield.GenesisState.scheduled_order_count":
+		value := x.ScheduledOrderCount
+		return protoreflect.ValueOfUint64(value)
+	case "elys.tradeshield.GenesisState.scheduled_order_slice_list":
+		if len(x.ScheduledOrderSliceList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_10_list{})
+		}
+		listValue := &_GenesisState_10_list{list: &x.ScheduledOrderSliceList}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.GenesisState"))
@@ -351,6 +588,22 @@ func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value
 		x.PendingPerpetualOrderList = *clv.list
 	case "elys.tradeshield.GenesisState.pending_perpetual_order_count":
 		x.PendingPerpetualOrderCount = value.Uint()
+	case "elys.tradeshield.GenesisState.order_group_list":
+		lv := value.List()
+		clv := lv.(*_GenesisState_6_list)
+		x.OrderGroupList = *clv.list
+	case "elys.tradeshield.GenesisState.order_group_count":
+		x.OrderGroupCount = value.Uint()
+	case "elys.tradeshield.GenesisState.scheduled_order_list":
+		lv := value.List()
+		clv := lv.(*_GenesisState_8_list)
+		x.ScheduledOrderList = *clv.list
+	case "elys.tradeshield.GenesisState.scheduled_order_count":
+		x.ScheduledOrderCount = value.Uint()
+	case "elys.tradeshield.GenesisState.scheduled_order_slice_list":
+		lv := value.List()
+		clv := lv.(*_GenesisState_10_list)
+		x.ScheduledOrderSliceList = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.GenesisState"))
@@ -388,10 +641,32 @@ func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) p
 		}
 		value := &_GenesisState_4_list{list: &x.PendingPerpetualOrderList}
 		return protoreflect.ValueOfList(value)
+	case "elys.tradeshield.GenesisState.order_group_list":
+		if x.OrderGroupList == nil {
+			x.OrderGroupList = []*OrderGroup{}
+		}
+		value := &_GenesisState_6_list{list: &x.OrderGroupList}
+		return protoreflect.ValueOfList(value)
+	case "elys.tradeshield.GenesisState.scheduled_order_list":
+		if x.ScheduledOrderList == nil {
+			x.ScheduledOrderList = []*ScheduledOrder{}
+		}
+		value := &_GenesisState_8_list{list: &x.ScheduledOrderList}
+		return protoreflect.ValueOfList(value)
+	case "elys.tradeshield.GenesisState.scheduled_order_slice_list":
+		if x.ScheduledOrderSliceList == nil {
+			x.ScheduledOrderSliceList = []*ScheduledOrderSlice{}
+		}
+		value := &_GenesisState_10_list{list: &x.ScheduledOrderSliceList}
+		return protoreflect.ValueOfList(value)
 	case "elys.tradeshield.GenesisState.pending_spot_order_count":
 		panic(fmt.Errorf("field pending_spot_order_count of message elys.tradeshield.GenesisState is not mutable"))
 	case "elys.tradeshield.GenesisState.pending_perpetual_order_count":
 		panic(fmt.Errorf("field pending_perpetual_order_count of message elys.tradeshield.GenesisState is not mutable"))
+	case "elys.tradeshield.GenesisState.order_group_count":
+		panic(fmt.Errorf("field order_group_count of message elys.tradeshield.GenesisState is not mutable"))
+	case "elys.tradeshield.GenesisState.scheduled_order_count":
+		panic(fmt.Errorf("field scheduled_order_count of message elys.tradeshield.GenesisState is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.GenesisState"))
@@ -418,6 +693,19 @@ func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor)
 		return protoreflect.ValueOfList(&_GenesisState_4_list{list: &list})
 	case "elys.tradeshield.GenesisState.pending_perpetual_order_count":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "elys.tradeshield.GenesisState.order_group_list":
+		list := []*OrderGroup{}
+		return protoreflect.ValueOfList(&_GenesisState_6_list{list: &list})
+	case "elys.tradeshield.GenesisState.order_group_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "elys.tradeshield.GenesisState.scheduled_order_list":
+		list := []*ScheduledOrder{}
+		return protoreflect.ValueOfList(&_GenesisState_8_list{list: &list})
+	case "elys.tradeshield.GenesisState.scheduled_order_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "elys.tradeshield.GenesisState.scheduled_order_slice_list":
+		list := []*ScheduledOrderSlice{}
+		return protoreflect.ValueOfList(&_GenesisState_10_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.GenesisState"))
@@ -509,6 +797,30 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 		if x.PendingPerpetualOrderCount != 0 {
 			n += 1 + runtime.Sov(uint64(x.PendingPerpetualOrderCount))
 		}
+		if len(x.OrderGroupList) > 0 {
+			for _, e := range x.OrderGroupList {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.OrderGroupCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.OrderGroupCount))
+		}
+		if len(x.ScheduledOrderList) > 0 {
+			for _, e := range x.ScheduledOrderList {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.ScheduledOrderCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.ScheduledOrderCount))
+		}
+		if len(x.ScheduledOrderSliceList) > 0 {
+			for _, e := range x.ScheduledOrderSliceList {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -538,6 +850,64 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.ScheduledOrderSliceList) > 0 {
+			for iNdEx := len(x.ScheduledOrderSliceList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ScheduledOrderSliceList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x52
+			}
+		}
+		if x.ScheduledOrderCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ScheduledOrderCount))
+			i--
+			dAtA[i] = 0x48
+		}
+		if len(x.ScheduledOrderList) > 0 {
+			for iNdEx := len(x.ScheduledOrderList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ScheduledOrderList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x42
+			}
+		}
+		if x.OrderGroupCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OrderGroupCount))
+			i--
+			dAtA[i] = 0x38
+		}
+		if len(x.OrderGroupList) > 0 {
+			for iNdEx := len(x.OrderGroupList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.OrderGroupList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
 		if x.PendingPerpetualOrderCount != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.PendingPerpetualOrderCount))
 			i--
@@ -785,6 +1155,146 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OrderGroupList", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.OrderGroupList = append(x.OrderGroupList, &OrderGroup{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.OrderGroupList[len(x.OrderGroupList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OrderGroupCount", wireType)
+				}
+				x.OrderGroupCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OrderGroupCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ScheduledOrderList", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ScheduledOrderList = append(x.ScheduledOrderList, &ScheduledOrder{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ScheduledOrderList[len(x.ScheduledOrderList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ScheduledOrderCount", wireType)
+				}
+				x.ScheduledOrderCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ScheduledOrderCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ScheduledOrderSliceList", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ScheduledOrderSliceList = append(x.ScheduledOrderSliceList, &ScheduledOrderSlice{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ScheduledOrderSliceList[len(x.ScheduledOrderSliceList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -839,11 +1349,16 @@ type GenesisState struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Params                     *Params           `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
-	PendingSpotOrderList       []*SpotOrder      `protobuf:"bytes,2,rep,name=pending_spot_order_list,json=pendingSpotOrderList,proto3" json:"pending_spot_order_list,omitempty"`
-	PendingSpotOrderCount      uint64            `protobuf:"varint,3,opt,name=pending_spot_order_count,json=pendingSpotOrderCount,proto3" json:"pending_spot_order_count,omitempty"`
-	PendingPerpetualOrderList  []*PerpetualOrder `protobuf:"bytes,4,rep,name=pending_perpetual_order_list,json=pendingPerpetualOrderList,proto3" json:"pending_perpetual_order_list,omitempty"`
-	PendingPerpetualOrderCount uint64            `protobuf:"varint,5,opt,name=pending_perpetual_order_count,json=pendingPerpetualOrderCount,proto3" json:"pending_perpetual_order_count,omitempty"`
+	Params                     *Params                `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	PendingSpotOrderList       []*SpotOrder           `protobuf:"bytes,2,rep,name=pending_spot_order_list,json=pendingSpotOrderList,proto3" json:"pending_spot_order_list,omitempty"`
+	PendingSpotOrderCount      uint64                 `protobuf:"varint,3,opt,name=pending_spot_order_count,json=pendingSpotOrderCount,proto3" json:"pending_spot_order_count,omitempty"`
+	PendingPerpetualOrderList  []*PerpetualOrder      `protobuf:"bytes,4,rep,name=pending_perpetual_order_list,json=pendingPerpetualOrderList,proto3" json:"pending_perpetual_order_list,omitempty"`
+	PendingPerpetualOrderCount uint64                 `protobuf:"varint,5,opt,name=pending_perpetual_order_count,json=pendingPerpetualOrderCount,proto3" json:"pending_perpetual_order_count,omitempty"`
+	OrderGroupList             []*OrderGroup          `protobuf:"bytes,6,rep,name=order_group_list,json=orderGroupList,proto3" json:"order_group_list,omitempty"`
+	OrderGroupCount            uint64                 `protobuf:"varint,7,opt,name=order_group_count,json=orderGroupCount,proto3" json:"order_group_count,omitempty"`
+	ScheduledOrderList         []*ScheduledOrder      `protobuf:"bytes,8,rep,name=scheduled_order_list,json=scheduledOrderList,proto3" json:"scheduled_order_list,omitempty"`
+	ScheduledOrderCount        uint64                 `protobuf:"varint,9,opt,name=scheduled_order_count,json=scheduledOrderCount,proto3" json:"scheduled_order_count,omitempty"`
+	ScheduledOrderSliceList    []*ScheduledOrderSlice `protobuf:"bytes,10,rep,name=scheduled_order_slice_list,json=scheduledOrderSliceList,proto3" json:"scheduled_order_slice_list,omitempty"`
 }
 
 func (x *GenesisState) Reset() {
@@ -901,6 +1416,41 @@ func (x *GenesisState) GetPendingPerpetualOrderCount() uint64 {
 	return 0
 }
 
+func (x *GenesisState) GetOrderGroupList() []*OrderGroup {
+	if x != nil {
+		return x.OrderGroupList
+	}
+	return nil
+}
+
+func (x *GenesisState) GetOrderGroupCount() uint64 {
+	if x != nil {
+		return x.OrderGroupCount
+	}
+	return 0
+}
+
+func (x *GenesisState) GetScheduledOrderList() []*ScheduledOrder {
+	if x != nil {
+		return x.ScheduledOrderList
+	}
+	return nil
+}
+
+func (x *GenesisState) GetScheduledOrderCount() uint64 {
+	if x != nil {
+		return x.ScheduledOrderCount
+	}
+	return 0
+}
+
+func (x *GenesisState) GetScheduledOrderSliceList() []*ScheduledOrderSlice {
+	if x != nil {
+		return x.ScheduledOrderSliceList
+	}
+	return nil
+}
+
 var File_elys_tradeshield_genesis_proto protoreflect.FileDescriptor
 
 var file_elys_tradeshield_genesis_proto_rawDesc = []byte{
@@ -912,7 +1462,7 @@ var file_elys_tradeshield_genesis_proto_rawDesc = []byte{
 	0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d,
 	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x65, 0x6c, 0x79, 0x73, 0x2f, 0x74, 0x72,
 	0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x85, 0x03, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf7, 0x05, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69,
 	0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x36, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72,
 	0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
@@ -936,19 +1486,43 @@ var file_elys_tradeshield_genesis_proto_rawDesc = []byte{
 	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x5f,
 	0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
 	0x04, 0x52, 0x1a, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74,
-	0x75, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0xb8, 0x01,
-	0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65,
-	0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50,
-	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x31, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2f, 0x65, 0x6c, 0x79, 0x73, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f,
-	0x65, 0x6c, 0x79, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x6c, 0x79, 0x73, 0x2f, 0x74, 0x72,
-	0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0xa2, 0x02, 0x03, 0x45, 0x54, 0x58, 0xaa,
-	0x02, 0x10, 0x45, 0x6c, 0x79, 0x73, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65,
-	0x6c, 0x64, 0xca, 0x02, 0x10, 0x45, 0x6c, 0x79, 0x73, 0x5c, 0x54, 0x72, 0x61, 0x64, 0x65, 0x73,
-	0x68, 0x69, 0x65, 0x6c, 0x64, 0xe2, 0x02, 0x1c, 0x45, 0x6c, 0x79, 0x73, 0x5c, 0x54, 0x72, 0x61,
-	0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x11, 0x45, 0x6c, 0x79, 0x73, 0x3a, 0x3a, 0x54, 0x72, 0x61,
-	0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x75, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x4c, 0x0a,
+	0x10, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6c, 0x69, 0x73,
+	0x74, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74,
+	0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0e, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x58, 0x0a, 0x14, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x64, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18,
+	0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61,
+	0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x12, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x32, 0x0a, 0x15, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x5f, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x13, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x68, 0x0a, 0x1a, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x64, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x73, 0x6c, 0x69, 0x63, 0x65, 0x5f, 0x6c,
+	0x69, 0x73, 0x74, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x65, 0x6c, 0x79, 0x73,
+	0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x6c, 0x69, 0x63, 0x65,
+	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x17, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x42,
+	0xb8, 0x01, 0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61,
+	0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69,
+	0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x31, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x65, 0x6c, 0x79, 0x73, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x2f, 0x65, 0x6c, 0x79, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x6c, 0x79, 0x73, 0x2f,
+	0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0xa2, 0x02, 0x03, 0x45, 0x54,
+	0x58, 0xaa, 0x02, 0x10, 0x45, 0x6c, 0x79, 0x73, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68,
+	0x69, 0x65, 0x6c, 0x64, 0xca, 0x02, 0x10, 0x45, 0x6c, 0x79, 0x73, 0x5c, 0x54, 0x72, 0x61, 0x64,
+	0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0xe2, 0x02, 0x1c, 0x45, 0x6c, 0x79, 0x73, 0x5c, 0x54,
+	0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x11, 0x45, 0x6c, 0x79, 0x73, 0x3a, 0x3a, 0x54,
+	0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -965,20 +1539,26 @@ func file_elys_tradeshield_genesis_proto_rawDescGZIP() []byte {
 
 var file_elys_tradeshield_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_elys_tradeshield_genesis_proto_goTypes = []interface{}{
-	(*GenesisState)(nil),   // 0: elys.tradeshield.GenesisState
-	(*Params)(nil),         // 1: elys.tradeshield.Params
-	(*SpotOrder)(nil),      // 2: elys.tradeshield.SpotOrder
-	(*PerpetualOrder)(nil), // 3: elys.tradeshield.PerpetualOrder
+	(*GenesisState)(nil),        // 0: elys.tradeshield.GenesisState
+	(*Params)(nil),              // 1: elys.tradeshield.Params
+	(*SpotOrder)(nil),           // 2: elys.tradeshield.SpotOrder
+	(*PerpetualOrder)(nil),      // 3: elys.tradeshield.PerpetualOrder
+	(*OrderGroup)(nil),          // 4: elys.tradeshield.OrderGroup
+	(*ScheduledOrder)(nil),      // 5: elys.tradeshield.ScheduledOrder
+	(*ScheduledOrderSlice)(nil), // 6: elys.tradeshield.ScheduledOrderSlice
 }
 var file_elys_tradeshield_genesis_proto_depIdxs = []int32{
 	1, // 0: elys.tradeshield.GenesisState.params:type_name -> elys.tradeshield.Params
 	2, // 1: elys.tradeshield.GenesisState.pending_spot_order_list:type_name -> elys.tradeshield.SpotOrder
 	3, // 2: elys.tradeshield.GenesisState.pending_perpetual_order_list:type_name -> elys.tradeshield.PerpetualOrder
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	4, // 3: elys.tradeshield.GenesisState.order_group_list:type_name -> elys.tradeshield.OrderGroup
+	5, // 4: elys.tradeshield.GenesisState.scheduled_order_list:type_name -> elys.tradeshield.ScheduledOrder
+	6, // 5: elys.tradeshield.GenesisState.scheduled_order_slice_list:type_name -> elys.tradeshield.ScheduledOrderSlice
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_elys_tradeshield_genesis_proto_init() }
@@ -1047,6 +1047,554 @@ func (x *fastReflection_TriggerPrice) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var (
+	md_TrailingStop                 protoreflect.MessageDescriptor
+	fd_TrailingStop_trail_percent   protoreflect.FieldDescriptor
+	fd_TrailingStop_trail_amount    protoreflect.FieldDescriptor
+	fd_TrailingStop_high_water_mark protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_elys_tradeshield_order_proto_init()
+	md_TrailingStop = File_elys_tradeshield_order_proto.Messages().ByName("TrailingStop")
+	fd_TrailingStop_trail_percent = md_TrailingStop.Fields().ByName("trail_percent")
+	fd_TrailingStop_trail_amount = md_TrailingStop.Fields().ByName("trail_amount")
+	fd_TrailingStop_high_water_mark = md_TrailingStop.Fields().ByName("high_water_mark")
+}
+
+var _ protoreflect.Message = (*fastReflection_TrailingStop)(nil)
+
+type fastReflection_TrailingStop TrailingStop
+
+func (x *TrailingStop) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TrailingStop)(x)
+}
+
+func (x *TrailingStop) slowProtoReflect() protoreflect.Message {
+	mi := &file_elys_tradeshield_order_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TrailingStop_messageType fastReflection_TrailingStop_messageType
+var _ protoreflect.MessageType = fastReflection_TrailingStop_messageType{}
+
+type fastReflection_TrailingStop_messageType struct{}
+
+func (x fastReflection_TrailingStop_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TrailingStop)(nil)
+}
+func (x fastReflection_TrailingStop_messageType) New() protoreflect.Message {
+	return new(fastReflection_TrailingStop)
+}
+func (x fastReflection_TrailingStop_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TrailingStop
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TrailingStop) Descriptor() protoreflect.MessageDescriptor {
+	return md_TrailingStop
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TrailingStop) Type() protoreflect.MessageType {
+	return _fastReflection_TrailingStop_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TrailingStop) New() protoreflect.Message {
+	return new(fastReflection_TrailingStop)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TrailingStop) Interface() protoreflect.ProtoMessage {
+	return (*TrailingStop)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TrailingStop) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TrailPercent != "" {
+		value := protoreflect.ValueOfString(x.TrailPercent)
+		if !f(fd_TrailingStop_trail_percent, value) {
+			return
+		}
+	}
+	if x.TrailAmount != "" {
+		value := protoreflect.ValueOfString(x.TrailAmount)
+		if !f(fd_TrailingStop_trail_amount, value) {
+			return
+		}
+	}
+	if x.HighWaterMark != "" {
+		value := protoreflect.ValueOfString(x.HighWaterMark)
+		if !f(fd_TrailingStop_high_water_mark, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TrailingStop) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "elys.tradeshield.TrailingStop.trail_percent":
+		return x.TrailPercent != ""
+	case "elys.tradeshield.TrailingStop.trail_amount":
+		return x.TrailAmount != ""
+	case "elys.tradeshield.TrailingStop.high_water_mark":
+		return x.HighWaterMark != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.TrailingStop"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.TrailingStop does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TrailingStop) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "elys.tradeshield.TrailingStop.trail_percent":
+		x.TrailPercent = ""
+	case "elys.tradeshield.TrailingStop.trail_amount":
+		x.TrailAmount = ""
+	case "elys.tradeshield.TrailingStop.high_water_mark":
+		x.HighWaterMark = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.TrailingStop"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.TrailingStop does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TrailingStop) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "elys.tradeshield.TrailingStop.trail_percent":
+		value := x.TrailPercent
+		return protoreflect.ValueOfString(value)
+	case "elys.tradeshield.TrailingStop.trail_amount":
+		value := x.TrailAmount
+		return protoreflect.ValueOfString(value)
+	case "elys.tradeshield.TrailingStop.high_water_mark":
+		value := x.HighWaterMark
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.TrailingStop"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.TrailingStop does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TrailingStop) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "elys.tradeshield.TrailingStop.trail_percent":
+		x.TrailPercent = value.Interface().(string)
+	case "elys.tradeshield.TrailingStop.trail_amount":
+		x.TrailAmount = value.Interface().(string)
+	case "elys.tradeshield.TrailingStop.high_water_mark":
+		x.HighWaterMark = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.TrailingStop"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.TrailingStop does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TrailingStop) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "elys.tradeshield.TrailingStop.trail_percent":
+		panic(fmt.Errorf("field trail_percent of message elys.tradeshield.TrailingStop is not mutable"))
+	case "elys.tradeshield.TrailingStop.trail_amount":
+		panic(fmt.Errorf("field trail_amount of message elys.tradeshield.TrailingStop is not mutable"))
+	case "elys.tradeshield.TrailingStop.high_water_mark":
+		panic(fmt.Errorf("field high_water_mark of message elys.tradeshield.TrailingStop is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.TrailingStop"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.TrailingStop does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TrailingStop) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "elys.tradeshield.TrailingStop.trail_percent":
+		return protoreflect.ValueOfString("")
+	case "elys.tradeshield.TrailingStop.trail_amount":
+		return protoreflect.ValueOfString("")
+	case "elys.tradeshield.TrailingStop.high_water_mark":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.TrailingStop"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.TrailingStop does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TrailingStop) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in elys.tradeshield.TrailingStop", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TrailingStop) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TrailingStop) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TrailingStop) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TrailingStop) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*TrailingStop)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.TrailPercent)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.TrailAmount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.HighWaterMark)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*TrailingStop)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.HighWaterMark) > 0 {
+			i -= len(x.HighWaterMark)
+			copy(dAtA[i:], x.HighWaterMark)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.HighWaterMark)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.TrailAmount) > 0 {
+			i -= len(x.TrailAmount)
+			copy(dAtA[i:], x.TrailAmount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TrailAmount)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.TrailPercent) > 0 {
+			i -= len(x.TrailPercent)
+			copy(dAtA[i:], x.TrailPercent)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TrailPercent)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*TrailingStop)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TrailingStop: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TrailingStop: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TrailPercent", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TrailPercent = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TrailAmount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TrailAmount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HighWaterMark", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.HighWaterMark = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
 // 	protoc-gen-go v1.27.0
@@ -1068,6 +1616,8 @@ const (
 	SpotOrderType_LIMITSELL SpotOrderType = 1
 	SpotOrderType_LIMITBUY  SpotOrderType = 2
 	SpotOrderType_MARKETBUY SpotOrderType = 3
+	// Stop loss whose rate follows the highest market price at a fixed trail
+	SpotOrderType_TRAILINGSTOP SpotOrderType = 4
 )
 
 // Enum value maps for SpotOrderType.
@@ -1077,12 +1627,14 @@ var (
 		1: "LIMITSELL",
 		2: "LIMITBUY",
 		3: "MARKETBUY",
+		4: "TRAILINGSTOP",
 	}
 	SpotOrderType_value = map[string]int32{
-		"STOPLOSS":  0,
-		"LIMITSELL": 1,
-		"LIMITBUY":  2,
-		"MARKETBUY": 3,
+		"STOPLOSS":     0,
+		"LIMITSELL":    1,
+		"LIMITBUY":     2,
+		"MARKETBUY":    3,
+		"TRAILINGSTOP": 4,
 	}
 )
 
@@ -1113,6 +1665,64 @@ func (SpotOrderType) EnumDescriptor() ([]byte, []int) {
 	return file_elys_tradeshield_order_proto_rawDescGZIP(), []int{0}
 }
 
+// Time in force of a pending order
+type TimeInForce int32
+
+const (
+	// Good til cancelled, the order stays pending until executed or cancelled
+	TimeInForce_GTC TimeInForce = 0
+	// Good til time, the order is cancelled once its expiry is reached
+	TimeInForce_GTT TimeInForce = 1
+	// Immediate or cancel, the order is cancelled if it can't be executed when
+	// created
+	TimeInForce_IOC TimeInForce = 2
+	// Fill or kill, the order creation fails if it can't be executed right away
+	TimeInForce_FOK TimeInForce = 3
+)
+
+// Enum value maps for TimeInForce.
+var (
+	TimeInForce_name = map[int32]string{
+		0: "GTC",
+		1: "GTT",
+		2: "IOC",
+		3: "FOK",
+	}
+	TimeInForce_value = map[string]int32{
+		"GTC": 0,
+		"GTT": 1,
+		"IOC": 2,
+		"FOK": 3,
+	}
+)
+
+func (x TimeInForce) Enum() *TimeInForce {
+	p := new(TimeInForce)
+	*p = x
+	return p
+}
+
+func (x TimeInForce) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TimeInForce) Descriptor() protoreflect.EnumDescriptor {
+	return file_elys_tradeshield_order_proto_enumTypes[1].Descriptor()
+}
+
+func (TimeInForce) Type() protoreflect.EnumType {
+	return &file_elys_tradeshield_order_proto_enumTypes[1]
+}
+
+func (x TimeInForce) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TimeInForce.Descriptor instead.
+func (TimeInForce) EnumDescriptor() ([]byte, []int) {
+	return file_elys_tradeshield_order_proto_rawDescGZIP(), []int{1}
+}
+
 type PerpetualPosition int32
 
 const (
@@ -1146,11 +1756,11 @@ func (x PerpetualPosition) String() string {
 }
 
 func (PerpetualPosition) Descriptor() protoreflect.EnumDescriptor {
-	return file_elys_tradeshield_order_proto_enumTypes[1].Descriptor()
+	return file_elys_tradeshield_order_proto_enumTypes[2].Descriptor()
 }
 
 func (PerpetualPosition) Type() protoreflect.EnumType {
-	return &file_elys_tradeshield_order_proto_enumTypes[1]
+	return &file_elys_tradeshield_order_proto_enumTypes[2]
 }
 
 func (x PerpetualPosition) Number() protoreflect.EnumNumber {
@@ -1159,7 +1769,7 @@ func (x PerpetualPosition) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use PerpetualPosition.Descriptor instead.
 func (PerpetualPosition) EnumDescriptor() ([]byte, []int) {
-	return file_elys_tradeshield_order_proto_rawDescGZIP(), []int{1}
+	return file_elys_tradeshield_order_proto_rawDescGZIP(), []int{2}
 }
 
 type OrderPrice struct {
@@ -1256,6 +1866,63 @@ func (x *TriggerPrice) GetRate() string {
 	return ""
 }
 
+// Trail of a trailing stop order. Its stop price follows the best market
+// price seen since the order was created, at a fixed distance from it.
+type TrailingStop struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Distance as a fraction of the best price, e.g. 0.05 for 5%
+	TrailPercent string `protobuf:"bytes,1,opt,name=trail_percent,json=trailPercent,proto3" json:"trail_percent,omitempty"`
+	// Absolute distance in the quote asset, used when trail_percent is zero
+	TrailAmount string `protobuf:"bytes,2,opt,name=trail_amount,json=trailAmount,proto3" json:"trail_amount,omitempty"`
+	// Best market price seen since the order was created, the highest for
+	// stops below the market price and the lowest for stops above it
+	HighWaterMark string `protobuf:"bytes,3,opt,name=high_water_mark,json=highWaterMark,proto3" json:"high_water_mark,omitempty"`
+}
+
+func (x *TrailingStop) Reset() {
+	*x = TrailingStop{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_elys_tradeshield_order_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrailingStop) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrailingStop) ProtoMessage() {}
+
+// Deprecated: Use TrailingStop.ProtoReflect.Descriptor instead.
+func (*TrailingStop) Descriptor() ([]byte, []int) {
+	return file_elys_tradeshield_order_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TrailingStop) GetTrailPercent() string {
+	if x != nil {
+		return x.TrailPercent
+	}
+	return ""
+}
+
+func (x *TrailingStop) GetTrailAmount() string {
+	if x != nil {
+		return x.TrailAmount
+	}
+	return ""
+}
+
+func (x *TrailingStop) GetHighWaterMark() string {
+	if x != nil {
+		return x.HighWaterMark
+	}
+	return ""
+}
+
 var File_elys_tradeshield_order_proto protoreflect.FileDescriptor
 
 var file_elys_tradeshield_order_proto_rawDesc = []byte{
@@ -1284,28 +1951,50 @@ var file_elys_tradeshield_order_proto_rawDesc = []byte{
 	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b,
 	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74,
 	0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65, 0x2a,
-	0x49, 0x0a, 0x0d, 0x53, 0x70, 0x6f, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65,
-	0x12, 0x0c, 0x0a, 0x08, 0x53, 0x54, 0x4f, 0x50, 0x4c, 0x4f, 0x53, 0x53, 0x10, 0x00, 0x12, 0x0d,
-	0x0a, 0x09, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x53, 0x45, 0x4c, 0x4c, 0x10, 0x01, 0x12, 0x0c, 0x0a,
-	0x08, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x42, 0x55, 0x59, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x4d,
-	0x41, 0x52, 0x4b, 0x45, 0x54, 0x42, 0x55, 0x59, 0x10, 0x03, 0x2a, 0x39, 0x0a, 0x11, 0x50, 0x65,
-	0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x0f, 0x0a, 0x0b, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
-	0x12, 0x08, 0x0a, 0x04, 0x4c, 0x4f, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x53, 0x48,
-	0x4f, 0x52, 0x54, 0x10, 0x02, 0x42, 0xb6, 0x01, 0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x6c,
-	0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x42, 0x0a,
-	0x4f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x31, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x65, 0x6c, 0x79, 0x73, 0x2d, 0x6e, 0x65,
-	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x65, 0x6c, 0x79, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65,
-	0x6c, 0x79, 0x73, 0x2f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0xa2,
-	0x02, 0x03, 0x45, 0x54, 0x58, 0xaa, 0x02, 0x10, 0x45, 0x6c, 0x79, 0x73, 0x2e, 0x54, 0x72, 0x61,
-	0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0xca, 0x02, 0x10, 0x45, 0x6c, 0x79, 0x73, 0x5c,
-	0x54, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0xe2, 0x02, 0x1c, 0x45, 0x6c,
-	0x79, 0x73, 0x5c, 0x54, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x5c, 0x47,
-	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x11, 0x45, 0x6c, 0x79,
-	0x73, 0x3a, 0x3a, 0x54, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65, 0x22,
+	0x97, 0x02, 0x0a, 0x0c, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x6f, 0x70,
+	0x12, 0x56, 0x0a, 0x0d, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f,
+	0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61,
+	0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x69,
+	0x6c, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x54, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x69,
+	0x6c, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31,
+	0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
+	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79,
+	0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65,
+	0x63, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x59,
+	0x0a, 0x0f, 0x68, 0x69, 0x67, 0x68, 0x5f, 0x77, 0x61, 0x74, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x72,
+	0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f,
+	0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61,
+	0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0d, 0x68, 0x69, 0x67, 0x68,
+	0x57, 0x61, 0x74, 0x65, 0x72, 0x4d, 0x61, 0x72, 0x6b, 0x2a, 0x5b, 0x0a, 0x0d, 0x53, 0x70, 0x6f,
+	0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0c, 0x0a, 0x08, 0x53, 0x54,
+	0x4f, 0x50, 0x4c, 0x4f, 0x53, 0x53, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x4c, 0x49, 0x4d, 0x49,
+	0x54, 0x53, 0x45, 0x4c, 0x4c, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x4c, 0x49, 0x4d, 0x49, 0x54,
+	0x42, 0x55, 0x59, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x4d, 0x41, 0x52, 0x4b, 0x45, 0x54, 0x42,
+	0x55, 0x59, 0x10, 0x03, 0x12, 0x10, 0x0a, 0x0c, 0x54, 0x52, 0x41, 0x49, 0x4c, 0x49, 0x4e, 0x47,
+	0x53, 0x54, 0x4f, 0x50, 0x10, 0x04, 0x2a, 0x31, 0x0a, 0x0b, 0x54, 0x69, 0x6d, 0x65, 0x49, 0x6e,
+	0x46, 0x6f, 0x72, 0x63, 0x65, 0x12, 0x07, 0x0a, 0x03, 0x47, 0x54, 0x43, 0x10, 0x00, 0x12, 0x07,
+	0x0a, 0x03, 0x47, 0x54, 0x54, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x49, 0x4f, 0x43, 0x10, 0x02,
+	0x12, 0x07, 0x0a, 0x03, 0x46, 0x4f, 0x4b, 0x10, 0x03, 0x2a, 0x39, 0x0a, 0x11, 0x50, 0x65, 0x72,
+	0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0f,
+	0x0a, 0x0b, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x08, 0x0a, 0x04, 0x4c, 0x4f, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x53, 0x48, 0x4f,
+	0x52, 0x54, 0x10, 0x02, 0x42, 0xb6, 0x01, 0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x6c, 0x79,
+	0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x42, 0x0a, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x31, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x65, 0x6c, 0x79, 0x73, 0x2d, 0x6e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x65, 0x6c, 0x79, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x6c,
+	0x79, 0x73, 0x2f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0xa2, 0x02,
+	0x03, 0x45, 0x54, 0x58, 0xaa, 0x02, 0x10, 0x45, 0x6c, 0x79, 0x73, 0x2e, 0x54, 0x72, 0x61, 0x64,
+	0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0xca, 0x02, 0x10, 0x45, 0x6c, 0x79, 0x73, 0x5c, 0x54,
+	0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0xe2, 0x02, 0x1c, 0x45, 0x6c, 0x79,
+	0x73, 0x5c, 0x54, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x5c, 0x47, 0x50,
+	0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x11, 0x45, 0x6c, 0x79, 0x73,
+	0x3a, 0x3a, 0x54, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1320,13 +2009,15 @@ func file_elys_tradeshield_order_proto_rawDescGZIP() []byte {
 	return file_elys_tradeshield_order_proto_rawDescData
 }
 
-var file_elys_tradeshield_order_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_elys_tradeshield_order_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_elys_tradeshield_order_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_elys_tradeshield_order_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_elys_tradeshield_order_proto_goTypes = []interface{}{
 	(SpotOrderType)(0),     // 0: elys.tradeshield.SpotOrderType
-	(PerpetualPosition)(0), // 1: elys.tradeshield.PerpetualPosition
-	(*OrderPrice)(nil),     // 2: elys.tradeshield.OrderPrice
-	(*TriggerPrice)(nil),   // 3: elys.tradeshield.TriggerPrice
+	(TimeInForce)(0),       // 1: elys.tradeshield.TimeInForce
+	(PerpetualPosition)(0), // 2: elys.tradeshield.PerpetualPosition
+	(*OrderPrice)(nil),     // 3: elys.tradeshield.OrderPrice
+	(*TriggerPrice)(nil),   // 4: elys.tradeshield.TriggerPrice
+	(*TrailingStop)(nil),   // 5: elys.tradeshield.TrailingStop
 }
 var file_elys_tradeshield_order_proto_depIdxs = []int32{
 	0, // [0:0] is the sub-list for method output_type
@@ -1366,14 +2057,26 @@ func file_elys_tradeshield_order_proto_init() {
 				return nil
 			}
 		}
+		file_elys_tradeshield_order_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrailingStop); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_elys_tradeshield_order_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   2,
+			NumEnums:      3,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -3,7 +3,9 @@ package tradeshield
 
 import (
 	v1beta1 "cosmossdk.io/api/cosmos/base/query/v1beta1"
+	v1beta11 "cosmossdk.io/api/cosmos/base/v1beta1"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -6424,533 +6426,10551 @@ func (x *fastReflection_QueryPendingSpotOrderForAddressResponse) ProtoMethods()
 	}
 }
 
+var (
+	md_QueryGetOrderGroupRequest    protoreflect.MessageDescriptor
+	fd_QueryGetOrderGroupRequest_id protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_elys_tradeshield_query_proto_init()
+	md_QueryGetOrderGroupRequest = File_elys_tradeshield_query_proto.Messages().ByName("QueryGetOrderGroupRequest")
+	fd_QueryGetOrderGroupRequest_id = md_QueryGetOrderGroupRequest.Fields().ByName("id")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryGetOrderGroupRequest)(nil)
+
+type fastReflection_QueryGetOrderGroupRequest QueryGetOrderGroupRequest
+
+func (x *QueryGetOrderGroupRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryGetOrderGroupRequest)(x)
+}
+
+func (x *QueryGetOrderGroupRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_elys_tradeshield_query_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryGetOrderGroupRequest_messageType fastReflection_QueryGetOrderGroupRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryGetOrderGroupRequest_messageType{}
+
+type fastReflection_QueryGetOrderGroupRequest_messageType struct{}
+
+func (x fastReflection_QueryGetOrderGroupRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryGetOrderGroupRequest)(nil)
+}
+func (x fastReflection_QueryGetOrderGroupRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryGetOrderGroupRequest)
+}
+func (x fastReflection_QueryGetOrderGroupRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetOrderGroupRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryGetOrderGroupRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetOrderGroupRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryGetOrderGroupRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryGetOrderGroupRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryGetOrderGroupRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryGetOrderGroupRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryGetOrderGroupRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryGetOrderGroupRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryGetOrderGroupRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_QueryGetOrderGroupRequest_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryGetOrderGroupRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "elys.tradeshield.QueryGetOrderGroupRequest.id":
+		return x.Id != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.QueryGetOrderGroupRequest"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.QueryGetOrderGroupRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetOrderGroupRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "elys.tradeshield.QueryGetOrderGroupRequest.id":
+		x.Id = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.QueryGetOrderGroupRequest"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.QueryGetOrderGroupRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryGetOrderGroupRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "elys.tradeshield.QueryGetOrderGroupRequest.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.QueryGetOrderGroupRequest"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.QueryGetOrderGroupRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetOrderGroupRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "elys.tradeshield.QueryGetOrderGroupRequest.id":
+		x.Id = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.QueryGetOrderGroupRequest"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.QueryGetOrderGroupRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetOrderGroupRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "elys.tradeshield.QueryGetOrderGroupRequest.id":
+		panic(fmt.Errorf("field id of message elys.tradeshield.QueryGetOrderGroupRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.QueryGetOrderGroupRequest"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.QueryGetOrderGroupRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryGetOrderGroupRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "elys.tradeshield.QueryGetOrderGroupRequest.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.QueryGetOrderGroupRequest"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.QueryGetOrderGroupRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryGetOrderGroupRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in elys.tradeshield.QueryGetOrderGroupRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryGetOrderGroupRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetOrderGroupRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryGetOrderGroupRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryGetOrderGroupRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryGetOrderGroupRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryGetOrderGroupRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryGetOrderGroupRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetOrderGroupRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetOrderGroupRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryGetOrderGroupResponse_2_list)(nil)
+
+type _QueryGetOrderGroupResponse_2_list struct {
+	list *[]*SpotOrder
+}
+
+func (x *_QueryGetOrderGroupResponse_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryGetOrderGroupResponse_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryGetOrderGroupResponse_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SpotOrder)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryGetOrderGroupResponse_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SpotOrder)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryGetOrderGroupResponse_2_list) AppendMutable() protoreflect.Value {
+	v := new(SpotOrder)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryGetOrderGroupResponse_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryGetOrderGroupResponse_2_list) NewElement() protoreflect.Value {
+	v := new(SpotOrder)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryGetOrderGroupResponse_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_QueryGetOrderGroupResponse_3_list)(nil)
+
+type _QueryGetOrderGroupResponse_3_list struct {
+	list *[]*PerpetualOrderExtraInfo
+}
+
+func (x *_QueryGetOrderGroupResponse_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryGetOrderGroupResponse_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryGetOrderGroupResponse_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PerpetualOrderExtraInfo)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryGetOrderGroupResponse_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PerpetualOrderExtraInfo)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryGetOrderGroupResponse_3_list) AppendMutable() protoreflect.Value {
+	v := new(PerpetualOrderExtraInfo)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryGetOrderGroupResponse_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryGetOrderGroupResponse_3_list) NewElement() protoreflect.Value {
+	v := new(PerpetualOrderExtraInfo)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryGetOrderGroupResponse_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryGetOrderGroupResponse                  protoreflect.MessageDescriptor
+	fd_QueryGetOrderGroupResponse_order_group      protoreflect.FieldDescriptor
+	fd_QueryGetOrderGroupResponse_spot_orders      protoreflect.FieldDescriptor
+	fd_QueryGetOrderGroupResponse_perpetual_orders protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_elys_tradeshield_query_proto_init()
+	md_QueryGetOrderGroupResponse = File_elys_tradeshield_query_proto.Messages().ByName("QueryGetOrderGroupResponse")
+	fd_QueryGetOrderGroupResponse_order_group = md_QueryGetOrderGroupResponse.Fields().ByName("order_group")
+	fd_QueryGetOrderGroupResponse_spot_orders = md_QueryGetOrderGroupResponse.Fields().ByName("spot_orders")
+	fd_QueryGetOrderGroupResponse_perpetual_orders = md_QueryGetOrderGroupResponse.Fields().ByName("perpetual_orders")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryGetOrderGroupResponse)(nil)
+
+type fastReflection_QueryGetOrderGroupResponse QueryGetOrderGroupResponse
+
+func (x *QueryGetOrderGroupResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryGetOrderGroupResponse)(x)
+}
+
+func (x *QueryGetOrderGroupResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_elys_tradeshield_query_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryGetOrderGroupResponse_messageType fastReflection_QueryGetOrderGroupResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryGetOrderGroupResponse_messageType{}
+
+type fastReflection_QueryGetOrderGroupResponse_messageType struct{}
+
+func (x fastReflection_QueryGetOrderGroupResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryGetOrderGroupResponse)(nil)
+}
+func (x fastReflection_QueryGetOrderGroupResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryGetOrderGroupResponse)
+}
+func (x fastReflection_QueryGetOrderGroupResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetOrderGroupResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryGetOrderGroupResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetOrderGroupResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryGetOrderGroupResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryGetOrderGroupResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryGetOrderGroupResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryGetOrderGroupResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryGetOrderGroupResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryGetOrderGroupResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryGetOrderGroupResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.OrderGroup != nil {
+		value := protoreflect.ValueOfMessage(x.OrderGroup.ProtoReflect())
+		if !f(fd_QueryGetOrderGroupResponse_order_group, value) {
+			return
+		}
+	}
+	if len(x.SpotOrders) != 0 {
+		value := protoreflect.ValueOfList(&_QueryGetOrderGroupResponse_2_list{list: &x.SpotOrders})
+		if !f(fd_QueryGetOrderGroupResponse_spot_orders, value) {
+			return
+		}
+	}
+	if len(x.PerpetualOrders) != 0 {
+		value := protoreflect.ValueOfList(&_QueryGetOrderGroupResponse_3_list{list: &x.PerpetualOrders})
+		if !f(fd_QueryGetOrderGroupResponse_perpetual_orders, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryGetOrderGroupResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "elys.tradeshield.QueryGetOrderGroupResponse.order_group":
+		return x.OrderGroup != nil
+	case "elys.tradeshield.QueryGetOrderGroupResponse.spot_orders":
+		return len(x.SpotOrders) != 0
+	case "elys.tradeshield.QueryGetOrderGroupResponse.perpetual_orders":
+		return len(x.PerpetualOrders) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.QueryGetOrderGroupResponse"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.QueryGetOrderGroupResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetOrderGroupResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "elys.tradeshield.QueryGetOrderGroupResponse.order_group":
+		x.OrderGroup = nil
+	case "elys.tradeshield.QueryGetOrderGroupResponse.spot_orders":
+		x.SpotOrders = nil
+	case "elys.tradeshield.QueryGetOrderGroupResponse.perpetual_orders":
+		x.PerpetualOrders = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.QueryGetOrderGroupResponse"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.QueryGetOrderGroupResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryGetOrderGroupResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "elys.tradeshield.QueryGetOrderGroupResponse.order_group":
+		value := x.OrderGroup
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "elys.tradeshield.QueryGetOrderGroupResponse.spot_orders":
+		if len(x.SpotOrders) == 0 {
+			return protoreflect.ValueOfList(&_QueryGetOrderGroupResponse_2_list{})
+		}
+		listValue := &_QueryGetOrderGroupResponse_2_list{list: &x.SpotOrders}
+		return protoreflect.ValueOfList(listValue)
+	case "elys.tradeshield.QueryGetOrderGroupResponse.perpetual_orders":
+		if len(x.PerpetualOrders) == 0 {
+			return protoreflect.ValueOfList(&_QueryGetOrderGroupResponse_3_list{})
+		}
+		listValue := &_QueryGetOrderGroupResponse_3_list{list: &x.PerpetualOrders}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.QueryGetOrderGroupResponse"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.QueryGetOrderGroupResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetOrderGroupResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "elys.tradeshield.QueryGetOrderGroupResponse.order_group":
+		x.OrderGroup = value.Message().Interface().(*OrderGroup)
+	case "elys.tradeshield.QueryGetOrderGroupResponse.spot_orders":
+		lv := value.List()
+		clv := lv.(*_QueryGetOrderGroupResponse_2_list)
+		x.SpotOrders = *clv.list
+	case "elys.tradeshield.QueryGetOrderGroupResponse.perpetual_orders":
+		lv := value.List()
+		clv := lv.(*_QueryGetOrderGroupResponse_3_list)
+		x.PerpetualOrders = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.QueryGetOrderGroupResponse"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.QueryGetOrderGroupResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetOrderGroupResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "elys.tradeshield.QueryGetOrderGroupResponse.order_group":
+		if x.OrderGroup == nil {
+			x.OrderGroup = new(OrderGroup)
+		}
+		return protoreflect.ValueOfMessage(x.OrderGroup.ProtoReflect())
+	case "elys.tradeshield.QueryGetOrderGroupResponse.spot_orders":
+		if x.SpotOrders == nil {
+			x.SpotOrders = []*SpotOrder{}
+		}
+		value := &_QueryGetOrderGroupResponse_2_list{list: &x.SpotOrders}
+		return protoreflect.ValueOfList(value)
+	case "elys.tradeshield.QueryGetOrderGroupResponse.perpetual_orders":
+		if x.PerpetualOrders == nil {
+			x.PerpetualOrders = []*PerpetualOrderExtraInfo{}
+		}
+		value := &_QueryGetOrderGroupResponse_3_list{list: &x.PerpetualOrders}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.QueryGetOrderGroupResponse"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.QueryGetOrderGroupResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryGetOrderGroupResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "elys.tradeshield.QueryGetOrderGroupResponse.order_group":
+		m := new(OrderGroup)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "elys.tradeshield.QueryGetOrderGroupResponse.spot_orders":
+		list := []*SpotOrder{}
+		return protoreflect.ValueOfList(&_QueryGetOrderGroupResponse_2_list{list: &list})
+	case "elys.tradeshield.QueryGetOrderGroupResponse.perpetual_orders":
+		list := []*PerpetualOrderExtraInfo{}
+		return protoreflect.ValueOfList(&_QueryGetOrderGroupResponse_3_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.QueryGetOrderGroupResponse"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.QueryGetOrderGroupResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryGetOrderGroupResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in elys.tradeshield.QueryGetOrderGroupResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryGetOrderGroupResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetOrderGroupResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryGetOrderGroupResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryGetOrderGroupResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryGetOrderGroupResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.OrderGroup != nil {
+			l = options.Size(x.OrderGroup)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.SpotOrders) > 0 {
+			for _, e := range x.SpotOrders {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.PerpetualOrders) > 0 {
+			for _, e := range x.PerpetualOrders {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryGetOrderGroupResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.PerpetualOrders) > 0 {
+			for iNdEx := len(x.PerpetualOrders) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PerpetualOrders[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.SpotOrders) > 0 {
+			for iNdEx := len(x.SpotOrders) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.SpotOrders[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if x.OrderGroup != nil {
+			encoded, err := options.Marshal(x.OrderGroup)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryGetOrderGroupResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetOrderGroupResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetOrderGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OrderGroup", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.OrderGroup == nil {
+					x.OrderGroup = &OrderGroup{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.OrderGroup); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SpotOrders", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SpotOrders = append(x.SpotOrders, &SpotOrder{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SpotOrders[len(x.SpotOrders)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PerpetualOrders", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PerpetualOrders = append(x.PerpetualOrders, &PerpetualOrderExtraInfo{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PerpetualOrders[len(x.PerpetualOrders)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryGetScheduledOrderRequest    protoreflect.MessageDescriptor
+	fd_QueryGetScheduledOrderRequest_id protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_elys_tradeshield_query_proto_init()
+	md_QueryGetScheduledOrderRequest = File_elys_tradeshield_query_proto.Messages().ByName("QueryGetScheduledOrderRequest")
+	fd_QueryGetScheduledOrderRequest_id = md_QueryGetScheduledOrderRequest.Fields().ByName("id")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryGetScheduledOrderRequest)(nil)
+
+type fastReflection_QueryGetScheduledOrderRequest QueryGetScheduledOrderRequest
+
+func (x *QueryGetScheduledOrderRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryGetScheduledOrderRequest)(x)
+}
+
+func (x *QueryGetScheduledOrderRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_elys_tradeshield_query_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryGetScheduledOrderRequest_messageType fastReflection_QueryGetScheduledOrderRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryGetScheduledOrderRequest_messageType{}
+
+type fastReflection_QueryGetScheduledOrderRequest_messageType struct{}
+
+func (x fastReflection_QueryGetScheduledOrderRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryGetScheduledOrderRequest)(nil)
+}
+func (x fastReflection_QueryGetScheduledOrderRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryGetScheduledOrderRequest)
+}
+func (x fastReflection_QueryGetScheduledOrderRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetScheduledOrderRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryGetScheduledOrderRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetScheduledOrderRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryGetScheduledOrderRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryGetScheduledOrderRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryGetScheduledOrderRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryGetScheduledOrderRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryGetScheduledOrderRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryGetScheduledOrderRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryGetScheduledOrderRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_QueryGetScheduledOrderRequest_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryGetScheduledOrderRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "elys.tradeshield.QueryGetScheduledOrderRequest.id":
+		return x.Id != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.QueryGetScheduledOrderRequest"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.QueryGetScheduledOrderRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetScheduledOrderRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "elys.tradeshield.QueryGetScheduledOrderRequest.id":
+		x.Id = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.QueryGetScheduledOrderRequest"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.QueryGetScheduledOrderRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryGetScheduledOrderRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "elys.tradeshield.QueryGetScheduledOrderRequest.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.QueryGetScheduledOrderRequest"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.QueryGetScheduledOrderRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetScheduledOrderRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "elys.tradeshield.QueryGetScheduledOrderRequest.id":
+		x.Id = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.QueryGetScheduledOrderRequest"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.QueryGetScheduledOrderRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetScheduledOrderRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "elys.tradeshield.QueryGetScheduledOrderRequest.id":
+		panic(fmt.Errorf("field id of message elys.tradeshield.QueryGetScheduledOrderRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.QueryGetScheduledOrderRequest"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.QueryGetScheduledOrderRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryGetScheduledOrderRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "elys.tradeshield.QueryGetScheduledOrderRequest.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.QueryGetScheduledOrderRequest"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.QueryGetScheduledOrderRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryGetScheduledOrderRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in elys.tradeshield.QueryGetScheduledOrderRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryGetScheduledOrderRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetScheduledOrderRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryGetScheduledOrderRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryGetScheduledOrderRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryGetScheduledOrderRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryGetScheduledOrderRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryGetScheduledOrderRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetScheduledOrderRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetScheduledOrderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryGetScheduledOrderResponse_2_list)(nil)
+
+type _QueryGetScheduledOrderResponse_2_list struct {
+	list *[]*ScheduledOrderSlice
+}
+
+func (x *_QueryGetScheduledOrderResponse_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryGetScheduledOrderResponse_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryGetScheduledOrderResponse_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ScheduledOrderSlice)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryGetScheduledOrderResponse_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ScheduledOrderSlice)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryGetScheduledOrderResponse_2_list) AppendMutable() protoreflect.Value {
+	v := new(ScheduledOrderSlice)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryGetScheduledOrderResponse_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryGetScheduledOrderResponse_2_list) NewElement() protoreflect.Value {
+	v := new(ScheduledOrderSlice)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryGetScheduledOrderResponse_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryGetScheduledOrderResponse                 protoreflect.MessageDescriptor
+	fd_QueryGetScheduledOrderResponse_scheduled_order protoreflect.FieldDescriptor
+	fd_QueryGetScheduledOrderResponse_slices          protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_elys_tradeshield_query_proto_init()
+	md_QueryGetScheduledOrderResponse = File_elys_tradeshield_query_proto.Messages().ByName("QueryGetScheduledOrderResponse")
+	fd_QueryGetScheduledOrderResponse_scheduled_order = md_QueryGetScheduledOrderResponse.Fields().ByName("scheduled_order")
+	fd_QueryGetScheduledOrderResponse_slices = md_QueryGetScheduledOrderResponse.Fields().ByName("slices")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryGetScheduledOrderResponse)(nil)
+
+type fastReflection_QueryGetScheduledOrderResponse QueryGetScheduledOrderResponse
+
+func (x *QueryGetScheduledOrderResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryGetScheduledOrderResponse)(x)
+}
+
+func (x *QueryGetScheduledOrderResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_elys_tradeshield_query_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryGetScheduledOrderResponse_messageType fastReflection_QueryGetScheduledOrderResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryGetScheduledOrderResponse_messageType{}
+
+type fastReflection_QueryGetScheduledOrderResponse_messageType struct{}
+
+func (x fastReflection_QueryGetScheduledOrderResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryGetScheduledOrderResponse)(nil)
+}
+func (x fastReflection_QueryGetScheduledOrderResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryGetScheduledOrderResponse)
+}
+func (x fastReflection_QueryGetScheduledOrderResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetScheduledOrderResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryGetScheduledOrderResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetScheduledOrderResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryGetScheduledOrderResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryGetScheduledOrderResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryGetScheduledOrderResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryGetScheduledOrderResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryGetScheduledOrderResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryGetScheduledOrderResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryGetScheduledOrderResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ScheduledOrder != nil {
+		value := protoreflect.ValueOfMessage(x.ScheduledOrder.ProtoReflect())
+		if !f(fd_QueryGetScheduledOrderResponse_scheduled_order, value) {
+			return
+		}
+	}
+	if len(x.Slices) != 0 {
+		value := protoreflect.ValueOfList(&_QueryGetScheduledOrderResponse_2_list{list: &x.Slices})
+		if !f(fd_QueryGetScheduledOrderResponse_slices, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryGetScheduledOrderResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "elys.tradeshield.QueryGetScheduledOrderResponse.scheduled_order":
+		return x.ScheduledOrder != nil
+	case "elys.tradeshield.QueryGetScheduledOrderResponse.slices":
+		return len(x.Slices) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.QueryGetScheduledOrderResponse"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.QueryGetScheduledOrderResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetScheduledOrderResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "elys.tradeshield.QueryGetScheduledOrderResponse.scheduled_order":
+		x.ScheduledOrder = nil
+	case "elys.tradeshield.QueryGetScheduledOrderResponse.slices":
+		x.Slices = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.QueryGetScheduledOrderResponse"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.QueryGetScheduledOrderResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryGetScheduledOrderResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "elys.tradeshield.QueryGetScheduledOrderResponse.scheduled_order":
+		value := x.ScheduledOrder
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "elys.tradeshield.QueryGetScheduledOrderResponse.slices":
+		if len(x.Slices) == 0 {
+			return protoreflect.ValueOfList(&_QueryGetScheduledOrderResponse_2_list{})
+		}
+		listValue := &_QueryGetScheduledOrderResponse_2_list{list: &x.Slices}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.QueryGetScheduledOrderResponse"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.QueryGetScheduledOrderResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetScheduledOrderResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "elys.tradeshield.QueryGetScheduledOrderResponse.scheduled_order":
+		x.ScheduledOrder = value.Message().Interface().(*ScheduledOrder)
+	case "elys.tradeshield.QueryGetScheduledOrderResponse.slices":
+		lv := value.List()
+		clv := lv.(*_QueryGetScheduledOrderResponse_2_list)
+		x.Slices = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.QueryGetScheduledOrderResponse"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.QueryGetScheduledOrderResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetScheduledOrderResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "elys.tradeshield.QueryGetScheduledOrderResponse.scheduled_order":
+		if x.ScheduledOrder == nil {
+			x.ScheduledOrder = new(ScheduledOrder)
+		}
+		return protoreflect.ValueOfMessage(x.ScheduledOrder.ProtoReflect())
+	case "elys.tradeshield.QueryGetScheduledOrderResponse.slices":
+		if x.Slices == nil {
+			x.Slices = []*ScheduledOrderSlice{}
+		}
+		value := &_QueryGetScheduledOrderResponse_2_list{list: &x.Slices}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.QueryGetScheduledOrderResponse"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.QueryGetScheduledOrderResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryGetScheduledOrderResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "elys.tradeshield.QueryGetScheduledOrderResponse.scheduled_order":
+		m := new(ScheduledOrder)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "elys.tradeshield.QueryGetScheduledOrderResponse.slices":
+		list := []*ScheduledOrderSlice{}
+		return protoreflect.ValueOfList(&_QueryGetScheduledOrderResponse_2_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.QueryGetScheduledOrderResponse"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.QueryGetScheduledOrderResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryGetScheduledOrderResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in elys.tradeshield.QueryGetScheduledOrderResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryGetScheduledOrderResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetScheduledOrderResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryGetScheduledOrderResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryGetScheduledOrderResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryGetScheduledOrderResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.ScheduledOrder != nil {
+			l = options.Size(x.ScheduledOrder)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Slices) > 0 {
+			for _, e := range x.Slices {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryGetScheduledOrderResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Slices) > 0 {
+			for iNdEx := len(x.Slices) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Slices[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if x.ScheduledOrder != nil {
+			encoded, err := options.Marshal(x.ScheduledOrder)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryGetScheduledOrderResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetScheduledOrderResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetScheduledOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ScheduledOrder", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ScheduledOrder == nil {
+					x.ScheduledOrder = &ScheduledOrder{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ScheduledOrder); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Slices", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Slices = append(x.Slices, &ScheduledOrderSlice{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Slices[len(x.Slices)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_OrderBookLevel_3_list)(nil)
+
+type _OrderBookLevel_3_list struct {
+	list *[]*v1beta11.Coin
+}
+
+func (x *_OrderBookLevel_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_OrderBookLevel_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_OrderBookLevel_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta11.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_OrderBookLevel_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta11.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_OrderBookLevel_3_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta11.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_OrderBookLevel_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_OrderBookLevel_3_list) NewElement() protoreflect.Value {
+	v := new(v1beta11.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_OrderBookLevel_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_OrderBookLevel             protoreflect.MessageDescriptor
+	fd_OrderBookLevel_price       protoreflect.FieldDescriptor
+	fd_OrderBookLevel_order_count protoreflect.FieldDescriptor
+	fd_OrderBookLevel_amount      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_elys_tradeshield_query_proto_init()
+	md_OrderBookLevel = File_elys_tradeshield_query_proto.Messages().ByName("OrderBookLevel")
+	fd_OrderBookLevel_price = md_OrderBookLevel.Fields().ByName("price")
+	fd_OrderBookLevel_order_count = md_OrderBookLevel.Fields().ByName("order_count")
+	fd_OrderBookLevel_amount = md_OrderBookLevel.Fields().ByName("amount")
+}
+
+var _ protoreflect.Message = (*fastReflection_OrderBookLevel)(nil)
+
+type fastReflection_OrderBookLevel OrderBookLevel
+
+func (x *OrderBookLevel) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_OrderBookLevel)(x)
+}
+
+func (x *OrderBookLevel) slowProtoReflect() protoreflect.Message {
+	mi := &file_elys_tradeshield_query_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_OrderBookLevel_messageType fastReflection_OrderBookLevel_messageType
+var _ protoreflect.MessageType = fastReflection_OrderBookLevel_messageType{}
+
+type fastReflection_OrderBookLevel_messageType struct{}
+
+func (x fastReflection_OrderBookLevel_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_OrderBookLevel)(nil)
+}
+func (x fastReflection_OrderBookLevel_messageType) New() protoreflect.Message {
+	return new(fastReflection_OrderBookLevel)
+}
+func (x fastReflection_OrderBookLevel_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_OrderBookLevel
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_OrderBookLevel) Descriptor() protoreflect.MessageDescriptor {
+	return md_OrderBookLevel
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_OrderBookLevel) Type() protoreflect.MessageType {
+	return _fastReflection_OrderBookLevel_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_OrderBookLevel) New() protoreflect.Message {
+	return new(fastReflection_OrderBookLevel)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_OrderBookLevel) Interface() protoreflect.ProtoMessage {
+	return (*OrderBookLevel)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_OrderBookLevel) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Price != "" {
+		value := protoreflect.ValueOfString(x.Price)
+		if !f(fd_OrderBookLevel_price, value) {
+			return
+		}
+	}
+	if x.OrderCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.OrderCount)
+		if !f(fd_OrderBookLevel_order_count, value) {
+			return
+		}
+	}
+	if len(x.Amount) != 0 {
+		value := protoreflect.ValueOfList(&_OrderBookLevel_3_list{list: &x.Amount})
+		if !f(fd_OrderBookLevel_amount, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_OrderBookLevel) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "elys.tradeshield.OrderBookLevel.price":
+		return x.Price != ""
+	case "elys.tradeshield.OrderBookLevel.order_count":
+		return x.OrderCount != uint64(0)
+	case "elys.tradeshield.OrderBookLevel.amount":
+		return len(x.Amount) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.OrderBookLevel"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.OrderBookLevel does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OrderBookLevel) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "elys.tradeshield.OrderBookLevel.price":
+		x.Price = ""
+	case "elys.tradeshield.OrderBookLevel.order_count":
+		x.OrderCount = uint64(0)
+	case "elys.tradeshield.OrderBookLevel.amount":
+		x.Amount = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.OrderBookLevel"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.OrderBookLevel does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_OrderBookLevel) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "elys.tradeshield.OrderBookLevel.price":
+		value := x.Price
+		return protoreflect.ValueOfString(value)
+	case "elys.tradeshield.OrderBookLevel.order_count":
+		value := x.OrderCount
+		return protoreflect.ValueOfUint64(value)
+	case "elys.tradeshield.OrderBookLevel.amount":
+		if len(x.Amount) == 0 {
+			return protoreflect.ValueOfList(&_OrderBookLevel_3_list{})
+		}
+		listValue := &_OrderBookLevel_3_list{list: &x.Amount}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.OrderBookLevel"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.OrderBookLevel does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OrderBookLevel) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "elys.tradeshield.OrderBookLevel.price":
+		x.Price = value.Interface().(string)
+	case "elys.tradeshield.OrderBookLevel.order_count":
+		x.OrderCount = value.Uint()
+	case "elys.tradeshield.OrderBookLevel.amount":
+		lv := value.List()
+		clv := lv.(*_OrderBookLevel_3_list)
+		x.Amount = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.OrderBookLevel"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.OrderBookLevel does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OrderBookLevel) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "elys.tradeshield.OrderBookLevel.amount":
+		if x.Amount == nil {
+			x.Amount = []*v1beta11.Coin{}
+		}
+		value := &_OrderBookLevel_3_list{list: &x.Amount}
+		return protoreflect.ValueOfList(value)
+	case "elys.tradeshield.OrderBookLevel.price":
+		panic(fmt.Errorf("field price of message elys.tradeshield.OrderBookLevel is not mutable"))
+	case "elys.tradeshield.OrderBookLevel.order_count":
+		panic(fmt.Errorf("field order_count of message elys.tradeshield.OrderBookLevel is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.OrderBookLevel"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.OrderBookLevel does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_OrderBookLevel) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "elys.tradeshield.OrderBookLevel.price":
+		return protoreflect.ValueOfString("")
+	case "elys.tradeshield.OrderBookLevel.order_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "elys.tradeshield.OrderBookLevel.amount":
+		list := []*v1beta11.Coin{}
+		return protoreflect.ValueOfList(&_OrderBookLevel_3_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.OrderBookLevel"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.OrderBookLevel does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_OrderBookLevel) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in elys.tradeshield.OrderBookLevel", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_OrderBookLevel) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OrderBookLevel) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_OrderBookLevel) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_OrderBookLevel) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*OrderBookLevel)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Price)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.OrderCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.OrderCount))
+		}
+		if len(x.Amount) > 0 {
+			for _, e := range x.Amount {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*OrderBookLevel)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Amount) > 0 {
+			for iNdEx := len(x.Amount) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Amount[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if x.OrderCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OrderCount))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Price) > 0 {
+			i -= len(x.Price)
+			copy(dAtA[i:], x.Price)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Price)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*OrderBookLevel)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OrderBookLevel: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OrderBookLevel: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Price = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OrderCount", wireType)
+				}
+				x.OrderCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OrderCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Amount = append(x.Amount, &v1beta11.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Amount[len(x.Amount)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QuerySpotOrderBookRequest             protoreflect.MessageDescriptor
+	fd_QuerySpotOrderBookRequest_base_denom  protoreflect.FieldDescriptor
+	fd_QuerySpotOrderBookRequest_quote_denom protoreflect.FieldDescriptor
+	fd_QuerySpotOrderBookRequest_side        protoreflect.FieldDescriptor
+	fd_QuerySpotOrderBookRequest_bucket_size protoreflect.FieldDescriptor
+	fd_QuerySpotOrderBookRequest_pagination  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_elys_tradeshield_query_proto_init()
+	md_QuerySpotOrderBookRequest = File_elys_tradeshield_query_proto.Messages().ByName("QuerySpotOrderBookRequest")
+	fd_QuerySpotOrderBookRequest_base_denom = md_QuerySpotOrderBookRequest.Fields().ByName("base_denom")
+	fd_QuerySpotOrderBookRequest_quote_denom = md_QuerySpotOrderBookRequest.Fields().ByName("quote_denom")
+	fd_QuerySpotOrderBookRequest_side = md_QuerySpotOrderBookRequest.Fields().ByName("side")
+	fd_QuerySpotOrderBookRequest_bucket_size = md_QuerySpotOrderBookRequest.Fields().ByName("bucket_size")
+	fd_QuerySpotOrderBookRequest_pagination = md_QuerySpotOrderBookRequest.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QuerySpotOrderBookRequest)(nil)
+
+type fastReflection_QuerySpotOrderBookRequest QuerySpotOrderBookRequest
+
+func (x *QuerySpotOrderBookRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QuerySpotOrderBookRequest)(x)
+}
+
+func (x *QuerySpotOrderBookRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_elys_tradeshield_query_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QuerySpotOrderBookRequest_messageType fastReflection_QuerySpotOrderBookRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QuerySpotOrderBookRequest_messageType{}
+
+type fastReflection_QuerySpotOrderBookRequest_messageType struct{}
+
+func (x fastReflection_QuerySpotOrderBookRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QuerySpotOrderBookRequest)(nil)
+}
+func (x fastReflection_QuerySpotOrderBookRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QuerySpotOrderBookRequest)
+}
+func (x fastReflection_QuerySpotOrderBookRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySpotOrderBookRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QuerySpotOrderBookRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySpotOrderBookRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QuerySpotOrderBookRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QuerySpotOrderBookRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QuerySpotOrderBookRequest) New() protoreflect.Message {
+	return new(fastReflection_QuerySpotOrderBookRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QuerySpotOrderBookRequest) Interface() protoreflect.ProtoMessage {
+	return (*QuerySpotOrderBookRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QuerySpotOrderBookRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.BaseDenom != "" {
+		value := protoreflect.ValueOfString(x.BaseDenom)
+		if !f(fd_QuerySpotOrderBookRequest_base_denom, value) {
+			return
+		}
+	}
+	if x.QuoteDenom != "" {
+		value := protoreflect.ValueOfString(x.QuoteDenom)
+		if !f(fd_QuerySpotOrderBookRequest_quote_denom, value) {
+			return
+		}
+	}
+	if x.Side != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Side))
+		if !f(fd_QuerySpotOrderBookRequest_side, value) {
+			return
+		}
+	}
+	if x.BucketSize != "" {
+		value := protoreflect.ValueOfString(x.BucketSize)
+		if !f(fd_QuerySpotOrderBookRequest_bucket_size, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QuerySpotOrderBookRequest_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QuerySpotOrderBookRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "elys.tradeshield.QuerySpotOrderBookRequest.base_denom":
+		return x.BaseDenom != ""
+	case "elys.tradeshield.QuerySpotOrderBookRequest.quote_denom":
+		return x.QuoteDenom != ""
+	case "elys.tradeshield.QuerySpotOrderBookRequest.side":
+		return x.Side != 0
+	case "elys.tradeshield.QuerySpotOrderBookRequest.bucket_size":
+		return x.BucketSize != ""
+	case "elys.tradeshield.QuerySpotOrderBookRequest.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.QuerySpotOrderBookRequest"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.QuerySpotOrderBookRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySpotOrderBookRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "elys.tradeshield.QuerySpotOrderBookRequest.base_denom":
+		x.BaseDenom = ""
+	case "elys.tradeshield.QuerySpotOrderBookRequest.quote_denom":
+		x.QuoteDenom = ""
+	case "elys.tradeshield.QuerySpotOrderBookRequest.side":
+		x.Side = 0
+	case "elys.tradeshield.QuerySpotOrderBookRequest.bucket_size":
+		x.BucketSize = ""
+	case "elys.tradeshield.QuerySpotOrderBookRequest.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.QuerySpotOrderBookRequest"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.QuerySpotOrderBookRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QuerySpotOrderBookRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "elys.tradeshield.QuerySpotOrderBookRequest.base_denom":
+		value := x.BaseDenom
+		return protoreflect.ValueOfString(value)
+	case "elys.tradeshield.QuerySpotOrderBookRequest.quote_denom":
+		value := x.QuoteDenom
+		return protoreflect.ValueOfString(value)
+	case "elys.tradeshield.QuerySpotOrderBookRequest.side":
+		value := x.Side
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "elys.tradeshield.QuerySpotOrderBookRequest.bucket_size":
+		value := x.BucketSize
+		return protoreflect.ValueOfString(value)
+	case "elys.tradeshield.QuerySpotOrderBookRequest.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.QuerySpotOrderBookRequest"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.QuerySpotOrderBookRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySpotOrderBookRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "elys.tradeshield.QuerySpotOrderBookRequest.base_denom":
+		x.BaseDenom = value.Interface().(string)
+	case "elys.tradeshield.QuerySpotOrderBookRequest.quote_denom":
+		x.QuoteDenom = value.Interface().(string)
+	case "elys.tradeshield.QuerySpotOrderBookRequest.side":
+		x.Side = (OrderBookSide)(value.Enum())
+	case "elys.tradeshield.QuerySpotOrderBookRequest.bucket_size":
+		x.BucketSize = value.Interface().(string)
+	case "elys.tradeshield.QuerySpotOrderBookRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.QuerySpotOrderBookRequest"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.QuerySpotOrderBookRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySpotOrderBookRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "elys.tradeshield.QuerySpotOrderBookRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageRequest)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "elys.tradeshield.QuerySpotOrderBookRequest.base_denom":
+		panic(fmt.Errorf("field base_denom of message elys.tradeshield.QuerySpotOrderBookRequest is not mutable"))
+	case "elys.tradeshield.QuerySpotOrderBookRequest.quote_denom":
+		panic(fmt.Errorf("field quote_denom of message elys.tradeshield.QuerySpotOrderBookRequest is not mutable"))
+	case "elys.tradeshield.QuerySpotOrderBookRequest.side":
+		panic(fmt.Errorf("field side of message elys.tradeshield.QuerySpotOrderBookRequest is not mutable"))
+	case "elys.tradeshield.QuerySpotOrderBookRequest.bucket_size":
+		panic(fmt.Errorf("field bucket_size of message elys.tradeshield.QuerySpotOrderBookRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.QuerySpotOrderBookRequest"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.QuerySpotOrderBookRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QuerySpotOrderBookRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "elys.tradeshield.QuerySpotOrderBookRequest.base_denom":
+		return protoreflect.ValueOfString("")
+	case "elys.tradeshield.QuerySpotOrderBookRequest.quote_denom":
+		return protoreflect.ValueOfString("")
+	case "elys.tradeshield.QuerySpotOrderBookRequest.side":
+		return protoreflect.ValueOfEnum(0)
+	case "elys.tradeshield.QuerySpotOrderBookRequest.bucket_size":
+		return protoreflect.ValueOfString("")
+	case "elys.tradeshield.QuerySpotOrderBookRequest.pagination":
+		m := new(v1beta1.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.QuerySpotOrderBookRequest"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.QuerySpotOrderBookRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QuerySpotOrderBookRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in elys.tradeshield.QuerySpotOrderBookRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QuerySpotOrderBookRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySpotOrderBookRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QuerySpotOrderBookRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QuerySpotOrderBookRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QuerySpotOrderBookRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.BaseDenom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.QuoteDenom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Side != 0 {
+			n += 1 + runtime.Sov(uint64(x.Side))
+		}
+		l = len(x.BucketSize)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QuerySpotOrderBookRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.BucketSize) > 0 {
+			i -= len(x.BucketSize)
+			copy(dAtA[i:], x.BucketSize)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BucketSize)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.Side != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Side))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.QuoteDenom) > 0 {
+			i -= len(x.QuoteDenom)
+			copy(dAtA[i:], x.QuoteDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.QuoteDenom)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.BaseDenom) > 0 {
+			i -= len(x.BaseDenom)
+			copy(dAtA[i:], x.BaseDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BaseDenom)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QuerySpotOrderBookRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySpotOrderBookRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySpotOrderBookRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BaseDenom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.QuoteDenom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Side", wireType)
+				}
+				x.Side = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Side |= OrderBookSide(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BucketSize", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BucketSize = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QuerySpotOrderBookResponse_1_list)(nil)
+
+type _QuerySpotOrderBookResponse_1_list struct {
+	list *[]*OrderBookLevel
+}
+
+func (x *_QuerySpotOrderBookResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QuerySpotOrderBookResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QuerySpotOrderBookResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*OrderBookLevel)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QuerySpotOrderBookResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*OrderBookLevel)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QuerySpotOrderBookResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(OrderBookLevel)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QuerySpotOrderBookResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QuerySpotOrderBookResponse_1_list) NewElement() protoreflect.Value {
+	v := new(OrderBookLevel)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QuerySpotOrderBookResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QuerySpotOrderBookResponse            protoreflect.MessageDescriptor
+	fd_QuerySpotOrderBookResponse_levels     protoreflect.FieldDescriptor
+	fd_QuerySpotOrderBookResponse_pagination protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_elys_tradeshield_query_proto_init()
+	md_QuerySpotOrderBookResponse = File_elys_tradeshield_query_proto.Messages().ByName("QuerySpotOrderBookResponse")
+	fd_QuerySpotOrderBookResponse_levels = md_QuerySpotOrderBookResponse.Fields().ByName("levels")
+	fd_QuerySpotOrderBookResponse_pagination = md_QuerySpotOrderBookResponse.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QuerySpotOrderBookResponse)(nil)
+
+type fastReflection_QuerySpotOrderBookResponse QuerySpotOrderBookResponse
+
+func (x *QuerySpotOrderBookResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QuerySpotOrderBookResponse)(x)
+}
+
+func (x *QuerySpotOrderBookResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_elys_tradeshield_query_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QuerySpotOrderBookResponse_messageType fastReflection_QuerySpotOrderBookResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QuerySpotOrderBookResponse_messageType{}
+
+type fastReflection_QuerySpotOrderBookResponse_messageType struct{}
+
+func (x fastReflection_QuerySpotOrderBookResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QuerySpotOrderBookResponse)(nil)
+}
+func (x fastReflection_QuerySpotOrderBookResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QuerySpotOrderBookResponse)
+}
+func (x fastReflection_QuerySpotOrderBookResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySpotOrderBookResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QuerySpotOrderBookResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySpotOrderBookResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QuerySpotOrderBookResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QuerySpotOrderBookResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QuerySpotOrderBookResponse) New() protoreflect.Message {
+	return new(fastReflection_QuerySpotOrderBookResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QuerySpotOrderBookResponse) Interface() protoreflect.ProtoMessage {
+	return (*QuerySpotOrderBookResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QuerySpotOrderBookResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Levels) != 0 {
+		value := protoreflect.ValueOfList(&_QuerySpotOrderBookResponse_1_list{list: &x.Levels})
+		if !f(fd_QuerySpotOrderBookResponse_levels, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QuerySpotOrderBookResponse_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QuerySpotOrderBookResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "elys.tradeshield.QuerySpotOrderBookResponse.levels":
+		return len(x.Levels) != 0
+	case "elys.tradeshield.QuerySpotOrderBookResponse.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.QuerySpotOrderBookResponse"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.QuerySpotOrderBookResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySpotOrderBookResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "elys.tradeshield.QuerySpotOrderBookResponse.levels":
+		x.Levels = nil
+	case "elys.tradeshield.QuerySpotOrderBookResponse.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.QuerySpotOrderBookResponse"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.QuerySpotOrderBookResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QuerySpotOrderBookResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "elys.tradeshield.QuerySpotOrderBookResponse.levels":
+		if len(x.Levels) == 0 {
+			return protoreflect.ValueOfList(&_QuerySpotOrderBookResponse_1_list{})
+		}
+		listValue := &_QuerySpotOrderBookResponse_1_list{list: &x.Levels}
+		return protoreflect.ValueOfList(listValue)
+	case "elys.tradeshield.QuerySpotOrderBookResponse.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.QuerySpotOrderBookResponse"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.QuerySpotOrderBookResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySpotOrderBookResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "elys.tradeshield.QuerySpotOrderBookResponse.levels":
+		lv := value.List()
+		clv := lv.(*_QuerySpotOrderBookResponse_1_list)
+		x.Levels = *clv.list
+	case "elys.tradeshield.QuerySpotOrderBookResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.QuerySpotOrderBookResponse"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.QuerySpotOrderBookResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySpotOrderBookResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "elys.tradeshield.QuerySpotOrderBookResponse.levels":
+		if x.Levels == nil {
+			x.Levels = []*OrderBookLevel{}
+		}
+		value := &_QuerySpotOrderBookResponse_1_list{list: &x.Levels}
+		return protoreflect.ValueOfList(value)
+	case "elys.tradeshield.QuerySpotOrderBookResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.QuerySpotOrderBookResponse"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.QuerySpotOrderBookResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QuerySpotOrderBookResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "elys.tradeshield.QuerySpotOrderBookResponse.levels":
+		list := []*OrderBookLevel{}
+		return protoreflect.ValueOfList(&_QuerySpotOrderBookResponse_1_list{list: &list})
+	case "elys.tradeshield.QuerySpotOrderBookResponse.pagination":
+		m := new(v1beta1.PageResponse)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.QuerySpotOrderBookResponse"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.QuerySpotOrderBookResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QuerySpotOrderBookResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in elys.tradeshield.QuerySpotOrderBookResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QuerySpotOrderBookResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySpotOrderBookResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QuerySpotOrderBookResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QuerySpotOrderBookResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QuerySpotOrderBookResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Levels) > 0 {
+			for _, e := range x.Levels {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QuerySpotOrderBookResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Levels) > 0 {
+			for iNdEx := len(x.Levels) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Levels[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QuerySpotOrderBookResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySpotOrderBookResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySpotOrderBookResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Levels", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Levels = append(x.Levels, &OrderBookLevel{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Levels[len(x.Levels)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageResponse{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryPerpetualOrderBookRequest               protoreflect.MessageDescriptor
+	fd_QueryPerpetualOrderBookRequest_trading_asset protoreflect.FieldDescriptor
+	fd_QueryPerpetualOrderBookRequest_position      protoreflect.FieldDescriptor
+	fd_QueryPerpetualOrderBookRequest_order_type    protoreflect.FieldDescriptor
+	fd_QueryPerpetualOrderBookRequest_bucket_size   protoreflect.FieldDescriptor
+	fd_QueryPerpetualOrderBookRequest_pagination    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_elys_tradeshield_query_proto_init()
+	md_QueryPerpetualOrderBookRequest = File_elys_tradeshield_query_proto.Messages().ByName("QueryPerpetualOrderBookRequest")
+	fd_QueryPerpetualOrderBookRequest_trading_asset = md_QueryPerpetualOrderBookRequest.Fields().ByName("trading_asset")
+	fd_QueryPerpetualOrderBookRequest_position = md_QueryPerpetualOrderBookRequest.Fields().ByName("position")
+	fd_QueryPerpetualOrderBookRequest_order_type = md_QueryPerpetualOrderBookRequest.Fields().ByName("order_type")
+	fd_QueryPerpetualOrderBookRequest_bucket_size = md_QueryPerpetualOrderBookRequest.Fields().ByName("bucket_size")
+	fd_QueryPerpetualOrderBookRequest_pagination = md_QueryPerpetualOrderBookRequest.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryPerpetualOrderBookRequest)(nil)
+
+type fastReflection_QueryPerpetualOrderBookRequest QueryPerpetualOrderBookRequest
+
+func (x *QueryPerpetualOrderBookRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryPerpetualOrderBookRequest)(x)
+}
+
+func (x *QueryPerpetualOrderBookRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_elys_tradeshield_query_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryPerpetualOrderBookRequest_messageType fastReflection_QueryPerpetualOrderBookRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryPerpetualOrderBookRequest_messageType{}
+
+type fastReflection_QueryPerpetualOrderBookRequest_messageType struct{}
+
+func (x fastReflection_QueryPerpetualOrderBookRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryPerpetualOrderBookRequest)(nil)
+}
+func (x fastReflection_QueryPerpetualOrderBookRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryPerpetualOrderBookRequest)
+}
+func (x fastReflection_QueryPerpetualOrderBookRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPerpetualOrderBookRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryPerpetualOrderBookRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPerpetualOrderBookRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryPerpetualOrderBookRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryPerpetualOrderBookRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryPerpetualOrderBookRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryPerpetualOrderBookRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryPerpetualOrderBookRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryPerpetualOrderBookRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryPerpetualOrderBookRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TradingAsset != "" {
+		value := protoreflect.ValueOfString(x.TradingAsset)
+		if !f(fd_QueryPerpetualOrderBookRequest_trading_asset, value) {
+			return
+		}
+	}
+	if x.Position != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Position))
+		if !f(fd_QueryPerpetualOrderBookRequest_position, value) {
+			return
+		}
+	}
+	if x.OrderType != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.OrderType))
+		if !f(fd_QueryPerpetualOrderBookRequest_order_type, value) {
+			return
+		}
+	}
+	if x.BucketSize != "" {
+		value := protoreflect.ValueOfString(x.BucketSize)
+		if !f(fd_QueryPerpetualOrderBookRequest_bucket_size, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryPerpetualOrderBookRequest_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryPerpetualOrderBookRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "elys.tradeshield.QueryPerpetualOrderBookRequest.trading_asset":
+		return x.TradingAsset != ""
+	case "elys.tradeshield.QueryPerpetualOrderBookRequest.position":
+		return x.Position != 0
+	case "elys.tradeshield.QueryPerpetualOrderBookRequest.order_type":
+		return x.OrderType != 0
+	case "elys.tradeshield.QueryPerpetualOrderBookRequest.bucket_size":
+		return x.BucketSize != ""
+	case "elys.tradeshield.QueryPerpetualOrderBookRequest.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.QueryPerpetualOrderBookRequest"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.QueryPerpetualOrderBookRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPerpetualOrderBookRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "elys.tradeshield.QueryPerpetualOrderBookRequest.trading_asset":
+		x.TradingAsset = ""
+	case "elys.tradeshield.QueryPerpetualOrderBookRequest.position":
+		x.Position = 0
+	case "elys.tradeshield.QueryPerpetualOrderBookRequest.order_type":
+		x.OrderType = 0
+	case "elys.tradeshield.QueryPerpetualOrderBookRequest.bucket_size":
+		x.BucketSize = ""
+	case "elys.tradeshield.QueryPerpetualOrderBookRequest.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.QueryPerpetualOrderBookRequest"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.QueryPerpetualOrderBookRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryPerpetualOrderBookRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "elys.tradeshield.QueryPerpetualOrderBookRequest.trading_asset":
+		value := x.TradingAsset
+		return protoreflect.ValueOfString(value)
+	case "elys.tradeshield.QueryPerpetualOrderBookRequest.position":
+		value := x.Position
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "elys.tradeshield.QueryPerpetualOrderBookRequest.order_type":
+		value := x.OrderType
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "elys.tradeshield.QueryPerpetualOrderBookRequest.bucket_size":
+		value := x.BucketSize
+		return protoreflect.ValueOfString(value)
+	case "elys.tradeshield.QueryPerpetualOrderBookRequest.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.QueryPerpetualOrderBookRequest"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.QueryPerpetualOrderBookRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPerpetualOrderBookRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "elys.tradeshield.QueryPerpetualOrderBookRequest.trading_asset":
+		x.TradingAsset = value.Interface().(string)
+	case "elys.tradeshield.QueryPerpetualOrderBookRequest.position":
+		x.Position = (PerpetualPosition)(value.Enum())
+	case "elys.tradeshield.QueryPerpetualOrderBookRequest.order_type":
+		x.OrderType = (PerpetualOrderType)(value.Enum())
+	case "elys.tradeshield.QueryPerpetualOrderBookRequest.bucket_size":
+		x.BucketSize = value.Interface().(string)
+	case "elys.tradeshield.QueryPerpetualOrderBookRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.QueryPerpetualOrderBookRequest"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.QueryPerpetualOrderBookRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPerpetualOrderBookRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "elys.tradeshield.QueryPerpetualOrderBookRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageRequest)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "elys.tradeshield.QueryPerpetualOrderBookRequest.trading_asset":
+		panic(fmt.Errorf("field trading_asset of message elys.tradeshield.QueryPerpetualOrderBookRequest is not mutable"))
+	case "elys.tradeshield.QueryPerpetualOrderBookRequest.position":
+		panic(fmt.Errorf("field position of message elys.tradeshield.QueryPerpetualOrderBookRequest is not mutable"))
+	case "elys.tradeshield.QueryPerpetualOrderBookRequest.order_type":
+		panic(fmt.Errorf("field order_type of message elys.tradeshield.QueryPerpetualOrderBookRequest is not mutable"))
+	case "elys.tradeshield.QueryPerpetualOrderBookRequest.bucket_size":
+		panic(fmt.Errorf("field bucket_size of message elys.tradeshield.QueryPerpetualOrderBookRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.QueryPerpetualOrderBookRequest"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.QueryPerpetualOrderBookRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryPerpetualOrderBookRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "elys.tradeshield.QueryPerpetualOrderBookRequest.trading_asset":
+		return protoreflect.ValueOfString("")
+	case "elys.tradeshield.QueryPerpetualOrderBookRequest.position":
+		return protoreflect.ValueOfEnum(0)
+	case "elys.tradeshield.QueryPerpetualOrderBookRequest.order_type":
+		return protoreflect.ValueOfEnum(0)
+	case "elys.tradeshield.QueryPerpetualOrderBookRequest.bucket_size":
+		return protoreflect.ValueOfString("")
+	case "elys.tradeshield.QueryPerpetualOrderBookRequest.pagination":
+		m := new(v1beta1.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.QueryPerpetualOrderBookRequest"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.QueryPerpetualOrderBookRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryPerpetualOrderBookRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in elys.tradeshield.QueryPerpetualOrderBookRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryPerpetualOrderBookRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPerpetualOrderBookRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryPerpetualOrderBookRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryPerpetualOrderBookRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryPerpetualOrderBookRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.TradingAsset)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Position != 0 {
+			n += 1 + runtime.Sov(uint64(x.Position))
+		}
+		if x.OrderType != 0 {
+			n += 1 + runtime.Sov(uint64(x.OrderType))
+		}
+		l = len(x.BucketSize)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryPerpetualOrderBookRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.BucketSize) > 0 {
+			i -= len(x.BucketSize)
+			copy(dAtA[i:], x.BucketSize)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BucketSize)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.OrderType != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OrderType))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Position != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Position))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.TradingAsset) > 0 {
+			i -= len(x.TradingAsset)
+			copy(dAtA[i:], x.TradingAsset)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TradingAsset)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryPerpetualOrderBookRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPerpetualOrderBookRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPerpetualOrderBookRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TradingAsset", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TradingAsset = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+				}
+				x.Position = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Position |= PerpetualPosition(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OrderType", wireType)
+				}
+				x.OrderType = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OrderType |= PerpetualOrderType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BucketSize", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BucketSize = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryPerpetualOrderBookResponse_1_list)(nil)
+
+type _QueryPerpetualOrderBookResponse_1_list struct {
+	list *[]*OrderBookLevel
+}
+
+func (x *_QueryPerpetualOrderBookResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryPerpetualOrderBookResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryPerpetualOrderBookResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*OrderBookLevel)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryPerpetualOrderBookResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*OrderBookLevel)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryPerpetualOrderBookResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(OrderBookLevel)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryPerpetualOrderBookResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryPerpetualOrderBookResponse_1_list) NewElement() protoreflect.Value {
+	v := new(OrderBookLevel)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryPerpetualOrderBookResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryPerpetualOrderBookResponse            protoreflect.MessageDescriptor
+	fd_QueryPerpetualOrderBookResponse_levels     protoreflect.FieldDescriptor
+	fd_QueryPerpetualOrderBookResponse_pagination protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_elys_tradeshield_query_proto_init()
+	md_QueryPerpetualOrderBookResponse = File_elys_tradeshield_query_proto.Messages().ByName("QueryPerpetualOrderBookResponse")
+	fd_QueryPerpetualOrderBookResponse_levels = md_QueryPerpetualOrderBookResponse.Fields().ByName("levels")
+	fd_QueryPerpetualOrderBookResponse_pagination = md_QueryPerpetualOrderBookResponse.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryPerpetualOrderBookResponse)(nil)
+
+type fastReflection_QueryPerpetualOrderBookResponse QueryPerpetualOrderBookResponse
+
+func (x *QueryPerpetualOrderBookResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryPerpetualOrderBookResponse)(x)
+}
+
+func (x *QueryPerpetualOrderBookResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_elys_tradeshield_query_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryPerpetualOrderBookResponse_messageType fastReflection_QueryPerpetualOrderBookResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryPerpetualOrderBookResponse_messageType{}
+
+type fastReflection_QueryPerpetualOrderBookResponse_messageType struct{}
+
+func (x fastReflection_QueryPerpetualOrderBookResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryPerpetualOrderBookResponse)(nil)
+}
+func (x fastReflection_QueryPerpetualOrderBookResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryPerpetualOrderBookResponse)
+}
+func (x fastReflection_QueryPerpetualOrderBookResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPerpetualOrderBookResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryPerpetualOrderBookResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPerpetualOrderBookResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryPerpetualOrderBookResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryPerpetualOrderBookResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryPerpetualOrderBookResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryPerpetualOrderBookResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryPerpetualOrderBookResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryPerpetualOrderBookResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryPerpetualOrderBookResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Levels) != 0 {
+		value := protoreflect.ValueOfList(&_QueryPerpetualOrderBookResponse_1_list{list: &x.Levels})
+		if !f(fd_QueryPerpetualOrderBookResponse_levels, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryPerpetualOrderBookResponse_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryPerpetualOrderBookResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "elys.tradeshield.QueryPerpetualOrderBookResponse.levels":
+		return len(x.Levels) != 0
+	case "elys.tradeshield.QueryPerpetualOrderBookResponse.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.QueryPerpetualOrderBookResponse"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.QueryPerpetualOrderBookResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPerpetualOrderBookResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "elys.tradeshield.QueryPerpetualOrderBookResponse.levels":
+		x.Levels = nil
+	case "elys.tradeshield.QueryPerpetualOrderBookResponse.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.QueryPerpetualOrderBookResponse"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.QueryPerpetualOrderBookResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryPerpetualOrderBookResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "elys.tradeshield.QueryPerpetualOrderBookResponse.levels":
+		if len(x.Levels) == 0 {
+			return protoreflect.ValueOfList(&_QueryPerpetualOrderBookResponse_1_list{})
+		}
+		listValue := &_QueryPerpetualOrderBookResponse_1_list{list: &x.Levels}
+		return protoreflect.ValueOfList(listValue)
+	case "elys.tradeshield.QueryPerpetualOrderBookResponse.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.QueryPerpetualOrderBookResponse"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.QueryPerpetualOrderBookResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPerpetualOrderBookResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "elys.tradeshield.QueryPerpetualOrderBookResponse.levels":
+		lv := value.List()
+		clv := lv.(*_QueryPerpetualOrderBookResponse_1_list)
+		x.Levels = *clv.list
+	case "elys.tradeshield.QueryPerpetualOrderBookResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.QueryPerpetualOrderBookResponse"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.QueryPerpetualOrderBookResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPerpetualOrderBookResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "elys.tradeshield.QueryPerpetualOrderBookResponse.levels":
+		if x.Levels == nil {
+			x.Levels = []*OrderBookLevel{}
+		}
+		value := &_QueryPerpetualOrderBookResponse_1_list{list: &x.Levels}
+		return protoreflect.ValueOfList(value)
+	case "elys.tradeshield.QueryPerpetualOrderBookResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.QueryPerpetualOrderBookResponse"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.QueryPerpetualOrderBookResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryPerpetualOrderBookResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "elys.tradeshield.QueryPerpetualOrderBookResponse.levels":
+		list := []*OrderBookLevel{}
+		return protoreflect.ValueOfList(&_QueryPerpetualOrderBookResponse_1_list{list: &list})
+	case "elys.tradeshield.QueryPerpetualOrderBookResponse.pagination":
+		m := new(v1beta1.PageResponse)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.QueryPerpetualOrderBookResponse"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.QueryPerpetualOrderBookResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryPerpetualOrderBookResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in elys.tradeshield.QueryPerpetualOrderBookResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryPerpetualOrderBookResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPerpetualOrderBookResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryPerpetualOrderBookResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryPerpetualOrderBookResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryPerpetualOrderBookResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Levels) > 0 {
+			for _, e := range x.Levels {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryPerpetualOrderBookResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Levels) > 0 {
+			for iNdEx := len(x.Levels) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Levels[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryPerpetualOrderBookResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPerpetualOrderBookResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPerpetualOrderBookResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Levels", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Levels = append(x.Levels, &OrderBookLevel{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Levels[len(x.Levels)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageResponse{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QuerySimulateSpotOrderRequest                    protoreflect.MessageDescriptor
+	fd_QuerySimulateSpotOrderRequest_owner_address      protoreflect.FieldDescriptor
+	fd_QuerySimulateSpotOrderRequest_order_type         protoreflect.FieldDescriptor
+	fd_QuerySimulateSpotOrderRequest_order_price        protoreflect.FieldDescriptor
+	fd_QuerySimulateSpotOrderRequest_order_amount       protoreflect.FieldDescriptor
+	fd_QuerySimulateSpotOrderRequest_order_target_denom protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_elys_tradeshield_query_proto_init()
+	md_QuerySimulateSpotOrderRequest = File_elys_tradeshield_query_proto.Messages().ByName("QuerySimulateSpotOrderRequest")
+	fd_QuerySimulateSpotOrderRequest_owner_address = md_QuerySimulateSpotOrderRequest.Fields().ByName("owner_address")
+	fd_QuerySimulateSpotOrderRequest_order_type = md_QuerySimulateSpotOrderRequest.Fields().ByName("order_type")
+	fd_QuerySimulateSpotOrderRequest_order_price = md_QuerySimulateSpotOrderRequest.Fields().ByName("order_price")
+	fd_QuerySimulateSpotOrderRequest_order_amount = md_QuerySimulateSpotOrderRequest.Fields().ByName("order_amount")
+	fd_QuerySimulateSpotOrderRequest_order_target_denom = md_QuerySimulateSpotOrderRequest.Fields().ByName("order_target_denom")
+}
+
+var _ protoreflect.Message = (*fastReflection_QuerySimulateSpotOrderRequest)(nil)
+
+type fastReflection_QuerySimulateSpotOrderRequest QuerySimulateSpotOrderRequest
+
+func (x *QuerySimulateSpotOrderRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QuerySimulateSpotOrderRequest)(x)
+}
+
+func (x *QuerySimulateSpotOrderRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_elys_tradeshield_query_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QuerySimulateSpotOrderRequest_messageType fastReflection_QuerySimulateSpotOrderRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QuerySimulateSpotOrderRequest_messageType{}
+
+type fastReflection_QuerySimulateSpotOrderRequest_messageType struct{}
+
+func (x fastReflection_QuerySimulateSpotOrderRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QuerySimulateSpotOrderRequest)(nil)
+}
+func (x fastReflection_QuerySimulateSpotOrderRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QuerySimulateSpotOrderRequest)
+}
+func (x fastReflection_QuerySimulateSpotOrderRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySimulateSpotOrderRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QuerySimulateSpotOrderRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySimulateSpotOrderRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QuerySimulateSpotOrderRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QuerySimulateSpotOrderRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QuerySimulateSpotOrderRequest) New() protoreflect.Message {
+	return new(fastReflection_QuerySimulateSpotOrderRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QuerySimulateSpotOrderRequest) Interface() protoreflect.ProtoMessage {
+	return (*QuerySimulateSpotOrderRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QuerySimulateSpotOrderRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.OwnerAddress != "" {
+		value := protoreflect.ValueOfString(x.OwnerAddress)
+		if !f(fd_QuerySimulateSpotOrderRequest_owner_address, value) {
+			return
+		}
+	}
+	if x.OrderType != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.OrderType))
+		if !f(fd_QuerySimulateSpotOrderRequest_order_type, value) {
+			return
+		}
+	}
+	if x.OrderPrice != nil {
+		value := protoreflect.ValueOfMessage(x.OrderPrice.ProtoReflect())
+		if !f(fd_QuerySimulateSpotOrderRequest_order_price, value) {
+			return
+		}
+	}
+	if x.OrderAmount != nil {
+		value := protoreflect.ValueOfMessage(x.OrderAmount.ProtoReflect())
+		if !f(fd_QuerySimulateSpotOrderRequest_order_amount, value) {
+			return
+		}
+	}
+	if x.OrderTargetDenom != "" {
+		value := protoreflect.ValueOfString(x.OrderTargetDenom)
+		if !f(fd_QuerySimulateSpotOrderRequest_order_target_denom, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QuerySimulateSpotOrderRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "elys.tradeshield.QuerySimulateSpotOrderRequest.owner_address":
+		return x.OwnerAddress != ""
+	case "elys.tradeshield.QuerySimulateSpotOrderRequest.order_type":
+		return x.OrderType != 0
+	case "elys.tradeshield.QuerySimulateSpotOrderRequest.order_price":
+		return x.OrderPrice != nil
+	case "elys.tradeshield.QuerySimulateSpotOrderRequest.order_amount":
+		return x.OrderAmount != nil
+	case "elys.tradeshield.QuerySimulateSpotOrderRequest.order_target_denom":
+		return x.OrderTargetDenom != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.QuerySimulateSpotOrderRequest"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.QuerySimulateSpotOrderRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySimulateSpotOrderRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "elys.tradeshield.QuerySimulateSpotOrderRequest.owner_address":
+		x.OwnerAddress = ""
+	case "elys.tradeshield.QuerySimulateSpotOrderRequest.order_type":
+		x.OrderType = 0
+	case "elys.tradeshield.QuerySimulateSpotOrderRequest.order_price":
+		x.OrderPrice = nil
+	case "elys.tradeshield.QuerySimulateSpotOrderRequest.order_amount":
+		x.OrderAmount = nil
+	case "elys.tradeshield.QuerySimulateSpotOrderRequest.order_target_denom":
+		x.OrderTargetDenom = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.QuerySimulateSpotOrderRequest"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.QuerySimulateSpotOrderRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QuerySimulateSpotOrderRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "elys.tradeshield.QuerySimulateSpotOrderRequest.owner_address":
+		value := x.OwnerAddress
+		return protoreflect.ValueOfString(value)
+	case "elys.tradeshield.QuerySimulateSpotOrderRequest.order_type":
+		value := x.OrderType
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "elys.tradeshield.QuerySimulateSpotOrderRequest.order_price":
+		value := x.OrderPrice
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "elys.tradeshield.QuerySimulateSpotOrderRequest.order_amount":
+		value := x.OrderAmount
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "elys.tradeshield.QuerySimulateSpotOrderRequest.order_target_denom":
+		value := x.OrderTargetDenom
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.QuerySimulateSpotOrderRequest"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.QuerySimulateSpotOrderRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySimulateSpotOrderRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "elys.tradeshield.QuerySimulateSpotOrderRequest.owner_address":
+		x.OwnerAddress = value.Interface().(string)
+	case "elys.tradeshield.QuerySimulateSpotOrderRequest.order_type":
+		x.OrderType = (SpotOrderType)(value.Enum())
+	case "elys.tradeshield.QuerySimulateSpotOrderRequest.order_price":
+		x.OrderPrice = value.Message().Interface().(*OrderPrice)
+	case "elys.tradeshield.QuerySimulateSpotOrderRequest.order_amount":
+		x.OrderAmount = value.Message().Interface().(*v1beta11.Coin)
+	case "elys.tradeshield.QuerySimulateSpotOrderRequest.order_target_denom":
+		x.OrderTargetDenom = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.QuerySimulateSpotOrderRequest"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.QuerySimulateSpotOrderRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySimulateSpotOrderRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "elys.tradeshield.QuerySimulateSpotOrderRequest.order_price":
+		if x.OrderPrice == nil {
+			x.OrderPrice = new(OrderPrice)
+		}
+		return protoreflect.ValueOfMessage(x.OrderPrice.ProtoReflect())
+	case "elys.tradeshield.QuerySimulateSpotOrderRequest.order_amount":
+		if x.OrderAmount == nil {
+			x.OrderAmount = new(v1beta11.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.OrderAmount.ProtoReflect())
+	case "elys.tradeshield.QuerySimulateSpotOrderRequest.owner_address":
+		panic(fmt.Errorf("field owner_address of message elys.tradeshield.QuerySimulateSpotOrderRequest is not mutable"))
+	case "elys.tradeshield.QuerySimulateSpotOrderRequest.order_type":
+		panic(fmt.Errorf("field order_type of message elys.tradeshield.QuerySimulateSpotOrderRequest is not mutable"))
+	case "elys.tradeshield.QuerySimulateSpotOrderRequest.order_target_denom":
+		panic(fmt.Errorf("field order_target_denom of message elys.tradeshield.QuerySimulateSpotOrderRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.QuerySimulateSpotOrderRequest"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.QuerySimulateSpotOrderRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QuerySimulateSpotOrderRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "elys.tradeshield.QuerySimulateSpotOrderRequest.owner_address":
+		return protoreflect.ValueOfString("")
+	case "elys.tradeshield.QuerySimulateSpotOrderRequest.order_type":
+		return protoreflect.ValueOfEnum(0)
+	case "elys.tradeshield.QuerySimulateSpotOrderRequest.order_price":
+		m := new(OrderPrice)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "elys.tradeshield.QuerySimulateSpotOrderRequest.order_amount":
+		m := new(v1beta11.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "elys.tradeshield.QuerySimulateSpotOrderRequest.order_target_denom":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.QuerySimulateSpotOrderRequest"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.QuerySimulateSpotOrderRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QuerySimulateSpotOrderRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in elys.tradeshield.QuerySimulateSpotOrderRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QuerySimulateSpotOrderRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySimulateSpotOrderRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QuerySimulateSpotOrderRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QuerySimulateSpotOrderRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QuerySimulateSpotOrderRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.OwnerAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.OrderType != 0 {
+			n += 1 + runtime.Sov(uint64(x.OrderType))
+		}
+		if x.OrderPrice != nil {
+			l = options.Size(x.OrderPrice)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.OrderAmount != nil {
+			l = options.Size(x.OrderAmount)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.OrderTargetDenom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QuerySimulateSpotOrderRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.OrderTargetDenom) > 0 {
+			i -= len(x.OrderTargetDenom)
+			copy(dAtA[i:], x.OrderTargetDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OrderTargetDenom)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.OrderAmount != nil {
+			encoded, err := options.Marshal(x.OrderAmount)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.OrderPrice != nil {
+			encoded, err := options.Marshal(x.OrderPrice)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.OrderType != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OrderType))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.OwnerAddress) > 0 {
+			i -= len(x.OwnerAddress)
+			copy(dAtA[i:], x.OwnerAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OwnerAddress)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QuerySimulateSpotOrderRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySimulateSpotOrderRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySimulateSpotOrderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.OwnerAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OrderType", wireType)
+				}
+				x.OrderType = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OrderType |= SpotOrderType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OrderPrice", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.OrderPrice == nil {
+					x.OrderPrice = &OrderPrice{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.OrderPrice); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OrderAmount", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.OrderAmount == nil {
+					x.OrderAmount = &v1beta11.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.OrderAmount); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OrderTargetDenom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.OrderTargetDenom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QuerySimulateSpotOrderResponse                      protoreflect.MessageDescriptor
+	fd_QuerySimulateSpotOrderResponse_amount_out           protoreflect.FieldDescriptor
+	fd_QuerySimulateSpotOrderResponse_spot_price           protoreflect.FieldDescriptor
+	fd_QuerySimulateSpotOrderResponse_swap_fee             protoreflect.FieldDescriptor
+	fd_QuerySimulateSpotOrderResponse_discount             protoreflect.FieldDescriptor
+	fd_QuerySimulateSpotOrderResponse_slippage             protoreflect.FieldDescriptor
+	fd_QuerySimulateSpotOrderResponse_price_impact         protoreflect.FieldDescriptor
+	fd_QuerySimulateSpotOrderResponse_weight_balance_ratio protoreflect.FieldDescriptor
+	fd_QuerySimulateSpotOrderResponse_market_price         protoreflect.FieldDescriptor
+	fd_QuerySimulateSpotOrderResponse_executable           protoreflect.FieldDescriptor
+	fd_QuerySimulateSpotOrderResponse_fill_amount          protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_elys_tradeshield_query_proto_init()
+	md_QuerySimulateSpotOrderResponse = File_elys_tradeshield_query_proto.Messages().ByName("QuerySimulateSpotOrderResponse")
+	fd_QuerySimulateSpotOrderResponse_amount_out = md_QuerySimulateSpotOrderResponse.Fields().ByName("amount_out")
+	fd_QuerySimulateSpotOrderResponse_spot_price = md_QuerySimulateSpotOrderResponse.Fields().ByName("spot_price")
+	fd_QuerySimulateSpotOrderResponse_swap_fee = md_QuerySimulateSpotOrderResponse.Fields().ByName("swap_fee")
+	fd_QuerySimulateSpotOrderResponse_discount = md_QuerySimulateSpotOrderResponse.Fields().ByName("discount")
+	fd_QuerySimulateSpotOrderResponse_slippage = md_QuerySimulateSpotOrderResponse.Fields().ByName("slippage")
+	fd_QuerySimulateSpotOrderResponse_price_impact = md_QuerySimulateSpotOrderResponse.Fields().ByName("price_impact")
+	fd_QuerySimulateSpotOrderResponse_weight_balance_ratio = md_QuerySimulateSpotOrderResponse.Fields().ByName("weight_balance_ratio")
+	fd_QuerySimulateSpotOrderResponse_market_price = md_QuerySimulateSpotOrderResponse.Fields().ByName("market_price")
+	fd_QuerySimulateSpotOrderResponse_executable = md_QuerySimulateSpotOrderResponse.Fields().ByName("executable")
+	fd_QuerySimulateSpotOrderResponse_fill_amount = md_QuerySimulateSpotOrderResponse.Fields().ByName("fill_amount")
+}
+
+var _ protoreflect.Message = (*fastReflection_QuerySimulateSpotOrderResponse)(nil)
+
+type fastReflection_QuerySimulateSpotOrderResponse QuerySimulateSpotOrderResponse
+
+func (x *QuerySimulateSpotOrderResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QuerySimulateSpotOrderResponse)(x)
+}
+
+func (x *QuerySimulateSpotOrderResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_elys_tradeshield_query_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QuerySimulateSpotOrderResponse_messageType fastReflection_QuerySimulateSpotOrderResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QuerySimulateSpotOrderResponse_messageType{}
+
+type fastReflection_QuerySimulateSpotOrderResponse_messageType struct{}
+
+func (x fastReflection_QuerySimulateSpotOrderResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QuerySimulateSpotOrderResponse)(nil)
+}
+func (x fastReflection_QuerySimulateSpotOrderResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QuerySimulateSpotOrderResponse)
+}
+func (x fastReflection_QuerySimulateSpotOrderResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySimulateSpotOrderResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QuerySimulateSpotOrderResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySimulateSpotOrderResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QuerySimulateSpotOrderResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QuerySimulateSpotOrderResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QuerySimulateSpotOrderResponse) New() protoreflect.Message {
+	return new(fastReflection_QuerySimulateSpotOrderResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QuerySimulateSpotOrderResponse) Interface() protoreflect.ProtoMessage {
+	return (*QuerySimulateSpotOrderResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QuerySimulateSpotOrderResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.AmountOut != nil {
+		value := protoreflect.ValueOfMessage(x.AmountOut.ProtoReflect())
+		if !f(fd_QuerySimulateSpotOrderResponse_amount_out, value) {
+			return
+		}
+	}
+	if x.SpotPrice != "" {
+		value := protoreflect.ValueOfString(x.SpotPrice)
+		if !f(fd_QuerySimulateSpotOrderResponse_spot_price, value) {
+			return
+		}
+	}
+	if x.SwapFee != "" {
+		value := protoreflect.ValueOfString(x.SwapFee)
+		if !f(fd_QuerySimulateSpotOrderResponse_swap_fee, value) {
+			return
+		}
+	}
+	if x.Discount != "" {
+		value := protoreflect.ValueOfString(x.Discount)
+		if !f(fd_QuerySimulateSpotOrderResponse_discount, value) {
+			return
+		}
+	}
+	if x.Slippage != "" {
+		value := protoreflect.ValueOfString(x.Slippage)
+		if !f(fd_QuerySimulateSpotOrderResponse_slippage, value) {
+			return
+		}
+	}
+	if x.PriceImpact != "" {
+		value := protoreflect.ValueOfString(x.PriceImpact)
+		if !f(fd_QuerySimulateSpotOrderResponse_price_impact, value) {
+			return
+		}
+	}
+	if x.WeightBalanceRatio != "" {
+		value := protoreflect.ValueOfString(x.WeightBalanceRatio)
+		if !f(fd_QuerySimulateSpotOrderResponse_weight_balance_ratio, value) {
+			return
+		}
+	}
+	if x.MarketPrice != "" {
+		value := protoreflect.ValueOfString(x.MarketPrice)
+		if !f(fd_QuerySimulateSpotOrderResponse_market_price, value) {
+			return
+		}
+	}
+	if x.Executable != false {
+		value := protoreflect.ValueOfBool(x.Executable)
+		if !f(fd_QuerySimulateSpotOrderResponse_executable, value) {
+			return
+		}
+	}
+	if x.FillAmount != nil {
+		value := protoreflect.ValueOfMessage(x.FillAmount.ProtoReflect())
+		if !f(fd_QuerySimulateSpotOrderResponse_fill_amount, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QuerySimulateSpotOrderResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "elys.tradeshield.QuerySimulateSpotOrderResponse.amount_out":
+		return x.AmountOut != nil
+	case "elys.tradeshield.QuerySimulateSpotOrderResponse.spot_price":
+		return x.SpotPrice != ""
+	case "elys.tradeshield.QuerySimulateSpotOrderResponse.swap_fee":
+		return x.SwapFee != ""
+	case "elys.tradeshield.QuerySimulateSpotOrderResponse.discount":
+		return x.Discount != ""
+	case "elys.tradeshield.QuerySimulateSpotOrderResponse.slippage":
+		return x.Slippage != ""
+	case "elys.tradeshield.QuerySimulateSpotOrderResponse.price_impact":
+		return x.PriceImpact != ""
+	case "elys.tradeshield.QuerySimulateSpotOrderResponse.weight_balance_ratio":
+		return x.WeightBalanceRatio != ""
+	case "elys.tradeshield.QuerySimulateSpotOrderResponse.market_price":
+		return x.MarketPrice != ""
+	case "elys.tradeshield.QuerySimulateSpotOrderResponse.executable":
+		return x.Executable != false
+	case "elys.tradeshield.QuerySimulateSpotOrderResponse.fill_amount":
+		return x.FillAmount != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.QuerySimulateSpotOrderResponse"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.QuerySimulateSpotOrderResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySimulateSpotOrderResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "elys.tradeshield.QuerySimulateSpotOrderResponse.amount_out":
+		x.AmountOut = nil
+	case "elys.tradeshield.QuerySimulateSpotOrderResponse.spot_price":
+		x.SpotPrice = ""
+	case "elys.tradeshield.QuerySimulateSpotOrderResponse.swap_fee":
+		x.SwapFee = ""
+	case "elys.tradeshield.QuerySimulateSpotOrderResponse.discount":
+		x.Discount = ""
+	case "elys.tradeshield.QuerySimulateSpotOrderResponse.slippage":
+		x.Slippage = ""
+	case "elys.tradeshield.QuerySimulateSpotOrderResponse.price_impact":
+		x.PriceImpact = ""
+	case "elys.tradeshield.QuerySimulateSpotOrderResponse.weight_balance_ratio":
+		x.WeightBalanceRatio = ""
+	case "elys.tradeshield.QuerySimulateSpotOrderResponse.market_price":
+		x.MarketPrice = ""
+	case "elys.tradeshield.QuerySimulateSpotOrderResponse.executable":
+		x.Executable = false
+	case "elys.tradeshield.QuerySimulateSpotOrderResponse.fill_amount":
+		x.FillAmount = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.QuerySimulateSpotOrderResponse"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.QuerySimulateSpotOrderResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QuerySimulateSpotOrderResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "elys.tradeshield.QuerySimulateSpotOrderResponse.amount_out":
+		value := x.AmountOut
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "elys.tradeshield.QuerySimulateSpotOrderResponse.spot_price":
+		value := x.SpotPrice
+		return protoreflect.ValueOfString(value)
+	case "elys.tradeshield.QuerySimulateSpotOrderResponse.swap_fee":
+		value := x.SwapFee
+		return protoreflect.ValueOfString(value)
+	case "elys.tradeshield.QuerySimulateSpotOrderResponse.discount":
+		value := x.Discount
+		return protoreflect.ValueOfString(value)
+	case "elys.tradeshield.QuerySimulateSpotOrderResponse.slippage":
+		value := x.Slippage
+		return protoreflect.ValueOfString(value)
+	case "elys.tradeshield.QuerySimulateSpotOrderResponse.price_impact":
+		value := x.PriceImpact
+		return protoreflect.ValueOfString(value)
+	case "elys.tradeshield.QuerySimulateSpotOrderResponse.weight_balance_ratio":
+		value := x.WeightBalanceRatio
+		return protoreflect.ValueOfString(value)
+	case "elys.tradeshield.QuerySimulateSpotOrderResponse.market_price":
+		value := x.MarketPrice
+		return protoreflect.ValueOfString(value)
+	case "elys.tradeshield.QuerySimulateSpotOrderResponse.executable":
+		value := x.Executable
+		return protoreflect.ValueOfBool(value)
+	case "elys.tradeshield.QuerySimulateSpotOrderResponse.fill_amount":
+		value := x.FillAmount
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.QuerySimulateSpotOrderResponse"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.QuerySimulateSpotOrderResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySimulateSpotOrderResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "elys.tradeshield.QuerySimulateSpotOrderResponse.amount_out":
+		x.AmountOut = value.Message().Interface().(*v1beta11.Coin)
+	case "elys.tradeshield.QuerySimulateSpotOrderResponse.spot_price":
+		x.SpotPrice = value.Interface().(string)
+	case "elys.tradeshield.QuerySimulateSpotOrderResponse.swap_fee":
+		x.SwapFee = value.Interface().(string)
+	case "elys.tradeshield.QuerySimulateSpotOrderResponse.discount":
+		x.Discount = value.Interface().(string)
+	case "elys.tradeshield.QuerySimulateSpotOrderResponse.slippage":
+		x.Slippage = value.Interface().(string)
+	case "elys.tradeshield.QuerySimulateSpotOrderResponse.price_impact":
+		x.PriceImpact = value.Interface().(string)
+	case "elys.tradeshield.QuerySimulateSpotOrderResponse.weight_balance_ratio":
+		x.WeightBalanceRatio = value.Interface().(string)
+	case "elys.tradeshield.QuerySimulateSpotOrderResponse.market_price":
+		x.MarketPrice = value.Interface().(string)
+	case "elys.tradeshield.QuerySimulateSpotOrderResponse.executable":
+		x.Executable = value.Bool()
+	case "elys.tradeshield.QuerySimulateSpotOrderResponse.fill_amount":
+		x.FillAmount = value.Message().Interface().(*v1beta11.Coin)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.QuerySimulateSpotOrderResponse"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.QuerySimulateSpotOrderResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySimulateSpotOrderResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "elys.tradeshield.QuerySimulateSpotOrderResponse.amount_out":
+		if x.AmountOut == nil {
+			x.AmountOut = new(v1beta11.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.AmountOut.ProtoReflect())
+	case "elys.tradeshield.QuerySimulateSpotOrderResponse.fill_amount":
+		if x.FillAmount == nil {
+			x.FillAmount = new(v1beta11.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.FillAmount.ProtoReflect())
+	case "elys.tradeshield.QuerySimulateSpotOrderResponse.spot_price":
+		panic(fmt.Errorf("field spot_price of message elys.tradeshield.QuerySimulateSpotOrderResponse is not mutable"))
+	case "elys.tradeshield.QuerySimulateSpotOrderResponse.swap_fee":
+		panic(fmt.Errorf("field swap_fee of message elys.tradeshield.QuerySimulateSpotOrderResponse is not mutable"))
+	case "elys.tradeshield.QuerySimulateSpotOrderResponse.discount":
+		panic(fmt.Errorf("field discount of message elys.tradeshield.QuerySimulateSpotOrderResponse is not mutable"))
+	case "elys.tradeshield.QuerySimulateSpotOrderResponse.slippage":
+		panic(fmt.Errorf("field slippage of message elys.tradeshield.QuerySimulateSpotOrderResponse is not mutable"))
+	case "elys.tradeshield.QuerySimulateSpotOrderResponse.price_impact":
+		panic(fmt.Errorf("field price_impact of message elys.tradeshield.QuerySimulateSpotOrderResponse is not mutable"))
+	case "elys.tradeshield.QuerySimulateSpotOrderResponse.weight_balance_ratio":
+		panic(fmt.Errorf("field weight_balance_ratio of message elys.tradeshield.QuerySimulateSpotOrderResponse is not mutable"))
+	case "elys.tradeshield.QuerySimulateSpotOrderResponse.market_price":
+		panic(fmt.Errorf("field market_price of message elys.tradeshield.QuerySimulateSpotOrderResponse is not mutable"))
+	case "elys.tradeshield.QuerySimulateSpotOrderResponse.executable":
+		panic(fmt.Errorf("field executable of message elys.tradeshield.QuerySimulateSpotOrderResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.QuerySimulateSpotOrderResponse"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.QuerySimulateSpotOrderResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QuerySimulateSpotOrderResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "elys.tradeshield.QuerySimulateSpotOrderResponse.amount_out":
+		m := new(v1beta11.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "elys.tradeshield.QuerySimulateSpotOrderResponse.spot_price":
+		return protoreflect.ValueOfString("")
+	case "elys.tradeshield.QuerySimulateSpotOrderResponse.swap_fee":
+		return protoreflect.ValueOfString("")
+	case "elys.tradeshield.QuerySimulateSpotOrderResponse.discount":
+		return protoreflect.ValueOfString("")
+	case "elys.tradeshield.QuerySimulateSpotOrderResponse.slippage":
+		return protoreflect.ValueOfString("")
+	case "elys.tradeshield.QuerySimulateSpotOrderResponse.price_impact":
+		return protoreflect.ValueOfString("")
+	case "elys.tradeshield.QuerySimulateSpotOrderResponse.weight_balance_ratio":
+		return protoreflect.ValueOfString("")
+	case "elys.tradeshield.QuerySimulateSpotOrderResponse.market_price":
+		return protoreflect.ValueOfString("")
+	case "elys.tradeshield.QuerySimulateSpotOrderResponse.executable":
+		return protoreflect.ValueOfBool(false)
+	case "elys.tradeshield.QuerySimulateSpotOrderResponse.fill_amount":
+		m := new(v1beta11.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.QuerySimulateSpotOrderResponse"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.QuerySimulateSpotOrderResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QuerySimulateSpotOrderResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in elys.tradeshield.QuerySimulateSpotOrderResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QuerySimulateSpotOrderResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySimulateSpotOrderResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QuerySimulateSpotOrderResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QuerySimulateSpotOrderResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QuerySimulateSpotOrderResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.AmountOut != nil {
+			l = options.Size(x.AmountOut)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.SpotPrice)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.SwapFee)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Discount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Slippage)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.PriceImpact)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.WeightBalanceRatio)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MarketPrice)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Executable {
+			n += 2
+		}
+		if x.FillAmount != nil {
+			l = options.Size(x.FillAmount)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QuerySimulateSpotOrderResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.FillAmount != nil {
+			encoded, err := options.Marshal(x.FillAmount)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if x.Executable {
+			i--
+			if x.Executable {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x48
+		}
+		if len(x.MarketPrice) > 0 {
+			i -= len(x.MarketPrice)
+			copy(dAtA[i:], x.MarketPrice)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MarketPrice)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if len(x.WeightBalanceRatio) > 0 {
+			i -= len(x.WeightBalanceRatio)
+			copy(dAtA[i:], x.WeightBalanceRatio)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.WeightBalanceRatio)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.PriceImpact) > 0 {
+			i -= len(x.PriceImpact)
+			copy(dAtA[i:], x.PriceImpact)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PriceImpact)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.Slippage) > 0 {
+			i -= len(x.Slippage)
+			copy(dAtA[i:], x.Slippage)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Slippage)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.Discount) > 0 {
+			i -= len(x.Discount)
+			copy(dAtA[i:], x.Discount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Discount)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.SwapFee) > 0 {
+			i -= len(x.SwapFee)
+			copy(dAtA[i:], x.SwapFee)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SwapFee)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.SpotPrice) > 0 {
+			i -= len(x.SpotPrice)
+			copy(dAtA[i:], x.SpotPrice)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SpotPrice)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.AmountOut != nil {
+			encoded, err := options.Marshal(x.AmountOut)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QuerySimulateSpotOrderResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySimulateSpotOrderResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySimulateSpotOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AmountOut", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.AmountOut == nil {
+					x.AmountOut = &v1beta11.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AmountOut); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SpotPrice", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SpotPrice = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SwapFee", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SwapFee = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Discount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Discount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Slippage", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Slippage = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PriceImpact", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PriceImpact = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WeightBalanceRatio", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.WeightBalanceRatio = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MarketPrice", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MarketPrice = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Executable", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Executable = bool(v != 0)
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FillAmount", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.FillAmount == nil {
+					x.FillAmount = &v1beta11.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FillAmount); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QuerySimulatePerpetualOrderRequest                   protoreflect.MessageDescriptor
+	fd_QuerySimulatePerpetualOrderRequest_owner_address     protoreflect.FieldDescriptor
+	fd_QuerySimulatePerpetualOrderRequest_position          protoreflect.FieldDescriptor
+	fd_QuerySimulatePerpetualOrderRequest_leverage          protoreflect.FieldDescriptor
+	fd_QuerySimulatePerpetualOrderRequest_trading_asset     protoreflect.FieldDescriptor
+	fd_QuerySimulatePerpetualOrderRequest_collateral        protoreflect.FieldDescriptor
+	fd_QuerySimulatePerpetualOrderRequest_trigger_price     protoreflect.FieldDescriptor
+	fd_QuerySimulatePerpetualOrderRequest_take_profit_price protoreflect.FieldDescriptor
+	fd_QuerySimulatePerpetualOrderRequest_pool_id           protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_elys_tradeshield_query_proto_init()
+	md_QuerySimulatePerpetualOrderRequest = File_elys_tradeshield_query_proto.Messages().ByName("QuerySimulatePerpetualOrderRequest")
+	fd_QuerySimulatePerpetualOrderRequest_owner_address = md_QuerySimulatePerpetualOrderRequest.Fields().ByName("owner_address")
+	fd_QuerySimulatePerpetualOrderRequest_position = md_QuerySimulatePerpetualOrderRequest.Fields().ByName("position")
+	fd_QuerySimulatePerpetualOrderRequest_leverage = md_QuerySimulatePerpetualOrderRequest.Fields().ByName("leverage")
+	fd_QuerySimulatePerpetualOrderRequest_trading_asset = md_QuerySimulatePerpetualOrderRequest.Fields().ByName("trading_asset")
+	fd_QuerySimulatePerpetualOrderRequest_collateral = md_QuerySimulatePerpetualOrderRequest.Fields().ByName("collateral")
+	fd_QuerySimulatePerpetualOrderRequest_trigger_price = md_QuerySimulatePerpetualOrderRequest.Fields().ByName("trigger_price")
+	fd_QuerySimulatePerpetualOrderRequest_take_profit_price = md_QuerySimulatePerpetualOrderRequest.Fields().ByName("take_profit_price")
+	fd_QuerySimulatePerpetualOrderRequest_pool_id = md_QuerySimulatePerpetualOrderRequest.Fields().ByName("pool_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_QuerySimulatePerpetualOrderRequest)(nil)
+
+type fastReflection_QuerySimulatePerpetualOrderRequest QuerySimulatePerpetualOrderRequest
+
+func (x *QuerySimulatePerpetualOrderRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QuerySimulatePerpetualOrderRequest)(x)
+}
+
+func (x *QuerySimulatePerpetualOrderRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_elys_tradeshield_query_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QuerySimulatePerpetualOrderRequest_messageType fastReflection_QuerySimulatePerpetualOrderRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QuerySimulatePerpetualOrderRequest_messageType{}
+
+type fastReflection_QuerySimulatePerpetualOrderRequest_messageType struct{}
+
+func (x fastReflection_QuerySimulatePerpetualOrderRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QuerySimulatePerpetualOrderRequest)(nil)
+}
+func (x fastReflection_QuerySimulatePerpetualOrderRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QuerySimulatePerpetualOrderRequest)
+}
+func (x fastReflection_QuerySimulatePerpetualOrderRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySimulatePerpetualOrderRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QuerySimulatePerpetualOrderRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySimulatePerpetualOrderRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QuerySimulatePerpetualOrderRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QuerySimulatePerpetualOrderRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QuerySimulatePerpetualOrderRequest) New() protoreflect.Message {
+	return new(fastReflection_QuerySimulatePerpetualOrderRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QuerySimulatePerpetualOrderRequest) Interface() protoreflect.ProtoMessage {
+	return (*QuerySimulatePerpetualOrderRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QuerySimulatePerpetualOrderRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.OwnerAddress != "" {
+		value := protoreflect.ValueOfString(x.OwnerAddress)
+		if !f(fd_QuerySimulatePerpetualOrderRequest_owner_address, value) {
+			return
+		}
+	}
+	if x.Position != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Position))
+		if !f(fd_QuerySimulatePerpetualOrderRequest_position, value) {
+			return
+		}
+	}
+	if x.Leverage != "" {
+		value := protoreflect.ValueOfString(x.Leverage)
+		if !f(fd_QuerySimulatePerpetualOrderRequest_leverage, value) {
+			return
+		}
+	}
+	if x.TradingAsset != "" {
+		value := protoreflect.ValueOfString(x.TradingAsset)
+		if !f(fd_QuerySimulatePerpetualOrderRequest_trading_asset, value) {
+			return
+		}
+	}
+	if x.Collateral != nil {
+		value := protoreflect.ValueOfMessage(x.Collateral.ProtoReflect())
+		if !f(fd_QuerySimulatePerpetualOrderRequest_collateral, value) {
+			return
+		}
+	}
+	if x.TriggerPrice != "" {
+		value := protoreflect.ValueOfString(x.TriggerPrice)
+		if !f(fd_QuerySimulatePerpetualOrderRequest_trigger_price, value) {
+			return
+		}
+	}
+	if x.TakeProfitPrice != "" {
+		value := protoreflect.ValueOfString(x.TakeProfitPrice)
+		if !f(fd_QuerySimulatePerpetualOrderRequest_take_profit_price, value) {
+			return
+		}
+	}
+	if x.PoolId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PoolId)
+		if !f(fd_QuerySimulatePerpetualOrderRequest_pool_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QuerySimulatePerpetualOrderRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "elys.tradeshield.QuerySimulatePerpetualOrderRequest.owner_address":
+		return x.OwnerAddress != ""
+	case "elys.tradeshield.QuerySimulatePerpetualOrderRequest.position":
+		return x.Position != 0
+	case "elys.tradeshield.QuerySimulatePerpetualOrderRequest.leverage":
+		return x.Leverage != ""
+	case "elys.tradeshield.QuerySimulatePerpetualOrderRequest.trading_asset":
+		return x.TradingAsset != ""
+	case "elys.tradeshield.QuerySimulatePerpetualOrderRequest.collateral":
+		return x.Collateral != nil
+	case "elys.tradeshield.QuerySimulatePerpetualOrderRequest.trigger_price":
+		return x.TriggerPrice != ""
+	case "elys.tradeshield.QuerySimulatePerpetualOrderRequest.take_profit_price":
+		return x.TakeProfitPrice != ""
+	case "elys.tradeshield.QuerySimulatePerpetualOrderRequest.pool_id":
+		return x.PoolId != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.QuerySimulatePerpetualOrderRequest"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.QuerySimulatePerpetualOrderRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySimulatePerpetualOrderRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "elys.tradeshield.QuerySimulatePerpetualOrderRequest.owner_address":
+		x.OwnerAddress = ""
+	case "elys.tradeshield.QuerySimulatePerpetualOrderRequest.position":
+		x.Position = 0
+	case "elys.tradeshield.QuerySimulatePerpetualOrderRequest.leverage":
+		x.Leverage = ""
+	case "elys.tradeshield.QuerySimulatePerpetualOrderRequest.trading_asset":
+		x.TradingAsset = ""
+	case "elys.tradeshield.QuerySimulatePerpetualOrderRequest.collateral":
+		x.Collateral = nil
+	case "elys.tradeshield.QuerySimulatePerpetualOrderRequest.trigger_price":
+		x.TriggerPrice = ""
+	case "elys.tradeshield.QuerySimulatePerpetualOrderRequest.take_profit_price":
+		x.TakeProfitPrice = ""
+	case "elys.tradeshield.QuerySimulatePerpetualOrderRequest.pool_id":
+		x.PoolId = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.QuerySimulatePerpetualOrderRequest"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.QuerySimulatePerpetualOrderRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QuerySimulatePerpetualOrderRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "elys.tradeshield.QuerySimulatePerpetualOrderRequest.owner_address":
+		value := x.OwnerAddress
+		return protoreflect.ValueOfString(value)
+	case "elys.tradeshield.QuerySimulatePerpetualOrderRequest.position":
+		value := x.Position
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "elys.tradeshield.QuerySimulatePerpetualOrderRequest.leverage":
+		value := x.Leverage
+		return protoreflect.ValueOfString(value)
+	case "elys.tradeshield.QuerySimulatePerpetualOrderRequest.trading_asset":
+		value := x.TradingAsset
+		return protoreflect.ValueOfString(value)
+	case "elys.tradeshield.QuerySimulatePerpetualOrderRequest.collateral":
+		value := x.Collateral
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "elys.tradeshield.QuerySimulatePerpetualOrderRequest.trigger_price":
+		value := x.TriggerPrice
+		return protoreflect.ValueOfString(value)
+	case "elys.tradeshield.QuerySimulatePerpetualOrderRequest.take_profit_price":
+		value := x.TakeProfitPrice
+		return protoreflect.ValueOfString(value)
+	case "elys.tradeshield.QuerySimulatePerpetualOrderRequest.pool_id":
+		value := x.PoolId
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.QuerySimulatePerpetualOrderRequest"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.QuerySimulatePerpetualOrderRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySimulatePerpetualOrderRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "elys.tradeshield.QuerySimulatePerpetualOrderRequest.owner_address":
+		x.OwnerAddress = value.Interface().(string)
+	case "elys.tradeshield.QuerySimulatePerpetualOrderRequest.position":
+		x.Position = (PerpetualPosition)(value.Enum())
+	case "elys.tradeshield.QuerySimulatePerpetualOrderRequest.leverage":
+		x.Leverage = value.Interface().(string)
+	case "elys.tradeshield.QuerySimulatePerpetualOrderRequest.trading_asset":
+		x.TradingAsset = value.Interface().(string)
+	case "elys.tradeshield.QuerySimulatePerpetualOrderRequest.collateral":
+		x.Collateral = value.Message().Interface().(*v1beta11.Coin)
+	case "elys.tradeshield.QuerySimulatePerpetualOrderRequest.trigger_price":
+		x.TriggerPrice = value.Interface().(string)
+	case "elys.tradeshield.QuerySimulatePerpetualOrderRequest.take_profit_price":
+		x.TakeProfitPrice = value.Interface().(string)
+	case "elys.tradeshield.QuerySimulatePerpetualOrderRequest.pool_id":
+		x.PoolId = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.QuerySimulatePerpetualOrderRequest"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.QuerySimulatePerpetualOrderRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySimulatePerpetualOrderRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "elys.tradeshield.QuerySimulatePerpetualOrderRequest.collateral":
+		if x.Collateral == nil {
+			x.Collateral = new(v1beta11.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.Collateral.ProtoReflect())
+	case "elys.tradeshield.QuerySimulatePerpetualOrderRequest.owner_address":
+		panic(fmt.Errorf("field owner_address of message elys.tradeshield.QuerySimulatePerpetualOrderRequest is not mutable"))
+	case "elys.tradeshield.QuerySimulatePerpetualOrderRequest.position":
+		panic(fmt.Errorf("field position of message elys.tradeshield.QuerySimulatePerpetualOrderRequest is not mutable"))
+	case "elys.tradeshield.QuerySimulatePerpetualOrderRequest.leverage":
+		panic(fmt.Errorf("field leverage of message elys.tradeshield.QuerySimulatePerpetualOrderRequest is not mutable"))
+	case "elys.tradeshield.QuerySimulatePerpetualOrderRequest.trading_asset":
+		panic(fmt.Errorf("field trading_asset of message elys.tradeshield.QuerySimulatePerpetualOrderRequest is not mutable"))
+	case "elys.tradeshield.QuerySimulatePerpetualOrderRequest.trigger_price":
+		panic(fmt.Errorf("field trigger_price of message elys.tradeshield.QuerySimulatePerpetualOrderRequest is not mutable"))
+	case "elys.tradeshield.QuerySimulatePerpetualOrderRequest.take_profit_price":
+		panic(fmt.Errorf("field take_profit_price of message elys.tradeshield.QuerySimulatePerpetualOrderRequest is not mutable"))
+	case "elys.tradeshield.QuerySimulatePerpetualOrderRequest.pool_id":
+		panic(fmt.Errorf("field pool_id of message elys.tradeshield.QuerySimulatePerpetualOrderRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.QuerySimulatePerpetualOrderRequest"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.QuerySimulatePerpetualOrderRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QuerySimulatePerpetualOrderRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "elys.tradeshield.QuerySimulatePerpetualOrderRequest.owner_address":
+		return protoreflect.ValueOfString("")
+	case "elys.tradeshield.QuerySimulatePerpetualOrderRequest.position":
+		return protoreflect.ValueOfEnum(0)
+	case "elys.tradeshield.QuerySimulatePerpetualOrderRequest.leverage":
+		return protoreflect.ValueOfString("")
+	case "elys.tradeshield.QuerySimulatePerpetualOrderRequest.trading_asset":
+		return protoreflect.ValueOfString("")
+	case "elys.tradeshield.QuerySimulatePerpetualOrderRequest.collateral":
+		m := new(v1beta11.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "elys.tradeshield.QuerySimulatePerpetualOrderRequest.trigger_price":
+		return protoreflect.ValueOfString("")
+	case "elys.tradeshield.QuerySimulatePerpetualOrderRequest.take_profit_price":
+		return protoreflect.ValueOfString("")
+	case "elys.tradeshield.QuerySimulatePerpetualOrderRequest.pool_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.QuerySimulatePerpetualOrderRequest"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.QuerySimulatePerpetualOrderRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QuerySimulatePerpetualOrderRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in elys.tradeshield.QuerySimulatePerpetualOrderRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QuerySimulatePerpetualOrderRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySimulatePerpetualOrderRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QuerySimulatePerpetualOrderRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QuerySimulatePerpetualOrderRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QuerySimulatePerpetualOrderRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.OwnerAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Position != 0 {
+			n += 1 + runtime.Sov(uint64(x.Position))
+		}
+		l = len(x.Leverage)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.TradingAsset)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Collateral != nil {
+			l = options.Size(x.Collateral)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.TriggerPrice)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.TakeProfitPrice)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.PoolId != 0 {
+			n += 1 + runtime.Sov(uint64(x.PoolId))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QuerySimulatePerpetualOrderRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.PoolId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PoolId))
+			i--
+			dAtA[i] = 0x40
+		}
+		if len(x.TakeProfitPrice) > 0 {
+			i -= len(x.TakeProfitPrice)
+			copy(dAtA[i:], x.TakeProfitPrice)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TakeProfitPrice)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.TriggerPrice) > 0 {
+			i -= len(x.TriggerPrice)
+			copy(dAtA[i:], x.TriggerPrice)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TriggerPrice)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.Collateral != nil {
+			encoded, err := options.Marshal(x.Collateral)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.TradingAsset) > 0 {
+			i -= len(x.TradingAsset)
+			copy(dAtA[i:], x.TradingAsset)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TradingAsset)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Leverage) > 0 {
+			i -= len(x.Leverage)
+			copy(dAtA[i:], x.Leverage)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Leverage)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Position != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Position))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.OwnerAddress) > 0 {
+			i -= len(x.OwnerAddress)
+			copy(dAtA[i:], x.OwnerAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OwnerAddress)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QuerySimulatePerpetualOrderRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySimulatePerpetualOrderRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySimulatePerpetualOrderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.OwnerAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+				}
+				x.Position = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Position |= PerpetualPosition(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Leverage", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Leverage = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TradingAsset", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TradingAsset = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Collateral == nil {
+					x.Collateral = &v1beta11.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Collateral); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TriggerPrice", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TriggerPrice = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TakeProfitPrice", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TakeProfitPrice = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+				}
+				x.PoolId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PoolId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QuerySimulatePerpetualOrderResponse                      protoreflect.MessageDescriptor
+	fd_QuerySimulatePerpetualOrderResponse_position_size        protoreflect.FieldDescriptor
+	fd_QuerySimulatePerpetualOrderResponse_open_price           protoreflect.FieldDescriptor
+	fd_QuerySimulatePerpetualOrderResponse_liquidation_price    protoreflect.FieldDescriptor
+	fd_QuerySimulatePerpetualOrderResponse_effective_leverage   protoreflect.FieldDescriptor
+	fd_QuerySimulatePerpetualOrderResponse_custody              protoreflect.FieldDescriptor
+	fd_QuerySimulatePerpetualOrderResponse_liabilities          protoreflect.FieldDescriptor
+	fd_QuerySimulatePerpetualOrderResponse_slippage             protoreflect.FieldDescriptor
+	fd_QuerySimulatePerpetualOrderResponse_price_impact         protoreflect.FieldDescriptor
+	fd_QuerySimulatePerpetualOrderResponse_weight_breaking_fee  protoreflect.FieldDescriptor
+	fd_QuerySimulatePerpetualOrderResponse_funding_rate         protoreflect.FieldDescriptor
+	fd_QuerySimulatePerpetualOrderResponse_borrow_interest_rate protoreflect.FieldDescriptor
+	fd_QuerySimulatePerpetualOrderResponse_hourly_interest_rate protoreflect.FieldDescriptor
+	fd_QuerySimulatePerpetualOrderResponse_available_liquidity  protoreflect.FieldDescriptor
+	fd_QuerySimulatePerpetualOrderResponse_market_price         protoreflect.FieldDescriptor
+	fd_QuerySimulatePerpetualOrderResponse_executable           protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_elys_tradeshield_query_proto_init()
+	md_QuerySimulatePerpetualOrderResponse = File_elys_tradeshield_query_proto.Messages().ByName("QuerySimulatePerpetualOrderResponse")
+	fd_QuerySimulatePerpetualOrderResponse_position_size = md_QuerySimulatePerpetualOrderResponse.Fields().ByName("position_size")
+	fd_QuerySimulatePerpetualOrderResponse_open_price = md_QuerySimulatePerpetualOrderResponse.Fields().ByName("open_price")
+	fd_QuerySimulatePerpetualOrderResponse_liquidation_price = md_QuerySimulatePerpetualOrderResponse.Fields().ByName("liquidation_price")
+	fd_QuerySimulatePerpetualOrderResponse_effective_leverage = md_QuerySimulatePerpetualOrderResponse.Fields().ByName("effective_leverage")
+	fd_QuerySimulatePerpetualOrderResponse_custody = md_QuerySimulatePerpetualOrderResponse.Fields().ByName("custody")
+	fd_QuerySimulatePerpetualOrderResponse_liabilities = md_QuerySimulatePerpetualOrderResponse.Fields().ByName("liabilities")
+	fd_QuerySimulatePerpetualOrderResponse_slippage = md_QuerySimulatePerpetualOrderResponse.Fields().ByName("slippage")
+	fd_QuerySimulatePerpetualOrderResponse_price_impact = md_QuerySimulatePerpetualOrderResponse.Fields().ByName("price_impact")
+	fd_QuerySimulatePerpetualOrderResponse_weight_breaking_fee = md_QuerySimulatePerpetualOrderResponse.Fields().ByName("weight_breaking_fee")
+	fd_QuerySimulatePerpetualOrderResponse_funding_rate = md_QuerySimulatePerpetualOrderResponse.Fields().ByName("funding_rate")
+	fd_QuerySimulatePerpetualOrderResponse_borrow_interest_rate = md_QuerySimulatePerpetualOrderResponse.Fields().ByName("borrow_interest_rate")
+	fd_QuerySimulatePerpetualOrderResponse_hourly_interest_rate = md_QuerySimulatePerpetualOrderResponse.Fields().ByName("hourly_interest_rate")
+	fd_QuerySimulatePerpetualOrderResponse_available_liquidity = md_QuerySimulatePerpetualOrderResponse.Fields().ByName("available_liquidity")
+	fd_QuerySimulatePerpetualOrderResponse_market_price = md_QuerySimulatePerpetualOrderResponse.Fields().ByName("market_price")
+	fd_QuerySimulatePerpetualOrderResponse_executable = md_QuerySimulatePerpetualOrderResponse.Fields().ByName("executable")
+}
+
+var _ protoreflect.Message = (*fastReflection_QuerySimulatePerpetualOrderResponse)(nil)
+
+type fastReflection_QuerySimulatePerpetualOrderResponse QuerySimulatePerpetualOrderResponse
+
+func (x *QuerySimulatePerpetualOrderResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QuerySimulatePerpetualOrderResponse)(x)
+}
+
+func (x *QuerySimulatePerpetualOrderResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_elys_tradeshield_query_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QuerySimulatePerpetualOrderResponse_messageType fastReflection_QuerySimulatePerpetualOrderResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QuerySimulatePerpetualOrderResponse_messageType{}
+
+type fastReflection_QuerySimulatePerpetualOrderResponse_messageType struct{}
+
+func (x fastReflection_QuerySimulatePerpetualOrderResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QuerySimulatePerpetualOrderResponse)(nil)
+}
+func (x fastReflection_QuerySimulatePerpetualOrderResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QuerySimulatePerpetualOrderResponse)
+}
+func (x fastReflection_QuerySimulatePerpetualOrderResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySimulatePerpetualOrderResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QuerySimulatePerpetualOrderResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySimulatePerpetualOrderResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QuerySimulatePerpetualOrderResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QuerySimulatePerpetualOrderResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QuerySimulatePerpetualOrderResponse) New() protoreflect.Message {
+	return new(fastReflection_QuerySimulatePerpetualOrderResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QuerySimulatePerpetualOrderResponse) Interface() protoreflect.ProtoMessage {
+	return (*QuerySimulatePerpetualOrderResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QuerySimulatePerpetualOrderResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.PositionSize != nil {
+		value := protoreflect.ValueOfMessage(x.PositionSize.ProtoReflect())
+		if !f(fd_QuerySimulatePerpetualOrderResponse_position_size, value) {
+			return
+		}
+	}
+	if x.OpenPrice != "" {
+		value := protoreflect.ValueOfString(x.OpenPrice)
+		if !f(fd_QuerySimulatePerpetualOrderResponse_open_price, value) {
+			return
+		}
+	}
+	if x.LiquidationPrice != "" {
+		value := protoreflect.ValueOfString(x.LiquidationPrice)
+		if !f(fd_QuerySimulatePerpetualOrderResponse_liquidation_price, value) {
+			return
+		}
+	}
+	if x.EffectiveLeverage != "" {
+		value := protoreflect.ValueOfString(x.EffectiveLeverage)
+		if !f(fd_QuerySimulatePerpetualOrderResponse_effective_leverage, value) {
+			return
+		}
+	}
+	if x.Custody != nil {
+		value := protoreflect.ValueOfMessage(x.Custody.ProtoReflect())
+		if !f(fd_QuerySimulatePerpetualOrderResponse_custody, value) {
+			return
+		}
+	}
+	if x.Liabilities != nil {
+		value := protoreflect.ValueOfMessage(x.Liabilities.ProtoReflect())
+		if !f(fd_QuerySimulatePerpetualOrderResponse_liabilities, value) {
+			return
+		}
+	}
+	if x.Slippage != "" {
+		value := protoreflect.ValueOfString(x.Slippage)
+		if !f(fd_QuerySimulatePerpetualOrderResponse_slippage, value) {
+			return
+		}
+	}
+	if x.PriceImpact != "" {
+		value := protoreflect.ValueOfString(x.PriceImpact)
+		if !f(fd_QuerySimulatePerpetualOrderResponse_price_impact, value) {
+			return
+		}
+	}
+	if x.WeightBreakingFee != "" {
+		value := protoreflect.ValueOfString(x.WeightBreakingFee)
+		if !f(fd_QuerySimulatePerpetualOrderResponse_weight_breaking_fee, value) {
+			return
+		}
+	}
+	if x.FundingRate != "" {
+		value := protoreflect.ValueOfString(x.FundingRate)
+		if !f(fd_QuerySimulatePerpetualOrderResponse_funding_rate, value) {
+			return
+		}
+	}
+	if x.BorrowInterestRate != "" {
+		value := protoreflect.ValueOfString(x.BorrowInterestRate)
+		if !f(fd_QuerySimulatePerpetualOrderResponse_borrow_interest_rate, value) {
+			return
+		}
+	}
+	if x.HourlyInterestRate != "" {
+		value := protoreflect.ValueOfString(x.HourlyInterestRate)
+		if !f(fd_QuerySimulatePerpetualOrderResponse_hourly_interest_rate, value) {
+			return
+		}
+	}
+	if x.AvailableLiquidity != nil {
+		value := protoreflect.ValueOfMessage(x.AvailableLiquidity.ProtoReflect())
+		if !f(fd_QuerySimulatePerpetualOrderResponse_available_liquidity, value) {
+			return
+		}
+	}
+	if x.MarketPrice != "" {
+		value := protoreflect.ValueOfString(x.MarketPrice)
+		if !f(fd_QuerySimulatePerpetualOrderResponse_market_price, value) {
+			return
+		}
+	}
+	if x.Executable != false {
+		value := protoreflect.ValueOfBool(x.Executable)
+		if !f(fd_QuerySimulatePerpetualOrderResponse_executable, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QuerySimulatePerpetualOrderResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "elys.tradeshield.QuerySimulatePerpetualOrderResponse.position_size":
+		return x.PositionSize != nil
+	case "elys.tradeshield.QuerySimulatePerpetualOrderResponse.open_price":
+		return x.OpenPrice != ""
+	case "elys.tradeshield.QuerySimulatePerpetualOrderResponse.liquidation_price":
+		return x.LiquidationPrice != ""
+	case "elys.tradeshield.QuerySimulatePerpetualOrderResponse.effective_leverage":
+		return x.EffectiveLeverage != ""
+	case "elys.tradeshield.QuerySimulatePerpetualOrderResponse.custody":
+		return x.Custody != nil
+	case "elys.tradeshield.QuerySimulatePerpetualOrderResponse.liabilities":
+		return x.Liabilities != nil
+	case "elys.tradeshield.QuerySimulatePerpetualOrderResponse.slippage":
+		return x.Slippage != ""
+	case "elys.tradeshield.QuerySimulatePerpetualOrderResponse.price_impact":
+		return x.PriceImpact != ""
+	case "elys.tradeshield.QuerySimulatePerpetualOrderResponse.weight_breaking_fee":
+		return x.WeightBreakingFee != ""
+	case "elys.tradeshield.QuerySimulatePerpetualOrderResponse.funding_rate":
+		return x.FundingRate != ""
+	case "elys.tradeshield.QuerySimulatePerpetualOrderResponse.borrow_interest_rate":
+		return x.BorrowInterestRate != ""
+	case "elys.tradeshield.QuerySimulatePerpetualOrderResponse.hourly_interest_rate":
+		return x.HourlyInterestRate != ""
+	case "elys.tradeshield.QuerySimulatePerpetualOrderResponse.available_liquidity":
+		return x.AvailableLiquidity != nil
+	case "elys.tradeshield.QuerySimulatePerpetualOrderResponse.market_price":
+		return x.MarketPrice != ""
+	case "elys.tradeshield.QuerySimulatePerpetualOrderResponse.executable":
+		return x.Executable != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.QuerySimulatePerpetualOrderResponse"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.QuerySimulatePerpetualOrderResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySimulatePerpetualOrderResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "elys.tradeshield.QuerySimulatePerpetualOrderResponse.position_size":
+		x.PositionSize = nil
+	case "elys.tradeshield.QuerySimulatePerpetualOrderResponse.open_price":
+		x.OpenPrice = ""
+	case "elys.tradeshield.QuerySimulatePerpetualOrderResponse.liquidation_price":
+		x.LiquidationPrice = ""
+	case "elys.tradeshield.QuerySimulatePerpetualOrderResponse.effective_leverage":
+		x.EffectiveLeverage = ""
+	case "elys.tradeshield.QuerySimulatePerpetualOrderResponse.custody":
+		x.Custody = nil
+	case "elys.tradeshield.QuerySimulatePerpetualOrderResponse.liabilities":
+		x.Liabilities = nil
+	case "elys.tradeshield.QuerySimulatePerpetualOrderResponse.slippage":
+		x.Slippage = ""
+	case "elys.tradeshield.QuerySimulatePerpetualOrderResponse.price_impact":
+		x.PriceImpact = ""
+	case "elys.tradeshield.QuerySimulatePerpetualOrderResponse.weight_breaking_fee":
+		x.WeightBreakingFee = ""
+	case "elys.tradeshield.QuerySimulatePerpetualOrderResponse.funding_rate":
+		x.FundingRate = ""
+	case "elys.tradeshield.QuerySimulatePerpetualOrderResponse.borrow_interest_rate":
+		x.BorrowInterestRate = ""
+	case "elys.tradeshield.QuerySimulatePerpetualOrderResponse.hourly_interest_rate":
+		x.HourlyInterestRate = ""
+	case "elys.tradeshield.QuerySimulatePerpetualOrderResponse.available_liquidity":
+		x.AvailableLiquidity = nil
+	case "elys.tradeshield.QuerySimulatePerpetualOrderResponse.market_price":
+		x.MarketPrice = ""
+	case "elys.tradeshield.QuerySimulatePerpetualOrderResponse.executable":
+		x.Executable = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.QuerySimulatePerpetualOrderResponse"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.QuerySimulatePerpetualOrderResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QuerySimulatePerpetualOrderResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "elys.tradeshield.QuerySimulatePerpetualOrderResponse.position_size":
+		value := x.PositionSize
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "elys.tradeshield.QuerySimulatePerpetualOrderResponse.open_price":
+		value := x.OpenPrice
+		return protoreflect.ValueOfString(value)
+	case "elys.tradeshield.QuerySimulatePerpetualOrderResponse.liquidation_price":
+		value := x.LiquidationPrice
+		return protoreflect.ValueOfString(value)
+	case "elys.tradeshield.QuerySimulatePerpetualOrderResponse.effective_leverage":
+		value := x.EffectiveLeverage
+		return protoreflect.ValueOfString(value)
+	case "elys.tradeshield.QuerySimulatePerpetualOrderResponse.custody":
+		value := x.Custody
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "elys.tradeshield.QuerySimulatePerpetualOrderResponse.liabilities":
+		value := x.Liabilities
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "elys.tradeshield.QuerySimulatePerpetualOrderResponse.slippage":
+		value := x.Slippage
+		return protoreflect.ValueOfString(value)
+	case "elys.tradeshield.QuerySimulatePerpetualOrderResponse.price_impact":
+		value := x.PriceImpact
+		return protoreflect.ValueOfString(value)
+	case "elys.tradeshield.QuerySimulatePerpetualOrderResponse.weight_breaking_fee":
+		value := x.WeightBreakingFee
+		return protoreflect.ValueOfString(value)
+	case "elys.tradeshield.QuerySimulatePerpetualOrderResponse.funding_rate":
+		value := x.FundingRate
+		return protoreflect.ValueOfString(value)
+	case "elys.tradeshield.QuerySimulatePerpetualOrderResponse.borrow_interest_rate":
+		value := x.BorrowInterestRate
+		return protoreflect.ValueOfString(value)
+	case "elys.tradeshield.QuerySimulatePerpetualOrderResponse.hourly_interest_rate":
+		value := x.HourlyInterestRate
+		return protoreflect.ValueOfString(value)
+	case "elys.tradeshield.QuerySimulatePerpetualOrderResponse.available_liquidity":
+		value := x.AvailableLiquidity
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "elys.tradeshield.QuerySimulatePerpetualOrderResponse.market_price":
+		value := x.MarketPrice
+		return protoreflect.ValueOfString(value)
+	case "elys.tradeshield.QuerySimulatePerpetualOrderResponse.executable":
+		value := x.Executable
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.QuerySimulatePerpetualOrderResponse"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.QuerySimulatePerpetualOrderResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySimulatePerpetualOrderResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "elys.tradeshield.QuerySimulatePerpetualOrderResponse.position_size":
+		x.PositionSize = value.Message().Interface().(*v1beta11.Coin)
+	case "elys.tradeshield.QuerySimulatePerpetualOrderResponse.open_price":
+		x.OpenPrice = value.Interface().(string)
+	case "elys.tradeshield.QuerySimulatePerpetualOrderResponse.liquidation_price":
+		x.LiquidationPrice = value.Interface().(string)
+	case "elys.tradeshield.QuerySimulatePerpetualOrderResponse.effective_leverage":
+		x.EffectiveLeverage = value.Interface().(string)
+	case "elys.tradeshield.QuerySimulatePerpetualOrderResponse.custody":
+		x.Custody = value.Message().Interface().(*v1beta11.Coin)
+	case "elys.tradeshield.QuerySimulatePerpetualOrderResponse.liabilities":
+		x.Liabilities = value.Message().Interface().(*v1beta11.Coin)
+	case "elys.tradeshield.QuerySimulatePerpetualOrderResponse.slippage":
+		x.Slippage = value.Interface().(string)
+	case "elys.tradeshield.QuerySimulatePerpetualOrderResponse.price_impact":
+		x.PriceImpact = value.Interface().(string)
+	case "elys.tradeshield.QuerySimulatePerpetualOrderResponse.weight_breaking_fee":
+		x.WeightBreakingFee = value.Interface().(string)
+	case "elys.tradeshield.QuerySimulatePerpetualOrderResponse.funding_rate":
+		x.FundingRate = value.Interface().(string)
+	case "elys.tradeshield.QuerySimulatePerpetualOrderResponse.borrow_interest_rate":
+		x.BorrowInterestRate = value.Interface().(string)
+	case "elys.tradeshield.QuerySimulatePerpetualOrderResponse.hourly_interest_rate":
+		x.HourlyInterestRate = value.Interface().(string)
+	case "elys.tradeshield.QuerySimulatePerpetualOrderResponse.available_liquidity":
+		x.AvailableLiquidity = value.Message().Interface().(*v1beta11.Coin)
+	case "elys.tradeshield.QuerySimulatePerpetualOrderResponse.market_price":
+		x.MarketPrice = value.Interface().(string)
+	case "elys.tradeshield.QuerySimulatePerpetualOrderResponse.executable":
+		x.Executable = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.QuerySimulatePerpetualOrderResponse"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.QuerySimulatePerpetualOrderResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySimulatePerpetualOrderResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "elys.tradeshield.QuerySimulatePerpetualOrderResponse.position_size":
+		if x.PositionSize == nil {
+			x.PositionSize = new(v1beta11.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.PositionSize.ProtoReflect())
+	case "elys.tradeshield.QuerySimulatePerpetualOrderResponse.custody":
+		if x.Custody == nil {
+			x.Custody = new(v1beta11.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.Custody.ProtoReflect())
+	case "elys.tradeshield.QuerySimulatePerpetualOrderResponse.liabilities":
+		if x.Liabilities == nil {
+			x.Liabilities = new(v1beta11.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.Liabilities.ProtoReflect())
+	case "elys.tradeshield.QuerySimulatePerpetualOrderResponse.available_liquidity":
+		if x.AvailableLiquidity == nil {
+			x.AvailableLiquidity = new(v1beta11.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.AvailableLiquidity.ProtoReflect())
+	case "elys.tradeshield.QuerySimulatePerpetualOrderResponse.open_price":
+		panic(fmt.Errorf("field open_price of message elys.tradeshield.QuerySimulatePerpetualOrderResponse is not mutable"))
+	case "elys.tradeshield.QuerySimulatePerpetualOrderResponse.liquidation_price":
+		panic(fmt.Errorf("field liquidation_price of message elys.tradeshield.QuerySimulatePerpetualOrderResponse is not mutable"))
+	case "elys.tradeshield.QuerySimulatePerpetualOrderResponse.effective_leverage":
+		panic(fmt.Errorf("field effective_leverage of message elys.tradeshield.QuerySimulatePerpetualOrderResponse is not mutable"))
+	case "elys.tradeshield.QuerySimulatePerpetualOrderResponse.slippage":
+		panic(fmt.Errorf("field slippage of message elys.tradeshield.QuerySimulatePerpetualOrderResponse is not mutable"))
+	case "elys.tradeshield.QuerySimulatePerpetualOrderResponse.price_impact":
+		panic(fmt.Errorf("field price_impact of message elys.tradeshield.QuerySimulatePerpetualOrderResponse is not mutable"))
+	case "elys.tradeshield.QuerySimulatePerpetualOrderResponse.weight_breaking_fee":
+		panic(fmt.Errorf("field weight_breaking_fee of message elys.tradeshield.QuerySimulatePerpetualOrderResponse is not mutable"))
+	case "elys.tradeshield.QuerySimulatePerpetualOrderResponse.funding_rate":
+		panic(fmt.Errorf("field funding_rate of message elys.tradeshield.QuerySimulatePerpetualOrderResponse is not mutable"))
+	case "elys.tradeshield.QuerySimulatePerpetualOrderResponse.borrow_interest_rate":
+		panic(fmt.Errorf("field borrow_interest_rate of message elys.tradeshield.QuerySimulatePerpetualOrderResponse is not mutable"))
+	case "elys.tradeshield.QuerySimulatePerpetualOrderResponse.hourly_interest_rate":
+		panic(fmt.Errorf("field hourly_interest_rate of message elys.tradeshield.QuerySimulatePerpetualOrderResponse is not mutable"))
+	case "elys.tradeshield.QuerySimulatePerpetualOrderResponse.market_price":
+		panic(fmt.Errorf("field market_price of message elys.tradeshield.QuerySimulatePerpetualOrderResponse is not mutable"))
+	case "elys.tradeshield.QuerySimulatePerpetualOrderResponse.executable":
+		panic(fmt.Errorf("field executable of message elys.tradeshield.QuerySimulatePerpetualOrderResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.QuerySimulatePerpetualOrderResponse"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.QuerySimulatePerpetualOrderResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QuerySimulatePerpetualOrderResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "elys.tradeshield.QuerySimulatePerpetualOrderResponse.position_size":
+		m := new(v1beta11.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "elys.tradeshield.QuerySimulatePerpetualOrderResponse.open_price":
+		return protoreflect.ValueOfString("")
+	case "elys.tradeshield.QuerySimulatePerpetualOrderResponse.liquidation_price":
+		return protoreflect.ValueOfString("")
+	case "elys.tradeshield.QuerySimulatePerpetualOrderResponse.effective_leverage":
+		return protoreflect.ValueOfString("")
+	case "elys.tradeshield.QuerySimulatePerpetualOrderResponse.custody":
+		m := new(v1beta11.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "elys.tradeshield.QuerySimulatePerpetualOrderResponse.liabilities":
+		m := new(v1beta11.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "elys.tradeshield.QuerySimulatePerpetualOrderResponse.slippage":
+		return protoreflect.ValueOfString("")
+	case "elys.tradeshield.QuerySimulatePerpetualOrderResponse.price_impact":
+		return protoreflect.ValueOfString("")
+	case "elys.tradeshield.QuerySimulatePerpetualOrderResponse.weight_breaking_fee":
+		return protoreflect.ValueOfString("")
+	case "elys.tradeshield.QuerySimulatePerpetualOrderResponse.funding_rate":
+		return protoreflect.ValueOfString("")
+	case "elys.tradeshield.QuerySimulatePerpetualOrderResponse.borrow_interest_rate":
+		return protoreflect.ValueOfString("")
+	case "elys.tradeshield.QuerySimulatePerpetualOrderResponse.hourly_interest_rate":
+		return protoreflect.ValueOfString("")
+	case "elys.tradeshield.QuerySimulatePerpetualOrderResponse.available_liquidity":
+		m := new(v1beta11.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "elys.tradeshield.QuerySimulatePerpetualOrderResponse.market_price":
+		return protoreflect.ValueOfString("")
+	case "elys.tradeshield.QuerySimulatePerpetualOrderResponse.executable":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.QuerySimulatePerpetualOrderResponse"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.QuerySimulatePerpetualOrderResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QuerySimulatePerpetualOrderResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in elys.tradeshield.QuerySimulatePerpetualOrderResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QuerySimulatePerpetualOrderResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySimulatePerpetualOrderResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QuerySimulatePerpetualOrderResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QuerySimulatePerpetualOrderResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QuerySimulatePerpetualOrderResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.PositionSize != nil {
+			l = options.Size(x.PositionSize)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.OpenPrice)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.LiquidationPrice)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.EffectiveLeverage)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Custody != nil {
+			l = options.Size(x.Custody)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Liabilities != nil {
+			l = options.Size(x.Liabilities)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Slippage)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.PriceImpact)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.WeightBreakingFee)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.FundingRate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.BorrowInterestRate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.HourlyInterestRate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.AvailableLiquidity != nil {
+			l = options.Size(x.AvailableLiquidity)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MarketPrice)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Executable {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QuerySimulatePerpetualOrderResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Executable {
+			i--
+			if x.Executable {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x78
+		}
+		if len(x.MarketPrice) > 0 {
+			i -= len(x.MarketPrice)
+			copy(dAtA[i:], x.MarketPrice)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MarketPrice)))
+			i--
+			dAtA[i] = 0x72
+		}
+		if x.AvailableLiquidity != nil {
+			encoded, err := options.Marshal(x.AvailableLiquidity)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x6a
+		}
+		if len(x.HourlyInterestRate) > 0 {
+			i -= len(x.HourlyInterestRate)
+			copy(dAtA[i:], x.HourlyInterestRate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.HourlyInterestRate)))
+			i--
+			dAtA[i] = 0x62
+		}
+		if len(x.BorrowInterestRate) > 0 {
+			i -= len(x.BorrowInterestRate)
+			copy(dAtA[i:], x.BorrowInterestRate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BorrowInterestRate)))
+			i--
+			dAtA[i] = 0x5a
+		}
+		if len(x.FundingRate) > 0 {
+			i -= len(x.FundingRate)
+			copy(dAtA[i:], x.FundingRate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FundingRate)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if len(x.WeightBreakingFee) > 0 {
+			i -= len(x.WeightBreakingFee)
+			copy(dAtA[i:], x.WeightBreakingFee)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.WeightBreakingFee)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if len(x.PriceImpact) > 0 {
+			i -= len(x.PriceImpact)
+			copy(dAtA[i:], x.PriceImpact)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PriceImpact)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if len(x.Slippage) > 0 {
+			i -= len(x.Slippage)
+			copy(dAtA[i:], x.Slippage)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Slippage)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if x.Liabilities != nil {
+			encoded, err := options.Marshal(x.Liabilities)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.Custody != nil {
+			encoded, err := options.Marshal(x.Custody)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.EffectiveLeverage) > 0 {
+			i -= len(x.EffectiveLeverage)
+			copy(dAtA[i:], x.EffectiveLeverage)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EffectiveLeverage)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.LiquidationPrice) > 0 {
+			i -= len(x.LiquidationPrice)
+			copy(dAtA[i:], x.LiquidationPrice)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LiquidationPrice)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.OpenPrice) > 0 {
+			i -= len(x.OpenPrice)
+			copy(dAtA[i:], x.OpenPrice)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OpenPrice)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.PositionSize != nil {
+			encoded, err := options.Marshal(x.PositionSize)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QuerySimulatePerpetualOrderResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySimulatePerpetualOrderResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySimulatePerpetualOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PositionSize", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.PositionSize == nil {
+					x.PositionSize = &v1beta11.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PositionSize); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OpenPrice", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.OpenPrice = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LiquidationPrice", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LiquidationPrice = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EffectiveLeverage", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EffectiveLeverage = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Custody", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Custody == nil {
+					x.Custody = &v1beta11.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Custody); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Liabilities", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Liabilities == nil {
+					x.Liabilities = &v1beta11.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Liabilities); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Slippage", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Slippage = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PriceImpact", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PriceImpact = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WeightBreakingFee", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.WeightBreakingFee = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FundingRate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FundingRate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BorrowInterestRate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BorrowInterestRate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HourlyInterestRate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.HourlyInterestRate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AvailableLiquidity", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.AvailableLiquidity == nil {
+					x.AvailableLiquidity = &v1beta11.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AvailableLiquidity); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 14:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MarketPrice", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MarketPrice = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 15:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Executable", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Executable = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
 // 	protoc-gen-go v1.27.0
 // 	protoc        (unknown)
 // source: elys/tradeshield/query.proto
 
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Side of the order book
+type OrderBookSide int32
+
+const (
+	// LIMITBUY orders
+	OrderBookSide_BUY OrderBookSide = 0
+	// LIMITSELL orders
+	OrderBookSide_SELL OrderBookSide = 1
+)
+
+// Enum value maps for OrderBookSide.
+var (
+	OrderBookSide_name = map[int32]string{
+		0: "BUY",
+		1: "SELL",
+	}
+	OrderBookSide_value = map[string]int32{
+		"BUY":  0,
+		"SELL": 1,
+	}
+)
+
+func (x OrderBookSide) Enum() *OrderBookSide {
+	p := new(OrderBookSide)
+	*p = x
+	return p
+}
+
+func (x OrderBookSide) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OrderBookSide) Descriptor() protoreflect.EnumDescriptor {
+	return file_elys_tradeshield_query_proto_enumTypes[0].Descriptor()
+}
+
+func (OrderBookSide) Type() protoreflect.EnumType {
+	return &file_elys_tradeshield_query_proto_enumTypes[0]
+}
+
+func (x OrderBookSide) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OrderBookSide.Descriptor instead.
+func (OrderBookSide) EnumDescriptor() ([]byte, []int) {
+	return file_elys_tradeshield_query_proto_rawDescGZIP(), []int{0}
+}
+
+// QueryParamsRequest is request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *QueryParamsRequest) Reset() {
+	*x = QueryParamsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_elys_tradeshield_query_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryParamsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryParamsRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryParamsRequest.ProtoReflect.Descriptor instead.
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return file_elys_tradeshield_query_proto_rawDescGZIP(), []int{0}
+}
+
+// QueryParamsResponse is response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// params holds all the parameters of this module.
+	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+}
+
+func (x *QueryParamsResponse) Reset() {
+	*x = QueryParamsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_elys_tradeshield_query_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryParamsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryParamsResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryParamsResponse.ProtoReflect.Descriptor instead.
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return file_elys_tradeshield_query_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *QueryParamsResponse) GetParams() *Params {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+type QueryGetPendingSpotOrderRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *QueryGetPendingSpotOrderRequest) Reset() {
+	*x = QueryGetPendingSpotOrderRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_elys_tradeshield_query_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryGetPendingSpotOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryGetPendingSpotOrderRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryGetPendingSpotOrderRequest.ProtoReflect.Descriptor instead.
+func (*QueryGetPendingSpotOrderRequest) Descriptor() ([]byte, []int) {
+	return file_elys_tradeshield_query_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *QueryGetPendingSpotOrderRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type QueryGetPendingSpotOrderResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PendingSpotOrder *SpotOrder `protobuf:"bytes,1,opt,name=pending_spot_order,json=pendingSpotOrder,proto3" json:"pending_spot_order,omitempty"`
+}
+
+func (x *QueryGetPendingSpotOrderResponse) Reset() {
+	*x = QueryGetPendingSpotOrderResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_elys_tradeshield_query_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryGetPendingSpotOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryGetPendingSpotOrderResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryGetPendingSpotOrderResponse.ProtoReflect.Descriptor instead.
+func (*QueryGetPendingSpotOrderResponse) Descriptor() ([]byte, []int) {
+	return file_elys_tradeshield_query_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *QueryGetPendingSpotOrderResponse) GetPendingSpotOrder() *SpotOrder {
+	if x != nil {
+		return x.PendingSpotOrder
+	}
+	return nil
+}
+
+type QueryAllPendingSpotOrderRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pagination *v1beta1.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryAllPendingSpotOrderRequest) Reset() {
+	*x = QueryAllPendingSpotOrderRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_elys_tradeshield_query_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryAllPendingSpotOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryAllPendingSpotOrderRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryAllPendingSpotOrderRequest.ProtoReflect.Descriptor instead.
+func (*QueryAllPendingSpotOrderRequest) Descriptor() ([]byte, []int) {
+	return file_elys_tradeshield_query_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *QueryAllPendingSpotOrderRequest) GetPagination() *v1beta1.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type QueryAllPendingSpotOrderResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PendingSpotOrder []*SpotOrder          `protobuf:"bytes,1,rep,name=pending_spot_order,json=pendingSpotOrder,proto3" json:"pending_spot_order,omitempty"`
+	Pagination       *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryAllPendingSpotOrderResponse) Reset() {
+	*x = QueryAllPendingSpotOrderResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_elys_tradeshield_query_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryAllPendingSpotOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryAllPendingSpotOrderResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryAllPendingSpotOrderResponse.ProtoReflect.Descriptor instead.
+func (*QueryAllPendingSpotOrderResponse) Descriptor() ([]byte, []int) {
+	return file_elys_tradeshield_query_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *QueryAllPendingSpotOrderResponse) GetPendingSpotOrder() []*SpotOrder {
+	if x != nil {
+		return x.PendingSpotOrder
+	}
+	return nil
+}
+
+func (x *QueryAllPendingSpotOrderResponse) GetPagination() *v1beta1.PageResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type QueryGetPendingPerpetualOrderRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *QueryGetPendingPerpetualOrderRequest) Reset() {
+	*x = QueryGetPendingPerpetualOrderRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_elys_tradeshield_query_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryGetPendingPerpetualOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryGetPendingPerpetualOrderRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryGetPendingPerpetualOrderRequest.ProtoReflect.Descriptor instead.
+func (*QueryGetPendingPerpetualOrderRequest) Descriptor() ([]byte, []int) {
+	return file_elys_tradeshield_query_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *QueryGetPendingPerpetualOrderRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type QueryGetPendingPerpetualOrderResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PendingPerpetualOrder *PerpetualOrderExtraInfo `protobuf:"bytes,1,opt,name=pending_perpetual_order,json=pendingPerpetualOrder,proto3" json:"pending_perpetual_order,omitempty"`
+}
+
+func (x *QueryGetPendingPerpetualOrderResponse) Reset() {
+	*x = QueryGetPendingPerpetualOrderResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_elys_tradeshield_query_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryGetPendingPerpetualOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryGetPendingPerpetualOrderResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryGetPendingPerpetualOrderResponse.ProtoReflect.Descriptor instead.
+func (*QueryGetPendingPerpetualOrderResponse) Descriptor() ([]byte, []int) {
+	return file_elys_tradeshield_query_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *QueryGetPendingPerpetualOrderResponse) GetPendingPerpetualOrder() *PerpetualOrderExtraInfo {
+	if x != nil {
+		return x.PendingPerpetualOrder
+	}
+	return nil
+}
+
+type QueryAllPendingPerpetualOrderRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pagination *v1beta1.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryAllPendingPerpetualOrderRequest) Reset() {
+	*x = QueryAllPendingPerpetualOrderRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_elys_tradeshield_query_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryAllPendingPerpetualOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryAllPendingPerpetualOrderRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryAllPendingPerpetualOrderRequest.ProtoReflect.Descriptor instead.
+func (*QueryAllPendingPerpetualOrderRequest) Descriptor() ([]byte, []int) {
+	return file_elys_tradeshield_query_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *QueryAllPendingPerpetualOrderRequest) GetPagination() *v1beta1.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type QueryAllPendingPerpetualOrderResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PendingPerpetualOrder []*PerpetualOrderExtraInfo `protobuf:"bytes,1,rep,name=pending_perpetual_order,json=pendingPerpetualOrder,proto3" json:"pending_perpetual_order,omitempty"`
+	Pagination            *v1beta1.PageResponse      `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryAllPendingPerpetualOrderResponse) Reset() {
+	*x = QueryAllPendingPerpetualOrderResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_elys_tradeshield_query_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryAllPendingPerpetualOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryAllPendingPerpetualOrderResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryAllPendingPerpetualOrderResponse.ProtoReflect.Descriptor instead.
+func (*QueryAllPendingPerpetualOrderResponse) Descriptor() ([]byte, []int) {
+	return file_elys_tradeshield_query_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *QueryAllPendingPerpetualOrderResponse) GetPendingPerpetualOrder() []*PerpetualOrderExtraInfo {
+	if x != nil {
+		return x.PendingPerpetualOrder
+	}
+	return nil
+}
+
+func (x *QueryAllPendingPerpetualOrderResponse) GetPagination() *v1beta1.PageResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type QueryPendingPerpetualOrderForAddressRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Status  Status `protobuf:"varint,2,opt,name=status,proto3,enum=elys.tradeshield.Status" json:"status,omitempty"`
+}
+
+func (x *QueryPendingPerpetualOrderForAddressRequest) Reset() {
+	*x = QueryPendingPerpetualOrderForAddressRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_elys_tradeshield_query_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryPendingPerpetualOrderForAddressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryPendingPerpetualOrderForAddressRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryPendingPerpetualOrderForAddressRequest.ProtoReflect.Descriptor instead.
+func (*QueryPendingPerpetualOrderForAddressRequest) Descriptor() ([]byte, []int) {
+	return file_elys_tradeshield_query_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *QueryPendingPerpetualOrderForAddressRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *QueryPendingPerpetualOrderForAddressRequest) GetStatus() Status {
+	if x != nil {
+		return x.Status
+	}
+	return Status_PENDING
+}
+
+type QueryPendingPerpetualOrderForAddressResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PendingPerpetualOrders []*PerpetualOrderExtraInfo `protobuf:"bytes,1,rep,name=pending_perpetual_orders,json=pendingPerpetualOrders,proto3" json:"pending_perpetual_orders,omitempty"`
+}
+
+func (x *QueryPendingPerpetualOrderForAddressResponse) Reset() {
+	*x = QueryPendingPerpetualOrderForAddressResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_elys_tradeshield_query_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryPendingPerpetualOrderForAddressResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryPendingPerpetualOrderForAddressResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryPendingPerpetualOrderForAddressResponse.ProtoReflect.Descriptor instead.
+func (*QueryPendingPerpetualOrderForAddressResponse) Descriptor() ([]byte, []int) {
+	return file_elys_tradeshield_query_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *QueryPendingPerpetualOrderForAddressResponse) GetPendingPerpetualOrders() []*PerpetualOrderExtraInfo {
+	if x != nil {
+		return x.PendingPerpetualOrders
+	}
+	return nil
+}
 
-// QueryParamsRequest is request type for the Query/Params RPC method.
-type QueryParamsRequest struct {
+type QueryPendingSpotOrderForAddressRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Status  Status `protobuf:"varint,2,opt,name=status,proto3,enum=elys.tradeshield.Status" json:"status,omitempty"`
 }
 
-func (x *QueryParamsRequest) Reset() {
-	*x = QueryParamsRequest{}
+func (x *QueryPendingSpotOrderForAddressRequest) Reset() {
+	*x = QueryPendingSpotOrderForAddressRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_elys_tradeshield_query_proto_msgTypes[0]
+		mi := &file_elys_tradeshield_query_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryParamsRequest) String() string {
+func (x *QueryPendingSpotOrderForAddressRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryParamsRequest) ProtoMessage() {}
+func (*QueryPendingSpotOrderForAddressRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryParamsRequest.ProtoReflect.Descriptor instead.
-func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return file_elys_tradeshield_query_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use QueryPendingSpotOrderForAddressRequest.ProtoReflect.Descriptor instead.
+func (*QueryPendingSpotOrderForAddressRequest) Descriptor() ([]byte, []int) {
+	return file_elys_tradeshield_query_proto_rawDescGZIP(), []int{12}
 }
 
-// QueryParamsResponse is response type for the Query/Params RPC method.
-type QueryParamsResponse struct {
+func (x *QueryPendingSpotOrderForAddressRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *QueryPendingSpotOrderForAddressRequest) GetStatus() Status {
+	if x != nil {
+		return x.Status
+	}
+	return Status_PENDING
+}
+
+type QueryPendingSpotOrderForAddressResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// params holds all the parameters of this module.
-	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	PendingSpotOrders []*SpotOrder `protobuf:"bytes,1,rep,name=pending_spot_orders,json=pendingSpotOrders,proto3" json:"pending_spot_orders,omitempty"`
 }
 
-func (x *QueryParamsResponse) Reset() {
-	*x = QueryParamsResponse{}
+func (x *QueryPendingSpotOrderForAddressResponse) Reset() {
+	*x = QueryPendingSpotOrderForAddressResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_elys_tradeshield_query_proto_msgTypes[1]
+		mi := &file_elys_tradeshield_query_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryParamsResponse) String() string {
+func (x *QueryPendingSpotOrderForAddressResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryParamsResponse) ProtoMessage() {}
+func (*QueryPendingSpotOrderForAddressResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryParamsResponse.ProtoReflect.Descriptor instead.
-func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return file_elys_tradeshield_query_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use QueryPendingSpotOrderForAddressResponse.ProtoReflect.Descriptor instead.
+func (*QueryPendingSpotOrderForAddressResponse) Descriptor() ([]byte, []int) {
+	return file_elys_tradeshield_query_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *QueryPendingSpotOrderForAddressResponse) GetPendingSpotOrders() []*SpotOrder {
+	if x != nil {
+		return x.PendingSpotOrders
+	}
+	return nil
+}
+
+type QueryGetOrderGroupRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *QueryGetOrderGroupRequest) Reset() {
+	*x = QueryGetOrderGroupRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_elys_tradeshield_query_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryGetOrderGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryGetOrderGroupRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryGetOrderGroupRequest.ProtoReflect.Descriptor instead.
+func (*QueryGetOrderGroupRequest) Descriptor() ([]byte, []int) {
+	return file_elys_tradeshield_query_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *QueryGetOrderGroupRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type QueryGetOrderGroupResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderGroup      *OrderGroup                `protobuf:"bytes,1,opt,name=order_group,json=orderGroup,proto3" json:"order_group,omitempty"`
+	SpotOrders      []*SpotOrder               `protobuf:"bytes,2,rep,name=spot_orders,json=spotOrders,proto3" json:"spot_orders,omitempty"`
+	PerpetualOrders []*PerpetualOrderExtraInfo `protobuf:"bytes,3,rep,name=perpetual_orders,json=perpetualOrders,proto3" json:"perpetual_orders,omitempty"`
+}
+
+func (x *QueryGetOrderGroupResponse) Reset() {
+	*x = QueryGetOrderGroupResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_elys_tradeshield_query_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryGetOrderGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryGetOrderGroupResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryGetOrderGroupResponse.ProtoReflect.Descriptor instead.
+func (*QueryGetOrderGroupResponse) Descriptor() ([]byte, []int) {
+	return file_elys_tradeshield_query_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *QueryGetOrderGroupResponse) GetOrderGroup() *OrderGroup {
+	if x != nil {
+		return x.OrderGroup
+	}
+	return nil
+}
+
+func (x *QueryGetOrderGroupResponse) GetSpotOrders() []*SpotOrder {
+	if x != nil {
+		return x.SpotOrders
+	}
+	return nil
+}
+
+func (x *QueryGetOrderGroupResponse) GetPerpetualOrders() []*PerpetualOrderExtraInfo {
+	if x != nil {
+		return x.PerpetualOrders
+	}
+	return nil
+}
+
+type QueryGetScheduledOrderRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *QueryGetScheduledOrderRequest) Reset() {
+	*x = QueryGetScheduledOrderRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_elys_tradeshield_query_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryGetScheduledOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryGetScheduledOrderRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryGetScheduledOrderRequest.ProtoReflect.Descriptor instead.
+func (*QueryGetScheduledOrderRequest) Descriptor() ([]byte, []int) {
+	return file_elys_tradeshield_query_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *QueryGetScheduledOrderRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type QueryGetScheduledOrderResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ScheduledOrder *ScheduledOrder        `protobuf:"bytes,1,opt,name=scheduled_order,json=scheduledOrder,proto3" json:"scheduled_order,omitempty"`
+	Slices         []*ScheduledOrderSlice `protobuf:"bytes,2,rep,name=slices,proto3" json:"slices,omitempty"`
+}
+
+func (x *QueryGetScheduledOrderResponse) Reset() {
+	*x = QueryGetScheduledOrderResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_elys_tradeshield_query_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryGetScheduledOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryGetScheduledOrderResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryGetScheduledOrderResponse.ProtoReflect.Descriptor instead.
+func (*QueryGetScheduledOrderResponse) Descriptor() ([]byte, []int) {
+	return file_elys_tradeshield_query_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *QueryGetScheduledOrderResponse) GetScheduledOrder() *ScheduledOrder {
+	if x != nil {
+		return x.ScheduledOrder
+	}
+	return nil
+}
+
+func (x *QueryGetScheduledOrderResponse) GetSlices() []*ScheduledOrderSlice {
+	if x != nil {
+		return x.Slices
+	}
+	return nil
+}
+
+// Pending orders aggregated at a price level
+type OrderBookLevel struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Lower bound of the price bucket, the exact price when no bucket size is
+	// set
+	Price      string `protobuf:"bytes,1,opt,name=price,proto3" json:"price,omitempty"`
+	OrderCount uint64 `protobuf:"varint,2,opt,name=order_count,json=orderCount,proto3" json:"order_count,omitempty"`
+	// Order amounts of spot orders, collateral of perpetual orders
+	Amount []*v1beta11.Coin `protobuf:"bytes,3,rep,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *OrderBookLevel) Reset() {
+	*x = OrderBookLevel{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_elys_tradeshield_query_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OrderBookLevel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderBookLevel) ProtoMessage() {}
+
+// Deprecated: Use OrderBookLevel.ProtoReflect.Descriptor instead.
+func (*OrderBookLevel) Descriptor() ([]byte, []int) {
+	return file_elys_tradeshield_query_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *OrderBookLevel) GetPrice() string {
+	if x != nil {
+		return x.Price
+	}
+	return ""
+}
+
+func (x *OrderBookLevel) GetOrderCount() uint64 {
+	if x != nil {
+		return x.OrderCount
+	}
+	return 0
+}
+
+func (x *OrderBookLevel) GetAmount() []*v1beta11.Coin {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+type QuerySpotOrderBookRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BaseDenom  string        `protobuf:"bytes,1,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
+	QuoteDenom string        `protobuf:"bytes,2,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty"`
+	Side       OrderBookSide `protobuf:"varint,3,opt,name=side,proto3,enum=elys.tradeshield.OrderBookSide" json:"side,omitempty"`
+	// Width of the price buckets, zero aggregates orders of the same price
+	BucketSize string               `protobuf:"bytes,4,opt,name=bucket_size,json=bucketSize,proto3" json:"bucket_size,omitempty"`
+	Pagination *v1beta1.PageRequest `protobuf:"bytes,5,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QuerySpotOrderBookRequest) Reset() {
+	*x = QuerySpotOrderBookRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_elys_tradeshield_query_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuerySpotOrderBookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuerySpotOrderBookRequest) ProtoMessage() {}
+
+// Deprecated: Use QuerySpotOrderBookRequest.ProtoReflect.Descriptor instead.
+func (*QuerySpotOrderBookRequest) Descriptor() ([]byte, []int) {
+	return file_elys_tradeshield_query_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *QuerySpotOrderBookRequest) GetBaseDenom() string {
+	if x != nil {
+		return x.BaseDenom
+	}
+	return ""
+}
+
+func (x *QuerySpotOrderBookRequest) GetQuoteDenom() string {
+	if x != nil {
+		return x.QuoteDenom
+	}
+	return ""
+}
+
+func (x *QuerySpotOrderBookRequest) GetSide() OrderBookSide {
+	if x != nil {
+		return x.Side
+	}
+	return OrderBookSide_BUY
 }
 
-func (x *QueryParamsResponse) GetParams() *Params {
+func (x *QuerySpotOrderBookRequest) GetBucketSize() string {
 	if x != nil {
-		return x.Params
+		return x.BucketSize
+	}
+	return ""
+}
+
+func (x *QuerySpotOrderBookRequest) GetPagination() *v1beta1.PageRequest {
+	if x != nil {
+		return x.Pagination
 	}
 	return nil
 }
 
-type QueryGetPendingSpotOrderRequest struct {
+// Levels are sorted from the price closest to the market, descending for
+// BUY and ascending for SELL
+type QuerySpotOrderBookResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Levels     []*OrderBookLevel     `protobuf:"bytes,1,rep,name=levels,proto3" json:"levels,omitempty"`
+	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *QueryGetPendingSpotOrderRequest) Reset() {
-	*x = QueryGetPendingSpotOrderRequest{}
+func (x *QuerySpotOrderBookResponse) Reset() {
+	*x = QuerySpotOrderBookResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_elys_tradeshield_query_proto_msgTypes[2]
+		mi := &file_elys_tradeshield_query_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryGetPendingSpotOrderRequest) String() string {
+func (x *QuerySpotOrderBookResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryGetPendingSpotOrderRequest) ProtoMessage() {}
+func (*QuerySpotOrderBookResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryGetPendingSpotOrderRequest.ProtoReflect.Descriptor instead.
-func (*QueryGetPendingSpotOrderRequest) Descriptor() ([]byte, []int) {
-	return file_elys_tradeshield_query_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use QuerySpotOrderBookResponse.ProtoReflect.Descriptor instead.
+func (*QuerySpotOrderBookResponse) Descriptor() ([]byte, []int) {
+	return file_elys_tradeshield_query_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *QueryGetPendingSpotOrderRequest) GetId() uint64 {
+func (x *QuerySpotOrderBookResponse) GetLevels() []*OrderBookLevel {
 	if x != nil {
-		return x.Id
+		return x.Levels
 	}
-	return 0
+	return nil
 }
 
-type QueryGetPendingSpotOrderResponse struct {
+func (x *QuerySpotOrderBookResponse) GetPagination() *v1beta1.PageResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type QueryPerpetualOrderBookRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PendingSpotOrder *SpotOrder `protobuf:"bytes,1,opt,name=pending_spot_order,json=pendingSpotOrder,proto3" json:"pending_spot_order,omitempty"`
+	TradingAsset string             `protobuf:"bytes,1,opt,name=trading_asset,json=tradingAsset,proto3" json:"trading_asset,omitempty"`
+	Position     PerpetualPosition  `protobuf:"varint,2,opt,name=position,proto3,enum=elys.tradeshield.PerpetualPosition" json:"position,omitempty"`
+	OrderType    PerpetualOrderType `protobuf:"varint,3,opt,name=order_type,json=orderType,proto3,enum=elys.tradeshield.PerpetualOrderType" json:"order_type,omitempty"`
+	// Width of the trigger price buckets, zero aggregates orders of the same
+	// trigger price
+	BucketSize string               `protobuf:"bytes,4,opt,name=bucket_size,json=bucketSize,proto3" json:"bucket_size,omitempty"`
+	Pagination *v1beta1.PageRequest `protobuf:"bytes,5,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *QueryGetPendingSpotOrderResponse) Reset() {
-	*x = QueryGetPendingSpotOrderResponse{}
+func (x *QueryPerpetualOrderBookRequest) Reset() {
+	*x = QueryPerpetualOrderBookRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_elys_tradeshield_query_proto_msgTypes[3]
+		mi := &file_elys_tradeshield_query_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryGetPendingSpotOrderResponse) String() string {
+func (x *QueryPerpetualOrderBookRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryGetPendingSpotOrderResponse) ProtoMessage() {}
+func (*QueryPerpetualOrderBookRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryGetPendingSpotOrderResponse.ProtoReflect.Descriptor instead.
-func (*QueryGetPendingSpotOrderResponse) Descriptor() ([]byte, []int) {
-	return file_elys_tradeshield_query_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use QueryPerpetualOrderBookRequest.ProtoReflect.Descriptor instead.
+func (*QueryPerpetualOrderBookRequest) Descriptor() ([]byte, []int) {
+	return file_elys_tradeshield_query_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *QueryGetPendingSpotOrderResponse) GetPendingSpotOrder() *SpotOrder {
+func (x *QueryPerpetualOrderBookRequest) GetTradingAsset() string {
 	if x != nil {
-		return x.PendingSpotOrder
+		return x.TradingAsset
 	}
-	return nil
-}
-
-type QueryAllPendingSpotOrderRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Pagination *v1beta1.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	return ""
 }
 
-func (x *QueryAllPendingSpotOrderRequest) Reset() {
-	*x = QueryAllPendingSpotOrderRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_elys_tradeshield_query_proto_msgTypes[4]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *QueryPerpetualOrderBookRequest) GetPosition() PerpetualPosition {
+	if x != nil {
+		return x.Position
 	}
+	return PerpetualPosition_UNSPECIFIED
 }
 
-func (x *QueryAllPendingSpotOrderRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *QueryPerpetualOrderBookRequest) GetOrderType() PerpetualOrderType {
+	if x != nil {
+		return x.OrderType
+	}
+	return PerpetualOrderType_LIMITOPEN
 }
 
-func (*QueryAllPendingSpotOrderRequest) ProtoMessage() {}
-
-// Deprecated: Use QueryAllPendingSpotOrderRequest.ProtoReflect.Descriptor instead.
-func (*QueryAllPendingSpotOrderRequest) Descriptor() ([]byte, []int) {
-	return file_elys_tradeshield_query_proto_rawDescGZIP(), []int{4}
+func (x *QueryPerpetualOrderBookRequest) GetBucketSize() string {
+	if x != nil {
+		return x.BucketSize
+	}
+	return ""
 }
 
-func (x *QueryAllPendingSpotOrderRequest) GetPagination() *v1beta1.PageRequest {
+func (x *QueryPerpetualOrderBookRequest) GetPagination() *v1beta1.PageRequest {
 	if x != nil {
 		return x.Pagination
 	}
 	return nil
 }
 
-type QueryAllPendingSpotOrderResponse struct {
+// Levels are sorted from the trigger price closest to the market
+type QueryPerpetualOrderBookResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PendingSpotOrder []*SpotOrder          `protobuf:"bytes,1,rep,name=pending_spot_order,json=pendingSpotOrder,proto3" json:"pending_spot_order,omitempty"`
-	Pagination       *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Levels     []*OrderBookLevel     `protobuf:"bytes,1,rep,name=levels,proto3" json:"levels,omitempty"`
+	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *QueryAllPendingSpotOrderResponse) Reset() {
-	*x = QueryAllPendingSpotOrderResponse{}
+func (x *QueryPerpetualOrderBookResponse) Reset() {
+	*x = QueryPerpetualOrderBookResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_elys_tradeshield_query_proto_msgTypes[5]
+		mi := &file_elys_tradeshield_query_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryAllPendingSpotOrderResponse) String() string {
+func (x *QueryPerpetualOrderBookResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryAllPendingSpotOrderResponse) ProtoMessage() {}
+func (*QueryPerpetualOrderBookResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryAllPendingSpotOrderResponse.ProtoReflect.Descriptor instead.
-func (*QueryAllPendingSpotOrderResponse) Descriptor() ([]byte, []int) {
-	return file_elys_tradeshield_query_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use QueryPerpetualOrderBookResponse.ProtoReflect.Descriptor instead.
+func (*QueryPerpetualOrderBookResponse) Descriptor() ([]byte, []int) {
+	return file_elys_tradeshield_query_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *QueryAllPendingSpotOrderResponse) GetPendingSpotOrder() []*SpotOrder {
+func (x *QueryPerpetualOrderBookResponse) GetLevels() []*OrderBookLevel {
 	if x != nil {
-		return x.PendingSpotOrder
+		return x.Levels
 	}
 	return nil
 }
 
-func (x *QueryAllPendingSpotOrderResponse) GetPagination() *v1beta1.PageResponse {
+func (x *QueryPerpetualOrderBookResponse) GetPagination() *v1beta1.PageResponse {
 	if x != nil {
 		return x.Pagination
 	}
 	return nil
 }
 
-type QueryGetPendingPerpetualOrderRequest struct {
+type QuerySimulateSpotOrderRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Owner of the order, optional, used for the swap fee discount
+	OwnerAddress     string         `protobuf:"bytes,1,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
+	OrderType        SpotOrderType  `protobuf:"varint,2,opt,name=order_type,json=orderType,proto3,enum=elys.tradeshield.SpotOrderType" json:"order_type,omitempty"`
+	OrderPrice       *OrderPrice    `protobuf:"bytes,3,opt,name=order_price,json=orderPrice,proto3" json:"order_price,omitempty"`
+	OrderAmount      *v1beta11.Coin `protobuf:"bytes,4,opt,name=order_amount,json=orderAmount,proto3" json:"order_amount,omitempty"`
+	OrderTargetDenom string         `protobuf:"bytes,5,opt,name=order_target_denom,json=orderTargetDenom,proto3" json:"order_target_denom,omitempty"`
 }
 
-func (x *QueryGetPendingPerpetualOrderRequest) Reset() {
-	*x = QueryGetPendingPerpetualOrderRequest{}
+func (x *QuerySimulateSpotOrderRequest) Reset() {
+	*x = QuerySimulateSpotOrderRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_elys_tradeshield_query_proto_msgTypes[6]
+		mi := &file_elys_tradeshield_query_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryGetPendingPerpetualOrderRequest) String() string {
+func (x *QuerySimulateSpotOrderRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryGetPendingPerpetualOrderRequest) ProtoMessage() {}
+func (*QuerySimulateSpotOrderRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryGetPendingPerpetualOrderRequest.ProtoReflect.Descriptor instead.
-func (*QueryGetPendingPerpetualOrderRequest) Descriptor() ([]byte, []int) {
-	return file_elys_tradeshield_query_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use QuerySimulateSpotOrderRequest.ProtoReflect.Descriptor instead.
+func (*QuerySimulateSpotOrderRequest) Descriptor() ([]byte, []int) {
+	return file_elys_tradeshield_query_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *QueryGetPendingPerpetualOrderRequest) GetId() uint64 {
+func (x *QuerySimulateSpotOrderRequest) GetOwnerAddress() string {
 	if x != nil {
-		return x.Id
+		return x.OwnerAddress
 	}
-	return 0
+	return ""
 }
 
-type QueryGetPendingPerpetualOrderResponse struct {
+func (x *QuerySimulateSpotOrderRequest) GetOrderType() SpotOrderType {
+	if x != nil {
+		return x.OrderType
+	}
+	return SpotOrderType_STOPLOSS
+}
+
+func (x *QuerySimulateSpotOrderRequest) GetOrderPrice() *OrderPrice {
+	if x != nil {
+		return x.OrderPrice
+	}
+	return nil
+}
+
+func (x *QuerySimulateSpotOrderRequest) GetOrderAmount() *v1beta11.Coin {
+	if x != nil {
+		return x.OrderAmount
+	}
+	return nil
+}
+
+func (x *QuerySimulateSpotOrderRequest) GetOrderTargetDenom() string {
+	if x != nil {
+		return x.OrderTargetDenom
+	}
+	return ""
+}
+
+type QuerySimulateSpotOrderResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PendingPerpetualOrder *PerpetualOrderExtraInfo `protobuf:"bytes,1,opt,name=pending_perpetual_order,json=pendingPerpetualOrder,proto3" json:"pending_perpetual_order,omitempty"`
-}
-
-func (x *QueryGetPendingPerpetualOrderResponse) Reset() {
-	*x = QueryGetPendingPerpetualOrderResponse{}
+	// Output of a swap of the whole order amount at the current pool state
+	AmountOut          *v1beta11.Coin `protobuf:"bytes,1,opt,name=amount_out,json=amountOut,proto3" json:"amount_out,omitempty"`
+	SpotPrice          string         `protobuf:"bytes,2,opt,name=spot_price,json=spotPrice,proto3" json:"spot_price,omitempty"`
+	SwapFee            string         `protobuf:"bytes,3,opt,name=swap_fee,json=swapFee,proto3" json:"swap_fee,omitempty"`
+	Discount           string         `protobuf:"bytes,4,opt,name=discount,proto3" json:"discount,omitempty"`
+	Slippage           string         `protobuf:"bytes,5,opt,name=slippage,proto3" json:"slippage,omitempty"`
+	PriceImpact        string         `protobuf:"bytes,6,opt,name=price_impact,json=priceImpact,proto3" json:"price_impact,omitempty"`
+	WeightBalanceRatio string         `protobuf:"bytes,7,opt,name=weight_balance_ratio,json=weightBalanceRatio,proto3" json:"weight_balance_ratio,omitempty"`
+	// Oracle price of the order pair, compared to the rate of the order
+	MarketPrice string `protobuf:"bytes,8,opt,name=market_price,json=marketPrice,proto3" json:"market_price,omitempty"`
+	// Whether the order would execute right now
+	Executable bool `protobuf:"varint,9,opt,name=executable,proto3" json:"executable,omitempty"`
+	// Part of the order amount that would be swapped right now, limit orders
+	// are partially filled within their rate
+	FillAmount *v1beta11.Coin `protobuf:"bytes,10,opt,name=fill_amount,json=fillAmount,proto3" json:"fill_amount,omitempty"`
+}
+
+func (x *QuerySimulateSpotOrderResponse) Reset() {
+	*x = QuerySimulateSpotOrderResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_elys_tradeshield_query_proto_msgTypes[7]
+		mi := &file_elys_tradeshield_query_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryGetPendingPerpetualOrderResponse) String() string {
+func (x *QuerySimulateSpotOrderResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryGetPendingPerpetualOrderResponse) ProtoMessage() {}
+func (*QuerySimulateSpotOrderResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryGetPendingPerpetualOrderResponse.ProtoReflect.Descriptor instead.
-func (*QueryGetPendingPerpetualOrderResponse) Descriptor() ([]byte, []int) {
-	return file_elys_tradeshield_query_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use QuerySimulateSpotOrderResponse.ProtoReflect.Descriptor instead.
+func (*QuerySimulateSpotOrderResponse) Descriptor() ([]byte, []int) {
+	return file_elys_tradeshield_query_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *QueryGetPendingPerpetualOrderResponse) GetPendingPerpetualOrder() *PerpetualOrderExtraInfo {
+func (x *QuerySimulateSpotOrderResponse) GetAmountOut() *v1beta11.Coin {
 	if x != nil {
-		return x.PendingPerpetualOrder
+		return x.AmountOut
 	}
 	return nil
 }
 
-type QueryAllPendingPerpetualOrderRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *QuerySimulateSpotOrderResponse) GetSpotPrice() string {
+	if x != nil {
+		return x.SpotPrice
+	}
+	return ""
+}
 
-	Pagination *v1beta1.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+func (x *QuerySimulateSpotOrderResponse) GetSwapFee() string {
+	if x != nil {
+		return x.SwapFee
+	}
+	return ""
 }
 
-func (x *QueryAllPendingPerpetualOrderRequest) Reset() {
-	*x = QueryAllPendingPerpetualOrderRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_elys_tradeshield_query_proto_msgTypes[8]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *QuerySimulateSpotOrderResponse) GetDiscount() string {
+	if x != nil {
+		return x.Discount
 	}
+	return ""
 }
 
-func (x *QueryAllPendingPerpetualOrderRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *QuerySimulateSpotOrderResponse) GetSlippage() string {
+	if x != nil {
+		return x.Slippage
+	}
+	return ""
 }
 
-func (*QueryAllPendingPerpetualOrderRequest) ProtoMessage() {}
+func (x *QuerySimulateSpotOrderResponse) GetPriceImpact() string {
+	if x != nil {
+		return x.PriceImpact
+	}
+	return ""
+}
 
-// Deprecated: Use QueryAllPendingPerpetualOrderRequest.ProtoReflect.Descriptor instead.
-func (*QueryAllPendingPerpetualOrderRequest) Descriptor() ([]byte, []int) {
-	return file_elys_tradeshield_query_proto_rawDescGZIP(), []int{8}
+func (x *QuerySimulateSpotOrderResponse) GetWeightBalanceRatio() string {
+	if x != nil {
+		return x.WeightBalanceRatio
+	}
+	return ""
 }
 
-func (x *QueryAllPendingPerpetualOrderRequest) GetPagination() *v1beta1.PageRequest {
+func (x *QuerySimulateSpotOrderResponse) GetMarketPrice() string {
 	if x != nil {
-		return x.Pagination
+		return x.MarketPrice
+	}
+	return ""
+}
+
+func (x *QuerySimulateSpotOrderResponse) GetExecutable() bool {
+	if x != nil {
+		return x.Executable
+	}
+	return false
+}
+
+func (x *QuerySimulateSpotOrderResponse) GetFillAmount() *v1beta11.Coin {
+	if x != nil {
+		return x.FillAmount
 	}
 	return nil
 }
 
-type QueryAllPendingPerpetualOrderResponse struct {
+type QuerySimulatePerpetualOrderRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PendingPerpetualOrder []*PerpetualOrderExtraInfo `protobuf:"bytes,1,rep,name=pending_perpetual_order,json=pendingPerpetualOrder,proto3" json:"pending_perpetual_order,omitempty"`
-	Pagination            *v1beta1.PageResponse      `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
-}
-
-func (x *QueryAllPendingPerpetualOrderResponse) Reset() {
-	*x = QueryAllPendingPerpetualOrderResponse{}
+	// Owner of the order, optional
+	OwnerAddress string            `protobuf:"bytes,1,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
+	Position     PerpetualPosition `protobuf:"varint,2,opt,name=position,proto3,enum=elys.tradeshield.PerpetualPosition" json:"position,omitempty"`
+	Leverage     string            `protobuf:"bytes,3,opt,name=leverage,proto3" json:"leverage,omitempty"`
+	TradingAsset string            `protobuf:"bytes,4,opt,name=trading_asset,json=tradingAsset,proto3" json:"trading_asset,omitempty"`
+	Collateral   *v1beta11.Coin    `protobuf:"bytes,5,opt,name=collateral,proto3" json:"collateral,omitempty"`
+	// Trigger price of the limit open order, zero simulates a market open
+	TriggerPrice string `protobuf:"bytes,6,opt,name=trigger_price,json=triggerPrice,proto3" json:"trigger_price,omitempty"`
+	// Unset uses the default take profit price of perpetual positions
+	TakeProfitPrice string `protobuf:"bytes,7,opt,name=take_profit_price,json=takeProfitPrice,proto3" json:"take_profit_price,omitempty"`
+	PoolId          uint64 `protobuf:"varint,8,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+}
+
+func (x *QuerySimulatePerpetualOrderRequest) Reset() {
+	*x = QuerySimulatePerpetualOrderRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_elys_tradeshield_query_proto_msgTypes[9]
+		mi := &file_elys_tradeshield_query_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryAllPendingPerpetualOrderResponse) String() string {
+func (x *QuerySimulatePerpetualOrderRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryAllPendingPerpetualOrderResponse) ProtoMessage() {}
+func (*QuerySimulatePerpetualOrderRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryAllPendingPerpetualOrderResponse.ProtoReflect.Descriptor instead.
-func (*QueryAllPendingPerpetualOrderResponse) Descriptor() ([]byte, []int) {
-	return file_elys_tradeshield_query_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use QuerySimulatePerpetualOrderRequest.ProtoReflect.Descriptor instead.
+func (*QuerySimulatePerpetualOrderRequest) Descriptor() ([]byte, []int) {
+	return file_elys_tradeshield_query_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *QueryAllPendingPerpetualOrderResponse) GetPendingPerpetualOrder() []*PerpetualOrderExtraInfo {
+func (x *QuerySimulatePerpetualOrderRequest) GetOwnerAddress() string {
 	if x != nil {
-		return x.PendingPerpetualOrder
+		return x.OwnerAddress
 	}
-	return nil
+	return ""
 }
 
-func (x *QueryAllPendingPerpetualOrderResponse) GetPagination() *v1beta1.PageResponse {
+func (x *QuerySimulatePerpetualOrderRequest) GetPosition() PerpetualPosition {
 	if x != nil {
-		return x.Pagination
+		return x.Position
 	}
-	return nil
+	return PerpetualPosition_UNSPECIFIED
 }
 
-type QueryPendingPerpetualOrderForAddressRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Status  Status `protobuf:"varint,2,opt,name=status,proto3,enum=elys.tradeshield.Status" json:"status,omitempty"`
+func (x *QuerySimulatePerpetualOrderRequest) GetLeverage() string {
+	if x != nil {
+		return x.Leverage
+	}
+	return ""
 }
 
-func (x *QueryPendingPerpetualOrderForAddressRequest) Reset() {
-	*x = QueryPendingPerpetualOrderForAddressRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_elys_tradeshield_query_proto_msgTypes[10]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *QuerySimulatePerpetualOrderRequest) GetTradingAsset() string {
+	if x != nil {
+		return x.TradingAsset
 	}
+	return ""
 }
 
-func (x *QueryPendingPerpetualOrderForAddressRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *QuerySimulatePerpetualOrderRequest) GetCollateral() *v1beta11.Coin {
+	if x != nil {
+		return x.Collateral
+	}
+	return nil
 }
 
-func (*QueryPendingPerpetualOrderForAddressRequest) ProtoMessage() {}
-
-// Deprecated: Use QueryPendingPerpetualOrderForAddressRequest.ProtoReflect.Descriptor instead.
-func (*QueryPendingPerpetualOrderForAddressRequest) Descriptor() ([]byte, []int) {
-	return file_elys_tradeshield_query_proto_rawDescGZIP(), []int{10}
+func (x *QuerySimulatePerpetualOrderRequest) GetTriggerPrice() string {
+	if x != nil {
+		return x.TriggerPrice
+	}
+	return ""
 }
 
-func (x *QueryPendingPerpetualOrderForAddressRequest) GetAddress() string {
+func (x *QuerySimulatePerpetualOrderRequest) GetTakeProfitPrice() string {
 	if x != nil {
-		return x.Address
+		return x.TakeProfitPrice
 	}
 	return ""
 }
 
-func (x *QueryPendingPerpetualOrderForAddressRequest) GetStatus() Status {
+func (x *QuerySimulatePerpetualOrderRequest) GetPoolId() uint64 {
 	if x != nil {
-		return x.Status
+		return x.PoolId
 	}
-	return Status_PENDING
+	return 0
 }
 
-type QueryPendingPerpetualOrderForAddressResponse struct {
+type QuerySimulatePerpetualOrderResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PendingPerpetualOrders []*PerpetualOrderExtraInfo `protobuf:"bytes,1,rep,name=pending_perpetual_orders,json=pendingPerpetualOrders,proto3" json:"pending_perpetual_orders,omitempty"`
-}
-
-func (x *QueryPendingPerpetualOrderForAddressResponse) Reset() {
-	*x = QueryPendingPerpetualOrderForAddressResponse{}
+	PositionSize       *v1beta11.Coin `protobuf:"bytes,1,opt,name=position_size,json=positionSize,proto3" json:"position_size,omitempty"`
+	OpenPrice          string         `protobuf:"bytes,2,opt,name=open_price,json=openPrice,proto3" json:"open_price,omitempty"`
+	LiquidationPrice   string         `protobuf:"bytes,3,opt,name=liquidation_price,json=liquidationPrice,proto3" json:"liquidation_price,omitempty"`
+	EffectiveLeverage  string         `protobuf:"bytes,4,opt,name=effective_leverage,json=effectiveLeverage,proto3" json:"effective_leverage,omitempty"`
+	Custody            *v1beta11.Coin `protobuf:"bytes,5,opt,name=custody,proto3" json:"custody,omitempty"`
+	Liabilities        *v1beta11.Coin `protobuf:"bytes,6,opt,name=liabilities,proto3" json:"liabilities,omitempty"`
+	Slippage           string         `protobuf:"bytes,7,opt,name=slippage,proto3" json:"slippage,omitempty"`
+	PriceImpact        string         `protobuf:"bytes,8,opt,name=price_impact,json=priceImpact,proto3" json:"price_impact,omitempty"`
+	WeightBreakingFee  string         `protobuf:"bytes,9,opt,name=weight_breaking_fee,json=weightBreakingFee,proto3" json:"weight_breaking_fee,omitempty"`
+	FundingRate        string         `protobuf:"bytes,10,opt,name=funding_rate,json=fundingRate,proto3" json:"funding_rate,omitempty"`
+	BorrowInterestRate string         `protobuf:"bytes,11,opt,name=borrow_interest_rate,json=borrowInterestRate,proto3" json:"borrow_interest_rate,omitempty"`
+	HourlyInterestRate string         `protobuf:"bytes,12,opt,name=hourly_interest_rate,json=hourlyInterestRate,proto3" json:"hourly_interest_rate,omitempty"`
+	AvailableLiquidity *v1beta11.Coin `protobuf:"bytes,13,opt,name=available_liquidity,json=availableLiquidity,proto3" json:"available_liquidity,omitempty"`
+	// Oracle price of the trading asset, compared to the trigger price
+	MarketPrice string `protobuf:"bytes,14,opt,name=market_price,json=marketPrice,proto3" json:"market_price,omitempty"`
+	// Whether the order would execute right now
+	Executable bool `protobuf:"varint,15,opt,name=executable,proto3" json:"executable,omitempty"`
+}
+
+func (x *QuerySimulatePerpetualOrderResponse) Reset() {
+	*x = QuerySimulatePerpetualOrderResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_elys_tradeshield_query_proto_msgTypes[11]
+		mi := &file_elys_tradeshield_query_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryPendingPerpetualOrderForAddressResponse) String() string {
+func (x *QuerySimulatePerpetualOrderResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryPendingPerpetualOrderForAddressResponse) ProtoMessage() {}
+func (*QuerySimulatePerpetualOrderResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryPendingPerpetualOrderForAddressResponse.ProtoReflect.Descriptor instead.
-func (*QueryPendingPerpetualOrderForAddressResponse) Descriptor() ([]byte, []int) {
-	return file_elys_tradeshield_query_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use QuerySimulatePerpetualOrderResponse.ProtoReflect.Descriptor instead.
+func (*QuerySimulatePerpetualOrderResponse) Descriptor() ([]byte, []int) {
+	return file_elys_tradeshield_query_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *QuerySimulatePerpetualOrderResponse) GetPositionSize() *v1beta11.Coin {
+	if x != nil {
+		return x.PositionSize
+	}
+	return nil
+}
+
+func (x *QuerySimulatePerpetualOrderResponse) GetOpenPrice() string {
+	if x != nil {
+		return x.OpenPrice
+	}
+	return ""
 }
 
-func (x *QueryPendingPerpetualOrderForAddressResponse) GetPendingPerpetualOrders() []*PerpetualOrderExtraInfo {
+func (x *QuerySimulatePerpetualOrderResponse) GetLiquidationPrice() string {
 	if x != nil {
-		return x.PendingPerpetualOrders
+		return x.LiquidationPrice
 	}
-	return nil
+	return ""
 }
 
-type QueryPendingSpotOrderForAddressRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Status  Status `protobuf:"varint,2,opt,name=status,proto3,enum=elys.tradeshield.Status" json:"status,omitempty"`
+func (x *QuerySimulatePerpetualOrderResponse) GetEffectiveLeverage() string {
+	if x != nil {
+		return x.EffectiveLeverage
+	}
+	return ""
 }
 
-func (x *QueryPendingSpotOrderForAddressRequest) Reset() {
-	*x = QueryPendingSpotOrderForAddressRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_elys_tradeshield_query_proto_msgTypes[12]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *QuerySimulatePerpetualOrderResponse) GetCustody() *v1beta11.Coin {
+	if x != nil {
+		return x.Custody
 	}
+	return nil
 }
 
-func (x *QueryPendingSpotOrderForAddressRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *QuerySimulatePerpetualOrderResponse) GetLiabilities() *v1beta11.Coin {
+	if x != nil {
+		return x.Liabilities
+	}
+	return nil
 }
 
-func (*QueryPendingSpotOrderForAddressRequest) ProtoMessage() {}
-
-// Deprecated: Use QueryPendingSpotOrderForAddressRequest.ProtoReflect.Descriptor instead.
-func (*QueryPendingSpotOrderForAddressRequest) Descriptor() ([]byte, []int) {
-	return file_elys_tradeshield_query_proto_rawDescGZIP(), []int{12}
+func (x *QuerySimulatePerpetualOrderResponse) GetSlippage() string {
+	if x != nil {
+		return x.Slippage
+	}
+	return ""
 }
 
-func (x *QueryPendingSpotOrderForAddressRequest) GetAddress() string {
+func (x *QuerySimulatePerpetualOrderResponse) GetPriceImpact() string {
 	if x != nil {
-		return x.Address
+		return x.PriceImpact
 	}
 	return ""
 }
 
-func (x *QueryPendingSpotOrderForAddressRequest) GetStatus() Status {
+func (x *QuerySimulatePerpetualOrderResponse) GetWeightBreakingFee() string {
 	if x != nil {
-		return x.Status
+		return x.WeightBreakingFee
 	}
-	return Status_PENDING
+	return ""
 }
 
-type QueryPendingSpotOrderForAddressResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	PendingSpotOrders []*SpotOrder `protobuf:"bytes,1,rep,name=pending_spot_orders,json=pendingSpotOrders,proto3" json:"pending_spot_orders,omitempty"`
+func (x *QuerySimulatePerpetualOrderResponse) GetFundingRate() string {
+	if x != nil {
+		return x.FundingRate
+	}
+	return ""
 }
 
-func (x *QueryPendingSpotOrderForAddressResponse) Reset() {
-	*x = QueryPendingSpotOrderForAddressResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_elys_tradeshield_query_proto_msgTypes[13]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *QuerySimulatePerpetualOrderResponse) GetBorrowInterestRate() string {
+	if x != nil {
+		return x.BorrowInterestRate
 	}
+	return ""
 }
 
-func (x *QueryPendingSpotOrderForAddressResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *QuerySimulatePerpetualOrderResponse) GetHourlyInterestRate() string {
+	if x != nil {
+		return x.HourlyInterestRate
+	}
+	return ""
 }
 
-func (*QueryPendingSpotOrderForAddressResponse) ProtoMessage() {}
+func (x *QuerySimulatePerpetualOrderResponse) GetAvailableLiquidity() *v1beta11.Coin {
+	if x != nil {
+		return x.AvailableLiquidity
+	}
+	return nil
+}
 
-// Deprecated: Use QueryPendingSpotOrderForAddressResponse.ProtoReflect.Descriptor instead.
-func (*QueryPendingSpotOrderForAddressResponse) Descriptor() ([]byte, []int) {
-	return file_elys_tradeshield_query_proto_rawDescGZIP(), []int{13}
+func (x *QuerySimulatePerpetualOrderResponse) GetMarketPrice() string {
+	if x != nil {
+		return x.MarketPrice
+	}
+	return ""
 }
 
-func (x *QueryPendingSpotOrderForAddressResponse) GetPendingSpotOrders() []*SpotOrder {
+func (x *QuerySimulatePerpetualOrderResponse) GetExecutable() bool {
 	if x != nil {
-		return x.PendingSpotOrders
+		return x.Executable
 	}
-	return nil
+	return false
 }
 
 var File_elys_tradeshield_query_proto protoreflect.FileDescriptor
@@ -6960,217 +16980,587 @@ var file_elys_tradeshield_query_proto_rawDesc = []byte{
 	0x6c, 0x64, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x10,
 	0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64,
 	0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73,
-	0x65, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f,
-	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x1a, 0x1d, 0x65, 0x6c, 0x79, 0x73, 0x2f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65,
-	0x6c, 0x64, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
-	0x1c, 0x65, 0x6c, 0x79, 0x73, 0x2f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c,
-	0x64, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x14, 0x0a,
-	0x12, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x22, 0x4d, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61,
-	0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x06, 0x70, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x65, 0x6c, 0x79,
-	0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x50, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61,
-	0x6d, 0x73, 0x22, 0x31, 0x0a, 0x1f, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x50, 0x65,
-	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x70, 0x6f, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1c, 0x65, 0x6c, 0x79, 0x73, 0x2f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69,
+	0x65, 0x6c, 0x64, 0x2f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2a, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79,
+	0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1d, 0x65, 0x6c, 0x79, 0x73, 0x2f,
+	0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2f, 0x70, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x65, 0x6c, 0x79, 0x73, 0x2f, 0x74,
+	0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x14, 0x0a, 0x12, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4d, 0x0a, 0x13,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65,
+	0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x00, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x31, 0x0a, 0x1f, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x70,
+	0x6f, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x73,
+	0x0a, 0x20, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x53, 0x70, 0x6f, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x4f, 0x0a, 0x12, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x70,
+	0x6f, 0x74, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c,
+	0x64, 0x2e, 0x53, 0x70, 0x6f, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x04, 0xc8, 0xde, 0x1f,
+	0x00, 0x52, 0x10, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x70, 0x6f, 0x74, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x22, 0x69, 0x0a, 0x1f, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x50,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x70, 0x6f, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xbc,
+	0x01, 0x0a, 0x20, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x50, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x53, 0x70, 0x6f, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x12, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x73,
+	0x70, 0x6f, 0x74, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65,
+	0x6c, 0x64, 0x2e, 0x53, 0x70, 0x6f, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x10, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x70, 0x6f, 0x74, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x36, 0x0a,
+	0x24, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65,
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x73, 0x0a, 0x20, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65,
-	0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x70, 0x6f, 0x74, 0x4f, 0x72, 0x64, 0x65,
-	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x12, 0x70, 0x65, 0x6e,
-	0x64, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x70, 0x6f, 0x74, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61,
-	0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x53, 0x70, 0x6f, 0x74, 0x4f, 0x72, 0x64,
-	0x65, 0x72, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x10, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e,
-	0x67, 0x53, 0x70, 0x6f, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x22, 0x69, 0x0a, 0x1f, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x70, 0x6f,
-	0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x46, 0x0a,
-	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
-	0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61,
-	0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xbc, 0x01, 0x0a, 0x20, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41,
-	0x6c, 0x6c, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x70, 0x6f, 0x74, 0x4f, 0x72, 0x64,
-	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x12, 0x70, 0x65,
-	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x70, 0x6f, 0x74, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72,
-	0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x53, 0x70, 0x6f, 0x74, 0x4f, 0x72,
-	0x64, 0x65, 0x72, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x10, 0x70, 0x65, 0x6e, 0x64, 0x69,
-	0x6e, 0x67, 0x53, 0x70, 0x6f, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x47, 0x0a, 0x0a, 0x70,
+	0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x90, 0x01, 0x0a, 0x25, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47,
+	0x65, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75,
+	0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x67, 0x0a, 0x17, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x65, 0x72, 0x70, 0x65,
+	0x74, 0x75, 0x61, 0x6c, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x29, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69,
+	0x65, 0x6c, 0x64, 0x2e, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x45, 0x78, 0x74, 0x72, 0x61, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x04, 0xc8, 0xde, 0x1f,
+	0x00, 0x52, 0x15, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74,
+	0x75, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x22, 0x6e, 0x0a, 0x24, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x41, 0x6c, 0x6c, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x72, 0x70, 0x65,
+	0x74, 0x75, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xd9, 0x01, 0x0a, 0x25, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x41, 0x6c, 0x6c, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x72, 0x70,
+	0x65, 0x74, 0x75, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x67, 0x0a, 0x17, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x65,
+	0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65,
+	0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x45, 0x78, 0x74, 0x72, 0x61, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x04,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x15, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x72,
+	0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x47, 0x0a, 0x0a, 0x70,
 	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75,
 	0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65,
 	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x36, 0x0a, 0x24, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74,
-	0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c,
-	0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x90, 0x01, 0x0a,
-	0x25, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
-	0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x67, 0x0a, 0x17, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e,
-	0x67, 0x5f, 0x70, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x6f, 0x72, 0x64, 0x65,
-	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74,
-	0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x50, 0x65, 0x72, 0x70, 0x65,
-	0x74, 0x75, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x45, 0x78, 0x74, 0x72, 0x61, 0x49, 0x6e,
-	0x66, 0x6f, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x15, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e,
-	0x67, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x22,
-	0x6e, 0x0a, 0x24, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x50, 0x65, 0x6e, 0x64, 0x69,
-	0x6e, 0x67, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e,
-	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
-	0xd9, 0x01, 0x0a, 0x25, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x50, 0x65, 0x6e, 0x64,
-	0x69, 0x6e, 0x67, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65,
-	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x67, 0x0a, 0x17, 0x70, 0x65, 0x6e,
-	0x64, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x6f,
-	0x72, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x65, 0x6c, 0x79,
-	0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x50, 0x65,
-	0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x45, 0x78, 0x74, 0x72,
-	0x61, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x15, 0x70, 0x65, 0x6e,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x79, 0x0a, 0x2b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x65, 0x6e,
 	0x64, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x4f, 0x72, 0x64,
-	0x65, 0x72, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
-	0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52,
-	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x79, 0x0a, 0x2b, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x72, 0x70, 0x65,
-	0x74, 0x75, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x46, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64,
-	0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x99, 0x01, 0x0a, 0x2c, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c,
-	0x4f, 0x72, 0x64, 0x65, 0x72, 0x46, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x69, 0x0a, 0x18, 0x70, 0x65, 0x6e, 0x64, 0x69,
-	0x6e, 0x67, 0x5f, 0x70, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x6f, 0x72, 0x64,
-	0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x65, 0x6c, 0x79, 0x73,
-	0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x50, 0x65, 0x72,
-	0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x45, 0x78, 0x74, 0x72, 0x61,
-	0x49, 0x6e, 0x66, 0x6f, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x16, 0x70, 0x65, 0x6e, 0x64,
-	0x69, 0x6e, 0x67, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65,
-	0x72, 0x73, 0x22, 0x74, 0x0a, 0x26, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x65, 0x6e, 0x64, 0x69,
-	0x6e, 0x67, 0x53, 0x70, 0x6f, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x46, 0x6f, 0x72, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72,
-	0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x7c, 0x0a, 0x27, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x70, 0x6f, 0x74, 0x4f, 0x72, 0x64, 0x65,
-	0x72, 0x46, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x13, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x73,
-	0x70, 0x6f, 0x74, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x1b, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69,
-	0x65, 0x6c, 0x64, 0x2e, 0x53, 0x70, 0x6f, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x04, 0xc8,
-	0xde, 0x1f, 0x00, 0x52, 0x11, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x70, 0x6f, 0x74,
-	0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x32, 0x80, 0x0b, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x12, 0x84, 0x01, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x24, 0x2e, 0x65, 0x6c,
+	0x65, 0x72, 0x46, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x30, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e,
+	0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64,
+	0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
+	0x99, 0x01, 0x0a, 0x2c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x46, 0x6f,
+	0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x69, 0x0a, 0x18, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x65, 0x72, 0x70,
+	0x65, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x29, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73,
+	0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x45, 0x78, 0x74, 0x72, 0x61, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x00, 0x52, 0x16, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x72, 0x70,
+	0x65, 0x74, 0x75, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x22, 0x74, 0x0a, 0x26, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x70, 0x6f, 0x74, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x46, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x30, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x18, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65,
+	0x6c, 0x64, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x22, 0x7c, 0x0a, 0x27, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x53, 0x70, 0x6f, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x46, 0x6f, 0x72, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x13,
+	0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x70, 0x6f, 0x74, 0x5f, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x65, 0x6c, 0x79, 0x73,
+	0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x53, 0x70, 0x6f,
+	0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x11, 0x70, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x70, 0x6f, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x22,
+	0x2b, 0x0a, 0x19, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x81, 0x02, 0x0a,
+	0x1a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0b, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69,
+	0x65, 0x6c, 0x64, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x04,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x12, 0x42, 0x0a, 0x0b, 0x73, 0x70, 0x6f, 0x74, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61,
+	0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x53, 0x70, 0x6f, 0x74, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a, 0x73, 0x70, 0x6f, 0x74, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x73, 0x12, 0x5a, 0x0a, 0x10, 0x70, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61,
+	0x6c, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29,
+	0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c,
+	0x64, 0x2e, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x45, 0x78, 0x74, 0x72, 0x61, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
+	0x0f, 0x70, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73,
+	0x22, 0x2f, 0x0a, 0x1d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0xb6, 0x01, 0x0a, 0x1e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x0f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x64, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64,
+	0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42,
+	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x43, 0x0a, 0x06, 0x73, 0x6c, 0x69, 0x63, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61,
+	0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x06, 0x73, 0x6c, 0x69, 0x63, 0x65, 0x73, 0x22, 0xdf, 0x01, 0x0a, 0x0e, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6b, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x47, 0x0a,
+	0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde,
+	0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
+	0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65,
+	0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52,
+	0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x63, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f,
+	0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43,
+	0x6f, 0x69, 0x6e, 0x73, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xac, 0x02, 0x0a,
+	0x19, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x70, 0x6f, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42,
+	0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61,
+	0x73, 0x65, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x62, 0x61, 0x73, 0x65, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x1f, 0x0a, 0x0b, 0x71, 0x75, 0x6f,
+	0x74, 0x65, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x71, 0x75, 0x6f, 0x74, 0x65, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x33, 0x0a, 0x04, 0x73, 0x69,
+	0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e,
+	0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x42, 0x6f, 0x6f, 0x6b, 0x53, 0x69, 0x64, 0x65, 0x52, 0x04, 0x73, 0x69, 0x64, 0x65, 0x12,
+	0x52, 0x0a, 0x0b, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e,
+	0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0a, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x53,
+	0x69, 0x7a, 0x65, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
+	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xa5, 0x01, 0x0a, 0x1a,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x70, 0x6f, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x6f,
+	0x6f, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x06, 0x6c, 0x65,
+	0x76, 0x65, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x65, 0x6c, 0x79,
+	0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6b, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x06, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65,
+	0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0xe7, 0x02, 0x0a, 0x1e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x65, 0x72,
+	0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6b, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x72, 0x61, 0x64, 0x69, 0x6e,
+	0x67, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74,
+	0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x3f, 0x0a, 0x08, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e,
+	0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64,
+	0x2e, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x43, 0x0a, 0x0a,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x24, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69,
+	0x65, 0x6c, 0x64, 0x2e, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x52, 0x0a, 0x0b, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74,
+	0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0a, 0x62, 0x75, 0x63, 0x6b, 0x65,
+	0x74, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xaa, 0x01,
+	0x0a, 0x1f, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x3e, 0x0a, 0x06, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x20, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68,
+	0x69, 0x65, 0x6c, 0x64, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6b, 0x4c, 0x65,
+	0x76, 0x65, 0x6c, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x6c, 0x65, 0x76, 0x65, 0x6c,
+	0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a,
+	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xbb, 0x02, 0x0a, 0x1d, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x53, 0x70, 0x6f, 0x74,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d,
+	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x3e, 0x0a, 0x0a, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61,
+	0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x53, 0x70, 0x6f, 0x74, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x43, 0x0a, 0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72,
+	0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x42, 0x0a, 0x0c, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0b, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x91, 0x06, 0x0a, 0x1e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x53, 0x70, 0x6f, 0x74, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0a, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
+	0x52, 0x09, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x12, 0x50, 0x0a, 0x0a, 0x73,
+	0x70, 0x6f, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
+	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63,
+	0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44,
+	0x65, 0x63, 0x52, 0x09, 0x73, 0x70, 0x6f, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x4c, 0x0a,
+	0x08, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
+	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63,
+	0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44,
+	0x65, 0x63, 0x52, 0x07, 0x73, 0x77, 0x61, 0x70, 0x46, 0x65, 0x65, 0x12, 0x4d, 0x0a, 0x08, 0x64,
+	0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8,
+	0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
+	0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44,
+	0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63,
+	0x52, 0x08, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x4d, 0x0a, 0x08, 0x73, 0x6c,
+	0x69, 0x70, 0x70, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde,
+	0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
+	0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65,
+	0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52,
+	0x08, 0x73, 0x6c, 0x69, 0x70, 0x70, 0x61, 0x67, 0x65, 0x12, 0x54, 0x0a, 0x0c, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x5f, 0x69, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
+	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63,
+	0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44,
+	0x65, 0x63, 0x52, 0x0b, 0x70, 0x72, 0x69, 0x63, 0x65, 0x49, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x12,
+	0x63, 0x0a, 0x14, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8,
+	0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
+	0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44,
+	0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63,
+	0x52, 0x12, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52,
+	0x61, 0x74, 0x69, 0x6f, 0x12, 0x54, 0x0a, 0x0c, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00,
+	0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
+	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2,
+	0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0b, 0x6d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a,
+	0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x40, 0x0a, 0x0b, 0x66, 0x69,
+	0x6c, 0x6c, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
+	0x52, 0x0a, 0x66, 0x69, 0x6c, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x8f, 0x04, 0x0a,
+	0x22, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x50, 0x65,
+	0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x3f, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x65, 0x6c, 0x79,
+	0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x50, 0x65,
+	0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4d, 0x0a, 0x08, 0x6c, 0x65, 0x76,
+	0x65, 0x72, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f,
+	0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
+	0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63,
+	0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x08,
+	0x6c, 0x65, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x72, 0x61, 0x64,
+	0x69, 0x6e, 0x67, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x74, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x3f, 0x0a,
+	0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x12, 0x56,
+	0x0a, 0x0d, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68,
+	0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0c, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65,
+	0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x5d, 0x0a, 0x11, 0x74, 0x61, 0x6b, 0x65, 0x5f, 0x70,
+	0x72, 0x6f, 0x66, 0x69, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67,
+	0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x44, 0x65, 0x63, 0x52, 0x0f, 0x74, 0x61, 0x6b, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x74,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x69, 0x64,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x6f, 0x6f, 0x6c, 0x49, 0x64, 0x22, 0xed,
+	0x09, 0x0a, 0x23, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65,
+	0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0d, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0c,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x50, 0x0a, 0x0a,
+	0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61,
+	0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x44, 0x65, 0x63, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x6e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x5e,
+	0x0a, 0x11, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda,
+	0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
+	0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4,
+	0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x10, 0x6c, 0x69,
+	0x71, 0x75, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x60,
+	0x0a, 0x12, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x6c, 0x65, 0x76, 0x65,
+	0x72, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00,
+	0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
+	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2,
+	0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x11, 0x65,
+	0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x65, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65,
+	0x12, 0x39, 0x0a, 0x07, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x64, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x07, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x64, 0x79, 0x12, 0x41, 0x0a, 0x0b, 0x6c,
+	0x69, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f,
+	0x00, 0x52, 0x0b, 0x6c, 0x69, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x4d,
+	0x0a, 0x08, 0x73, 0x6c, 0x69, 0x70, 0x70, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61,
+	0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x44, 0x65, 0x63, 0x52, 0x08, 0x73, 0x6c, 0x69, 0x70, 0x70, 0x61, 0x67, 0x65, 0x12, 0x54, 0x0a,
+	0x0c, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c,
+	0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0b, 0x70, 0x72, 0x69, 0x63, 0x65, 0x49, 0x6d, 0x70,
+	0x61, 0x63, 0x74, 0x12, 0x61, 0x0a, 0x13, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x62, 0x72,
+	0x65, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61,
+	0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x44, 0x65, 0x63, 0x52, 0x11, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b,
+	0x69, 0x6e, 0x67, 0x46, 0x65, 0x65, 0x12, 0x54, 0x0a, 0x0c, 0x66, 0x75, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde,
+	0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
+	0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65,
+	0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52,
+	0x0b, 0x66, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x65, 0x12, 0x63, 0x0a, 0x14,
+	0x62, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x5f,
+	0x72, 0x61, 0x74, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00,
+	0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
+	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2,
+	0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x12, 0x62,
+	0x6f, 0x72, 0x72, 0x6f, 0x77, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x52, 0x61, 0x74,
+	0x65, 0x12, 0x63, 0x0a, 0x14, 0x68, 0x6f, 0x75, 0x72, 0x6c, 0x79, 0x5f, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x65, 0x73, 0x74, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
+	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63,
+	0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44,
+	0x65, 0x63, 0x52, 0x12, 0x68, 0x6f, 0x75, 0x72, 0x6c, 0x79, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65,
+	0x73, 0x74, 0x52, 0x61, 0x74, 0x65, 0x12, 0x50, 0x0a, 0x13, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61,
+	0x62, 0x6c, 0x65, 0x5f, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x12, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x4c,
+	0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x12, 0x54, 0x0a, 0x0c, 0x6d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31,
+	0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
+	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79,
+	0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65,
+	0x63, 0x52, 0x0b, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1e,
+	0x0a, 0x0a, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x0f, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0a, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x2a, 0x22,
+	0x0a, 0x0d, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6b, 0x53, 0x69, 0x64, 0x65, 0x12,
+	0x07, 0x0a, 0x03, 0x42, 0x55, 0x59, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x45, 0x4c, 0x4c,
+	0x10, 0x01, 0x32, 0xdd, 0x13, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x84, 0x01, 0x0a,
+	0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x24, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74,
+	0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e,
+	0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x12, 0x25, 0x2f, 0x65,
+	0x6c, 0x79, 0x73, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x65, 0x6c, 0x79, 0x73,
+	0x2f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2f, 0x70, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x12, 0xb9, 0x01, 0x0a, 0x10, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53,
+	0x70, 0x6f, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x31, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e,
+	0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x47, 0x65, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x70, 0x6f, 0x74, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x65, 0x6c,
 	0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x25, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68,
-	0x69, 0x65, 0x6c, 0x64, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27,
-	0x12, 0x25, 0x2f, 0x65, 0x6c, 0x79, 0x73, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f,
-	0x65, 0x6c, 0x79, 0x73, 0x2f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64,
-	0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0xb9, 0x01, 0x0a, 0x10, 0x50, 0x65, 0x6e, 0x64,
-	0x69, 0x6e, 0x67, 0x53, 0x70, 0x6f, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x31, 0x2e, 0x65,
-	0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53,
-	0x70, 0x6f, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x32, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65,
-	0x6c, 0x64, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69,
-	0x6e, 0x67, 0x53, 0x70, 0x6f, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x3e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x38, 0x12, 0x36, 0x2f, 0x65, 0x6c,
-	0x79, 0x73, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x65, 0x6c, 0x79, 0x73, 0x2f,
-	0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2f, 0x70, 0x65, 0x6e, 0x64,
-	0x69, 0x6e, 0x67, 0x5f, 0x73, 0x70, 0x6f, 0x74, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2f, 0x7b,
-	0x69, 0x64, 0x7d, 0x12, 0xb7, 0x01, 0x0a, 0x13, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53,
-	0x70, 0x6f, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x41, 0x6c, 0x6c, 0x12, 0x31, 0x2e, 0x65, 0x6c,
+	0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x70,
+	0x6f, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x3e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x38, 0x12, 0x36, 0x2f, 0x65, 0x6c, 0x79, 0x73, 0x2d, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x65, 0x6c, 0x79, 0x73, 0x2f, 0x74, 0x72, 0x61, 0x64,
+	0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f,
+	0x73, 0x70, 0x6f, 0x74, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12,
+	0xb7, 0x01, 0x0a, 0x13, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x70, 0x6f, 0x74, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x41, 0x6c, 0x6c, 0x12, 0x31, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74,
+	0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x41, 0x6c, 0x6c, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x70, 0x6f, 0x74, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x65, 0x6c, 0x79,
+	0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x70, 0x6f,
+	0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x39,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x33, 0x12, 0x31, 0x2f, 0x65, 0x6c, 0x79, 0x73, 0x2d, 0x6e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x65, 0x6c, 0x79, 0x73, 0x2f, 0x74, 0x72, 0x61, 0x64, 0x65,
+	0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x73,
+	0x70, 0x6f, 0x74, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0xcd, 0x01, 0x0a, 0x15, 0x50, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x12, 0x36, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65,
+	0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x50,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x65, 0x6c,
 	0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x70,
-	0x6f, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32,
+	0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x65,
+	0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x43, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3d, 0x12, 0x3b, 0x2f, 0x65,
+	0x6c, 0x79, 0x73, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x65, 0x6c, 0x79, 0x73,
+	0x2f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2f, 0x70, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0xcb, 0x01, 0x0a, 0x18, 0x50, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x41, 0x6c, 0x6c, 0x12, 0x36, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72,
+	0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41,
+	0x6c, 0x6c, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75,
+	0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37,
 	0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c,
 	0x64, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e,
-	0x67, 0x53, 0x70, 0x6f, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x39, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x33, 0x12, 0x31, 0x2f, 0x65, 0x6c, 0x79,
-	0x73, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x65, 0x6c, 0x79, 0x73, 0x2f, 0x74,
-	0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2f, 0x70, 0x65, 0x6e, 0x64, 0x69,
-	0x6e, 0x67, 0x5f, 0x73, 0x70, 0x6f, 0x74, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0xcd, 0x01,
-	0x0a, 0x15, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75,
-	0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x36, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74,
-	0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x47, 0x65, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74,
-	0x75, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x37, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65,
-	0x6c, 0x64, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69,
-	0x6e, 0x67, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x43, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3d,
-	0x12, 0x3b, 0x2f, 0x65, 0x6c, 0x79, 0x73, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f,
-	0x65, 0x6c, 0x79, 0x73, 0x2f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64,
-	0x2f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75,
-	0x61, 0x6c, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0xcb, 0x01,
-	0x0a, 0x18, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75,
-	0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x41, 0x6c, 0x6c, 0x12, 0x36, 0x2e, 0x65, 0x6c, 0x79,
+	0x67, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x38, 0x12,
+	0x36, 0x2f, 0x65, 0x6c, 0x79, 0x73, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x65,
+	0x6c, 0x79, 0x73, 0x2f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2f,
+	0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61,
+	0x6c, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0xf6, 0x01, 0x0a, 0x1f, 0x50, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x46, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x3d, 0x2e, 0x65, 0x6c,
+	0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x72, 0x70, 0x65,
+	0x74, 0x75, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x46, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3e, 0x2e, 0x65, 0x6c, 0x79,
 	0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x72,
-	0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x37, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73,
-	0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x50, 0x65,
-	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x4f, 0x72,
-	0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3e, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x38, 0x12, 0x36, 0x2f, 0x65, 0x6c, 0x79, 0x73, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f,
-	0x72, 0x6b, 0x2f, 0x65, 0x6c, 0x79, 0x73, 0x2f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69,
-	0x65, 0x6c, 0x64, 0x2f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x65, 0x72, 0x70,
-	0x65, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0xf6, 0x01, 0x0a, 0x1f,
-	0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c,
+	0x65, 0x72, 0x79, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74,
+	0x75, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x46, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x54, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x4e, 0x12, 0x4c, 0x2f, 0x65, 0x6c, 0x79, 0x73, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x2f, 0x65, 0x6c, 0x79, 0x73, 0x2f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65,
+	0x6c, 0x64, 0x2f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x65, 0x72, 0x70, 0x65,
+	0x74, 0x75, 0x61, 0x6c, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d,
+	0x12, 0xe2, 0x01, 0x0a, 0x1a, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x70, 0x6f, 0x74,
 	0x4f, 0x72, 0x64, 0x65, 0x72, 0x46, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
-	0x3d, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65,
-	0x6c, 0x64, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50,
-	0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x46, 0x6f, 0x72,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3e,
-	0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c,
-	0x64, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x65,
-	0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x46, 0x6f, 0x72, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x54,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x4e, 0x12, 0x4c, 0x2f, 0x65, 0x6c, 0x79, 0x73, 0x2d, 0x6e, 0x65,
+	0x38, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65,
+	0x6c, 0x64, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53,
+	0x70, 0x6f, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x46, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x39, 0x2e, 0x65, 0x6c, 0x79, 0x73,
+	0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x70, 0x6f, 0x74, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x46, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x49, 0x12, 0x47, 0x2f, 0x65,
+	0x6c, 0x79, 0x73, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x65, 0x6c, 0x79, 0x73,
+	0x2f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2f, 0x70, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x70, 0x6f, 0x74, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f,
+	0x66, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x2f, 0x7b, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0xa0, 0x01, 0x0a, 0x0a, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x12, 0x2b, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64,
+	0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2c, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68,
+	0x69, 0x65, 0x6c, 0x64, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x37, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x31, 0x12, 0x2f, 0x2f, 0x65, 0x6c, 0x79, 0x73, 0x2d, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x65, 0x6c, 0x79, 0x73, 0x2f, 0x74, 0x72, 0x61, 0x64,
+	0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0xb0, 0x01, 0x0a, 0x0e, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x2f, 0x2e, 0x65, 0x6c,
+	0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x65,
+	0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3b,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x35, 0x12, 0x33, 0x2f, 0x65, 0x6c, 0x79, 0x73, 0x2d, 0x6e, 0x65,
 	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x65, 0x6c, 0x79, 0x73, 0x2f, 0x74, 0x72, 0x61, 0x64, 0x65,
-	0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x70,
-	0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x66,
-	0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x7d, 0x12, 0xe2, 0x01, 0x0a, 0x1a, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
-	0x53, 0x70, 0x6f, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x46, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x12, 0x38, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65,
-	0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x65, 0x6e, 0x64,
-	0x69, 0x6e, 0x67, 0x53, 0x70, 0x6f, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x46, 0x6f, 0x72, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x39, 0x2e,
+	0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64,
+	0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0xbd, 0x01, 0x0a, 0x0d,
+	0x53, 0x70, 0x6f, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6b, 0x12, 0x2b, 0x2e,
 	0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64,
-	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x70, 0x6f,
-	0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x46, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x49,
-	0x12, 0x47, 0x2f, 0x65, 0x6c, 0x79, 0x73, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x70, 0x6f, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42,
+	0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x65, 0x6c, 0x79,
+	0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x53, 0x70, 0x6f, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6b,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x51, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x4b,
+	0x12, 0x49, 0x2f, 0x65, 0x6c, 0x79, 0x73, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f,
 	0x65, 0x6c, 0x79, 0x73, 0x2f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64,
-	0x2f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x70, 0x6f, 0x74, 0x5f, 0x6f, 0x72,
-	0x64, 0x65, 0x72, 0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x2f,
-	0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x42, 0xb6, 0x01, 0x0a, 0x14, 0x63, 0x6f,
-	0x6d, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65,
-	0x6c, 0x64, 0x42, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
-	0x5a, 0x31, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x65, 0x6c, 0x79,
-	0x73, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x65, 0x6c, 0x79, 0x73, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x65, 0x6c, 0x79, 0x73, 0x2f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69,
-	0x65, 0x6c, 0x64, 0xa2, 0x02, 0x03, 0x45, 0x54, 0x58, 0xaa, 0x02, 0x10, 0x45, 0x6c, 0x79, 0x73,
-	0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0xca, 0x02, 0x10, 0x45,
-	0x6c, 0x79, 0x73, 0x5c, 0x54, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0xe2,
-	0x02, 0x1c, 0x45, 0x6c, 0x79, 0x73, 0x5c, 0x54, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65,
-	0x6c, 0x64, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
-	0x11, 0x45, 0x6c, 0x79, 0x73, 0x3a, 0x3a, 0x54, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65,
-	0x6c, 0x64, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x2f, 0x73, 0x70, 0x6f, 0x74, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x6f, 0x6f, 0x6b,
+	0x2f, 0x7b, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x7d, 0x2f, 0x7b, 0x71,
+	0x75, 0x6f, 0x74, 0x65, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x7d, 0x12, 0xc6, 0x01, 0x0a, 0x12,
+	0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x6f,
+	0x6f, 0x6b, 0x12, 0x30, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73,
+	0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x65, 0x72, 0x70, 0x65,
+	0x74, 0x75, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64,
+	0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x65, 0x72,
+	0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6b, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x45, 0x12,
+	0x43, 0x2f, 0x65, 0x6c, 0x79, 0x73, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x65,
+	0x6c, 0x79, 0x73, 0x2f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2f,
+	0x70, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f,
+	0x62, 0x6f, 0x6f, 0x6b, 0x2f, 0x7b, 0x74, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x73,
+	0x73, 0x65, 0x74, 0x7d, 0x12, 0xb2, 0x01, 0x0a, 0x11, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74,
+	0x65, 0x53, 0x70, 0x6f, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x2f, 0x2e, 0x65, 0x6c, 0x79,
+	0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x53, 0x70, 0x6f, 0x74, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x65, 0x6c,
+	0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x53, 0x70, 0x6f, 0x74,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3a, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x34, 0x12, 0x32, 0x2f, 0x65, 0x6c, 0x79, 0x73, 0x2d, 0x6e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x65, 0x6c, 0x79, 0x73, 0x2f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73,
+	0x68, 0x69, 0x65, 0x6c, 0x64, 0x2f, 0x73, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x73,
+	0x70, 0x6f, 0x74, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0xc6, 0x01, 0x0a, 0x16, 0x53, 0x69,
+	0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x12, 0x34, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64,
+	0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x69, 0x6d,
+	0x75, 0x6c, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x65, 0x6c, 0x79,
+	0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x70, 0x65,
+	0x74, 0x75, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x3f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x39, 0x12, 0x37, 0x2f, 0x65, 0x6c, 0x79, 0x73,
+	0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x65, 0x6c, 0x79, 0x73, 0x2f, 0x74, 0x72,
+	0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2f, 0x73, 0x69, 0x6d, 0x75, 0x6c, 0x61,
+	0x74, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x42, 0xb6, 0x01, 0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e,
+	0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x42, 0x0a, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x31, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x65, 0x6c, 0x79, 0x73, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x2f, 0x65, 0x6c, 0x79, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x6c, 0x79, 0x73,
+	0x2f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0xa2, 0x02, 0x03, 0x45,
+	0x54, 0x58, 0xaa, 0x02, 0x10, 0x45, 0x6c, 0x79, 0x73, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x73,
+	0x68, 0x69, 0x65, 0x6c, 0x64, 0xca, 0x02, 0x10, 0x45, 0x6c, 0x79, 0x73, 0x5c, 0x54, 0x72, 0x61,
+	0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0xe2, 0x02, 0x1c, 0x45, 0x6c, 0x79, 0x73, 0x5c,
+	0x54, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x5c, 0x47, 0x50, 0x42, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x11, 0x45, 0x6c, 0x79, 0x73, 0x3a, 0x3a,
+	0x54, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -7185,62 +17575,123 @@ func file_elys_tradeshield_query_proto_rawDescGZIP() []byte {
 	return file_elys_tradeshield_query_proto_rawDescData
 }
 
-var file_elys_tradeshield_query_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_elys_tradeshield_query_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_elys_tradeshield_query_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
 var file_elys_tradeshield_query_proto_goTypes = []interface{}{
-	(*QueryParamsRequest)(nil),                           // 0: elys.tradeshield.QueryParamsRequest
-	(*QueryParamsResponse)(nil),                          // 1: elys.tradeshield.QueryParamsResponse
-	(*QueryGetPendingSpotOrderRequest)(nil),              // 2: elys.tradeshield.QueryGetPendingSpotOrderRequest
-	(*QueryGetPendingSpotOrderResponse)(nil),             // 3: elys.tradeshield.QueryGetPendingSpotOrderResponse
-	(*QueryAllPendingSpotOrderRequest)(nil),              // 4: elys.tradeshield.QueryAllPendingSpotOrderRequest
-	(*QueryAllPendingSpotOrderResponse)(nil),             // 5: elys.tradeshield.QueryAllPendingSpotOrderResponse
-	(*QueryGetPendingPerpetualOrderRequest)(nil),         // 6: elys.tradeshield.QueryGetPendingPerpetualOrderRequest
-	(*QueryGetPendingPerpetualOrderResponse)(nil),        // 7: elys.tradeshield.QueryGetPendingPerpetualOrderResponse
-	(*QueryAllPendingPerpetualOrderRequest)(nil),         // 8: elys.tradeshield.QueryAllPendingPerpetualOrderRequest
-	(*QueryAllPendingPerpetualOrderResponse)(nil),        // 9: elys.tradeshield.QueryAllPendingPerpetualOrderResponse
-	(*QueryPendingPerpetualOrderForAddressRequest)(nil),  // 10: elys.tradeshield.QueryPendingPerpetualOrderForAddressRequest
-	(*QueryPendingPerpetualOrderForAddressResponse)(nil), // 11: elys.tradeshield.QueryPendingPerpetualOrderForAddressResponse
-	(*QueryPendingSpotOrderForAddressRequest)(nil),       // 12: elys.tradeshield.QueryPendingSpotOrderForAddressRequest
-	(*QueryPendingSpotOrderForAddressResponse)(nil),      // 13: elys.tradeshield.QueryPendingSpotOrderForAddressResponse
-	(*Params)(nil),                  // 14: elys.tradeshield.Params
-	(*SpotOrder)(nil),               // 15: elys.tradeshield.SpotOrder
-	(*v1beta1.PageRequest)(nil),     // 16: cosmos.base.query.v1beta1.PageRequest
-	(*v1beta1.PageResponse)(nil),    // 17: cosmos.base.query.v1beta1.PageResponse
-	(*PerpetualOrderExtraInfo)(nil), // 18: elys.tradeshield.PerpetualOrderExtraInfo
-	(Status)(0),                     // 19: elys.tradeshield.Status
+	(OrderBookSide)(0),                                   // 0: elys.tradeshield.OrderBookSide
+	(*QueryParamsRequest)(nil),                           // 1: elys.tradeshield.QueryParamsRequest
+	(*QueryParamsResponse)(nil),                          // 2: elys.tradeshield.QueryParamsResponse
+	(*QueryGetPendingSpotOrderRequest)(nil),              // 3: elys.tradeshield.QueryGetPendingSpotOrderRequest
+	(*QueryGetPendingSpotOrderResponse)(nil),             // 4: elys.tradeshield.QueryGetPendingSpotOrderResponse
+	(*QueryAllPendingSpotOrderRequest)(nil),              // 5: elys.tradeshield.QueryAllPendingSpotOrderRequest
+	(*QueryAllPendingSpotOrderResponse)(nil),             // 6: elys.tradeshield.QueryAllPendingSpotOrderResponse
+	(*QueryGetPendingPerpetualOrderRequest)(nil),         // 7: elys.tradeshield.QueryGetPendingPerpetualOrderRequest
+	(*QueryGetPendingPerpetualOrderResponse)(nil),        // 8: elys.tradeshield.QueryGetPendingPerpetualOrderResponse
+	(*QueryAllPendingPerpetualOrderRequest)(nil),         // 9: elys.tradeshield.QueryAllPendingPerpetualOrderRequest
+	(*QueryAllPendingPerpetualOrderResponse)(nil),        // 10: elys.tradeshield.QueryAllPendingPerpetualOrderResponse
+	(*QueryPendingPerpetualOrderForAddressRequest)(nil),  // 11: elys.tradeshield.QueryPendingPerpetualOrderForAddressRequest
+	(*QueryPendingPerpetualOrderForAddressResponse)(nil), // 12: elys.tradeshield.QueryPendingPerpetualOrderForAddressResponse
+	(*QueryPendingSpotOrderForAddressRequest)(nil),       // 13: elys.tradeshield.QueryPendingSpotOrderForAddressRequest
+	(*QueryPendingSpotOrderForAddressResponse)(nil),      // 14: elys.tradeshield.QueryPendingSpotOrderForAddressResponse
+	(*QueryGetOrderGroupRequest)(nil),                    // 15: elys.tradeshield.QueryGetOrderGroupRequest
+	(*QueryGetOrderGroupResponse)(nil),                   // 16: elys.tradeshield.QueryGetOrderGroupResponse
+	(*QueryGetScheduledOrderRequest)(nil),                // 17: elys.tradeshield.QueryGetScheduledOrderRequest
+	(*QueryGetScheduledOrderResponse)(nil),               // 18: elys.tradeshield.QueryGetScheduledOrderResponse
+	(*OrderBookLevel)(nil),                               // 19: elys.tradeshield.OrderBookLevel
+	(*QuerySpotOrderBookRequest)(nil),                    // 20: elys.tradeshield.QuerySpotOrderBookRequest
+	(*QuerySpotOrderBookResponse)(nil),                   // 21: elys.tradeshield.QuerySpotOrderBookResponse
+	(*QueryPerpetualOrderBookRequest)(nil),               // 22: elys.tradeshield.QueryPerpetualOrderBookRequest
+	(*QueryPerpetualOrderBookResponse)(nil),              // 23: elys.tradeshield.QueryPerpetualOrderBookResponse
+	(*QuerySimulateSpotOrderRequest)(nil),                // 24: elys.tradeshield.QuerySimulateSpotOrderRequest
+	(*QuerySimulateSpotOrderResponse)(nil),               // 25: elys.tradeshield.QuerySimulateSpotOrderResponse
+	(*QuerySimulatePerpetualOrderRequest)(nil),           // 26: elys.tradeshield.QuerySimulatePerpetualOrderRequest
+	(*QuerySimulatePerpetualOrderResponse)(nil),          // 27: elys.tradeshield.QuerySimulatePerpetualOrderResponse
+	(*Params)(nil),                  // 28: elys.tradeshield.Params
+	(*SpotOrder)(nil),               // 29: elys.tradeshield.SpotOrder
+	(*v1beta1.PageRequest)(nil),     // 30: cosmos.base.query.v1beta1.PageRequest
+	(*v1beta1.PageResponse)(nil),    // 31: cosmos.base.query.v1beta1.PageResponse
+	(*PerpetualOrderExtraInfo)(nil), // 32: elys.tradeshield.PerpetualOrderExtraInfo
+	(Status)(0),                     // 33: elys.tradeshield.Status
+	(*OrderGroup)(nil),              // 34: elys.tradeshield.OrderGroup
+	(*ScheduledOrder)(nil),          // 35: elys.tradeshield.ScheduledOrder
+	(*ScheduledOrderSlice)(nil),     // 36: elys.tradeshield.ScheduledOrderSlice
+	(*v1beta11.Coin)(nil),           // 37: cosmos.base.v1beta1.Coin
+	(PerpetualPosition)(0),          // 38: elys.tradeshield.PerpetualPosition
+	(PerpetualOrderType)(0),         // 39: elys.tradeshield.PerpetualOrderType
+	(SpotOrderType)(0),              // 40: elys.tradeshield.SpotOrderType
+	(*OrderPrice)(nil),              // 41: elys.tradeshield.OrderPrice
 }
 var file_elys_tradeshield_query_proto_depIdxs = []int32{
-	14, // 0: elys.tradeshield.QueryParamsResponse.params:type_name -> elys.tradeshield.Params
-	15, // 1: elys.tradeshield.QueryGetPendingSpotOrderResponse.pending_spot_order:type_name -> elys.tradeshield.SpotOrder
-	16, // 2: elys.tradeshield.QueryAllPendingSpotOrderRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	15, // 3: elys.tradeshield.QueryAllPendingSpotOrderResponse.pending_spot_order:type_name -> elys.tradeshield.SpotOrder
-	17, // 4: elys.tradeshield.QueryAllPendingSpotOrderResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	18, // 5: elys.tradeshield.QueryGetPendingPerpetualOrderResponse.pending_perpetual_order:type_name -> elys.tradeshield.PerpetualOrderExtraInfo
-	16, // 6: elys.tradeshield.QueryAllPendingPerpetualOrderRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	18, // 7: elys.tradeshield.QueryAllPendingPerpetualOrderResponse.pending_perpetual_order:type_name -> elys.tradeshield.PerpetualOrderExtraInfo
-	17, // 8: elys.tradeshield.QueryAllPendingPerpetualOrderResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	19, // 9: elys.tradeshield.QueryPendingPerpetualOrderForAddressRequest.status:type_name -> elys.tradeshield.Status
-	18, // 10: elys.tradeshield.QueryPendingPerpetualOrderForAddressResponse.pending_perpetual_orders:type_name -> elys.tradeshield.PerpetualOrderExtraInfo
-	19, // 11: elys.tradeshield.QueryPendingSpotOrderForAddressRequest.status:type_name -> elys.tradeshield.Status
-	15, // 12: elys.tradeshield.QueryPendingSpotOrderForAddressResponse.pending_spot_orders:type_name -> elys.tradeshield.SpotOrder
-	0,  // 13: elys.tradeshield.Query.Params:input_type -> elys.tradeshield.QueryParamsRequest
-	2,  // 14: elys.tradeshield.Query.PendingSpotOrder:input_type -> elys.tradeshield.QueryGetPendingSpotOrderRequest
-	4,  // 15: elys.tradeshield.Query.PendingSpotOrderAll:input_type -> elys.tradeshield.QueryAllPendingSpotOrderRequest
-	6,  // 16: elys.tradeshield.Query.PendingPerpetualOrder:input_type -> elys.tradeshield.QueryGetPendingPerpetualOrderRequest
-	8,  // 17: elys.tradeshield.Query.PendingPerpetualOrderAll:input_type -> elys.tradeshield.QueryAllPendingPerpetualOrderRequest
-	10, // 18: elys.tradeshield.Query.PendingPerpetualOrderForAddress:input_type -> elys.tradeshield.QueryPendingPerpetualOrderForAddressRequest
-	12, // 19: elys.tradeshield.Query.PendingSpotOrderForAddress:input_type -> elys.tradeshield.QueryPendingSpotOrderForAddressRequest
-	1,  // 20: elys.tradeshield.Query.Params:output_type -> elys.tradeshield.QueryParamsResponse
-	3,  // 21: elys.tradeshield.Query.PendingSpotOrder:output_type -> elys.tradeshield.QueryGetPendingSpotOrderResponse
-	5,  // 22: elys.tradeshield.Query.PendingSpotOrderAll:output_type -> elys.tradeshield.QueryAllPendingSpotOrderResponse
-	7,  // 23: elys.tradeshield.Query.PendingPerpetualOrder:output_type -> elys.tradeshield.QueryGetPendingPerpetualOrderResponse
-	9,  // 24: elys.tradeshield.Query.PendingPerpetualOrderAll:output_type -> elys.tradeshield.QueryAllPendingPerpetualOrderResponse
-	11, // 25: elys.tradeshield.Query.PendingPerpetualOrderForAddress:output_type -> elys.tradeshield.QueryPendingPerpetualOrderForAddressResponse
-	13, // 26: elys.tradeshield.Query.PendingSpotOrderForAddress:output_type -> elys.tradeshield.QueryPendingSpotOrderForAddressResponse
-	20, // [20:27] is the sub-list for method output_type
-	13, // [13:20] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	28, // 0: elys.tradeshield.QueryParamsResponse.params:type_name -> elys.tradeshield.Params
+	29, // 1: elys.tradeshield.QueryGetPendingSpotOrderResponse.pending_spot_order:type_name -> elys.tradeshield.SpotOrder
+	30, // 2: elys.tradeshield.QueryAllPendingSpotOrderRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	29, // 3: elys.tradeshield.QueryAllPendingSpotOrderResponse.pending_spot_order:type_name -> elys.tradeshield.SpotOrder
+	31, // 4: elys.tradeshield.QueryAllPendingSpotOrderResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	32, // 5: elys.tradeshield.QueryGetPendingPerpetualOrderResponse.pending_perpetual_order:type_name -> elys.tradeshield.PerpetualOrderExtraInfo
+	30, // 6: elys.tradeshield.QueryAllPendingPerpetualOrderRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	32, // 7: elys.tradeshield.QueryAllPendingPerpetualOrderResponse.pending_perpetual_order:type_name -> elys.tradeshield.PerpetualOrderExtraInfo
+	31, // 8: elys.tradeshield.QueryAllPendingPerpetualOrderResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	33, // 9: elys.tradeshield.QueryPendingPerpetualOrderForAddressRequest.status:type_name -> elys.tradeshield.Status
+	32, // 10: elys.tradeshield.QueryPendingPerpetualOrderForAddressResponse.pending_perpetual_orders:type_name -> elys.tradeshield.PerpetualOrderExtraInfo
+	33, // 11: elys.tradeshield.QueryPendingSpotOrderForAddressRequest.status:type_name -> elys.tradeshield.Status
+	29, // 12: elys.tradeshield.QueryPendingSpotOrderForAddressResponse.pending_spot_orders:type_name -> elys.tradeshield.SpotOrder
+	34, // 13: elys.tradeshield.QueryGetOrderGroupResponse.order_group:type_name -> elys.tradeshield.OrderGroup
+	29, // 14: elys.tradeshield.QueryGetOrderGroupResponse.spot_orders:type_name -> elys.tradeshield.SpotOrder
+	32, // 15: elys.tradeshield.QueryGetOrderGroupResponse.perpetual_orders:type_name -> elys.tradeshield.PerpetualOrderExtraInfo
+	35, // 16: elys.tradeshield.QueryGetScheduledOrderResponse.scheduled_order:type_name -> elys.tradeshield.ScheduledOrder
+	36, // 17: elys.tradeshield.QueryGetScheduledOrderResponse.slices:type_name -> elys.tradeshield.ScheduledOrderSlice
+	37, // 18: elys.tradeshield.OrderBookLevel.amount:type_name -> cosmos.base.v1beta1.Coin
+	0,  // 19: elys.tradeshield.QuerySpotOrderBookRequest.side:type_name -> elys.tradeshield.OrderBookSide
+	30, // 20: elys.tradeshield.QuerySpotOrderBookRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	19, // 21: elys.tradeshield.QuerySpotOrderBookResponse.levels:type_name -> elys.tradeshield.OrderBookLevel
+	31, // 22: elys.tradeshield.QuerySpotOrderBookResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	38, // 23: elys.tradeshield.QueryPerpetualOrderBookRequest.position:type_name -> elys.tradeshield.PerpetualPosition
+	39, // 24: elys.tradeshield.QueryPerpetualOrderBookRequest.order_type:type_name -> elys.tradeshield.PerpetualOrderType
+	30, // 25: elys.tradeshield.QueryPerpetualOrderBookRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	19, // 26: elys.tradeshield.QueryPerpetualOrderBookResponse.levels:type_name -> elys.tradeshield.OrderBookLevel
+	31, // 27: elys.tradeshield.QueryPerpetualOrderBookResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	40, // 28: elys.tradeshield.QuerySimulateSpotOrderRequest.order_type:type_name -> elys.tradeshield.SpotOrderType
+	41, // 29: elys.tradeshield.QuerySimulateSpotOrderRequest.order_price:type_name -> elys.tradeshield.OrderPrice
+	37, // 30: elys.tradeshield.QuerySimulateSpotOrderRequest.order_amount:type_name -> cosmos.base.v1beta1.Coin
+	37, // 31: elys.tradeshield.QuerySimulateSpotOrderResponse.amount_out:type_name -> cosmos.base.v1beta1.Coin
+	37, // 32: elys.tradeshield.QuerySimulateSpotOrderResponse.fill_amount:type_name -> cosmos.base.v1beta1.Coin
+	38, // 33: elys.tradeshield.QuerySimulatePerpetualOrderRequest.position:type_name -> elys.tradeshield.PerpetualPosition
+	37, // 34: elys.tradeshield.QuerySimulatePerpetualOrderRequest.collateral:type_name -> cosmos.base.v1beta1.Coin
+	37, // 35: elys.tradeshield.QuerySimulatePerpetualOrderResponse.position_size:type_name -> cosmos.base.v1beta1.Coin
+	37, // 36: elys.tradeshield.QuerySimulatePerpetualOrderResponse.custody:type_name -> cosmos.base.v1beta1.Coin
+	37, // 37: elys.tradeshield.QuerySimulatePerpetualOrderResponse.liabilities:type_name -> cosmos.base.v1beta1.Coin
+	37, // 38: elys.tradeshield.QuerySimulatePerpetualOrderResponse.available_liquidity:type_name -> cosmos.base.v1beta1.Coin
+	1,  // 39: elys.tradeshield.Query.Params:input_type -> elys.tradeshield.QueryParamsRequest
+	3,  // 40: elys.tradeshield.Query.PendingSpotOrder:input_type -> elys.tradeshield.QueryGetPendingSpotOrderRequest
+	5,  // 41: elys.tradeshield.Query.PendingSpotOrderAll:input_type -> elys.tradeshield.QueryAllPendingSpotOrderRequest
+	7,  // 42: elys.tradeshield.Query.PendingPerpetualOrder:input_type -> elys.tradeshield.QueryGetPendingPerpetualOrderRequest
+	9,  // 43: elys.tradeshield.Query.PendingPerpetualOrderAll:input_type -> elys.tradeshield.QueryAllPendingPerpetualOrderRequest
+	11, // 44: elys.tradeshield.Query.PendingPerpetualOrderForAddress:input_type -> elys.tradeshield.QueryPendingPerpetualOrderForAddressRequest
+	13, // 45: elys.tradeshield.Query.PendingSpotOrderForAddress:input_type -> elys.tradeshield.QueryPendingSpotOrderForAddressRequest
+	15, // 46: elys.tradeshield.Query.OrderGroup:input_type -> elys.tradeshield.QueryGetOrderGroupRequest
+	17, // 47: elys.tradeshield.Query.ScheduledOrder:input_type -> elys.tradeshield.QueryGetScheduledOrderRequest
+	20, // 48: elys.tradeshield.Query.SpotOrderBook:input_type -> elys.tradeshield.QuerySpotOrderBookRequest
+	22, // 49: elys.tradeshield.Query.PerpetualOrderBook:input_type -> elys.tradeshield.QueryPerpetualOrderBookRequest
+	24, // 50: elys.tradeshield.Query.SimulateSpotOrder:input_type -> elys.tradeshield.QuerySimulateSpotOrderRequest
+	26, // 51: elys.tradeshield.Query.SimulatePerpetualOrder:input_type -> elys.tradeshield.QuerySimulatePerpetualOrderRequest
+	2,  // 52: elys.tradeshield.Query.Params:output_type -> elys.tradeshield.QueryParamsResponse
+	4,  // 53: elys.tradeshield.Query.PendingSpotOrder:output_type -> elys.tradeshield.QueryGetPendingSpotOrderResponse
+	6,  // 54: elys.tradeshield.Query.PendingSpotOrderAll:output_type -> elys.tradeshield.QueryAllPendingSpotOrderResponse
+	8,  // 55: elys.tradeshield.Query.PendingPerpetualOrder:output_type -> elys.tradeshield.QueryGetPendingPerpetualOrderResponse
+	10, // 56: elys.tradeshield.Query.PendingPerpetualOrderAll:output_type -> elys.tradeshield.QueryAllPendingPerpetualOrderResponse
+	12, // 57: elys.tradeshield.Query.PendingPerpetualOrderForAddress:output_type -> elys.tradeshield.QueryPendingPerpetualOrderForAddressResponse
+	14, // 58: elys.tradeshield.Query.PendingSpotOrderForAddress:output_type -> elys.tradeshield.QueryPendingSpotOrderForAddressResponse
+	16, // 59: elys.tradeshield.Query.OrderGroup:output_type -> elys.tradeshield.QueryGetOrderGroupResponse
+	18, // 60: elys.tradeshield.Query.ScheduledOrder:output_type -> elys.tradeshield.QueryGetScheduledOrderResponse
+	21, // 61: elys.tradeshield.Query.SpotOrderBook:output_type -> elys.tradeshield.QuerySpotOrderBookResponse
+	23, // 62: elys.tradeshield.Query.PerpetualOrderBook:output_type -> elys.tradeshield.QueryPerpetualOrderBookResponse
+	25, // 63: elys.tradeshield.Query.SimulateSpotOrder:output_type -> elys.tradeshield.QuerySimulateSpotOrderResponse
+	27, // 64: elys.tradeshield.Query.SimulatePerpetualOrder:output_type -> elys.tradeshield.QuerySimulatePerpetualOrderResponse
+	52, // [52:65] is the sub-list for method output_type
+	39, // [39:52] is the sub-list for method input_type
+	39, // [39:39] is the sub-list for extension type_name
+	39, // [39:39] is the sub-list for extension extendee
+	0,  // [0:39] is the sub-list for field type_name
 }
 
 func init() { file_elys_tradeshield_query_proto_init() }
@@ -7248,6 +17699,7 @@ func file_elys_tradeshield_query_proto_init() {
 	if File_elys_tradeshield_query_proto != nil {
 		return
 	}
+	file_elys_tradeshield_order_proto_init()
 	file_elys_tradeshield_params_proto_init()
 	file_elys_tradeshield_types_proto_init()
 	if !protoimpl.UnsafeEnabled {
@@ -7419,19 +17871,176 @@ func file_elys_tradeshield_query_proto_init() {
 				return nil
 			}
 		}
+		file_elys_tradeshield_query_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryGetOrderGroupRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_elys_tradeshield_query_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryGetOrderGroupResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_elys_tradeshield_query_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryGetScheduledOrderRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_elys_tradeshield_query_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryGetScheduledOrderResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_elys_tradeshield_query_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OrderBookLevel); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_elys_tradeshield_query_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuerySpotOrderBookRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_elys_tradeshield_query_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuerySpotOrderBookResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_elys_tradeshield_query_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryPerpetualOrderBookRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_elys_tradeshield_query_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryPerpetualOrderBookResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_elys_tradeshield_query_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuerySimulateSpotOrderRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_elys_tradeshield_query_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuerySimulateSpotOrderResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_elys_tradeshield_query_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuerySimulatePerpetualOrderRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_elys_tradeshield_query_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuerySimulatePerpetualOrderResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_elys_tradeshield_query_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   14,
+			NumEnums:      1,
+			NumMessages:   27,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_elys_tradeshield_query_proto_goTypes,
 		DependencyIndexes: file_elys_tradeshield_query_proto_depIdxs,
+		EnumInfos:         file_elys_tradeshield_query_proto_enumTypes,
 		MessageInfos:      file_elys_tradeshield_query_proto_msgTypes,
 	}.Build()
 	File_elys_tradeshield_query_proto = out.File
@@ -30,6 +30,21 @@ type QueryClient interface {
 	PendingPerpetualOrderForAddress(ctx context.Context, in *QueryPendingPerpetualOrderForAddressRequest, opts ...grpc.CallOption) (*QueryPendingPerpetualOrderForAddressResponse, error)
 	// Queries a list of PendingSpotOrderForAddress items.
 	PendingSpotOrderForAddress(ctx context.Context, in *QueryPendingSpotOrderForAddressRequest, opts ...grpc.CallOption) (*QueryPendingSpotOrderForAddressResponse, error)
+	// Queries an order group and its pending orders.
+	OrderGroup(ctx context.Context, in *QueryGetOrderGroupRequest, opts ...grpc.CallOption) (*QueryGetOrderGroupResponse, error)
+	// Queries a scheduled order and the records of its slices.
+	ScheduledOrder(ctx context.Context, in *QueryGetScheduledOrderRequest, opts ...grpc.CallOption) (*QueryGetScheduledOrderResponse, error)
+	// Queries the pending spot limit orders of a denom pair aggregated by
+	// price level.
+	SpotOrderBook(ctx context.Context, in *QuerySpotOrderBookRequest, opts ...grpc.CallOption) (*QuerySpotOrderBookResponse, error)
+	// Queries the pending perpetual orders of a trading asset aggregated by
+	// trigger price level.
+	PerpetualOrderBook(ctx context.Context, in *QueryPerpetualOrderBookRequest, opts ...grpc.CallOption) (*QueryPerpetualOrderBookResponse, error)
+	// Estimates the execution of an unsaved spot order at the current state.
+	SimulateSpotOrder(ctx context.Context, in *QuerySimulateSpotOrderRequest, opts ...grpc.CallOption) (*QuerySimulateSpotOrderResponse, error)
+	// Estimates the execution of an unsaved perpetual open order at the current
+	// state.
+	SimulatePerpetualOrder(ctx context.Context, in *QuerySimulatePerpetualOrderRequest, opts ...grpc.CallOption) (*QuerySimulatePerpetualOrderResponse, error)
 }
 
 type queryClient struct {
@@ -103,6 +118,60 @@ func (c *queryClient) PendingSpotOrderForAddress(ctx context.Context, in *QueryP
 	return out, nil
 }
 
+func (c *queryClient) OrderGroup(ctx context.Context, in *QueryGetOrderGroupRequest, opts ...grpc.CallOption) (*QueryGetOrderGroupResponse, error) {
+	out := new(QueryGetOrderGroupResponse)
+	err := c.cc.Invoke(ctx, "/elys.tradeshield.Query/OrderGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ScheduledOrder(ctx context.Context, in *QueryGetScheduledOrderRequest, opts ...grpc.CallOption) (*QueryGetScheduledOrderResponse, error) {
+	out := new(QueryGetScheduledOrderResponse)
+	err := c.cc.Invoke(ctx, "/elys.tradeshield.Query/ScheduledOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SpotOrderBook(ctx context.Context, in *QuerySpotOrderBookRequest, opts ...grpc.CallOption) (*QuerySpotOrderBookResponse, error) {
+	out := new(QuerySpotOrderBookResponse)
+	err := c.cc.Invoke(ctx, "/elys.tradeshield.Query/SpotOrderBook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PerpetualOrderBook(ctx context.Context, in *QueryPerpetualOrderBookRequest, opts ...grpc.CallOption) (*QueryPerpetualOrderBookResponse, error) {
+	out := new(QueryPerpetualOrderBookResponse)
+	err := c.cc.Invoke(ctx, "/elys.tradeshield.Query/PerpetualOrderBook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SimulateSpotOrder(ctx context.Context, in *QuerySimulateSpotOrderRequest, opts ...grpc.CallOption) (*QuerySimulateSpotOrderResponse, error) {
+	out := new(QuerySimulateSpotOrderResponse)
+	err := c.cc.Invoke(ctx, "/elys.tradeshield.Query/SimulateSpotOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SimulatePerpetualOrder(ctx context.Context, in *QuerySimulatePerpetualOrderRequest, opts ...grpc.CallOption) (*QuerySimulatePerpetualOrderResponse, error) {
+	out := new(QuerySimulatePerpetualOrderResponse)
+	err := c.cc.Invoke(ctx, "/elys.tradeshield.Query/SimulatePerpetualOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -119,6 +188,21 @@ type QueryServer interface {
 	PendingPerpetualOrderForAddress(context.Context, *QueryPendingPerpetualOrderForAddressRequest) (*QueryPendingPerpetualOrderForAddressResponse, error)
 	// Queries a list of PendingSpotOrderForAddress items.
 	PendingSpotOrderForAddress(context.Context, *QueryPendingSpotOrderForAddressRequest) (*QueryPendingSpotOrderForAddressResponse, error)
+	// Queries an order group and its pending orders.
+	OrderGroup(context.Context, *QueryGetOrderGroupRequest) (*QueryGetOrderGroupResponse, error)
+	// Queries a scheduled order and the records of its slices.
+	ScheduledOrder(context.Context, *QueryGetScheduledOrderRequest) (*QueryGetScheduledOrderResponse, error)
+	// Queries the pending spot limit orders of a denom pair aggregated by
+	// price level.
+	SpotOrderBook(context.Context, *QuerySpotOrderBookRequest) (*QuerySpotOrderBookResponse, error)
+	// Queries the pending perpetual orders of a trading asset aggregated by
+	// trigger price level.
+	PerpetualOrderBook(context.Context, *QueryPerpetualOrderBookRequest) (*QueryPerpetualOrderBookResponse, error)
+	// Estimates the execution of an unsaved spot order at the current state.
+	SimulateSpotOrder(context.Context, *QuerySimulateSpotOrderRequest) (*QuerySimulateSpotOrderResponse, error)
+	// Estimates the execution of an unsaved perpetual open order at the current
+	// state.
+	SimulatePerpetualOrder(context.Context, *QuerySimulatePerpetualOrderRequest) (*QuerySimulatePerpetualOrderResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -147,6 +231,24 @@ func (UnimplementedQueryServer) PendingPerpetualOrderForAddress(context.Context,
 func (UnimplementedQueryServer) PendingSpotOrderForAddress(context.Context, *QueryPendingSpotOrderForAddressRequest) (*QueryPendingSpotOrderForAddressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PendingSpotOrderForAddress not implemented")
 }
+func (UnimplementedQueryServer) OrderGroup(context.Context, *QueryGetOrderGroupRequest) (*QueryGetOrderGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OrderGroup not implemented")
+}
+func (UnimplementedQueryServer) ScheduledOrder(context.Context, *QueryGetScheduledOrderRequest) (*QueryGetScheduledOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduledOrder not implemented")
+}
+func (UnimplementedQueryServer) SpotOrderBook(context.Context, *QuerySpotOrderBookRequest) (*QuerySpotOrderBookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SpotOrderBook not implemented")
+}
+func (UnimplementedQueryServer) PerpetualOrderBook(context.Context, *QueryPerpetualOrderBookRequest) (*QueryPerpetualOrderBookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PerpetualOrderBook not implemented")
+}
+func (UnimplementedQueryServer) SimulateSpotOrder(context.Context, *QuerySimulateSpotOrderRequest) (*QuerySimulateSpotOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateSpotOrder not implemented")
+}
+func (UnimplementedQueryServer) SimulatePerpetualOrder(context.Context, *QuerySimulatePerpetualOrderRequest) (*QuerySimulatePerpetualOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulatePerpetualOrder not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -286,6 +388,114 @@ func _Query_PendingSpotOrderForAddress_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_OrderGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetOrderGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OrderGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elys.tradeshield.Query/OrderGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OrderGroup(ctx, req.(*QueryGetOrderGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ScheduledOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetScheduledOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScheduledOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elys.tradeshield.Query/ScheduledOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScheduledOrder(ctx, req.(*QueryGetScheduledOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SpotOrderBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySpotOrderBookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SpotOrderBook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elys.tradeshield.Query/SpotOrderBook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SpotOrderBook(ctx, req.(*QuerySpotOrderBookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PerpetualOrderBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPerpetualOrderBookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PerpetualOrderBook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elys.tradeshield.Query/PerpetualOrderBook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PerpetualOrderBook(ctx, req.(*QueryPerpetualOrderBookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulateSpotOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateSpotOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateSpotOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elys.tradeshield.Query/SimulateSpotOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateSpotOrder(ctx, req.(*QuerySimulateSpotOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulatePerpetualOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulatePerpetualOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulatePerpetualOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elys.tradeshield.Query/SimulatePerpetualOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulatePerpetualOrder(ctx, req.(*QuerySimulatePerpetualOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -321,6 +531,30 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PendingSpotOrderForAddress",
 			Handler:    _Query_PendingSpotOrderForAddress_Handler,
 		},
+		{
+			MethodName: "OrderGroup",
+			Handler:    _Query_OrderGroup_Handler,
+		},
+		{
+			MethodName: "ScheduledOrder",
+			Handler:    _Query_ScheduledOrder_Handler,
+		},
+		{
+			MethodName: "SpotOrderBook",
+			Handler:    _Query_SpotOrderBook_Handler,
+		},
+		{
+			MethodName: "PerpetualOrderBook",
+			Handler:    _Query_PerpetualOrderBook_Handler,
+		},
+		{
+			MethodName: "SimulateSpotOrder",
+			Handler:    _Query_SimulateSpotOrder_Handler,
+		},
+		{
+			MethodName: "SimulatePerpetualOrder",
+			Handler:    _Query_SimulatePerpetualOrder_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "elys/tradeshield/query.proto",
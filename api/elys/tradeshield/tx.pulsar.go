@@ -24,6 +24,9 @@ var (
 	fd_MsgCreateSpotOrder_order_amount       protoreflect.FieldDescriptor
 	fd_MsgCreateSpotOrder_owner_address      protoreflect.FieldDescriptor
 	fd_MsgCreateSpotOrder_order_target_denom protoreflect.FieldDescriptor
+	fd_MsgCreateSpotOrder_time_in_force      protoreflect.FieldDescriptor
+	fd_MsgCreateSpotOrder_expiry             protoreflect.FieldDescriptor
+	fd_MsgCreateSpotOrder_trailing_stop      protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -34,6 +37,9 @@ func init() {
 	fd_MsgCreateSpotOrder_order_amount = md_MsgCreateSpotOrder.Fields().ByName("order_amount")
 	fd_MsgCreateSpotOrder_owner_address = md_MsgCreateSpotOrder.Fields().ByName("owner_address")
 	fd_MsgCreateSpotOrder_order_target_denom = md_MsgCreateSpotOrder.Fields().ByName("order_target_denom")
+	fd_MsgCreateSpotOrder_time_in_force = md_MsgCreateSpotOrder.Fields().ByName("time_in_force")
+	fd_MsgCreateSpotOrder_expiry = md_MsgCreateSpotOrder.Fields().ByName("expiry")
+	fd_MsgCreateSpotOrder_trailing_stop = md_MsgCreateSpotOrder.Fields().ByName("trailing_stop")
 }
 
 var _ protoreflect.Message = (*fastReflection_MsgCreateSpotOrder)(nil)
@@ -131,6 +137,24 @@ func (x *fastReflection_MsgCreateSpotOrder) Range(f func(protoreflect.FieldDescr
 			return
 		}
 	}
+	if x.TimeInForce != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.TimeInForce))
+		if !f(fd_MsgCreateSpotOrder_time_in_force, value) {
+			return
+		}
+	}
+	if x.Expiry != nil {
+		value := protoreflect.ValueOfMessage(x.Expiry.ProtoReflect())
+		if !f(fd_MsgCreateSpotOrder_expiry, value) {
+			return
+		}
+	}
+	if x.TrailingStop != nil {
+		value := protoreflect.ValueOfMessage(x.TrailingStop.ProtoReflect())
+		if !f(fd_MsgCreateSpotOrder_trailing_stop, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -156,6 +180,12 @@ func (x *fastReflection_MsgCreateSpotOrder) Has(fd protoreflect.FieldDescriptor)
 		return x.OwnerAddress != ""
 	case "elys.tradeshield.MsgCreateSpotOrder.order_target_denom":
 		return x.OrderTargetDenom != ""
+	case "elys.tradeshield.MsgCreateSpotOrder.time_in_force":
+		return x.TimeInForce != 0
+	case "elys.tradeshield.MsgCreateSpotOrder.expiry":
+		return x.Expiry != nil
+	case "elys.tradeshield.MsgCreateSpotOrder.trailing_stop":
+		return x.TrailingStop != nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.MsgCreateSpotOrder"))
@@ -182,6 +212,12 @@ func (x *fastReflection_MsgCreateSpotOrder) Clear(fd protoreflect.FieldDescripto
 		x.OwnerAddress = ""
 	case "elys.tradeshield.MsgCreateSpotOrder.order_target_denom":
 		x.OrderTargetDenom = ""
+	case "elys.tradeshield.MsgCreateSpotOrder.time_in_force":
+		x.TimeInForce = 0
+	case "elys.tradeshield.MsgCreateSpotOrder.expiry":
+		x.Expiry = nil
+	case "elys.tradeshield.MsgCreateSpotOrder.trailing_stop":
+		x.TrailingStop = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.MsgCreateSpotOrder"))
@@ -213,6 +249,15 @@ func (x *fastReflection_MsgCreateSpotOrder) Get(descriptor protoreflect.FieldDes
 	case "elys.tradeshield.MsgCreateSpotOrder.order_target_denom":
 		value := x.OrderTargetDenom
 		return protoreflect.ValueOfString(value)
+	case "elys.tradeshield.MsgCreateSpotOrder.time_in_force":
+		value := x.TimeInForce
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "elys.tradeshield.MsgCreateSpotOrder.expiry":
+		value := x.Expiry
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "elys.tradeshield.MsgCreateSpotOrder.trailing_stop":
+		value := x.TrailingStop
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.MsgCreateSpotOrder"))
@@ -243,6 +288,12 @@ func (x *fastReflection_MsgCreateSpotOrder) Set(fd protoreflect.FieldDescriptor,
 		x.OwnerAddress = value.Interface().(string)
 	case "elys.tradeshield.MsgCreateSpotOrder.order_target_denom":
 		x.OrderTargetDenom = value.Interface().(string)
+	case "elys.tradeshield.MsgCreateSpotOrder.time_in_force":
+		x.TimeInForce = (TimeInForce)(value.Enum())
+	case "elys.tradeshield.MsgCreateSpotOrder.expiry":
+		x.Expiry = value.Message().Interface().(*Date)
+	case "elys.tradeshield.MsgCreateSpotOrder.trailing_stop":
+		x.TrailingStop = value.Message().Interface().(*TrailingStop)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.MsgCreateSpotOrder"))
@@ -273,12 +324,24 @@ func (x *fastReflection_MsgCreateSpotOrder) Mutable(fd protoreflect.FieldDescrip
 			x.OrderAmount = new(v1beta1.Coin)
 		}
 		return protoreflect.ValueOfMessage(x.OrderAmount.ProtoReflect())
+	case "elys.tradeshield.MsgCreateSpotOrder.expiry":
+		if x.Expiry == nil {
+			x.Expiry = new(Date)
+		}
+		return protoreflect.ValueOfMessage(x.Expiry.ProtoReflect())
+	case "elys.tradeshield.MsgCreateSpotOrder.trailing_stop":
+		if x.TrailingStop == nil {
+			x.TrailingStop = new(TrailingStop)
+		}
+		return protoreflect.ValueOfMessage(x.TrailingStop.ProtoReflect())
 	case "elys.tradeshield.MsgCreateSpotOrder.order_type":
 		panic(fmt.Errorf("field order_type of message elys.tradeshield.MsgCreateSpotOrder is not mutable"))
 	case "elys.tradeshield.MsgCreateSpotOrder.owner_address":
 		panic(fmt.Errorf("field owner_address of message elys.tradeshield.MsgCreateSpotOrder is not mutable"))
 	case "elys.tradeshield.MsgCreateSpotOrder.order_target_denom":
 		panic(fmt.Errorf("field order_target_denom of message elys.tradeshield.MsgCreateSpotOrder is not mutable"))
+	case "elys.tradeshield.MsgCreateSpotOrder.time_in_force":
+		panic(fmt.Errorf("field time_in_force of message elys.tradeshield.MsgCreateSpotOrder is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.MsgCreateSpotOrder"))
@@ -304,6 +367,14 @@ func (x *fastReflection_MsgCreateSpotOrder) NewField(fd protoreflect.FieldDescri
 		return protoreflect.ValueOfString("")
 	case "elys.tradeshield.MsgCreateSpotOrder.order_target_denom":
 		return protoreflect.ValueOfString("")
+	case "elys.tradeshield.MsgCreateSpotOrder.time_in_force":
+		return protoreflect.ValueOfEnum(0)
+	case "elys.tradeshield.MsgCreateSpotOrder.expiry":
+		m := new(Date)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "elys.tradeshield.MsgCreateSpotOrder.trailing_stop":
+		m := new(TrailingStop)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.MsgCreateSpotOrder"))
@@ -392,6 +463,17 @@ func (x *fastReflection_MsgCreateSpotOrder) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.TimeInForce != 0 {
+			n += 1 + runtime.Sov(uint64(x.TimeInForce))
+		}
+		if x.Expiry != nil {
+			l = options.Size(x.Expiry)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.TrailingStop != nil {
+			l = options.Size(x.TrailingStop)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -421,6 +503,39 @@ func (x *fastReflection_MsgCreateSpotOrder) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.TrailingStop != nil {
+			encoded, err := options.Marshal(x.TrailingStop)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if x.Expiry != nil {
+			encoded, err := options.Marshal(x.Expiry)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if x.TimeInForce != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TimeInForce))
+			i--
+			dAtA[i] = 0x30
+		}
 		if len(x.OrderTargetDenom) > 0 {
 			i -= len(x.OrderTargetDenom)
 			copy(dAtA[i:], x.OrderTargetDenom)
@@ -672,6 +787,97 @@ func (x *fastReflection_MsgCreateSpotOrder) ProtoMethods() *protoiface.Methods {
 				}
 				x.OrderTargetDenom = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TimeInForce", wireType)
+				}
+				x.TimeInForce = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TimeInForce |= TimeInForce(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Expiry == nil {
+					x.Expiry = &Date{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Expiry); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TrailingStop", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.TrailingStop == nil {
+					x.TrailingStop = &TrailingStop{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TrailingStop); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -3842,6 +4048,57 @@ func (x *fastReflection_MsgCancelSpotOrdersResponse) ProtoMethods() *protoiface.
 	}
 }
 
+var _ protoreflect.List = (*_MsgCreatePerpetualOpenOrder_12_list)(nil)
+
+type _MsgCreatePerpetualOpenOrder_12_list struct {
+	list *[]*BracketOrder
+}
+
+func (x *_MsgCreatePerpetualOpenOrder_12_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgCreatePerpetualOpenOrder_12_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgCreatePerpetualOpenOrder_12_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*BracketOrder)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgCreatePerpetualOpenOrder_12_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*BracketOrder)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgCreatePerpetualOpenOrder_12_list) AppendMutable() protoreflect.Value {
+	v := new(BracketOrder)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgCreatePerpetualOpenOrder_12_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgCreatePerpetualOpenOrder_12_list) NewElement() protoreflect.Value {
+	v := new(BracketOrder)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgCreatePerpetualOpenOrder_12_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_MsgCreatePerpetualOpenOrder                   protoreflect.MessageDescriptor
 	fd_MsgCreatePerpetualOpenOrder_owner_address     protoreflect.FieldDescriptor
@@ -3853,6 +4110,9 @@ var (
 	fd_MsgCreatePerpetualOpenOrder_take_profit_price protoreflect.FieldDescriptor
 	fd_MsgCreatePerpetualOpenOrder_stop_loss_price   protoreflect.FieldDescriptor
 	fd_MsgCreatePerpetualOpenOrder_pool_id           protoreflect.FieldDescriptor
+	fd_MsgCreatePerpetualOpenOrder_time_in_force     protoreflect.FieldDescriptor
+	fd_MsgCreatePerpetualOpenOrder_expiry            protoreflect.FieldDescriptor
+	fd_MsgCreatePerpetualOpenOrder_bracket_orders    protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -3867,6 +4127,9 @@ func init() {
 	fd_MsgCreatePerpetualOpenOrder_take_profit_price = md_MsgCreatePerpetualOpenOrder.Fields().ByName("take_profit_price")
 	fd_MsgCreatePerpetualOpenOrder_stop_loss_price = md_MsgCreatePerpetualOpenOrder.Fields().ByName("stop_loss_price")
 	fd_MsgCreatePerpetualOpenOrder_pool_id = md_MsgCreatePerpetualOpenOrder.Fields().ByName("pool_id")
+	fd_MsgCreatePerpetualOpenOrder_time_in_force = md_MsgCreatePerpetualOpenOrder.Fields().ByName("time_in_force")
+	fd_MsgCreatePerpetualOpenOrder_expiry = md_MsgCreatePerpetualOpenOrder.Fields().ByName("expiry")
+	fd_MsgCreatePerpetualOpenOrder_bracket_orders = md_MsgCreatePerpetualOpenOrder.Fields().ByName("bracket_orders")
 }
 
 var _ protoreflect.Message = (*fastReflection_MsgCreatePerpetualOpenOrder)(nil)
@@ -3988,6 +4251,24 @@ func (x *fastReflection_MsgCreatePerpetualOpenOrder) Range(f func(protoreflect.F
 			return
 		}
 	}
+	if x.TimeInForce != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.TimeInForce))
+		if !f(fd_MsgCreatePerpetualOpenOrder_time_in_force, value) {
+			return
+		}
+	}
+	if x.Expiry != nil {
+		value := protoreflect.ValueOfMessage(x.Expiry.ProtoReflect())
+		if !f(fd_MsgCreatePerpetualOpenOrder_expiry, value) {
+			return
+		}
+	}
+	if len(x.BracketOrders) != 0 {
+		value := protoreflect.ValueOfList(&_MsgCreatePerpetualOpenOrder_12_list{list: &x.BracketOrders})
+		if !f(fd_MsgCreatePerpetualOpenOrder_bracket_orders, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -4021,6 +4302,12 @@ func (x *fastReflection_MsgCreatePerpetualOpenOrder) Has(fd protoreflect.FieldDe
 		return x.StopLossPrice != ""
 	case "elys.tradeshield.MsgCreatePerpetualOpenOrder.pool_id":
 		return x.PoolId != uint64(0)
+	case "elys.tradeshield.MsgCreatePerpetualOpenOrder.time_in_force":
+		return x.TimeInForce != 0
+	case "elys.tradeshield.MsgCreatePerpetualOpenOrder.expiry":
+		return x.Expiry != nil
+	case "elys.tradeshield.MsgCreatePerpetualOpenOrder.bracket_orders":
+		return len(x.BracketOrders) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.MsgCreatePerpetualOpenOrder"))
@@ -4055,6 +4342,12 @@ func (x *fastReflection_MsgCreatePerpetualOpenOrder) Clear(fd protoreflect.Field
 		x.StopLossPrice = ""
 	case "elys.tradeshield.MsgCreatePerpetualOpenOrder.pool_id":
 		x.PoolId = uint64(0)
+	case "elys.tradeshield.MsgCreatePerpetualOpenOrder.time_in_force":
+		x.TimeInForce = 0
+	case "elys.tradeshield.MsgCreatePerpetualOpenOrder.expiry":
+		x.Expiry = nil
+	case "elys.tradeshield.MsgCreatePerpetualOpenOrder.bracket_orders":
+		x.BracketOrders = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.MsgCreatePerpetualOpenOrder"))
@@ -4098,6 +4391,18 @@ func (x *fastReflection_MsgCreatePerpetualOpenOrder) Get(descriptor protoreflect
 	case "elys.tradeshield.MsgCreatePerpetualOpenOrder.pool_id":
 		value := x.PoolId
 		return protoreflect.ValueOfUint64(value)
+	case "elys.tradeshield.MsgCreatePerpetualOpenOrder.time_in_force":
+		value := x.TimeInForce
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "elys.tradeshield.MsgCreatePerpetualOpenOrder.expiry":
+		value := x.Expiry
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "elys.tradeshield.MsgCreatePerpetualOpenOrder.bracket_orders":
+		if len(x.BracketOrders) == 0 {
+			return protoreflect.ValueOfList(&_MsgCreatePerpetualOpenOrder_12_list{})
+		}
+		listValue := &_MsgCreatePerpetualOpenOrder_12_list{list: &x.BracketOrders}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.MsgCreatePerpetualOpenOrder"))
@@ -4136,6 +4441,14 @@ func (x *fastReflection_MsgCreatePerpetualOpenOrder) Set(fd protoreflect.FieldDe
 		x.StopLossPrice = value.Interface().(string)
 	case "elys.tradeshield.MsgCreatePerpetualOpenOrder.pool_id":
 		x.PoolId = value.Uint()
+	case "elys.tradeshield.MsgCreatePerpetualOpenOrder.time_in_force":
+		x.TimeInForce = (TimeInForce)(value.Enum())
+	case "elys.tradeshield.MsgCreatePerpetualOpenOrder.expiry":
+		x.Expiry = value.Message().Interface().(*Date)
+	case "elys.tradeshield.MsgCreatePerpetualOpenOrder.bracket_orders":
+		lv := value.List()
+		clv := lv.(*_MsgCreatePerpetualOpenOrder_12_list)
+		x.BracketOrders = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.MsgCreatePerpetualOpenOrder"))
@@ -4166,6 +4479,17 @@ func (x *fastReflection_MsgCreatePerpetualOpenOrder) Mutable(fd protoreflect.Fie
 			x.Collateral = new(v1beta1.Coin)
 		}
 		return protoreflect.ValueOfMessage(x.Collateral.ProtoReflect())
+	case "elys.tradeshield.MsgCreatePerpetualOpenOrder.expiry":
+		if x.Expiry == nil {
+			x.Expiry = new(Date)
+		}
+		return protoreflect.ValueOfMessage(x.Expiry.ProtoReflect())
+	case "elys.tradeshield.MsgCreatePerpetualOpenOrder.bracket_orders":
+		if x.BracketOrders == nil {
+			x.BracketOrders = []*BracketOrder{}
+		}
+		value := &_MsgCreatePerpetualOpenOrder_12_list{list: &x.BracketOrders}
+		return protoreflect.ValueOfList(value)
 	case "elys.tradeshield.MsgCreatePerpetualOpenOrder.owner_address":
 		panic(fmt.Errorf("field owner_address of message elys.tradeshield.MsgCreatePerpetualOpenOrder is not mutable"))
 	case "elys.tradeshield.MsgCreatePerpetualOpenOrder.trading_asset":
@@ -4180,6 +4504,8 @@ func (x *fastReflection_MsgCreatePerpetualOpenOrder) Mutable(fd protoreflect.Fie
 		panic(fmt.Errorf("field stop_loss_price of message elys.tradeshield.MsgCreatePerpetualOpenOrder is not mutable"))
 	case "elys.tradeshield.MsgCreatePerpetualOpenOrder.pool_id":
 		panic(fmt.Errorf("field pool_id of message elys.tradeshield.MsgCreatePerpetualOpenOrder is not mutable"))
+	case "elys.tradeshield.MsgCreatePerpetualOpenOrder.time_in_force":
+		panic(fmt.Errorf("field time_in_force of message elys.tradeshield.MsgCreatePerpetualOpenOrder is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.MsgCreatePerpetualOpenOrder"))
@@ -4213,6 +4539,14 @@ func (x *fastReflection_MsgCreatePerpetualOpenOrder) NewField(fd protoreflect.Fi
 		return protoreflect.ValueOfString("")
 	case "elys.tradeshield.MsgCreatePerpetualOpenOrder.pool_id":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "elys.tradeshield.MsgCreatePerpetualOpenOrder.time_in_force":
+		return protoreflect.ValueOfEnum(0)
+	case "elys.tradeshield.MsgCreatePerpetualOpenOrder.expiry":
+		m := new(Date)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "elys.tradeshield.MsgCreatePerpetualOpenOrder.bracket_orders":
+		list := []*BracketOrder{}
+		return protoreflect.ValueOfList(&_MsgCreatePerpetualOpenOrder_12_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.MsgCreatePerpetualOpenOrder"))
@@ -4316,6 +4650,19 @@ func (x *fastReflection_MsgCreatePerpetualOpenOrder) ProtoMethods() *protoiface.
 		if x.PoolId != 0 {
 			n += 1 + runtime.Sov(uint64(x.PoolId))
 		}
+		if x.TimeInForce != 0 {
+			n += 1 + runtime.Sov(uint64(x.TimeInForce))
+		}
+		if x.Expiry != nil {
+			l = options.Size(x.Expiry)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.BracketOrders) > 0 {
+			for _, e := range x.BracketOrders {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -4345,6 +4692,41 @@ func (x *fastReflection_MsgCreatePerpetualOpenOrder) ProtoMethods() *protoiface.
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.BracketOrders) > 0 {
+			for iNdEx := len(x.BracketOrders) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.BracketOrders[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x62
+			}
+		}
+		if x.Expiry != nil {
+			encoded, err := options.Marshal(x.Expiry)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x5a
+		}
+		if x.TimeInForce != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TimeInForce))
+			i--
+			dAtA[i] = 0x50
+		}
 		if x.PoolId != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.PoolId))
 			i--
@@ -4737,35 +5119,124 @@ func (x *fastReflection_MsgCreatePerpetualOpenOrder) ProtoMethods() *protoiface.
 						break
 					}
 				}
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TimeInForce", wireType)
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				x.TimeInForce = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TimeInForce |= TimeInForce(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				if x.Expiry == nil {
+					x.Expiry = &Date{}
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Expiry); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BracketOrders", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BracketOrders = append(x.BracketOrders, &BracketOrder{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BracketOrders[len(x.BracketOrders)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
 		Unmarshal:         unmarshal,
 		Merge:             nil,
 		CheckInitialized:  nil,
@@ -5181,6 +5652,11 @@ var (
 	fd_MsgCreatePerpetualCloseOrder_owner_address protoreflect.FieldDescriptor
 	fd_MsgCreatePerpetualCloseOrder_trigger_price protoreflect.FieldDescriptor
 	fd_MsgCreatePerpetualCloseOrder_position_id   protoreflect.FieldDescriptor
+	fd_MsgCreatePerpetualCloseOrder_order_type    protoreflect.FieldDescriptor
+	fd_MsgCreatePerpetualCloseOrder_close_amount  protoreflect.FieldDescriptor
+	fd_MsgCreatePerpetualCloseOrder_time_in_force protoreflect.FieldDescriptor
+	fd_MsgCreatePerpetualCloseOrder_expiry        protoreflect.FieldDescriptor
+	fd_MsgCreatePerpetualCloseOrder_trailing_stop protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -5189,6 +5665,11 @@ func init() {
 	fd_MsgCreatePerpetualCloseOrder_owner_address = md_MsgCreatePerpetualCloseOrder.Fields().ByName("owner_address")
 	fd_MsgCreatePerpetualCloseOrder_trigger_price = md_MsgCreatePerpetualCloseOrder.Fields().ByName("trigger_price")
 	fd_MsgCreatePerpetualCloseOrder_position_id = md_MsgCreatePerpetualCloseOrder.Fields().ByName("position_id")
+	fd_MsgCreatePerpetualCloseOrder_order_type = md_MsgCreatePerpetualCloseOrder.Fields().ByName("order_type")
+	fd_MsgCreatePerpetualCloseOrder_close_amount = md_MsgCreatePerpetualCloseOrder.Fields().ByName("close_amount")
+	fd_MsgCreatePerpetualCloseOrder_time_in_force = md_MsgCreatePerpetualCloseOrder.Fields().ByName("time_in_force")
+	fd_MsgCreatePerpetualCloseOrder_expiry = md_MsgCreatePerpetualCloseOrder.Fields().ByName("expiry")
+	fd_MsgCreatePerpetualCloseOrder_trailing_stop = md_MsgCreatePerpetualCloseOrder.Fields().ByName("trailing_stop")
 }
 
 var _ protoreflect.Message = (*fastReflection_MsgCreatePerpetualCloseOrder)(nil)
@@ -5274,6 +5755,36 @@ func (x *fastReflection_MsgCreatePerpetualCloseOrder) Range(f func(protoreflect.
 			return
 		}
 	}
+	if x.OrderType != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.OrderType))
+		if !f(fd_MsgCreatePerpetualCloseOrder_order_type, value) {
+			return
+		}
+	}
+	if x.CloseAmount != "" {
+		value := protoreflect.ValueOfString(x.CloseAmount)
+		if !f(fd_MsgCreatePerpetualCloseOrder_close_amount, value) {
+			return
+		}
+	}
+	if x.TimeInForce != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.TimeInForce))
+		if !f(fd_MsgCreatePerpetualCloseOrder_time_in_force, value) {
+			return
+		}
+	}
+	if x.Expiry != nil {
+		value := protoreflect.ValueOfMessage(x.Expiry.ProtoReflect())
+		if !f(fd_MsgCreatePerpetualCloseOrder_expiry, value) {
+			return
+		}
+	}
+	if x.TrailingStop != nil {
+		value := protoreflect.ValueOfMessage(x.TrailingStop.ProtoReflect())
+		if !f(fd_MsgCreatePerpetualCloseOrder_trailing_stop, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -5295,6 +5806,16 @@ func (x *fastReflection_MsgCreatePerpetualCloseOrder) Has(fd protoreflect.FieldD
 		return x.TriggerPrice != nil
 	case "elys.tradeshield.MsgCreatePerpetualCloseOrder.position_id":
 		return x.PositionId != uint64(0)
+	case "elys.tradeshield.MsgCreatePerpetualCloseOrder.order_type":
+		return x.OrderType != 0
+	case "elys.tradeshield.MsgCreatePerpetualCloseOrder.close_amount":
+		return x.CloseAmount != ""
+	case "elys.tradeshield.MsgCreatePerpetualCloseOrder.time_in_force":
+		return x.TimeInForce != 0
+	case "elys.tradeshield.MsgCreatePerpetualCloseOrder.expiry":
+		return x.Expiry != nil
+	case "elys.tradeshield.MsgCreatePerpetualCloseOrder.trailing_stop":
+		return x.TrailingStop != nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.MsgCreatePerpetualCloseOrder"))
@@ -5317,6 +5838,16 @@ func (x *fastReflection_MsgCreatePerpetualCloseOrder) Clear(fd protoreflect.Fiel
 		x.TriggerPrice = nil
 	case "elys.tradeshield.MsgCreatePerpetualCloseOrder.position_id":
 		x.PositionId = uint64(0)
+	case "elys.tradeshield.MsgCreatePerpetualCloseOrder.order_type":
+		x.OrderType = 0
+	case "elys.tradeshield.MsgCreatePerpetualCloseOrder.close_amount":
+		x.CloseAmount = ""
+	case "elys.tradeshield.MsgCreatePerpetualCloseOrder.time_in_force":
+		x.TimeInForce = 0
+	case "elys.tradeshield.MsgCreatePerpetualCloseOrder.expiry":
+		x.Expiry = nil
+	case "elys.tradeshield.MsgCreatePerpetualCloseOrder.trailing_stop":
+		x.TrailingStop = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.MsgCreatePerpetualCloseOrder"))
@@ -5342,6 +5873,21 @@ func (x *fastReflection_MsgCreatePerpetualCloseOrder) Get(descriptor protoreflec
 	case "elys.tradeshield.MsgCreatePerpetualCloseOrder.position_id":
 		value := x.PositionId
 		return protoreflect.ValueOfUint64(value)
+	case "elys.tradeshield.MsgCreatePerpetualCloseOrder.order_type":
+		value := x.OrderType
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "elys.tradeshield.MsgCreatePerpetualCloseOrder.close_amount":
+		value := x.CloseAmount
+		return protoreflect.ValueOfString(value)
+	case "elys.tradeshield.MsgCreatePerpetualCloseOrder.time_in_force":
+		value := x.TimeInForce
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "elys.tradeshield.MsgCreatePerpetualCloseOrder.expiry":
+		value := x.Expiry
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "elys.tradeshield.MsgCreatePerpetualCloseOrder.trailing_stop":
+		value := x.TrailingStop
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.MsgCreatePerpetualCloseOrder"))
@@ -5368,6 +5914,16 @@ func (x *fastReflection_MsgCreatePerpetualCloseOrder) Set(fd protoreflect.FieldD
 		x.TriggerPrice = value.Message().Interface().(*TriggerPrice)
 	case "elys.tradeshield.MsgCreatePerpetualCloseOrder.position_id":
 		x.PositionId = value.Uint()
+	case "elys.tradeshield.MsgCreatePerpetualCloseOrder.order_type":
+		x.OrderType = (PerpetualOrderType)(value.Enum())
+	case "elys.tradeshield.MsgCreatePerpetualCloseOrder.close_amount":
+		x.CloseAmount = value.Interface().(string)
+	case "elys.tradeshield.MsgCreatePerpetualCloseOrder.time_in_force":
+		x.TimeInForce = (TimeInForce)(value.Enum())
+	case "elys.tradeshield.MsgCreatePerpetualCloseOrder.expiry":
+		x.Expiry = value.Message().Interface().(*Date)
+	case "elys.tradeshield.MsgCreatePerpetualCloseOrder.trailing_stop":
+		x.TrailingStop = value.Message().Interface().(*TrailingStop)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.MsgCreatePerpetualCloseOrder"))
@@ -5393,10 +5949,26 @@ func (x *fastReflection_MsgCreatePerpetualCloseOrder) Mutable(fd protoreflect.Fi
 			x.TriggerPrice = new(TriggerPrice)
 		}
 		return protoreflect.ValueOfMessage(x.TriggerPrice.ProtoReflect())
+	case "elys.tradeshield.MsgCreatePerpetualCloseOrder.expiry":
+		if x.Expiry == nil {
+			x.Expiry = new(Date)
+		}
+		return protoreflect.ValueOfMessage(x.Expiry.ProtoReflect())
+	case "elys.tradeshield.MsgCreatePerpetualCloseOrder.trailing_stop":
+		if x.TrailingStop == nil {
+			x.TrailingStop = new(TrailingStop)
+		}
+		return protoreflect.ValueOfMessage(x.TrailingStop.ProtoReflect())
 	case "elys.tradeshield.MsgCreatePerpetualCloseOrder.owner_address":
 		panic(fmt.Errorf("field owner_address of message elys.tradeshield.MsgCreatePerpetualCloseOrder is not mutable"))
 	case "elys.tradeshield.MsgCreatePerpetualCloseOrder.position_id":
 		panic(fmt.Errorf("field position_id of message elys.tradeshield.MsgCreatePerpetualCloseOrder is not mutable"))
+	case "elys.tradeshield.MsgCreatePerpetualCloseOrder.order_type":
+		panic(fmt.Errorf("field order_type of message elys.tradeshield.MsgCreatePerpetualCloseOrder is not mutable"))
+	case "elys.tradeshield.MsgCreatePerpetualCloseOrder.close_amount":
+		panic(fmt.Errorf("field close_amount of message elys.tradeshield.MsgCreatePerpetualCloseOrder is not mutable"))
+	case "elys.tradeshield.MsgCreatePerpetualCloseOrder.time_in_force":
+		panic(fmt.Errorf("field time_in_force of message elys.tradeshield.MsgCreatePerpetualCloseOrder is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.MsgCreatePerpetualCloseOrder"))
@@ -5417,6 +5989,18 @@ func (x *fastReflection_MsgCreatePerpetualCloseOrder) NewField(fd protoreflect.F
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	case "elys.tradeshield.MsgCreatePerpetualCloseOrder.position_id":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "elys.tradeshield.MsgCreatePerpetualCloseOrder.order_type":
+		return protoreflect.ValueOfEnum(0)
+	case "elys.tradeshield.MsgCreatePerpetualCloseOrder.close_amount":
+		return protoreflect.ValueOfString("")
+	case "elys.tradeshield.MsgCreatePerpetualCloseOrder.time_in_force":
+		return protoreflect.ValueOfEnum(0)
+	case "elys.tradeshield.MsgCreatePerpetualCloseOrder.expiry":
+		m := new(Date)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "elys.tradeshield.MsgCreatePerpetualCloseOrder.trailing_stop":
+		m := new(TrailingStop)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.MsgCreatePerpetualCloseOrder"))
@@ -5497,6 +6081,24 @@ func (x *fastReflection_MsgCreatePerpetualCloseOrder) ProtoMethods() *protoiface
 		if x.PositionId != 0 {
 			n += 1 + runtime.Sov(uint64(x.PositionId))
 		}
+		if x.OrderType != 0 {
+			n += 1 + runtime.Sov(uint64(x.OrderType))
+		}
+		l = len(x.CloseAmount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.TimeInForce != 0 {
+			n += 1 + runtime.Sov(uint64(x.TimeInForce))
+		}
+		if x.Expiry != nil {
+			l = options.Size(x.Expiry)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.TrailingStop != nil {
+			l = options.Size(x.TrailingStop)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -5526,6 +6128,51 @@ func (x *fastReflection_MsgCreatePerpetualCloseOrder) ProtoMethods() *protoiface
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.TrailingStop != nil {
+			encoded, err := options.Marshal(x.TrailingStop)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if x.Expiry != nil {
+			encoded, err := options.Marshal(x.Expiry)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if x.TimeInForce != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TimeInForce))
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.CloseAmount) > 0 {
+			i -= len(x.CloseAmount)
+			copy(dAtA[i:], x.CloseAmount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CloseAmount)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.OrderType != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OrderType))
+			i--
+			dAtA[i] = 0x20
+		}
 		if x.PositionId != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.PositionId))
 			i--
@@ -5688,85 +6335,227 @@ func (x *fastReflection_MsgCreatePerpetualCloseOrder) ProtoMethods() *protoiface
 						break
 					}
 				}
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OrderType", wireType)
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				x.OrderType = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OrderType |= PerpetualOrderType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CloseAmount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				x.CloseAmount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TimeInForce", wireType)
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
-	}
-}
-
-var (
-	md_MsgCreatePerpetualCloseOrderResponse          protoreflect.MessageDescriptor
-	fd_MsgCreatePerpetualCloseOrderResponse_order_id protoreflect.FieldDescriptor
-)
-
-func init() {
-	file_elys_tradeshield_tx_proto_init()
-	md_MsgCreatePerpetualCloseOrderResponse = File_elys_tradeshield_tx_proto.Messages().ByName("MsgCreatePerpetualCloseOrderResponse")
-	fd_MsgCreatePerpetualCloseOrderResponse_order_id = md_MsgCreatePerpetualCloseOrderResponse.Fields().ByName("order_id")
-}
-
-var _ protoreflect.Message = (*fastReflection_MsgCreatePerpetualCloseOrderResponse)(nil)
-
-type fastReflection_MsgCreatePerpetualCloseOrderResponse MsgCreatePerpetualCloseOrderResponse
-
-func (x *MsgCreatePerpetualCloseOrderResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgCreatePerpetualCloseOrderResponse)(x)
-}
-
-func (x *MsgCreatePerpetualCloseOrderResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_elys_tradeshield_tx_proto_msgTypes[11]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-var _fastReflection_MsgCreatePerpetualCloseOrderResponse_messageType fastReflection_MsgCreatePerpetualCloseOrderResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MsgCreatePerpetualCloseOrderResponse_messageType{}
-
-type fastReflection_MsgCreatePerpetualCloseOrderResponse_messageType struct{}
-
-func (x fastReflection_MsgCreatePerpetualCloseOrderResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgCreatePerpetualCloseOrderResponse)(nil)
-}
-func (x fastReflection_MsgCreatePerpetualCloseOrderResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgCreatePerpetualCloseOrderResponse)
-}
-func (x fastReflection_MsgCreatePerpetualCloseOrderResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgCreatePerpetualCloseOrderResponse
+				x.TimeInForce = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TimeInForce |= TimeInForce(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Expiry == nil {
+					x.Expiry = &Date{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Expiry); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TrailingStop", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.TrailingStop == nil {
+					x.TrailingStop = &TrailingStop{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TrailingStop); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgCreatePerpetualCloseOrderResponse          protoreflect.MessageDescriptor
+	fd_MsgCreatePerpetualCloseOrderResponse_order_id protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_elys_tradeshield_tx_proto_init()
+	md_MsgCreatePerpetualCloseOrderResponse = File_elys_tradeshield_tx_proto.Messages().ByName("MsgCreatePerpetualCloseOrderResponse")
+	fd_MsgCreatePerpetualCloseOrderResponse_order_id = md_MsgCreatePerpetualCloseOrderResponse.Fields().ByName("order_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgCreatePerpetualCloseOrderResponse)(nil)
+
+type fastReflection_MsgCreatePerpetualCloseOrderResponse MsgCreatePerpetualCloseOrderResponse
+
+func (x *MsgCreatePerpetualCloseOrderResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgCreatePerpetualCloseOrderResponse)(x)
+}
+
+func (x *MsgCreatePerpetualCloseOrderResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_elys_tradeshield_tx_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgCreatePerpetualCloseOrderResponse_messageType fastReflection_MsgCreatePerpetualCloseOrderResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgCreatePerpetualCloseOrderResponse_messageType{}
+
+type fastReflection_MsgCreatePerpetualCloseOrderResponse_messageType struct{}
+
+func (x fastReflection_MsgCreatePerpetualCloseOrderResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgCreatePerpetualCloseOrderResponse)(nil)
+}
+func (x fastReflection_MsgCreatePerpetualCloseOrderResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgCreatePerpetualCloseOrderResponse)
+}
+func (x fastReflection_MsgCreatePerpetualCloseOrderResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCreatePerpetualCloseOrderResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
@@ -10849,242 +11638,4636 @@ func (x *fastReflection_MsgExecuteOrdersResponse) ProtoMethods() *protoiface.Met
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: elys/tradeshield/tx.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
-
-type MsgCreateSpotOrder struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+var _ protoreflect.List = (*_MsgCreateOrderGroup_2_list)(nil)
 
-	OrderType        SpotOrderType `protobuf:"varint,1,opt,name=order_type,json=orderType,proto3,enum=elys.tradeshield.SpotOrderType" json:"order_type,omitempty"`
-	OrderPrice       *OrderPrice   `protobuf:"bytes,2,opt,name=order_price,json=orderPrice,proto3" json:"order_price,omitempty"`
-	OrderAmount      *v1beta1.Coin `protobuf:"bytes,3,opt,name=order_amount,json=orderAmount,proto3" json:"order_amount,omitempty"`
-	OwnerAddress     string        `protobuf:"bytes,4,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
-	OrderTargetDenom string        `protobuf:"bytes,5,opt,name=order_target_denom,json=orderTargetDenom,proto3" json:"order_target_denom,omitempty"`
+type _MsgCreateOrderGroup_2_list struct {
+	list *[]uint64
 }
 
-func (x *MsgCreateSpotOrder) Reset() {
-	*x = MsgCreateSpotOrder{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_elys_tradeshield_tx_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *_MsgCreateOrderGroup_2_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
+	return len(*x.list)
 }
 
-func (x *MsgCreateSpotOrder) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *_MsgCreateOrderGroup_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfUint64((*x.list)[i])
 }
 
-func (*MsgCreateSpotOrder) ProtoMessage() {}
-
-// Deprecated: Use MsgCreateSpotOrder.ProtoReflect.Descriptor instead.
-func (*MsgCreateSpotOrder) Descriptor() ([]byte, []int) {
-	return file_elys_tradeshield_tx_proto_rawDescGZIP(), []int{0}
+func (x *_MsgCreateOrderGroup_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
 }
 
-func (x *MsgCreateSpotOrder) GetOrderType() SpotOrderType {
-	if x != nil {
-		return x.OrderType
-	}
-	return SpotOrderType_STOPLOSS
+func (x *_MsgCreateOrderGroup_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *MsgCreateSpotOrder) GetOrderPrice() *OrderPrice {
-	if x != nil {
-		return x.OrderPrice
-	}
-	return nil
+func (x *_MsgCreateOrderGroup_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message MsgCreateOrderGroup at list field SpotOrderIds as it is not of Message kind"))
 }
 
-func (x *MsgCreateSpotOrder) GetOrderAmount() *v1beta1.Coin {
-	if x != nil {
-		return x.OrderAmount
-	}
-	return nil
+func (x *_MsgCreateOrderGroup_2_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
 }
 
-func (x *MsgCreateSpotOrder) GetOwnerAddress() string {
-	if x != nil {
-		return x.OwnerAddress
-	}
-	return ""
+func (x *_MsgCreateOrderGroup_2_list) NewElement() protoreflect.Value {
+	v := uint64(0)
+	return protoreflect.ValueOfUint64(v)
 }
 
-func (x *MsgCreateSpotOrder) GetOrderTargetDenom() string {
-	if x != nil {
-		return x.OrderTargetDenom
-	}
-	return ""
+func (x *_MsgCreateOrderGroup_2_list) IsValid() bool {
+	return x.list != nil
 }
 
-type MsgCreateSpotOrderResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+var _ protoreflect.List = (*_MsgCreateOrderGroup_3_list)(nil)
 
-	OrderId uint64 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+type _MsgCreateOrderGroup_3_list struct {
+	list *[]uint64
 }
 
-func (x *MsgCreateSpotOrderResponse) Reset() {
-	*x = MsgCreateSpotOrderResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_elys_tradeshield_tx_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *_MsgCreateOrderGroup_3_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
+	return len(*x.list)
 }
 
-func (x *MsgCreateSpotOrderResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *_MsgCreateOrderGroup_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfUint64((*x.list)[i])
 }
 
-func (*MsgCreateSpotOrderResponse) ProtoMessage() {}
+func (x *_MsgCreateOrderGroup_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
 
-// Deprecated: Use MsgCreateSpotOrderResponse.ProtoReflect.Descriptor instead.
-func (*MsgCreateSpotOrderResponse) Descriptor() ([]byte, []int) {
+func (x *_MsgCreateOrderGroup_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgCreateOrderGroup_3_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message MsgCreateOrderGroup at list field PerpetualOrderIds as it is not of Message kind"))
+}
+
+func (x *_MsgCreateOrderGroup_3_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgCreateOrderGroup_3_list) NewElement() protoreflect.Value {
+	v := uint64(0)
+	return protoreflect.ValueOfUint64(v)
+}
+
+func (x *_MsgCreateOrderGroup_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MsgCreateOrderGroup                     protoreflect.MessageDescriptor
+	fd_MsgCreateOrderGroup_owner_address       protoreflect.FieldDescriptor
+	fd_MsgCreateOrderGroup_spot_order_ids      protoreflect.FieldDescriptor
+	fd_MsgCreateOrderGroup_perpetual_order_ids protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_elys_tradeshield_tx_proto_init()
+	md_MsgCreateOrderGroup = File_elys_tradeshield_tx_proto.Messages().ByName("MsgCreateOrderGroup")
+	fd_MsgCreateOrderGroup_owner_address = md_MsgCreateOrderGroup.Fields().ByName("owner_address")
+	fd_MsgCreateOrderGroup_spot_order_ids = md_MsgCreateOrderGroup.Fields().ByName("spot_order_ids")
+	fd_MsgCreateOrderGroup_perpetual_order_ids = md_MsgCreateOrderGroup.Fields().ByName("perpetual_order_ids")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgCreateOrderGroup)(nil)
+
+type fastReflection_MsgCreateOrderGroup MsgCreateOrderGroup
+
+func (x *MsgCreateOrderGroup) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgCreateOrderGroup)(x)
+}
+
+func (x *MsgCreateOrderGroup) slowProtoReflect() protoreflect.Message {
+	mi := &file_elys_tradeshield_tx_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgCreateOrderGroup_messageType fastReflection_MsgCreateOrderGroup_messageType
+var _ protoreflect.MessageType = fastReflection_MsgCreateOrderGroup_messageType{}
+
+type fastReflection_MsgCreateOrderGroup_messageType struct{}
+
+func (x fastReflection_MsgCreateOrderGroup_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgCreateOrderGroup)(nil)
+}
+func (x fastReflection_MsgCreateOrderGroup_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgCreateOrderGroup)
+}
+func (x fastReflection_MsgCreateOrderGroup_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCreateOrderGroup
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgCreateOrderGroup) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCreateOrderGroup
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgCreateOrderGroup) Type() protoreflect.MessageType {
+	return _fastReflection_MsgCreateOrderGroup_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgCreateOrderGroup) New() protoreflect.Message {
+	return new(fastReflection_MsgCreateOrderGroup)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgCreateOrderGroup) Interface() protoreflect.ProtoMessage {
+	return (*MsgCreateOrderGroup)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgCreateOrderGroup) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.OwnerAddress != "" {
+		value := protoreflect.ValueOfString(x.OwnerAddress)
+		if !f(fd_MsgCreateOrderGroup_owner_address, value) {
+			return
+		}
+	}
+	if len(x.SpotOrderIds) != 0 {
+		value := protoreflect.ValueOfList(&_MsgCreateOrderGroup_2_list{list: &x.SpotOrderIds})
+		if !f(fd_MsgCreateOrderGroup_spot_order_ids, value) {
+			return
+		}
+	}
+	if len(x.PerpetualOrderIds) != 0 {
+		value := protoreflect.ValueOfList(&_MsgCreateOrderGroup_3_list{list: &x.PerpetualOrderIds})
+		if !f(fd_MsgCreateOrderGroup_perpetual_order_ids, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgCreateOrderGroup) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "elys.tradeshield.MsgCreateOrderGroup.owner_address":
+		return x.OwnerAddress != ""
+	case "elys.tradeshield.MsgCreateOrderGroup.spot_order_ids":
+		return len(x.SpotOrderIds) != 0
+	case "elys.tradeshield.MsgCreateOrderGroup.perpetual_order_ids":
+		return len(x.PerpetualOrderIds) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.MsgCreateOrderGroup"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.MsgCreateOrderGroup does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCreateOrderGroup) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "elys.tradeshield.MsgCreateOrderGroup.owner_address":
+		x.OwnerAddress = ""
+	case "elys.tradeshield.MsgCreateOrderGroup.spot_order_ids":
+		x.SpotOrderIds = nil
+	case "elys.tradeshield.MsgCreateOrderGroup.perpetual_order_ids":
+		x.PerpetualOrderIds = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.MsgCreateOrderGroup"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.MsgCreateOrderGroup does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgCreateOrderGroup) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "elys.tradeshield.MsgCreateOrderGroup.owner_address":
+		value := x.OwnerAddress
+		return protoreflect.ValueOfString(value)
+	case "elys.tradeshield.MsgCreateOrderGroup.spot_order_ids":
+		if len(x.SpotOrderIds) == 0 {
+			return protoreflect.ValueOfList(&_MsgCreateOrderGroup_2_list{})
+		}
+		listValue := &_MsgCreateOrderGroup_2_list{list: &x.SpotOrderIds}
+		return protoreflect.ValueOfList(listValue)
+	case "elys.tradeshield.MsgCreateOrderGroup.perpetual_order_ids":
+		if len(x.PerpetualOrderIds) == 0 {
+			return protoreflect.ValueOfList(&_MsgCreateOrderGroup_3_list{})
+		}
+		listValue := &_MsgCreateOrderGroup_3_list{list: &x.PerpetualOrderIds}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.MsgCreateOrderGroup"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.MsgCreateOrderGroup does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCreateOrderGroup) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "elys.tradeshield.MsgCreateOrderGroup.owner_address":
+		x.OwnerAddress = value.Interface().(string)
+	case "elys.tradeshield.MsgCreateOrderGroup.spot_order_ids":
+		lv := value.List()
+		clv := lv.(*_MsgCreateOrderGroup_2_list)
+		x.SpotOrderIds = *clv.list
+	case "elys.tradeshield.MsgCreateOrderGroup.perpetual_order_ids":
+		lv := value.List()
+		clv := lv.(*_MsgCreateOrderGroup_3_list)
+		x.PerpetualOrderIds = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.MsgCreateOrderGroup"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.MsgCreateOrderGroup does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCreateOrderGroup) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "elys.tradeshield.MsgCreateOrderGroup.spot_order_ids":
+		if x.SpotOrderIds == nil {
+			x.SpotOrderIds = []uint64{}
+		}
+		value := &_MsgCreateOrderGroup_2_list{list: &x.SpotOrderIds}
+		return protoreflect.ValueOfList(value)
+	case "elys.tradeshield.MsgCreateOrderGroup.perpetual_order_ids":
+		if x.PerpetualOrderIds == nil {
+			x.PerpetualOrderIds = []uint64{}
+		}
+		value := &_MsgCreateOrderGroup_3_list{list: &x.PerpetualOrderIds}
+		return protoreflect.ValueOfList(value)
+	case "elys.tradeshield.MsgCreateOrderGroup.owner_address":
+		panic(fmt.Errorf("field owner_address of message elys.tradeshield.MsgCreateOrderGroup is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.MsgCreateOrderGroup"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.MsgCreateOrderGroup does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgCreateOrderGroup) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "elys.tradeshield.MsgCreateOrderGroup.owner_address":
+		return protoreflect.ValueOfString("")
+	case "elys.tradeshield.MsgCreateOrderGroup.spot_order_ids":
+		list := []uint64{}
+		return protoreflect.ValueOfList(&_MsgCreateOrderGroup_2_list{list: &list})
+	case "elys.tradeshield.MsgCreateOrderGroup.perpetual_order_ids":
+		list := []uint64{}
+		return protoreflect.ValueOfList(&_MsgCreateOrderGroup_3_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.MsgCreateOrderGroup"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.MsgCreateOrderGroup does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgCreateOrderGroup) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in elys.tradeshield.MsgCreateOrderGroup", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgCreateOrderGroup) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCreateOrderGroup) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgCreateOrderGroup) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgCreateOrderGroup) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgCreateOrderGroup)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.OwnerAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.SpotOrderIds) > 0 {
+			l = 0
+			for _, e := range x.SpotOrderIds {
+				l += runtime.Sov(uint64(e))
+			}
+			n += 1 + runtime.Sov(uint64(l)) + l
+		}
+		if len(x.PerpetualOrderIds) > 0 {
+			l = 0
+			for _, e := range x.PerpetualOrderIds {
+				l += runtime.Sov(uint64(e))
+			}
+			n += 1 + runtime.Sov(uint64(l)) + l
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgCreateOrderGroup)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.PerpetualOrderIds) > 0 {
+			var pksize2 int
+			for _, num := range x.PerpetualOrderIds {
+				pksize2 += runtime.Sov(uint64(num))
+			}
+			i -= pksize2
+			j1 := i
+			for _, num := range x.PerpetualOrderIds {
+				for num >= 1<<7 {
+					dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
+					num >>= 7
+					j1++
+				}
+				dAtA[j1] = uint8(num)
+				j1++
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(pksize2))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.SpotOrderIds) > 0 {
+			var pksize4 int
+			for _, num := range x.SpotOrderIds {
+				pksize4 += runtime.Sov(uint64(num))
+			}
+			i -= pksize4
+			j3 := i
+			for _, num := range x.SpotOrderIds {
+				for num >= 1<<7 {
+					dAtA[j3] = uint8(uint64(num)&0x7f | 0x80)
+					num >>= 7
+					j3++
+				}
+				dAtA[j3] = uint8(num)
+				j3++
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(pksize4))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.OwnerAddress) > 0 {
+			i -= len(x.OwnerAddress)
+			copy(dAtA[i:], x.OwnerAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OwnerAddress)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgCreateOrderGroup)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCreateOrderGroup: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCreateOrderGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.OwnerAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType == 0 {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.SpotOrderIds = append(x.SpotOrderIds, v)
+				} else if wireType == 2 {
+					var packedLen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						packedLen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if packedLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postIndex := iNdEx + packedLen
+					if postIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					var elementCount int
+					var count int
+					for _, integer := range dAtA[iNdEx:postIndex] {
+						if integer < 128 {
+							count++
+						}
+					}
+					elementCount = count
+					if elementCount != 0 && len(x.SpotOrderIds) == 0 {
+						x.SpotOrderIds = make([]uint64, 0, elementCount)
+					}
+					for iNdEx < postIndex {
+						var v uint64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							v |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						x.SpotOrderIds = append(x.SpotOrderIds, v)
+					}
+				} else {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SpotOrderIds", wireType)
+				}
+			case 3:
+				if wireType == 0 {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.PerpetualOrderIds = append(x.PerpetualOrderIds, v)
+				} else if wireType == 2 {
+					var packedLen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						packedLen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if packedLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postIndex := iNdEx + packedLen
+					if postIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					var elementCount int
+					var count int
+					for _, integer := range dAtA[iNdEx:postIndex] {
+						if integer < 128 {
+							count++
+						}
+					}
+					elementCount = count
+					if elementCount != 0 && len(x.PerpetualOrderIds) == 0 {
+						x.PerpetualOrderIds = make([]uint64, 0, elementCount)
+					}
+					for iNdEx < postIndex {
+						var v uint64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							v |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						x.PerpetualOrderIds = append(x.PerpetualOrderIds, v)
+					}
+				} else {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PerpetualOrderIds", wireType)
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgCreateOrderGroupResponse          protoreflect.MessageDescriptor
+	fd_MsgCreateOrderGroupResponse_group_id protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_elys_tradeshield_tx_proto_init()
+	md_MsgCreateOrderGroupResponse = File_elys_tradeshield_tx_proto.Messages().ByName("MsgCreateOrderGroupResponse")
+	fd_MsgCreateOrderGroupResponse_group_id = md_MsgCreateOrderGroupResponse.Fields().ByName("group_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgCreateOrderGroupResponse)(nil)
+
+type fastReflection_MsgCreateOrderGroupResponse MsgCreateOrderGroupResponse
+
+func (x *MsgCreateOrderGroupResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgCreateOrderGroupResponse)(x)
+}
+
+func (x *MsgCreateOrderGroupResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_elys_tradeshield_tx_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgCreateOrderGroupResponse_messageType fastReflection_MsgCreateOrderGroupResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgCreateOrderGroupResponse_messageType{}
+
+type fastReflection_MsgCreateOrderGroupResponse_messageType struct{}
+
+func (x fastReflection_MsgCreateOrderGroupResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgCreateOrderGroupResponse)(nil)
+}
+func (x fastReflection_MsgCreateOrderGroupResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgCreateOrderGroupResponse)
+}
+func (x fastReflection_MsgCreateOrderGroupResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCreateOrderGroupResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgCreateOrderGroupResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCreateOrderGroupResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgCreateOrderGroupResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgCreateOrderGroupResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgCreateOrderGroupResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgCreateOrderGroupResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgCreateOrderGroupResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgCreateOrderGroupResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgCreateOrderGroupResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.GroupId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.GroupId)
+		if !f(fd_MsgCreateOrderGroupResponse_group_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgCreateOrderGroupResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "elys.tradeshield.MsgCreateOrderGroupResponse.group_id":
+		return x.GroupId != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.MsgCreateOrderGroupResponse"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.MsgCreateOrderGroupResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCreateOrderGroupResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "elys.tradeshield.MsgCreateOrderGroupResponse.group_id":
+		x.GroupId = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.MsgCreateOrderGroupResponse"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.MsgCreateOrderGroupResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgCreateOrderGroupResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "elys.tradeshield.MsgCreateOrderGroupResponse.group_id":
+		value := x.GroupId
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.MsgCreateOrderGroupResponse"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.MsgCreateOrderGroupResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCreateOrderGroupResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "elys.tradeshield.MsgCreateOrderGroupResponse.group_id":
+		x.GroupId = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.MsgCreateOrderGroupResponse"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.MsgCreateOrderGroupResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCreateOrderGroupResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "elys.tradeshield.MsgCreateOrderGroupResponse.group_id":
+		panic(fmt.Errorf("field group_id of message elys.tradeshield.MsgCreateOrderGroupResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.MsgCreateOrderGroupResponse"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.MsgCreateOrderGroupResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgCreateOrderGroupResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "elys.tradeshield.MsgCreateOrderGroupResponse.group_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.MsgCreateOrderGroupResponse"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.MsgCreateOrderGroupResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgCreateOrderGroupResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in elys.tradeshield.MsgCreateOrderGroupResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgCreateOrderGroupResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCreateOrderGroupResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgCreateOrderGroupResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgCreateOrderGroupResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgCreateOrderGroupResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.GroupId != 0 {
+			n += 1 + runtime.Sov(uint64(x.GroupId))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgCreateOrderGroupResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.GroupId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GroupId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgCreateOrderGroupResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCreateOrderGroupResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCreateOrderGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+				}
+				x.GroupId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GroupId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgCancelOrderGroup               protoreflect.MessageDescriptor
+	fd_MsgCancelOrderGroup_owner_address protoreflect.FieldDescriptor
+	fd_MsgCancelOrderGroup_group_id      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_elys_tradeshield_tx_proto_init()
+	md_MsgCancelOrderGroup = File_elys_tradeshield_tx_proto.Messages().ByName("MsgCancelOrderGroup")
+	fd_MsgCancelOrderGroup_owner_address = md_MsgCancelOrderGroup.Fields().ByName("owner_address")
+	fd_MsgCancelOrderGroup_group_id = md_MsgCancelOrderGroup.Fields().ByName("group_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgCancelOrderGroup)(nil)
+
+type fastReflection_MsgCancelOrderGroup MsgCancelOrderGroup
+
+func (x *MsgCancelOrderGroup) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgCancelOrderGroup)(x)
+}
+
+func (x *MsgCancelOrderGroup) slowProtoReflect() protoreflect.Message {
+	mi := &file_elys_tradeshield_tx_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgCancelOrderGroup_messageType fastReflection_MsgCancelOrderGroup_messageType
+var _ protoreflect.MessageType = fastReflection_MsgCancelOrderGroup_messageType{}
+
+type fastReflection_MsgCancelOrderGroup_messageType struct{}
+
+func (x fastReflection_MsgCancelOrderGroup_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgCancelOrderGroup)(nil)
+}
+func (x fastReflection_MsgCancelOrderGroup_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgCancelOrderGroup)
+}
+func (x fastReflection_MsgCancelOrderGroup_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCancelOrderGroup
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgCancelOrderGroup) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCancelOrderGroup
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgCancelOrderGroup) Type() protoreflect.MessageType {
+	return _fastReflection_MsgCancelOrderGroup_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgCancelOrderGroup) New() protoreflect.Message {
+	return new(fastReflection_MsgCancelOrderGroup)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgCancelOrderGroup) Interface() protoreflect.ProtoMessage {
+	return (*MsgCancelOrderGroup)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgCancelOrderGroup) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.OwnerAddress != "" {
+		value := protoreflect.ValueOfString(x.OwnerAddress)
+		if !f(fd_MsgCancelOrderGroup_owner_address, value) {
+			return
+		}
+	}
+	if x.GroupId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.GroupId)
+		if !f(fd_MsgCancelOrderGroup_group_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgCancelOrderGroup) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "elys.tradeshield.MsgCancelOrderGroup.owner_address":
+		return x.OwnerAddress != ""
+	case "elys.tradeshield.MsgCancelOrderGroup.group_id":
+		return x.GroupId != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.MsgCancelOrderGroup"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.MsgCancelOrderGroup does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCancelOrderGroup) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "elys.tradeshield.MsgCancelOrderGroup.owner_address":
+		x.OwnerAddress = ""
+	case "elys.tradeshield.MsgCancelOrderGroup.group_id":
+		x.GroupId = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.MsgCancelOrderGroup"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.MsgCancelOrderGroup does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgCancelOrderGroup) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "elys.tradeshield.MsgCancelOrderGroup.owner_address":
+		value := x.OwnerAddress
+		return protoreflect.ValueOfString(value)
+	case "elys.tradeshield.MsgCancelOrderGroup.group_id":
+		value := x.GroupId
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.MsgCancelOrderGroup"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.MsgCancelOrderGroup does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCancelOrderGroup) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "elys.tradeshield.MsgCancelOrderGroup.owner_address":
+		x.OwnerAddress = value.Interface().(string)
+	case "elys.tradeshield.MsgCancelOrderGroup.group_id":
+		x.GroupId = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.MsgCancelOrderGroup"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.MsgCancelOrderGroup does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCancelOrderGroup) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "elys.tradeshield.MsgCancelOrderGroup.owner_address":
+		panic(fmt.Errorf("field owner_address of message elys.tradeshield.MsgCancelOrderGroup is not mutable"))
+	case "elys.tradeshield.MsgCancelOrderGroup.group_id":
+		panic(fmt.Errorf("field group_id of message elys.tradeshield.MsgCancelOrderGroup is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.MsgCancelOrderGroup"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.MsgCancelOrderGroup does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgCancelOrderGroup) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "elys.tradeshield.MsgCancelOrderGroup.owner_address":
+		return protoreflect.ValueOfString("")
+	case "elys.tradeshield.MsgCancelOrderGroup.group_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.MsgCancelOrderGroup"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.MsgCancelOrderGroup does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgCancelOrderGroup) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in elys.tradeshield.MsgCancelOrderGroup", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgCancelOrderGroup) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCancelOrderGroup) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgCancelOrderGroup) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgCancelOrderGroup) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgCancelOrderGroup)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.OwnerAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.GroupId != 0 {
+			n += 1 + runtime.Sov(uint64(x.GroupId))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgCancelOrderGroup)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.GroupId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GroupId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.OwnerAddress) > 0 {
+			i -= len(x.OwnerAddress)
+			copy(dAtA[i:], x.OwnerAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OwnerAddress)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgCancelOrderGroup)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCancelOrderGroup: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCancelOrderGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.OwnerAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+				}
+				x.GroupId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GroupId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgCancelOrderGroupResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_elys_tradeshield_tx_proto_init()
+	md_MsgCancelOrderGroupResponse = File_elys_tradeshield_tx_proto.Messages().ByName("MsgCancelOrderGroupResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgCancelOrderGroupResponse)(nil)
+
+type fastReflection_MsgCancelOrderGroupResponse MsgCancelOrderGroupResponse
+
+func (x *MsgCancelOrderGroupResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgCancelOrderGroupResponse)(x)
+}
+
+func (x *MsgCancelOrderGroupResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_elys_tradeshield_tx_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgCancelOrderGroupResponse_messageType fastReflection_MsgCancelOrderGroupResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgCancelOrderGroupResponse_messageType{}
+
+type fastReflection_MsgCancelOrderGroupResponse_messageType struct{}
+
+func (x fastReflection_MsgCancelOrderGroupResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgCancelOrderGroupResponse)(nil)
+}
+func (x fastReflection_MsgCancelOrderGroupResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgCancelOrderGroupResponse)
+}
+func (x fastReflection_MsgCancelOrderGroupResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCancelOrderGroupResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgCancelOrderGroupResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCancelOrderGroupResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgCancelOrderGroupResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgCancelOrderGroupResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgCancelOrderGroupResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgCancelOrderGroupResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgCancelOrderGroupResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgCancelOrderGroupResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgCancelOrderGroupResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgCancelOrderGroupResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.MsgCancelOrderGroupResponse"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.MsgCancelOrderGroupResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCancelOrderGroupResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.MsgCancelOrderGroupResponse"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.MsgCancelOrderGroupResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgCancelOrderGroupResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.MsgCancelOrderGroupResponse"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.MsgCancelOrderGroupResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCancelOrderGroupResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.MsgCancelOrderGroupResponse"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.MsgCancelOrderGroupResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCancelOrderGroupResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.MsgCancelOrderGroupResponse"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.MsgCancelOrderGroupResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgCancelOrderGroupResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.MsgCancelOrderGroupResponse"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.MsgCancelOrderGroupResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgCancelOrderGroupResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in elys.tradeshield.MsgCancelOrderGroupResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgCancelOrderGroupResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCancelOrderGroupResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgCancelOrderGroupResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgCancelOrderGroupResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgCancelOrderGroupResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgCancelOrderGroupResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgCancelOrderGroupResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCancelOrderGroupResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCancelOrderGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgCreateScheduledOrder               protoreflect.MessageDescriptor
+	fd_MsgCreateScheduledOrder_owner_address protoreflect.FieldDescriptor
+	fd_MsgCreateScheduledOrder_amount        protoreflect.FieldDescriptor
+	fd_MsgCreateScheduledOrder_target_denom  protoreflect.FieldDescriptor
+	fd_MsgCreateScheduledOrder_slice_count   protoreflect.FieldDescriptor
+	fd_MsgCreateScheduledOrder_interval      protoreflect.FieldDescriptor
+	fd_MsgCreateScheduledOrder_interval_unit protoreflect.FieldDescriptor
+	fd_MsgCreateScheduledOrder_max_slippage  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_elys_tradeshield_tx_proto_init()
+	md_MsgCreateScheduledOrder = File_elys_tradeshield_tx_proto.Messages().ByName("MsgCreateScheduledOrder")
+	fd_MsgCreateScheduledOrder_owner_address = md_MsgCreateScheduledOrder.Fields().ByName("owner_address")
+	fd_MsgCreateScheduledOrder_amount = md_MsgCreateScheduledOrder.Fields().ByName("amount")
+	fd_MsgCreateScheduledOrder_target_denom = md_MsgCreateScheduledOrder.Fields().ByName("target_denom")
+	fd_MsgCreateScheduledOrder_slice_count = md_MsgCreateScheduledOrder.Fields().ByName("slice_count")
+	fd_MsgCreateScheduledOrder_interval = md_MsgCreateScheduledOrder.Fields().ByName("interval")
+	fd_MsgCreateScheduledOrder_interval_unit = md_MsgCreateScheduledOrder.Fields().ByName("interval_unit")
+	fd_MsgCreateScheduledOrder_max_slippage = md_MsgCreateScheduledOrder.Fields().ByName("max_slippage")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgCreateScheduledOrder)(nil)
+
+type fastReflection_MsgCreateScheduledOrder MsgCreateScheduledOrder
+
+func (x *MsgCreateScheduledOrder) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgCreateScheduledOrder)(x)
+}
+
+func (x *MsgCreateScheduledOrder) slowProtoReflect() protoreflect.Message {
+	mi := &file_elys_tradeshield_tx_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgCreateScheduledOrder_messageType fastReflection_MsgCreateScheduledOrder_messageType
+var _ protoreflect.MessageType = fastReflection_MsgCreateScheduledOrder_messageType{}
+
+type fastReflection_MsgCreateScheduledOrder_messageType struct{}
+
+func (x fastReflection_MsgCreateScheduledOrder_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgCreateScheduledOrder)(nil)
+}
+func (x fastReflection_MsgCreateScheduledOrder_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgCreateScheduledOrder)
+}
+func (x fastReflection_MsgCreateScheduledOrder_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCreateScheduledOrder
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgCreateScheduledOrder) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCreateScheduledOrder
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgCreateScheduledOrder) Type() protoreflect.MessageType {
+	return _fastReflection_MsgCreateScheduledOrder_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgCreateScheduledOrder) New() protoreflect.Message {
+	return new(fastReflection_MsgCreateScheduledOrder)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgCreateScheduledOrder) Interface() protoreflect.ProtoMessage {
+	return (*MsgCreateScheduledOrder)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgCreateScheduledOrder) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.OwnerAddress != "" {
+		value := protoreflect.ValueOfString(x.OwnerAddress)
+		if !f(fd_MsgCreateScheduledOrder_owner_address, value) {
+			return
+		}
+	}
+	if x.Amount != nil {
+		value := protoreflect.ValueOfMessage(x.Amount.ProtoReflect())
+		if !f(fd_MsgCreateScheduledOrder_amount, value) {
+			return
+		}
+	}
+	if x.TargetDenom != "" {
+		value := protoreflect.ValueOfString(x.TargetDenom)
+		if !f(fd_MsgCreateScheduledOrder_target_denom, value) {
+			return
+		}
+	}
+	if x.SliceCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SliceCount)
+		if !f(fd_MsgCreateScheduledOrder_slice_count, value) {
+			return
+		}
+	}
+	if x.Interval != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Interval)
+		if !f(fd_MsgCreateScheduledOrder_interval, value) {
+			return
+		}
+	}
+	if x.IntervalUnit != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.IntervalUnit))
+		if !f(fd_MsgCreateScheduledOrder_interval_unit, value) {
+			return
+		}
+	}
+	if x.MaxSlippage != "" {
+		value := protoreflect.ValueOfString(x.MaxSlippage)
+		if !f(fd_MsgCreateScheduledOrder_max_slippage, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgCreateScheduledOrder) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "elys.tradeshield.MsgCreateScheduledOrder.owner_address":
+		return x.OwnerAddress != ""
+	case "elys.tradeshield.MsgCreateScheduledOrder.amount":
+		return x.Amount != nil
+	case "elys.tradeshield.MsgCreateScheduledOrder.target_denom":
+		return x.TargetDenom != ""
+	case "elys.tradeshield.MsgCreateScheduledOrder.slice_count":
+		return x.SliceCount != uint64(0)
+	case "elys.tradeshield.MsgCreateScheduledOrder.interval":
+		return x.Interval != uint64(0)
+	case "elys.tradeshield.MsgCreateScheduledOrder.interval_unit":
+		return x.IntervalUnit != 0
+	case "elys.tradeshield.MsgCreateScheduledOrder.max_slippage":
+		return x.MaxSlippage != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.MsgCreateScheduledOrder"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.MsgCreateScheduledOrder does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCreateScheduledOrder) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "elys.tradeshield.MsgCreateScheduledOrder.owner_address":
+		x.OwnerAddress = ""
+	case "elys.tradeshield.MsgCreateScheduledOrder.amount":
+		x.Amount = nil
+	case "elys.tradeshield.MsgCreateScheduledOrder.target_denom":
+		x.TargetDenom = ""
+	case "elys.tradeshield.MsgCreateScheduledOrder.slice_count":
+		x.SliceCount = uint64(0)
+	case "elys.tradeshield.MsgCreateScheduledOrder.interval":
+		x.Interval = uint64(0)
+	case "elys.tradeshield.MsgCreateScheduledOrder.interval_unit":
+		x.IntervalUnit = 0
+	case "elys.tradeshield.MsgCreateScheduledOrder.max_slippage":
+		x.MaxSlippage = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.MsgCreateScheduledOrder"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.MsgCreateScheduledOrder does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgCreateScheduledOrder) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "elys.tradeshield.MsgCreateScheduledOrder.owner_address":
+		value := x.OwnerAddress
+		return protoreflect.ValueOfString(value)
+	case "elys.tradeshield.MsgCreateScheduledOrder.amount":
+		value := x.Amount
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "elys.tradeshield.MsgCreateScheduledOrder.target_denom":
+		value := x.TargetDenom
+		return protoreflect.ValueOfString(value)
+	case "elys.tradeshield.MsgCreateScheduledOrder.slice_count":
+		value := x.SliceCount
+		return protoreflect.ValueOfUint64(value)
+	case "elys.tradeshield.MsgCreateScheduledOrder.interval":
+		value := x.Interval
+		return protoreflect.ValueOfUint64(value)
+	case "elys.tradeshield.MsgCreateScheduledOrder.interval_unit":
+		value := x.IntervalUnit
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "elys.tradeshield.MsgCreateScheduledOrder.max_slippage":
+		value := x.MaxSlippage
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.MsgCreateScheduledOrder"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.MsgCreateScheduledOrder does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCreateScheduledOrder) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "elys.tradeshield.MsgCreateScheduledOrder.owner_address":
+		x.OwnerAddress = value.Interface().(string)
+	case "elys.tradeshield.MsgCreateScheduledOrder.amount":
+		x.Amount = value.Message().Interface().(*v1beta1.Coin)
+	case "elys.tradeshield.MsgCreateScheduledOrder.target_denom":
+		x.TargetDenom = value.Interface().(string)
+	case "elys.tradeshield.MsgCreateScheduledOrder.slice_count":
+		x.SliceCount = value.Uint()
+	case "elys.tradeshield.MsgCreateScheduledOrder.interval":
+		x.Interval = value.Uint()
+	case "elys.tradeshield.MsgCreateScheduledOrder.interval_unit":
+		x.IntervalUnit = (ScheduleIntervalUnit)(value.Enum())
+	case "elys.tradeshield.MsgCreateScheduledOrder.max_slippage":
+		x.MaxSlippage = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.MsgCreateScheduledOrder"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.MsgCreateScheduledOrder does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCreateScheduledOrder) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "elys.tradeshield.MsgCreateScheduledOrder.amount":
+		if x.Amount == nil {
+			x.Amount = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.Amount.ProtoReflect())
+	case "elys.tradeshield.MsgCreateScheduledOrder.owner_address":
+		panic(fmt.Errorf("field owner_address of message elys.tradeshield.MsgCreateScheduledOrder is not mutable"))
+	case "elys.tradeshield.MsgCreateScheduledOrder.target_denom":
+		panic(fmt.Errorf("field target_denom of message elys.tradeshield.MsgCreateScheduledOrder is not mutable"))
+	case "elys.tradeshield.MsgCreateScheduledOrder.slice_count":
+		panic(fmt.Errorf("field slice_count of message elys.tradeshield.MsgCreateScheduledOrder is not mutable"))
+	case "elys.tradeshield.MsgCreateScheduledOrder.interval":
+		panic(fmt.Errorf("field interval of message elys.tradeshield.MsgCreateScheduledOrder is not mutable"))
+	case "elys.tradeshield.MsgCreateScheduledOrder.interval_unit":
+		panic(fmt.Errorf("field interval_unit of message elys.tradeshield.MsgCreateScheduledOrder is not mutable"))
+	case "elys.tradeshield.MsgCreateScheduledOrder.max_slippage":
+		panic(fmt.Errorf("field max_slippage of message elys.tradeshield.MsgCreateScheduledOrder is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.MsgCreateScheduledOrder"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.MsgCreateScheduledOrder does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgCreateScheduledOrder) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "elys.tradeshield.MsgCreateScheduledOrder.owner_address":
+		return protoreflect.ValueOfString("")
+	case "elys.tradeshield.MsgCreateScheduledOrder.amount":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "elys.tradeshield.MsgCreateScheduledOrder.target_denom":
+		return protoreflect.ValueOfString("")
+	case "elys.tradeshield.MsgCreateScheduledOrder.slice_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "elys.tradeshield.MsgCreateScheduledOrder.interval":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "elys.tradeshield.MsgCreateScheduledOrder.interval_unit":
+		return protoreflect.ValueOfEnum(0)
+	case "elys.tradeshield.MsgCreateScheduledOrder.max_slippage":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.MsgCreateScheduledOrder"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.MsgCreateScheduledOrder does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgCreateScheduledOrder) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in elys.tradeshield.MsgCreateScheduledOrder", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgCreateScheduledOrder) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCreateScheduledOrder) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgCreateScheduledOrder) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgCreateScheduledOrder) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgCreateScheduledOrder)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.OwnerAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Amount != nil {
+			l = options.Size(x.Amount)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.TargetDenom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.SliceCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.SliceCount))
+		}
+		if x.Interval != 0 {
+			n += 1 + runtime.Sov(uint64(x.Interval))
+		}
+		if x.IntervalUnit != 0 {
+			n += 1 + runtime.Sov(uint64(x.IntervalUnit))
+		}
+		l = len(x.MaxSlippage)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgCreateScheduledOrder)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.MaxSlippage) > 0 {
+			i -= len(x.MaxSlippage)
+			copy(dAtA[i:], x.MaxSlippage)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxSlippage)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if x.IntervalUnit != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.IntervalUnit))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.Interval != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Interval))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.SliceCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SliceCount))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.TargetDenom) > 0 {
+			i -= len(x.TargetDenom)
+			copy(dAtA[i:], x.TargetDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TargetDenom)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Amount != nil {
+			encoded, err := options.Marshal(x.Amount)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.OwnerAddress) > 0 {
+			i -= len(x.OwnerAddress)
+			copy(dAtA[i:], x.OwnerAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OwnerAddress)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgCreateScheduledOrder)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCreateScheduledOrder: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCreateScheduledOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.OwnerAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Amount == nil {
+					x.Amount = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Amount); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TargetDenom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TargetDenom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SliceCount", wireType)
+				}
+				x.SliceCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SliceCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+				}
+				x.Interval = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Interval |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IntervalUnit", wireType)
+				}
+				x.IntervalUnit = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.IntervalUnit |= ScheduleIntervalUnit(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxSlippage", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxSlippage = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgCreateScheduledOrderResponse          protoreflect.MessageDescriptor
+	fd_MsgCreateScheduledOrderResponse_order_id protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_elys_tradeshield_tx_proto_init()
+	md_MsgCreateScheduledOrderResponse = File_elys_tradeshield_tx_proto.Messages().ByName("MsgCreateScheduledOrderResponse")
+	fd_MsgCreateScheduledOrderResponse_order_id = md_MsgCreateScheduledOrderResponse.Fields().ByName("order_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgCreateScheduledOrderResponse)(nil)
+
+type fastReflection_MsgCreateScheduledOrderResponse MsgCreateScheduledOrderResponse
+
+func (x *MsgCreateScheduledOrderResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgCreateScheduledOrderResponse)(x)
+}
+
+func (x *MsgCreateScheduledOrderResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_elys_tradeshield_tx_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgCreateScheduledOrderResponse_messageType fastReflection_MsgCreateScheduledOrderResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgCreateScheduledOrderResponse_messageType{}
+
+type fastReflection_MsgCreateScheduledOrderResponse_messageType struct{}
+
+func (x fastReflection_MsgCreateScheduledOrderResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgCreateScheduledOrderResponse)(nil)
+}
+func (x fastReflection_MsgCreateScheduledOrderResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgCreateScheduledOrderResponse)
+}
+func (x fastReflection_MsgCreateScheduledOrderResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCreateScheduledOrderResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgCreateScheduledOrderResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCreateScheduledOrderResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgCreateScheduledOrderResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgCreateScheduledOrderResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgCreateScheduledOrderResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgCreateScheduledOrderResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgCreateScheduledOrderResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgCreateScheduledOrderResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgCreateScheduledOrderResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.OrderId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.OrderId)
+		if !f(fd_MsgCreateScheduledOrderResponse_order_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgCreateScheduledOrderResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "elys.tradeshield.MsgCreateScheduledOrderResponse.order_id":
+		return x.OrderId != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.MsgCreateScheduledOrderResponse"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.MsgCreateScheduledOrderResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCreateScheduledOrderResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "elys.tradeshield.MsgCreateScheduledOrderResponse.order_id":
+		x.OrderId = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.MsgCreateScheduledOrderResponse"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.MsgCreateScheduledOrderResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgCreateScheduledOrderResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "elys.tradeshield.MsgCreateScheduledOrderResponse.order_id":
+		value := x.OrderId
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.MsgCreateScheduledOrderResponse"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.MsgCreateScheduledOrderResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCreateScheduledOrderResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "elys.tradeshield.MsgCreateScheduledOrderResponse.order_id":
+		x.OrderId = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.MsgCreateScheduledOrderResponse"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.MsgCreateScheduledOrderResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCreateScheduledOrderResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "elys.tradeshield.MsgCreateScheduledOrderResponse.order_id":
+		panic(fmt.Errorf("field order_id of message elys.tradeshield.MsgCreateScheduledOrderResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.MsgCreateScheduledOrderResponse"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.MsgCreateScheduledOrderResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgCreateScheduledOrderResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "elys.tradeshield.MsgCreateScheduledOrderResponse.order_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.MsgCreateScheduledOrderResponse"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.MsgCreateScheduledOrderResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgCreateScheduledOrderResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in elys.tradeshield.MsgCreateScheduledOrderResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgCreateScheduledOrderResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCreateScheduledOrderResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgCreateScheduledOrderResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgCreateScheduledOrderResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgCreateScheduledOrderResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.OrderId != 0 {
+			n += 1 + runtime.Sov(uint64(x.OrderId))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgCreateScheduledOrderResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.OrderId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OrderId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgCreateScheduledOrderResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCreateScheduledOrderResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCreateScheduledOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+				}
+				x.OrderId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OrderId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgCancelScheduledOrder               protoreflect.MessageDescriptor
+	fd_MsgCancelScheduledOrder_owner_address protoreflect.FieldDescriptor
+	fd_MsgCancelScheduledOrder_order_id      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_elys_tradeshield_tx_proto_init()
+	md_MsgCancelScheduledOrder = File_elys_tradeshield_tx_proto.Messages().ByName("MsgCancelScheduledOrder")
+	fd_MsgCancelScheduledOrder_owner_address = md_MsgCancelScheduledOrder.Fields().ByName("owner_address")
+	fd_MsgCancelScheduledOrder_order_id = md_MsgCancelScheduledOrder.Fields().ByName("order_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgCancelScheduledOrder)(nil)
+
+type fastReflection_MsgCancelScheduledOrder MsgCancelScheduledOrder
+
+func (x *MsgCancelScheduledOrder) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgCancelScheduledOrder)(x)
+}
+
+func (x *MsgCancelScheduledOrder) slowProtoReflect() protoreflect.Message {
+	mi := &file_elys_tradeshield_tx_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgCancelScheduledOrder_messageType fastReflection_MsgCancelScheduledOrder_messageType
+var _ protoreflect.MessageType = fastReflection_MsgCancelScheduledOrder_messageType{}
+
+type fastReflection_MsgCancelScheduledOrder_messageType struct{}
+
+func (x fastReflection_MsgCancelScheduledOrder_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgCancelScheduledOrder)(nil)
+}
+func (x fastReflection_MsgCancelScheduledOrder_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgCancelScheduledOrder)
+}
+func (x fastReflection_MsgCancelScheduledOrder_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCancelScheduledOrder
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgCancelScheduledOrder) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCancelScheduledOrder
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgCancelScheduledOrder) Type() protoreflect.MessageType {
+	return _fastReflection_MsgCancelScheduledOrder_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgCancelScheduledOrder) New() protoreflect.Message {
+	return new(fastReflection_MsgCancelScheduledOrder)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgCancelScheduledOrder) Interface() protoreflect.ProtoMessage {
+	return (*MsgCancelScheduledOrder)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgCancelScheduledOrder) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.OwnerAddress != "" {
+		value := protoreflect.ValueOfString(x.OwnerAddress)
+		if !f(fd_MsgCancelScheduledOrder_owner_address, value) {
+			return
+		}
+	}
+	if x.OrderId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.OrderId)
+		if !f(fd_MsgCancelScheduledOrder_order_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgCancelScheduledOrder) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "elys.tradeshield.MsgCancelScheduledOrder.owner_address":
+		return x.OwnerAddress != ""
+	case "elys.tradeshield.MsgCancelScheduledOrder.order_id":
+		return x.OrderId != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.MsgCancelScheduledOrder"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.MsgCancelScheduledOrder does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCancelScheduledOrder) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "elys.tradeshield.MsgCancelScheduledOrder.owner_address":
+		x.OwnerAddress = ""
+	case "elys.tradeshield.MsgCancelScheduledOrder.order_id":
+		x.OrderId = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.MsgCancelScheduledOrder"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.MsgCancelScheduledOrder does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgCancelScheduledOrder) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "elys.tradeshield.MsgCancelScheduledOrder.owner_address":
+		value := x.OwnerAddress
+		return protoreflect.ValueOfString(value)
+	case "elys.tradeshield.MsgCancelScheduledOrder.order_id":
+		value := x.OrderId
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.MsgCancelScheduledOrder"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.MsgCancelScheduledOrder does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCancelScheduledOrder) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "elys.tradeshield.MsgCancelScheduledOrder.owner_address":
+		x.OwnerAddress = value.Interface().(string)
+	case "elys.tradeshield.MsgCancelScheduledOrder.order_id":
+		x.OrderId = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.MsgCancelScheduledOrder"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.MsgCancelScheduledOrder does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCancelScheduledOrder) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "elys.tradeshield.MsgCancelScheduledOrder.owner_address":
+		panic(fmt.Errorf("field owner_address of message elys.tradeshield.MsgCancelScheduledOrder is not mutable"))
+	case "elys.tradeshield.MsgCancelScheduledOrder.order_id":
+		panic(fmt.Errorf("field order_id of message elys.tradeshield.MsgCancelScheduledOrder is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.MsgCancelScheduledOrder"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.MsgCancelScheduledOrder does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgCancelScheduledOrder) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "elys.tradeshield.MsgCancelScheduledOrder.owner_address":
+		return protoreflect.ValueOfString("")
+	case "elys.tradeshield.MsgCancelScheduledOrder.order_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.MsgCancelScheduledOrder"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.MsgCancelScheduledOrder does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgCancelScheduledOrder) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in elys.tradeshield.MsgCancelScheduledOrder", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgCancelScheduledOrder) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCancelScheduledOrder) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgCancelScheduledOrder) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgCancelScheduledOrder) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgCancelScheduledOrder)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.OwnerAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.OrderId != 0 {
+			n += 1 + runtime.Sov(uint64(x.OrderId))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgCancelScheduledOrder)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.OrderId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OrderId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.OwnerAddress) > 0 {
+			i -= len(x.OwnerAddress)
+			copy(dAtA[i:], x.OwnerAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OwnerAddress)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgCancelScheduledOrder)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCancelScheduledOrder: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCancelScheduledOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.OwnerAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+				}
+				x.OrderId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OrderId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgCancelScheduledOrderResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_elys_tradeshield_tx_proto_init()
+	md_MsgCancelScheduledOrderResponse = File_elys_tradeshield_tx_proto.Messages().ByName("MsgCancelScheduledOrderResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgCancelScheduledOrderResponse)(nil)
+
+type fastReflection_MsgCancelScheduledOrderResponse MsgCancelScheduledOrderResponse
+
+func (x *MsgCancelScheduledOrderResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgCancelScheduledOrderResponse)(x)
+}
+
+func (x *MsgCancelScheduledOrderResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_elys_tradeshield_tx_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgCancelScheduledOrderResponse_messageType fastReflection_MsgCancelScheduledOrderResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgCancelScheduledOrderResponse_messageType{}
+
+type fastReflection_MsgCancelScheduledOrderResponse_messageType struct{}
+
+func (x fastReflection_MsgCancelScheduledOrderResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgCancelScheduledOrderResponse)(nil)
+}
+func (x fastReflection_MsgCancelScheduledOrderResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgCancelScheduledOrderResponse)
+}
+func (x fastReflection_MsgCancelScheduledOrderResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCancelScheduledOrderResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgCancelScheduledOrderResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCancelScheduledOrderResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgCancelScheduledOrderResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgCancelScheduledOrderResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgCancelScheduledOrderResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgCancelScheduledOrderResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgCancelScheduledOrderResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgCancelScheduledOrderResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgCancelScheduledOrderResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgCancelScheduledOrderResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.MsgCancelScheduledOrderResponse"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.MsgCancelScheduledOrderResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCancelScheduledOrderResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.MsgCancelScheduledOrderResponse"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.MsgCancelScheduledOrderResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgCancelScheduledOrderResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.MsgCancelScheduledOrderResponse"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.MsgCancelScheduledOrderResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCancelScheduledOrderResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.MsgCancelScheduledOrderResponse"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.MsgCancelScheduledOrderResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCancelScheduledOrderResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.MsgCancelScheduledOrderResponse"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.MsgCancelScheduledOrderResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgCancelScheduledOrderResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.MsgCancelScheduledOrderResponse"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.MsgCancelScheduledOrderResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgCancelScheduledOrderResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in elys.tradeshield.MsgCancelScheduledOrderResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgCancelScheduledOrderResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCancelScheduledOrderResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgCancelScheduledOrderResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgCancelScheduledOrderResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgCancelScheduledOrderResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgCancelScheduledOrderResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgCancelScheduledOrderResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCancelScheduledOrderResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCancelScheduledOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: elys/tradeshield/tx.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type MsgCreateSpotOrder struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderType        SpotOrderType `protobuf:"varint,1,opt,name=order_type,json=orderType,proto3,enum=elys.tradeshield.SpotOrderType" json:"order_type,omitempty"`
+	OrderPrice       *OrderPrice   `protobuf:"bytes,2,opt,name=order_price,json=orderPrice,proto3" json:"order_price,omitempty"`
+	OrderAmount      *v1beta1.Coin `protobuf:"bytes,3,opt,name=order_amount,json=orderAmount,proto3" json:"order_amount,omitempty"`
+	OwnerAddress     string        `protobuf:"bytes,4,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
+	OrderTargetDenom string        `protobuf:"bytes,5,opt,name=order_target_denom,json=orderTargetDenom,proto3" json:"order_target_denom,omitempty"`
+	TimeInForce      TimeInForce   `protobuf:"varint,6,opt,name=time_in_force,json=timeInForce,proto3,enum=elys.tradeshield.TimeInForce" json:"time_in_force,omitempty"`
+	// Height and/or timestamp at which a GTT order expires
+	Expiry *Date `protobuf:"bytes,7,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	// Trail of TRAILINGSTOP orders, their rate is set from the market price
+	TrailingStop *TrailingStop `protobuf:"bytes,8,opt,name=trailing_stop,json=trailingStop,proto3" json:"trailing_stop,omitempty"`
+}
+
+func (x *MsgCreateSpotOrder) Reset() {
+	*x = MsgCreateSpotOrder{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_elys_tradeshield_tx_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgCreateSpotOrder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgCreateSpotOrder) ProtoMessage() {}
+
+// Deprecated: Use MsgCreateSpotOrder.ProtoReflect.Descriptor instead.
+func (*MsgCreateSpotOrder) Descriptor() ([]byte, []int) {
+	return file_elys_tradeshield_tx_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MsgCreateSpotOrder) GetOrderType() SpotOrderType {
+	if x != nil {
+		return x.OrderType
+	}
+	return SpotOrderType_STOPLOSS
+}
+
+func (x *MsgCreateSpotOrder) GetOrderPrice() *OrderPrice {
+	if x != nil {
+		return x.OrderPrice
+	}
+	return nil
+}
+
+func (x *MsgCreateSpotOrder) GetOrderAmount() *v1beta1.Coin {
+	if x != nil {
+		return x.OrderAmount
+	}
+	return nil
+}
+
+func (x *MsgCreateSpotOrder) GetOwnerAddress() string {
+	if x != nil {
+		return x.OwnerAddress
+	}
+	return ""
+}
+
+func (x *MsgCreateSpotOrder) GetOrderTargetDenom() string {
+	if x != nil {
+		return x.OrderTargetDenom
+	}
+	return ""
+}
+
+func (x *MsgCreateSpotOrder) GetTimeInForce() TimeInForce {
+	if x != nil {
+		return x.TimeInForce
+	}
+	return TimeInForce_GTC
+}
+
+func (x *MsgCreateSpotOrder) GetExpiry() *Date {
+	if x != nil {
+		return x.Expiry
+	}
+	return nil
+}
+
+func (x *MsgCreateSpotOrder) GetTrailingStop() *TrailingStop {
+	if x != nil {
+		return x.TrailingStop
+	}
+	return nil
+}
+
+type MsgCreateSpotOrderResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderId uint64 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+}
+
+func (x *MsgCreateSpotOrderResponse) Reset() {
+	*x = MsgCreateSpotOrderResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_elys_tradeshield_tx_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgCreateSpotOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgCreateSpotOrderResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgCreateSpotOrderResponse.ProtoReflect.Descriptor instead.
+func (*MsgCreateSpotOrderResponse) Descriptor() ([]byte, []int) {
 	return file_elys_tradeshield_tx_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *MsgCreateSpotOrderResponse) GetOrderId() uint64 {
+func (x *MsgCreateSpotOrderResponse) GetOrderId() uint64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+type MsgUpdateSpotOrder struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OwnerAddress string      `protobuf:"bytes,1,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
+	OrderId      uint64      `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	OrderPrice   *OrderPrice `protobuf:"bytes,3,opt,name=order_price,json=orderPrice,proto3" json:"order_price,omitempty"`
+}
+
+func (x *MsgUpdateSpotOrder) Reset() {
+	*x = MsgUpdateSpotOrder{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_elys_tradeshield_tx_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgUpdateSpotOrder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgUpdateSpotOrder) ProtoMessage() {}
+
+// Deprecated: Use MsgUpdateSpotOrder.ProtoReflect.Descriptor instead.
+func (*MsgUpdateSpotOrder) Descriptor() ([]byte, []int) {
+	return file_elys_tradeshield_tx_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MsgUpdateSpotOrder) GetOwnerAddress() string {
+	if x != nil {
+		return x.OwnerAddress
+	}
+	return ""
+}
+
+func (x *MsgUpdateSpotOrder) GetOrderId() uint64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *MsgUpdateSpotOrder) GetOrderPrice() *OrderPrice {
+	if x != nil {
+		return x.OrderPrice
+	}
+	return nil
+}
+
+type MsgUpdateSpotOrderResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgUpdateSpotOrderResponse) Reset() {
+	*x = MsgUpdateSpotOrderResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_elys_tradeshield_tx_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgUpdateSpotOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgUpdateSpotOrderResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgUpdateSpotOrderResponse.ProtoReflect.Descriptor instead.
+func (*MsgUpdateSpotOrderResponse) Descriptor() ([]byte, []int) {
+	return file_elys_tradeshield_tx_proto_rawDescGZIP(), []int{3}
+}
+
+type MsgCancelSpotOrder struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OwnerAddress string `protobuf:"bytes,1,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
+	OrderId      uint64 `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+}
+
+func (x *MsgCancelSpotOrder) Reset() {
+	*x = MsgCancelSpotOrder{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_elys_tradeshield_tx_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgCancelSpotOrder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgCancelSpotOrder) ProtoMessage() {}
+
+// Deprecated: Use MsgCancelSpotOrder.ProtoReflect.Descriptor instead.
+func (*MsgCancelSpotOrder) Descriptor() ([]byte, []int) {
+	return file_elys_tradeshield_tx_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *MsgCancelSpotOrder) GetOwnerAddress() string {
+	if x != nil {
+		return x.OwnerAddress
+	}
+	return ""
+}
+
+func (x *MsgCancelSpotOrder) GetOrderId() uint64 {
 	if x != nil {
 		return x.OrderId
 	}
 	return 0
 }
 
-type MsgUpdateSpotOrder struct {
+type MsgCancelSpotOrderResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OwnerAddress string      `protobuf:"bytes,1,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
-	OrderId      uint64      `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	OrderPrice   *OrderPrice `protobuf:"bytes,3,opt,name=order_price,json=orderPrice,proto3" json:"order_price,omitempty"`
+	OrderId uint64 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
 }
 
-func (x *MsgUpdateSpotOrder) Reset() {
-	*x = MsgUpdateSpotOrder{}
+func (x *MsgCancelSpotOrderResponse) Reset() {
+	*x = MsgCancelSpotOrderResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_elys_tradeshield_tx_proto_msgTypes[2]
+		mi := &file_elys_tradeshield_tx_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgUpdateSpotOrder) String() string {
+func (x *MsgCancelSpotOrderResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgUpdateSpotOrder) ProtoMessage() {}
+func (*MsgCancelSpotOrderResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgUpdateSpotOrder.ProtoReflect.Descriptor instead.
-func (*MsgUpdateSpotOrder) Descriptor() ([]byte, []int) {
-	return file_elys_tradeshield_tx_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use MsgCancelSpotOrderResponse.ProtoReflect.Descriptor instead.
+func (*MsgCancelSpotOrderResponse) Descriptor() ([]byte, []int) {
+	return file_elys_tradeshield_tx_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *MsgCancelSpotOrderResponse) GetOrderId() uint64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+type MsgCancelSpotOrders struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Creator      string   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	SpotOrderIds []uint64 `protobuf:"varint,2,rep,packed,name=spot_order_ids,json=spotOrderIds,proto3" json:"spot_order_ids,omitempty"`
+}
+
+func (x *MsgCancelSpotOrders) Reset() {
+	*x = MsgCancelSpotOrders{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_elys_tradeshield_tx_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgCancelSpotOrders) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgCancelSpotOrders) ProtoMessage() {}
+
+// Deprecated: Use MsgCancelSpotOrders.ProtoReflect.Descriptor instead.
+func (*MsgCancelSpotOrders) Descriptor() ([]byte, []int) {
+	return file_elys_tradeshield_tx_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *MsgCancelSpotOrders) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *MsgCancelSpotOrders) GetSpotOrderIds() []uint64 {
+	if x != nil {
+		return x.SpotOrderIds
+	}
+	return nil
+}
+
+type MsgCancelSpotOrdersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgCancelSpotOrdersResponse) Reset() {
+	*x = MsgCancelSpotOrdersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_elys_tradeshield_tx_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgCancelSpotOrdersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgCancelSpotOrdersResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgCancelSpotOrdersResponse.ProtoReflect.Descriptor instead.
+func (*MsgCancelSpotOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_elys_tradeshield_tx_proto_rawDescGZIP(), []int{7}
+}
+
+type MsgCreatePerpetualOpenOrder struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OwnerAddress    string            `protobuf:"bytes,1,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
+	TriggerPrice    *TriggerPrice     `protobuf:"bytes,2,opt,name=trigger_price,json=triggerPrice,proto3" json:"trigger_price,omitempty"`
+	Collateral      *v1beta1.Coin     `protobuf:"bytes,3,opt,name=collateral,proto3" json:"collateral,omitempty"`
+	TradingAsset    string            `protobuf:"bytes,4,opt,name=trading_asset,json=tradingAsset,proto3" json:"trading_asset,omitempty"`
+	Position        PerpetualPosition `protobuf:"varint,5,opt,name=position,proto3,enum=elys.tradeshield.PerpetualPosition" json:"position,omitempty"`
+	Leverage        string            `protobuf:"bytes,6,opt,name=leverage,proto3" json:"leverage,omitempty"`
+	TakeProfitPrice string            `protobuf:"bytes,7,opt,name=take_profit_price,json=takeProfitPrice,proto3" json:"take_profit_price,omitempty"`
+	StopLossPrice   string            `protobuf:"bytes,8,opt,name=stop_loss_price,json=stopLossPrice,proto3" json:"stop_loss_price,omitempty"`
+	PoolId          uint64            `protobuf:"varint,9,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	TimeInForce     TimeInForce       `protobuf:"varint,10,opt,name=time_in_force,json=timeInForce,proto3,enum=elys.tradeshield.TimeInForce" json:"time_in_force,omitempty"`
+	// Height and/or timestamp at which a GTT order expires
+	Expiry *Date `protobuf:"bytes,11,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	// Take profit and stop loss close orders created once the position is
+	// opened
+	BracketOrders []*BracketOrder `protobuf:"bytes,12,rep,name=bracket_orders,json=bracketOrders,proto3" json:"bracket_orders,omitempty"`
+}
+
+func (x *MsgCreatePerpetualOpenOrder) Reset() {
+	*x = MsgCreatePerpetualOpenOrder{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_elys_tradeshield_tx_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgCreatePerpetualOpenOrder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgCreatePerpetualOpenOrder) ProtoMessage() {}
+
+// Deprecated: Use MsgCreatePerpetualOpenOrder.ProtoReflect.Descriptor instead.
+func (*MsgCreatePerpetualOpenOrder) Descriptor() ([]byte, []int) {
+	return file_elys_tradeshield_tx_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *MsgCreatePerpetualOpenOrder) GetOwnerAddress() string {
+	if x != nil {
+		return x.OwnerAddress
+	}
+	return ""
+}
+
+func (x *MsgCreatePerpetualOpenOrder) GetTriggerPrice() *TriggerPrice {
+	if x != nil {
+		return x.TriggerPrice
+	}
+	return nil
+}
+
+func (x *MsgCreatePerpetualOpenOrder) GetCollateral() *v1beta1.Coin {
+	if x != nil {
+		return x.Collateral
+	}
+	return nil
+}
+
+func (x *MsgCreatePerpetualOpenOrder) GetTradingAsset() string {
+	if x != nil {
+		return x.TradingAsset
+	}
+	return ""
+}
+
+func (x *MsgCreatePerpetualOpenOrder) GetPosition() PerpetualPosition {
+	if x != nil {
+		return x.Position
+	}
+	return PerpetualPosition_UNSPECIFIED
+}
+
+func (x *MsgCreatePerpetualOpenOrder) GetLeverage() string {
+	if x != nil {
+		return x.Leverage
+	}
+	return ""
+}
+
+func (x *MsgCreatePerpetualOpenOrder) GetTakeProfitPrice() string {
+	if x != nil {
+		return x.TakeProfitPrice
+	}
+	return ""
+}
+
+func (x *MsgCreatePerpetualOpenOrder) GetStopLossPrice() string {
+	if x != nil {
+		return x.StopLossPrice
+	}
+	return ""
+}
+
+func (x *MsgCreatePerpetualOpenOrder) GetPoolId() uint64 {
+	if x != nil {
+		return x.PoolId
+	}
+	return 0
 }
 
-func (x *MsgUpdateSpotOrder) GetOwnerAddress() string {
+func (x *MsgCreatePerpetualOpenOrder) GetTimeInForce() TimeInForce {
 	if x != nil {
-		return x.OwnerAddress
+		return x.TimeInForce
 	}
-	return ""
+	return TimeInForce_GTC
 }
 
-func (x *MsgUpdateSpotOrder) GetOrderId() uint64 {
+func (x *MsgCreatePerpetualOpenOrder) GetExpiry() *Date {
 	if x != nil {
-		return x.OrderId
+		return x.Expiry
 	}
-	return 0
+	return nil
 }
 
-func (x *MsgUpdateSpotOrder) GetOrderPrice() *OrderPrice {
+func (x *MsgCreatePerpetualOpenOrder) GetBracketOrders() []*BracketOrder {
 	if x != nil {
-		return x.OrderPrice
+		return x.BracketOrders
 	}
 	return nil
 }
 
-type MsgUpdateSpotOrderResponse struct {
+type MsgCreatePerpetualOpenOrderResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	OrderId uint64 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
 }
 
-func (x *MsgUpdateSpotOrderResponse) Reset() {
-	*x = MsgUpdateSpotOrderResponse{}
+func (x *MsgCreatePerpetualOpenOrderResponse) Reset() {
+	*x = MsgCreatePerpetualOpenOrderResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_elys_tradeshield_tx_proto_msgTypes[3]
+		mi := &file_elys_tradeshield_tx_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgUpdateSpotOrderResponse) String() string {
+func (x *MsgCreatePerpetualOpenOrderResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgUpdateSpotOrderResponse) ProtoMessage() {}
+func (*MsgCreatePerpetualOpenOrderResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgUpdateSpotOrderResponse.ProtoReflect.Descriptor instead.
-func (*MsgUpdateSpotOrderResponse) Descriptor() ([]byte, []int) {
-	return file_elys_tradeshield_tx_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use MsgCreatePerpetualOpenOrderResponse.ProtoReflect.Descriptor instead.
+func (*MsgCreatePerpetualOpenOrderResponse) Descriptor() ([]byte, []int) {
+	return file_elys_tradeshield_tx_proto_rawDescGZIP(), []int{9}
 }
 
-type MsgCancelSpotOrder struct {
+func (x *MsgCreatePerpetualOpenOrderResponse) GetOrderId() uint64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+type MsgCreatePerpetualCloseOrder struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OwnerAddress string `protobuf:"bytes,1,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
-	OrderId      uint64 `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	OwnerAddress string        `protobuf:"bytes,1,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
+	TriggerPrice *TriggerPrice `protobuf:"bytes,2,opt,name=trigger_price,json=triggerPrice,proto3" json:"trigger_price,omitempty"`
+	PositionId   uint64        `protobuf:"varint,3,opt,name=position_id,json=positionId,proto3" json:"position_id,omitempty"`
+	// LIMITCLOSE, STOPLOSSPERP or TRAILINGSTOPPERP
+	OrderType PerpetualOrderType `protobuf:"varint,4,opt,name=order_type,json=orderType,proto3,enum=elys.tradeshield.PerpetualOrderType" json:"order_type,omitempty"`
+	// Amount of the position to close, zero closes the whole position
+	CloseAmount string      `protobuf:"bytes,5,opt,name=close_amount,json=closeAmount,proto3" json:"close_amount,omitempty"`
+	TimeInForce TimeInForce `protobuf:"varint,6,opt,name=time_in_force,json=timeInForce,proto3,enum=elys.tradeshield.TimeInForce" json:"time_in_force,omitempty"`
+	// Height and/or timestamp at which a GTT order expires
+	Expiry *Date `protobuf:"bytes,7,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	// Trail of TRAILINGSTOPPERP orders, their trigger price is set from the
+	// market price
+	TrailingStop *TrailingStop `protobuf:"bytes,8,opt,name=trailing_stop,json=trailingStop,proto3" json:"trailing_stop,omitempty"`
 }
 
-func (x *MsgCancelSpotOrder) Reset() {
-	*x = MsgCancelSpotOrder{}
+func (x *MsgCreatePerpetualCloseOrder) Reset() {
+	*x = MsgCreatePerpetualCloseOrder{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_elys_tradeshield_tx_proto_msgTypes[4]
+		mi := &file_elys_tradeshield_tx_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgCancelSpotOrder) String() string {
+func (x *MsgCreatePerpetualCloseOrder) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgCancelSpotOrder) ProtoMessage() {}
+func (*MsgCreatePerpetualCloseOrder) ProtoMessage() {}
 
-// Deprecated: Use MsgCancelSpotOrder.ProtoReflect.Descriptor instead.
-func (*MsgCancelSpotOrder) Descriptor() ([]byte, []int) {
-	return file_elys_tradeshield_tx_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use MsgCreatePerpetualCloseOrder.ProtoReflect.Descriptor instead.
+func (*MsgCreatePerpetualCloseOrder) Descriptor() ([]byte, []int) {
+	return file_elys_tradeshield_tx_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *MsgCancelSpotOrder) GetOwnerAddress() string {
+func (x *MsgCreatePerpetualCloseOrder) GetOwnerAddress() string {
 	if x != nil {
 		return x.OwnerAddress
 	}
 	return ""
 }
 
-func (x *MsgCancelSpotOrder) GetOrderId() uint64 {
+func (x *MsgCreatePerpetualCloseOrder) GetTriggerPrice() *TriggerPrice {
 	if x != nil {
-		return x.OrderId
+		return x.TriggerPrice
+	}
+	return nil
+}
+
+func (x *MsgCreatePerpetualCloseOrder) GetPositionId() uint64 {
+	if x != nil {
+		return x.PositionId
 	}
 	return 0
 }
 
-type MsgCancelSpotOrderResponse struct {
+func (x *MsgCreatePerpetualCloseOrder) GetOrderType() PerpetualOrderType {
+	if x != nil {
+		return x.OrderType
+	}
+	return PerpetualOrderType_LIMITOPEN
+}
+
+func (x *MsgCreatePerpetualCloseOrder) GetCloseAmount() string {
+	if x != nil {
+		return x.CloseAmount
+	}
+	return ""
+}
+
+func (x *MsgCreatePerpetualCloseOrder) GetTimeInForce() TimeInForce {
+	if x != nil {
+		return x.TimeInForce
+	}
+	return TimeInForce_GTC
+}
+
+func (x *MsgCreatePerpetualCloseOrder) GetExpiry() *Date {
+	if x != nil {
+		return x.Expiry
+	}
+	return nil
+}
+
+func (x *MsgCreatePerpetualCloseOrder) GetTrailingStop() *TrailingStop {
+	if x != nil {
+		return x.TrailingStop
+	}
+	return nil
+}
+
+type MsgCreatePerpetualCloseOrderResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -11092,202 +16275,154 @@ type MsgCancelSpotOrderResponse struct {
 	OrderId uint64 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
 }
 
-func (x *MsgCancelSpotOrderResponse) Reset() {
-	*x = MsgCancelSpotOrderResponse{}
+func (x *MsgCreatePerpetualCloseOrderResponse) Reset() {
+	*x = MsgCreatePerpetualCloseOrderResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_elys_tradeshield_tx_proto_msgTypes[5]
+		mi := &file_elys_tradeshield_tx_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgCancelSpotOrderResponse) String() string {
+func (x *MsgCreatePerpetualCloseOrderResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgCancelSpotOrderResponse) ProtoMessage() {}
+func (*MsgCreatePerpetualCloseOrderResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgCancelSpotOrderResponse.ProtoReflect.Descriptor instead.
-func (*MsgCancelSpotOrderResponse) Descriptor() ([]byte, []int) {
-	return file_elys_tradeshield_tx_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use MsgCreatePerpetualCloseOrderResponse.ProtoReflect.Descriptor instead.
+func (*MsgCreatePerpetualCloseOrderResponse) Descriptor() ([]byte, []int) {
+	return file_elys_tradeshield_tx_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *MsgCancelSpotOrderResponse) GetOrderId() uint64 {
+func (x *MsgCreatePerpetualCloseOrderResponse) GetOrderId() uint64 {
 	if x != nil {
 		return x.OrderId
 	}
 	return 0
 }
 
-type MsgCancelSpotOrders struct {
+type MsgUpdatePerpetualOrder struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Creator      string   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	SpotOrderIds []uint64 `protobuf:"varint,2,rep,packed,name=spot_order_ids,json=spotOrderIds,proto3" json:"spot_order_ids,omitempty"`
+	OwnerAddress string        `protobuf:"bytes,1,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
+	OrderId      uint64        `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	TriggerPrice *TriggerPrice `protobuf:"bytes,3,opt,name=trigger_price,json=triggerPrice,proto3" json:"trigger_price,omitempty"`
 }
 
-func (x *MsgCancelSpotOrders) Reset() {
-	*x = MsgCancelSpotOrders{}
+func (x *MsgUpdatePerpetualOrder) Reset() {
+	*x = MsgUpdatePerpetualOrder{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_elys_tradeshield_tx_proto_msgTypes[6]
+		mi := &file_elys_tradeshield_tx_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgCancelSpotOrders) String() string {
+func (x *MsgUpdatePerpetualOrder) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgCancelSpotOrders) ProtoMessage() {}
+func (*MsgUpdatePerpetualOrder) ProtoMessage() {}
 
-// Deprecated: Use MsgCancelSpotOrders.ProtoReflect.Descriptor instead.
-func (*MsgCancelSpotOrders) Descriptor() ([]byte, []int) {
-	return file_elys_tradeshield_tx_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use MsgUpdatePerpetualOrder.ProtoReflect.Descriptor instead.
+func (*MsgUpdatePerpetualOrder) Descriptor() ([]byte, []int) {
+	return file_elys_tradeshield_tx_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *MsgCancelSpotOrders) GetCreator() string {
+func (x *MsgUpdatePerpetualOrder) GetOwnerAddress() string {
 	if x != nil {
-		return x.Creator
+		return x.OwnerAddress
 	}
 	return ""
 }
 
-func (x *MsgCancelSpotOrders) GetSpotOrderIds() []uint64 {
+func (x *MsgUpdatePerpetualOrder) GetOrderId() uint64 {
 	if x != nil {
-		return x.SpotOrderIds
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *MsgUpdatePerpetualOrder) GetTriggerPrice() *TriggerPrice {
+	if x != nil {
+		return x.TriggerPrice
 	}
 	return nil
 }
 
-type MsgCancelSpotOrdersResponse struct {
+type MsgUpdatePerpetualOrderResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *MsgCancelSpotOrdersResponse) Reset() {
-	*x = MsgCancelSpotOrdersResponse{}
+func (x *MsgUpdatePerpetualOrderResponse) Reset() {
+	*x = MsgUpdatePerpetualOrderResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_elys_tradeshield_tx_proto_msgTypes[7]
+		mi := &file_elys_tradeshield_tx_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgCancelSpotOrdersResponse) String() string {
+func (x *MsgUpdatePerpetualOrderResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgCancelSpotOrdersResponse) ProtoMessage() {}
+func (*MsgUpdatePerpetualOrderResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgCancelSpotOrdersResponse.ProtoReflect.Descriptor instead.
-func (*MsgCancelSpotOrdersResponse) Descriptor() ([]byte, []int) {
-	return file_elys_tradeshield_tx_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use MsgUpdatePerpetualOrderResponse.ProtoReflect.Descriptor instead.
+func (*MsgUpdatePerpetualOrderResponse) Descriptor() ([]byte, []int) {
+	return file_elys_tradeshield_tx_proto_rawDescGZIP(), []int{13}
 }
 
-type MsgCreatePerpetualOpenOrder struct {
+type MsgCancelPerpetualOrder struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OwnerAddress    string            `protobuf:"bytes,1,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
-	TriggerPrice    *TriggerPrice     `protobuf:"bytes,2,opt,name=trigger_price,json=triggerPrice,proto3" json:"trigger_price,omitempty"`
-	Collateral      *v1beta1.Coin     `protobuf:"bytes,3,opt,name=collateral,proto3" json:"collateral,omitempty"`
-	TradingAsset    string            `protobuf:"bytes,4,opt,name=trading_asset,json=tradingAsset,proto3" json:"trading_asset,omitempty"`
-	Position        PerpetualPosition `protobuf:"varint,5,opt,name=position,proto3,enum=elys.tradeshield.PerpetualPosition" json:"position,omitempty"`
-	Leverage        string            `protobuf:"bytes,6,opt,name=leverage,proto3" json:"leverage,omitempty"`
-	TakeProfitPrice string            `protobuf:"bytes,7,opt,name=take_profit_price,json=takeProfitPrice,proto3" json:"take_profit_price,omitempty"`
-	StopLossPrice   string            `protobuf:"bytes,8,opt,name=stop_loss_price,json=stopLossPrice,proto3" json:"stop_loss_price,omitempty"`
-	PoolId          uint64            `protobuf:"varint,9,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	OwnerAddress string `protobuf:"bytes,1,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
+	OrderId      uint64 `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
 }
 
-func (x *MsgCreatePerpetualOpenOrder) Reset() {
-	*x = MsgCreatePerpetualOpenOrder{}
+func (x *MsgCancelPerpetualOrder) Reset() {
+	*x = MsgCancelPerpetualOrder{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_elys_tradeshield_tx_proto_msgTypes[8]
+		mi := &file_elys_tradeshield_tx_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgCreatePerpetualOpenOrder) String() string {
+func (x *MsgCancelPerpetualOrder) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgCreatePerpetualOpenOrder) ProtoMessage() {}
+func (*MsgCancelPerpetualOrder) ProtoMessage() {}
 
-// Deprecated: Use MsgCreatePerpetualOpenOrder.ProtoReflect.Descriptor instead.
-func (*MsgCreatePerpetualOpenOrder) Descriptor() ([]byte, []int) {
-	return file_elys_tradeshield_tx_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use MsgCancelPerpetualOrder.ProtoReflect.Descriptor instead.
+func (*MsgCancelPerpetualOrder) Descriptor() ([]byte, []int) {
+	return file_elys_tradeshield_tx_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *MsgCreatePerpetualOpenOrder) GetOwnerAddress() string {
+func (x *MsgCancelPerpetualOrder) GetOwnerAddress() string {
 	if x != nil {
 		return x.OwnerAddress
 	}
 	return ""
 }
 
-func (x *MsgCreatePerpetualOpenOrder) GetTriggerPrice() *TriggerPrice {
-	if x != nil {
-		return x.TriggerPrice
-	}
-	return nil
-}
-
-func (x *MsgCreatePerpetualOpenOrder) GetCollateral() *v1beta1.Coin {
-	if x != nil {
-		return x.Collateral
-	}
-	return nil
-}
-
-func (x *MsgCreatePerpetualOpenOrder) GetTradingAsset() string {
-	if x != nil {
-		return x.TradingAsset
-	}
-	return ""
-}
-
-func (x *MsgCreatePerpetualOpenOrder) GetPosition() PerpetualPosition {
-	if x != nil {
-		return x.Position
-	}
-	return PerpetualPosition_UNSPECIFIED
-}
-
-func (x *MsgCreatePerpetualOpenOrder) GetLeverage() string {
-	if x != nil {
-		return x.Leverage
-	}
-	return ""
-}
-
-func (x *MsgCreatePerpetualOpenOrder) GetTakeProfitPrice() string {
-	if x != nil {
-		return x.TakeProfitPrice
-	}
-	return ""
-}
-
-func (x *MsgCreatePerpetualOpenOrder) GetStopLossPrice() string {
-	if x != nil {
-		return x.StopLossPrice
-	}
-	return ""
-}
-
-func (x *MsgCreatePerpetualOpenOrder) GetPoolId() uint64 {
+func (x *MsgCancelPerpetualOrder) GetOrderId() uint64 {
 	if x != nil {
-		return x.PoolId
+		return x.OrderId
 	}
 	return 0
 }
 
-type MsgCreatePerpetualOpenOrderResponse struct {
+type MsgCancelPerpetualOrderResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -11295,490 +16430,592 @@ type MsgCreatePerpetualOpenOrderResponse struct {
 	OrderId uint64 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
 }
 
-func (x *MsgCreatePerpetualOpenOrderResponse) Reset() {
-	*x = MsgCreatePerpetualOpenOrderResponse{}
+func (x *MsgCancelPerpetualOrderResponse) Reset() {
+	*x = MsgCancelPerpetualOrderResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_elys_tradeshield_tx_proto_msgTypes[9]
+		mi := &file_elys_tradeshield_tx_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgCreatePerpetualOpenOrderResponse) String() string {
+func (x *MsgCancelPerpetualOrderResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgCreatePerpetualOpenOrderResponse) ProtoMessage() {}
+func (*MsgCancelPerpetualOrderResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgCreatePerpetualOpenOrderResponse.ProtoReflect.Descriptor instead.
-func (*MsgCreatePerpetualOpenOrderResponse) Descriptor() ([]byte, []int) {
-	return file_elys_tradeshield_tx_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use MsgCancelPerpetualOrderResponse.ProtoReflect.Descriptor instead.
+func (*MsgCancelPerpetualOrderResponse) Descriptor() ([]byte, []int) {
+	return file_elys_tradeshield_tx_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *MsgCreatePerpetualOpenOrderResponse) GetOrderId() uint64 {
+func (x *MsgCancelPerpetualOrderResponse) GetOrderId() uint64 {
 	if x != nil {
 		return x.OrderId
 	}
 	return 0
 }
 
-type MsgCreatePerpetualCloseOrder struct {
+type MsgCancelPerpetualOrders struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OwnerAddress string        `protobuf:"bytes,1,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
-	TriggerPrice *TriggerPrice `protobuf:"bytes,2,opt,name=trigger_price,json=triggerPrice,proto3" json:"trigger_price,omitempty"`
-	PositionId   uint64        `protobuf:"varint,3,opt,name=position_id,json=positionId,proto3" json:"position_id,omitempty"`
+	OwnerAddress string   `protobuf:"bytes,1,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
+	OrderIds     []uint64 `protobuf:"varint,2,rep,packed,name=order_ids,json=orderIds,proto3" json:"order_ids,omitempty"`
 }
 
-func (x *MsgCreatePerpetualCloseOrder) Reset() {
-	*x = MsgCreatePerpetualCloseOrder{}
+func (x *MsgCancelPerpetualOrders) Reset() {
+	*x = MsgCancelPerpetualOrders{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_elys_tradeshield_tx_proto_msgTypes[10]
+		mi := &file_elys_tradeshield_tx_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgCreatePerpetualCloseOrder) String() string {
+func (x *MsgCancelPerpetualOrders) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgCreatePerpetualCloseOrder) ProtoMessage() {}
+func (*MsgCancelPerpetualOrders) ProtoMessage() {}
 
-// Deprecated: Use MsgCreatePerpetualCloseOrder.ProtoReflect.Descriptor instead.
-func (*MsgCreatePerpetualCloseOrder) Descriptor() ([]byte, []int) {
-	return file_elys_tradeshield_tx_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use MsgCancelPerpetualOrders.ProtoReflect.Descriptor instead.
+func (*MsgCancelPerpetualOrders) Descriptor() ([]byte, []int) {
+	return file_elys_tradeshield_tx_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *MsgCreatePerpetualCloseOrder) GetOwnerAddress() string {
+func (x *MsgCancelPerpetualOrders) GetOwnerAddress() string {
 	if x != nil {
 		return x.OwnerAddress
 	}
 	return ""
 }
 
-func (x *MsgCreatePerpetualCloseOrder) GetTriggerPrice() *TriggerPrice {
+func (x *MsgCancelPerpetualOrders) GetOrderIds() []uint64 {
 	if x != nil {
-		return x.TriggerPrice
+		return x.OrderIds
 	}
 	return nil
 }
 
-func (x *MsgCreatePerpetualCloseOrder) GetPositionId() uint64 {
-	if x != nil {
-		return x.PositionId
+type MsgCancelPerpetualOrdersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgCancelPerpetualOrdersResponse) Reset() {
+	*x = MsgCancelPerpetualOrdersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_elys_tradeshield_tx_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-type MsgCreatePerpetualCloseOrderResponse struct {
+func (x *MsgCancelPerpetualOrdersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgCancelPerpetualOrdersResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgCancelPerpetualOrdersResponse.ProtoReflect.Descriptor instead.
+func (*MsgCancelPerpetualOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_elys_tradeshield_tx_proto_rawDescGZIP(), []int{17}
+}
+
+type MsgUpdateParams struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OrderId uint64 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// authority is the address that controls the module (defaults to x/gov unless
+	// overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// NOTE: All parameters must be supplied.
+	Params *Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params,omitempty"`
 }
 
-func (x *MsgCreatePerpetualCloseOrderResponse) Reset() {
-	*x = MsgCreatePerpetualCloseOrderResponse{}
+func (x *MsgUpdateParams) Reset() {
+	*x = MsgUpdateParams{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_elys_tradeshield_tx_proto_msgTypes[11]
+		mi := &file_elys_tradeshield_tx_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgCreatePerpetualCloseOrderResponse) String() string {
+func (x *MsgUpdateParams) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgCreatePerpetualCloseOrderResponse) ProtoMessage() {}
+func (*MsgUpdateParams) ProtoMessage() {}
 
-// Deprecated: Use MsgCreatePerpetualCloseOrderResponse.ProtoReflect.Descriptor instead.
-func (*MsgCreatePerpetualCloseOrderResponse) Descriptor() ([]byte, []int) {
-	return file_elys_tradeshield_tx_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use MsgUpdateParams.ProtoReflect.Descriptor instead.
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return file_elys_tradeshield_tx_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *MsgCreatePerpetualCloseOrderResponse) GetOrderId() uint64 {
+func (x *MsgUpdateParams) GetAuthority() string {
 	if x != nil {
-		return x.OrderId
+		return x.Authority
 	}
-	return 0
+	return ""
 }
 
-type MsgUpdatePerpetualOrder struct {
+func (x *MsgUpdateParams) GetParams() *Params {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+type MsgUpdateParamsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+}
 
-	OwnerAddress string        `protobuf:"bytes,1,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
-	OrderId      uint64        `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	TriggerPrice *TriggerPrice `protobuf:"bytes,3,opt,name=trigger_price,json=triggerPrice,proto3" json:"trigger_price,omitempty"`
+func (x *MsgUpdateParamsResponse) Reset() {
+	*x = MsgUpdateParamsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_elys_tradeshield_tx_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-func (x *MsgUpdatePerpetualOrder) Reset() {
-	*x = MsgUpdatePerpetualOrder{}
+func (x *MsgUpdateParamsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgUpdateParamsResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgUpdateParamsResponse.ProtoReflect.Descriptor instead.
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return file_elys_tradeshield_tx_proto_rawDescGZIP(), []int{19}
+}
+
+type MsgExecuteOrders struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Creator           string   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	SpotOrderIds      []uint64 `protobuf:"varint,2,rep,packed,name=spot_order_ids,json=spotOrderIds,proto3" json:"spot_order_ids,omitempty"`
+	PerpetualOrderIds []uint64 `protobuf:"varint,3,rep,packed,name=perpetual_order_ids,json=perpetualOrderIds,proto3" json:"perpetual_order_ids,omitempty"`
+}
+
+func (x *MsgExecuteOrders) Reset() {
+	*x = MsgExecuteOrders{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_elys_tradeshield_tx_proto_msgTypes[12]
+		mi := &file_elys_tradeshield_tx_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgUpdatePerpetualOrder) String() string {
+func (x *MsgExecuteOrders) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgUpdatePerpetualOrder) ProtoMessage() {}
+func (*MsgExecuteOrders) ProtoMessage() {}
 
-// Deprecated: Use MsgUpdatePerpetualOrder.ProtoReflect.Descriptor instead.
-func (*MsgUpdatePerpetualOrder) Descriptor() ([]byte, []int) {
-	return file_elys_tradeshield_tx_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use MsgExecuteOrders.ProtoReflect.Descriptor instead.
+func (*MsgExecuteOrders) Descriptor() ([]byte, []int) {
+	return file_elys_tradeshield_tx_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *MsgUpdatePerpetualOrder) GetOwnerAddress() string {
+func (x *MsgExecuteOrders) GetCreator() string {
 	if x != nil {
-		return x.OwnerAddress
+		return x.Creator
 	}
 	return ""
 }
 
-func (x *MsgUpdatePerpetualOrder) GetOrderId() uint64 {
+func (x *MsgExecuteOrders) GetSpotOrderIds() []uint64 {
 	if x != nil {
-		return x.OrderId
+		return x.SpotOrderIds
 	}
-	return 0
+	return nil
 }
 
-func (x *MsgUpdatePerpetualOrder) GetTriggerPrice() *TriggerPrice {
+func (x *MsgExecuteOrders) GetPerpetualOrderIds() []uint64 {
 	if x != nil {
-		return x.TriggerPrice
+		return x.PerpetualOrderIds
 	}
 	return nil
 }
 
-type MsgUpdatePerpetualOrderResponse struct {
+type MsgExecuteOrdersResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *MsgUpdatePerpetualOrderResponse) Reset() {
-	*x = MsgUpdatePerpetualOrderResponse{}
+func (x *MsgExecuteOrdersResponse) Reset() {
+	*x = MsgExecuteOrdersResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_elys_tradeshield_tx_proto_msgTypes[13]
+		mi := &file_elys_tradeshield_tx_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgUpdatePerpetualOrderResponse) String() string {
+func (x *MsgExecuteOrdersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgUpdatePerpetualOrderResponse) ProtoMessage() {}
+func (*MsgExecuteOrdersResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgUpdatePerpetualOrderResponse.ProtoReflect.Descriptor instead.
-func (*MsgUpdatePerpetualOrderResponse) Descriptor() ([]byte, []int) {
-	return file_elys_tradeshield_tx_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use MsgExecuteOrdersResponse.ProtoReflect.Descriptor instead.
+func (*MsgExecuteOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_elys_tradeshield_tx_proto_rawDescGZIP(), []int{21}
 }
 
-type MsgCancelPerpetualOrder struct {
+type MsgCreateOrderGroup struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OwnerAddress string `protobuf:"bytes,1,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
-	OrderId      uint64 `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	OwnerAddress      string   `protobuf:"bytes,1,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
+	SpotOrderIds      []uint64 `protobuf:"varint,2,rep,packed,name=spot_order_ids,json=spotOrderIds,proto3" json:"spot_order_ids,omitempty"`
+	PerpetualOrderIds []uint64 `protobuf:"varint,3,rep,packed,name=perpetual_order_ids,json=perpetualOrderIds,proto3" json:"perpetual_order_ids,omitempty"`
 }
 
-func (x *MsgCancelPerpetualOrder) Reset() {
-	*x = MsgCancelPerpetualOrder{}
+func (x *MsgCreateOrderGroup) Reset() {
+	*x = MsgCreateOrderGroup{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_elys_tradeshield_tx_proto_msgTypes[14]
+		mi := &file_elys_tradeshield_tx_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgCancelPerpetualOrder) String() string {
+func (x *MsgCreateOrderGroup) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgCancelPerpetualOrder) ProtoMessage() {}
+func (*MsgCreateOrderGroup) ProtoMessage() {}
 
-// Deprecated: Use MsgCancelPerpetualOrder.ProtoReflect.Descriptor instead.
-func (*MsgCancelPerpetualOrder) Descriptor() ([]byte, []int) {
-	return file_elys_tradeshield_tx_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use MsgCreateOrderGroup.ProtoReflect.Descriptor instead.
+func (*MsgCreateOrderGroup) Descriptor() ([]byte, []int) {
+	return file_elys_tradeshield_tx_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *MsgCancelPerpetualOrder) GetOwnerAddress() string {
+func (x *MsgCreateOrderGroup) GetOwnerAddress() string {
 	if x != nil {
 		return x.OwnerAddress
 	}
 	return ""
 }
 
-func (x *MsgCancelPerpetualOrder) GetOrderId() uint64 {
+func (x *MsgCreateOrderGroup) GetSpotOrderIds() []uint64 {
 	if x != nil {
-		return x.OrderId
+		return x.SpotOrderIds
 	}
-	return 0
+	return nil
 }
 
-type MsgCancelPerpetualOrderResponse struct {
+func (x *MsgCreateOrderGroup) GetPerpetualOrderIds() []uint64 {
+	if x != nil {
+		return x.PerpetualOrderIds
+	}
+	return nil
+}
+
+type MsgCreateOrderGroupResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OrderId uint64 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	GroupId uint64 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
 }
 
-func (x *MsgCancelPerpetualOrderResponse) Reset() {
-	*x = MsgCancelPerpetualOrderResponse{}
+func (x *MsgCreateOrderGroupResponse) Reset() {
+	*x = MsgCreateOrderGroupResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_elys_tradeshield_tx_proto_msgTypes[15]
+		mi := &file_elys_tradeshield_tx_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgCancelPerpetualOrderResponse) String() string {
+func (x *MsgCreateOrderGroupResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgCancelPerpetualOrderResponse) ProtoMessage() {}
+func (*MsgCreateOrderGroupResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgCancelPerpetualOrderResponse.ProtoReflect.Descriptor instead.
-func (*MsgCancelPerpetualOrderResponse) Descriptor() ([]byte, []int) {
-	return file_elys_tradeshield_tx_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use MsgCreateOrderGroupResponse.ProtoReflect.Descriptor instead.
+func (*MsgCreateOrderGroupResponse) Descriptor() ([]byte, []int) {
+	return file_elys_tradeshield_tx_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *MsgCancelPerpetualOrderResponse) GetOrderId() uint64 {
+func (x *MsgCreateOrderGroupResponse) GetGroupId() uint64 {
 	if x != nil {
-		return x.OrderId
+		return x.GroupId
 	}
 	return 0
 }
 
-type MsgCancelPerpetualOrders struct {
+type MsgCancelOrderGroup struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OwnerAddress string   `protobuf:"bytes,1,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
-	OrderIds     []uint64 `protobuf:"varint,2,rep,packed,name=order_ids,json=orderIds,proto3" json:"order_ids,omitempty"`
+	OwnerAddress string `protobuf:"bytes,1,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
+	GroupId      uint64 `protobuf:"varint,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
 }
 
-func (x *MsgCancelPerpetualOrders) Reset() {
-	*x = MsgCancelPerpetualOrders{}
+func (x *MsgCancelOrderGroup) Reset() {
+	*x = MsgCancelOrderGroup{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_elys_tradeshield_tx_proto_msgTypes[16]
+		mi := &file_elys_tradeshield_tx_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgCancelPerpetualOrders) String() string {
+func (x *MsgCancelOrderGroup) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgCancelPerpetualOrders) ProtoMessage() {}
+func (*MsgCancelOrderGroup) ProtoMessage() {}
 
-// Deprecated: Use MsgCancelPerpetualOrders.ProtoReflect.Descriptor instead.
-func (*MsgCancelPerpetualOrders) Descriptor() ([]byte, []int) {
-	return file_elys_tradeshield_tx_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use MsgCancelOrderGroup.ProtoReflect.Descriptor instead.
+func (*MsgCancelOrderGroup) Descriptor() ([]byte, []int) {
+	return file_elys_tradeshield_tx_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *MsgCancelPerpetualOrders) GetOwnerAddress() string {
+func (x *MsgCancelOrderGroup) GetOwnerAddress() string {
 	if x != nil {
 		return x.OwnerAddress
 	}
 	return ""
 }
 
-func (x *MsgCancelPerpetualOrders) GetOrderIds() []uint64 {
+func (x *MsgCancelOrderGroup) GetGroupId() uint64 {
 	if x != nil {
-		return x.OrderIds
+		return x.GroupId
 	}
-	return nil
+	return 0
 }
 
-type MsgCancelPerpetualOrdersResponse struct {
+type MsgCancelOrderGroupResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *MsgCancelPerpetualOrdersResponse) Reset() {
-	*x = MsgCancelPerpetualOrdersResponse{}
+func (x *MsgCancelOrderGroupResponse) Reset() {
+	*x = MsgCancelOrderGroupResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_elys_tradeshield_tx_proto_msgTypes[17]
+		mi := &file_elys_tradeshield_tx_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgCancelPerpetualOrdersResponse) String() string {
+func (x *MsgCancelOrderGroupResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgCancelPerpetualOrdersResponse) ProtoMessage() {}
+func (*MsgCancelOrderGroupResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgCancelPerpetualOrdersResponse.ProtoReflect.Descriptor instead.
-func (*MsgCancelPerpetualOrdersResponse) Descriptor() ([]byte, []int) {
-	return file_elys_tradeshield_tx_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use MsgCancelOrderGroupResponse.ProtoReflect.Descriptor instead.
+func (*MsgCancelOrderGroupResponse) Descriptor() ([]byte, []int) {
+	return file_elys_tradeshield_tx_proto_rawDescGZIP(), []int{25}
 }
 
-type MsgUpdateParams struct {
+type MsgCreateScheduledOrder struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// authority is the address that controls the module (defaults to x/gov unless
-	// overwritten).
-	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	// NOTE: All parameters must be supplied.
-	Params *Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params,omitempty"`
+	OwnerAddress string `protobuf:"bytes,1,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
+	// Amount to swap over all the slices
+	Amount       *v1beta1.Coin        `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	TargetDenom  string               `protobuf:"bytes,3,opt,name=target_denom,json=targetDenom,proto3" json:"target_denom,omitempty"`
+	SliceCount   uint64               `protobuf:"varint,4,opt,name=slice_count,json=sliceCount,proto3" json:"slice_count,omitempty"`
+	Interval     uint64               `protobuf:"varint,5,opt,name=interval,proto3" json:"interval,omitempty"`
+	IntervalUnit ScheduleIntervalUnit `protobuf:"varint,6,opt,name=interval_unit,json=intervalUnit,proto3,enum=elys.tradeshield.ScheduleIntervalUnit" json:"interval_unit,omitempty"`
+	MaxSlippage  string               `protobuf:"bytes,7,opt,name=max_slippage,json=maxSlippage,proto3" json:"max_slippage,omitempty"`
 }
 
-func (x *MsgUpdateParams) Reset() {
-	*x = MsgUpdateParams{}
+func (x *MsgCreateScheduledOrder) Reset() {
+	*x = MsgCreateScheduledOrder{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_elys_tradeshield_tx_proto_msgTypes[18]
+		mi := &file_elys_tradeshield_tx_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgUpdateParams) String() string {
+func (x *MsgCreateScheduledOrder) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgUpdateParams) ProtoMessage() {}
+func (*MsgCreateScheduledOrder) ProtoMessage() {}
 
-// Deprecated: Use MsgUpdateParams.ProtoReflect.Descriptor instead.
-func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return file_elys_tradeshield_tx_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use MsgCreateScheduledOrder.ProtoReflect.Descriptor instead.
+func (*MsgCreateScheduledOrder) Descriptor() ([]byte, []int) {
+	return file_elys_tradeshield_tx_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *MsgUpdateParams) GetAuthority() string {
+func (x *MsgCreateScheduledOrder) GetOwnerAddress() string {
 	if x != nil {
-		return x.Authority
+		return x.OwnerAddress
 	}
 	return ""
 }
 
-func (x *MsgUpdateParams) GetParams() *Params {
+func (x *MsgCreateScheduledOrder) GetAmount() *v1beta1.Coin {
 	if x != nil {
-		return x.Params
+		return x.Amount
 	}
 	return nil
 }
 
-type MsgUpdateParamsResponse struct {
+func (x *MsgCreateScheduledOrder) GetTargetDenom() string {
+	if x != nil {
+		return x.TargetDenom
+	}
+	return ""
+}
+
+func (x *MsgCreateScheduledOrder) GetSliceCount() uint64 {
+	if x != nil {
+		return x.SliceCount
+	}
+	return 0
+}
+
+func (x *MsgCreateScheduledOrder) GetInterval() uint64 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
+}
+
+func (x *MsgCreateScheduledOrder) GetIntervalUnit() ScheduleIntervalUnit {
+	if x != nil {
+		return x.IntervalUnit
+	}
+	return ScheduleIntervalUnit_BLOCKS
+}
+
+func (x *MsgCreateScheduledOrder) GetMaxSlippage() string {
+	if x != nil {
+		return x.MaxSlippage
+	}
+	return ""
+}
+
+type MsgCreateScheduledOrderResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	OrderId uint64 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
 }
 
-func (x *MsgUpdateParamsResponse) Reset() {
-	*x = MsgUpdateParamsResponse{}
+func (x *MsgCreateScheduledOrderResponse) Reset() {
+	*x = MsgCreateScheduledOrderResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_elys_tradeshield_tx_proto_msgTypes[19]
+		mi := &file_elys_tradeshield_tx_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgUpdateParamsResponse) String() string {
+func (x *MsgCreateScheduledOrderResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgUpdateParamsResponse) ProtoMessage() {}
+func (*MsgCreateScheduledOrderResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgUpdateParamsResponse.ProtoReflect.Descriptor instead.
-func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return file_elys_tradeshield_tx_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use MsgCreateScheduledOrderResponse.ProtoReflect.Descriptor instead.
+func (*MsgCreateScheduledOrderResponse) Descriptor() ([]byte, []int) {
+	return file_elys_tradeshield_tx_proto_rawDescGZIP(), []int{27}
 }
 
-type MsgExecuteOrders struct {
+func (x *MsgCreateScheduledOrderResponse) GetOrderId() uint64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+type MsgCancelScheduledOrder struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Creator           string   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	SpotOrderIds      []uint64 `protobuf:"varint,2,rep,packed,name=spot_order_ids,json=spotOrderIds,proto3" json:"spot_order_ids,omitempty"`
-	PerpetualOrderIds []uint64 `protobuf:"varint,3,rep,packed,name=perpetual_order_ids,json=perpetualOrderIds,proto3" json:"perpetual_order_ids,omitempty"`
+	OwnerAddress string `protobuf:"bytes,1,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
+	OrderId      uint64 `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
 }
 
-func (x *MsgExecuteOrders) Reset() {
-	*x = MsgExecuteOrders{}
+func (x *MsgCancelScheduledOrder) Reset() {
+	*x = MsgCancelScheduledOrder{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_elys_tradeshield_tx_proto_msgTypes[20]
+		mi := &file_elys_tradeshield_tx_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgExecuteOrders) String() string {
+func (x *MsgCancelScheduledOrder) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgExecuteOrders) ProtoMessage() {}
+func (*MsgCancelScheduledOrder) ProtoMessage() {}
 
-// Deprecated: Use MsgExecuteOrders.ProtoReflect.Descriptor instead.
-func (*MsgExecuteOrders) Descriptor() ([]byte, []int) {
-	return file_elys_tradeshield_tx_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use MsgCancelScheduledOrder.ProtoReflect.Descriptor instead.
+func (*MsgCancelScheduledOrder) Descriptor() ([]byte, []int) {
+	return file_elys_tradeshield_tx_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *MsgExecuteOrders) GetCreator() string {
+func (x *MsgCancelScheduledOrder) GetOwnerAddress() string {
 	if x != nil {
-		return x.Creator
+		return x.OwnerAddress
 	}
 	return ""
 }
 
-func (x *MsgExecuteOrders) GetSpotOrderIds() []uint64 {
-	if x != nil {
-		return x.SpotOrderIds
-	}
-	return nil
-}
-
-func (x *MsgExecuteOrders) GetPerpetualOrderIds() []uint64 {
+func (x *MsgCancelScheduledOrder) GetOrderId() uint64 {
 	if x != nil {
-		return x.PerpetualOrderIds
+		return x.OrderId
 	}
-	return nil
+	return 0
 }
 
-type MsgExecuteOrdersResponse struct {
+type MsgCancelScheduledOrderResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *MsgExecuteOrdersResponse) Reset() {
-	*x = MsgExecuteOrdersResponse{}
+func (x *MsgCancelScheduledOrderResponse) Reset() {
+	*x = MsgCancelScheduledOrderResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_elys_tradeshield_tx_proto_msgTypes[21]
+		mi := &file_elys_tradeshield_tx_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgExecuteOrdersResponse) String() string {
+func (x *MsgCancelScheduledOrderResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgExecuteOrdersResponse) ProtoMessage() {}
+func (*MsgCancelScheduledOrderResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgExecuteOrdersResponse.ProtoReflect.Descriptor instead.
-func (*MsgExecuteOrdersResponse) Descriptor() ([]byte, []int) {
-	return file_elys_tradeshield_tx_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use MsgCancelScheduledOrderResponse.ProtoReflect.Descriptor instead.
+func (*MsgCancelScheduledOrderResponse) Descriptor() ([]byte, []int) {
+	return file_elys_tradeshield_tx_proto_rawDescGZIP(), []int{29}
 }
 
 var File_elys_tradeshield_tx_proto protoreflect.FileDescriptor
@@ -11792,133 +17029,99 @@ var file_elys_tradeshield_tx_proto_rawDesc = []byte{
 	0x68, 0x69, 0x65, 0x6c, 0x64, 0x2f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x1a, 0x1d, 0x65, 0x6c, 0x79, 0x73, 0x2f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69,
 	0x65, 0x6c, 0x64, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x76, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x1a, 0x17, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x6d, 0x73, 0x67, 0x2f, 0x76, 0x31, 0x2f,
-	0x6d, 0x73, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f,
-	0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x81, 0x03, 0x0a, 0x12, 0x4d, 0x73, 0x67, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x70, 0x6f, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x3e,
-	0x0a, 0x0a, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73,
-	0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x53, 0x70, 0x6f, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x54,
-	0x79, 0x70, 0x65, 0x52, 0x09, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x43,
-	0x0a, 0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65,
-	0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x72, 0x69, 0x63,
-	0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x72,
-	0x69, 0x63, 0x65, 0x12, 0x42, 0x0a, 0x0c, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x61, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
-	0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0b, 0x6f, 0x72, 0x64, 0x65,
-	0x72, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3d, 0x0a, 0x0d, 0x6f, 0x77, 0x6e, 0x65, 0x72,
-	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18,
-	0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0c, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f,
-	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x10, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x44,
-	0x65, 0x6e, 0x6f, 0x6d, 0x3a, 0x35, 0x82, 0xe7, 0xb0, 0x2a, 0x0d, 0x6f, 0x77, 0x6e, 0x65, 0x72,
-	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x8a, 0xe7, 0xb0, 0x2a, 0x1e, 0x74, 0x72, 0x61,
-	0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2f, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x53, 0x70, 0x6f, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x22, 0x37, 0x0a, 0x1a, 0x4d,
-	0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x70, 0x6f, 0x74, 0x4f, 0x72, 0x64, 0x65,
-	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64,
-	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6f, 0x72, 0x64,
-	0x65, 0x72, 0x49, 0x64, 0x22, 0xea, 0x01, 0x0a, 0x12, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x53, 0x70, 0x6f, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x3d, 0x0a, 0x0d, 0x6f,
-	0x77, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0c, 0x6f, 0x77,
-	0x6e, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72,
-	0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6f, 0x72,
-	0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x43, 0x0a, 0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x70,
-	0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x65, 0x6c, 0x79,
-	0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x4f, 0x72,
-	0x64, 0x65, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a,
-	0x6f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x3a, 0x35, 0x82, 0xe7, 0xb0, 0x2a,
+	0x1a, 0x1c, 0x65, 0x6c, 0x79, 0x73, 0x2f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65,
+	0x6c, 0x64, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x6d, 0x73, 0x67, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x73,
+	0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61,
+	0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb9, 0x04, 0x0a, 0x12, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x53, 0x70, 0x6f, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x3e, 0x0a, 0x0a,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1f, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69,
+	0x65, 0x6c, 0x64, 0x2e, 0x53, 0x70, 0x6f, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x09, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x43, 0x0a, 0x0b,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68,
+	0x69, 0x65, 0x6c, 0x64, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x42,
+	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x12, 0x42, 0x0a, 0x0c, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f,
+	0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x41,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3d, 0x0a, 0x0d, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4,
+	0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0c, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x10, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x44, 0x65, 0x6e,
+	0x6f, 0x6d, 0x12, 0x41, 0x0a, 0x0d, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x66, 0x6f,
+	0x72, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x65, 0x6c, 0x79, 0x73,
+	0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x49, 0x6e, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x52, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x49, 0x6e,
+	0x46, 0x6f, 0x72, 0x63, 0x65, 0x12, 0x2e, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61,
+	0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x52, 0x06, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x79, 0x12, 0x43, 0x0a, 0x0d, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x69, 0x6e,
+	0x67, 0x5f, 0x73, 0x74, 0x6f, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x65,
+	0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e,
+	0x54, 0x72, 0x61, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x0c, 0x74, 0x72,
+	0x61, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x6f, 0x70, 0x3a, 0x35, 0x82, 0xe7, 0xb0, 0x2a,
 	0x0d, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x8a, 0xe7,
 	0xb0, 0x2a, 0x1e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2f, 0x4d,
+	0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x70, 0x6f, 0x74, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x22, 0x37, 0x0a, 0x1a, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x70,
+	0x6f, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x22, 0xea, 0x01, 0x0a, 0x12, 0x4d,
 	0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x70, 0x6f, 0x74, 0x4f, 0x72, 0x64, 0x65,
-	0x72, 0x22, 0x1c, 0x0a, 0x1a, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x70,
-	0x6f, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0xa5, 0x01, 0x0a, 0x12, 0x4d, 0x73, 0x67, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x70, 0x6f,
-	0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x3d, 0x0a, 0x0d, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2,
-	0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0c, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64,
+	0x72, 0x12, 0x3d, 0x0a, 0x0d, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x52, 0x0c, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x43, 0x0a, 0x0b, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69,
+	0x65, 0x6c, 0x64, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x42, 0x04,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65,
 	0x3a, 0x35, 0x82, 0xe7, 0xb0, 0x2a, 0x0d, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64,
 	0x72, 0x65, 0x73, 0x73, 0x8a, 0xe7, 0xb0, 0x2a, 0x1e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68,
-	0x69, 0x65, 0x6c, 0x64, 0x2f, 0x4d, 0x73, 0x67, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x70,
-	0x6f, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x22, 0x37, 0x0a, 0x1a, 0x4d, 0x73, 0x67, 0x43, 0x61,
-	0x6e, 0x63, 0x65, 0x6c, 0x53, 0x70, 0x6f, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64,
-	0x22, 0xa1, 0x01, 0x0a, 0x13, 0x4d, 0x73, 0x67, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x70,
-	0x6f, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x32, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x24, 0x0a, 0x0e,
-	0x73, 0x70, 0x6f, 0x74, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x04, 0x52, 0x0c, 0x73, 0x70, 0x6f, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49,
-	0x64, 0x73, 0x3a, 0x30, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72,
-	0x8a, 0xe7, 0xb0, 0x2a, 0x1f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64,
-	0x2f, 0x4d, 0x73, 0x67, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x70, 0x6f, 0x74, 0x4f, 0x72,
-	0x64, 0x65, 0x72, 0x73, 0x22, 0x1d, 0x0a, 0x1b, 0x4d, 0x73, 0x67, 0x43, 0x61, 0x6e, 0x63, 0x65,
-	0x6c, 0x53, 0x70, 0x6f, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0xb0, 0x05, 0x0a, 0x1b, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x4f, 0x70, 0x65, 0x6e, 0x4f, 0x72,
-	0x64, 0x65, 0x72, 0x12, 0x3d, 0x0a, 0x0d, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x52, 0x0c, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x12, 0x49, 0x0a, 0x0d, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x70, 0x72,
-	0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x65, 0x6c, 0x79, 0x73,
-	0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x54, 0x72, 0x69,
-	0x67, 0x67, 0x65, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
-	0x0c, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x3f, 0x0a,
-	0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
-	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde,
-	0x1f, 0x00, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x12, 0x23,
-	0x0a, 0x0d, 0x74, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x73,
-	0x73, 0x65, 0x74, 0x12, 0x3f, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61,
-	0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75,
-	0x61, 0x6c, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4d, 0x0a, 0x08, 0x6c, 0x65, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74,
-	0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x08, 0x6c, 0x65, 0x76, 0x65, 0x72,
-	0x61, 0x67, 0x65, 0x12, 0x5d, 0x0a, 0x11, 0x74, 0x61, 0x6b, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x66,
-	0x69, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31,
-	0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
-	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79,
-	0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65,
-	0x63, 0x52, 0x0f, 0x74, 0x61, 0x6b, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x74, 0x50, 0x72, 0x69,
-	0x63, 0x65, 0x12, 0x59, 0x0a, 0x0f, 0x73, 0x74, 0x6f, 0x70, 0x5f, 0x6c, 0x6f, 0x73, 0x73, 0x5f,
-	0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f,
-	0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
-	0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63,
-	0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0d,
-	0x73, 0x74, 0x6f, 0x70, 0x4c, 0x6f, 0x73, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x17, 0x0a,
-	0x07, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
-	0x70, 0x6f, 0x6f, 0x6c, 0x49, 0x64, 0x3a, 0x3e, 0x82, 0xe7, 0xb0, 0x2a, 0x0d, 0x6f, 0x77, 0x6e,
-	0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x8a, 0xe7, 0xb0, 0x2a, 0x27, 0x74,
-	0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2f, 0x4d, 0x73, 0x67, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x4f, 0x70, 0x65,
-	0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x22, 0x40, 0x0a, 0x23, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x4f, 0x70, 0x65, 0x6e,
-	0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a,
-	0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x22, 0x87, 0x02, 0x0a, 0x1c, 0x4d, 0x73, 0x67,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x43,
-	0x6c, 0x6f, 0x73, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x3d, 0x0a, 0x0d, 0x6f, 0x77, 0x6e,
+	0x69, 0x65, 0x6c, 0x64, 0x2f, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x70,
+	0x6f, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x22, 0x1c, 0x0a, 0x1a, 0x4d, 0x73, 0x67, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x53, 0x70, 0x6f, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa5, 0x01, 0x0a, 0x12, 0x4d, 0x73, 0x67, 0x43, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x53, 0x70, 0x6f, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x3d, 0x0a, 0x0d,
+	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0c, 0x6f,
+	0x77, 0x6e, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x3a, 0x35, 0x82, 0xe7, 0xb0, 0x2a, 0x0d, 0x6f, 0x77, 0x6e,
+	0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x8a, 0xe7, 0xb0, 0x2a, 0x1e, 0x74,
+	0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2f, 0x4d, 0x73, 0x67, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x53, 0x70, 0x6f, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x22, 0x37, 0x0a,
+	0x1a, 0x4d, 0x73, 0x67, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x70, 0x6f, 0x74, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x22, 0xa1, 0x01, 0x0a, 0x13, 0x4d, 0x73, 0x67, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x53, 0x70, 0x6f, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x32,
+	0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x6f, 0x72, 0x12, 0x24, 0x0a, 0x0e, 0x73, 0x70, 0x6f, 0x74, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0c, 0x73, 0x70, 0x6f, 0x74,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x73, 0x3a, 0x30, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x1f, 0x74, 0x72, 0x61, 0x64, 0x65,
+	0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2f, 0x4d, 0x73, 0x67, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x53, 0x70, 0x6f, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x22, 0x1d, 0x0a, 0x1b, 0x4d, 0x73,
+	0x67, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x70, 0x6f, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xf0, 0x06, 0x0a, 0x1b, 0x4d, 0x73,
+	0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c,
+	0x4f, 0x70, 0x65, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x3d, 0x0a, 0x0d, 0x6f, 0x77, 0x6e,
 	0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
 	0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64,
 	0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0c, 0x6f, 0x77, 0x6e, 0x65,
@@ -11927,180 +17130,365 @@ var file_elys_tradeshield_tx_proto_rawDesc = []byte{
 	0x1e, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65,
 	0x6c, 0x64, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x42,
 	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0c, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x50, 0x72,
-	0x69, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
-	0x6f, 0x6e, 0x49, 0x64, 0x3a, 0x3c, 0x82, 0xe7, 0xb0, 0x2a, 0x0d, 0x6f, 0x77, 0x6e, 0x65, 0x72,
-	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x8a, 0xe7, 0xb0, 0x2a, 0x25, 0x74, 0x72, 0x61,
-	0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50,
-	0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x4f, 0x72, 0x64,
-	0x65, 0x72, 0x22, 0x41, 0x0a, 0x24, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50,
-	0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x4f, 0x72, 0x64,
-	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72,
-	0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6f, 0x72,
-	0x64, 0x65, 0x72, 0x49, 0x64, 0x22, 0xfa, 0x01, 0x0a, 0x17, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65,
-	0x72, 0x12, 0x3d, 0x0a, 0x0d, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x52, 0x0c, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x49, 0x0a, 0x0d, 0x74,
-	0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73,
-	0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x50, 0x72, 0x69,
-	0x63, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0c, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65,
-	0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x3a, 0x3a, 0x82, 0xe7, 0xb0, 0x2a, 0x0d, 0x6f, 0x77, 0x6e,
-	0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x8a, 0xe7, 0xb0, 0x2a, 0x23, 0x74,
-	0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2f, 0x4d, 0x73, 0x67, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x4f, 0x72, 0x64,
-	0x65, 0x72, 0x22, 0x21, 0x0a, 0x1f, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50,
-	0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xaf, 0x01, 0x0a, 0x17, 0x4d, 0x73, 0x67, 0x43, 0x61, 0x6e,
-	0x63, 0x65, 0x6c, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65,
-	0x72, 0x12, 0x3d, 0x0a, 0x0d, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x52, 0x0c, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x3a, 0x3a, 0x82, 0xe7, 0xb0,
-	0x2a, 0x0d, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x8a,
-	0xe7, 0xb0, 0x2a, 0x23, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2f,
-	0x4d, 0x73, 0x67, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75,
-	0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x22, 0x3c, 0x0a, 0x1f, 0x4d, 0x73, 0x67, 0x43, 0x61,
-	0x6e, 0x63, 0x65, 0x6c, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x4f, 0x72, 0x64,
-	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72,
-	0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6f, 0x72,
-	0x64, 0x65, 0x72, 0x49, 0x64, 0x22, 0xb3, 0x01, 0x0a, 0x18, 0x4d, 0x73, 0x67, 0x43, 0x61, 0x6e,
-	0x63, 0x65, 0x6c, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65,
-	0x72, 0x73, 0x12, 0x3d, 0x0a, 0x0d, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x52, 0x0c, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x04, 0x52, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x73, 0x3a, 0x3b,
-	0x82, 0xe7, 0xb0, 0x2a, 0x0d, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x8a, 0xe7, 0xb0, 0x2a, 0x24, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65,
-	0x6c, 0x64, 0x2f, 0x4d, 0x73, 0x67, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x65, 0x72, 0x70,
-	0x65, 0x74, 0x75, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x22, 0x22, 0x0a, 0x20, 0x4d,
-	0x73, 0x67, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61,
-	0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0xab, 0x01, 0x0a, 0x0f, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x12, 0x36, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79,
+	0x69, 0x63, 0x65, 0x12, 0x3f, 0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61,
+	0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f,
+	0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74,
+	0x65, 0x72, 0x61, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x5f,
+	0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x72, 0x61,
+	0x64, 0x69, 0x6e, 0x67, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x3f, 0x0a, 0x08, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x65, 0x6c,
+	0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x50,
+	0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4d, 0x0a, 0x08, 0x6c, 0x65,
+	0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde,
+	0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
+	0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65,
+	0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52,
+	0x08, 0x6c, 0x65, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x12, 0x5d, 0x0a, 0x11, 0x74, 0x61, 0x6b,
+	0x65, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e,
+	0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0f, 0x74, 0x61, 0x6b, 0x65, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x59, 0x0a, 0x0f, 0x73, 0x74, 0x6f, 0x70,
+	0x5f, 0x6c, 0x6f, 0x73, 0x73, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67,
+	0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x44, 0x65, 0x63, 0x52, 0x0d, 0x73, 0x74, 0x6f, 0x70, 0x4c, 0x6f, 0x73, 0x73, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x6f, 0x6f, 0x6c, 0x49, 0x64, 0x12, 0x41, 0x0a, 0x0d,
+	0x74, 0x69, 0x6d, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65,
+	0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x49, 0x6e, 0x46, 0x6f, 0x72,
+	0x63, 0x65, 0x52, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x49, 0x6e, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x12,
+	0x2e, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65,
+	0x6c, 0x64, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x52, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x12,
+	0x4b, 0x0a, 0x0e, 0x62, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74,
+	0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x42, 0x72, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0d, 0x62,
+	0x72, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x3a, 0x3e, 0x82, 0xe7,
+	0xb0, 0x2a, 0x0d, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x8a, 0xe7, 0xb0, 0x2a, 0x27, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64,
+	0x2f, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74,
+	0x75, 0x61, 0x6c, 0x4f, 0x70, 0x65, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x22, 0x40, 0x0a, 0x23,
+	0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75,
+	0x61, 0x6c, 0x4f, 0x70, 0x65, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x22, 0xd4,
+	0x04, 0x0a, 0x1c, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x70,
+	0x65, 0x74, 0x75, 0x61, 0x6c, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12,
+	0x3d, 0x0a, 0x0d, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d,
 	0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x30, 0x0a, 0x06, 0x70,
-	0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x65, 0x6c,
-	0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x50,
-	0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x3a, 0x2e, 0x82,
-	0xe7, 0xb0, 0x2a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x8a, 0xe7, 0xb0,
-	0x2a, 0x1b, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2f, 0x4d, 0x73,
-	0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x19, 0x0a,
-	0x17, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xcb, 0x01, 0x0a, 0x10, 0x4d, 0x73, 0x67,
-	0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x32, 0x0a,
-	0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18,
-	0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f,
-	0x72, 0x12, 0x24, 0x0a, 0x0e, 0x73, 0x70, 0x6f, 0x74, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f,
-	0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0c, 0x73, 0x70, 0x6f, 0x74, 0x4f,
-	0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x70, 0x65, 0x72, 0x70, 0x65,
-	0x74, 0x75, 0x61, 0x6c, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03,
-	0x20, 0x03, 0x28, 0x04, 0x52, 0x11, 0x70, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x4f,
-	0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x73, 0x3a, 0x2d, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x6f, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x1c, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73,
-	0x68, 0x69, 0x65, 0x6c, 0x64, 0x2f, 0x4d, 0x73, 0x67, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65,
-	0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x22, 0x1a, 0x0a, 0x18, 0x4d, 0x73, 0x67, 0x45, 0x78, 0x65,
-	0x63, 0x75, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x32, 0xd8, 0x09, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x65, 0x0a, 0x0f, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x53, 0x70, 0x6f, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x24, 0x2e,
-	0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64,
-	0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x70, 0x6f, 0x74, 0x4f, 0x72,
-	0x64, 0x65, 0x72, 0x1a, 0x2c, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65,
-	0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x53, 0x70, 0x6f, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x65, 0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x70, 0x6f, 0x74, 0x4f,
-	0x72, 0x64, 0x65, 0x72, 0x12, 0x24, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64,
-	0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x53, 0x70, 0x6f, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x1a, 0x2c, 0x2e, 0x65, 0x6c, 0x79,
-	0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x4d, 0x73,
-	0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x70, 0x6f, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x0f, 0x43, 0x61, 0x6e, 0x63,
-	0x65, 0x6c, 0x53, 0x70, 0x6f, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x24, 0x2e, 0x65, 0x6c,
+	0x52, 0x0c, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x49,
+	0x0a, 0x0d, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61,
+	0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0c, 0x74, 0x72, 0x69,
+	0x67, 0x67, 0x65, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x43, 0x0a, 0x0a, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24,
+	0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c,
+	0x64, 0x2e, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x4e, 0x0a, 0x0c, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2b, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68,
+	0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49,
+	0x6e, 0x74, 0x52, 0x0b, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x41, 0x0a, 0x0d, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x66, 0x6f, 0x72, 0x63, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72,
+	0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x49, 0x6e,
+	0x46, 0x6f, 0x72, 0x63, 0x65, 0x52, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x49, 0x6e, 0x46, 0x6f, 0x72,
+	0x63, 0x65, 0x12, 0x2e, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73,
+	0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x52, 0x06, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x79, 0x12, 0x43, 0x0a, 0x0d, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x73,
+	0x74, 0x6f, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x65, 0x6c, 0x79, 0x73,
+	0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x54, 0x72, 0x61,
+	0x69, 0x6c, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x69, 0x6c,
+	0x69, 0x6e, 0x67, 0x53, 0x74, 0x6f, 0x70, 0x3a, 0x3c, 0x82, 0xe7, 0xb0, 0x2a, 0x0d, 0x6f, 0x77,
+	0x6e, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x8a, 0xe7, 0xb0, 0x2a, 0x25,
+	0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2f, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x43, 0x6c, 0x6f, 0x73, 0x65,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x22, 0x41, 0x0a, 0x24, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x43, 0x6c, 0x6f, 0x73, 0x65,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a,
+	0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x22, 0xfa, 0x01, 0x0a, 0x17, 0x4d, 0x73, 0x67,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x12, 0x3d, 0x0a, 0x0d, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d,
+	0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0c, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x49,
+	0x0a, 0x0d, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61,
+	0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0c, 0x74, 0x72, 0x69,
+	0x67, 0x67, 0x65, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x3a, 0x3a, 0x82, 0xe7, 0xb0, 0x2a, 0x0d,
+	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x8a, 0xe7, 0xb0,
+	0x2a, 0x23, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2f, 0x4d, 0x73,
+	0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x22, 0x21, 0x0a, 0x1f, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xaf, 0x01, 0x0a, 0x17, 0x4d, 0x73, 0x67,
+	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x12, 0x3d, 0x0a, 0x0d, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d,
+	0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0c, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x3a, 0x3a,
+	0x82, 0xe7, 0xb0, 0x2a, 0x0d, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x8a, 0xe7, 0xb0, 0x2a, 0x23, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65,
+	0x6c, 0x64, 0x2f, 0x4d, 0x73, 0x67, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x65, 0x72, 0x70,
+	0x65, 0x74, 0x75, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x22, 0x3c, 0x0a, 0x1f, 0x4d, 0x73,
+	0x67, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a,
+	0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x22, 0xb3, 0x01, 0x0a, 0x18, 0x4d, 0x73, 0x67,
+	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x3d, 0x0a, 0x0d, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4,
+	0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0c, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x52, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64,
+	0x73, 0x3a, 0x3b, 0x82, 0xe7, 0xb0, 0x2a, 0x0d, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x8a, 0xe7, 0xb0, 0x2a, 0x24, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73,
+	0x68, 0x69, 0x65, 0x6c, 0x64, 0x2f, 0x4d, 0x73, 0x67, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50,
+	0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x22, 0x22,
+	0x0a, 0x20, 0x4d, 0x73, 0x67, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x65, 0x72, 0x70, 0x65,
+	0x74, 0x75, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0xab, 0x01, 0x0a, 0x0f, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x36, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x30,
+	0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c,
+	0x64, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x3a, 0x2e, 0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79,
+	0x8a, 0xe7, 0xb0, 0x2a, 0x1b, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64,
+	0x2f, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x22, 0x19, 0x0a, 0x17, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xcb, 0x01, 0x0a, 0x10,
+	0x4d, 0x73, 0x67, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73,
+	0x12, 0x32, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x6f, 0x72, 0x12, 0x24, 0x0a, 0x0e, 0x73, 0x70, 0x6f, 0x74, 0x5f, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0c, 0x73, 0x70,
+	0x6f, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x70, 0x65,
+	0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x04, 0x52, 0x11, 0x70, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75,
+	0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x73, 0x3a, 0x2d, 0x82, 0xe7, 0xb0, 0x2a,
+	0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x1c, 0x74, 0x72, 0x61,
+	0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2f, 0x4d, 0x73, 0x67, 0x45, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x22, 0x1a, 0x0a, 0x18, 0x4d, 0x73, 0x67,
+	0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xe2, 0x01, 0x0a, 0x13, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x3d, 0x0a,
+	0x0d, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0c,
+	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x0e,
+	0x73, 0x70, 0x6f, 0x74, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x04, 0x52, 0x0c, 0x73, 0x70, 0x6f, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49,
+	0x64, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x70, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x5f,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x04, 0x52,
+	0x11, 0x70, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49,
+	0x64, 0x73, 0x3a, 0x36, 0x82, 0xe7, 0xb0, 0x2a, 0x0d, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x8a, 0xe7, 0xb0, 0x2a, 0x1f, 0x74, 0x72, 0x61, 0x64, 0x65,
+	0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2f, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x38, 0x0a, 0x1b, 0x4d, 0x73,
+	0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x49, 0x64, 0x22, 0xa7, 0x01, 0x0a, 0x13, 0x4d, 0x73, 0x67, 0x43, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x3d, 0x0a, 0x0d,
+	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0c, 0x6f,
+	0x77, 0x6e, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x3a, 0x36, 0x82, 0xe7, 0xb0, 0x2a, 0x0d, 0x6f, 0x77, 0x6e,
+	0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x8a, 0xe7, 0xb0, 0x2a, 0x1f, 0x74,
+	0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2f, 0x4d, 0x73, 0x67, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x1d,
+	0x0a, 0x1b, 0x4d, 0x73, 0x67, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xd0, 0x03,
+	0x0a, 0x17, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x3d, 0x0a, 0x0d, 0x6f, 0x77, 0x6e,
+	0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0c, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x37, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43,
+	0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x64, 0x65, 0x6e, 0x6f,
+	0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x44,
+	0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6c, 0x69, 0x63, 0x65, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x6c, 0x69, 0x63, 0x65,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
+	0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
+	0x6c, 0x12, 0x4b, 0x0a, 0x0d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x75, 0x6e,
+	0x69, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e,
+	0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x55, 0x6e, 0x69, 0x74,
+	0x52, 0x0c, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x54,
+	0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x6c, 0x69, 0x70, 0x70, 0x61, 0x67, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e,
+	0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x53, 0x6c, 0x69, 0x70,
+	0x70, 0x61, 0x67, 0x65, 0x3a, 0x3a, 0x82, 0xe7, 0xb0, 0x2a, 0x0d, 0x6f, 0x77, 0x6e, 0x65, 0x72,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x8a, 0xe7, 0xb0, 0x2a, 0x23, 0x74, 0x72, 0x61,
+	0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2f, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x22, 0x3c, 0x0a, 0x1f, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x22, 0xaf,
+	0x01, 0x0a, 0x17, 0x4d, 0x73, 0x67, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x3d, 0x0a, 0x0d, 0x6f, 0x77,
+	0x6e, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0c, 0x6f, 0x77, 0x6e,
+	0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x49, 0x64, 0x3a, 0x3a, 0x82, 0xe7, 0xb0, 0x2a, 0x0d, 0x6f, 0x77, 0x6e, 0x65, 0x72,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x8a, 0xe7, 0xb0, 0x2a, 0x23, 0x74, 0x72, 0x61,
+	0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2f, 0x4d, 0x73, 0x67, 0x43, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x22, 0x21, 0x0a, 0x1f, 0x4d, 0x73, 0x67, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x32, 0x98, 0x0d, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x65, 0x0a, 0x0f, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x70, 0x6f, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x24,
+	0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c,
+	0x64, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x70, 0x6f, 0x74, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x1a, 0x2c, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64,
+	0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x53, 0x70, 0x6f, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x65, 0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x70, 0x6f, 0x74,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x24, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61,
+	0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x53, 0x70, 0x6f, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x1a, 0x2c, 0x2e, 0x65, 0x6c,
+	0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x4d,
+	0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x70, 0x6f, 0x74, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x0f, 0x43, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x53, 0x70, 0x6f, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x24, 0x2e, 0x65,
+	0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e,
+	0x4d, 0x73, 0x67, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x70, 0x6f, 0x74, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x1a, 0x2c, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73,
+	0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53,
+	0x70, 0x6f, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x68, 0x0a, 0x10, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x70, 0x6f, 0x74, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x73, 0x12, 0x25, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64,
+	0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x53, 0x70, 0x6f, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x1a, 0x2d, 0x2e, 0x65, 0x6c,
 	0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x4d,
 	0x73, 0x67, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x70, 0x6f, 0x74, 0x4f, 0x72, 0x64, 0x65,
-	0x72, 0x1a, 0x2c, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68,
-	0x69, 0x65, 0x6c, 0x64, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x70,
-	0x6f, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x68, 0x0a, 0x10, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x70, 0x6f, 0x74, 0x4f, 0x72, 0x64,
-	0x65, 0x72, 0x73, 0x12, 0x25, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65,
-	0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
-	0x53, 0x70, 0x6f, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x1a, 0x2d, 0x2e, 0x65, 0x6c, 0x79,
-	0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x4d, 0x73,
-	0x67, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x70, 0x6f, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x80, 0x01, 0x0a, 0x18, 0x43, 0x72,
+	0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x80, 0x01, 0x0a, 0x18, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x4f, 0x70,
+	0x65, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x2d, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74,
+	0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72,
 	0x65, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x4f, 0x70, 0x65,
-	0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x2d, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72,
+	0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x1a, 0x35, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72,
 	0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65,
 	0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x4f, 0x70, 0x65, 0x6e,
-	0x4f, 0x72, 0x64, 0x65, 0x72, 0x1a, 0x35, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61,
-	0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x4f, 0x70, 0x65, 0x6e, 0x4f,
-	0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x83, 0x01, 0x0a,
-	0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c,
-	0x43, 0x6c, 0x6f, 0x73, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x2e, 0x2e, 0x65, 0x6c, 0x79,
-	0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x4d, 0x73,
-	0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c,
-	0x43, 0x6c, 0x6f, 0x73, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x1a, 0x36, 0x2e, 0x65, 0x6c, 0x79,
-	0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x4d, 0x73,
-	0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c,
-	0x43, 0x6c, 0x6f, 0x73, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x74, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x70,
-	0x65, 0x74, 0x75, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x29, 0x2e, 0x65, 0x6c, 0x79,
-	0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x4d, 0x73,
-	0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c,
-	0x4f, 0x72, 0x64, 0x65, 0x72, 0x1a, 0x31, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61,
-	0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x74, 0x0a, 0x14, 0x43, 0x61, 0x6e, 0x63,
-	0x65, 0x6c, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72,
-	0x12, 0x29, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69,
-	0x65, 0x6c, 0x64, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x65, 0x72,
-	0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x1a, 0x31, 0x2e, 0x65, 0x6c,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x83, 0x01,
+	0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61,
+	0x6c, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x2e, 0x2e, 0x65, 0x6c,
+	0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x4d,
+	0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61,
+	0x6c, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x1a, 0x36, 0x2e, 0x65, 0x6c,
 	0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x4d,
-	0x73, 0x67, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61,
-	0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x77,
-	0x0a, 0x15, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61,
-	0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x2a, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74,
-	0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x61,
-	0x6e, 0x63, 0x65, 0x6c, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x4f, 0x72, 0x64,
-	0x65, 0x72, 0x73, 0x1a, 0x32, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65,
-	0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
-	0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x21, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74,
-	0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x29, 0x2e, 0x65, 0x6c, 0x79,
-	0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x4d, 0x73,
-	0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x0d, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65,
-	0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x22, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72,
-	0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x78, 0x65,
-	0x63, 0x75, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x1a, 0x2a, 0x2e, 0x65, 0x6c, 0x79,
-	0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x4d, 0x73,
-	0x67, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x05, 0x80, 0xe7, 0xb0, 0x2a, 0x01, 0x42, 0xb3, 0x01,
-	0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65,
-	0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x42, 0x07, 0x54, 0x78, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
-	0x01, 0x5a, 0x31, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x65, 0x6c,
-	0x79, 0x73, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x65, 0x6c, 0x79, 0x73, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x65, 0x6c, 0x79, 0x73, 0x2f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68,
-	0x69, 0x65, 0x6c, 0x64, 0xa2, 0x02, 0x03, 0x45, 0x54, 0x58, 0xaa, 0x02, 0x10, 0x45, 0x6c, 0x79,
-	0x73, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0xca, 0x02, 0x10,
-	0x45, 0x6c, 0x79, 0x73, 0x5c, 0x54, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64,
-	0xe2, 0x02, 0x1c, 0x45, 0x6c, 0x79, 0x73, 0x5c, 0x54, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69,
-	0x65, 0x6c, 0x64, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
-	0x02, 0x11, 0x45, 0x6c, 0x79, 0x73, 0x3a, 0x3a, 0x54, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69,
-	0x65, 0x6c, 0x64, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61,
+	0x6c, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x74, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72,
+	0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x29, 0x2e, 0x65, 0x6c,
+	0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x4d,
+	0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61,
+	0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x1a, 0x31, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72,
+	0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x74, 0x0a, 0x14, 0x43, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x12, 0x29, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68,
+	0x69, 0x65, 0x6c, 0x64, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x65,
+	0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x1a, 0x31, 0x2e, 0x65,
+	0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e,
+	0x4d, 0x73, 0x67, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75,
+	0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x77, 0x0a, 0x15, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75,
+	0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x2a, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e,
+	0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x4d, 0x73, 0x67, 0x43,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x73, 0x1a, 0x32, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64,
+	0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x21, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e,
+	0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x4d, 0x73, 0x67, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x29, 0x2e, 0x65, 0x6c,
+	0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x4d,
+	0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x0d, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x22, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74,
+	0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x1a, 0x2a, 0x2e, 0x65, 0x6c,
+	0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x4d,
+	0x73, 0x67, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x25, 0x2e, 0x65, 0x6c,
+	0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x4d,
+	0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x1a, 0x2d, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73,
+	0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x68, 0x0a, 0x10, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x25, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61,
+	0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x1a, 0x2d, 0x2e, 0x65,
+	0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e,
+	0x4d, 0x73, 0x67, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x74, 0x0a, 0x14, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x12, 0x29, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65,
+	0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x1a, 0x31,
+	0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c,
+	0x64, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x74, 0x0a, 0x14, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x29, 0x2e, 0x65, 0x6c, 0x79, 0x73,
+	0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x4d, 0x73, 0x67,
+	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x1a, 0x31, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64,
+	0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x05, 0x80, 0xe7, 0xb0, 0x2a, 0x01, 0x42, 0xb3,
+	0x01, 0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64,
+	0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x42, 0x07, 0x54, 0x78, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x50, 0x01, 0x5a, 0x31, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x65,
+	0x6c, 0x79, 0x73, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x65, 0x6c, 0x79, 0x73,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x6c, 0x79, 0x73, 0x2f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73,
+	0x68, 0x69, 0x65, 0x6c, 0x64, 0xa2, 0x02, 0x03, 0x45, 0x54, 0x58, 0xaa, 0x02, 0x10, 0x45, 0x6c,
+	0x79, 0x73, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0xca, 0x02,
+	0x10, 0x45, 0x6c, 0x79, 0x73, 0x5c, 0x54, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c,
+	0x64, 0xe2, 0x02, 0x1c, 0x45, 0x6c, 0x79, 0x73, 0x5c, 0x54, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68,
+	0x69, 0x65, 0x6c, 0x64, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0xea, 0x02, 0x11, 0x45, 0x6c, 0x79, 0x73, 0x3a, 0x3a, 0x54, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68,
+	0x69, 0x65, 0x6c, 0x64, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -12115,7 +17503,7 @@ func file_elys_tradeshield_tx_proto_rawDescGZIP() []byte {
 	return file_elys_tradeshield_tx_proto_rawDescData
 }
 
-var file_elys_tradeshield_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+var file_elys_tradeshield_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
 var file_elys_tradeshield_tx_proto_goTypes = []interface{}{
 	(*MsgCreateSpotOrder)(nil),                   // 0: elys.tradeshield.MsgCreateSpotOrder
 	(*MsgCreateSpotOrderResponse)(nil),           // 1: elys.tradeshield.MsgCreateSpotOrderResponse
@@ -12139,51 +17527,85 @@ var file_elys_tradeshield_tx_proto_goTypes = []interface{}{
 	(*MsgUpdateParamsResponse)(nil),              // 19: elys.tradeshield.MsgUpdateParamsResponse
 	(*MsgExecuteOrders)(nil),                     // 20: elys.tradeshield.MsgExecuteOrders
 	(*MsgExecuteOrdersResponse)(nil),             // 21: elys.tradeshield.MsgExecuteOrdersResponse
-	(SpotOrderType)(0),                           // 22: elys.tradeshield.SpotOrderType
-	(*OrderPrice)(nil),                           // 23: elys.tradeshield.OrderPrice
-	(*v1beta1.Coin)(nil),                         // 24: cosmos.base.v1beta1.Coin
-	(*TriggerPrice)(nil),                         // 25: elys.tradeshield.TriggerPrice
-	(PerpetualPosition)(0),                       // 26: elys.tradeshield.PerpetualPosition
-	(*Params)(nil),                               // 27: elys.tradeshield.Params
+	(*MsgCreateOrderGroup)(nil),                  // 22: elys.tradeshield.MsgCreateOrderGroup
+	(*MsgCreateOrderGroupResponse)(nil),          // 23: elys.tradeshield.MsgCreateOrderGroupResponse
+	(*MsgCancelOrderGroup)(nil),                  // 24: elys.tradeshield.MsgCancelOrderGroup
+	(*MsgCancelOrderGroupResponse)(nil),          // 25: elys.tradeshield.MsgCancelOrderGroupResponse
+	(*MsgCreateScheduledOrder)(nil),              // 26: elys.tradeshield.MsgCreateScheduledOrder
+	(*MsgCreateScheduledOrderResponse)(nil),      // 27: elys.tradeshield.MsgCreateScheduledOrderResponse
+	(*MsgCancelScheduledOrder)(nil),              // 28: elys.tradeshield.MsgCancelScheduledOrder
+	(*MsgCancelScheduledOrderResponse)(nil),      // 29: elys.tradeshield.MsgCancelScheduledOrderResponse
+	(SpotOrderType)(0),                           // 30: elys.tradeshield.SpotOrderType
+	(*OrderPrice)(nil),                           // 31: elys.tradeshield.OrderPrice
+	(*v1beta1.Coin)(nil),                         // 32: cosmos.base.v1beta1.Coin
+	(TimeInForce)(0),                             // 33: elys.tradeshield.TimeInForce
+	(*Date)(nil),                                 // 34: elys.tradeshield.Date
+	(*TrailingStop)(nil),                         // 35: elys.tradeshield.TrailingStop
+	(*TriggerPrice)(nil),                         // 36: elys.tradeshield.TriggerPrice
+	(PerpetualPosition)(0),                       // 37: elys.tradeshield.PerpetualPosition
+	(*BracketOrder)(nil),                         // 38: elys.tradeshield.BracketOrder
+	(PerpetualOrderType)(0),                      // 39: elys.tradeshield.PerpetualOrderType
+	(*Params)(nil),                               // 40: elys.tradeshield.Params
+	(ScheduleIntervalUnit)(0),                    // 41: elys.tradeshield.ScheduleIntervalUnit
 }
 var file_elys_tradeshield_tx_proto_depIdxs = []int32{
-	22, // 0: elys.tradeshield.MsgCreateSpotOrder.order_type:type_name -> elys.tradeshield.SpotOrderType
-	23, // 1: elys.tradeshield.MsgCreateSpotOrder.order_price:type_name -> elys.tradeshield.OrderPrice
-	24, // 2: elys.tradeshield.MsgCreateSpotOrder.order_amount:type_name -> cosmos.base.v1beta1.Coin
-	23, // 3: elys.tradeshield.MsgUpdateSpotOrder.order_price:type_name -> elys.tradeshield.OrderPrice
-	25, // 4: elys.tradeshield.MsgCreatePerpetualOpenOrder.trigger_price:type_name -> elys.tradeshield.TriggerPrice
-	24, // 5: elys.tradeshield.MsgCreatePerpetualOpenOrder.collateral:type_name -> cosmos.base.v1beta1.Coin
-	26, // 6: elys.tradeshield.MsgCreatePerpetualOpenOrder.position:type_name -> elys.tradeshield.PerpetualPosition
-	25, // 7: elys.tradeshield.MsgCreatePerpetualCloseOrder.trigger_price:type_name -> elys.tradeshield.TriggerPrice
-	25, // 8: elys.tradeshield.MsgUpdatePerpetualOrder.trigger_price:type_name -> elys.tradeshield.TriggerPrice
-	27, // 9: elys.tradeshield.MsgUpdateParams.params:type_name -> elys.tradeshield.Params
-	0,  // 10: elys.tradeshield.Msg.CreateSpotOrder:input_type -> elys.tradeshield.MsgCreateSpotOrder
-	2,  // 11: elys.tradeshield.Msg.UpdateSpotOrder:input_type -> elys.tradeshield.MsgUpdateSpotOrder
-	4,  // 12: elys.tradeshield.Msg.CancelSpotOrder:input_type -> elys.tradeshield.MsgCancelSpotOrder
-	6,  // 13: elys.tradeshield.Msg.CancelSpotOrders:input_type -> elys.tradeshield.MsgCancelSpotOrders
-	8,  // 14: elys.tradeshield.Msg.CreatePerpetualOpenOrder:input_type -> elys.tradeshield.MsgCreatePerpetualOpenOrder
-	10, // 15: elys.tradeshield.Msg.CreatePerpetualCloseOrder:input_type -> elys.tradeshield.MsgCreatePerpetualCloseOrder
-	12, // 16: elys.tradeshield.Msg.UpdatePerpetualOrder:input_type -> elys.tradeshield.MsgUpdatePerpetualOrder
-	14, // 17: elys.tradeshield.Msg.CancelPerpetualOrder:input_type -> elys.tradeshield.MsgCancelPerpetualOrder
-	16, // 18: elys.tradeshield.Msg.CancelPerpetualOrders:input_type -> elys.tradeshield.MsgCancelPerpetualOrders
-	18, // 19: elys.tradeshield.Msg.UpdateParams:input_type -> elys.tradeshield.MsgUpdateParams
-	20, // 20: elys.tradeshield.Msg.ExecuteOrders:input_type -> elys.tradeshield.MsgExecuteOrders
-	1,  // 21: elys.tradeshield.Msg.CreateSpotOrder:output_type -> elys.tradeshield.MsgCreateSpotOrderResponse
-	3,  // 22: elys.tradeshield.Msg.UpdateSpotOrder:output_type -> elys.tradeshield.MsgUpdateSpotOrderResponse
-	5,  // 23: elys.tradeshield.Msg.CancelSpotOrder:output_type -> elys.tradeshield.MsgCancelSpotOrderResponse
-	7,  // 24: elys.tradeshield.Msg.CancelSpotOrders:output_type -> elys.tradeshield.MsgCancelSpotOrdersResponse
-	9,  // 25: elys.tradeshield.Msg.CreatePerpetualOpenOrder:output_type -> elys.tradeshield.MsgCreatePerpetualOpenOrderResponse
-	11, // 26: elys.tradeshield.Msg.CreatePerpetualCloseOrder:output_type -> elys.tradeshield.MsgCreatePerpetualCloseOrderResponse
-	13, // 27: elys.tradeshield.Msg.UpdatePerpetualOrder:output_type -> elys.tradeshield.MsgUpdatePerpetualOrderResponse
-	15, // 28: elys.tradeshield.Msg.CancelPerpetualOrder:output_type -> elys.tradeshield.MsgCancelPerpetualOrderResponse
-	17, // 29: elys.tradeshield.Msg.CancelPerpetualOrders:output_type -> elys.tradeshield.MsgCancelPerpetualOrdersResponse
-	19, // 30: elys.tradeshield.Msg.UpdateParams:output_type -> elys.tradeshield.MsgUpdateParamsResponse
-	21, // 31: elys.tradeshield.Msg.ExecuteOrders:output_type -> elys.tradeshield.MsgExecuteOrdersResponse
-	21, // [21:32] is the sub-list for method output_type
-	10, // [10:21] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	30, // 0: elys.tradeshield.MsgCreateSpotOrder.order_type:type_name -> elys.tradeshield.SpotOrderType
+	31, // 1: elys.tradeshield.MsgCreateSpotOrder.order_price:type_name -> elys.tradeshield.OrderPrice
+	32, // 2: elys.tradeshield.MsgCreateSpotOrder.order_amount:type_name -> cosmos.base.v1beta1.Coin
+	33, // 3: elys.tradeshield.MsgCreateSpotOrder.time_in_force:type_name -> elys.tradeshield.TimeInForce
+	34, // 4: elys.tradeshield.MsgCreateSpotOrder.expiry:type_name -> elys.tradeshield.Date
+	35, // 5: elys.tradeshield.MsgCreateSpotOrder.trailing_stop:type_name -> elys.tradeshield.TrailingStop
+	31, // 6: elys.tradeshield.MsgUpdateSpotOrder.order_price:type_name -> elys.tradeshield.OrderPrice
+	36, // 7: elys.tradeshield.MsgCreatePerpetualOpenOrder.trigger_price:type_name -> elys.tradeshield.TriggerPrice
+	32, // 8: elys.tradeshield.MsgCreatePerpetualOpenOrder.collateral:type_name -> cosmos.base.v1beta1.Coin
+	37, // 9: elys.tradeshield.MsgCreatePerpetualOpenOrder.position:type_name -> elys.tradeshield.PerpetualPosition
+	33, // 10: elys.tradeshield.MsgCreatePerpetualOpenOrder.time_in_force:type_name -> elys.tradeshield.TimeInForce
+	34, // 11: elys.tradeshield.MsgCreatePerpetualOpenOrder.expiry:type_name -> elys.tradeshield.Date
+	38, // 12: elys.tradeshield.MsgCreatePerpetualOpenOrder.bracket_orders:type_name -> elys.tradeshield.BracketOrder
+	36, // 13: elys.tradeshield.MsgCreatePerpetualCloseOrder.trigger_price:type_name -> elys.tradeshield.TriggerPrice
+	39, // 14: elys.tradeshield.MsgCreatePerpetualCloseOrder.order_type:type_name -> elys.tradeshield.PerpetualOrderType
+	33, // 15: elys.tradeshield.MsgCreatePerpetualCloseOrder.time_in_force:type_name -> elys.tradeshield.TimeInForce
+	34, // 16: elys.tradeshield.MsgCreatePerpetualCloseOrder.expiry:type_name -> elys.tradeshield.Date
+	35, // 17: elys.tradeshield.MsgCreatePerpetualCloseOrder.trailing_stop:type_name -> elys.tradeshield.TrailingStop
+	36, // 18: elys.tradeshield.MsgUpdatePerpetualOrder.trigger_price:type_name -> elys.tradeshield.TriggerPrice
+	40, // 19: elys.tradeshield.MsgUpdateParams.params:type_name -> elys.tradeshield.Params
+	32, // 20: elys.tradeshield.MsgCreateScheduledOrder.amount:type_name -> cosmos.base.v1beta1.Coin
+	41, // 21: elys.tradeshield.MsgCreateScheduledOrder.interval_unit:type_name -> elys.tradeshield.ScheduleIntervalUnit
+	0,  // 22: elys.tradeshield.Msg.CreateSpotOrder:input_type -> elys.tradeshield.MsgCreateSpotOrder
+	2,  // 23: elys.tradeshield.Msg.UpdateSpotOrder:input_type -> elys.tradeshield.MsgUpdateSpotOrder
+	4,  // 24: elys.tradeshield.Msg.CancelSpotOrder:input_type -> elys.tradeshield.MsgCancelSpotOrder
+	6,  // 25: elys.tradeshield.Msg.CancelSpotOrders:input_type -> elys.tradeshield.MsgCancelSpotOrders
+	8,  // 26: elys.tradeshield.Msg.CreatePerpetualOpenOrder:input_type -> elys.tradeshield.MsgCreatePerpetualOpenOrder
+	10, // 27: elys.tradeshield.Msg.CreatePerpetualCloseOrder:input_type -> elys.tradeshield.MsgCreatePerpetualCloseOrder
+	12, // 28: elys.tradeshield.Msg.UpdatePerpetualOrder:input_type -> elys.tradeshield.MsgUpdatePerpetualOrder
+	14, // 29: elys.tradeshield.Msg.CancelPerpetualOrder:input_type -> elys.tradeshield.MsgCancelPerpetualOrder
+	16, // 30: elys.tradeshield.Msg.CancelPerpetualOrders:input_type -> elys.tradeshield.MsgCancelPerpetualOrders
+	18, // 31: elys.tradeshield.Msg.UpdateParams:input_type -> elys.tradeshield.MsgUpdateParams
+	20, // 32: elys.tradeshield.Msg.ExecuteOrders:input_type -> elys.tradeshield.MsgExecuteOrders
+	22, // 33: elys.tradeshield.Msg.CreateOrderGroup:input_type -> elys.tradeshield.MsgCreateOrderGroup
+	24, // 34: elys.tradeshield.Msg.CancelOrderGroup:input_type -> elys.tradeshield.MsgCancelOrderGroup
+	26, // 35: elys.tradeshield.Msg.CreateScheduledOrder:input_type -> elys.tradeshield.MsgCreateScheduledOrder
+	28, // 36: elys.tradeshield.Msg.CancelScheduledOrder:input_type -> elys.tradeshield.MsgCancelScheduledOrder
+	1,  // 37: elys.tradeshield.Msg.CreateSpotOrder:output_type -> elys.tradeshield.MsgCreateSpotOrderResponse
+	3,  // 38: elys.tradeshield.Msg.UpdateSpotOrder:output_type -> elys.tradeshield.MsgUpdateSpotOrderResponse
+	5,  // 39: elys.tradeshield.Msg.CancelSpotOrder:output_type -> elys.tradeshield.MsgCancelSpotOrderResponse
+	7,  // 40: elys.tradeshield.Msg.CancelSpotOrders:output_type -> elys.tradeshield.MsgCancelSpotOrdersResponse
+	9,  // 41: elys.tradeshield.Msg.CreatePerpetualOpenOrder:output_type -> elys.tradeshield.MsgCreatePerpetualOpenOrderResponse
+	11, // 42: elys.tradeshield.Msg.CreatePerpetualCloseOrder:output_type -> elys.tradeshield.MsgCreatePerpetualCloseOrderResponse
+	13, // 43: elys.tradeshield.Msg.UpdatePerpetualOrder:output_type -> elys.tradeshield.MsgUpdatePerpetualOrderResponse
+	15, // 44: elys.tradeshield.Msg.CancelPerpetualOrder:output_type -> elys.tradeshield.MsgCancelPerpetualOrderResponse
+	17, // 45: elys.tradeshield.Msg.CancelPerpetualOrders:output_type -> elys.tradeshield.MsgCancelPerpetualOrdersResponse
+	19, // 46: elys.tradeshield.Msg.UpdateParams:output_type -> elys.tradeshield.MsgUpdateParamsResponse
+	21, // 47: elys.tradeshield.Msg.ExecuteOrders:output_type -> elys.tradeshield.MsgExecuteOrdersResponse
+	23, // 48: elys.tradeshield.Msg.CreateOrderGroup:output_type -> elys.tradeshield.MsgCreateOrderGroupResponse
+	25, // 49: elys.tradeshield.Msg.CancelOrderGroup:output_type -> elys.tradeshield.MsgCancelOrderGroupResponse
+	27, // 50: elys.tradeshield.Msg.CreateScheduledOrder:output_type -> elys.tradeshield.MsgCreateScheduledOrderResponse
+	29, // 51: elys.tradeshield.Msg.CancelScheduledOrder:output_type -> elys.tradeshield.MsgCancelScheduledOrderResponse
+	37, // [37:52] is the sub-list for method output_type
+	22, // [22:37] is the sub-list for method input_type
+	22, // [22:22] is the sub-list for extension type_name
+	22, // [22:22] is the sub-list for extension extendee
+	0,  // [0:22] is the sub-list for field type_name
 }
 
 func init() { file_elys_tradeshield_tx_proto_init() }
@@ -12193,6 +17615,7 @@ func file_elys_tradeshield_tx_proto_init() {
 	}
 	file_elys_tradeshield_order_proto_init()
 	file_elys_tradeshield_params_proto_init()
+	file_elys_tradeshield_types_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_elys_tradeshield_tx_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MsgCreateSpotOrder); i {
@@ -12458,6 +17881,102 @@ func file_elys_tradeshield_tx_proto_init() {
 				return nil
 			}
 		}
+		file_elys_tradeshield_tx_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgCreateOrderGroup); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_elys_tradeshield_tx_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgCreateOrderGroupResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_elys_tradeshield_tx_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgCancelOrderGroup); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_elys_tradeshield_tx_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgCancelOrderGroupResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_elys_tradeshield_tx_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgCreateScheduledOrder); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_elys_tradeshield_tx_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgCreateScheduledOrderResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_elys_tradeshield_tx_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgCancelScheduledOrder); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_elys_tradeshield_tx_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgCancelScheduledOrderResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -12465,7 +17984,7 @@ func file_elys_tradeshield_tx_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_elys_tradeshield_tx_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   22,
+			NumMessages:   30,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
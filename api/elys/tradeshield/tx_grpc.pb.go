@@ -29,6 +29,10 @@ type MsgClient interface {
 	CancelPerpetualOrders(ctx context.Context, in *MsgCancelPerpetualOrders, opts ...grpc.CallOption) (*MsgCancelPerpetualOrdersResponse, error)
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 	ExecuteOrders(ctx context.Context, in *MsgExecuteOrders, opts ...grpc.CallOption) (*MsgExecuteOrdersResponse, error)
+	CreateOrderGroup(ctx context.Context, in *MsgCreateOrderGroup, opts ...grpc.CallOption) (*MsgCreateOrderGroupResponse, error)
+	CancelOrderGroup(ctx context.Context, in *MsgCancelOrderGroup, opts ...grpc.CallOption) (*MsgCancelOrderGroupResponse, error)
+	CreateScheduledOrder(ctx context.Context, in *MsgCreateScheduledOrder, opts ...grpc.CallOption) (*MsgCreateScheduledOrderResponse, error)
+	CancelScheduledOrder(ctx context.Context, in *MsgCancelScheduledOrder, opts ...grpc.CallOption) (*MsgCancelScheduledOrderResponse, error)
 }
 
 type msgClient struct {
@@ -138,6 +142,42 @@ func (c *msgClient) ExecuteOrders(ctx context.Context, in *MsgExecuteOrders, opt
 	return out, nil
 }
 
+func (c *msgClient) CreateOrderGroup(ctx context.Context, in *MsgCreateOrderGroup, opts ...grpc.CallOption) (*MsgCreateOrderGroupResponse, error) {
+	out := new(MsgCreateOrderGroupResponse)
+	err := c.cc.Invoke(ctx, "/elys.tradeshield.Msg/CreateOrderGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelOrderGroup(ctx context.Context, in *MsgCancelOrderGroup, opts ...grpc.CallOption) (*MsgCancelOrderGroupResponse, error) {
+	out := new(MsgCancelOrderGroupResponse)
+	err := c.cc.Invoke(ctx, "/elys.tradeshield.Msg/CancelOrderGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateScheduledOrder(ctx context.Context, in *MsgCreateScheduledOrder, opts ...grpc.CallOption) (*MsgCreateScheduledOrderResponse, error) {
+	out := new(MsgCreateScheduledOrderResponse)
+	err := c.cc.Invoke(ctx, "/elys.tradeshield.Msg/CreateScheduledOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelScheduledOrder(ctx context.Context, in *MsgCancelScheduledOrder, opts ...grpc.CallOption) (*MsgCancelScheduledOrderResponse, error) {
+	out := new(MsgCancelScheduledOrderResponse)
+	err := c.cc.Invoke(ctx, "/elys.tradeshield.Msg/CancelScheduledOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -153,6 +193,10 @@ type MsgServer interface {
 	CancelPerpetualOrders(context.Context, *MsgCancelPerpetualOrders) (*MsgCancelPerpetualOrdersResponse, error)
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 	ExecuteOrders(context.Context, *MsgExecuteOrders) (*MsgExecuteOrdersResponse, error)
+	CreateOrderGroup(context.Context, *MsgCreateOrderGroup) (*MsgCreateOrderGroupResponse, error)
+	CancelOrderGroup(context.Context, *MsgCancelOrderGroup) (*MsgCancelOrderGroupResponse, error)
+	CreateScheduledOrder(context.Context, *MsgCreateScheduledOrder) (*MsgCreateScheduledOrderResponse, error)
+	CancelScheduledOrder(context.Context, *MsgCancelScheduledOrder) (*MsgCancelScheduledOrderResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -193,6 +237,18 @@ func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*
 func (UnimplementedMsgServer) ExecuteOrders(context.Context, *MsgExecuteOrders) (*MsgExecuteOrdersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExecuteOrders not implemented")
 }
+func (UnimplementedMsgServer) CreateOrderGroup(context.Context, *MsgCreateOrderGroup) (*MsgCreateOrderGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOrderGroup not implemented")
+}
+func (UnimplementedMsgServer) CancelOrderGroup(context.Context, *MsgCancelOrderGroup) (*MsgCancelOrderGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelOrderGroup not implemented")
+}
+func (UnimplementedMsgServer) CreateScheduledOrder(context.Context, *MsgCreateScheduledOrder) (*MsgCreateScheduledOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateScheduledOrder not implemented")
+}
+func (UnimplementedMsgServer) CancelScheduledOrder(context.Context, *MsgCancelScheduledOrder) (*MsgCancelScheduledOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledOrder not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -404,6 +460,78 @@ func _Msg_ExecuteOrders_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CreateOrderGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateOrderGroup)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateOrderGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elys.tradeshield.Msg/CreateOrderGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateOrderGroup(ctx, req.(*MsgCreateOrderGroup))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelOrderGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelOrderGroup)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelOrderGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elys.tradeshield.Msg/CancelOrderGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelOrderGroup(ctx, req.(*MsgCancelOrderGroup))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateScheduledOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateScheduledOrder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateScheduledOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elys.tradeshield.Msg/CreateScheduledOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateScheduledOrder(ctx, req.(*MsgCreateScheduledOrder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelScheduledOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelScheduledOrder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelScheduledOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elys.tradeshield.Msg/CancelScheduledOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelScheduledOrder(ctx, req.(*MsgCancelScheduledOrder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -455,6 +583,22 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ExecuteOrders",
 			Handler:    _Msg_ExecuteOrders_Handler,
 		},
+		{
+			MethodName: "CreateOrderGroup",
+			Handler:    _Msg_CreateOrderGroup_Handler,
+		},
+		{
+			MethodName: "CancelOrderGroup",
+			Handler:    _Msg_CancelOrderGroup_Handler,
+		},
+		{
+			MethodName: "CreateScheduledOrder",
+			Handler:    _Msg_CreateScheduledOrder_Handler,
+		},
+		{
+			MethodName: "CancelScheduledOrder",
+			Handler:    _Msg_CancelScheduledOrder_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "elys/tradeshield/tx.proto",
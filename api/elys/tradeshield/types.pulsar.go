@@ -477,6 +477,12 @@ var (
 	fd_SpotOrder_order_target_denom protoreflect.FieldDescriptor
 	fd_SpotOrder_status             protoreflect.FieldDescriptor
 	fd_SpotOrder_date               protoreflect.FieldDescriptor
+	fd_SpotOrder_deposit            protoreflect.FieldDescriptor
+	fd_SpotOrder_time_in_force      protoreflect.FieldDescriptor
+	fd_SpotOrder_expiry             protoreflect.FieldDescriptor
+	fd_SpotOrder_trailing_stop      protoreflect.FieldDescriptor
+	fd_SpotOrder_group_id           protoreflect.FieldDescriptor
+	fd_SpotOrder_filled_amount      protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -490,6 +496,12 @@ func init() {
 	fd_SpotOrder_order_target_denom = md_SpotOrder.Fields().ByName("order_target_denom")
 	fd_SpotOrder_status = md_SpotOrder.Fields().ByName("status")
 	fd_SpotOrder_date = md_SpotOrder.Fields().ByName("date")
+	fd_SpotOrder_deposit = md_SpotOrder.Fields().ByName("deposit")
+	fd_SpotOrder_time_in_force = md_SpotOrder.Fields().ByName("time_in_force")
+	fd_SpotOrder_expiry = md_SpotOrder.Fields().ByName("expiry")
+	fd_SpotOrder_trailing_stop = md_SpotOrder.Fields().ByName("trailing_stop")
+	fd_SpotOrder_group_id = md_SpotOrder.Fields().ByName("group_id")
+	fd_SpotOrder_filled_amount = md_SpotOrder.Fields().ByName("filled_amount")
 }
 
 var _ protoreflect.Message = (*fastReflection_SpotOrder)(nil)
@@ -605,6 +617,42 @@ func (x *fastReflection_SpotOrder) Range(f func(protoreflect.FieldDescriptor, pr
 			return
 		}
 	}
+	if x.Deposit != nil {
+		value := protoreflect.ValueOfMessage(x.Deposit.ProtoReflect())
+		if !f(fd_SpotOrder_deposit, value) {
+			return
+		}
+	}
+	if x.TimeInForce != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.TimeInForce))
+		if !f(fd_SpotOrder_time_in_force, value) {
+			return
+		}
+	}
+	if x.Expiry != nil {
+		value := protoreflect.ValueOfMessage(x.Expiry.ProtoReflect())
+		if !f(fd_SpotOrder_expiry, value) {
+			return
+		}
+	}
+	if x.TrailingStop != nil {
+		value := protoreflect.ValueOfMessage(x.TrailingStop.ProtoReflect())
+		if !f(fd_SpotOrder_trailing_stop, value) {
+			return
+		}
+	}
+	if x.GroupId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.GroupId)
+		if !f(fd_SpotOrder_group_id, value) {
+			return
+		}
+	}
+	if x.FilledAmount != nil {
+		value := protoreflect.ValueOfMessage(x.FilledAmount.ProtoReflect())
+		if !f(fd_SpotOrder_filled_amount, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -636,6 +684,18 @@ func (x *fastReflection_SpotOrder) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.Status != 0
 	case "elys.tradeshield.SpotOrder.date":
 		return x.Date != nil
+	case "elys.tradeshield.SpotOrder.deposit":
+		return x.Deposit != nil
+	case "elys.tradeshield.SpotOrder.time_in_force":
+		return x.TimeInForce != 0
+	case "elys.tradeshield.SpotOrder.expiry":
+		return x.Expiry != nil
+	case "elys.tradeshield.SpotOrder.trailing_stop":
+		return x.TrailingStop != nil
+	case "elys.tradeshield.SpotOrder.group_id":
+		return x.GroupId != uint64(0)
+	case "elys.tradeshield.SpotOrder.filled_amount":
+		return x.FilledAmount != nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.SpotOrder"))
@@ -668,6 +728,18 @@ func (x *fastReflection_SpotOrder) Clear(fd protoreflect.FieldDescriptor) {
 		x.Status = 0
 	case "elys.tradeshield.SpotOrder.date":
 		x.Date = nil
+	case "elys.tradeshield.SpotOrder.deposit":
+		x.Deposit = nil
+	case "elys.tradeshield.SpotOrder.time_in_force":
+		x.TimeInForce = 0
+	case "elys.tradeshield.SpotOrder.expiry":
+		x.Expiry = nil
+	case "elys.tradeshield.SpotOrder.trailing_stop":
+		x.TrailingStop = nil
+	case "elys.tradeshield.SpotOrder.group_id":
+		x.GroupId = uint64(0)
+	case "elys.tradeshield.SpotOrder.filled_amount":
+		x.FilledAmount = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.SpotOrder"))
@@ -708,6 +780,24 @@ func (x *fastReflection_SpotOrder) Get(descriptor protoreflect.FieldDescriptor)
 	case "elys.tradeshield.SpotOrder.date":
 		value := x.Date
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "elys.tradeshield.SpotOrder.deposit":
+		value := x.Deposit
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "elys.tradeshield.SpotOrder.time_in_force":
+		value := x.TimeInForce
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "elys.tradeshield.SpotOrder.expiry":
+		value := x.Expiry
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "elys.tradeshield.SpotOrder.trailing_stop":
+		value := x.TrailingStop
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "elys.tradeshield.SpotOrder.group_id":
+		value := x.GroupId
+		return protoreflect.ValueOfUint64(value)
+	case "elys.tradeshield.SpotOrder.filled_amount":
+		value := x.FilledAmount
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.SpotOrder"))
@@ -744,6 +834,18 @@ func (x *fastReflection_SpotOrder) Set(fd protoreflect.FieldDescriptor, value pr
 		x.Status = (Status)(value.Enum())
 	case "elys.tradeshield.SpotOrder.date":
 		x.Date = value.Message().Interface().(*Date)
+	case "elys.tradeshield.SpotOrder.deposit":
+		x.Deposit = value.Message().Interface().(*v1beta1.Coin)
+	case "elys.tradeshield.SpotOrder.time_in_force":
+		x.TimeInForce = (TimeInForce)(value.Enum())
+	case "elys.tradeshield.SpotOrder.expiry":
+		x.Expiry = value.Message().Interface().(*Date)
+	case "elys.tradeshield.SpotOrder.trailing_stop":
+		x.TrailingStop = value.Message().Interface().(*TrailingStop)
+	case "elys.tradeshield.SpotOrder.group_id":
+		x.GroupId = value.Uint()
+	case "elys.tradeshield.SpotOrder.filled_amount":
+		x.FilledAmount = value.Message().Interface().(*v1beta1.Coin)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.SpotOrder"))
@@ -779,6 +881,26 @@ func (x *fastReflection_SpotOrder) Mutable(fd protoreflect.FieldDescriptor) prot
 			x.Date = new(Date)
 		}
 		return protoreflect.ValueOfMessage(x.Date.ProtoReflect())
+	case "elys.tradeshield.SpotOrder.deposit":
+		if x.Deposit == nil {
+			x.Deposit = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.Deposit.ProtoReflect())
+	case "elys.tradeshield.SpotOrder.expiry":
+		if x.Expiry == nil {
+			x.Expiry = new(Date)
+		}
+		return protoreflect.ValueOfMessage(x.Expiry.ProtoReflect())
+	case "elys.tradeshield.SpotOrder.trailing_stop":
+		if x.TrailingStop == nil {
+			x.TrailingStop = new(TrailingStop)
+		}
+		return protoreflect.ValueOfMessage(x.TrailingStop.ProtoReflect())
+	case "elys.tradeshield.SpotOrder.filled_amount":
+		if x.FilledAmount == nil {
+			x.FilledAmount = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.FilledAmount.ProtoReflect())
 	case "elys.tradeshield.SpotOrder.order_type":
 		panic(fmt.Errorf("field order_type of message elys.tradeshield.SpotOrder is not mutable"))
 	case "elys.tradeshield.SpotOrder.order_id":
@@ -789,6 +911,10 @@ func (x *fastReflection_SpotOrder) Mutable(fd protoreflect.FieldDescriptor) prot
 		panic(fmt.Errorf("field order_target_denom of message elys.tradeshield.SpotOrder is not mutable"))
 	case "elys.tradeshield.SpotOrder.status":
 		panic(fmt.Errorf("field status of message elys.tradeshield.SpotOrder is not mutable"))
+	case "elys.tradeshield.SpotOrder.time_in_force":
+		panic(fmt.Errorf("field time_in_force of message elys.tradeshield.SpotOrder is not mutable"))
+	case "elys.tradeshield.SpotOrder.group_id":
+		panic(fmt.Errorf("field group_id of message elys.tradeshield.SpotOrder is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.SpotOrder"))
@@ -821,6 +947,22 @@ func (x *fastReflection_SpotOrder) NewField(fd protoreflect.FieldDescriptor) pro
 	case "elys.tradeshield.SpotOrder.date":
 		m := new(Date)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "elys.tradeshield.SpotOrder.deposit":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "elys.tradeshield.SpotOrder.time_in_force":
+		return protoreflect.ValueOfEnum(0)
+	case "elys.tradeshield.SpotOrder.expiry":
+		m := new(Date)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "elys.tradeshield.SpotOrder.trailing_stop":
+		m := new(TrailingStop)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "elys.tradeshield.SpotOrder.group_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "elys.tradeshield.SpotOrder.filled_amount":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.SpotOrder"))
@@ -919,6 +1061,28 @@ func (x *fastReflection_SpotOrder) ProtoMethods() *protoiface.Methods {
 			l = options.Size(x.Date)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.Deposit != nil {
+			l = options.Size(x.Deposit)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.TimeInForce != 0 {
+			n += 1 + runtime.Sov(uint64(x.TimeInForce))
+		}
+		if x.Expiry != nil {
+			l = options.Size(x.Expiry)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.TrailingStop != nil {
+			l = options.Size(x.TrailingStop)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.GroupId != 0 {
+			n += 1 + runtime.Sov(uint64(x.GroupId))
+		}
+		if x.FilledAmount != nil {
+			l = options.Size(x.FilledAmount)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -948,6 +1112,72 @@ func (x *fastReflection_SpotOrder) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.FilledAmount != nil {
+			encoded, err := options.Marshal(x.FilledAmount)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x72
+		}
+		if x.GroupId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GroupId))
+			i--
+			dAtA[i] = 0x68
+		}
+		if x.TrailingStop != nil {
+			encoded, err := options.Marshal(x.TrailingStop)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x62
+		}
+		if x.Expiry != nil {
+			encoded, err := options.Marshal(x.Expiry)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x5a
+		}
+		if x.TimeInForce != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TimeInForce))
+			i--
+			dAtA[i] = 0x50
+		}
+		if x.Deposit != nil {
+			encoded, err := options.Marshal(x.Deposit)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x4a
+		}
 		if x.Date != nil {
 			encoded, err := options.Marshal(x.Date)
 			if err != nil {
@@ -1297,6 +1527,188 @@ func (x *fastReflection_SpotOrder) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Deposit == nil {
+					x.Deposit = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Deposit); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TimeInForce", wireType)
+				}
+				x.TimeInForce = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TimeInForce |= TimeInForce(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Expiry == nil {
+					x.Expiry = &Date{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Expiry); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TrailingStop", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.TrailingStop == nil {
+					x.TrailingStop = &TrailingStop{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TrailingStop); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 13:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+				}
+				x.GroupId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GroupId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 14:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FilledAmount", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.FilledAmount == nil {
+					x.FilledAmount = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FilledAmount); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2694,6 +3106,12 @@ var (
 	fd_PerpetualOrder_status               protoreflect.FieldDescriptor
 	fd_PerpetualOrder_stop_loss_price      protoreflect.FieldDescriptor
 	fd_PerpetualOrder_poolId               protoreflect.FieldDescriptor
+	fd_PerpetualOrder_close_amount         protoreflect.FieldDescriptor
+	fd_PerpetualOrder_deposit              protoreflect.FieldDescriptor
+	fd_PerpetualOrder_time_in_force        protoreflect.FieldDescriptor
+	fd_PerpetualOrder_expiry               protoreflect.FieldDescriptor
+	fd_PerpetualOrder_trailing_stop        protoreflect.FieldDescriptor
+	fd_PerpetualOrder_group_id             protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -2712,6 +3130,12 @@ func init() {
 	fd_PerpetualOrder_status = md_PerpetualOrder.Fields().ByName("status")
 	fd_PerpetualOrder_stop_loss_price = md_PerpetualOrder.Fields().ByName("stop_loss_price")
 	fd_PerpetualOrder_poolId = md_PerpetualOrder.Fields().ByName("poolId")
+	fd_PerpetualOrder_close_amount = md_PerpetualOrder.Fields().ByName("close_amount")
+	fd_PerpetualOrder_deposit = md_PerpetualOrder.Fields().ByName("deposit")
+	fd_PerpetualOrder_time_in_force = md_PerpetualOrder.Fields().ByName("time_in_force")
+	fd_PerpetualOrder_expiry = md_PerpetualOrder.Fields().ByName("expiry")
+	fd_PerpetualOrder_trailing_stop = md_PerpetualOrder.Fields().ByName("trailing_stop")
+	fd_PerpetualOrder_group_id = md_PerpetualOrder.Fields().ByName("group_id")
 }
 
 var _ protoreflect.Message = (*fastReflection_PerpetualOrder)(nil)
@@ -2857,6 +3281,42 @@ func (x *fastReflection_PerpetualOrder) Range(f func(protoreflect.FieldDescripto
 			return
 		}
 	}
+	if x.CloseAmount != "" {
+		value := protoreflect.ValueOfString(x.CloseAmount)
+		if !f(fd_PerpetualOrder_close_amount, value) {
+			return
+		}
+	}
+	if x.Deposit != nil {
+		value := protoreflect.ValueOfMessage(x.Deposit.ProtoReflect())
+		if !f(fd_PerpetualOrder_deposit, value) {
+			return
+		}
+	}
+	if x.TimeInForce != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.TimeInForce))
+		if !f(fd_PerpetualOrder_time_in_force, value) {
+			return
+		}
+	}
+	if x.Expiry != nil {
+		value := protoreflect.ValueOfMessage(x.Expiry.ProtoReflect())
+		if !f(fd_PerpetualOrder_expiry, value) {
+			return
+		}
+	}
+	if x.TrailingStop != nil {
+		value := protoreflect.ValueOfMessage(x.TrailingStop.ProtoReflect())
+		if !f(fd_PerpetualOrder_trailing_stop, value) {
+			return
+		}
+	}
+	if x.GroupId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.GroupId)
+		if !f(fd_PerpetualOrder_group_id, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -2898,6 +3358,18 @@ func (x *fastReflection_PerpetualOrder) Has(fd protoreflect.FieldDescriptor) boo
 		return x.StopLossPrice != ""
 	case "elys.tradeshield.PerpetualOrder.poolId":
 		return x.PoolId != uint64(0)
+	case "elys.tradeshield.PerpetualOrder.close_amount":
+		return x.CloseAmount != ""
+	case "elys.tradeshield.PerpetualOrder.deposit":
+		return x.Deposit != nil
+	case "elys.tradeshield.PerpetualOrder.time_in_force":
+		return x.TimeInForce != 0
+	case "elys.tradeshield.PerpetualOrder.expiry":
+		return x.Expiry != nil
+	case "elys.tradeshield.PerpetualOrder.trailing_stop":
+		return x.TrailingStop != nil
+	case "elys.tradeshield.PerpetualOrder.group_id":
+		return x.GroupId != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.PerpetualOrder"))
@@ -2940,6 +3412,18 @@ func (x *fastReflection_PerpetualOrder) Clear(fd protoreflect.FieldDescriptor) {
 		x.StopLossPrice = ""
 	case "elys.tradeshield.PerpetualOrder.poolId":
 		x.PoolId = uint64(0)
+	case "elys.tradeshield.PerpetualOrder.close_amount":
+		x.CloseAmount = ""
+	case "elys.tradeshield.PerpetualOrder.deposit":
+		x.Deposit = nil
+	case "elys.tradeshield.PerpetualOrder.time_in_force":
+		x.TimeInForce = 0
+	case "elys.tradeshield.PerpetualOrder.expiry":
+		x.Expiry = nil
+	case "elys.tradeshield.PerpetualOrder.trailing_stop":
+		x.TrailingStop = nil
+	case "elys.tradeshield.PerpetualOrder.group_id":
+		x.GroupId = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.PerpetualOrder"))
@@ -2995,6 +3479,24 @@ func (x *fastReflection_PerpetualOrder) Get(descriptor protoreflect.FieldDescrip
 	case "elys.tradeshield.PerpetualOrder.poolId":
 		value := x.PoolId
 		return protoreflect.ValueOfUint64(value)
+	case "elys.tradeshield.PerpetualOrder.close_amount":
+		value := x.CloseAmount
+		return protoreflect.ValueOfString(value)
+	case "elys.tradeshield.PerpetualOrder.deposit":
+		value := x.Deposit
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "elys.tradeshield.PerpetualOrder.time_in_force":
+		value := x.TimeInForce
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "elys.tradeshield.PerpetualOrder.expiry":
+		value := x.Expiry
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "elys.tradeshield.PerpetualOrder.trailing_stop":
+		value := x.TrailingStop
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "elys.tradeshield.PerpetualOrder.group_id":
+		value := x.GroupId
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.PerpetualOrder"))
@@ -3041,6 +3543,18 @@ func (x *fastReflection_PerpetualOrder) Set(fd protoreflect.FieldDescriptor, val
 		x.StopLossPrice = value.Interface().(string)
 	case "elys.tradeshield.PerpetualOrder.poolId":
 		x.PoolId = value.Uint()
+	case "elys.tradeshield.PerpetualOrder.close_amount":
+		x.CloseAmount = value.Interface().(string)
+	case "elys.tradeshield.PerpetualOrder.deposit":
+		x.Deposit = value.Message().Interface().(*v1beta1.Coin)
+	case "elys.tradeshield.PerpetualOrder.time_in_force":
+		x.TimeInForce = (TimeInForce)(value.Enum())
+	case "elys.tradeshield.PerpetualOrder.expiry":
+		x.Expiry = value.Message().Interface().(*Date)
+	case "elys.tradeshield.PerpetualOrder.trailing_stop":
+		x.TrailingStop = value.Message().Interface().(*TrailingStop)
+	case "elys.tradeshield.PerpetualOrder.group_id":
+		x.GroupId = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.PerpetualOrder"))
@@ -3071,6 +3585,21 @@ func (x *fastReflection_PerpetualOrder) Mutable(fd protoreflect.FieldDescriptor)
 			x.Collateral = new(v1beta1.Coin)
 		}
 		return protoreflect.ValueOfMessage(x.Collateral.ProtoReflect())
+	case "elys.tradeshield.PerpetualOrder.deposit":
+		if x.Deposit == nil {
+			x.Deposit = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.Deposit.ProtoReflect())
+	case "elys.tradeshield.PerpetualOrder.expiry":
+		if x.Expiry == nil {
+			x.Expiry = new(Date)
+		}
+		return protoreflect.ValueOfMessage(x.Expiry.ProtoReflect())
+	case "elys.tradeshield.PerpetualOrder.trailing_stop":
+		if x.TrailingStop == nil {
+			x.TrailingStop = new(TrailingStop)
+		}
+		return protoreflect.ValueOfMessage(x.TrailingStop.ProtoReflect())
 	case "elys.tradeshield.PerpetualOrder.order_id":
 		panic(fmt.Errorf("field order_id of message elys.tradeshield.PerpetualOrder is not mutable"))
 	case "elys.tradeshield.PerpetualOrder.owner_address":
@@ -3093,6 +3622,12 @@ func (x *fastReflection_PerpetualOrder) Mutable(fd protoreflect.FieldDescriptor)
 		panic(fmt.Errorf("field stop_loss_price of message elys.tradeshield.PerpetualOrder is not mutable"))
 	case "elys.tradeshield.PerpetualOrder.poolId":
 		panic(fmt.Errorf("field poolId of message elys.tradeshield.PerpetualOrder is not mutable"))
+	case "elys.tradeshield.PerpetualOrder.close_amount":
+		panic(fmt.Errorf("field close_amount of message elys.tradeshield.PerpetualOrder is not mutable"))
+	case "elys.tradeshield.PerpetualOrder.time_in_force":
+		panic(fmt.Errorf("field time_in_force of message elys.tradeshield.PerpetualOrder is not mutable"))
+	case "elys.tradeshield.PerpetualOrder.group_id":
+		panic(fmt.Errorf("field group_id of message elys.tradeshield.PerpetualOrder is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.PerpetualOrder"))
@@ -3134,6 +3669,21 @@ func (x *fastReflection_PerpetualOrder) NewField(fd protoreflect.FieldDescriptor
 		return protoreflect.ValueOfString("")
 	case "elys.tradeshield.PerpetualOrder.poolId":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "elys.tradeshield.PerpetualOrder.close_amount":
+		return protoreflect.ValueOfString("")
+	case "elys.tradeshield.PerpetualOrder.deposit":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "elys.tradeshield.PerpetualOrder.time_in_force":
+		return protoreflect.ValueOfEnum(0)
+	case "elys.tradeshield.PerpetualOrder.expiry":
+		m := new(Date)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "elys.tradeshield.PerpetualOrder.trailing_stop":
+		m := new(TrailingStop)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "elys.tradeshield.PerpetualOrder.group_id":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.PerpetualOrder"))
@@ -3249,6 +3799,28 @@ func (x *fastReflection_PerpetualOrder) ProtoMethods() *protoiface.Methods {
 		if x.PoolId != 0 {
 			n += 1 + runtime.Sov(uint64(x.PoolId))
 		}
+		l = len(x.CloseAmount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Deposit != nil {
+			l = options.Size(x.Deposit)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.TimeInForce != 0 {
+			n += 2 + runtime.Sov(uint64(x.TimeInForce))
+		}
+		if x.Expiry != nil {
+			l = options.Size(x.Expiry)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.TrailingStop != nil {
+			l = options.Size(x.TrailingStop)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.GroupId != 0 {
+			n += 2 + runtime.Sov(uint64(x.GroupId))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -3278,6 +3850,73 @@ func (x *fastReflection_PerpetualOrder) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.GroupId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GroupId))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x98
+		}
+		if x.TrailingStop != nil {
+			encoded, err := options.Marshal(x.TrailingStop)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+		if x.Expiry != nil {
+			encoded, err := options.Marshal(x.Expiry)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+		if x.TimeInForce != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TimeInForce))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x80
+		}
+		if x.Deposit != nil {
+			encoded, err := options.Marshal(x.Deposit)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x7a
+		}
+		if len(x.CloseAmount) > 0 {
+			i -= len(x.CloseAmount)
+			copy(dAtA[i:], x.CloseAmount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CloseAmount)))
+			i--
+			dAtA[i] = 0x72
+		}
 		if x.PoolId != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.PoolId))
 			i--
@@ -3766,6 +4405,184 @@ func (x *fastReflection_PerpetualOrder) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 14:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CloseAmount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CloseAmount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 15:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Deposit == nil {
+					x.Deposit = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Deposit); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 16:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TimeInForce", wireType)
+				}
+				x.TimeInForce = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TimeInForce |= TimeInForce(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 17:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Expiry == nil {
+					x.Expiry = &Date{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Expiry); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 18:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TrailingStop", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.TrailingStop == nil {
+					x.TrailingStop = &TrailingStop{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TrailingStop); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 19:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+				}
+				x.GroupId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GroupId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -3802,33 +4619,31 @@ func (x *fastReflection_PerpetualOrder) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_PerpetualOrderExtraInfo                      protoreflect.MessageDescriptor
-	fd_PerpetualOrderExtraInfo_perpetual_order      protoreflect.FieldDescriptor
-	fd_PerpetualOrderExtraInfo_position_size        protoreflect.FieldDescriptor
-	fd_PerpetualOrderExtraInfo_liquidation_price    protoreflect.FieldDescriptor
-	fd_PerpetualOrderExtraInfo_funding_rate         protoreflect.FieldDescriptor
-	fd_PerpetualOrderExtraInfo_borrow_interest_rate protoreflect.FieldDescriptor
+	md_BracketOrder               protoreflect.MessageDescriptor
+	fd_BracketOrder_order_type    protoreflect.FieldDescriptor
+	fd_BracketOrder_trigger_price protoreflect.FieldDescriptor
+	fd_BracketOrder_close_amount  protoreflect.FieldDescriptor
+	fd_BracketOrder_deposit       protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_elys_tradeshield_types_proto_init()
-	md_PerpetualOrderExtraInfo = File_elys_tradeshield_types_proto.Messages().ByName("PerpetualOrderExtraInfo")
-	fd_PerpetualOrderExtraInfo_perpetual_order = md_PerpetualOrderExtraInfo.Fields().ByName("perpetual_order")
-	fd_PerpetualOrderExtraInfo_position_size = md_PerpetualOrderExtraInfo.Fields().ByName("position_size")
-	fd_PerpetualOrderExtraInfo_liquidation_price = md_PerpetualOrderExtraInfo.Fields().ByName("liquidation_price")
-	fd_PerpetualOrderExtraInfo_funding_rate = md_PerpetualOrderExtraInfo.Fields().ByName("funding_rate")
-	fd_PerpetualOrderExtraInfo_borrow_interest_rate = md_PerpetualOrderExtraInfo.Fields().ByName("borrow_interest_rate")
+	md_BracketOrder = File_elys_tradeshield_types_proto.Messages().ByName("BracketOrder")
+	fd_BracketOrder_order_type = md_BracketOrder.Fields().ByName("order_type")
+	fd_BracketOrder_trigger_price = md_BracketOrder.Fields().ByName("trigger_price")
+	fd_BracketOrder_close_amount = md_BracketOrder.Fields().ByName("close_amount")
+	fd_BracketOrder_deposit = md_BracketOrder.Fields().ByName("deposit")
 }
 
-var _ protoreflect.Message = (*fastReflection_PerpetualOrderExtraInfo)(nil)
+var _ protoreflect.Message = (*fastReflection_BracketOrder)(nil)
 
-type fastReflection_PerpetualOrderExtraInfo PerpetualOrderExtraInfo
+type fastReflection_BracketOrder BracketOrder
 
-func (x *PerpetualOrderExtraInfo) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_PerpetualOrderExtraInfo)(x)
+func (x *BracketOrder) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_BracketOrder)(x)
 }
 
-func (x *PerpetualOrderExtraInfo) slowProtoReflect() protoreflect.Message {
+func (x *BracketOrder) slowProtoReflect() protoreflect.Message {
 	mi := &file_elys_tradeshield_types_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3840,43 +4655,43 @@ func (x *PerpetualOrderExtraInfo) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_PerpetualOrderExtraInfo_messageType fastReflection_PerpetualOrderExtraInfo_messageType
-var _ protoreflect.MessageType = fastReflection_PerpetualOrderExtraInfo_messageType{}
+var _fastReflection_BracketOrder_messageType fastReflection_BracketOrder_messageType
+var _ protoreflect.MessageType = fastReflection_BracketOrder_messageType{}
 
-type fastReflection_PerpetualOrderExtraInfo_messageType struct{}
+type fastReflection_BracketOrder_messageType struct{}
 
-func (x fastReflection_PerpetualOrderExtraInfo_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_PerpetualOrderExtraInfo)(nil)
+func (x fastReflection_BracketOrder_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_BracketOrder)(nil)
 }
-func (x fastReflection_PerpetualOrderExtraInfo_messageType) New() protoreflect.Message {
-	return new(fastReflection_PerpetualOrderExtraInfo)
+func (x fastReflection_BracketOrder_messageType) New() protoreflect.Message {
+	return new(fastReflection_BracketOrder)
 }
-func (x fastReflection_PerpetualOrderExtraInfo_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_PerpetualOrderExtraInfo
+func (x fastReflection_BracketOrder_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_BracketOrder
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_PerpetualOrderExtraInfo) Descriptor() protoreflect.MessageDescriptor {
-	return md_PerpetualOrderExtraInfo
+func (x *fastReflection_BracketOrder) Descriptor() protoreflect.MessageDescriptor {
+	return md_BracketOrder
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_PerpetualOrderExtraInfo) Type() protoreflect.MessageType {
-	return _fastReflection_PerpetualOrderExtraInfo_messageType
+func (x *fastReflection_BracketOrder) Type() protoreflect.MessageType {
+	return _fastReflection_BracketOrder_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_PerpetualOrderExtraInfo) New() protoreflect.Message {
-	return new(fastReflection_PerpetualOrderExtraInfo)
+func (x *fastReflection_BracketOrder) New() protoreflect.Message {
+	return new(fastReflection_BracketOrder)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_PerpetualOrderExtraInfo) Interface() protoreflect.ProtoMessage {
-	return (*PerpetualOrderExtraInfo)(x)
+func (x *fastReflection_BracketOrder) Interface() protoreflect.ProtoMessage {
+	return (*BracketOrder)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3884,34 +4699,28 @@ func (x *fastReflection_PerpetualOrderExtraInfo) Interface() protoreflect.ProtoM
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_PerpetualOrderExtraInfo) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.PerpetualOrder != nil {
-		value := protoreflect.ValueOfMessage(x.PerpetualOrder.ProtoReflect())
-		if !f(fd_PerpetualOrderExtraInfo_perpetual_order, value) {
-			return
-		}
-	}
-	if x.PositionSize != nil {
-		value := protoreflect.ValueOfMessage(x.PositionSize.ProtoReflect())
-		if !f(fd_PerpetualOrderExtraInfo_position_size, value) {
+func (x *fastReflection_BracketOrder) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.OrderType != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.OrderType))
+		if !f(fd_BracketOrder_order_type, value) {
 			return
 		}
 	}
-	if x.LiquidationPrice != "" {
-		value := protoreflect.ValueOfString(x.LiquidationPrice)
-		if !f(fd_PerpetualOrderExtraInfo_liquidation_price, value) {
+	if x.TriggerPrice != "" {
+		value := protoreflect.ValueOfString(x.TriggerPrice)
+		if !f(fd_BracketOrder_trigger_price, value) {
 			return
 		}
 	}
-	if x.FundingRate != "" {
-		value := protoreflect.ValueOfString(x.FundingRate)
-		if !f(fd_PerpetualOrderExtraInfo_funding_rate, value) {
+	if x.CloseAmount != "" {
+		value := protoreflect.ValueOfString(x.CloseAmount)
+		if !f(fd_BracketOrder_close_amount, value) {
 			return
 		}
 	}
-	if x.BorrowInterestRate != "" {
-		value := protoreflect.ValueOfString(x.BorrowInterestRate)
-		if !f(fd_PerpetualOrderExtraInfo_borrow_interest_rate, value) {
+	if x.Deposit != nil {
+		value := protoreflect.ValueOfMessage(x.Deposit.ProtoReflect())
+		if !f(fd_BracketOrder_deposit, value) {
 			return
 		}
 	}
@@ -3928,23 +4737,21 @@ func (x *fastReflection_PerpetualOrderExtraInfo) Range(f func(protoreflect.Field
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_PerpetualOrderExtraInfo) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_BracketOrder) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "elys.tradeshield.PerpetualOrderExtraInfo.perpetual_order":
-		return x.PerpetualOrder != nil
-	case "elys.tradeshield.PerpetualOrderExtraInfo.position_size":
-		return x.PositionSize != nil
-	case "elys.tradeshield.PerpetualOrderExtraInfo.liquidation_price":
-		return x.LiquidationPrice != ""
-	case "elys.tradeshield.PerpetualOrderExtraInfo.funding_rate":
-		return x.FundingRate != ""
-	case "elys.tradeshield.PerpetualOrderExtraInfo.borrow_interest_rate":
-		return x.BorrowInterestRate != ""
+	case "elys.tradeshield.BracketOrder.order_type":
+		return x.OrderType != 0
+	case "elys.tradeshield.BracketOrder.trigger_price":
+		return x.TriggerPrice != ""
+	case "elys.tradeshield.BracketOrder.close_amount":
+		return x.CloseAmount != ""
+	case "elys.tradeshield.BracketOrder.deposit":
+		return x.Deposit != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.PerpetualOrderExtraInfo"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.BracketOrder"))
 		}
-		panic(fmt.Errorf("message elys.tradeshield.PerpetualOrderExtraInfo does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message elys.tradeshield.BracketOrder does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3954,23 +4761,21 @@ func (x *fastReflection_PerpetualOrderExtraInfo) Has(fd protoreflect.FieldDescri
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_PerpetualOrderExtraInfo) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_BracketOrder) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "elys.tradeshield.PerpetualOrderExtraInfo.perpetual_order":
-		x.PerpetualOrder = nil
-	case "elys.tradeshield.PerpetualOrderExtraInfo.position_size":
-		x.PositionSize = nil
-	case "elys.tradeshield.PerpetualOrderExtraInfo.liquidation_price":
-		x.LiquidationPrice = ""
-	case "elys.tradeshield.PerpetualOrderExtraInfo.funding_rate":
-		x.FundingRate = ""
-	case "elys.tradeshield.PerpetualOrderExtraInfo.borrow_interest_rate":
-		x.BorrowInterestRate = ""
+	case "elys.tradeshield.BracketOrder.order_type":
+		x.OrderType = 0
+	case "elys.tradeshield.BracketOrder.trigger_price":
+		x.TriggerPrice = ""
+	case "elys.tradeshield.BracketOrder.close_amount":
+		x.CloseAmount = ""
+	case "elys.tradeshield.BracketOrder.deposit":
+		x.Deposit = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.PerpetualOrderExtraInfo"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.BracketOrder"))
 		}
-		panic(fmt.Errorf("message elys.tradeshield.PerpetualOrderExtraInfo does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message elys.tradeshield.BracketOrder does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3980,28 +4785,25 @@ func (x *fastReflection_PerpetualOrderExtraInfo) Clear(fd protoreflect.FieldDesc
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_PerpetualOrderExtraInfo) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_BracketOrder) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "elys.tradeshield.PerpetualOrderExtraInfo.perpetual_order":
-		value := x.PerpetualOrder
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "elys.tradeshield.PerpetualOrderExtraInfo.position_size":
-		value := x.PositionSize
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "elys.tradeshield.PerpetualOrderExtraInfo.liquidation_price":
-		value := x.LiquidationPrice
-		return protoreflect.ValueOfString(value)
-	case "elys.tradeshield.PerpetualOrderExtraInfo.funding_rate":
-		value := x.FundingRate
+	case "elys.tradeshield.BracketOrder.order_type":
+		value := x.OrderType
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "elys.tradeshield.BracketOrder.trigger_price":
+		value := x.TriggerPrice
 		return protoreflect.ValueOfString(value)
-	case "elys.tradeshield.PerpetualOrderExtraInfo.borrow_interest_rate":
-		value := x.BorrowInterestRate
+	case "elys.tradeshield.BracketOrder.close_amount":
+		value := x.CloseAmount
 		return protoreflect.ValueOfString(value)
+	case "elys.tradeshield.BracketOrder.deposit":
+		value := x.Deposit
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.PerpetualOrderExtraInfo"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.BracketOrder"))
 		}
-		panic(fmt.Errorf("message elys.tradeshield.PerpetualOrderExtraInfo does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message elys.tradeshield.BracketOrder does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4015,23 +4817,21 @@ func (x *fastReflection_PerpetualOrderExtraInfo) Get(descriptor protoreflect.Fie
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_PerpetualOrderExtraInfo) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_BracketOrder) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "elys.tradeshield.PerpetualOrderExtraInfo.perpetual_order":
-		x.PerpetualOrder = value.Message().Interface().(*PerpetualOrder)
-	case "elys.tradeshield.PerpetualOrderExtraInfo.position_size":
-		x.PositionSize = value.Message().Interface().(*v1beta1.Coin)
-	case "elys.tradeshield.PerpetualOrderExtraInfo.liquidation_price":
-		x.LiquidationPrice = value.Interface().(string)
-	case "elys.tradeshield.PerpetualOrderExtraInfo.funding_rate":
-		x.FundingRate = value.Interface().(string)
-	case "elys.tradeshield.PerpetualOrderExtraInfo.borrow_interest_rate":
-		x.BorrowInterestRate = value.Interface().(string)
+	case "elys.tradeshield.BracketOrder.order_type":
+		x.OrderType = (PerpetualOrderType)(value.Enum())
+	case "elys.tradeshield.BracketOrder.trigger_price":
+		x.TriggerPrice = value.Interface().(string)
+	case "elys.tradeshield.BracketOrder.close_amount":
+		x.CloseAmount = value.Interface().(string)
+	case "elys.tradeshield.BracketOrder.deposit":
+		x.Deposit = value.Message().Interface().(*v1beta1.Coin)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.PerpetualOrderExtraInfo"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.BracketOrder"))
 		}
-		panic(fmt.Errorf("message elys.tradeshield.PerpetualOrderExtraInfo does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message elys.tradeshield.BracketOrder does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4045,64 +4845,56 @@ func (x *fastReflection_PerpetualOrderExtraInfo) Set(fd protoreflect.FieldDescri
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_PerpetualOrderExtraInfo) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_BracketOrder) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "elys.tradeshield.PerpetualOrderExtraInfo.perpetual_order":
-		if x.PerpetualOrder == nil {
-			x.PerpetualOrder = new(PerpetualOrder)
-		}
-		return protoreflect.ValueOfMessage(x.PerpetualOrder.ProtoReflect())
-	case "elys.tradeshield.PerpetualOrderExtraInfo.position_size":
-		if x.PositionSize == nil {
-			x.PositionSize = new(v1beta1.Coin)
-		}
-		return protoreflect.ValueOfMessage(x.PositionSize.ProtoReflect())
-	case "elys.tradeshield.PerpetualOrderExtraInfo.liquidation_price":
-		panic(fmt.Errorf("field liquidation_price of message elys.tradeshield.PerpetualOrderExtraInfo is not mutable"))
-	case "elys.tradeshield.PerpetualOrderExtraInfo.funding_rate":
-		panic(fmt.Errorf("field funding_rate of message elys.tradeshield.PerpetualOrderExtraInfo is not mutable"))
-	case "elys.tradeshield.PerpetualOrderExtraInfo.borrow_interest_rate":
-		panic(fmt.Errorf("field borrow_interest_rate of message elys.tradeshield.PerpetualOrderExtraInfo is not mutable"))
+	case "elys.tradeshield.BracketOrder.deposit":
+		if x.Deposit == nil {
+			x.Deposit = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.Deposit.ProtoReflect())
+	case "elys.tradeshield.BracketOrder.order_type":
+		panic(fmt.Errorf("field order_type of message elys.tradeshield.BracketOrder is not mutable"))
+	case "elys.tradeshield.BracketOrder.trigger_price":
+		panic(fmt.Errorf("field trigger_price of message elys.tradeshield.BracketOrder is not mutable"))
+	case "elys.tradeshield.BracketOrder.close_amount":
+		panic(fmt.Errorf("field close_amount of message elys.tradeshield.BracketOrder is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.PerpetualOrderExtraInfo"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.BracketOrder"))
 		}
-		panic(fmt.Errorf("message elys.tradeshield.PerpetualOrderExtraInfo does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message elys.tradeshield.BracketOrder does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_PerpetualOrderExtraInfo) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_BracketOrder) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "elys.tradeshield.PerpetualOrderExtraInfo.perpetual_order":
-		m := new(PerpetualOrder)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "elys.tradeshield.PerpetualOrderExtraInfo.position_size":
-		m := new(v1beta1.Coin)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "elys.tradeshield.PerpetualOrderExtraInfo.liquidation_price":
-		return protoreflect.ValueOfString("")
-	case "elys.tradeshield.PerpetualOrderExtraInfo.funding_rate":
+	case "elys.tradeshield.BracketOrder.order_type":
+		return protoreflect.ValueOfEnum(0)
+	case "elys.tradeshield.BracketOrder.trigger_price":
 		return protoreflect.ValueOfString("")
-	case "elys.tradeshield.PerpetualOrderExtraInfo.borrow_interest_rate":
+	case "elys.tradeshield.BracketOrder.close_amount":
 		return protoreflect.ValueOfString("")
+	case "elys.tradeshield.BracketOrder.deposit":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.PerpetualOrderExtraInfo"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.BracketOrder"))
 		}
-		panic(fmt.Errorf("message elys.tradeshield.PerpetualOrderExtraInfo does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message elys.tradeshield.BracketOrder does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_PerpetualOrderExtraInfo) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_BracketOrder) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in elys.tradeshield.PerpetualOrderExtraInfo", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in elys.tradeshield.BracketOrder", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4110,7 +4902,7 @@ func (x *fastReflection_PerpetualOrderExtraInfo) WhichOneof(d protoreflect.Oneof
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_PerpetualOrderExtraInfo) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_BracketOrder) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4121,7 +4913,7 @@ func (x *fastReflection_PerpetualOrderExtraInfo) GetUnknown() protoreflect.RawFi
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_PerpetualOrderExtraInfo) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_BracketOrder) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4133,7 +4925,7 @@ func (x *fastReflection_PerpetualOrderExtraInfo) SetUnknown(fields protoreflect.
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_PerpetualOrderExtraInfo) IsValid() bool {
+func (x *fastReflection_BracketOrder) IsValid() bool {
 	return x != nil
 }
 
@@ -4143,9 +4935,9 @@ func (x *fastReflection_PerpetualOrderExtraInfo) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_PerpetualOrderExtraInfo) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_BracketOrder) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*PerpetualOrderExtraInfo)
+		x := input.Message.Interface().(*BracketOrder)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4157,24 +4949,19 @@ func (x *fastReflection_PerpetualOrderExtraInfo) ProtoMethods() *protoiface.Meth
 		var n int
 		var l int
 		_ = l
-		if x.PerpetualOrder != nil {
-			l = options.Size(x.PerpetualOrder)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.PositionSize != nil {
-			l = options.Size(x.PositionSize)
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.OrderType != 0 {
+			n += 1 + runtime.Sov(uint64(x.OrderType))
 		}
-		l = len(x.LiquidationPrice)
+		l = len(x.TriggerPrice)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.FundingRate)
+		l = len(x.CloseAmount)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.BorrowInterestRate)
-		if l > 0 {
+		if x.Deposit != nil {
+			l = options.Size(x.Deposit)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -4187,7 +4974,7 @@ func (x *fastReflection_PerpetualOrderExtraInfo) ProtoMethods() *protoiface.Meth
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*PerpetualOrderExtraInfo)
+		x := input.Message.Interface().(*BracketOrder)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4206,29 +4993,8 @@ func (x *fastReflection_PerpetualOrderExtraInfo) ProtoMethods() *protoiface.Meth
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.BorrowInterestRate) > 0 {
-			i -= len(x.BorrowInterestRate)
-			copy(dAtA[i:], x.BorrowInterestRate)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BorrowInterestRate)))
-			i--
-			dAtA[i] = 0x2a
-		}
-		if len(x.FundingRate) > 0 {
-			i -= len(x.FundingRate)
-			copy(dAtA[i:], x.FundingRate)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FundingRate)))
-			i--
-			dAtA[i] = 0x22
-		}
-		if len(x.LiquidationPrice) > 0 {
-			i -= len(x.LiquidationPrice)
-			copy(dAtA[i:], x.LiquidationPrice)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LiquidationPrice)))
-			i--
-			dAtA[i] = 0x1a
-		}
-		if x.PositionSize != nil {
-			encoded, err := options.Marshal(x.PositionSize)
+		if x.Deposit != nil {
+			encoded, err := options.Marshal(x.Deposit)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4239,21 +5005,26 @@ func (x *fastReflection_PerpetualOrderExtraInfo) ProtoMethods() *protoiface.Meth
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.CloseAmount) > 0 {
+			i -= len(x.CloseAmount)
+			copy(dAtA[i:], x.CloseAmount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CloseAmount)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.TriggerPrice) > 0 {
+			i -= len(x.TriggerPrice)
+			copy(dAtA[i:], x.TriggerPrice)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TriggerPrice)))
+			i--
 			dAtA[i] = 0x12
 		}
-		if x.PerpetualOrder != nil {
-			encoded, err := options.Marshal(x.PerpetualOrder)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if x.OrderType != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OrderType))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -4266,7 +5037,7 @@ func (x *fastReflection_PerpetualOrderExtraInfo) ProtoMethods() *protoiface.Meth
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*PerpetualOrderExtraInfo)
+		x := input.Message.Interface().(*BracketOrder)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4298,17 +5069,17 @@ func (x *fastReflection_PerpetualOrderExtraInfo) ProtoMethods() *protoiface.Meth
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PerpetualOrderExtraInfo: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BracketOrder: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PerpetualOrderExtraInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BracketOrder: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PerpetualOrder", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OrderType", wireType)
 				}
-				var msglen int
+				x.OrderType = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4318,33 +5089,16 @@ func (x *fastReflection_PerpetualOrderExtraInfo) ProtoMethods() *protoiface.Meth
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					x.OrderType |= PerpetualOrderType(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.PerpetualOrder == nil {
-					x.PerpetualOrder = &PerpetualOrder{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PerpetualOrder); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PositionSize", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TriggerPrice", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4354,31 +5108,27 @@ func (x *fastReflection_PerpetualOrderExtraInfo) ProtoMethods() *protoiface.Meth
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.PositionSize == nil {
-					x.PositionSize = &v1beta1.Coin{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PositionSize); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.TriggerPrice = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LiquidationPrice", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CloseAmount", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -4406,13 +5156,13 @@ func (x *fastReflection_PerpetualOrderExtraInfo) ProtoMethods() *protoiface.Meth
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.LiquidationPrice = string(dAtA[iNdEx:postIndex])
+				x.CloseAmount = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 4:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FundingRate", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4422,55 +5172,27 @@ func (x *fastReflection_PerpetualOrderExtraInfo) ProtoMethods() *protoiface.Meth
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.FundingRate = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 5:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BorrowInterestRate", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				if x.Deposit == nil {
+					x.Deposit = &v1beta1.Coin{}
 				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Deposit); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				x.BorrowInterestRate = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -4507,539 +5229,4691 @@ func (x *fastReflection_PerpetualOrderExtraInfo) ProtoMethods() *protoiface.Meth
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: elys/tradeshield/types.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
-
-type Status int32
+var _ protoreflect.List = (*_OrderGroup_3_list)(nil)
 
-const (
-	Status_PENDING  Status = 0
-	Status_EXECUTED Status = 1
-	Status_CANCELED Status = 2
-	Status_ALL      Status = 3
-)
+type _OrderGroup_3_list struct {
+	list *[]uint64
+}
 
-// Enum value maps for Status.
-var (
-	Status_name = map[int32]string{
-		0: "PENDING",
-		1: "EXECUTED",
-		2: "CANCELED",
-		3: "ALL",
-	}
-	Status_value = map[string]int32{
-		"PENDING":  0,
-		"EXECUTED": 1,
-		"CANCELED": 2,
-		"ALL":      3,
+func (x *_OrderGroup_3_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
-)
+	return len(*x.list)
+}
 
-func (x Status) Enum() *Status {
-	p := new(Status)
-	*p = x
-	return p
+func (x *_OrderGroup_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfUint64((*x.list)[i])
 }
 
-func (x Status) String() string {
-	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+func (x *_OrderGroup_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
 }
 
-func (Status) Descriptor() protoreflect.EnumDescriptor {
-	return file_elys_tradeshield_types_proto_enumTypes[0].Descriptor()
+func (x *_OrderGroup_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
 }
 
-func (Status) Type() protoreflect.EnumType {
-	return &file_elys_tradeshield_types_proto_enumTypes[0]
+func (x *_OrderGroup_3_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message OrderGroup at list field SpotOrderIds as it is not of Message kind"))
 }
 
-func (x Status) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
+func (x *_OrderGroup_3_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
 }
 
-// Deprecated: Use Status.Descriptor instead.
-func (Status) EnumDescriptor() ([]byte, []int) {
-	return file_elys_tradeshield_types_proto_rawDescGZIP(), []int{0}
+func (x *_OrderGroup_3_list) NewElement() protoreflect.Value {
+	v := uint64(0)
+	return protoreflect.ValueOfUint64(v)
 }
 
-// Perpetual Order
-type PerpetualOrderType int32
+func (x *_OrderGroup_3_list) IsValid() bool {
+	return x.list != nil
+}
 
-const (
-	PerpetualOrderType_LIMITOPEN    PerpetualOrderType = 0
-	PerpetualOrderType_LIMITCLOSE   PerpetualOrderType = 1
-	PerpetualOrderType_STOPLOSSPERP PerpetualOrderType = 2
-)
+var _ protoreflect.List = (*_OrderGroup_4_list)(nil)
 
-// Enum value maps for PerpetualOrderType.
-var (
-	PerpetualOrderType_name = map[int32]string{
-		0: "LIMITOPEN",
-		1: "LIMITCLOSE",
-		2: "STOPLOSSPERP",
-	}
-	PerpetualOrderType_value = map[string]int32{
-		"LIMITOPEN":    0,
-		"LIMITCLOSE":   1,
-		"STOPLOSSPERP": 2,
+type _OrderGroup_4_list struct {
+	list *[]uint64
+}
+
+func (x *_OrderGroup_4_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
-)
+	return len(*x.list)
+}
 
-func (x PerpetualOrderType) Enum() *PerpetualOrderType {
-	p := new(PerpetualOrderType)
-	*p = x
-	return p
+func (x *_OrderGroup_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfUint64((*x.list)[i])
 }
 
-func (x PerpetualOrderType) String() string {
-	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+func (x *_OrderGroup_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
 }
 
-func (PerpetualOrderType) Descriptor() protoreflect.EnumDescriptor {
-	return file_elys_tradeshield_types_proto_enumTypes[1].Descriptor()
+func (x *_OrderGroup_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
 }
 
-func (PerpetualOrderType) Type() protoreflect.EnumType {
-	return &file_elys_tradeshield_types_proto_enumTypes[1]
+func (x *_OrderGroup_4_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message OrderGroup at list field PerpetualOrderIds as it is not of Message kind"))
 }
 
-func (x PerpetualOrderType) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
+func (x *_OrderGroup_4_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
 }
 
-// Deprecated: Use PerpetualOrderType.Descriptor instead.
-func (PerpetualOrderType) EnumDescriptor() ([]byte, []int) {
-	return file_elys_tradeshield_types_proto_rawDescGZIP(), []int{1}
+func (x *_OrderGroup_4_list) NewElement() protoreflect.Value {
+	v := uint64(0)
+	return protoreflect.ValueOfUint64(v)
 }
 
-type Date struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *_OrderGroup_4_list) IsValid() bool {
+	return x.list != nil
+}
 
-	Height    uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
-	Timestamp uint64 `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+var _ protoreflect.List = (*_OrderGroup_6_list)(nil)
+
+type _OrderGroup_6_list struct {
+	list *[]*BracketOrder
 }
 
-func (x *Date) Reset() {
-	*x = Date{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_elys_tradeshield_types_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *_OrderGroup_6_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
+	return len(*x.list)
 }
 
-func (x *Date) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *_OrderGroup_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (*Date) ProtoMessage() {}
+func (x *_OrderGroup_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*BracketOrder)
+	(*x.list)[i] = concreteValue
+}
 
-// Deprecated: Use Date.ProtoReflect.Descriptor instead.
-func (*Date) Descriptor() ([]byte, []int) {
-	return file_elys_tradeshield_types_proto_rawDescGZIP(), []int{0}
+func (x *_OrderGroup_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*BracketOrder)
+	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *Date) GetHeight() uint64 {
-	if x != nil {
-		return x.Height
-	}
-	return 0
+func (x *_OrderGroup_6_list) AppendMutable() protoreflect.Value {
+	v := new(BracketOrder)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *Date) GetTimestamp() uint64 {
-	if x != nil {
-		return x.Timestamp
+func (x *_OrderGroup_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
-	return 0
+	*x.list = (*x.list)[:n]
 }
 
-type SpotOrder struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	OrderType        SpotOrderType `protobuf:"varint,1,opt,name=order_type,json=orderType,proto3,enum=elys.tradeshield.SpotOrderType" json:"order_type,omitempty"`
-	OrderId          uint64        `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	OrderPrice       *OrderPrice   `protobuf:"bytes,3,opt,name=order_price,json=orderPrice,proto3" json:"order_price,omitempty"`
-	OrderAmount      *v1beta1.Coin `protobuf:"bytes,4,opt,name=order_amount,json=orderAmount,proto3" json:"order_amount,omitempty"`
-	OwnerAddress     string        `protobuf:"bytes,5,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
-	OrderTargetDenom string        `protobuf:"bytes,6,opt,name=order_target_denom,json=orderTargetDenom,proto3" json:"order_target_denom,omitempty"`
-	Status           Status        `protobuf:"varint,7,opt,name=status,proto3,enum=elys.tradeshield.Status" json:"status,omitempty"`
-	Date             *Date         `protobuf:"bytes,8,opt,name=date,proto3" json:"date,omitempty"`
+func (x *_OrderGroup_6_list) NewElement() protoreflect.Value {
+	v := new(BracketOrder)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *SpotOrder) Reset() {
-	*x = SpotOrder{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_elys_tradeshield_types_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x *_OrderGroup_6_list) IsValid() bool {
+	return x.list != nil
 }
 
-func (x *SpotOrder) String() string {
-	return protoimpl.X.MessageStringOf(x)
+var (
+	md_OrderGroup                     protoreflect.MessageDescriptor
+	fd_OrderGroup_id                  protoreflect.FieldDescriptor
+	fd_OrderGroup_owner_address       protoreflect.FieldDescriptor
+	fd_OrderGroup_spot_order_ids      protoreflect.FieldDescriptor
+	fd_OrderGroup_perpetual_order_ids protoreflect.FieldDescriptor
+	fd_OrderGroup_parent_order_id     protoreflect.FieldDescriptor
+	fd_OrderGroup_bracket_orders      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_elys_tradeshield_types_proto_init()
+	md_OrderGroup = File_elys_tradeshield_types_proto.Messages().ByName("OrderGroup")
+	fd_OrderGroup_id = md_OrderGroup.Fields().ByName("id")
+	fd_OrderGroup_owner_address = md_OrderGroup.Fields().ByName("owner_address")
+	fd_OrderGroup_spot_order_ids = md_OrderGroup.Fields().ByName("spot_order_ids")
+	fd_OrderGroup_perpetual_order_ids = md_OrderGroup.Fields().ByName("perpetual_order_ids")
+	fd_OrderGroup_parent_order_id = md_OrderGroup.Fields().ByName("parent_order_id")
+	fd_OrderGroup_bracket_orders = md_OrderGroup.Fields().ByName("bracket_orders")
 }
 
-func (*SpotOrder) ProtoMessage() {}
+var _ protoreflect.Message = (*fastReflection_OrderGroup)(nil)
 
-// Deprecated: Use SpotOrder.ProtoReflect.Descriptor instead.
-func (*SpotOrder) Descriptor() ([]byte, []int) {
-	return file_elys_tradeshield_types_proto_rawDescGZIP(), []int{1}
-}
+type fastReflection_OrderGroup OrderGroup
 
-func (x *SpotOrder) GetOrderType() SpotOrderType {
-	if x != nil {
-		return x.OrderType
-	}
-	return SpotOrderType_STOPLOSS
+func (x *OrderGroup) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_OrderGroup)(x)
 }
 
-func (x *SpotOrder) GetOrderId() uint64 {
-	if x != nil {
-		return x.OrderId
+func (x *OrderGroup) slowProtoReflect() protoreflect.Message {
+	mi := &file_elys_tradeshield_types_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *SpotOrder) GetOrderPrice() *OrderPrice {
-	if x != nil {
-		return x.OrderPrice
-	}
-	return nil
-}
+var _fastReflection_OrderGroup_messageType fastReflection_OrderGroup_messageType
+var _ protoreflect.MessageType = fastReflection_OrderGroup_messageType{}
 
-func (x *SpotOrder) GetOrderAmount() *v1beta1.Coin {
-	if x != nil {
-		return x.OrderAmount
-	}
-	return nil
-}
+type fastReflection_OrderGroup_messageType struct{}
 
-func (x *SpotOrder) GetOwnerAddress() string {
-	if x != nil {
-		return x.OwnerAddress
-	}
-	return ""
+func (x fastReflection_OrderGroup_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_OrderGroup)(nil)
+}
+func (x fastReflection_OrderGroup_messageType) New() protoreflect.Message {
+	return new(fastReflection_OrderGroup)
+}
+func (x fastReflection_OrderGroup_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_OrderGroup
 }
 
-func (x *SpotOrder) GetOrderTargetDenom() string {
-	if x != nil {
-		return x.OrderTargetDenom
-	}
-	return ""
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_OrderGroup) Descriptor() protoreflect.MessageDescriptor {
+	return md_OrderGroup
 }
 
-func (x *SpotOrder) GetStatus() Status {
-	if x != nil {
-		return x.Status
-	}
-	return Status_PENDING
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_OrderGroup) Type() protoreflect.MessageType {
+	return _fastReflection_OrderGroup_messageType
 }
 
-func (x *SpotOrder) GetDate() *Date {
-	if x != nil {
-		return x.Date
-	}
-	return nil
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_OrderGroup) New() protoreflect.Message {
+	return new(fastReflection_OrderGroup)
 }
 
-type LegacyPerpetualOrder struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_OrderGroup) Interface() protoreflect.ProtoMessage {
+	return (*OrderGroup)(x)
+}
 
-	OrderId            uint64             `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	OwnerAddress       string             `protobuf:"bytes,2,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
-	PerpetualOrderType PerpetualOrderType `protobuf:"varint,3,opt,name=perpetual_order_type,json=perpetualOrderType,proto3,enum=elys.tradeshield.PerpetualOrderType" json:"perpetual_order_type,omitempty"`
-	Position           PerpetualPosition  `protobuf:"varint,4,opt,name=position,proto3,enum=elys.tradeshield.PerpetualPosition" json:"position,omitempty"`
-	TriggerPrice       *OrderPrice        `protobuf:"bytes,5,opt,name=trigger_price,json=triggerPrice,proto3" json:"trigger_price,omitempty"`
-	Collateral         *v1beta1.Coin      `protobuf:"bytes,6,opt,name=collateral,proto3" json:"collateral,omitempty"`
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_OrderGroup) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_OrderGroup_id, value) {
+			return
+		}
+	}
+	if x.OwnerAddress != "" {
+		value := protoreflect.ValueOfString(x.OwnerAddress)
+		if !f(fd_OrderGroup_owner_address, value) {
+			return
+		}
+	}
+	if len(x.SpotOrderIds) != 0 {
+		value := protoreflect.ValueOfList(&_OrderGroup_3_list{list: &x.SpotOrderIds})
+		if !f(fd_OrderGroup_spot_order_ids, value) {
+			return
+		}
+	}
+	if len(x.PerpetualOrderIds) != 0 {
+		value := protoreflect.ValueOfList(&_OrderGroup_4_list{list: &x.PerpetualOrderIds})
+		if !f(fd_OrderGroup_perpetual_order_ids, value) {
+			return
+		}
+	}
+	if x.ParentOrderId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ParentOrderId)
+		if !f(fd_OrderGroup_parent_order_id, value) {
+			return
+		}
+	}
+	if len(x.BracketOrders) != 0 {
+		value := protoreflect.ValueOfList(&_OrderGroup_6_list{list: &x.BracketOrders})
+		if !f(fd_OrderGroup_bracket_orders, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_OrderGroup) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "elys.tradeshield.OrderGroup.id":
+		return x.Id != uint64(0)
+	case "elys.tradeshield.OrderGroup.owner_address":
+		return x.OwnerAddress != ""
+	case "elys.tradeshield.OrderGroup.spot_order_ids":
+		return len(x.SpotOrderIds) != 0
+	case "elys.tradeshield.OrderGroup.perpetual_order_ids":
+		return len(x.PerpetualOrderIds) != 0
+	case "elys.tradeshield.OrderGroup.parent_order_id":
+		return x.ParentOrderId != uint64(0)
+	case "elys.tradeshield.OrderGroup.bracket_orders":
+		return len(x.BracketOrders) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.OrderGroup"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.OrderGroup does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OrderGroup) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "elys.tradeshield.OrderGroup.id":
+		x.Id = uint64(0)
+	case "elys.tradeshield.OrderGroup.owner_address":
+		x.OwnerAddress = ""
+	case "elys.tradeshield.OrderGroup.spot_order_ids":
+		x.SpotOrderIds = nil
+	case "elys.tradeshield.OrderGroup.perpetual_order_ids":
+		x.PerpetualOrderIds = nil
+	case "elys.tradeshield.OrderGroup.parent_order_id":
+		x.ParentOrderId = uint64(0)
+	case "elys.tradeshield.OrderGroup.bracket_orders":
+		x.BracketOrders = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.OrderGroup"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.OrderGroup does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_OrderGroup) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "elys.tradeshield.OrderGroup.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "elys.tradeshield.OrderGroup.owner_address":
+		value := x.OwnerAddress
+		return protoreflect.ValueOfString(value)
+	case "elys.tradeshield.OrderGroup.spot_order_ids":
+		if len(x.SpotOrderIds) == 0 {
+			return protoreflect.ValueOfList(&_OrderGroup_3_list{})
+		}
+		listValue := &_OrderGroup_3_list{list: &x.SpotOrderIds}
+		return protoreflect.ValueOfList(listValue)
+	case "elys.tradeshield.OrderGroup.perpetual_order_ids":
+		if len(x.PerpetualOrderIds) == 0 {
+			return protoreflect.ValueOfList(&_OrderGroup_4_list{})
+		}
+		listValue := &_OrderGroup_4_list{list: &x.PerpetualOrderIds}
+		return protoreflect.ValueOfList(listValue)
+	case "elys.tradeshield.OrderGroup.parent_order_id":
+		value := x.ParentOrderId
+		return protoreflect.ValueOfUint64(value)
+	case "elys.tradeshield.OrderGroup.bracket_orders":
+		if len(x.BracketOrders) == 0 {
+			return protoreflect.ValueOfList(&_OrderGroup_6_list{})
+		}
+		listValue := &_OrderGroup_6_list{list: &x.BracketOrders}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.OrderGroup"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.OrderGroup does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OrderGroup) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "elys.tradeshield.OrderGroup.id":
+		x.Id = value.Uint()
+	case "elys.tradeshield.OrderGroup.owner_address":
+		x.OwnerAddress = value.Interface().(string)
+	case "elys.tradeshield.OrderGroup.spot_order_ids":
+		lv := value.List()
+		clv := lv.(*_OrderGroup_3_list)
+		x.SpotOrderIds = *clv.list
+	case "elys.tradeshield.OrderGroup.perpetual_order_ids":
+		lv := value.List()
+		clv := lv.(*_OrderGroup_4_list)
+		x.PerpetualOrderIds = *clv.list
+	case "elys.tradeshield.OrderGroup.parent_order_id":
+		x.ParentOrderId = value.Uint()
+	case "elys.tradeshield.OrderGroup.bracket_orders":
+		lv := value.List()
+		clv := lv.(*_OrderGroup_6_list)
+		x.BracketOrders = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.OrderGroup"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.OrderGroup does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OrderGroup) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "elys.tradeshield.OrderGroup.spot_order_ids":
+		if x.SpotOrderIds == nil {
+			x.SpotOrderIds = []uint64{}
+		}
+		value := &_OrderGroup_3_list{list: &x.SpotOrderIds}
+		return protoreflect.ValueOfList(value)
+	case "elys.tradeshield.OrderGroup.perpetual_order_ids":
+		if x.PerpetualOrderIds == nil {
+			x.PerpetualOrderIds = []uint64{}
+		}
+		value := &_OrderGroup_4_list{list: &x.PerpetualOrderIds}
+		return protoreflect.ValueOfList(value)
+	case "elys.tradeshield.OrderGroup.bracket_orders":
+		if x.BracketOrders == nil {
+			x.BracketOrders = []*BracketOrder{}
+		}
+		value := &_OrderGroup_6_list{list: &x.BracketOrders}
+		return protoreflect.ValueOfList(value)
+	case "elys.tradeshield.OrderGroup.id":
+		panic(fmt.Errorf("field id of message elys.tradeshield.OrderGroup is not mutable"))
+	case "elys.tradeshield.OrderGroup.owner_address":
+		panic(fmt.Errorf("field owner_address of message elys.tradeshield.OrderGroup is not mutable"))
+	case "elys.tradeshield.OrderGroup.parent_order_id":
+		panic(fmt.Errorf("field parent_order_id of message elys.tradeshield.OrderGroup is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.OrderGroup"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.OrderGroup does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_OrderGroup) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "elys.tradeshield.OrderGroup.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "elys.tradeshield.OrderGroup.owner_address":
+		return protoreflect.ValueOfString("")
+	case "elys.tradeshield.OrderGroup.spot_order_ids":
+		list := []uint64{}
+		return protoreflect.ValueOfList(&_OrderGroup_3_list{list: &list})
+	case "elys.tradeshield.OrderGroup.perpetual_order_ids":
+		list := []uint64{}
+		return protoreflect.ValueOfList(&_OrderGroup_4_list{list: &list})
+	case "elys.tradeshield.OrderGroup.parent_order_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "elys.tradeshield.OrderGroup.bracket_orders":
+		list := []*BracketOrder{}
+		return protoreflect.ValueOfList(&_OrderGroup_6_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.OrderGroup"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.OrderGroup does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_OrderGroup) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in elys.tradeshield.OrderGroup", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_OrderGroup) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OrderGroup) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_OrderGroup) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_OrderGroup) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*OrderGroup)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		l = len(x.OwnerAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.SpotOrderIds) > 0 {
+			l = 0
+			for _, e := range x.SpotOrderIds {
+				l += runtime.Sov(uint64(e))
+			}
+			n += 1 + runtime.Sov(uint64(l)) + l
+		}
+		if len(x.PerpetualOrderIds) > 0 {
+			l = 0
+			for _, e := range x.PerpetualOrderIds {
+				l += runtime.Sov(uint64(e))
+			}
+			n += 1 + runtime.Sov(uint64(l)) + l
+		}
+		if x.ParentOrderId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ParentOrderId))
+		}
+		if len(x.BracketOrders) > 0 {
+			for _, e := range x.BracketOrders {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*OrderGroup)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.BracketOrders) > 0 {
+			for iNdEx := len(x.BracketOrders) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.BracketOrders[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if x.ParentOrderId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ParentOrderId))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.PerpetualOrderIds) > 0 {
+			var pksize2 int
+			for _, num := range x.PerpetualOrderIds {
+				pksize2 += runtime.Sov(uint64(num))
+			}
+			i -= pksize2
+			j1 := i
+			for _, num := range x.PerpetualOrderIds {
+				for num >= 1<<7 {
+					dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
+					num >>= 7
+					j1++
+				}
+				dAtA[j1] = uint8(num)
+				j1++
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(pksize2))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.SpotOrderIds) > 0 {
+			var pksize4 int
+			for _, num := range x.SpotOrderIds {
+				pksize4 += runtime.Sov(uint64(num))
+			}
+			i -= pksize4
+			j3 := i
+			for _, num := range x.SpotOrderIds {
+				for num >= 1<<7 {
+					dAtA[j3] = uint8(uint64(num)&0x7f | 0x80)
+					num >>= 7
+					j3++
+				}
+				dAtA[j3] = uint8(num)
+				j3++
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(pksize4))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.OwnerAddress) > 0 {
+			i -= len(x.OwnerAddress)
+			copy(dAtA[i:], x.OwnerAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OwnerAddress)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*OrderGroup)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OrderGroup: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OrderGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.OwnerAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType == 0 {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.SpotOrderIds = append(x.SpotOrderIds, v)
+				} else if wireType == 2 {
+					var packedLen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						packedLen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if packedLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postIndex := iNdEx + packedLen
+					if postIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					var elementCount int
+					var count int
+					for _, integer := range dAtA[iNdEx:postIndex] {
+						if integer < 128 {
+							count++
+						}
+					}
+					elementCount = count
+					if elementCount != 0 && len(x.SpotOrderIds) == 0 {
+						x.SpotOrderIds = make([]uint64, 0, elementCount)
+					}
+					for iNdEx < postIndex {
+						var v uint64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							v |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						x.SpotOrderIds = append(x.SpotOrderIds, v)
+					}
+				} else {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SpotOrderIds", wireType)
+				}
+			case 4:
+				if wireType == 0 {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.PerpetualOrderIds = append(x.PerpetualOrderIds, v)
+				} else if wireType == 2 {
+					var packedLen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						packedLen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if packedLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postIndex := iNdEx + packedLen
+					if postIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					var elementCount int
+					var count int
+					for _, integer := range dAtA[iNdEx:postIndex] {
+						if integer < 128 {
+							count++
+						}
+					}
+					elementCount = count
+					if elementCount != 0 && len(x.PerpetualOrderIds) == 0 {
+						x.PerpetualOrderIds = make([]uint64, 0, elementCount)
+					}
+					for iNdEx < postIndex {
+						var v uint64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							v |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						x.PerpetualOrderIds = append(x.PerpetualOrderIds, v)
+					}
+				} else {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PerpetualOrderIds", wireType)
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ParentOrderId", wireType)
+				}
+				x.ParentOrderId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ParentOrderId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BracketOrders", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BracketOrders = append(x.BracketOrders, &BracketOrder{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BracketOrders[len(x.BracketOrders)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ScheduledOrder                  protoreflect.MessageDescriptor
+	fd_ScheduledOrder_id               protoreflect.FieldDescriptor
+	fd_ScheduledOrder_owner_address    protoreflect.FieldDescriptor
+	fd_ScheduledOrder_total_amount     protoreflect.FieldDescriptor
+	fd_ScheduledOrder_remaining_amount protoreflect.FieldDescriptor
+	fd_ScheduledOrder_target_denom     protoreflect.FieldDescriptor
+	fd_ScheduledOrder_slice_count      protoreflect.FieldDescriptor
+	fd_ScheduledOrder_executed_slices  protoreflect.FieldDescriptor
+	fd_ScheduledOrder_interval         protoreflect.FieldDescriptor
+	fd_ScheduledOrder_interval_unit    protoreflect.FieldDescriptor
+	fd_ScheduledOrder_max_slippage     protoreflect.FieldDescriptor
+	fd_ScheduledOrder_next_execution   protoreflect.FieldDescriptor
+	fd_ScheduledOrder_received_amount  protoreflect.FieldDescriptor
+	fd_ScheduledOrder_date             protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_elys_tradeshield_types_proto_init()
+	md_ScheduledOrder = File_elys_tradeshield_types_proto.Messages().ByName("ScheduledOrder")
+	fd_ScheduledOrder_id = md_ScheduledOrder.Fields().ByName("id")
+	fd_ScheduledOrder_owner_address = md_ScheduledOrder.Fields().ByName("owner_address")
+	fd_ScheduledOrder_total_amount = md_ScheduledOrder.Fields().ByName("total_amount")
+	fd_ScheduledOrder_remaining_amount = md_ScheduledOrder.Fields().ByName("remaining_amount")
+	fd_ScheduledOrder_target_denom = md_ScheduledOrder.Fields().ByName("target_denom")
+	fd_ScheduledOrder_slice_count = md_ScheduledOrder.Fields().ByName("slice_count")
+	fd_ScheduledOrder_executed_slices = md_ScheduledOrder.Fields().ByName("executed_slices")
+	fd_ScheduledOrder_interval = md_ScheduledOrder.Fields().ByName("interval")
+	fd_ScheduledOrder_interval_unit = md_ScheduledOrder.Fields().ByName("interval_unit")
+	fd_ScheduledOrder_max_slippage = md_ScheduledOrder.Fields().ByName("max_slippage")
+	fd_ScheduledOrder_next_execution = md_ScheduledOrder.Fields().ByName("next_execution")
+	fd_ScheduledOrder_received_amount = md_ScheduledOrder.Fields().ByName("received_amount")
+	fd_ScheduledOrder_date = md_ScheduledOrder.Fields().ByName("date")
+}
+
+var _ protoreflect.Message = (*fastReflection_ScheduledOrder)(nil)
+
+type fastReflection_ScheduledOrder ScheduledOrder
+
+func (x *ScheduledOrder) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ScheduledOrder)(x)
+}
+
+func (x *ScheduledOrder) slowProtoReflect() protoreflect.Message {
+	mi := &file_elys_tradeshield_types_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ScheduledOrder_messageType fastReflection_ScheduledOrder_messageType
+var _ protoreflect.MessageType = fastReflection_ScheduledOrder_messageType{}
+
+type fastReflection_ScheduledOrder_messageType struct{}
+
+func (x fastReflection_ScheduledOrder_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ScheduledOrder)(nil)
+}
+func (x fastReflection_ScheduledOrder_messageType) New() protoreflect.Message {
+	return new(fastReflection_ScheduledOrder)
+}
+func (x fastReflection_ScheduledOrder_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ScheduledOrder
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ScheduledOrder) Descriptor() protoreflect.MessageDescriptor {
+	return md_ScheduledOrder
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ScheduledOrder) Type() protoreflect.MessageType {
+	return _fastReflection_ScheduledOrder_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ScheduledOrder) New() protoreflect.Message {
+	return new(fastReflection_ScheduledOrder)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ScheduledOrder) Interface() protoreflect.ProtoMessage {
+	return (*ScheduledOrder)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ScheduledOrder) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_ScheduledOrder_id, value) {
+			return
+		}
+	}
+	if x.OwnerAddress != "" {
+		value := protoreflect.ValueOfString(x.OwnerAddress)
+		if !f(fd_ScheduledOrder_owner_address, value) {
+			return
+		}
+	}
+	if x.TotalAmount != nil {
+		value := protoreflect.ValueOfMessage(x.TotalAmount.ProtoReflect())
+		if !f(fd_ScheduledOrder_total_amount, value) {
+			return
+		}
+	}
+	if x.RemainingAmount != nil {
+		value := protoreflect.ValueOfMessage(x.RemainingAmount.ProtoReflect())
+		if !f(fd_ScheduledOrder_remaining_amount, value) {
+			return
+		}
+	}
+	if x.TargetDenom != "" {
+		value := protoreflect.ValueOfString(x.TargetDenom)
+		if !f(fd_ScheduledOrder_target_denom, value) {
+			return
+		}
+	}
+	if x.SliceCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SliceCount)
+		if !f(fd_ScheduledOrder_slice_count, value) {
+			return
+		}
+	}
+	if x.ExecutedSlices != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ExecutedSlices)
+		if !f(fd_ScheduledOrder_executed_slices, value) {
+			return
+		}
+	}
+	if x.Interval != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Interval)
+		if !f(fd_ScheduledOrder_interval, value) {
+			return
+		}
+	}
+	if x.IntervalUnit != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.IntervalUnit))
+		if !f(fd_ScheduledOrder_interval_unit, value) {
+			return
+		}
+	}
+	if x.MaxSlippage != "" {
+		value := protoreflect.ValueOfString(x.MaxSlippage)
+		if !f(fd_ScheduledOrder_max_slippage, value) {
+			return
+		}
+	}
+	if x.NextExecution != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.NextExecution)
+		if !f(fd_ScheduledOrder_next_execution, value) {
+			return
+		}
+	}
+	if x.ReceivedAmount != nil {
+		value := protoreflect.ValueOfMessage(x.ReceivedAmount.ProtoReflect())
+		if !f(fd_ScheduledOrder_received_amount, value) {
+			return
+		}
+	}
+	if x.Date != nil {
+		value := protoreflect.ValueOfMessage(x.Date.ProtoReflect())
+		if !f(fd_ScheduledOrder_date, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ScheduledOrder) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "elys.tradeshield.ScheduledOrder.id":
+		return x.Id != uint64(0)
+	case "elys.tradeshield.ScheduledOrder.owner_address":
+		return x.OwnerAddress != ""
+	case "elys.tradeshield.ScheduledOrder.total_amount":
+		return x.TotalAmount != nil
+	case "elys.tradeshield.ScheduledOrder.remaining_amount":
+		return x.RemainingAmount != nil
+	case "elys.tradeshield.ScheduledOrder.target_denom":
+		return x.TargetDenom != ""
+	case "elys.tradeshield.ScheduledOrder.slice_count":
+		return x.SliceCount != uint64(0)
+	case "elys.tradeshield.ScheduledOrder.executed_slices":
+		return x.ExecutedSlices != uint64(0)
+	case "elys.tradeshield.ScheduledOrder.interval":
+		return x.Interval != uint64(0)
+	case "elys.tradeshield.ScheduledOrder.interval_unit":
+		return x.IntervalUnit != 0
+	case "elys.tradeshield.ScheduledOrder.max_slippage":
+		return x.MaxSlippage != ""
+	case "elys.tradeshield.ScheduledOrder.next_execution":
+		return x.NextExecution != uint64(0)
+	case "elys.tradeshield.ScheduledOrder.received_amount":
+		return x.ReceivedAmount != nil
+	case "elys.tradeshield.ScheduledOrder.date":
+		return x.Date != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.ScheduledOrder"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.ScheduledOrder does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ScheduledOrder) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "elys.tradeshield.ScheduledOrder.id":
+		x.Id = uint64(0)
+	case "elys.tradeshield.ScheduledOrder.owner_address":
+		x.OwnerAddress = ""
+	case "elys.tradeshield.ScheduledOrder.total_amount":
+		x.TotalAmount = nil
+	case "elys.tradeshield.ScheduledOrder.remaining_amount":
+		x.RemainingAmount = nil
+	case "elys.tradeshield.ScheduledOrder.target_denom":
+		x.TargetDenom = ""
+	case "elys.tradeshield.ScheduledOrder.slice_count":
+		x.SliceCount = uint64(0)
+	case "elys.tradeshield.ScheduledOrder.executed_slices":
+		x.ExecutedSlices = uint64(0)
+	case "elys.tradeshield.ScheduledOrder.interval":
+		x.Interval = uint64(0)
+	case "elys.tradeshield.ScheduledOrder.interval_unit":
+		x.IntervalUnit = 0
+	case "elys.tradeshield.ScheduledOrder.max_slippage":
+		x.MaxSlippage = ""
+	case "elys.tradeshield.ScheduledOrder.next_execution":
+		x.NextExecution = uint64(0)
+	case "elys.tradeshield.ScheduledOrder.received_amount":
+		x.ReceivedAmount = nil
+	case "elys.tradeshield.ScheduledOrder.date":
+		x.Date = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.ScheduledOrder"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.ScheduledOrder does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ScheduledOrder) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "elys.tradeshield.ScheduledOrder.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "elys.tradeshield.ScheduledOrder.owner_address":
+		value := x.OwnerAddress
+		return protoreflect.ValueOfString(value)
+	case "elys.tradeshield.ScheduledOrder.total_amount":
+		value := x.TotalAmount
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "elys.tradeshield.ScheduledOrder.remaining_amount":
+		value := x.RemainingAmount
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "elys.tradeshield.ScheduledOrder.target_denom":
+		value := x.TargetDenom
+		return protoreflect.ValueOfString(value)
+	case "elys.tradeshield.ScheduledOrder.slice_count":
+		value := x.SliceCount
+		return protoreflect.ValueOfUint64(value)
+	case "elys.tradeshield.ScheduledOrder.executed_slices":
+		value := x.ExecutedSlices
+		return protoreflect.ValueOfUint64(value)
+	case "elys.tradeshield.ScheduledOrder.interval":
+		value := x.Interval
+		return protoreflect.ValueOfUint64(value)
+	case "elys.tradeshield.ScheduledOrder.interval_unit":
+		value := x.IntervalUnit
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "elys.tradeshield.ScheduledOrder.max_slippage":
+		value := x.MaxSlippage
+		return protoreflect.ValueOfString(value)
+	case "elys.tradeshield.ScheduledOrder.next_execution":
+		value := x.NextExecution
+		return protoreflect.ValueOfUint64(value)
+	case "elys.tradeshield.ScheduledOrder.received_amount":
+		value := x.ReceivedAmount
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "elys.tradeshield.ScheduledOrder.date":
+		value := x.Date
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.ScheduledOrder"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.ScheduledOrder does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ScheduledOrder) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "elys.tradeshield.ScheduledOrder.id":
+		x.Id = value.Uint()
+	case "elys.tradeshield.ScheduledOrder.owner_address":
+		x.OwnerAddress = value.Interface().(string)
+	case "elys.tradeshield.ScheduledOrder.total_amount":
+		x.TotalAmount = value.Message().Interface().(*v1beta1.Coin)
+	case "elys.tradeshield.ScheduledOrder.remaining_amount":
+		x.RemainingAmount = value.Message().Interface().(*v1beta1.Coin)
+	case "elys.tradeshield.ScheduledOrder.target_denom":
+		x.TargetDenom = value.Interface().(string)
+	case "elys.tradeshield.ScheduledOrder.slice_count":
+		x.SliceCount = value.Uint()
+	case "elys.tradeshield.ScheduledOrder.executed_slices":
+		x.ExecutedSlices = value.Uint()
+	case "elys.tradeshield.ScheduledOrder.interval":
+		x.Interval = value.Uint()
+	case "elys.tradeshield.ScheduledOrder.interval_unit":
+		x.IntervalUnit = (ScheduleIntervalUnit)(value.Enum())
+	case "elys.tradeshield.ScheduledOrder.max_slippage":
+		x.MaxSlippage = value.Interface().(string)
+	case "elys.tradeshield.ScheduledOrder.next_execution":
+		x.NextExecution = value.Uint()
+	case "elys.tradeshield.ScheduledOrder.received_amount":
+		x.ReceivedAmount = value.Message().Interface().(*v1beta1.Coin)
+	case "elys.tradeshield.ScheduledOrder.date":
+		x.Date = value.Message().Interface().(*Date)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.ScheduledOrder"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.ScheduledOrder does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ScheduledOrder) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "elys.tradeshield.ScheduledOrder.total_amount":
+		if x.TotalAmount == nil {
+			x.TotalAmount = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.TotalAmount.ProtoReflect())
+	case "elys.tradeshield.ScheduledOrder.remaining_amount":
+		if x.RemainingAmount == nil {
+			x.RemainingAmount = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.RemainingAmount.ProtoReflect())
+	case "elys.tradeshield.ScheduledOrder.received_amount":
+		if x.ReceivedAmount == nil {
+			x.ReceivedAmount = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.ReceivedAmount.ProtoReflect())
+	case "elys.tradeshield.ScheduledOrder.date":
+		if x.Date == nil {
+			x.Date = new(Date)
+		}
+		return protoreflect.ValueOfMessage(x.Date.ProtoReflect())
+	case "elys.tradeshield.ScheduledOrder.id":
+		panic(fmt.Errorf("field id of message elys.tradeshield.ScheduledOrder is not mutable"))
+	case "elys.tradeshield.ScheduledOrder.owner_address":
+		panic(fmt.Errorf("field owner_address of message elys.tradeshield.ScheduledOrder is not mutable"))
+	case "elys.tradeshield.ScheduledOrder.target_denom":
+		panic(fmt.Errorf("field target_denom of message elys.tradeshield.ScheduledOrder is not mutable"))
+	case "elys.tradeshield.ScheduledOrder.slice_count":
+		panic(fmt.Errorf("field slice_count of message elys.tradeshield.ScheduledOrder is not mutable"))
+	case "elys.tradeshield.ScheduledOrder.executed_slices":
+		panic(fmt.Errorf("field executed_slices of message elys.tradeshield.ScheduledOrder is not mutable"))
+	case "elys.tradeshield.ScheduledOrder.interval":
+		panic(fmt.Errorf("field interval of message elys.tradeshield.ScheduledOrder is not mutable"))
+	case "elys.tradeshield.ScheduledOrder.interval_unit":
+		panic(fmt.Errorf("field interval_unit of message elys.tradeshield.ScheduledOrder is not mutable"))
+	case "elys.tradeshield.ScheduledOrder.max_slippage":
+		panic(fmt.Errorf("field max_slippage of message elys.tradeshield.ScheduledOrder is not mutable"))
+	case "elys.tradeshield.ScheduledOrder.next_execution":
+		panic(fmt.Errorf("field next_execution of message elys.tradeshield.ScheduledOrder is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.ScheduledOrder"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.ScheduledOrder does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ScheduledOrder) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "elys.tradeshield.ScheduledOrder.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "elys.tradeshield.ScheduledOrder.owner_address":
+		return protoreflect.ValueOfString("")
+	case "elys.tradeshield.ScheduledOrder.total_amount":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "elys.tradeshield.ScheduledOrder.remaining_amount":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "elys.tradeshield.ScheduledOrder.target_denom":
+		return protoreflect.ValueOfString("")
+	case "elys.tradeshield.ScheduledOrder.slice_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "elys.tradeshield.ScheduledOrder.executed_slices":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "elys.tradeshield.ScheduledOrder.interval":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "elys.tradeshield.ScheduledOrder.interval_unit":
+		return protoreflect.ValueOfEnum(0)
+	case "elys.tradeshield.ScheduledOrder.max_slippage":
+		return protoreflect.ValueOfString("")
+	case "elys.tradeshield.ScheduledOrder.next_execution":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "elys.tradeshield.ScheduledOrder.received_amount":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "elys.tradeshield.ScheduledOrder.date":
+		m := new(Date)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.ScheduledOrder"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.ScheduledOrder does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ScheduledOrder) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in elys.tradeshield.ScheduledOrder", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ScheduledOrder) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ScheduledOrder) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ScheduledOrder) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ScheduledOrder) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ScheduledOrder)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		l = len(x.OwnerAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.TotalAmount != nil {
+			l = options.Size(x.TotalAmount)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.RemainingAmount != nil {
+			l = options.Size(x.RemainingAmount)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.TargetDenom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.SliceCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.SliceCount))
+		}
+		if x.ExecutedSlices != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExecutedSlices))
+		}
+		if x.Interval != 0 {
+			n += 1 + runtime.Sov(uint64(x.Interval))
+		}
+		if x.IntervalUnit != 0 {
+			n += 1 + runtime.Sov(uint64(x.IntervalUnit))
+		}
+		l = len(x.MaxSlippage)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.NextExecution != 0 {
+			n += 1 + runtime.Sov(uint64(x.NextExecution))
+		}
+		if x.ReceivedAmount != nil {
+			l = options.Size(x.ReceivedAmount)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Date != nil {
+			l = options.Size(x.Date)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ScheduledOrder)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Date != nil {
+			encoded, err := options.Marshal(x.Date)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x6a
+		}
+		if x.ReceivedAmount != nil {
+			encoded, err := options.Marshal(x.ReceivedAmount)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x62
+		}
+		if x.NextExecution != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NextExecution))
+			i--
+			dAtA[i] = 0x58
+		}
+		if len(x.MaxSlippage) > 0 {
+			i -= len(x.MaxSlippage)
+			copy(dAtA[i:], x.MaxSlippage)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxSlippage)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if x.IntervalUnit != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.IntervalUnit))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.Interval != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Interval))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.ExecutedSlices != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExecutedSlices))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.SliceCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SliceCount))
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.TargetDenom) > 0 {
+			i -= len(x.TargetDenom)
+			copy(dAtA[i:], x.TargetDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TargetDenom)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.RemainingAmount != nil {
+			encoded, err := options.Marshal(x.RemainingAmount)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.TotalAmount != nil {
+			encoded, err := options.Marshal(x.TotalAmount)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.OwnerAddress) > 0 {
+			i -= len(x.OwnerAddress)
+			copy(dAtA[i:], x.OwnerAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OwnerAddress)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ScheduledOrder)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ScheduledOrder: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ScheduledOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.OwnerAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalAmount", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.TotalAmount == nil {
+					x.TotalAmount = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TotalAmount); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RemainingAmount", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.RemainingAmount == nil {
+					x.RemainingAmount = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RemainingAmount); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TargetDenom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TargetDenom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SliceCount", wireType)
+				}
+				x.SliceCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SliceCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExecutedSlices", wireType)
+				}
+				x.ExecutedSlices = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExecutedSlices |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+				}
+				x.Interval = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Interval |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IntervalUnit", wireType)
+				}
+				x.IntervalUnit = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.IntervalUnit |= ScheduleIntervalUnit(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxSlippage", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxSlippage = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NextExecution", wireType)
+				}
+				x.NextExecution = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NextExecution |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReceivedAmount", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ReceivedAmount == nil {
+					x.ReceivedAmount = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ReceivedAmount); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Date", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Date == nil {
+					x.Date = &Date{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Date); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ScheduledOrderSlice            protoreflect.MessageDescriptor
+	fd_ScheduledOrderSlice_order_id   protoreflect.FieldDescriptor
+	fd_ScheduledOrderSlice_index      protoreflect.FieldDescriptor
+	fd_ScheduledOrderSlice_date       protoreflect.FieldDescriptor
+	fd_ScheduledOrderSlice_amount_in  protoreflect.FieldDescriptor
+	fd_ScheduledOrderSlice_amount_out protoreflect.FieldDescriptor
+	fd_ScheduledOrderSlice_error      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_elys_tradeshield_types_proto_init()
+	md_ScheduledOrderSlice = File_elys_tradeshield_types_proto.Messages().ByName("ScheduledOrderSlice")
+	fd_ScheduledOrderSlice_order_id = md_ScheduledOrderSlice.Fields().ByName("order_id")
+	fd_ScheduledOrderSlice_index = md_ScheduledOrderSlice.Fields().ByName("index")
+	fd_ScheduledOrderSlice_date = md_ScheduledOrderSlice.Fields().ByName("date")
+	fd_ScheduledOrderSlice_amount_in = md_ScheduledOrderSlice.Fields().ByName("amount_in")
+	fd_ScheduledOrderSlice_amount_out = md_ScheduledOrderSlice.Fields().ByName("amount_out")
+	fd_ScheduledOrderSlice_error = md_ScheduledOrderSlice.Fields().ByName("error")
+}
+
+var _ protoreflect.Message = (*fastReflection_ScheduledOrderSlice)(nil)
+
+type fastReflection_ScheduledOrderSlice ScheduledOrderSlice
+
+func (x *ScheduledOrderSlice) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ScheduledOrderSlice)(x)
+}
+
+func (x *ScheduledOrderSlice) slowProtoReflect() protoreflect.Message {
+	mi := &file_elys_tradeshield_types_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ScheduledOrderSlice_messageType fastReflection_ScheduledOrderSlice_messageType
+var _ protoreflect.MessageType = fastReflection_ScheduledOrderSlice_messageType{}
+
+type fastReflection_ScheduledOrderSlice_messageType struct{}
+
+func (x fastReflection_ScheduledOrderSlice_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ScheduledOrderSlice)(nil)
+}
+func (x fastReflection_ScheduledOrderSlice_messageType) New() protoreflect.Message {
+	return new(fastReflection_ScheduledOrderSlice)
+}
+func (x fastReflection_ScheduledOrderSlice_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ScheduledOrderSlice
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ScheduledOrderSlice) Descriptor() protoreflect.MessageDescriptor {
+	return md_ScheduledOrderSlice
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ScheduledOrderSlice) Type() protoreflect.MessageType {
+	return _fastReflection_ScheduledOrderSlice_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ScheduledOrderSlice) New() protoreflect.Message {
+	return new(fastReflection_ScheduledOrderSlice)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ScheduledOrderSlice) Interface() protoreflect.ProtoMessage {
+	return (*ScheduledOrderSlice)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ScheduledOrderSlice) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.OrderId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.OrderId)
+		if !f(fd_ScheduledOrderSlice_order_id, value) {
+			return
+		}
+	}
+	if x.Index != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Index)
+		if !f(fd_ScheduledOrderSlice_index, value) {
+			return
+		}
+	}
+	if x.Date != nil {
+		value := protoreflect.ValueOfMessage(x.Date.ProtoReflect())
+		if !f(fd_ScheduledOrderSlice_date, value) {
+			return
+		}
+	}
+	if x.AmountIn != nil {
+		value := protoreflect.ValueOfMessage(x.AmountIn.ProtoReflect())
+		if !f(fd_ScheduledOrderSlice_amount_in, value) {
+			return
+		}
+	}
+	if x.AmountOut != nil {
+		value := protoreflect.ValueOfMessage(x.AmountOut.ProtoReflect())
+		if !f(fd_ScheduledOrderSlice_amount_out, value) {
+			return
+		}
+	}
+	if x.Error != "" {
+		value := protoreflect.ValueOfString(x.Error)
+		if !f(fd_ScheduledOrderSlice_error, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ScheduledOrderSlice) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "elys.tradeshield.ScheduledOrderSlice.order_id":
+		return x.OrderId != uint64(0)
+	case "elys.tradeshield.ScheduledOrderSlice.index":
+		return x.Index != uint64(0)
+	case "elys.tradeshield.ScheduledOrderSlice.date":
+		return x.Date != nil
+	case "elys.tradeshield.ScheduledOrderSlice.amount_in":
+		return x.AmountIn != nil
+	case "elys.tradeshield.ScheduledOrderSlice.amount_out":
+		return x.AmountOut != nil
+	case "elys.tradeshield.ScheduledOrderSlice.error":
+		return x.Error != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.ScheduledOrderSlice"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.ScheduledOrderSlice does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ScheduledOrderSlice) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "elys.tradeshield.ScheduledOrderSlice.order_id":
+		x.OrderId = uint64(0)
+	case "elys.tradeshield.ScheduledOrderSlice.index":
+		x.Index = uint64(0)
+	case "elys.tradeshield.ScheduledOrderSlice.date":
+		x.Date = nil
+	case "elys.tradeshield.ScheduledOrderSlice.amount_in":
+		x.AmountIn = nil
+	case "elys.tradeshield.ScheduledOrderSlice.amount_out":
+		x.AmountOut = nil
+	case "elys.tradeshield.ScheduledOrderSlice.error":
+		x.Error = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.ScheduledOrderSlice"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.ScheduledOrderSlice does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ScheduledOrderSlice) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "elys.tradeshield.ScheduledOrderSlice.order_id":
+		value := x.OrderId
+		return protoreflect.ValueOfUint64(value)
+	case "elys.tradeshield.ScheduledOrderSlice.index":
+		value := x.Index
+		return protoreflect.ValueOfUint64(value)
+	case "elys.tradeshield.ScheduledOrderSlice.date":
+		value := x.Date
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "elys.tradeshield.ScheduledOrderSlice.amount_in":
+		value := x.AmountIn
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "elys.tradeshield.ScheduledOrderSlice.amount_out":
+		value := x.AmountOut
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "elys.tradeshield.ScheduledOrderSlice.error":
+		value := x.Error
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.ScheduledOrderSlice"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.ScheduledOrderSlice does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ScheduledOrderSlice) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "elys.tradeshield.ScheduledOrderSlice.order_id":
+		x.OrderId = value.Uint()
+	case "elys.tradeshield.ScheduledOrderSlice.index":
+		x.Index = value.Uint()
+	case "elys.tradeshield.ScheduledOrderSlice.date":
+		x.Date = value.Message().Interface().(*Date)
+	case "elys.tradeshield.ScheduledOrderSlice.amount_in":
+		x.AmountIn = value.Message().Interface().(*v1beta1.Coin)
+	case "elys.tradeshield.ScheduledOrderSlice.amount_out":
+		x.AmountOut = value.Message().Interface().(*v1beta1.Coin)
+	case "elys.tradeshield.ScheduledOrderSlice.error":
+		x.Error = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.ScheduledOrderSlice"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.ScheduledOrderSlice does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ScheduledOrderSlice) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "elys.tradeshield.ScheduledOrderSlice.date":
+		if x.Date == nil {
+			x.Date = new(Date)
+		}
+		return protoreflect.ValueOfMessage(x.Date.ProtoReflect())
+	case "elys.tradeshield.ScheduledOrderSlice.amount_in":
+		if x.AmountIn == nil {
+			x.AmountIn = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.AmountIn.ProtoReflect())
+	case "elys.tradeshield.ScheduledOrderSlice.amount_out":
+		if x.AmountOut == nil {
+			x.AmountOut = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.AmountOut.ProtoReflect())
+	case "elys.tradeshield.ScheduledOrderSlice.order_id":
+		panic(fmt.Errorf("field order_id of message elys.tradeshield.ScheduledOrderSlice is not mutable"))
+	case "elys.tradeshield.ScheduledOrderSlice.index":
+		panic(fmt.Errorf("field index of message elys.tradeshield.ScheduledOrderSlice is not mutable"))
+	case "elys.tradeshield.ScheduledOrderSlice.error":
+		panic(fmt.Errorf("field error of message elys.tradeshield.ScheduledOrderSlice is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.ScheduledOrderSlice"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.ScheduledOrderSlice does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ScheduledOrderSlice) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "elys.tradeshield.ScheduledOrderSlice.order_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "elys.tradeshield.ScheduledOrderSlice.index":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "elys.tradeshield.ScheduledOrderSlice.date":
+		m := new(Date)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "elys.tradeshield.ScheduledOrderSlice.amount_in":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "elys.tradeshield.ScheduledOrderSlice.amount_out":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "elys.tradeshield.ScheduledOrderSlice.error":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.ScheduledOrderSlice"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.ScheduledOrderSlice does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ScheduledOrderSlice) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in elys.tradeshield.ScheduledOrderSlice", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ScheduledOrderSlice) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ScheduledOrderSlice) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ScheduledOrderSlice) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ScheduledOrderSlice) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ScheduledOrderSlice)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.OrderId != 0 {
+			n += 1 + runtime.Sov(uint64(x.OrderId))
+		}
+		if x.Index != 0 {
+			n += 1 + runtime.Sov(uint64(x.Index))
+		}
+		if x.Date != nil {
+			l = options.Size(x.Date)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.AmountIn != nil {
+			l = options.Size(x.AmountIn)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.AmountOut != nil {
+			l = options.Size(x.AmountOut)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Error)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ScheduledOrderSlice)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Error) > 0 {
+			i -= len(x.Error)
+			copy(dAtA[i:], x.Error)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Error)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.AmountOut != nil {
+			encoded, err := options.Marshal(x.AmountOut)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.AmountIn != nil {
+			encoded, err := options.Marshal(x.AmountIn)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.Date != nil {
+			encoded, err := options.Marshal(x.Date)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Index != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Index))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.OrderId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OrderId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ScheduledOrderSlice)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ScheduledOrderSlice: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ScheduledOrderSlice: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+				}
+				x.OrderId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OrderId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				}
+				x.Index = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Index |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Date", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Date == nil {
+					x.Date = &Date{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Date); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AmountIn", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.AmountIn == nil {
+					x.AmountIn = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AmountIn); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AmountOut", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.AmountOut == nil {
+					x.AmountOut = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AmountOut); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Error = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_PerpetualOrderExtraInfo                      protoreflect.MessageDescriptor
+	fd_PerpetualOrderExtraInfo_perpetual_order      protoreflect.FieldDescriptor
+	fd_PerpetualOrderExtraInfo_position_size        protoreflect.FieldDescriptor
+	fd_PerpetualOrderExtraInfo_liquidation_price    protoreflect.FieldDescriptor
+	fd_PerpetualOrderExtraInfo_funding_rate         protoreflect.FieldDescriptor
+	fd_PerpetualOrderExtraInfo_borrow_interest_rate protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_elys_tradeshield_types_proto_init()
+	md_PerpetualOrderExtraInfo = File_elys_tradeshield_types_proto.Messages().ByName("PerpetualOrderExtraInfo")
+	fd_PerpetualOrderExtraInfo_perpetual_order = md_PerpetualOrderExtraInfo.Fields().ByName("perpetual_order")
+	fd_PerpetualOrderExtraInfo_position_size = md_PerpetualOrderExtraInfo.Fields().ByName("position_size")
+	fd_PerpetualOrderExtraInfo_liquidation_price = md_PerpetualOrderExtraInfo.Fields().ByName("liquidation_price")
+	fd_PerpetualOrderExtraInfo_funding_rate = md_PerpetualOrderExtraInfo.Fields().ByName("funding_rate")
+	fd_PerpetualOrderExtraInfo_borrow_interest_rate = md_PerpetualOrderExtraInfo.Fields().ByName("borrow_interest_rate")
+}
+
+var _ protoreflect.Message = (*fastReflection_PerpetualOrderExtraInfo)(nil)
+
+type fastReflection_PerpetualOrderExtraInfo PerpetualOrderExtraInfo
+
+func (x *PerpetualOrderExtraInfo) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PerpetualOrderExtraInfo)(x)
+}
+
+func (x *PerpetualOrderExtraInfo) slowProtoReflect() protoreflect.Message {
+	mi := &file_elys_tradeshield_types_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PerpetualOrderExtraInfo_messageType fastReflection_PerpetualOrderExtraInfo_messageType
+var _ protoreflect.MessageType = fastReflection_PerpetualOrderExtraInfo_messageType{}
+
+type fastReflection_PerpetualOrderExtraInfo_messageType struct{}
+
+func (x fastReflection_PerpetualOrderExtraInfo_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PerpetualOrderExtraInfo)(nil)
+}
+func (x fastReflection_PerpetualOrderExtraInfo_messageType) New() protoreflect.Message {
+	return new(fastReflection_PerpetualOrderExtraInfo)
+}
+func (x fastReflection_PerpetualOrderExtraInfo_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PerpetualOrderExtraInfo
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PerpetualOrderExtraInfo) Descriptor() protoreflect.MessageDescriptor {
+	return md_PerpetualOrderExtraInfo
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PerpetualOrderExtraInfo) Type() protoreflect.MessageType {
+	return _fastReflection_PerpetualOrderExtraInfo_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PerpetualOrderExtraInfo) New() protoreflect.Message {
+	return new(fastReflection_PerpetualOrderExtraInfo)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PerpetualOrderExtraInfo) Interface() protoreflect.ProtoMessage {
+	return (*PerpetualOrderExtraInfo)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PerpetualOrderExtraInfo) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.PerpetualOrder != nil {
+		value := protoreflect.ValueOfMessage(x.PerpetualOrder.ProtoReflect())
+		if !f(fd_PerpetualOrderExtraInfo_perpetual_order, value) {
+			return
+		}
+	}
+	if x.PositionSize != nil {
+		value := protoreflect.ValueOfMessage(x.PositionSize.ProtoReflect())
+		if !f(fd_PerpetualOrderExtraInfo_position_size, value) {
+			return
+		}
+	}
+	if x.LiquidationPrice != "" {
+		value := protoreflect.ValueOfString(x.LiquidationPrice)
+		if !f(fd_PerpetualOrderExtraInfo_liquidation_price, value) {
+			return
+		}
+	}
+	if x.FundingRate != "" {
+		value := protoreflect.ValueOfString(x.FundingRate)
+		if !f(fd_PerpetualOrderExtraInfo_funding_rate, value) {
+			return
+		}
+	}
+	if x.BorrowInterestRate != "" {
+		value := protoreflect.ValueOfString(x.BorrowInterestRate)
+		if !f(fd_PerpetualOrderExtraInfo_borrow_interest_rate, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PerpetualOrderExtraInfo) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "elys.tradeshield.PerpetualOrderExtraInfo.perpetual_order":
+		return x.PerpetualOrder != nil
+	case "elys.tradeshield.PerpetualOrderExtraInfo.position_size":
+		return x.PositionSize != nil
+	case "elys.tradeshield.PerpetualOrderExtraInfo.liquidation_price":
+		return x.LiquidationPrice != ""
+	case "elys.tradeshield.PerpetualOrderExtraInfo.funding_rate":
+		return x.FundingRate != ""
+	case "elys.tradeshield.PerpetualOrderExtraInfo.borrow_interest_rate":
+		return x.BorrowInterestRate != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.PerpetualOrderExtraInfo"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.PerpetualOrderExtraInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PerpetualOrderExtraInfo) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "elys.tradeshield.PerpetualOrderExtraInfo.perpetual_order":
+		x.PerpetualOrder = nil
+	case "elys.tradeshield.PerpetualOrderExtraInfo.position_size":
+		x.PositionSize = nil
+	case "elys.tradeshield.PerpetualOrderExtraInfo.liquidation_price":
+		x.LiquidationPrice = ""
+	case "elys.tradeshield.PerpetualOrderExtraInfo.funding_rate":
+		x.FundingRate = ""
+	case "elys.tradeshield.PerpetualOrderExtraInfo.borrow_interest_rate":
+		x.BorrowInterestRate = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.PerpetualOrderExtraInfo"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.PerpetualOrderExtraInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PerpetualOrderExtraInfo) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "elys.tradeshield.PerpetualOrderExtraInfo.perpetual_order":
+		value := x.PerpetualOrder
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "elys.tradeshield.PerpetualOrderExtraInfo.position_size":
+		value := x.PositionSize
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "elys.tradeshield.PerpetualOrderExtraInfo.liquidation_price":
+		value := x.LiquidationPrice
+		return protoreflect.ValueOfString(value)
+	case "elys.tradeshield.PerpetualOrderExtraInfo.funding_rate":
+		value := x.FundingRate
+		return protoreflect.ValueOfString(value)
+	case "elys.tradeshield.PerpetualOrderExtraInfo.borrow_interest_rate":
+		value := x.BorrowInterestRate
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.PerpetualOrderExtraInfo"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.PerpetualOrderExtraInfo does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PerpetualOrderExtraInfo) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "elys.tradeshield.PerpetualOrderExtraInfo.perpetual_order":
+		x.PerpetualOrder = value.Message().Interface().(*PerpetualOrder)
+	case "elys.tradeshield.PerpetualOrderExtraInfo.position_size":
+		x.PositionSize = value.Message().Interface().(*v1beta1.Coin)
+	case "elys.tradeshield.PerpetualOrderExtraInfo.liquidation_price":
+		x.LiquidationPrice = value.Interface().(string)
+	case "elys.tradeshield.PerpetualOrderExtraInfo.funding_rate":
+		x.FundingRate = value.Interface().(string)
+	case "elys.tradeshield.PerpetualOrderExtraInfo.borrow_interest_rate":
+		x.BorrowInterestRate = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.PerpetualOrderExtraInfo"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.PerpetualOrderExtraInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PerpetualOrderExtraInfo) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "elys.tradeshield.PerpetualOrderExtraInfo.perpetual_order":
+		if x.PerpetualOrder == nil {
+			x.PerpetualOrder = new(PerpetualOrder)
+		}
+		return protoreflect.ValueOfMessage(x.PerpetualOrder.ProtoReflect())
+	case "elys.tradeshield.PerpetualOrderExtraInfo.position_size":
+		if x.PositionSize == nil {
+			x.PositionSize = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.PositionSize.ProtoReflect())
+	case "elys.tradeshield.PerpetualOrderExtraInfo.liquidation_price":
+		panic(fmt.Errorf("field liquidation_price of message elys.tradeshield.PerpetualOrderExtraInfo is not mutable"))
+	case "elys.tradeshield.PerpetualOrderExtraInfo.funding_rate":
+		panic(fmt.Errorf("field funding_rate of message elys.tradeshield.PerpetualOrderExtraInfo is not mutable"))
+	case "elys.tradeshield.PerpetualOrderExtraInfo.borrow_interest_rate":
+		panic(fmt.Errorf("field borrow_interest_rate of message elys.tradeshield.PerpetualOrderExtraInfo is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.PerpetualOrderExtraInfo"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.PerpetualOrderExtraInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PerpetualOrderExtraInfo) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "elys.tradeshield.PerpetualOrderExtraInfo.perpetual_order":
+		m := new(PerpetualOrder)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "elys.tradeshield.PerpetualOrderExtraInfo.position_size":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "elys.tradeshield.PerpetualOrderExtraInfo.liquidation_price":
+		return protoreflect.ValueOfString("")
+	case "elys.tradeshield.PerpetualOrderExtraInfo.funding_rate":
+		return protoreflect.ValueOfString("")
+	case "elys.tradeshield.PerpetualOrderExtraInfo.borrow_interest_rate":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: elys.tradeshield.PerpetualOrderExtraInfo"))
+		}
+		panic(fmt.Errorf("message elys.tradeshield.PerpetualOrderExtraInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PerpetualOrderExtraInfo) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in elys.tradeshield.PerpetualOrderExtraInfo", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PerpetualOrderExtraInfo) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PerpetualOrderExtraInfo) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PerpetualOrderExtraInfo) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PerpetualOrderExtraInfo) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*PerpetualOrderExtraInfo)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.PerpetualOrder != nil {
+			l = options.Size(x.PerpetualOrder)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.PositionSize != nil {
+			l = options.Size(x.PositionSize)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.LiquidationPrice)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.FundingRate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.BorrowInterestRate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*PerpetualOrderExtraInfo)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.BorrowInterestRate) > 0 {
+			i -= len(x.BorrowInterestRate)
+			copy(dAtA[i:], x.BorrowInterestRate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BorrowInterestRate)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.FundingRate) > 0 {
+			i -= len(x.FundingRate)
+			copy(dAtA[i:], x.FundingRate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FundingRate)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.LiquidationPrice) > 0 {
+			i -= len(x.LiquidationPrice)
+			copy(dAtA[i:], x.LiquidationPrice)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LiquidationPrice)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.PositionSize != nil {
+			encoded, err := options.Marshal(x.PositionSize)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.PerpetualOrder != nil {
+			encoded, err := options.Marshal(x.PerpetualOrder)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*PerpetualOrderExtraInfo)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PerpetualOrderExtraInfo: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PerpetualOrderExtraInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PerpetualOrder", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.PerpetualOrder == nil {
+					x.PerpetualOrder = &PerpetualOrder{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PerpetualOrder); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PositionSize", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.PositionSize == nil {
+					x.PositionSize = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PositionSize); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LiquidationPrice", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LiquidationPrice = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FundingRate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FundingRate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BorrowInterestRate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BorrowInterestRate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: elys/tradeshield/types.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Status int32
+
+const (
+	Status_PENDING  Status = 0
+	Status_EXECUTED Status = 1
+	Status_CANCELED Status = 2
+	Status_ALL      Status = 3
+)
+
+// Enum value maps for Status.
+var (
+	Status_name = map[int32]string{
+		0: "PENDING",
+		1: "EXECUTED",
+		2: "CANCELED",
+		3: "ALL",
+	}
+	Status_value = map[string]int32{
+		"PENDING":  0,
+		"EXECUTED": 1,
+		"CANCELED": 2,
+		"ALL":      3,
+	}
+)
+
+func (x Status) Enum() *Status {
+	p := new(Status)
+	*p = x
+	return p
+}
+
+func (x Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_elys_tradeshield_types_proto_enumTypes[0].Descriptor()
+}
+
+func (Status) Type() protoreflect.EnumType {
+	return &file_elys_tradeshield_types_proto_enumTypes[0]
+}
+
+func (x Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Status.Descriptor instead.
+func (Status) EnumDescriptor() ([]byte, []int) {
+	return file_elys_tradeshield_types_proto_rawDescGZIP(), []int{0}
+}
+
+// Perpetual Order
+type PerpetualOrderType int32
+
+const (
+	PerpetualOrderType_LIMITOPEN    PerpetualOrderType = 0
+	PerpetualOrderType_LIMITCLOSE   PerpetualOrderType = 1
+	PerpetualOrderType_STOPLOSSPERP PerpetualOrderType = 2
+	// Stop loss whose trigger price follows the best market price at a fixed
+	// trail
+	PerpetualOrderType_TRAILINGSTOPPERP PerpetualOrderType = 3
+)
+
+// Enum value maps for PerpetualOrderType.
+var (
+	PerpetualOrderType_name = map[int32]string{
+		0: "LIMITOPEN",
+		1: "LIMITCLOSE",
+		2: "STOPLOSSPERP",
+		3: "TRAILINGSTOPPERP",
+	}
+	PerpetualOrderType_value = map[string]int32{
+		"LIMITOPEN":        0,
+		"LIMITCLOSE":       1,
+		"STOPLOSSPERP":     2,
+		"TRAILINGSTOPPERP": 3,
+	}
+)
+
+func (x PerpetualOrderType) Enum() *PerpetualOrderType {
+	p := new(PerpetualOrderType)
+	*p = x
+	return p
+}
+
+func (x PerpetualOrderType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PerpetualOrderType) Descriptor() protoreflect.EnumDescriptor {
+	return file_elys_tradeshield_types_proto_enumTypes[1].Descriptor()
+}
+
+func (PerpetualOrderType) Type() protoreflect.EnumType {
+	return &file_elys_tradeshield_types_proto_enumTypes[1]
+}
+
+func (x PerpetualOrderType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PerpetualOrderType.Descriptor instead.
+func (PerpetualOrderType) EnumDescriptor() ([]byte, []int) {
+	return file_elys_tradeshield_types_proto_rawDescGZIP(), []int{1}
+}
+
+// Unit of the interval between the slices of a scheduled order
+type ScheduleIntervalUnit int32
+
+const (
+	ScheduleIntervalUnit_BLOCKS  ScheduleIntervalUnit = 0
+	ScheduleIntervalUnit_SECONDS ScheduleIntervalUnit = 1
+)
+
+// Enum value maps for ScheduleIntervalUnit.
+var (
+	ScheduleIntervalUnit_name = map[int32]string{
+		0: "BLOCKS",
+		1: "SECONDS",
+	}
+	ScheduleIntervalUnit_value = map[string]int32{
+		"BLOCKS":  0,
+		"SECONDS": 1,
+	}
+)
+
+func (x ScheduleIntervalUnit) Enum() *ScheduleIntervalUnit {
+	p := new(ScheduleIntervalUnit)
+	*p = x
+	return p
+}
+
+func (x ScheduleIntervalUnit) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ScheduleIntervalUnit) Descriptor() protoreflect.EnumDescriptor {
+	return file_elys_tradeshield_types_proto_enumTypes[2].Descriptor()
+}
+
+func (ScheduleIntervalUnit) Type() protoreflect.EnumType {
+	return &file_elys_tradeshield_types_proto_enumTypes[2]
+}
+
+func (x ScheduleIntervalUnit) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ScheduleIntervalUnit.Descriptor instead.
+func (ScheduleIntervalUnit) EnumDescriptor() ([]byte, []int) {
+	return file_elys_tradeshield_types_proto_rawDescGZIP(), []int{2}
+}
+
+type Date struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height    uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Timestamp uint64 `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *Date) Reset() {
+	*x = Date{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_elys_tradeshield_types_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Date) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Date) ProtoMessage() {}
+
+// Deprecated: Use Date.ProtoReflect.Descriptor instead.
+func (*Date) Descriptor() ([]byte, []int) {
+	return file_elys_tradeshield_types_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Date) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Date) GetTimestamp() uint64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+type SpotOrder struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderType  SpotOrderType `protobuf:"varint,1,opt,name=order_type,json=orderType,proto3,enum=elys.tradeshield.SpotOrderType" json:"order_type,omitempty"`
+	OrderId    uint64        `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	OrderPrice *OrderPrice   `protobuf:"bytes,3,opt,name=order_price,json=orderPrice,proto3" json:"order_price,omitempty"`
+	// Amount left to fill, escrowed on the order address
+	OrderAmount      *v1beta1.Coin `protobuf:"bytes,4,opt,name=order_amount,json=orderAmount,proto3" json:"order_amount,omitempty"`
+	OwnerAddress     string        `protobuf:"bytes,5,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
+	OrderTargetDenom string        `protobuf:"bytes,6,opt,name=order_target_denom,json=orderTargetDenom,proto3" json:"order_target_denom,omitempty"`
+	Status           Status        `protobuf:"varint,7,opt,name=status,proto3,enum=elys.tradeshield.Status" json:"status,omitempty"`
+	Date             *Date         `protobuf:"bytes,8,opt,name=date,proto3" json:"date,omitempty"`
+	// Deposit escrowed when the order is created, paid to the executor of the
+	// order or refunded to the owner
+	Deposit     *v1beta1.Coin `protobuf:"bytes,9,opt,name=deposit,proto3" json:"deposit,omitempty"`
+	TimeInForce TimeInForce   `protobuf:"varint,10,opt,name=time_in_force,json=timeInForce,proto3,enum=elys.tradeshield.TimeInForce" json:"time_in_force,omitempty"`
+	// Height and/or timestamp at which a GTT order expires
+	Expiry *Date `protobuf:"bytes,11,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	// Trail of TRAILINGSTOP orders, the rate is their current stop price
+	TrailingStop *TrailingStop `protobuf:"bytes,12,opt,name=trailing_stop,json=trailingStop,proto3" json:"trailing_stop,omitempty"`
+	// Order group of the order, zero when the order is not grouped
+	GroupId uint64 `protobuf:"varint,13,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// Part of the order amount already swapped by partial fills of limit
+	// orders, order_amount is the amount left to fill
+	FilledAmount *v1beta1.Coin `protobuf:"bytes,14,opt,name=filled_amount,json=filledAmount,proto3" json:"filled_amount,omitempty"`
+}
+
+func (x *SpotOrder) Reset() {
+	*x = SpotOrder{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_elys_tradeshield_types_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SpotOrder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpotOrder) ProtoMessage() {}
+
+// Deprecated: Use SpotOrder.ProtoReflect.Descriptor instead.
+func (*SpotOrder) Descriptor() ([]byte, []int) {
+	return file_elys_tradeshield_types_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SpotOrder) GetOrderType() SpotOrderType {
+	if x != nil {
+		return x.OrderType
+	}
+	return SpotOrderType_STOPLOSS
+}
+
+func (x *SpotOrder) GetOrderId() uint64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *SpotOrder) GetOrderPrice() *OrderPrice {
+	if x != nil {
+		return x.OrderPrice
+	}
+	return nil
+}
+
+func (x *SpotOrder) GetOrderAmount() *v1beta1.Coin {
+	if x != nil {
+		return x.OrderAmount
+	}
+	return nil
+}
+
+func (x *SpotOrder) GetOwnerAddress() string {
+	if x != nil {
+		return x.OwnerAddress
+	}
+	return ""
+}
+
+func (x *SpotOrder) GetOrderTargetDenom() string {
+	if x != nil {
+		return x.OrderTargetDenom
+	}
+	return ""
+}
+
+func (x *SpotOrder) GetStatus() Status {
+	if x != nil {
+		return x.Status
+	}
+	return Status_PENDING
+}
+
+func (x *SpotOrder) GetDate() *Date {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *SpotOrder) GetDeposit() *v1beta1.Coin {
+	if x != nil {
+		return x.Deposit
+	}
+	return nil
+}
+
+func (x *SpotOrder) GetTimeInForce() TimeInForce {
+	if x != nil {
+		return x.TimeInForce
+	}
+	return TimeInForce_GTC
+}
+
+func (x *SpotOrder) GetExpiry() *Date {
+	if x != nil {
+		return x.Expiry
+	}
+	return nil
+}
+
+func (x *SpotOrder) GetTrailingStop() *TrailingStop {
+	if x != nil {
+		return x.TrailingStop
+	}
+	return nil
+}
+
+func (x *SpotOrder) GetGroupId() uint64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *SpotOrder) GetFilledAmount() *v1beta1.Coin {
+	if x != nil {
+		return x.FilledAmount
+	}
+	return nil
+}
+
+type LegacyPerpetualOrder struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderId            uint64             `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	OwnerAddress       string             `protobuf:"bytes,2,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
+	PerpetualOrderType PerpetualOrderType `protobuf:"varint,3,opt,name=perpetual_order_type,json=perpetualOrderType,proto3,enum=elys.tradeshield.PerpetualOrderType" json:"perpetual_order_type,omitempty"`
+	Position           PerpetualPosition  `protobuf:"varint,4,opt,name=position,proto3,enum=elys.tradeshield.PerpetualPosition" json:"position,omitempty"`
+	TriggerPrice       *OrderPrice        `protobuf:"bytes,5,opt,name=trigger_price,json=triggerPrice,proto3" json:"trigger_price,omitempty"`
+	Collateral         *v1beta1.Coin      `protobuf:"bytes,6,opt,name=collateral,proto3" json:"collateral,omitempty"`
+	TradingAsset       string             `protobuf:"bytes,7,opt,name=trading_asset,json=tradingAsset,proto3" json:"trading_asset,omitempty"`
+	Leverage           string             `protobuf:"bytes,8,opt,name=leverage,proto3" json:"leverage,omitempty"`
+	TakeProfitPrice    string             `protobuf:"bytes,9,opt,name=take_profit_price,json=takeProfitPrice,proto3" json:"take_profit_price,omitempty"`
+	PositionId         uint64             `protobuf:"varint,10,opt,name=position_id,json=positionId,proto3" json:"position_id,omitempty"`
+	Status             Status             `protobuf:"varint,11,opt,name=status,proto3,enum=elys.tradeshield.Status" json:"status,omitempty"`
+	StopLossPrice      string             `protobuf:"bytes,12,opt,name=stop_loss_price,json=stopLossPrice,proto3" json:"stop_loss_price,omitempty"`
+	PositionSize       *v1beta1.Coin      `protobuf:"bytes,13,opt,name=position_size,json=positionSize,proto3" json:"position_size,omitempty"`
+	LiquidationPrice   string             `protobuf:"bytes,14,opt,name=liquidation_price,json=liquidationPrice,proto3" json:"liquidation_price,omitempty"`
+	FundingRate        string             `protobuf:"bytes,15,opt,name=funding_rate,json=fundingRate,proto3" json:"funding_rate,omitempty"`
+	BorrowInterestRate string             `protobuf:"bytes,16,opt,name=borrow_interest_rate,json=borrowInterestRate,proto3" json:"borrow_interest_rate,omitempty"`
+}
+
+func (x *LegacyPerpetualOrder) Reset() {
+	*x = LegacyPerpetualOrder{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_elys_tradeshield_types_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LegacyPerpetualOrder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LegacyPerpetualOrder) ProtoMessage() {}
+
+// Deprecated: Use LegacyPerpetualOrder.ProtoReflect.Descriptor instead.
+func (*LegacyPerpetualOrder) Descriptor() ([]byte, []int) {
+	return file_elys_tradeshield_types_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *LegacyPerpetualOrder) GetOrderId() uint64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *LegacyPerpetualOrder) GetOwnerAddress() string {
+	if x != nil {
+		return x.OwnerAddress
+	}
+	return ""
+}
+
+func (x *LegacyPerpetualOrder) GetPerpetualOrderType() PerpetualOrderType {
+	if x != nil {
+		return x.PerpetualOrderType
+	}
+	return PerpetualOrderType_LIMITOPEN
+}
+
+func (x *LegacyPerpetualOrder) GetPosition() PerpetualPosition {
+	if x != nil {
+		return x.Position
+	}
+	return PerpetualPosition_UNSPECIFIED
+}
+
+func (x *LegacyPerpetualOrder) GetTriggerPrice() *OrderPrice {
+	if x != nil {
+		return x.TriggerPrice
+	}
+	return nil
+}
+
+func (x *LegacyPerpetualOrder) GetCollateral() *v1beta1.Coin {
+	if x != nil {
+		return x.Collateral
+	}
+	return nil
+}
+
+func (x *LegacyPerpetualOrder) GetTradingAsset() string {
+	if x != nil {
+		return x.TradingAsset
+	}
+	return ""
+}
+
+func (x *LegacyPerpetualOrder) GetLeverage() string {
+	if x != nil {
+		return x.Leverage
+	}
+	return ""
+}
+
+func (x *LegacyPerpetualOrder) GetTakeProfitPrice() string {
+	if x != nil {
+		return x.TakeProfitPrice
+	}
+	return ""
+}
+
+func (x *LegacyPerpetualOrder) GetPositionId() uint64 {
+	if x != nil {
+		return x.PositionId
+	}
+	return 0
+}
+
+func (x *LegacyPerpetualOrder) GetStatus() Status {
+	if x != nil {
+		return x.Status
+	}
+	return Status_PENDING
+}
+
+func (x *LegacyPerpetualOrder) GetStopLossPrice() string {
+	if x != nil {
+		return x.StopLossPrice
+	}
+	return ""
+}
+
+func (x *LegacyPerpetualOrder) GetPositionSize() *v1beta1.Coin {
+	if x != nil {
+		return x.PositionSize
+	}
+	return nil
+}
+
+func (x *LegacyPerpetualOrder) GetLiquidationPrice() string {
+	if x != nil {
+		return x.LiquidationPrice
+	}
+	return ""
+}
+
+func (x *LegacyPerpetualOrder) GetFundingRate() string {
+	if x != nil {
+		return x.FundingRate
+	}
+	return ""
+}
+
+func (x *LegacyPerpetualOrder) GetBorrowInterestRate() string {
+	if x != nil {
+		return x.BorrowInterestRate
+	}
+	return ""
+}
+
+type PerpetualOrder struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderId            uint64             `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	OwnerAddress       string             `protobuf:"bytes,2,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
+	PerpetualOrderType PerpetualOrderType `protobuf:"varint,3,opt,name=perpetual_order_type,json=perpetualOrderType,proto3,enum=elys.tradeshield.PerpetualOrderType" json:"perpetual_order_type,omitempty"`
+	Position           PerpetualPosition  `protobuf:"varint,4,opt,name=position,proto3,enum=elys.tradeshield.PerpetualPosition" json:"position,omitempty"`
+	TriggerPrice       *TriggerPrice      `protobuf:"bytes,5,opt,name=trigger_price,json=triggerPrice,proto3" json:"trigger_price,omitempty"`
+	Collateral         *v1beta1.Coin      `protobuf:"bytes,6,opt,name=collateral,proto3" json:"collateral,omitempty"`
 	TradingAsset       string             `protobuf:"bytes,7,opt,name=trading_asset,json=tradingAsset,proto3" json:"trading_asset,omitempty"`
 	Leverage           string             `protobuf:"bytes,8,opt,name=leverage,proto3" json:"leverage,omitempty"`
 	TakeProfitPrice    string             `protobuf:"bytes,9,opt,name=take_profit_price,json=takeProfitPrice,proto3" json:"take_profit_price,omitempty"`
 	PositionId         uint64             `protobuf:"varint,10,opt,name=position_id,json=positionId,proto3" json:"position_id,omitempty"`
 	Status             Status             `protobuf:"varint,11,opt,name=status,proto3,enum=elys.tradeshield.Status" json:"status,omitempty"`
 	StopLossPrice      string             `protobuf:"bytes,12,opt,name=stop_loss_price,json=stopLossPrice,proto3" json:"stop_loss_price,omitempty"`
-	PositionSize       *v1beta1.Coin      `protobuf:"bytes,13,opt,name=position_size,json=positionSize,proto3" json:"position_size,omitempty"`
-	LiquidationPrice   string             `protobuf:"bytes,14,opt,name=liquidation_price,json=liquidationPrice,proto3" json:"liquidation_price,omitempty"`
-	FundingRate        string             `protobuf:"bytes,15,opt,name=funding_rate,json=fundingRate,proto3" json:"funding_rate,omitempty"`
-	BorrowInterestRate string             `protobuf:"bytes,16,opt,name=borrow_interest_rate,json=borrowInterestRate,proto3" json:"borrow_interest_rate,omitempty"`
+	PoolId             uint64             `protobuf:"varint,13,opt,name=poolId,proto3" json:"poolId,omitempty"`
+	// Amount of the position closed by close orders, zero closes the whole
+	// position
+	CloseAmount string `protobuf:"bytes,14,opt,name=close_amount,json=closeAmount,proto3" json:"close_amount,omitempty"`
+	// Deposit escrowed when the order is created, paid to the executor of the
+	// order or refunded to the owner
+	Deposit     *v1beta1.Coin `protobuf:"bytes,15,opt,name=deposit,proto3" json:"deposit,omitempty"`
+	TimeInForce TimeInForce   `protobuf:"varint,16,opt,name=time_in_force,json=timeInForce,proto3,enum=elys.tradeshield.TimeInForce" json:"time_in_force,omitempty"`
+	// Height and/or timestamp at which a GTT order expires
+	Expiry *Date `protobuf:"bytes,17,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	// Trail of TRAILINGSTOPPERP orders, the trigger price is their current stop
+	// price
+	TrailingStop *TrailingStop `protobuf:"bytes,18,opt,name=trailing_stop,json=trailingStop,proto3" json:"trailing_stop,omitempty"`
+	// Order group of the order, zero when the order is not grouped
+	GroupId uint64 `protobuf:"varint,19,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
 }
 
-func (x *LegacyPerpetualOrder) Reset() {
-	*x = LegacyPerpetualOrder{}
+func (x *PerpetualOrder) Reset() {
+	*x = PerpetualOrder{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_elys_tradeshield_types_proto_msgTypes[2]
+		mi := &file_elys_tradeshield_types_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LegacyPerpetualOrder) String() string {
+func (x *PerpetualOrder) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LegacyPerpetualOrder) ProtoMessage() {}
+func (*PerpetualOrder) ProtoMessage() {}
 
-// Deprecated: Use LegacyPerpetualOrder.ProtoReflect.Descriptor instead.
-func (*LegacyPerpetualOrder) Descriptor() ([]byte, []int) {
-	return file_elys_tradeshield_types_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use PerpetualOrder.ProtoReflect.Descriptor instead.
+func (*PerpetualOrder) Descriptor() ([]byte, []int) {
+	return file_elys_tradeshield_types_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PerpetualOrder) GetOrderId() uint64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *PerpetualOrder) GetOwnerAddress() string {
+	if x != nil {
+		return x.OwnerAddress
+	}
+	return ""
+}
+
+func (x *PerpetualOrder) GetPerpetualOrderType() PerpetualOrderType {
+	if x != nil {
+		return x.PerpetualOrderType
+	}
+	return PerpetualOrderType_LIMITOPEN
+}
+
+func (x *PerpetualOrder) GetPosition() PerpetualPosition {
+	if x != nil {
+		return x.Position
+	}
+	return PerpetualPosition_UNSPECIFIED
+}
+
+func (x *PerpetualOrder) GetTriggerPrice() *TriggerPrice {
+	if x != nil {
+		return x.TriggerPrice
+	}
+	return nil
+}
+
+func (x *PerpetualOrder) GetCollateral() *v1beta1.Coin {
+	if x != nil {
+		return x.Collateral
+	}
+	return nil
+}
+
+func (x *PerpetualOrder) GetTradingAsset() string {
+	if x != nil {
+		return x.TradingAsset
+	}
+	return ""
+}
+
+func (x *PerpetualOrder) GetLeverage() string {
+	if x != nil {
+		return x.Leverage
+	}
+	return ""
+}
+
+func (x *PerpetualOrder) GetTakeProfitPrice() string {
+	if x != nil {
+		return x.TakeProfitPrice
+	}
+	return ""
+}
+
+func (x *PerpetualOrder) GetPositionId() uint64 {
+	if x != nil {
+		return x.PositionId
+	}
+	return 0
+}
+
+func (x *PerpetualOrder) GetStatus() Status {
+	if x != nil {
+		return x.Status
+	}
+	return Status_PENDING
+}
+
+func (x *PerpetualOrder) GetStopLossPrice() string {
+	if x != nil {
+		return x.StopLossPrice
+	}
+	return ""
+}
+
+func (x *PerpetualOrder) GetPoolId() uint64 {
+	if x != nil {
+		return x.PoolId
+	}
+	return 0
+}
+
+func (x *PerpetualOrder) GetCloseAmount() string {
+	if x != nil {
+		return x.CloseAmount
+	}
+	return ""
+}
+
+func (x *PerpetualOrder) GetDeposit() *v1beta1.Coin {
+	if x != nil {
+		return x.Deposit
+	}
+	return nil
+}
+
+func (x *PerpetualOrder) GetTimeInForce() TimeInForce {
+	if x != nil {
+		return x.TimeInForce
+	}
+	return TimeInForce_GTC
+}
+
+func (x *PerpetualOrder) GetExpiry() *Date {
+	if x != nil {
+		return x.Expiry
+	}
+	return nil
+}
+
+func (x *PerpetualOrder) GetTrailingStop() *TrailingStop {
+	if x != nil {
+		return x.TrailingStop
+	}
+	return nil
+}
+
+func (x *PerpetualOrder) GetGroupId() uint64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+// Take profit or stop loss close order attached to a limit open order, it is
+// created once the position is opened
+type BracketOrder struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// LIMITCLOSE or STOPLOSSPERP
+	OrderType    PerpetualOrderType `protobuf:"varint,1,opt,name=order_type,json=orderType,proto3,enum=elys.tradeshield.PerpetualOrderType" json:"order_type,omitempty"`
+	TriggerPrice string             `protobuf:"bytes,2,opt,name=trigger_price,json=triggerPrice,proto3" json:"trigger_price,omitempty"`
+	// Amount of the position to close, zero closes the whole position
+	CloseAmount string `protobuf:"bytes,3,opt,name=close_amount,json=closeAmount,proto3" json:"close_amount,omitempty"`
+	// Deposit of the close order, escrowed on the limit open order until the
+	// close order is created
+	Deposit *v1beta1.Coin `protobuf:"bytes,4,opt,name=deposit,proto3" json:"deposit,omitempty"`
+}
+
+func (x *BracketOrder) Reset() {
+	*x = BracketOrder{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_elys_tradeshield_types_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BracketOrder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BracketOrder) ProtoMessage() {}
+
+// Deprecated: Use BracketOrder.ProtoReflect.Descriptor instead.
+func (*BracketOrder) Descriptor() ([]byte, []int) {
+	return file_elys_tradeshield_types_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *BracketOrder) GetOrderType() PerpetualOrderType {
+	if x != nil {
+		return x.OrderType
+	}
+	return PerpetualOrderType_LIMITOPEN
+}
+
+func (x *BracketOrder) GetTriggerPrice() string {
+	if x != nil {
+		return x.TriggerPrice
+	}
+	return ""
+}
+
+func (x *BracketOrder) GetCloseAmount() string {
+	if x != nil {
+		return x.CloseAmount
+	}
+	return ""
+}
+
+func (x *BracketOrder) GetDeposit() *v1beta1.Coin {
+	if x != nil {
+		return x.Deposit
+	}
+	return nil
+}
+
+// Pending orders of an owner managed together: once one of them is executed,
+// the others are cancelled and refunded
+type OrderGroup struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OwnerAddress      string   `protobuf:"bytes,2,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
+	SpotOrderIds      []uint64 `protobuf:"varint,3,rep,packed,name=spot_order_ids,json=spotOrderIds,proto3" json:"spot_order_ids,omitempty"`
+	PerpetualOrderIds []uint64 `protobuf:"varint,4,rep,packed,name=perpetual_order_ids,json=perpetualOrderIds,proto3" json:"perpetual_order_ids,omitempty"`
+	// Limit open order of a bracket, zero for one-cancels-other groups
+	ParentOrderId uint64 `protobuf:"varint,5,opt,name=parent_order_id,json=parentOrderId,proto3" json:"parent_order_id,omitempty"`
+	// Close orders created once the parent order opens its position
+	BracketOrders []*BracketOrder `protobuf:"bytes,6,rep,name=bracket_orders,json=bracketOrders,proto3" json:"bracket_orders,omitempty"`
+}
+
+func (x *OrderGroup) Reset() {
+	*x = OrderGroup{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_elys_tradeshield_types_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OrderGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderGroup) ProtoMessage() {}
+
+// Deprecated: Use OrderGroup.ProtoReflect.Descriptor instead.
+func (*OrderGroup) Descriptor() ([]byte, []int) {
+	return file_elys_tradeshield_types_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *LegacyPerpetualOrder) GetOrderId() uint64 {
+func (x *OrderGroup) GetId() uint64 {
 	if x != nil {
-		return x.OrderId
+		return x.Id
 	}
 	return 0
 }
 
-func (x *LegacyPerpetualOrder) GetOwnerAddress() string {
+func (x *OrderGroup) GetOwnerAddress() string {
 	if x != nil {
 		return x.OwnerAddress
 	}
 	return ""
 }
 
-func (x *LegacyPerpetualOrder) GetPerpetualOrderType() PerpetualOrderType {
+func (x *OrderGroup) GetSpotOrderIds() []uint64 {
 	if x != nil {
-		return x.PerpetualOrderType
+		return x.SpotOrderIds
 	}
-	return PerpetualOrderType_LIMITOPEN
+	return nil
 }
 
-func (x *LegacyPerpetualOrder) GetPosition() PerpetualPosition {
+func (x *OrderGroup) GetPerpetualOrderIds() []uint64 {
 	if x != nil {
-		return x.Position
+		return x.PerpetualOrderIds
 	}
-	return PerpetualPosition_UNSPECIFIED
+	return nil
 }
 
-func (x *LegacyPerpetualOrder) GetTriggerPrice() *OrderPrice {
+func (x *OrderGroup) GetParentOrderId() uint64 {
 	if x != nil {
-		return x.TriggerPrice
+		return x.ParentOrderId
 	}
-	return nil
+	return 0
 }
 
-func (x *LegacyPerpetualOrder) GetCollateral() *v1beta1.Coin {
+func (x *OrderGroup) GetBracketOrders() []*BracketOrder {
 	if x != nil {
-		return x.Collateral
+		return x.BracketOrders
 	}
 	return nil
 }
 
-func (x *LegacyPerpetualOrder) GetTradingAsset() string {
-	if x != nil {
-		return x.TradingAsset
-	}
-	return ""
-}
+// Swap of an amount split in slices executed at a regular interval through the
+// AMM, for TWAP and DCA strategies
+type ScheduledOrder struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *LegacyPerpetualOrder) GetLeverage() string {
-	if x != nil {
-		return x.Leverage
+	Id           uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OwnerAddress string `protobuf:"bytes,2,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
+	// Amount to swap over all the slices
+	TotalAmount *v1beta1.Coin `protobuf:"bytes,3,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+	// Amount left to swap, escrowed on the order address
+	RemainingAmount *v1beta1.Coin `protobuf:"bytes,4,opt,name=remaining_amount,json=remainingAmount,proto3" json:"remaining_amount,omitempty"`
+	TargetDenom     string        `protobuf:"bytes,5,opt,name=target_denom,json=targetDenom,proto3" json:"target_denom,omitempty"`
+	SliceCount      uint64        `protobuf:"varint,6,opt,name=slice_count,json=sliceCount,proto3" json:"slice_count,omitempty"`
+	// Number of slices already executed or skipped
+	ExecutedSlices uint64               `protobuf:"varint,7,opt,name=executed_slices,json=executedSlices,proto3" json:"executed_slices,omitempty"`
+	Interval       uint64               `protobuf:"varint,8,opt,name=interval,proto3" json:"interval,omitempty"`
+	IntervalUnit   ScheduleIntervalUnit `protobuf:"varint,9,opt,name=interval_unit,json=intervalUnit,proto3,enum=elys.tradeshield.ScheduleIntervalUnit" json:"interval_unit,omitempty"`
+	// Maximum slippage of a slice from the oracle price, slices that would
+	// exceed it are skipped
+	MaxSlippage string `protobuf:"bytes,10,opt,name=max_slippage,json=maxSlippage,proto3" json:"max_slippage,omitempty"`
+	// Block height or timestamp, depending on the interval unit, of the next
+	// slice
+	NextExecution uint64 `protobuf:"varint,11,opt,name=next_execution,json=nextExecution,proto3" json:"next_execution,omitempty"`
+	// Output of the executed slices
+	ReceivedAmount *v1beta1.Coin `protobuf:"bytes,12,opt,name=received_amount,json=receivedAmount,proto3" json:"received_amount,omitempty"`
+	Date           *Date         `protobuf:"bytes,13,opt,name=date,proto3" json:"date,omitempty"`
+}
+
+func (x *ScheduledOrder) Reset() {
+	*x = ScheduledOrder{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_elys_tradeshield_types_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *LegacyPerpetualOrder) GetTakeProfitPrice() string {
-	if x != nil {
-		return x.TakeProfitPrice
-	}
-	return ""
+func (x *ScheduledOrder) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *LegacyPerpetualOrder) GetPositionId() uint64 {
-	if x != nil {
-		return x.PositionId
-	}
-	return 0
+func (*ScheduledOrder) ProtoMessage() {}
+
+// Deprecated: Use ScheduledOrder.ProtoReflect.Descriptor instead.
+func (*ScheduledOrder) Descriptor() ([]byte, []int) {
+	return file_elys_tradeshield_types_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *LegacyPerpetualOrder) GetStatus() Status {
+func (x *ScheduledOrder) GetId() uint64 {
 	if x != nil {
-		return x.Status
+		return x.Id
 	}
-	return Status_PENDING
+	return 0
 }
 
-func (x *LegacyPerpetualOrder) GetStopLossPrice() string {
+func (x *ScheduledOrder) GetOwnerAddress() string {
 	if x != nil {
-		return x.StopLossPrice
+		return x.OwnerAddress
 	}
 	return ""
 }
 
-func (x *LegacyPerpetualOrder) GetPositionSize() *v1beta1.Coin {
+func (x *ScheduledOrder) GetTotalAmount() *v1beta1.Coin {
 	if x != nil {
-		return x.PositionSize
+		return x.TotalAmount
 	}
 	return nil
 }
 
-func (x *LegacyPerpetualOrder) GetLiquidationPrice() string {
+func (x *ScheduledOrder) GetRemainingAmount() *v1beta1.Coin {
 	if x != nil {
-		return x.LiquidationPrice
+		return x.RemainingAmount
 	}
-	return ""
+	return nil
 }
 
-func (x *LegacyPerpetualOrder) GetFundingRate() string {
+func (x *ScheduledOrder) GetTargetDenom() string {
 	if x != nil {
-		return x.FundingRate
+		return x.TargetDenom
 	}
 	return ""
 }
 
-func (x *LegacyPerpetualOrder) GetBorrowInterestRate() string {
+func (x *ScheduledOrder) GetSliceCount() uint64 {
 	if x != nil {
-		return x.BorrowInterestRate
+		return x.SliceCount
 	}
-	return ""
-}
-
-type PerpetualOrder struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	OrderId            uint64             `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	OwnerAddress       string             `protobuf:"bytes,2,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
-	PerpetualOrderType PerpetualOrderType `protobuf:"varint,3,opt,name=perpetual_order_type,json=perpetualOrderType,proto3,enum=elys.tradeshield.PerpetualOrderType" json:"perpetual_order_type,omitempty"`
-	Position           PerpetualPosition  `protobuf:"varint,4,opt,name=position,proto3,enum=elys.tradeshield.PerpetualPosition" json:"position,omitempty"`
-	TriggerPrice       *TriggerPrice      `protobuf:"bytes,5,opt,name=trigger_price,json=triggerPrice,proto3" json:"trigger_price,omitempty"`
-	Collateral         *v1beta1.Coin      `protobuf:"bytes,6,opt,name=collateral,proto3" json:"collateral,omitempty"`
-	TradingAsset       string             `protobuf:"bytes,7,opt,name=trading_asset,json=tradingAsset,proto3" json:"trading_asset,omitempty"`
-	Leverage           string             `protobuf:"bytes,8,opt,name=leverage,proto3" json:"leverage,omitempty"`
-	TakeProfitPrice    string             `protobuf:"bytes,9,opt,name=take_profit_price,json=takeProfitPrice,proto3" json:"take_profit_price,omitempty"`
-	PositionId         uint64             `protobuf:"varint,10,opt,name=position_id,json=positionId,proto3" json:"position_id,omitempty"`
-	Status             Status             `protobuf:"varint,11,opt,name=status,proto3,enum=elys.tradeshield.Status" json:"status,omitempty"`
-	StopLossPrice      string             `protobuf:"bytes,12,opt,name=stop_loss_price,json=stopLossPrice,proto3" json:"stop_loss_price,omitempty"`
-	PoolId             uint64             `protobuf:"varint,13,opt,name=poolId,proto3" json:"poolId,omitempty"`
+	return 0
 }
 
-func (x *PerpetualOrder) Reset() {
-	*x = PerpetualOrder{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_elys_tradeshield_types_proto_msgTypes[3]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ScheduledOrder) GetExecutedSlices() uint64 {
+	if x != nil {
+		return x.ExecutedSlices
 	}
+	return 0
 }
 
-func (x *PerpetualOrder) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*PerpetualOrder) ProtoMessage() {}
-
-// Deprecated: Use PerpetualOrder.ProtoReflect.Descriptor instead.
-func (*PerpetualOrder) Descriptor() ([]byte, []int) {
-	return file_elys_tradeshield_types_proto_rawDescGZIP(), []int{3}
-}
-
-func (x *PerpetualOrder) GetOrderId() uint64 {
+func (x *ScheduledOrder) GetInterval() uint64 {
 	if x != nil {
-		return x.OrderId
+		return x.Interval
 	}
 	return 0
 }
 
-func (x *PerpetualOrder) GetOwnerAddress() string {
+func (x *ScheduledOrder) GetIntervalUnit() ScheduleIntervalUnit {
 	if x != nil {
-		return x.OwnerAddress
+		return x.IntervalUnit
 	}
-	return ""
+	return ScheduleIntervalUnit_BLOCKS
 }
 
-func (x *PerpetualOrder) GetPerpetualOrderType() PerpetualOrderType {
+func (x *ScheduledOrder) GetMaxSlippage() string {
 	if x != nil {
-		return x.PerpetualOrderType
+		return x.MaxSlippage
 	}
-	return PerpetualOrderType_LIMITOPEN
+	return ""
 }
 
-func (x *PerpetualOrder) GetPosition() PerpetualPosition {
+func (x *ScheduledOrder) GetNextExecution() uint64 {
 	if x != nil {
-		return x.Position
+		return x.NextExecution
 	}
-	return PerpetualPosition_UNSPECIFIED
+	return 0
 }
 
-func (x *PerpetualOrder) GetTriggerPrice() *TriggerPrice {
+func (x *ScheduledOrder) GetReceivedAmount() *v1beta1.Coin {
 	if x != nil {
-		return x.TriggerPrice
+		return x.ReceivedAmount
 	}
 	return nil
 }
 
-func (x *PerpetualOrder) GetCollateral() *v1beta1.Coin {
+func (x *ScheduledOrder) GetDate() *Date {
 	if x != nil {
-		return x.Collateral
+		return x.Date
 	}
 	return nil
 }
 
-func (x *PerpetualOrder) GetTradingAsset() string {
-	if x != nil {
-		return x.TradingAsset
+// Execution record of a slice of a scheduled order
+type ScheduledOrderSlice struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderId uint64 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// Index of the slice, starting from 1
+	Index     uint64        `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Date      *Date         `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
+	AmountIn  *v1beta1.Coin `protobuf:"bytes,4,opt,name=amount_in,json=amountIn,proto3" json:"amount_in,omitempty"`
+	AmountOut *v1beta1.Coin `protobuf:"bytes,5,opt,name=amount_out,json=amountOut,proto3" json:"amount_out,omitempty"`
+	// Reason for which a skipped slice could not be executed
+	Error string `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *ScheduledOrderSlice) Reset() {
+	*x = ScheduledOrderSlice{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_elys_tradeshield_types_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *PerpetualOrder) GetLeverage() string {
+func (x *ScheduledOrderSlice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduledOrderSlice) ProtoMessage() {}
+
+// Deprecated: Use ScheduledOrderSlice.ProtoReflect.Descriptor instead.
+func (*ScheduledOrderSlice) Descriptor() ([]byte, []int) {
+	return file_elys_tradeshield_types_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ScheduledOrderSlice) GetOrderId() uint64 {
 	if x != nil {
-		return x.Leverage
+		return x.OrderId
 	}
-	return ""
+	return 0
 }
 
-func (x *PerpetualOrder) GetTakeProfitPrice() string {
+func (x *ScheduledOrderSlice) GetIndex() uint64 {
 	if x != nil {
-		return x.TakeProfitPrice
+		return x.Index
 	}
-	return ""
+	return 0
 }
 
-func (x *PerpetualOrder) GetPositionId() uint64 {
+func (x *ScheduledOrderSlice) GetDate() *Date {
 	if x != nil {
-		return x.PositionId
+		return x.Date
 	}
-	return 0
+	return nil
 }
 
-func (x *PerpetualOrder) GetStatus() Status {
+func (x *ScheduledOrderSlice) GetAmountIn() *v1beta1.Coin {
 	if x != nil {
-		return x.Status
+		return x.AmountIn
 	}
-	return Status_PENDING
+	return nil
 }
 
-func (x *PerpetualOrder) GetStopLossPrice() string {
+func (x *ScheduledOrderSlice) GetAmountOut() *v1beta1.Coin {
 	if x != nil {
-		return x.StopLossPrice
+		return x.AmountOut
 	}
-	return ""
+	return nil
 }
 
-func (x *PerpetualOrder) GetPoolId() uint64 {
+func (x *ScheduledOrderSlice) GetError() string {
 	if x != nil {
-		return x.PoolId
+		return x.Error
 	}
-	return 0
+	return ""
 }
 
 type PerpetualOrderExtraInfo struct {
@@ -5057,7 +9931,7 @@ type PerpetualOrderExtraInfo struct {
 func (x *PerpetualOrderExtraInfo) Reset() {
 	*x = PerpetualOrderExtraInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_elys_tradeshield_types_proto_msgTypes[4]
+		mi := &file_elys_tradeshield_types_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5071,7 +9945,7 @@ func (*PerpetualOrderExtraInfo) ProtoMessage() {}
 
 // Deprecated: Use PerpetualOrderExtraInfo.ProtoReflect.Descriptor instead.
 func (*PerpetualOrderExtraInfo) Descriptor() ([]byte, []int) {
-	return file_elys_tradeshield_types_proto_rawDescGZIP(), []int{4}
+	return file_elys_tradeshield_types_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *PerpetualOrderExtraInfo) GetPerpetualOrder() *PerpetualOrder {
@@ -5125,7 +9999,7 @@ var file_elys_tradeshield_types_proto_rawDesc = []byte{
 	0x3c, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68,
 	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
 	0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0xa0, 0x03,
+	0x28, 0x04, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0xf4, 0x05,
 	0x0a, 0x09, 0x53, 0x70, 0x6f, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x3e, 0x0a, 0x0a, 0x6f,
 	0x72, 0x64, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
 	0x1f, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65,
@@ -5152,174 +10026,316 @@ var file_elys_tradeshield_types_proto_rawDesc = []byte{
 	0x61, 0x74, 0x75, 0x73, 0x12, 0x2a, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73,
 	0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65,
-	0x22, 0xd5, 0x08, 0x0a, 0x14, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x50, 0x65, 0x72, 0x70, 0x65,
-	0x74, 0x75, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64,
-	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6f, 0x72, 0x64,
-	0x65, 0x72, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6f, 0x77, 0x6e,
-	0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x56, 0x0a, 0x14, 0x70, 0x65, 0x72,
-	0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74,
-	0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x50, 0x65, 0x72, 0x70, 0x65,
-	0x74, 0x75, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x12, 0x70,
+	0x12, 0x39, 0x0a, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x41, 0x0a, 0x0d, 0x74,
+	0x69, 0x6d, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73,
+	0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x49, 0x6e, 0x46, 0x6f, 0x72, 0x63,
+	0x65, 0x52, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x49, 0x6e, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x12, 0x2e,
+	0x0a, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c,
+	0x64, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x52, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x12, 0x43,
+	0x0a, 0x0d, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x74, 0x6f, 0x70, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61,
+	0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x69, 0x6e,
+	0x67, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x53,
+	0x74, 0x6f, 0x70, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x44,
+	0x0a, 0x0d, 0x66, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e,
+	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0c, 0x66, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x41, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x22, 0xd5, 0x08, 0x0a, 0x14, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x50,
+	0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x19, 0x0a,
+	0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x56, 0x0a,
+	0x14, 0x70, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x65, 0x6c,
+	0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x50,
 	0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x3f, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65,
-	0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c,
-	0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x41, 0x0a, 0x0d, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x70, 0x72,
-	0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x65, 0x6c, 0x79, 0x73,
-	0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x4f, 0x72, 0x64,
-	0x65, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x0c, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72,
-	0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x3f, 0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65,
-	0x72, 0x61, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
-	0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c,
-	0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x72, 0x61, 0x64, 0x69, 0x6e,
-	0x67, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74,
-	0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x4d, 0x0a, 0x08, 0x6c,
-	0x65, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8,
-	0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
-	0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44,
-	0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63,
-	0x52, 0x08, 0x6c, 0x65, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x12, 0x5d, 0x0a, 0x11, 0x74, 0x61,
-	0x6b, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18,
-	0x09, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68,
-	0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0f, 0x74, 0x61, 0x6b, 0x65, 0x50, 0x72,
-	0x6f, 0x66, 0x69, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6f, 0x73,
-	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
-	0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x65, 0x6c, 0x79,
-	0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x59, 0x0a, 0x0f,
-	0x73, 0x74, 0x6f, 0x70, 0x5f, 0x6c, 0x6f, 0x73, 0x73, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18,
-	0x0c, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63,
+	0x65, 0x52, 0x12, 0x70, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3f, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74,
+	0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x50, 0x65, 0x72, 0x70, 0x65,
+	0x74, 0x75, 0x61, 0x6c, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x41, 0x0a, 0x0d, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65,
+	0x72, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64,
+	0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x0c, 0x74, 0x72, 0x69,
+	0x67, 0x67, 0x65, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x3f, 0x0a, 0x0a, 0x63, 0x6f, 0x6c,
+	0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a,
+	0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x72,
+	0x61, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12,
+	0x4d, 0x0a, 0x08, 0x6c, 0x65, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67,
+	0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x44, 0x65, 0x63, 0x52, 0x08, 0x6c, 0x65, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x12, 0x5d,
+	0x0a, 0x11, 0x74, 0x61, 0x6b, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x74, 0x5f, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda,
+	0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
+	0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4,
+	0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0f, 0x74, 0x61,
+	0x6b, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1f, 0x0a,
+	0x0b, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0a, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x30,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18,
+	0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c,
+	0x64, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x59, 0x0a, 0x0f, 0x73, 0x74, 0x6f, 0x70, 0x5f, 0x6c, 0x6f, 0x73, 0x73, 0x5f, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda,
+	0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
+	0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4,
+	0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0d, 0x73, 0x74,
+	0x6f, 0x70, 0x4c, 0x6f, 0x73, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x44, 0x0a, 0x0d, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x00, 0x52, 0x0c, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x69, 0x7a,
+	0x65, 0x12, 0x5e, 0x0a, 0x11, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde,
+	0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
+	0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65,
+	0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52,
+	0x10, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x12, 0x54, 0x0a, 0x0c, 0x66, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x61, 0x74,
+	0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f,
+	0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61,
+	0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0b, 0x66, 0x75, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x65, 0x12, 0x63, 0x0a, 0x14, 0x62, 0x6f, 0x72, 0x72, 0x6f,
+	0x77, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18,
+	0x10, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63,
 	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68,
 	0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0d, 0x73, 0x74, 0x6f, 0x70, 0x4c, 0x6f,
-	0x73, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x44, 0x0a, 0x0d, 0x70, 0x6f, 0x73, 0x69, 0x74,
-	0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x12, 0x62, 0x6f, 0x72, 0x72, 0x6f, 0x77,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x52, 0x61, 0x74, 0x65, 0x22, 0xec, 0x08, 0x0a,
+	0x0e, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12,
+	0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x6f, 0x77,
+	0x6e, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x56, 0x0a, 0x14, 0x70, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e,
+	0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64,
+	0x2e, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x12, 0x70, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3f, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x65, 0x6c, 0x79, 0x73,
+	0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x50, 0x65, 0x72,
+	0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x49, 0x0a, 0x0d, 0x74, 0x72, 0x69, 0x67,
+	0x67, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65,
+	0x6c, 0x64, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x42,
+	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0c, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x12, 0x3f, 0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61,
+	0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f,
+	0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74,
+	0x65, 0x72, 0x61, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x5f,
+	0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x72, 0x61,
+	0x64, 0x69, 0x6e, 0x67, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x4d, 0x0a, 0x08, 0x6c, 0x65, 0x76,
+	0x65, 0x72, 0x61, 0x67, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f,
+	0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
+	0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63,
+	0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x08,
+	0x6c, 0x65, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x12, 0x5d, 0x0a, 0x11, 0x74, 0x61, 0x6b, 0x65,
+	0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c,
+	0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0f, 0x74, 0x61, 0x6b, 0x65, 0x50, 0x72, 0x6f, 0x66,
+	0x69, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e,
+	0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x59, 0x0a, 0x0f, 0x73, 0x74,
+	0x6f, 0x70, 0x5f, 0x6c, 0x6f, 0x73, 0x73, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c,
+	0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0d, 0x73, 0x74, 0x6f, 0x70, 0x4c, 0x6f, 0x73, 0x73,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x6f, 0x6c, 0x49, 0x64, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x6f, 0x6f, 0x6c, 0x49, 0x64, 0x12, 0x4e, 0x0a,
+	0x0c, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0e, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x2b, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49,
+	0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74,
+	0x52, 0x0b, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x39, 0x0a,
+	0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
 	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62,
 	0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
-	0x0c, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x5e, 0x0a,
-	0x11, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x72, 0x69,
-	0x63, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde,
+	0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x41, 0x0a, 0x0d, 0x74, 0x69, 0x6d, 0x65,
+	0x5f, 0x69, 0x6e, 0x5f, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1d, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65,
+	0x6c, 0x64, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x49, 0x6e, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x52, 0x0b,
+	0x74, 0x69, 0x6d, 0x65, 0x49, 0x6e, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x12, 0x2e, 0x0a, 0x06, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x65, 0x6c,
+	0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x44,
+	0x61, 0x74, 0x65, 0x52, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x12, 0x43, 0x0a, 0x0d, 0x74,
+	0x72, 0x61, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x74, 0x6f, 0x70, 0x18, 0x12, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73,
+	0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x53, 0x74,
+	0x6f, 0x70, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x6f, 0x70,
+	0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x13, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x22, 0xb6, 0x02, 0x0a, 0x0c,
+	0x42, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x43, 0x0a, 0x0a,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x24, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69,
+	0x65, 0x6c, 0x64, 0x2e, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x56, 0x0a, 0x0d, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde,
 	0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d,
 	0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d,
-	0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x10, 0x6c, 0x69, 0x71,
-	0x75, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x54, 0x0a,
-	0x0c, 0x66, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x0f, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c,
-	0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0b, 0x66, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52,
-	0x61, 0x74, 0x65, 0x12, 0x63, 0x0a, 0x14, 0x62, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x5f, 0x69, 0x6e,
-	0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67,
-	0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2e, 0x44, 0x65, 0x63, 0x52, 0x12, 0x62, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x49, 0x6e, 0x74, 0x65,
-	0x72, 0x65, 0x73, 0x74, 0x52, 0x61, 0x74, 0x65, 0x22, 0x8e, 0x06, 0x0a, 0x0e, 0x50, 0x65, 0x72,
-	0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x6f,
-	0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6f,
-	0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f,
+	0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0c, 0x74, 0x72, 0x69,
+	0x67, 0x67, 0x65, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x4e, 0x0a, 0x0c, 0x63, 0x6c, 0x6f,
+	0x73, 0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x2b, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
+	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4,
+	0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x0b, 0x63, 0x6c,
+	0x6f, 0x73, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x39, 0x0a, 0x07, 0x64, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x07, 0x64, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x22, 0x8c, 0x02, 0x0a, 0x0a, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x73, 0x70, 0x6f, 0x74,
+	0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x04,
+	0x52, 0x0c, 0x73, 0x70, 0x6f, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x2e,
+	0x0a, 0x13, 0x70, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x04, 0x52, 0x11, 0x70, 0x65, 0x72,
+	0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x26,
+	0x0a, 0x0f, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x4b, 0x0a, 0x0e, 0x62, 0x72, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e,
+	0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c,
+	0x64, 0x2e, 0x42, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x04,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0d, 0x62, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x73, 0x22, 0x9e, 0x05, 0x0a, 0x0e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f,
 	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6f,
-	0x77, 0x6e, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x56, 0x0a, 0x14, 0x70,
-	0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x74,
-	0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x65, 0x6c, 0x79, 0x73,
-	0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x50, 0x65, 0x72,
-	0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52,
-	0x12, 0x70, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x3f, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61,
-	0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75,
-	0x61, 0x6c, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x49, 0x0a, 0x0d, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x5f,
-	0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x65, 0x6c,
-	0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x54,
-	0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f,
-	0x00, 0x52, 0x0c, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12,
-	0x3f, 0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73,
-	0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04,
-	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c,
-	0x12, 0x23, 0x0a, 0x0d, 0x74, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x73, 0x73, 0x65,
-	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67,
-	0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x4d, 0x0a, 0x08, 0x6c, 0x65, 0x76, 0x65, 0x72, 0x61, 0x67,
-	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f,
+	0x77, 0x6e, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x42, 0x0a, 0x0c, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x4a, 0x0a, 0x10, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0f, 0x72, 0x65, 0x6d, 0x61,
+	0x69, 0x6e, 0x69, 0x6e, 0x67, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x1f,
+	0x0a, 0x0b, 0x73, 0x6c, 0x69, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x6c, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x27, 0x0a, 0x0f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x6c, 0x69, 0x63,
+	0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x65, 0x64, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x12, 0x4b, 0x0a, 0x0d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
+	0x5f, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x65, 0x6c,
+	0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x55,
+	0x6e, 0x69, 0x74, 0x52, 0x0c, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x55, 0x6e, 0x69,
+	0x74, 0x12, 0x54, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x6c, 0x69, 0x70, 0x70, 0x61, 0x67,
+	0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f,
 	0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61,
 	0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x08, 0x6c, 0x65, 0x76, 0x65,
-	0x72, 0x61, 0x67, 0x65, 0x12, 0x5d, 0x0a, 0x11, 0x74, 0x61, 0x6b, 0x65, 0x5f, 0x70, 0x72, 0x6f,
-	0x66, 0x69, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
-	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63,
-	0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44,
-	0x65, 0x63, 0x52, 0x0f, 0x74, 0x61, 0x6b, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x74, 0x50, 0x72,
-	0x69, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
-	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0b,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64,
-	0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x59, 0x0a, 0x0f, 0x73, 0x74, 0x6f, 0x70, 0x5f, 0x6c,
-	0x6f, 0x73, 0x73, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
-	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63,
-	0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44,
-	0x65, 0x63, 0x52, 0x0d, 0x73, 0x74, 0x6f, 0x70, 0x4c, 0x6f, 0x73, 0x73, 0x50, 0x72, 0x69, 0x63,
-	0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x6f, 0x6c, 0x49, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x06, 0x70, 0x6f, 0x6f, 0x6c, 0x49, 0x64, 0x22, 0xc5, 0x03, 0x0a, 0x17, 0x50, 0x65,
-	0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x45, 0x78, 0x74, 0x72,
-	0x61, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x49, 0x0a, 0x0f, 0x70, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75,
-	0x61, 0x6c, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20,
-	0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c,
-	0x64, 0x2e, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72,
-	0x52, 0x0e, 0x70, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72,
-	0x12, 0x44, 0x0a, 0x0d, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x69, 0x7a,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x53,
+	0x6c, 0x69, 0x70, 0x70, 0x61, 0x67, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x6e, 0x65, 0x78, 0x74, 0x5f,
+	0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0d, 0x6e, 0x65, 0x78, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x48,
+	0x0a, 0x0f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
 	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f,
-	0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0c, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
-	0x6f, 0x6e, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x5e, 0x0a, 0x11, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67,
-	0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2e, 0x44, 0x65, 0x63, 0x52, 0x10, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x54, 0x0a, 0x0c, 0x66, 0x75, 0x6e, 0x64, 0x69, 0x6e,
-	0x67, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde,
-	0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
-	0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65,
-	0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52,
-	0x0b, 0x66, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x65, 0x12, 0x63, 0x0a, 0x14,
-	0x62, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x5f,
-	0x72, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00,
-	0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
-	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2,
-	0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x12, 0x62,
-	0x6f, 0x72, 0x72, 0x6f, 0x77, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x52, 0x61, 0x74,
-	0x65, 0x2a, 0x3a, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0b, 0x0a, 0x07, 0x50,
-	0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x45, 0x58, 0x45, 0x43,
-	0x55, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c,
-	0x45, 0x44, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x4c, 0x4c, 0x10, 0x03, 0x2a, 0x45, 0x0a,
-	0x12, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x0d, 0x0a, 0x09, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x4f, 0x50, 0x45, 0x4e,
-	0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x43, 0x4c, 0x4f, 0x53, 0x45,
-	0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x54, 0x4f, 0x50, 0x4c, 0x4f, 0x53, 0x53, 0x50, 0x45,
-	0x52, 0x50, 0x10, 0x02, 0x42, 0xb6, 0x01, 0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x6c, 0x79,
-	0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x42, 0x0a, 0x54,
-	0x79, 0x70, 0x65, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x31, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x65, 0x6c, 0x79, 0x73, 0x2d, 0x6e, 0x65, 0x74,
-	0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x65, 0x6c, 0x79, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x6c,
-	0x79, 0x73, 0x2f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0xa2, 0x02,
-	0x03, 0x45, 0x54, 0x58, 0xaa, 0x02, 0x10, 0x45, 0x6c, 0x79, 0x73, 0x2e, 0x54, 0x72, 0x61, 0x64,
-	0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0xca, 0x02, 0x10, 0x45, 0x6c, 0x79, 0x73, 0x5c, 0x54,
-	0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0xe2, 0x02, 0x1c, 0x45, 0x6c, 0x79,
-	0x73, 0x5c, 0x54, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x5c, 0x47, 0x50,
-	0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x11, 0x45, 0x6c, 0x79, 0x73,
-	0x3a, 0x3a, 0x54, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76,
+	0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72,
+	0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x52, 0x04,
+	0x64, 0x61, 0x74, 0x65, 0x22, 0x86, 0x02, 0x0a, 0x13, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x12, 0x19, 0x0a, 0x08,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x2a, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x65, 0x6c,
+	0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x44,
+	0x61, 0x74, 0x65, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x3c, 0x0a, 0x09, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x08, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x12, 0x3e, 0x0a, 0x0a, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x09, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xc5, 0x03,
+	0x0a, 0x17, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x45, 0x78, 0x74, 0x72, 0x61, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x49, 0x0a, 0x0f, 0x70, 0x65, 0x72,
+	0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73,
+	0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x52, 0x0e, 0x70, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x12, 0x44, 0x0a, 0x0d, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0c, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x5e, 0x0a, 0x11, 0x6c, 0x69,
+	0x71, 0x75, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68,
+	0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x10, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x54, 0x0a, 0x0c, 0x66, 0x75,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61,
+	0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x44, 0x65, 0x63, 0x52, 0x0b, 0x66, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x65,
+	0x12, 0x63, 0x0a, 0x14, 0x62, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x65, 0x73, 0x74, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31,
+	0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
+	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79,
+	0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65,
+	0x63, 0x52, 0x12, 0x62, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73,
+	0x74, 0x52, 0x61, 0x74, 0x65, 0x2a, 0x3a, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x0b, 0x0a, 0x07, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08,
+	0x45, 0x58, 0x45, 0x43, 0x55, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x41,
+	0x4e, 0x43, 0x45, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x4c, 0x4c, 0x10,
+	0x03, 0x2a, 0x5b, 0x0a, 0x12, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0d, 0x0a, 0x09, 0x4c, 0x49, 0x4d, 0x49, 0x54,
+	0x4f, 0x50, 0x45, 0x4e, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x43,
+	0x4c, 0x4f, 0x53, 0x45, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x54, 0x4f, 0x50, 0x4c, 0x4f,
+	0x53, 0x53, 0x50, 0x45, 0x52, 0x50, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x52, 0x41, 0x49,
+	0x4c, 0x49, 0x4e, 0x47, 0x53, 0x54, 0x4f, 0x50, 0x50, 0x45, 0x52, 0x50, 0x10, 0x03, 0x2a, 0x2f,
+	0x0a, 0x14, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76,
+	0x61, 0x6c, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x0a, 0x0a, 0x06, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x53,
+	0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x45, 0x43, 0x4f, 0x4e, 0x44, 0x53, 0x10, 0x01, 0x42,
+	0xb6, 0x01, 0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x6c, 0x79, 0x73, 0x2e, 0x74, 0x72, 0x61,
+	0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x42, 0x0a, 0x54, 0x79, 0x70, 0x65, 0x73, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x31, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x65, 0x6c, 0x79, 0x73, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f,
+	0x65, 0x6c, 0x79, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x6c, 0x79, 0x73, 0x2f, 0x74, 0x72,
+	0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0xa2, 0x02, 0x03, 0x45, 0x54, 0x58, 0xaa,
+	0x02, 0x10, 0x45, 0x6c, 0x79, 0x73, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x73, 0x68, 0x69, 0x65,
+	0x6c, 0x64, 0xca, 0x02, 0x10, 0x45, 0x6c, 0x79, 0x73, 0x5c, 0x54, 0x72, 0x61, 0x64, 0x65, 0x73,
+	0x68, 0x69, 0x65, 0x6c, 0x64, 0xe2, 0x02, 0x1c, 0x45, 0x6c, 0x79, 0x73, 0x5c, 0x54, 0x72, 0x61,
+	0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x11, 0x45, 0x6c, 0x79, 0x73, 0x3a, 0x3a, 0x54, 0x72, 0x61,
+	0x64, 0x65, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -5334,46 +10350,73 @@ func file_elys_tradeshield_types_proto_rawDescGZIP() []byte {
 	return file_elys_tradeshield_types_proto_rawDescData
 }
 
-var file_elys_tradeshield_types_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_elys_tradeshield_types_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_elys_tradeshield_types_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_elys_tradeshield_types_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_elys_tradeshield_types_proto_goTypes = []interface{}{
 	(Status)(0),                     // 0: elys.tradeshield.Status
 	(PerpetualOrderType)(0),         // 1: elys.tradeshield.PerpetualOrderType
-	(*Date)(nil),                    // 2: elys.tradeshield.Date
-	(*SpotOrder)(nil),               // 3: elys.tradeshield.SpotOrder
-	(*LegacyPerpetualOrder)(nil),    // 4: elys.tradeshield.LegacyPerpetualOrder
-	(*PerpetualOrder)(nil),          // 5: elys.tradeshield.PerpetualOrder
-	(*PerpetualOrderExtraInfo)(nil), // 6: elys.tradeshield.PerpetualOrderExtraInfo
-	(SpotOrderType)(0),              // 7: elys.tradeshield.SpotOrderType
-	(*OrderPrice)(nil),              // 8: elys.tradeshield.OrderPrice
-	(*v1beta1.Coin)(nil),            // 9: cosmos.base.v1beta1.Coin
-	(PerpetualPosition)(0),          // 10: elys.tradeshield.PerpetualPosition
-	(*TriggerPrice)(nil),            // 11: elys.tradeshield.TriggerPrice
+	(ScheduleIntervalUnit)(0),       // 2: elys.tradeshield.ScheduleIntervalUnit
+	(*Date)(nil),                    // 3: elys.tradeshield.Date
+	(*SpotOrder)(nil),               // 4: elys.tradeshield.SpotOrder
+	(*LegacyPerpetualOrder)(nil),    // 5: elys.tradeshield.LegacyPerpetualOrder
+	(*PerpetualOrder)(nil),          // 6: elys.tradeshield.PerpetualOrder
+	(*BracketOrder)(nil),            // 7: elys.tradeshield.BracketOrder
+	(*OrderGroup)(nil),              // 8: elys.tradeshield.OrderGroup
+	(*ScheduledOrder)(nil),          // 9: elys.tradeshield.ScheduledOrder
+	(*ScheduledOrderSlice)(nil),     // 10: elys.tradeshield.ScheduledOrderSlice
+	(*PerpetualOrderExtraInfo)(nil), // 11: elys.tradeshield.PerpetualOrderExtraInfo
+	(SpotOrderType)(0),              // 12: elys.tradeshield.SpotOrderType
+	(*OrderPrice)(nil),              // 13: elys.tradeshield.OrderPrice
+	(*v1beta1.Coin)(nil),            // 14: cosmos.base.v1beta1.Coin
+	(TimeInForce)(0),                // 15: elys.tradeshield.TimeInForce
+	(*TrailingStop)(nil),            // 16: elys.tradeshield.TrailingStop
+	(PerpetualPosition)(0),          // 17: elys.tradeshield.PerpetualPosition
+	(*TriggerPrice)(nil),            // 18: elys.tradeshield.TriggerPrice
 }
 var file_elys_tradeshield_types_proto_depIdxs = []int32{
-	7,  // 0: elys.tradeshield.SpotOrder.order_type:type_name -> elys.tradeshield.SpotOrderType
-	8,  // 1: elys.tradeshield.SpotOrder.order_price:type_name -> elys.tradeshield.OrderPrice
-	9,  // 2: elys.tradeshield.SpotOrder.order_amount:type_name -> cosmos.base.v1beta1.Coin
+	12, // 0: elys.tradeshield.SpotOrder.order_type:type_name -> elys.tradeshield.SpotOrderType
+	13, // 1: elys.tradeshield.SpotOrder.order_price:type_name -> elys.tradeshield.OrderPrice
+	14, // 2: elys.tradeshield.SpotOrder.order_amount:type_name -> cosmos.base.v1beta1.Coin
 	0,  // 3: elys.tradeshield.SpotOrder.status:type_name -> elys.tradeshield.Status
-	2,  // 4: elys.tradeshield.SpotOrder.date:type_name -> elys.tradeshield.Date
-	1,  // 5: elys.tradeshield.LegacyPerpetualOrder.perpetual_order_type:type_name -> elys.tradeshield.PerpetualOrderType
-	10, // 6: elys.tradeshield.LegacyPerpetualOrder.position:type_name -> elys.tradeshield.PerpetualPosition
-	8,  // 7: elys.tradeshield.LegacyPerpetualOrder.trigger_price:type_name -> elys.tradeshield.OrderPrice
-	9,  // 8: elys.tradeshield.LegacyPerpetualOrder.collateral:type_name -> cosmos.base.v1beta1.Coin
-	0,  // 9: elys.tradeshield.LegacyPerpetualOrder.status:type_name -> elys.tradeshield.Status
-	9,  // 10: elys.tradeshield.LegacyPerpetualOrder.position_size:type_name -> cosmos.base.v1beta1.Coin
-	1,  // 11: elys.tradeshield.PerpetualOrder.perpetual_order_type:type_name -> elys.tradeshield.PerpetualOrderType
-	10, // 12: elys.tradeshield.PerpetualOrder.position:type_name -> elys.tradeshield.PerpetualPosition
-	11, // 13: elys.tradeshield.PerpetualOrder.trigger_price:type_name -> elys.tradeshield.TriggerPrice
-	9,  // 14: elys.tradeshield.PerpetualOrder.collateral:type_name -> cosmos.base.v1beta1.Coin
-	0,  // 15: elys.tradeshield.PerpetualOrder.status:type_name -> elys.tradeshield.Status
-	5,  // 16: elys.tradeshield.PerpetualOrderExtraInfo.perpetual_order:type_name -> elys.tradeshield.PerpetualOrder
-	9,  // 17: elys.tradeshield.PerpetualOrderExtraInfo.position_size:type_name -> cosmos.base.v1beta1.Coin
-	18, // [18:18] is the sub-list for method output_type
-	18, // [18:18] is the sub-list for method input_type
-	18, // [18:18] is the sub-list for extension type_name
-	18, // [18:18] is the sub-list for extension extendee
-	0,  // [0:18] is the sub-list for field type_name
+	3,  // 4: elys.tradeshield.SpotOrder.date:type_name -> elys.tradeshield.Date
+	14, // 5: elys.tradeshield.SpotOrder.deposit:type_name -> cosmos.base.v1beta1.Coin
+	15, // 6: elys.tradeshield.SpotOrder.time_in_force:type_name -> elys.tradeshield.TimeInForce
+	3,  // 7: elys.tradeshield.SpotOrder.expiry:type_name -> elys.tradeshield.Date
+	16, // 8: elys.tradeshield.SpotOrder.trailing_stop:type_name -> elys.tradeshield.TrailingStop
+	14, // 9: elys.tradeshield.SpotOrder.filled_amount:type_name -> cosmos.base.v1beta1.Coin
+	1,  // 10: elys.tradeshield.LegacyPerpetualOrder.perpetual_order_type:type_name -> elys.tradeshield.PerpetualOrderType
+	17, // 11: elys.tradeshield.LegacyPerpetualOrder.position:type_name -> elys.tradeshield.PerpetualPosition
+	13, // 12: elys.tradeshield.LegacyPerpetualOrder.trigger_price:type_name -> elys.tradeshield.OrderPrice
+	14, // 13: elys.tradeshield.LegacyPerpetualOrder.collateral:type_name -> cosmos.base.v1beta1.Coin
+	0,  // 14: elys.tradeshield.LegacyPerpetualOrder.status:type_name -> elys.tradeshield.Status
+	14, // 15: elys.tradeshield.LegacyPerpetualOrder.position_size:type_name -> cosmos.base.v1beta1.Coin
+	1,  // 16: elys.tradeshield.PerpetualOrder.perpetual_order_type:type_name -> elys.tradeshield.PerpetualOrderType
+	17, // 17: elys.tradeshield.PerpetualOrder.position:type_name -> elys.tradeshield.PerpetualPosition
+	18, // 18: elys.tradeshield.PerpetualOrder.trigger_price:type_name -> elys.tradeshield.TriggerPrice
+	14, // 19: elys.tradeshield.PerpetualOrder.collateral:type_name -> cosmos.base.v1beta1.Coin
+	0,  // 20: elys.tradeshield.PerpetualOrder.status:type_name -> elys.tradeshield.Status
+	14, // 21: elys.tradeshield.PerpetualOrder.deposit:type_name -> cosmos.base.v1beta1.Coin
+	15, // 22: elys.tradeshield.PerpetualOrder.time_in_force:type_name -> elys.tradeshield.TimeInForce
+	3,  // 23: elys.tradeshield.PerpetualOrder.expiry:type_name -> elys.tradeshield.Date
+	16, // 24: elys.tradeshield.PerpetualOrder.trailing_stop:type_name -> elys.tradeshield.TrailingStop
+	1,  // 25: elys.tradeshield.BracketOrder.order_type:type_name -> elys.tradeshield.PerpetualOrderType
+	14, // 26: elys.tradeshield.BracketOrder.deposit:type_name -> cosmos.base.v1beta1.Coin
+	7,  // 27: elys.tradeshield.OrderGroup.bracket_orders:type_name -> elys.tradeshield.BracketOrder
+	14, // 28: elys.tradeshield.ScheduledOrder.total_amount:type_name -> cosmos.base.v1beta1.Coin
+	14, // 29: elys.tradeshield.ScheduledOrder.remaining_amount:type_name -> cosmos.base.v1beta1.Coin
+	2,  // 30: elys.tradeshield.ScheduledOrder.interval_unit:type_name -> elys.tradeshield.ScheduleIntervalUnit
+	14, // 31: elys.tradeshield.ScheduledOrder.received_amount:type_name -> cosmos.base.v1beta1.Coin
+	3,  // 32: elys.tradeshield.ScheduledOrder.date:type_name -> elys.tradeshield.Date
+	3,  // 33: elys.tradeshield.ScheduledOrderSlice.date:type_name -> elys.tradeshield.Date
+	14, // 34: elys.tradeshield.ScheduledOrderSlice.amount_in:type_name -> cosmos.base.v1beta1.Coin
+	14, // 35: elys.tradeshield.ScheduledOrderSlice.amount_out:type_name -> cosmos.base.v1beta1.Coin
+	6,  // 36: elys.tradeshield.PerpetualOrderExtraInfo.perpetual_order:type_name -> elys.tradeshield.PerpetualOrder
+	14, // 37: elys.tradeshield.PerpetualOrderExtraInfo.position_size:type_name -> cosmos.base.v1beta1.Coin
+	38, // [38:38] is the sub-list for method output_type
+	38, // [38:38] is the sub-list for method input_type
+	38, // [38:38] is the sub-list for extension type_name
+	38, // [38:38] is the sub-list for extension extendee
+	0,  // [0:38] is the sub-list for field type_name
 }
 
 func init() { file_elys_tradeshield_types_proto_init() }
@@ -5432,6 +10475,54 @@ func file_elys_tradeshield_types_proto_init() {
 			}
 		}
 		file_elys_tradeshield_types_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BracketOrder); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_elys_tradeshield_types_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OrderGroup); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_elys_tradeshield_types_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduledOrder); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_elys_tradeshield_types_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduledOrderSlice); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_elys_tradeshield_types_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PerpetualOrderExtraInfo); i {
 			case 0:
 				return &v.state
@@ -5449,8 +10540,8 @@ func file_elys_tradeshield_types_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_elys_tradeshield_types_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   5,
+			NumEnums:      3,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
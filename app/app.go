@@ -5,12 +5,14 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 
 	/* *************************************************************************** */
 	/* Start of kwak-indexer node imports*/
 
 	"github.com/elys-network/elys/indexer"
+	indexerBankTypes "github.com/elys-network/elys/indexer/txs/bank"
 
 	/* End of kwak-indexer node imports*/
 	/* *************************************************************************** */
@@ -177,6 +179,14 @@ func NewElysApp(
 	bApp.SetInterfaceRegistry(interfaceRegistry)
 	bApp.SetTxEncoder(txConfig.TxEncoder())
 
+	/* *************************************************************************** */
+	/* Start of kwak-indexer node implementation*/
+
+	indexer.SetConfig(indexer.ReadConfig(appOpts))
+
+	/* End of kwak-indexer node implementation*/
+	/* *************************************************************************** */
+
 	app := &ElysApp{
 		BaseApp:           bApp,
 		legacyAmino:       legacyAmino,
@@ -311,6 +321,11 @@ func NewElysApp(
 	app.SetPreBlocker(app.PreBlocker)
 	app.SetBeginBlocker(app.BeginBlocker)
 	app.SetEndBlocker(app.EndBlocker)
+	/* *************************************************************************** */
+	/* Start of kwak-indexer node implementation*/
+	app.SetPrecommiter(app.Precommiter)
+	/* End of kwak-indexer node implementation*/
+	/* *************************************************************************** */
 
 	app.setUpgradeHandler()
 	app.setUpgradeStore()
@@ -359,6 +374,8 @@ func (app *ElysApp) PreBlocker(ctx sdk.Context, _ *abci.RequestFinalizeBlock) (*
 
 		fmt.Println("Indexer Starting")
 		indexer.Init(app)
+		indexer.SetUnrealizedPnLSource(app.unrealizedPnL)
+		indexer.SetBalanceSource(app.ledgerBalances)
 		app.indexerInitialized = true
 		fmt.Println("Indexer Started")
 
@@ -369,8 +386,104 @@ func (app *ElysApp) PreBlocker(ctx sdk.Context, _ *abci.RequestFinalizeBlock) (*
 	return app.mm.PreBlock(ctx)
 }
 
+/* *************************************************************************** */
+/* Start of kwak-indexer node implementation*/
+
+// Precommiter runs once the block is executed, every indexer record of the
+// block is queued by then and the sinks can deliver it
+func (app *ElysApp) Precommiter(ctx sdk.Context) {
+	if err := indexer.CheckpointBalances(ctx); err != nil {
+		ctx.Logger().Error("failed to checkpoint the indexer balances", "error", err)
+	}
+	indexer.CommitBlock(ctx.BlockHeight())
+}
+
+// FinalizeBlock executes a block. In ledger mode the addresses of its coin
+// events, including the ones of every transaction, get balance checkpoints.
+func (app *ElysApp) FinalizeBlock(req *abci.RequestFinalizeBlock) (*abci.ResponseFinalizeBlock, error) {
+	res, err := app.BaseApp.FinalizeBlock(req)
+	if err != nil || !indexer.LedgerModeEnabled() {
+		return res, err
+	}
+	indexer.TouchCoinEvents(req.Height, res.Events)
+	for _, txResult := range res.TxResults {
+		indexer.TouchCoinEvents(req.Height, txResult.Events)
+	}
+	return res, nil
+}
+
+// ledgerBalances reads the bank and commitment balances of an address for the
+// ledger mode balance checkpoints
+func (app *ElysApp) ledgerBalances(ctx sdk.Context, address string) ([]indexerBankTypes.DenomBalance, error) {
+	addr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return nil, err
+	}
+
+	type amounts struct{ bank, committed, claimed, vesting math.Int }
+	byDenom := make(map[string]*amounts)
+	get := func(denom string) *amounts {
+		if byDenom[denom] == nil {
+			byDenom[denom] = &amounts{math.ZeroInt(), math.ZeroInt(), math.ZeroInt(), math.ZeroInt()}
+		}
+		return byDenom[denom]
+	}
+
+	for _, coin := range app.BankKeeper.GetAllBalances(ctx, addr) {
+		get(coin.Denom).bank = coin.Amount
+	}
+	commitments := app.CommitmentKeeper.GetCommitments(ctx, addr)
+	for _, committed := range commitments.CommittedTokens {
+		entry := get(committed.Denom)
+		entry.committed = entry.committed.Add(committed.Amount)
+	}
+	for _, claimed := range commitments.Claimed {
+		entry := get(claimed.Denom)
+		entry.claimed = entry.claimed.Add(claimed.Amount)
+	}
+	for _, vesting := range commitments.VestingTokens {
+		entry := get(vesting.Denom)
+		entry.vesting = entry.vesting.Add(vesting.TotalAmount.Sub(vesting.ClaimedAmount))
+	}
+
+	var balances []indexerBankTypes.DenomBalance
+	for denom, entry := range byDenom {
+		if entry.bank.IsZero() && entry.committed.IsZero() && entry.claimed.IsZero() && entry.vesting.IsZero() {
+			continue
+		}
+		balances = append(balances, indexerBankTypes.DenomBalance{
+			Denom:     denom,
+			Bank:      entry.bank.String(),
+			Committed: entry.committed.String(),
+			Claimed:   entry.claimed.String(),
+			Vesting:   entry.vesting.String(),
+		})
+	}
+	sort.Slice(balances, func(i, j int) bool { return balances[i].Denom < balances[j].Denom })
+	return balances, nil
+}
+
+// unrealizedPnL computes the unrealized PnL of the open positions of an address
+// on the latest committed state
+func (app *ElysApp) unrealizedPnL(address string) ([]indexer.UnrealizedPnL, error) {
+	ctx, err := app.CreateQueryContext(0, false)
+	if err != nil {
+		return nil, err
+	}
+	return app.PerpetualKeeper.GetUnrealizedPnL(ctx, address)
+}
+
+/* End of kwak-indexer node implementation*/
+/* *************************************************************************** */
+
 // BeginBlocker application updates every begin block
 func (app *ElysApp) BeginBlocker(ctx sdk.Context) (sdk.BeginBlock, error) {
+	/* *************************************************************************** */
+	/* Start of kwak-indexer node implementation*/
+	ctx = indexer.WithEventPhase(ctx, indexer.EventPhaseBeginBlock)
+	/* End of kwak-indexer node implementation*/
+	/* *************************************************************************** */
+
 	// if block height is 11517092 then apply patch 3
 	if ctx.BlockHeight() == 11517092 {
 		app.Logger().Info("block heigh is 11517092, applying patch 3")
@@ -389,6 +502,12 @@ func (app *ElysApp) BeginBlocker(ctx sdk.Context) (sdk.BeginBlock, error) {
 
 // EndBlocker application updates every end block
 func (app *ElysApp) EndBlocker(ctx sdk.Context) (sdk.EndBlock, error) {
+	/* *************************************************************************** */
+	/* Start of kwak-indexer node implementation*/
+	ctx = indexer.WithEventPhase(ctx, indexer.EventPhaseEndBlock)
+	/* End of kwak-indexer node implementation*/
+	/* *************************************************************************** */
+
 	return app.mm.EndBlock(ctx)
 }
 
@@ -490,6 +490,7 @@ func NewAppKeeper(
 		app.BankKeeper,
 		app.CommitmentKeeper,
 		app.AssetprofileKeeper,
+		app.OracleKeeper,
 	)
 
 	app.CommitmentKeeper.SetHooks(
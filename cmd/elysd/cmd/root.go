@@ -48,6 +48,8 @@ import (
 
 	"github.com/elys-network/elys/app"
 	appparams "github.com/elys-network/elys/app/params"
+	"github.com/elys-network/elys/indexer"
+	indexercli "github.com/elys-network/elys/indexer/cli"
 )
 
 var tempDir = func() string {
@@ -205,6 +207,7 @@ func initRootCmd(rootCmd *cobra.Command,
 		queryCommand(basicManager),
 		txCommand(basicManager),
 		keys.Commands(),
+		indexercli.GetCommands(),
 	)
 }
 
@@ -374,6 +377,8 @@ func initAppConfig() (string, interface{}) {
 
 	type CustomAppConfig struct {
 		serverconfig.Config
+
+		Indexer indexer.Config `mapstructure:"indexer"`
 	}
 
 	// Optionally allow the chain developer to overwrite the SDK's default
@@ -394,8 +399,9 @@ func initAppConfig() (string, interface{}) {
 	srvCfg.MinGasPrices = "0uelys"
 	// srvCfg.BaseConfig.IAVLDisableFastNode = true // disable fastnode by default
 	customAppConfig := CustomAppConfig{
-		Config: *srvCfg,
+		Config:  *srvCfg,
+		Indexer: indexer.DefaultConfig(),
 	}
-	customAppTemplate := serverconfig.DefaultConfigTemplate
+	customAppTemplate := serverconfig.DefaultConfigTemplate + indexer.ConfigTemplate
 	return customAppTemplate, customAppConfig
 }
@@ -0,0 +1,62 @@
+package indexer
+
+import (
+	"encoding/json"
+
+	"github.com/bmatsuo/lmdb-go/lmdb"
+
+	indexerTypes "github.com/elys-network/elys/indexer/types"
+)
+
+// Aggregator maintains a derived view (candles, positions, metrics...) over the
+// indexed records. Aggregators own their LMDB databases and are updated inside
+// the same write transaction that stores the record, so a view never drifts
+// from the records it was built from.
+type Aggregator interface {
+	// Name identifies the aggregator in error messages
+	Name() string
+	// Open creates or opens the databases used by the aggregator
+	Open(txn *lmdb.Txn) error
+	// Apply updates the view with a newly stored record
+	Apply(txn *lmdb.Txn, index uint64, record indexerTypes.GenericRecord) error
+}
+
+var aggregators []Aggregator
+
+// RegisterAggregator adds an aggregator that is opened with the database and
+// applied to every record stored afterwards
+func RegisterAggregator(aggregator Aggregator) {
+	aggregators = append(aggregators, aggregator)
+}
+
+// decodeData converts record data into out. Data holds the typed struct when a
+// record is freshly processed and a generic map when it was read back from the
+// store, so it is converted through its JSON form in both cases.
+func decodeData(data interface{}, out interface{}) error {
+	dataBytes, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(dataBytes, out)
+}
+
+// recordData returns the typed data of a record. Freshly processed records
+// already carry their struct, records read back from the store are converted
+// through the transaction and event registries. ok is false for unknown types.
+func recordData(record indexerTypes.GenericRecord) (data interface{}, ok bool) {
+	if record.IsTransaction() {
+		if proc, isProc := record.Transaction.Data.(indexerTypes.Processor); isProc {
+			return proc, true
+		}
+		_, proc, err := ParseTransaction(*record.Transaction)
+		return proc, err == nil
+	}
+	if record.IsEvent() {
+		if proc, isProc := record.Event.Data.(indexerTypes.EventProcessor); isProc {
+			return proc, true
+		}
+		_, proc, err := ParseEvent(*record.Event)
+		return proc, err == nil
+	}
+	return nil, false
+}
@@ -0,0 +1,439 @@
+package indexer
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/bmatsuo/lmdb-go/lmdb"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/elys-network/elys/indexer/txs/bank"
+	indexerTypes "github.com/elys-network/elys/indexer/types"
+)
+
+// In ledger mode the indexer records the balances of every address whose
+// balances may have changed in a block: the receivers and spenders of the bank
+// coin events and the addresses whose commitments were written. The balances
+// are read from the state once the block is executed and stored as
+// BalanceCheckpointEvent records, the balances aggregator keeps a checkpoint
+// per address and denom at every height its balance changed.
+
+// BalanceSource reads the balances of an address from the state of ctx
+type BalanceSource func(ctx sdk.Context, address string) ([]bank.DenomBalance, error)
+
+var balanceSource BalanceSource
+
+// SetBalanceSource sets the source the balances of ledger mode are read from
+func SetBalanceSource(source BalanceSource) {
+	balanceSource = source
+}
+
+var (
+	touchedMutex     sync.Mutex
+	touchedHeight    int64
+	touchedAddresses map[string]struct{}
+)
+
+// TouchBalances marks the balances of addresses as changed in the block of ctx
+func TouchBalances(ctx sdk.Context, addresses ...string) {
+	if !LedgerModeEnabled() || !ShouldIndex(ctx) {
+		return
+	}
+	touchAddresses(ctx.BlockHeight(), addresses)
+}
+
+// TouchCoinEvents marks the receivers of coin_received events and the spenders
+// of coin_spent events of the block at height
+func TouchCoinEvents(height int64, events []abci.Event) {
+	if !LedgerModeEnabled() {
+		return
+	}
+	var addresses []string
+	for _, event := range events {
+		var key string
+		switch event.Type {
+		case banktypes.EventTypeCoinReceived:
+			key = banktypes.AttributeKeyReceiver
+		case banktypes.EventTypeCoinSpent:
+			key = banktypes.AttributeKeySpender
+		default:
+			continue
+		}
+		for _, attribute := range event.Attributes {
+			if attribute.Key == key {
+				addresses = append(addresses, attribute.Value)
+			}
+		}
+	}
+	touchAddresses(height, addresses)
+}
+
+func touchAddresses(height int64, addresses []string) {
+	touchedMutex.Lock()
+	defer touchedMutex.Unlock()
+
+	if height != touchedHeight {
+		touchedHeight = height
+		touchedAddresses = make(map[string]struct{})
+	}
+	for _, address := range addresses {
+		if address != "" {
+			touchedAddresses[address] = struct{}{}
+		}
+	}
+}
+
+// takeTouchedAddresses returns the addresses touched at height in order and
+// clears them
+func takeTouchedAddresses(height int64) []string {
+	touchedMutex.Lock()
+	defer touchedMutex.Unlock()
+
+	if height != touchedHeight {
+		return nil
+	}
+	addresses := make([]string, 0, len(touchedAddresses))
+	for address := range touchedAddresses {
+		addresses = append(addresses, address)
+	}
+	touchedAddresses = make(map[string]struct{})
+	sort.Strings(addresses)
+	return addresses
+}
+
+// CheckpointBalances queues the balances of the addresses touched in the block
+// of ctx. The app calls it once the block is executed, before CommitBlock. The
+// addresses whose balances cannot be read are skipped and reported in the
+// returned error.
+func CheckpointBalances(ctx sdk.Context) error {
+	addresses := takeTouchedAddresses(ctx.BlockHeight())
+	if balanceSource == nil || !LedgerModeEnabled() {
+		return nil
+	}
+	var errs []error
+	for _, address := range addresses {
+		balances, err := balanceSource(ctx, address)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to read the balances of %s: %w", address, err))
+			continue
+		}
+		QueueEvent(ctx, indexerTypes.ElysEventTypes.Bank.BalanceCheckpoint, bank.BalanceCheckpointEvent{
+			Address:  address,
+			Balances: balances,
+		}, []string{address})
+	}
+	return errors.Join(errs...)
+}
+
+// BalanceCheckpoint is the balance of an address in a denom from Height on.
+// Total sums the bank and commitment balances.
+type BalanceCheckpoint struct {
+	Address   string    `json:"address"`
+	Denom     string    `json:"denom"`
+	Height    int64     `json:"height"`
+	Time      time.Time `json:"time"`
+	Bank      string    `json:"bank"`
+	Committed string    `json:"committed"`
+	Claimed   string    `json:"claimed"`
+	Vesting   string    `json:"vesting"`
+	Total     string    `json:"total"`
+}
+
+func (c BalanceCheckpoint) sameBalance(other BalanceCheckpoint) bool {
+	return c.Bank == other.Bank && c.Committed == other.Committed && c.Claimed == other.Claimed && c.Vesting == other.Vesting
+}
+
+func (c BalanceCheckpoint) isZero() bool {
+	return c.Total == "" || c.Total == "0"
+}
+
+// newBalanceCheckpoint normalizes a denom balance into a checkpoint
+func newBalanceCheckpoint(address string, balance bank.DenomBalance, height int64, blockTime time.Time) BalanceCheckpoint {
+	checkpoint := BalanceCheckpoint{
+		Address:   address,
+		Denom:     balance.Denom,
+		Height:    height,
+		Time:      blockTime,
+		Bank:      balanceAmount(balance.Bank).String(),
+		Committed: balanceAmount(balance.Committed).String(),
+		Claimed:   balanceAmount(balance.Claimed).String(),
+		Vesting:   balanceAmount(balance.Vesting).String(),
+	}
+	checkpoint.Total = balanceAmount(balance.Bank).
+		Add(balanceAmount(balance.Committed)).
+		Add(balanceAmount(balance.Claimed)).
+		Add(balanceAmount(balance.Vesting)).String()
+	return checkpoint
+}
+
+func balanceAmount(value string) math.Int {
+	amount, ok := math.NewIntFromString(value)
+	if !ok {
+		return math.ZeroInt()
+	}
+	return amount
+}
+
+// balancesAggregator keeps the balance checkpoints of ledger mode.
+// - checkpointDB: "<address>/<denom>/<height>" -> BalanceCheckpoint
+// - latestDB: "<address>/<denom>" -> latest BalanceCheckpoint
+//
+// A checkpoint is only stored when the balance differs from the previous one.
+// Denoms an address no longer holds get a zero checkpoint.
+type balancesAggregator struct {
+	checkpointDB lmdb.DBI
+	latestDB     lmdb.DBI
+}
+
+var balances = &balancesAggregator{}
+
+func init() {
+	RegisterAggregator(balances)
+}
+
+func (a *balancesAggregator) Name() string {
+	return "balances"
+}
+
+func (a *balancesAggregator) Open(txn *lmdb.Txn) (err error) {
+	if a.checkpointDB, err = txn.OpenDBI("balancecheckpoints", lmdb.Create); err != nil {
+		return err
+	}
+	a.latestDB, err = txn.OpenDBI("balancelatest", lmdb.Create)
+	return err
+}
+
+func (a *balancesAggregator) Apply(txn *lmdb.Txn, index uint64, record indexerTypes.GenericRecord) error {
+	data, ok := recordData(record)
+	if !ok {
+		return nil
+	}
+	event, ok := data.(bank.BalanceCheckpointEvent)
+	if !ok {
+		return nil
+	}
+
+	height, blockTime := record.BlockHeight(), record.BlockTime()
+	held := make(map[string]bool)
+	for _, balance := range event.Balances {
+		held[balance.Denom] = true
+		if err := a.checkpoint(txn, newBalanceCheckpoint(event.Address, balance, height, blockTime)); err != nil {
+			return err
+		}
+	}
+
+	// Zero the denoms left out of the event
+	latest, err := a.latestBalances(txn, event.Address)
+	if err != nil {
+		return err
+	}
+	for _, checkpoint := range latest {
+		if held[checkpoint.Denom] || checkpoint.isZero() {
+			continue
+		}
+		zero := newBalanceCheckpoint(event.Address, bank.DenomBalance{Denom: checkpoint.Denom}, height, blockTime)
+		if err := a.checkpoint(txn, zero); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkpoint stores checkpoint unless the balance did not change
+func (a *balancesAggregator) checkpoint(txn *lmdb.Txn, checkpoint BalanceCheckpoint) error {
+	latestKey := []byte(checkpoint.Address + "/" + checkpoint.Denom)
+	var latest BalanceCheckpoint
+	value, err := txn.Get(a.latestDB, latestKey)
+	if err == nil {
+		if err := json.Unmarshal(value, &latest); err != nil {
+			return err
+		}
+		if latest.sameBalance(checkpoint) {
+			return nil
+		}
+	} else if !lmdb.IsNotFound(err) {
+		return err
+	} else if checkpoint.isZero() {
+		return nil
+	}
+
+	checkpointBytes, err := json.Marshal(checkpoint)
+	if err != nil {
+		return err
+	}
+	if err := txn.Put(a.checkpointDB, balanceCheckpointKey(checkpoint.Address, checkpoint.Denom, checkpoint.Height), checkpointBytes, 0); err != nil {
+		return err
+	}
+	return txn.Put(a.latestDB, latestKey, checkpointBytes, 0)
+}
+
+// latestBalances returns the latest checkpoint of every denom of an address
+func (a *balancesAggregator) latestBalances(txn *lmdb.Txn, address string) ([]BalanceCheckpoint, error) {
+	cursor, err := txn.OpenCursor(a.latestDB)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close()
+
+	var result []BalanceCheckpoint
+	prefix := address + "/"
+	key, value, err := cursor.Get([]byte(prefix), nil, lmdb.SetRange)
+	for ; err == nil; key, value, err = cursor.Get(nil, nil, lmdb.Next) {
+		if !strings.HasPrefix(string(key), prefix) {
+			break
+		}
+		var checkpoint BalanceCheckpoint
+		if err := json.Unmarshal(value, &checkpoint); err != nil {
+			return nil, err
+		}
+		result = append(result, checkpoint)
+	}
+	if err != nil && !lmdb.IsNotFound(err) {
+		return nil, err
+	}
+	return result, nil
+}
+
+// balanceAt returns the checkpoint of an address and denom in effect at height,
+// or the latest one when height is 0. A zero balance is returned when the
+// address held none of the denom.
+func (a *balancesAggregator) balanceAt(txn *lmdb.Txn, address, denom string, height int64) (BalanceCheckpoint, error) {
+	checkpoint := newBalanceCheckpoint(address, bank.DenomBalance{Denom: denom}, 0, time.Time{})
+	if height <= 0 {
+		value, err := txn.Get(a.latestDB, []byte(address+"/"+denom))
+		if lmdb.IsNotFound(err) {
+			return checkpoint, nil
+		} else if err != nil {
+			return checkpoint, err
+		}
+		err = json.Unmarshal(value, &checkpoint)
+		return checkpoint, err
+	}
+
+	cursor, err := txn.OpenCursor(a.checkpointDB)
+	if err != nil {
+		return checkpoint, err
+	}
+	defer cursor.Close()
+
+	// Step back from the first key past height
+	_, value, err := cursor.Get(balanceCheckpointKey(address, denom, height+1), nil, lmdb.SetRange)
+	if err == nil {
+		_, value, err = cursor.Get(nil, nil, lmdb.Prev)
+	} else if lmdb.IsNotFound(err) {
+		_, value, err = cursor.Get(nil, nil, lmdb.Last)
+	}
+	if lmdb.IsNotFound(err) {
+		return checkpoint, nil
+	} else if err != nil {
+		return checkpoint, err
+	}
+
+	var found BalanceCheckpoint
+	if err := json.Unmarshal(value, &found); err != nil {
+		return checkpoint, err
+	}
+	if found.Address != address || found.Denom != denom || found.Height > height {
+		return checkpoint, nil
+	}
+	return found, nil
+}
+
+// balanceAtTime returns the checkpoint of an address and denom in effect at t
+func (a *balancesAggregator) balanceAtTime(txn *lmdb.Txn, address, denom string, t time.Time) (BalanceCheckpoint, error) {
+	cursor, err := txn.OpenCursor(a.checkpointDB)
+	if err != nil {
+		return BalanceCheckpoint{}, err
+	}
+	defer cursor.Close()
+
+	// Checkpoint times increase with their height, walk back from the latest.
+	// The prefix also matches longer denoms, e.g. ibc/... for ibc.
+	prefix := address + "/" + denom + "/"
+	_, _, err = cursor.Get([]byte(prefix+"~"), nil, lmdb.SetRange)
+	var key, value []byte
+	if err == nil {
+		key, value, err = cursor.Get(nil, nil, lmdb.Prev)
+	} else if lmdb.IsNotFound(err) {
+		key, value, err = cursor.Get(nil, nil, lmdb.Last)
+	}
+	for ; err == nil; key, value, err = cursor.Get(nil, nil, lmdb.Prev) {
+		if !strings.HasPrefix(string(key), prefix) {
+			break
+		}
+		var checkpoint BalanceCheckpoint
+		if err := json.Unmarshal(value, &checkpoint); err != nil {
+			return BalanceCheckpoint{}, err
+		}
+		if checkpoint.Denom == denom && !checkpoint.Time.After(t) {
+			return checkpoint, nil
+		}
+	}
+	if err != nil && !lmdb.IsNotFound(err) {
+		return BalanceCheckpoint{}, err
+	}
+	return newBalanceCheckpoint(address, bank.DenomBalance{Denom: denom}, 0, time.Time{}), nil
+}
+
+// GetBalanceAt returns the balance of an address in a denom at the end of the
+// block at height, the latest balance when height is 0
+func (m *LMDBManager) GetBalanceAt(address, denom string, height int64) (BalanceCheckpoint, error) {
+	var result BalanceCheckpoint
+	err := m.view(func(txn *lmdb.Txn) (err error) {
+		result, err = balances.balanceAt(txn, address, denom, height)
+		return err
+	})
+	return result, err
+}
+
+// GetBalanceAtTime returns the balance of an address in a denom at the end of
+// the last block at or before t
+func (m *LMDBManager) GetBalanceAtTime(address, denom string, t time.Time) (BalanceCheckpoint, error) {
+	var result BalanceCheckpoint
+	err := m.view(func(txn *lmdb.Txn) (err error) {
+		result, err = balances.balanceAtTime(txn, address, denom, t)
+		return err
+	})
+	return result, err
+}
+
+// GetBalancesAt returns the non zero balances of an address in every denom at
+// height, or at t when t is set, ordered by denom. Height 0 and a zero t return
+// the latest balances.
+func (m *LMDBManager) GetBalancesAt(address string, height int64, t time.Time) ([]BalanceCheckpoint, error) {
+	result := []BalanceCheckpoint{}
+	err := m.view(func(txn *lmdb.Txn) error {
+		latest, err := balances.latestBalances(txn, address)
+		if err != nil {
+			return err
+		}
+		for _, denomLatest := range latest {
+			var checkpoint BalanceCheckpoint
+			if t.IsZero() {
+				checkpoint, err = balances.balanceAt(txn, address, denomLatest.Denom, height)
+			} else {
+				checkpoint, err = balances.balanceAtTime(txn, address, denomLatest.Denom, t)
+			}
+			if err != nil {
+				return err
+			}
+			if !checkpoint.isZero() {
+				result = append(result, checkpoint)
+			}
+		}
+		return nil
+	})
+	return result, err
+}
+
+func balanceCheckpointKey(address, denom string, height int64) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%020d", address, denom, height))
+}
@@ -0,0 +1,121 @@
+package indexer
+
+import (
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elys-network/elys/indexer/txs/bank"
+	indexerTypes "github.com/elys-network/elys/indexer/types"
+)
+
+func TestBalanceCheckpoints(t *testing.T) {
+	db := newTestDatabase(t)
+	start := time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC)
+	const owner = "elys1owner"
+	eventType := indexerTypes.ElysEventTypes.Bank.BalanceCheckpoint
+
+	checkpoint := func(height int64, balances ...bank.DenomBalance) {
+		blockTime := start.Add(time.Duration(height-100) * time.Hour)
+		id := FormatEventID(height, EventPhaseBlock, "bank", 1)
+		storeEvent(t, db, id, eventType, height, blockTime, bank.BalanceCheckpointEvent{Address: owner, Balances: balances})
+	}
+	checkpoint(100, bank.DenomBalance{Denom: "uelys", Bank: "1000"}, bank.DenomBalance{Denom: "ueden", Committed: "50", Claimed: "5"})
+	// Unchanged balances do not add a checkpoint
+	checkpoint(101, bank.DenomBalance{Denom: "uelys", Bank: "1000"}, bank.DenomBalance{Denom: "ueden", Committed: "50", Claimed: "5"})
+	// uelys vesting, ueden spent entirely
+	checkpoint(102, bank.DenomBalance{Denom: "uelys", Bank: "400", Vesting: "600"})
+	checkpoint(105, bank.DenomBalance{Denom: "uelys", Bank: "400", Vesting: "300"}, bank.DenomBalance{Denom: "ibc/ATOM", Bank: "7"})
+
+	balance, err := db.GetBalanceAt(owner, "uelys", 101)
+	require.NoError(t, err)
+	require.Equal(t, BalanceCheckpoint{
+		Address: owner, Denom: "uelys", Height: 100, Time: start,
+		Bank: "1000", Committed: "0", Claimed: "0", Vesting: "0", Total: "1000",
+	}, balance)
+
+	balance, err = db.GetBalanceAt(owner, "uelys", 104)
+	require.NoError(t, err)
+	require.Equal(t, int64(102), balance.Height)
+	require.Equal(t, "1000", balance.Total)
+	require.Equal(t, "600", balance.Vesting)
+
+	balance, err = db.GetBalanceAt(owner, "uelys", 0)
+	require.NoError(t, err)
+	require.Equal(t, int64(105), balance.Height)
+	require.Equal(t, "700", balance.Total)
+
+	balance, err = db.GetBalanceAt(owner, "ueden", 101)
+	require.NoError(t, err)
+	require.Equal(t, "55", balance.Total)
+	balance, err = db.GetBalanceAt(owner, "ueden", 102)
+	require.NoError(t, err)
+	require.Equal(t, int64(102), balance.Height)
+	require.Equal(t, "0", balance.Total)
+
+	// Before the first checkpoint and for unknown denoms the balance is zero
+	balance, err = db.GetBalanceAt(owner, "uelys", 99)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), balance.Height)
+	require.Equal(t, "0", balance.Total)
+	balance, err = db.GetBalanceAt(owner, "ibc", 200)
+	require.NoError(t, err)
+	require.Equal(t, "0", balance.Total)
+
+	balance, err = db.GetBalanceAtTime(owner, "uelys", start.Add(3*time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, int64(102), balance.Height)
+	balance, err = db.GetBalanceAtTime(owner, "uelys", start.Add(-time.Minute))
+	require.NoError(t, err)
+	require.Equal(t, "0", balance.Total)
+
+	all, err := db.GetBalancesAt(owner, 101, time.Time{})
+	require.NoError(t, err)
+	require.Len(t, all, 2)
+	require.Equal(t, "ueden", all[0].Denom)
+	require.Equal(t, "uelys", all[1].Denom)
+
+	all, err = db.GetBalancesAt(owner, 0, time.Time{})
+	require.NoError(t, err)
+	require.Len(t, all, 2)
+	require.Equal(t, "ibc/ATOM", all[0].Denom)
+
+	require.JSONEq(t, `{"data":{"balanceAt":{"height":102,"total":"1000"}}}`,
+		executeGraphQL(t, db, `{ balanceAt(address: "elys1owner", denom: "uelys", height: 104) { height total } }`, nil))
+}
+
+func TestTouchCoinEvents(t *testing.T) {
+	previous := GetConfig()
+	wasReady := IsReady()
+	t.Cleanup(func() {
+		SetConfig(previous)
+		ready.Store(wasReady)
+	})
+
+	events := []abci.Event{
+		{Type: "coin_spent", Attributes: []abci.EventAttribute{{Key: "spender", Value: "elys1b"}, {Key: "amount", Value: "5uelys"}}},
+		{Type: "coin_received", Attributes: []abci.EventAttribute{{Key: "receiver", Value: "elys1a"}, {Key: "amount", Value: "5uelys"}}},
+		{Type: "transfer", Attributes: []abci.EventAttribute{{Key: "recipient", Value: "elys1c"}}},
+	}
+
+	// Nothing is tracked outside of ledger mode
+	ready.Store(true)
+	TouchCoinEvents(10, events)
+	require.Empty(t, takeTouchedAddresses(10))
+
+	cfg := previous
+	cfg.LedgerMode = true
+	SetConfig(cfg)
+	TouchCoinEvents(10, events)
+	TouchCoinEvents(10, events[:1])
+	require.Equal(t, []string{"elys1a", "elys1b"}, takeTouchedAddresses(10))
+	require.Empty(t, takeTouchedAddresses(10))
+
+	// A new block drops the addresses of the previous one
+	TouchCoinEvents(11, events[:1])
+	TouchCoinEvents(12, events[1:])
+	require.Empty(t, takeTouchedAddresses(11))
+	require.Equal(t, []string{"elys1a"}, takeTouchedAddresses(12))
+}
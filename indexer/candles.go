@@ -0,0 +1,248 @@
+package indexer
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/bmatsuo/lmdb-go/lmdb"
+
+	"github.com/elys-network/elys/indexer/txs/amm"
+	indexerTypes "github.com/elys-network/elys/indexer/types"
+)
+
+// CandleIntervals are the OHLCV resolutions maintained for every pool and pair
+var CandleIntervals = map[string]time.Duration{
+	"1m": time.Minute,
+	"5m": 5 * time.Minute,
+	"1h": time.Hour,
+	"1d": 24 * time.Hour,
+}
+
+// Candle is an OHLCV bucket of the trades of one pool and pair
+type Candle struct {
+	PoolID      uint64    `json:"pool_id"`
+	BaseDenom   string    `json:"base_denom"`
+	QuoteDenom  string    `json:"quote_denom"`
+	Interval    string    `json:"interval"`
+	OpenTime    time.Time `json:"open_time"`
+	Open        string    `json:"open"`
+	High        string    `json:"high"`
+	Low         string    `json:"low"`
+	Close       string    `json:"close"`
+	VolumeBase  string    `json:"volume_base"`
+	VolumeQuote string    `json:"volume_quote"`
+	TradeCount  uint64    `json:"trade_count"`
+}
+
+// candleAggregator maintains the candles and the per-pool trade tape.
+// - candleDB: "<pool>/<base>/<quote>/<interval>/<open unix>" -> Candle
+// - tradeDB: pool id -> trade record indices (DupSort)
+type candleAggregator struct {
+	candleDB lmdb.DBI
+	tradeDB  lmdb.DBI
+}
+
+var candles = &candleAggregator{}
+
+func init() {
+	RegisterAggregator(candles)
+}
+
+func (a *candleAggregator) Name() string {
+	return "candles"
+}
+
+func (a *candleAggregator) Open(txn *lmdb.Txn) (err error) {
+	if a.candleDB, err = txn.OpenDBI("candles", lmdb.Create); err != nil {
+		return err
+	}
+	a.tradeDB, err = txn.OpenDBI("pooltrades", lmdb.Create|lmdb.DupSort)
+	return err
+}
+
+func (a *candleAggregator) Apply(txn *lmdb.Txn, index uint64, record indexerTypes.GenericRecord) error {
+	if !record.IsEvent() || record.Event.BaseEvent.EventType != indexerTypes.ElysEventTypes.Amm.Trade {
+		return nil
+	}
+	var trade amm.Trade
+	if err := decodeData(record.Event.Data, &trade); err != nil {
+		return err
+	}
+
+	indexBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(indexBytes, index)
+	if err := txn.Put(a.tradeDB, poolKey(trade.PoolID), indexBytes, 0); err != nil {
+		return err
+	}
+
+	price, err := math.LegacyNewDecFromStr(trade.Price)
+	if err != nil {
+		return fmt.Errorf("invalid trade price %q: %v", trade.Price, err)
+	}
+	volumeBase, ok := math.NewIntFromString(trade.VolumeBase)
+	if !ok {
+		return fmt.Errorf("invalid trade base volume %q", trade.VolumeBase)
+	}
+	volumeQuote, ok := math.NewIntFromString(trade.VolumeQuote)
+	if !ok {
+		return fmt.Errorf("invalid trade quote volume %q", trade.VolumeQuote)
+	}
+
+	blockTime := record.Event.BaseEvent.BlockTime.UTC()
+	for interval, duration := range CandleIntervals {
+		openTime := blockTime.Truncate(duration)
+		key := candleKey(trade.PoolID, trade.BaseDenom, trade.QuoteDenom, interval, openTime)
+
+		candle := Candle{
+			PoolID:      trade.PoolID,
+			BaseDenom:   trade.BaseDenom,
+			QuoteDenom:  trade.QuoteDenom,
+			Interval:    interval,
+			OpenTime:    openTime,
+			Open:        price.String(),
+			High:        price.String(),
+			Low:         price.String(),
+			VolumeBase:  "0",
+			VolumeQuote: "0",
+		}
+		existing, err := txn.Get(a.candleDB, key)
+		if err == nil {
+			if err := json.Unmarshal(existing, &candle); err != nil {
+				return err
+			}
+		} else if !lmdb.IsNotFound(err) {
+			return err
+		}
+
+		if high := math.LegacyMustNewDecFromStr(candle.High); price.GT(high) {
+			candle.High = price.String()
+		}
+		if low := math.LegacyMustNewDecFromStr(candle.Low); price.LT(low) {
+			candle.Low = price.String()
+		}
+		candle.Close = price.String()
+		candle.VolumeBase = addIntStrings(candle.VolumeBase, volumeBase)
+		candle.VolumeQuote = addIntStrings(candle.VolumeQuote, volumeQuote)
+		candle.TradeCount++
+
+		candleBytes, err := json.Marshal(candle)
+		if err != nil {
+			return err
+		}
+		if err := txn.Put(a.candleDB, key, candleBytes, 0); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetCandles returns the candles of a pool and pair for an interval whose open
+// time lies in [from, to], oldest first. Candles are priced in the quote denom
+// of the trades of the pair, whichever order the pair is given in.
+func (m *LMDBManager) GetCandles(poolID uint64, baseDenom, quoteDenom, interval string, from, to time.Time) ([]Candle, error) {
+	if _, ok := CandleIntervals[interval]; !ok {
+		return nil, fmt.Errorf("unknown candle interval: %s", interval)
+	}
+	// Candles are keyed by non-negative unix time
+	if from.Before(time.Unix(0, 0)) {
+		from = time.Unix(0, 0)
+	}
+
+	var result []Candle
+	err := m.view(func(txn *lmdb.Txn) error {
+		cursor, err := txn.OpenCursor(candles.candleDB)
+		if err != nil {
+			return err
+		}
+		defer cursor.Close()
+
+		// Candles are keyed by the pair oriented like its trades
+		if !hasKeyPrefix(cursor, candlePrefix(poolID, baseDenom, quoteDenom, interval)) {
+			baseDenom, quoteDenom = quoteDenom, baseDenom
+		}
+
+		prefix := candlePrefix(poolID, baseDenom, quoteDenom, interval)
+		endKey := string(candleKey(poolID, baseDenom, quoteDenom, interval, to))
+		key, value, err := cursor.Get(candleKey(poolID, baseDenom, quoteDenom, interval, from), nil, lmdb.SetRange)
+		for ; err == nil; key, value, err = cursor.Get(nil, nil, lmdb.Next) {
+			if len(key) < len(prefix) || string(key[:len(prefix)]) != prefix || string(key) > endKey {
+				return nil
+			}
+			var candle Candle
+			if err := json.Unmarshal(value, &candle); err != nil {
+				return err
+			}
+			result = append(result, candle)
+		}
+		if lmdb.IsNotFound(err) {
+			return nil
+		}
+		return err
+	})
+	return result, err
+}
+
+// GetPoolTrades returns the most recent trades of a pool, newest first
+func (m *LMDBManager) GetPoolTrades(poolID uint64, limit int) ([]indexerTypes.GenericRecord, error) {
+	var records []indexerTypes.GenericRecord
+	err := m.view(func(txn *lmdb.Txn) error {
+		cursor, err := txn.OpenCursor(candles.tradeDB)
+		if err != nil {
+			return err
+		}
+		defer cursor.Close()
+
+		if _, _, err := cursor.Get(poolKey(poolID), nil, lmdb.SetKey); err != nil {
+			if lmdb.IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+		_, value, err := cursor.Get(nil, nil, lmdb.LastDup)
+		for ; err == nil && (limit <= 0 || len(records) < limit); _, value, err = cursor.Get(nil, nil, lmdb.PrevDup) {
+			record, err := m.getRecordInTxn(txn, binary.BigEndian.Uint64(value))
+			if err != nil {
+				return err
+			}
+			records = append(records, record)
+		}
+		if err != nil && !lmdb.IsNotFound(err) {
+			return err
+		}
+		return nil
+	})
+	return records, err
+}
+
+// addIntStrings adds amount to an integer stored as a string, treating an
+// unparsable value as zero
+func addIntStrings(value string, amount math.Int) string {
+	current, ok := math.NewIntFromString(value)
+	if !ok {
+		current = math.ZeroInt()
+	}
+	return current.Add(amount).String()
+}
+
+// hasKeyPrefix reports whether a key of the cursor database starts with prefix
+func hasKeyPrefix(cursor *lmdb.Cursor, prefix string) bool {
+	key, _, err := cursor.Get([]byte(prefix), nil, lmdb.SetRange)
+	return err == nil && len(key) >= len(prefix) && string(key[:len(prefix)]) == prefix
+}
+
+func poolKey(poolID uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, poolID)
+	return key
+}
+
+func candlePrefix(poolID uint64, baseDenom, quoteDenom, interval string) string {
+	return fmt.Sprintf("%020d/%s/%s/%s/", poolID, baseDenom, quoteDenom, interval)
+}
+
+func candleKey(poolID uint64, baseDenom, quoteDenom, interval string, openTime time.Time) []byte {
+	return []byte(fmt.Sprintf("%s%020d", candlePrefix(poolID, baseDenom, quoteDenom, interval), openTime.Unix()))
+}
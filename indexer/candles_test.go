@@ -0,0 +1,92 @@
+package indexer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/elys-network/elys/indexer/txs/amm"
+	indexerTypes "github.com/elys-network/elys/indexer/types"
+)
+
+func newTestDatabase(t *testing.T) *LMDBManager {
+	var length uint64
+	db, err := NewLMDBManager(t.TempDir(), &length)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+	return db
+}
+
+func storeTrade(t *testing.T, db *LMDBManager, id string, blockTime time.Time, trade amm.Trade) {
+	_, err := trade.Process(db, indexerTypes.BaseEvent{
+		EventID:     id,
+		BlockTime:   blockTime,
+		BlockHeight: 1,
+		EventType:   indexerTypes.ElysEventTypes.Amm.Trade,
+	})
+	require.NoError(t, err)
+}
+
+func TestCandleAggregation(t *testing.T) {
+	db := newTestDatabase(t)
+	start := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	prices := []struct {
+		offset time.Duration
+		price  string
+		base   string
+		quote  string
+	}{
+		{0, "2.000000000000000000", "100", "200"},
+		{20 * time.Second, "3.000000000000000000", "10", "30"},
+		{40 * time.Second, "1.500000000000000000", "10", "15"},
+		{90 * time.Second, "2.500000000000000000", "4", "10"},
+	}
+	for i, p := range prices {
+		storeTrade(t, db, string(rune('a'+i)), start.Add(p.offset), amm.Trade{
+			PoolID:      1,
+			BaseDenom:   "uatom",
+			QuoteDenom:  "uusdc",
+			Price:       p.price,
+			VolumeBase:  p.base,
+			VolumeQuote: p.quote,
+		})
+	}
+
+	minute, err := db.GetCandles(1, "uatom", "uusdc", "1m", start, start.Add(time.Hour))
+	require.NoError(t, err)
+	require.Len(t, minute, 2)
+	require.Equal(t, "2.000000000000000000", minute[0].Open)
+	require.Equal(t, "3.000000000000000000", minute[0].High)
+	require.Equal(t, "1.500000000000000000", minute[0].Low)
+	require.Equal(t, "1.500000000000000000", minute[0].Close)
+	require.Equal(t, "120", minute[0].VolumeBase)
+	require.Equal(t, "245", minute[0].VolumeQuote)
+	require.Equal(t, uint64(3), minute[0].TradeCount)
+	require.Equal(t, start.Add(time.Minute), minute[1].OpenTime.UTC())
+
+	// The pair order of the query does not matter
+	hourly, err := db.GetCandles(1, "uusdc", "uatom", "1h", start, start.Add(time.Hour))
+	require.NoError(t, err)
+	require.Len(t, hourly, 1)
+	require.Equal(t, "2.500000000000000000", hourly[0].Close)
+	require.Equal(t, uint64(4), hourly[0].TradeCount)
+
+	// Out of range and other pools return nothing
+	none, err := db.GetCandles(1, "uatom", "uusdc", "1m", start.Add(2*time.Hour), start.Add(3*time.Hour))
+	require.NoError(t, err)
+	require.Empty(t, none)
+	none, err = db.GetCandles(2, "uatom", "uusdc", "1m", start, start.Add(time.Hour))
+	require.NoError(t, err)
+	require.Empty(t, none)
+
+	_, err = db.GetCandles(1, "uatom", "uusdc", "2m", start, start.Add(time.Hour))
+	require.Error(t, err)
+
+	trades, err := db.GetPoolTrades(1, 2)
+	require.NoError(t, err)
+	require.Len(t, trades, 2)
+	require.Equal(t, "d", trades[0].Event.BaseEvent.EventID)
+	require.Equal(t, "c", trades[1].Event.BaseEvent.EventID)
+}
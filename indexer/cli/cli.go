@@ -0,0 +1,171 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/elys-network/elys/indexer"
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagAddress = "address"
+	flagFrom    = "from"
+	flagTo      = "to"
+	flagFormat  = "format"
+	flagOutput  = "output"
+	flagDataDir = "data-dir"
+	flagLedger  = "ledger"
+
+	flagQueryAddress   = "query-address"
+	flagGraphQLAddress = "graphql-address"
+)
+
+// GetCommands returns the indexer command and its subcommands
+func GetCommands() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "indexer",
+		Short:                      "Commands of the kwak indexer",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       func(cmd *cobra.Command, _ []string) error { return cmd.Help() },
+	}
+
+	cmd.AddCommand(
+		CmdExport(),
+		CmdServe(),
+	)
+
+	return cmd
+}
+
+func CmdExport() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export the indexed history of an address for tax and accounting",
+		Long: `Export the indexed history of an address as csv, parquet or jsonl. Each row
+holds the time, height, hash, type, tokens in and out, fees and realized PnL of
+a record. With --ledger every debit or credit line becomes a row instead.`,
+		Example: `elysd indexer export --address elys1... --from 2024-01-01T00:00:00Z --to 2025-01-01T00:00:00Z --format csv`,
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) (err error) {
+			address, _ := cmd.Flags().GetString(flagAddress)
+			if address == "" {
+				return fmt.Errorf("--%s is required", flagAddress)
+			}
+			format, _ := cmd.Flags().GetString(flagFormat)
+			if format != indexer.ExportCSV && format != indexer.ExportJSONL && format != indexer.ExportParquet {
+				return fmt.Errorf("invalid format %q, expected csv, parquet or jsonl", format)
+			}
+			from, err := parseTime(cmd, flagFrom)
+			if err != nil {
+				return err
+			}
+			to, err := parseTime(cmd, flagTo)
+			if err != nil {
+				return err
+			}
+			ledger, _ := cmd.Flags().GetBool(flagLedger)
+
+			dataDir, _ := cmd.Flags().GetString(flagDataDir)
+			if dataDir == "" {
+				dataDir = indexer.ReadConfig(server.GetServerContextFromCmd(cmd).Viper).DataDir
+			}
+			if _, err := os.Stat(dataDir); err != nil {
+				return fmt.Errorf("indexer data directory %s: %w", dataDir, err)
+			}
+
+			database, err := indexer.OpenLMDBReadOnly(dataDir)
+			if err != nil {
+				return err
+			}
+			defer database.Close()
+
+			rows, err := database.ExportRecords(address, from, to, ledger)
+			if err != nil {
+				return err
+			}
+
+			out := cmd.OutOrStdout()
+			if output, _ := cmd.Flags().GetString(flagOutput); output != "" {
+				file, err := os.Create(output)
+				if err != nil {
+					return err
+				}
+				defer func() {
+					if closeErr := file.Close(); err == nil {
+						err = closeErr
+					}
+				}()
+				out = file
+			}
+			return indexer.WriteExport(out, format, rows)
+		},
+	}
+
+	cmd.Flags().String(flagAddress, "", "Address to export the history of")
+	cmd.Flags().String(flagFrom, "", "Start of the time range, unix seconds or RFC3339 (inclusive)")
+	cmd.Flags().String(flagTo, "", "End of the time range, unix seconds or RFC3339 (exclusive)")
+	cmd.Flags().String(flagFormat, indexer.ExportCSV, "Output format: csv, parquet or jsonl")
+	cmd.Flags().StringP(flagOutput, "o", "", "Output file, defaults to stdout")
+	cmd.Flags().String(flagDataDir, "", "Indexer data directory, defaults to indexer.data-dir of app.toml")
+	cmd.Flags().Bool(flagLedger, false, "Write one row per debit or credit line")
+
+	return cmd
+}
+
+func CmdServe() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "serve",
+		Short: "Serve the indexer query APIs from the data directory of a running node",
+		Long: `Serve the HTTP query and GraphQL APIs from a separate process, opening the
+indexer data directory of a node read-only. The node keeps indexing while any
+number of replicas serve queries. The addresses default to the [indexer]
+section of app.toml, the APIs left without an address are not served.`,
+		Example: `elysd indexer serve --home ~/.elys --query-address localhost:8585 --graphql-address localhost:8586`,
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cfg := indexer.ReadConfig(server.GetServerContextFromCmd(cmd).Viper)
+			if dataDir, _ := cmd.Flags().GetString(flagDataDir); dataDir != "" {
+				cfg.DataDir = dataDir
+			}
+			if cmd.Flags().Changed(flagQueryAddress) {
+				cfg.QueryAddress, _ = cmd.Flags().GetString(flagQueryAddress)
+			}
+			if cmd.Flags().Changed(flagGraphQLAddress) {
+				cfg.GraphQLAddress, _ = cmd.Flags().GetString(flagGraphQLAddress)
+			}
+
+			ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+			return indexer.ServeReadOnly(ctx, cfg)
+		},
+	}
+
+	cmd.Flags().String(flagDataDir, "", "Indexer data directory, defaults to indexer.data-dir of app.toml")
+	cmd.Flags().String(flagQueryAddress, "", "Listen address of the query API, defaults to indexer.query-address of app.toml")
+	cmd.Flags().String(flagGraphQLAddress, "", "Listen address of the GraphQL API, defaults to indexer.graphql-address of app.toml")
+
+	return cmd
+}
+
+// parseTime reads a time flag given as unix seconds or RFC3339, empty is the zero time
+func parseTime(cmd *cobra.Command, flag string) (time.Time, error) {
+	value, _ := cmd.Flags().GetString(flag)
+	if value == "" {
+		return time.Time{}, nil
+	}
+	if seconds, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return time.Unix(seconds, 0).UTC(), nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid --%s %q, expected unix seconds or RFC3339", flag, value)
+	}
+	return t, nil
+}
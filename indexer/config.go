@@ -0,0 +1,206 @@
+package indexer
+
+import (
+	"sync/atomic"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/cast"
+)
+
+// Config holds the node operator settings for the indexer. It is read from the
+// [indexer] section of app.toml.
+type Config struct {
+	// DataDir is the directory holding the LMDB data files
+	DataDir string `mapstructure:"data-dir"`
+	// SnapshotInterval is the number of blocks between protocol snapshots, 0 disables them
+	SnapshotInterval int64 `mapstructure:"snapshot-interval"`
+	// QueryAddress is the listen address of the HTTP query API, empty disables it
+	QueryAddress string `mapstructure:"query-address"`
+	// GraphQLAddress is the listen address of the GraphQL API, empty disables it
+	GraphQLAddress string `mapstructure:"graphql-address"`
+	// WebhookURL receives the indexed records of every block, empty disables the webhook sink
+	WebhookURL string `mapstructure:"webhook-url"`
+	// WebhookSecret signs webhook payloads with HMAC-SHA256, empty sends them unsigned
+	WebhookSecret string `mapstructure:"webhook-secret"`
+	// NATSURL is the server the indexed records are published to on JetStream, empty disables the NATS sink
+	NATSURL string `mapstructure:"nats-url"`
+	// NATSSubjectPrefix prefixes the per record type subjects
+	NATSSubjectPrefix string `mapstructure:"nats-subject-prefix"`
+	// KafkaBrokers is the comma separated list of seed brokers the indexed records are produced to, empty disables the Kafka sink
+	KafkaBrokers string `mapstructure:"kafka-brokers"`
+	// KafkaTopicPrefix prefixes the per record type topics
+	KafkaTopicPrefix string `mapstructure:"kafka-topic-prefix"`
+	// PublisherTLS enables TLS on the NATS and Kafka connections
+	PublisherTLS bool `mapstructure:"publisher-tls"`
+	// PublisherTLSCAFile holds the PEM certificates verifying the brokers, empty uses the system roots
+	PublisherTLSCAFile string `mapstructure:"publisher-tls-ca-file"`
+	// PublisherTLSCertFile and PublisherTLSKeyFile hold the PEM client certificate and key, empty disables client authentication
+	PublisherTLSCertFile string `mapstructure:"publisher-tls-cert-file"`
+	PublisherTLSKeyFile  string `mapstructure:"publisher-tls-key-file"`
+	// LedgerMode records balance checkpoints of the addresses whose balances change in every block
+	LedgerMode bool `mapstructure:"ledger-mode"`
+}
+
+// DefaultConfig returns the indexer configuration used when app.toml does not override it
+func DefaultConfig() Config {
+	return Config{
+		DataDir:           "./lmdb-data",
+		SnapshotInterval:  100,
+		NATSSubjectPrefix: "elys.indexer",
+		KafkaTopicPrefix:  "elys.indexer",
+	}
+}
+
+// ConfigTemplate is appended to the app.toml template
+const ConfigTemplate = `
+###############################################################################
+###                           Indexer Configuration                         ###
+###############################################################################
+
+[indexer]
+
+# Directory holding the indexer LMDB data files.
+data-dir = "{{ .Indexer.DataDir }}"
+
+# Number of blocks between protocol snapshots (pool TVL, open interest, rates).
+# Set to 0 to disable snapshots.
+snapshot-interval = {{ .Indexer.SnapshotInterval }}
+
+# Listen address of the indexer HTTP query API, e.g. "localhost:8585".
+# Leave empty to disable the query API.
+query-address = "{{ .Indexer.QueryAddress }}"
+
+# Listen address of the indexer GraphQL API, e.g. "localhost:8586". The endpoint
+# is served on /graphql and its schema on /graphql/schema.
+# Leave empty to disable the GraphQL API.
+graphql-address = "{{ .Indexer.GraphQLAddress }}"
+
+# URL the indexed records of every block are posted to, e.g. "https://bot.example.com/hook".
+# Leave empty to disable the webhook sink.
+webhook-url = "{{ .Indexer.WebhookURL }}"
+
+# Secret signing webhook payloads with HMAC-SHA256 in the X-Elys-Signature header.
+webhook-secret = "{{ .Indexer.WebhookSecret }}"
+
+# NATS server the indexed records are published to, e.g. "nats://localhost:4222".
+# Records are published to JetStream with the record index as Nats-Msg-Id, a
+# stream must capture the subjects below. Leave empty to disable the NATS sink.
+nats-url = "{{ .Indexer.NATSURL }}"
+
+# Prefix of the subjects records are published on, one subject per record type.
+nats-subject-prefix = "{{ .Indexer.NATSSubjectPrefix }}"
+
+# Comma separated Kafka seed brokers the indexed records are produced to, e.g.
+# "localhost:9092". Records are keyed on the record index.
+# Leave empty to disable the Kafka sink.
+kafka-brokers = "{{ .Indexer.KafkaBrokers }}"
+
+# Prefix of the topics records are produced to, one topic per record type.
+kafka-topic-prefix = "{{ .Indexer.KafkaTopicPrefix }}"
+
+# Connect to the NATS server and the Kafka brokers over TLS.
+publisher-tls = {{ .Indexer.PublisherTLS }}
+
+# PEM certificates verifying the servers. Leave empty to use the system roots.
+publisher-tls-ca-file = "{{ .Indexer.PublisherTLSCAFile }}"
+
+# PEM client certificate and key. Leave empty to disable client authentication.
+publisher-tls-cert-file = "{{ .Indexer.PublisherTLSCertFile }}"
+publisher-tls-key-file = "{{ .Indexer.PublisherTLSKeyFile }}"
+
+# Record the bank and commitment balances of every address whose balances change
+# in a block, to answer historical balance queries. Grows the store with every
+# transfer.
+ledger-mode = {{ .Indexer.LedgerMode }}
+`
+
+var (
+	config = DefaultConfig()
+	ready  atomic.Bool // Set once the database and workers are running
+)
+
+// ReadConfig reads the indexer configuration from the app options, falling back
+// to DefaultConfig for missing keys
+func ReadConfig(appOpts servertypes.AppOptions) Config {
+	cfg := DefaultConfig()
+	if v := appOpts.Get("indexer.data-dir"); v != nil {
+		if dir := cast.ToString(v); dir != "" {
+			cfg.DataDir = dir
+		}
+	}
+	if v := appOpts.Get("indexer.snapshot-interval"); v != nil {
+		cfg.SnapshotInterval = cast.ToInt64(v)
+	}
+	if v := appOpts.Get("indexer.query-address"); v != nil {
+		cfg.QueryAddress = cast.ToString(v)
+	}
+	if v := appOpts.Get("indexer.graphql-address"); v != nil {
+		cfg.GraphQLAddress = cast.ToString(v)
+	}
+	if v := appOpts.Get("indexer.webhook-url"); v != nil {
+		cfg.WebhookURL = cast.ToString(v)
+	}
+	if v := appOpts.Get("indexer.webhook-secret"); v != nil {
+		cfg.WebhookSecret = cast.ToString(v)
+	}
+	if v := appOpts.Get("indexer.nats-url"); v != nil {
+		cfg.NATSURL = cast.ToString(v)
+	}
+	if v := appOpts.Get("indexer.nats-subject-prefix"); v != nil {
+		if prefix := cast.ToString(v); prefix != "" {
+			cfg.NATSSubjectPrefix = prefix
+		}
+	}
+	if v := appOpts.Get("indexer.kafka-brokers"); v != nil {
+		cfg.KafkaBrokers = cast.ToString(v)
+	}
+	if v := appOpts.Get("indexer.kafka-topic-prefix"); v != nil {
+		if prefix := cast.ToString(v); prefix != "" {
+			cfg.KafkaTopicPrefix = prefix
+		}
+	}
+	if v := appOpts.Get("indexer.publisher-tls"); v != nil {
+		cfg.PublisherTLS = cast.ToBool(v)
+	}
+	if v := appOpts.Get("indexer.publisher-tls-ca-file"); v != nil {
+		cfg.PublisherTLSCAFile = cast.ToString(v)
+	}
+	if v := appOpts.Get("indexer.publisher-tls-cert-file"); v != nil {
+		cfg.PublisherTLSCertFile = cast.ToString(v)
+	}
+	if v := appOpts.Get("indexer.publisher-tls-key-file"); v != nil {
+		cfg.PublisherTLSKeyFile = cast.ToString(v)
+	}
+	if v := appOpts.Get("indexer.ledger-mode"); v != nil {
+		cfg.LedgerMode = cast.ToBool(v)
+	}
+	return cfg
+}
+
+// SetConfig replaces the indexer configuration. It must be called before Init.
+func SetConfig(cfg Config) {
+	config = cfg
+}
+
+// GetConfig returns the active indexer configuration
+func GetConfig() Config {
+	return config
+}
+
+// IsReady reports whether the indexer has been initialized and accepts records
+func IsReady() bool {
+	return ready.Load()
+}
+
+// ShouldSnapshot reports whether protocol snapshots must be taken at the given height
+func ShouldSnapshot(height int64) bool {
+	if !IsReady() || config.SnapshotInterval <= 0 {
+		return false
+	}
+	return height%config.SnapshotInterval == 0
+}
+
+// LedgerModeEnabled reports whether balance checkpoints are recorded
+func LedgerModeEnabled() bool {
+	return IsReady() && config.LedgerMode
+}
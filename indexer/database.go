@@ -4,34 +4,49 @@ package indexer
 import (
 	"encoding/binary"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"sync"
+	"syscall"
 
 	"github.com/bmatsuo/lmdb-go/lmdb"
 
 	indexerTypes "github.com/elys-network/elys/indexer/types"
 )
 
+// maxDBs is the maximum number of named databases opened in the LMDB
+// environment, including the ones owned by aggregators
+const maxDBs = 32
+
 // LMDBManager handles LMDB operations for storing and retrieving records.
-// It maintains five databases:
+// It owns the record databases below, the aggregators open their own
+// databases (candles, positions, pnl, balances, feeders, revenue, params and
+// the sink outbox) in the same environment, up to maxDBs in total:
 // - recordDB: Stores the actual transaction and event records
 // - addressDB: Maps addresses to record indices for efficient lookups
 // - recordCountDB: Tracks the total number of records in the system
 // - txHashDB: Maps transaction hashes to record indices to prevent duplicates
 // - eventIDDB: Maps event IDs to record indices to prevent duplicate events
+// - typeDB: Maps transaction and event types to record indices
 type LMDBManager struct {
-	env              *lmdb.Env  // LMDB environment handle
-	recordDB         lmdb.DBI   // Database for storing transaction/event records
-	addressDB        lmdb.DBI   // Database mapping addresses to record indices
-	recordCountDB    lmdb.DBI   // Database tracking total record count
-	txHashDB         lmdb.DBI   // Database mapping tx hashes to record indices
-	eventIDDB        lmdb.DBI   // Database mapping event IDs to record indices
-	path             string     // File system path to the LMDB data files
-	totalIndexLength *uint64    // Pointer to the current total number of records
-	indexMutex       sync.Mutex // Mutex to protect index operations
+	env              *lmdb.Env    // LMDB environment handle
+	recordDB         lmdb.DBI     // Database for storing transaction/event records
+	addressDB        lmdb.DBI     // Database mapping addresses to record indices
+	recordCountDB    lmdb.DBI     // Database tracking total record count
+	txHashDB         lmdb.DBI     // Database mapping tx hashes to record indices
+	eventIDDB        lmdb.DBI     // Database mapping event IDs to record indices
+	typeDB           lmdb.DBI     // Database mapping record types to record indices
+	path             string       // File system path to the LMDB data files
+	totalIndexLength *uint64      // Pointer to the current total number of records
+	indexMutex       sync.Mutex   // Mutex to protect index operations
+	readOnly         bool         // Set when the data files are owned by another process
+	mapMutex         sync.RWMutex // Held exclusively while adopting the map size of the writer
 }
 
+// ErrReadOnly is returned when writing through a read-only LMDB manager
+var ErrReadOnly = errors.New("indexer database is opened read-only")
+
 // NewLMDBManager creates and initializes a new LMDB manager instance.
 // It sets up the database environment, creates necessary subdatabases,
 // and loads or initializes the record count.
@@ -47,8 +62,8 @@ func NewLMDBManager(path string, totalIndexLength *uint64) (*LMDBManager, error)
 		return nil, err
 	}
 
-	// Configure environment to support 5 named databases
-	if err := env.SetMaxDBs(5); err != nil {
+	// Configure environment to support all named databases
+	if err := env.SetMaxDBs(maxDBs); err != nil {
 		return nil, err
 	}
 
@@ -70,25 +85,7 @@ func NewLMDBManager(path string, totalIndexLength *uint64) (*LMDBManager, error)
 
 	// Initialize the databases within a transaction
 	err = env.Update(func(txn *lmdb.Txn) error {
-		var err error
-		// Create main record storage database
-		if manager.recordDB, err = txn.OpenDBI("records", lmdb.Create); err != nil {
-			return err
-		}
-		// Create address index database with duplicate key support
-		if manager.addressDB, err = txn.OpenDBI("addresses", lmdb.Create|lmdb.DupSort); err != nil {
-			return err
-		}
-		// Create record count tracking database
-		if manager.recordCountDB, err = txn.OpenDBI("recordcount", lmdb.Create); err != nil {
-			return err
-		}
-		// Create tx hash tracking database
-		if manager.txHashDB, err = txn.OpenDBI("txhashes", lmdb.Create); err != nil {
-			return err
-		}
-		// Create event ID tracking database
-		if manager.eventIDDB, err = txn.OpenDBI("eventids", lmdb.Create); err != nil {
+		if err := manager.openDatabases(txn); err != nil {
 			return err
 		}
 
@@ -117,6 +114,110 @@ func NewLMDBManager(path string, totalIndexLength *uint64) (*LMDBManager, error)
 	return manager, nil
 }
 
+// OpenLMDBReadOnly opens the data files of an indexer running in another
+// process, e.g. to serve the query API from a read replica. Its databases must
+// have been created by the writer. Writes fail with ErrReadOnly, and the map
+// size grown by the writer is adopted on the next read.
+func OpenLMDBReadOnly(path string) (*LMDBManager, error) {
+	if _, err := os.Stat(path); err != nil {
+		return nil, err
+	}
+
+	env, err := lmdb.NewEnv()
+	if err != nil {
+		return nil, err
+	}
+	if err := env.SetMaxDBs(maxDBs); err != nil {
+		env.Close()
+		return nil, err
+	}
+	// The map size is read from the data files written by the writer
+	if err := env.Open(path, lmdb.Readonly, 0644); err != nil {
+		env.Close()
+		return nil, err
+	}
+
+	var totalIndexLength uint64
+	manager := &LMDBManager{
+		env:              env,
+		path:             path,
+		totalIndexLength: &totalIndexLength,
+		readOnly:         true,
+	}
+
+	// The handles opened in a read transaction are kept once it commits
+	err = manager.view(manager.openDatabases)
+	if err != nil {
+		env.Close()
+		var opErr *lmdb.OpError
+		if errors.As(err, &opErr) && opErr.Errno == syscall.EACCES {
+			return nil, fmt.Errorf("indexer databases missing in %s, start the node with this version first: %w", path, err)
+		}
+		return nil, err
+	}
+
+	return manager, nil
+}
+
+// openDatabases opens the record and index databases and the ones of every
+// registered aggregator, creating them in a write transaction
+func (m *LMDBManager) openDatabases(txn *lmdb.Txn) error {
+	var err error
+	// Create main record storage database
+	if m.recordDB, err = txn.OpenDBI("records", lmdb.Create); err != nil {
+		return err
+	}
+	// Create address index database with duplicate key support
+	if m.addressDB, err = txn.OpenDBI("addresses", lmdb.Create|lmdb.DupSort); err != nil {
+		return err
+	}
+	// Create record count tracking database
+	if m.recordCountDB, err = txn.OpenDBI("recordcount", lmdb.Create); err != nil {
+		return err
+	}
+	// Create tx hash tracking database
+	if m.txHashDB, err = txn.OpenDBI("txhashes", lmdb.Create); err != nil {
+		return err
+	}
+	// Create event ID tracking database
+	if m.eventIDDB, err = txn.OpenDBI("eventids", lmdb.Create); err != nil {
+		return err
+	}
+	// Create record type index database with duplicate key support
+	if m.typeDB, err = txn.OpenDBI("types", lmdb.Create|lmdb.DupSort); err != nil {
+		return err
+	}
+	// Open the databases of every registered aggregator
+	for _, aggregator := range aggregators {
+		if err := aggregator.Open(txn); err != nil {
+			return fmt.Errorf("failed to open %s aggregator: %w", aggregator.Name(), err)
+		}
+	}
+	return nil
+}
+
+// view runs fn in a read transaction. A read-only manager whose map is
+// smaller than the data written since by the writer adopts the new map size
+// and retries.
+func (m *LMDBManager) view(fn lmdb.TxnOp) error {
+	for {
+		m.mapMutex.RLock()
+		err := m.env.View(fn)
+		m.mapMutex.RUnlock()
+		if !m.readOnly || !lmdb.IsMapResized(err) {
+			return err
+		}
+
+		// The map size can only change while no transaction is open
+		m.mapMutex.Lock()
+		err = m.env.SetMapSize(0)
+		m.mapMutex.Unlock()
+		if err != nil {
+			return fmt.Errorf("failed to adopt the indexer map size: %w", err)
+		}
+	}
+}
+
 // CheckAndResizeIfNeeded monitors database usage and automatically increases
 // the size when available space drops below 20%. It doubles the current size
 // when more space is needed and handles the resize operation gracefully.
@@ -137,7 +238,7 @@ func (m *LMDBManager) CheckAndResizeIfNeeded() error {
 			// If direct resize fails, attempt recovery by recreating environment
 			m.env.Close()
 			if env, err := lmdb.NewEnv(); err == nil {
-				if err := env.SetMaxDBs(4); err == nil {
+				if err := env.SetMaxDBs(maxDBs); err == nil {
 					if err := env.SetMapSize(newSize); err == nil {
 						if err := env.Open(m.path, 0, 0644); err == nil {
 							m.env = env
@@ -177,11 +278,15 @@ func (m *LMDBManager) ProcessNewEvent(event indexerTypes.GenericEvent, address s
 // for both the main address and any included addresses.
 // Included addresses are like recievers, so if someone recieved 100 tokens they would be Included.
 func (m *LMDBManager) ProcessRecord(record indexerTypes.GenericRecord, address string) error {
+	if m.readOnly {
+		return ErrReadOnly
+	}
+
 	// Check for duplicate transaction if this is a transaction record
 	if record.IsTransaction() {
 		txHash := record.Transaction.BaseTransaction.TxHash
 		exists := false
-		err := m.env.View(func(txn *lmdb.Txn) error {
+		err := m.view(func(txn *lmdb.Txn) error {
 			_, err := txn.Get(m.txHashDB, []byte(txHash))
 			if err == nil {
 				exists = true
@@ -202,7 +307,7 @@ func (m *LMDBManager) ProcessRecord(record indexerTypes.GenericRecord, address s
 	if record.IsEvent() {
 		eventID := record.Event.BaseEvent.EventID
 		exists := false
-		err := m.env.View(func(txn *lmdb.Txn) error {
+		err := m.view(func(txn *lmdb.Txn) error {
 			_, err := txn.Get(m.eventIDDB, []byte(eventID))
 			if err == nil {
 				exists = true
@@ -279,6 +384,13 @@ func (m *LMDBManager) ProcessRecord(record indexerTypes.GenericRecord, address s
 			}
 		}
 
+		// Index the record under its transaction or event type
+		if recordType := record.Type(); recordType != "" {
+			if err := txn.Put(m.typeDB, []byte(recordType), indexBytes, 0); err != nil {
+				return fmt.Errorf("error storing record type mapping: %v", err)
+			}
+		}
+
 		// Get included addresses based on record type
 		var includedAddresses []string
 		if record.IsTransaction() {
@@ -309,61 +421,103 @@ func (m *LMDBManager) ProcessRecord(record indexerTypes.GenericRecord, address s
 			}
 		}
 
+		// Update the derived views
+		for _, aggregator := range aggregators {
+			if err := aggregator.Apply(txn, count, record); err != nil {
+				return fmt.Errorf("error applying %s aggregator: %v", aggregator.Name(), err)
+			}
+		}
+
 		return nil
 	})
 }
 
 // GetRecordCount returns the current total number of records in the database
 func (m *LMDBManager) GetRecordCount() uint64 {
-	return *m.totalIndexLength
+	if !m.readOnly {
+		return *m.totalIndexLength
+	}
+
+	// The writer counts the records of a read-only manager
+	var count uint64
+	_ = m.view(func(txn *lmdb.Txn) error {
+		countBytes, err := txn.Get(m.recordCountDB, []byte("count"))
+		if err == nil {
+			count = binary.LittleEndian.Uint64(countBytes)
+		}
+		return nil
+	})
+	return count
 }
 
 // GetRecordByIndex retrieves a specific record by its index number.
 // Returns the record and any error encountered during retrieval.
 func (m *LMDBManager) GetRecordByIndex(index uint64) (indexerTypes.GenericRecord, error) {
 	var record indexerTypes.GenericRecord
-	err := m.env.View(func(txn *lmdb.Txn) error {
-		indexBytes := make([]byte, 8)
-		binary.BigEndian.PutUint64(indexBytes, index)
-		recordBytes, err := txn.Get(m.recordDB, indexBytes)
-		if err != nil {
-			return err
-		}
-		return json.Unmarshal(recordBytes, &record)
+	err := m.view(func(txn *lmdb.Txn) error {
+		var err error
+		record, err = m.getRecordInTxn(txn, index)
+		return err
 	})
 	return record, err
 }
 
+// getRecordInTxn reads a record by index inside an open transaction
+func (m *LMDBManager) getRecordInTxn(txn *lmdb.Txn, index uint64) (indexerTypes.GenericRecord, error) {
+	var record indexerTypes.GenericRecord
+	indexBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(indexBytes, index)
+	recordBytes, err := txn.Get(m.recordDB, indexBytes)
+	if err != nil {
+		return record, err
+	}
+	err = json.Unmarshal(recordBytes, &record)
+	return record, err
+}
+
 // GetRecordsByAddress retrieves all records associated with a given address.
 // This includes both records where the address is the main address and where
 // it appears in the included addresses list.
 func (m *LMDBManager) GetRecordsByAddress(address string) ([]indexerTypes.GenericRecord, error) {
+	return m.getRecordsByDupKey(m.addressDB, address)
+}
+
+// GetRecordsByType retrieves all records of a given transaction or event type,
+// e.g. "/elys.amm.MsgSwapByDenom" or "/elys-event/perpetual/pool-snapshot",
+// ordered by record index.
+func (m *LMDBManager) GetRecordsByType(recordType string) ([]indexerTypes.GenericRecord, error) {
+	return m.getRecordsByDupKey(m.typeDB, recordType)
+}
+
+// getRecordsByDupKey loads every record whose index is stored under key in a
+// DupSort index database.
+func (m *LMDBManager) getRecordsByDupKey(dbi lmdb.DBI, key string) ([]indexerTypes.GenericRecord, error) {
 	var records []indexerTypes.GenericRecord
-	err := m.env.View(func(txn *lmdb.Txn) error {
-		cursor, err := txn.OpenCursor(m.addressDB)
+	err := m.view(func(txn *lmdb.Txn) error {
+		cursor, err := txn.OpenCursor(dbi)
 		if err != nil {
 			return fmt.Errorf("error opening cursor: %v", err)
 		}
 		defer cursor.Close()
 
-		// Position cursor at first record for this address
-		_, value, err := cursor.Get([]byte(address), nil, lmdb.SetKey)
+		// Position cursor at first record for this key
+		_, value, err := cursor.Get([]byte(key), nil, lmdb.SetKey)
 		if lmdb.IsNotFound(err) {
-			return nil // No records found for this address
+			return nil // No records found for this key
 		} else if err != nil {
 			return fmt.Errorf("error in initial cursor.Get: %v", err)
 		}
 
-		// Iterate through all records for this address
+		// Iterate through all records for this key
 		for {
 			index := binary.BigEndian.Uint64(value)
-			record, err := m.GetRecordByIndex(index)
+			record, err := m.getRecordInTxn(txn, index)
 			if err != nil {
 				return fmt.Errorf("error getting record by index %d: %v", index, err)
 			}
 			records = append(records, record)
 
-			// Move to next record with same address
+			// Move to next record with same key
 			_, value, err = cursor.Get(nil, nil, lmdb.NextDup)
 			if lmdb.IsNotFound(err) {
 				// Reached the end of the records
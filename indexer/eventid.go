@@ -0,0 +1,93 @@
+package indexer
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Block phases an event can be emitted in
+const (
+	EventPhaseBeginBlock = "begin"
+	EventPhaseTx         = "tx"
+	EventPhaseEndBlock   = "end"
+	EventPhaseBlock      = "block" // Outside of a transaction in an unmarked phase
+)
+
+type eventPhaseKey struct{}
+
+// WithEventPhase marks the events emitted under ctx with a block phase. The
+// app sets it for the begin and end blockers, transactions are recognized
+// from the context on their own.
+func WithEventPhase(ctx sdk.Context, phase string) sdk.Context {
+	return ctx.WithValue(eventPhaseKey{}, phase)
+}
+
+// eventPhase returns the block phase ctx is executing in
+func eventPhase(ctx sdk.Context) string {
+	if phase, ok := ctx.Value(eventPhaseKey{}).(string); ok {
+		return phase
+	}
+	if len(ctx.TxBytes()) > 0 {
+		return EventPhaseTx
+	}
+	return EventPhaseBlock
+}
+
+// NewEventID returns the ID of the next event of eventType emitted under ctx,
+// see FormatEventID. It must only be called for events that are indexed, so
+// that the block sequence only counts committed executions.
+func NewEventID(ctx sdk.Context, eventType string) string {
+	height := ctx.BlockHeight()
+	return FormatEventID(height, eventPhase(ctx), eventModule(eventType), nextEventSequence(height))
+}
+
+// FormatEventID builds an event ID "<height>-<phase>-<module>-<sequence>", e.g.
+// "1200-begin-leveragelp-3". The sequence counts every event of the block in
+// emission order, whatever its module, so IDs are unique within a block and,
+// as the chain executes blocks deterministically, stable when a block is
+// executed again. Phase and module only make the ID readable.
+func FormatEventID(height int64, phase, module string, sequence uint64) string {
+	return fmt.Sprintf("%d-%s-%s-%d", height, phase, module, sequence)
+}
+
+// ParseEventID splits an event ID built by FormatEventID into its parts
+func ParseEventID(id string) (height int64, phase, module string, sequence uint64, err error) {
+	parts := strings.Split(id, "-")
+	if len(parts) != 4 {
+		return 0, "", "", 0, fmt.Errorf("invalid event ID: %s", id)
+	}
+	if height, err = strconv.ParseInt(parts[0], 10, 64); err != nil {
+		return 0, "", "", 0, fmt.Errorf("invalid event ID height: %s", id)
+	}
+	if sequence, err = strconv.ParseUint(parts[3], 10, 64); err != nil {
+		return 0, "", "", 0, fmt.Errorf("invalid event ID sequence: %s", id)
+	}
+	return height, parts[1], parts[2], sequence, nil
+}
+
+// nextEventSequence returns a counter that increases for every event queued at
+// the same block height and restarts on a new height
+func nextEventSequence(height int64) uint64 {
+	sequenceMutex.Lock()
+	defer sequenceMutex.Unlock()
+
+	if height != sequenceHeight {
+		sequenceHeight = height
+		sequence = 0
+	}
+	sequence++
+	return sequence
+}
+
+// eventModule returns the module of an event type, e.g. "amm" for
+// "/elys-event/amm/trade"
+func eventModule(eventType string) string {
+	parts := strings.Split(strings.TrimPrefix(eventType, "/"), "/")
+	if len(parts) < 3 {
+		return "unknown"
+	}
+	return parts[1]
+}
@@ -0,0 +1,114 @@
+package indexer
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elys-network/elys/indexer/txs/leveragelp"
+	"github.com/elys-network/elys/indexer/txs/perpetual"
+	indexerTypes "github.com/elys-network/elys/indexer/types"
+)
+
+// allEventTypes lists every event type of ElysEventTypes
+func allEventTypes() []string {
+	var eventTypes []string
+	modules := reflect.ValueOf(indexerTypes.ElysEventTypes)
+	for i := 0; i < modules.NumField(); i++ {
+		module := modules.Field(i)
+		for j := 0; j < module.NumField(); j++ {
+			eventTypes = append(eventTypes, module.Field(j).String())
+		}
+	}
+	return eventTypes
+}
+
+func TestEventIDUniqueness(t *testing.T) {
+	base := sdk.NewContext(nil, cmtproto.Header{Height: 1200}, false, log.NewNopLogger())
+	phases := []sdk.Context{
+		WithEventPhase(base, EventPhaseBeginBlock),
+		base.WithTxBytes([]byte("tx1")),
+		base.WithTxBytes([]byte("tx2")),
+		WithEventPhase(base, EventPhaseEndBlock),
+	}
+
+	// Every emitter queuing several events of the same type in every phase of
+	// the block, like a stop loss and a liquidation of one position
+	ids := make(map[string]bool)
+	for _, ctx := range phases {
+		for _, eventType := range allEventTypes() {
+			for i := 0; i < 3; i++ {
+				id := NewEventID(ctx, eventType)
+				require.False(t, ids[id], "duplicate event ID %s", id)
+				ids[id] = true
+
+				height, phase, module, _, err := ParseEventID(id)
+				require.NoError(t, err)
+				require.Equal(t, int64(1200), height)
+				require.Equal(t, eventPhase(ctx), phase)
+				require.Equal(t, eventModule(eventType), module)
+			}
+		}
+	}
+	require.Len(t, ids, len(phases)*len(allEventTypes())*3)
+
+	require.Equal(t, "1200-begin-leveragelp-1", FormatEventID(1200, EventPhaseBeginBlock, "leveragelp", 1))
+	require.Equal(t, EventPhaseTx, eventPhase(phases[1]))
+	require.Equal(t, EventPhaseBlock, eventPhase(base))
+
+	// The sequence restarts on the next block, so re-executing a block yields
+	// the same IDs
+	next := base.WithBlockHeight(1201)
+	require.Equal(t, "1201-block-amm-1", NewEventID(next, indexerTypes.ElysEventTypes.Amm.Trade))
+	require.Equal(t, "1201-block-amm-2", NewEventID(next, indexerTypes.ElysEventTypes.Amm.Trade))
+
+	_, _, _, _, err := ParseEventID("1200-uusdc-/elys-event/stablestake/snapshot")
+	require.Error(t, err)
+}
+
+func TestEventIDsDoNotCollideInStore(t *testing.T) {
+	db := newTestDatabase(t)
+	ctx := WithEventPhase(sdk.NewContext(nil, cmtproto.Header{Height: 1300}, false, log.NewNopLogger()), EventPhaseBeginBlock)
+	blockTime := time.Date(2024, 9, 1, 0, 0, 0, 0, time.UTC)
+	const owner = "elys1owner"
+
+	// A stop loss followed by a liquidation of the same position in one block
+	stopLoss := indexerTypes.ElysEventTypes.Leveragelp.StopLoss
+	storeEvent(t, db, NewEventID(ctx, stopLoss), stopLoss, 1300, blockTime, leveragelp.StopLossEvent{Address: owner, PositionID: 7})
+	liquidation := indexerTypes.ElysEventTypes.Leveragelp.Liquidation
+	storeEvent(t, db, NewEventID(ctx, liquidation), liquidation, 1300, blockTime, leveragelp.LiquidationEvent{Address: owner, PositionID: 7})
+	require.Equal(t, uint64(2), db.GetRecordCount())
+}
+
+func TestBlockTransactionRecord(t *testing.T) {
+	db := newTestDatabase(t)
+	previous := database
+	database = db
+	t.Cleanup(func() { database = previous })
+
+	// A position opened by an order executed in the end blocker
+	ctx := WithEventPhase(sdk.NewContext(nil, cmtproto.Header{Height: 1400}, false, log.NewNopLogger()), EventPhaseEndBlock)
+	const owner = "elys1owner"
+	item := queueItem{
+		ctx:               ctx,
+		proc:              perpetual.MsgOpen{Creator: owner},
+		includedAddresses: []string{owner},
+		blockTxID:         FormatEventID(1400, EventPhaseEndBlock, "perpetual", 1),
+	}
+	processBlockTransactionInternal(item)
+
+	records, err := db.GetRecordsByAddress(owner)
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	require.True(t, records[0].IsTransaction())
+	tx := records[0].Transaction.BaseTransaction
+	require.Equal(t, "1400-end-perpetual-1", tx.TxHash)
+	require.Equal(t, "/elys.perpetual.MsgOpen", tx.TxType)
+	require.Equal(t, owner, tx.Author)
+	require.Equal(t, int64(1400), tx.BlockHeight)
+}
@@ -0,0 +1,264 @@
+package indexer
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/parquet-go/parquet-go"
+
+	indexerTypes "github.com/elys-network/elys/indexer/types"
+)
+
+// Export formats
+const (
+	ExportCSV     = "csv"
+	ExportJSONL   = "jsonl"
+	ExportParquet = "parquet"
+)
+
+// ExportRow is a flattened record of an account history. In and Out hold the
+// tokens received and sent by the account as "amount denom" joined by ";".
+type ExportRow struct {
+	Time     time.Time                  `json:"time"`
+	Height   int64                      `json:"height"`
+	Hash     string                     `json:"hash"` // Transaction hash or event ID
+	Type     string                     `json:"type"`
+	Category string                     `json:"category"`
+	In       string                     `json:"in"`
+	Out      string                     `json:"out"`
+	Fees     string                     `json:"fees"`
+	PnL      string                     `json:"pnl"`
+	Entries  []indexerTypes.LedgerEntry `json:"entries,omitempty"`
+}
+
+var exportColumns = []string{"time", "height", "hash", "type", "category", "in", "out", "fees", "pnl"}
+
+// RecordLedgerEntries returns the debit and credit lines of a record, the
+// transaction fees paid by the author included. Lines moving no tokens are dropped.
+func RecordLedgerEntries(record indexerTypes.GenericRecord) []indexerTypes.LedgerEntry {
+	var entries []indexerTypes.LedgerEntry
+	if record.IsTransaction() {
+		base := record.Transaction.BaseTransaction
+		for _, fee := range base.Fees {
+			entries = append(entries, indexerTypes.Debit(base.Author, indexerTypes.LedgerFee, indexerTypes.Token{Amount: fee.Amount, Denom: fee.Denom}))
+		}
+	}
+	if data, ok := recordData(record); ok {
+		if mapper, ok := data.(indexerTypes.LedgerMapper); ok {
+			entries = append(entries, mapper.LedgerEntries()...)
+		}
+	}
+
+	filtered := entries[:0]
+	for _, entry := range entries {
+		if !entry.IsEmpty() {
+			filtered = append(filtered, entry)
+		}
+	}
+	return filtered
+}
+
+// ExportRecords flattens the records of address produced in [from, to) into
+// rows. A zero from or to leaves that side of the range open. With ledger set
+// every debit or credit line of the account becomes a row, otherwise each
+// record is a single row and records moving no tokens are kept.
+func (m *LMDBManager) ExportRecords(address string, from, to time.Time, ledger bool) ([]ExportRow, error) {
+	records, err := m.GetRecordsByAddress(address)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []ExportRow
+	for _, record := range records {
+		blockTime := record.BlockTime()
+		if (!from.IsZero() && blockTime.Before(from)) || (!to.IsZero() && !blockTime.Before(to)) {
+			continue
+		}
+
+		var entries []indexerTypes.LedgerEntry
+		for _, entry := range RecordLedgerEntries(record) {
+			if entry.Address == address {
+				entries = append(entries, entry)
+			}
+		}
+
+		base := ExportRow{
+			Time:   blockTime.UTC(),
+			Height: record.BlockHeight(),
+			Hash:   recordHash(record),
+			Type:   record.Type(),
+			PnL:    recordPnL(record),
+		}
+		if !ledger {
+			rows = append(rows, exportRow(base, entries))
+			continue
+		}
+		for i, entry := range entries {
+			row := exportRow(base, []indexerTypes.LedgerEntry{entry})
+			// The profit or loss belongs to the record, keep it on its first line only
+			if i > 0 {
+				row.PnL = ""
+			}
+			rows = append(rows, row)
+		}
+	}
+	return rows, nil
+}
+
+// exportRow fills the token columns of row from the ledger entries
+func exportRow(row ExportRow, entries []indexerTypes.LedgerEntry) ExportRow {
+	var in, out, fees, categories []string
+	for _, entry := range entries {
+		token := entry.Amount + " " + entry.Denom
+		switch {
+		case entry.Category == indexerTypes.LedgerFee:
+			fees = append(fees, token)
+			continue
+		case entry.Direction == indexerTypes.LedgerCredit:
+			in = append(in, token)
+		default:
+			out = append(out, token)
+		}
+		if !containsString(categories, entry.Category) {
+			categories = append(categories, entry.Category)
+		}
+	}
+	if len(categories) == 0 && len(fees) > 0 {
+		categories = append(categories, indexerTypes.LedgerFee)
+	}
+
+	row.Category = strings.Join(categories, ";")
+	row.In = strings.Join(in, ";")
+	row.Out = strings.Join(out, ";")
+	row.Fees = strings.Join(fees, ";")
+	row.Entries = entries
+	return row
+}
+
+// recordHash returns the transaction hash or the event ID of a record
+func recordHash(record indexerTypes.GenericRecord) string {
+	if record.IsTransaction() {
+		return record.Transaction.BaseTransaction.TxHash
+	} else if record.IsEvent() {
+		return record.Event.BaseEvent.EventID
+	}
+	return ""
+}
+
+// recordPnL returns the realized profit or loss reported by a record, if any
+func recordPnL(record indexerTypes.GenericRecord) string {
+	var data interface{}
+	if record.IsTransaction() {
+		data = record.Transaction.Data
+	} else if record.IsEvent() {
+		data = record.Event.Data
+	}
+
+	var fields struct {
+		ProfitLoss string `json:"profit_loss"`
+	}
+	if err := decodeData(data, &fields); err != nil {
+		return ""
+	}
+	return fields.ProfitLoss
+}
+
+// WriteExport writes rows to w in the given format
+func WriteExport(w io.Writer, format string, rows []ExportRow) error {
+	switch format {
+	case ExportCSV:
+		return writeExportCSV(w, rows)
+	case ExportJSONL:
+		return writeExportJSONL(w, rows)
+	case ExportParquet:
+		return writeExportParquet(w, rows)
+	default:
+		return fmt.Errorf("unknown export format: %s", format)
+	}
+}
+
+func writeExportCSV(w io.Writer, rows []ExportRow) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(exportColumns); err != nil {
+		return err
+	}
+	for _, row := range rows {
+		if err := writer.Write(exportValues(row)); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+func writeExportJSONL(w io.Writer, rows []ExportRow) error {
+	encoder := json.NewEncoder(w)
+	for _, row := range rows {
+		if err := encoder.Encode(row); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// exportParquetRow is the Parquet row of an export row, its columns follow
+// exportColumns
+type exportParquetRow struct {
+	Time     string `parquet:"time"`
+	Height   int64  `parquet:"height"`
+	Hash     string `parquet:"hash"`
+	Type     string `parquet:"type"`
+	Category string `parquet:"category"`
+	In       string `parquet:"in"`
+	Out      string `parquet:"out"`
+	Fees     string `parquet:"fees"`
+	PnL      string `parquet:"pnl"`
+}
+
+func writeExportParquet(w io.Writer, rows []ExportRow) error {
+	parquetRows := make([]exportParquetRow, len(rows))
+	for i, row := range rows {
+		values := exportValues(row)
+		parquetRows[i] = exportParquetRow{
+			Time:     values[0],
+			Height:   row.Height,
+			Hash:     values[2],
+			Type:     values[3],
+			Category: values[4],
+			In:       values[5],
+			Out:      values[6],
+			Fees:     values[7],
+			PnL:      values[8],
+		}
+	}
+	return parquet.Write(w, parquetRows)
+}
+
+// exportValues returns the values of row in the order of exportColumns
+func exportValues(row ExportRow) []string {
+	return []string{
+		row.Time.Format(time.RFC3339),
+		strconv.FormatInt(row.Height, 10),
+		row.Hash,
+		row.Type,
+		row.Category,
+		row.In,
+		row.Out,
+		row.Fees,
+		row.PnL,
+	}
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
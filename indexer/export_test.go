@@ -0,0 +1,234 @@
+package indexer
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/parquet-go/parquet-go"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elys-network/elys/indexer/txs/amm"
+	"github.com/elys-network/elys/indexer/txs/masterchef"
+	"github.com/elys-network/elys/indexer/txs/perpetual"
+	indexerTypes "github.com/elys-network/elys/indexer/types"
+)
+
+// Record types that never move tokens of an account. Funding and interest
+// settle inside the position, batch messages are booked through the records
+// of the single operations they trigger, amm trades duplicate the swaps and
+// revenue moves between module accounts.
+var nonLedgerTypes = map[string]bool{
+	"/elys-event/amm/pool-snapshot":                true,
+	"/elys-event/amm/trade":                        true,
+	"/elys-event/bank/balance-checkpoint":          true,
+	"/elys-event/leveragelp/pool-snapshot":         true,
+	"/elys-event/masterchef/fee-conversion":        true,
+	"/elys-event/masterchef/revenue-collected":     true,
+	"/elys-event/oracle/price-pruned":              true,
+	"/elys-event/perpetual/funding-settlement":     true,
+	"/elys-event/perpetual/interest-settlement":    true,
+	"/elys-event/perpetual/pool-snapshot":          true,
+	"/elys-event/stablestake/snapshot":             true,
+	"/elys-event/tradeshield/bracket-activation":   true,
+	"/elys-event/tradeshield/perpetual-close":      true,
+	"/elys-event/tradeshield/order-execution":      true,
+	"/elys-event/tradeshield/trailing-stop":        true,
+	"/elys.amm.MsgFeedMultipleExternalLiquidity":   true,
+	"/elys.amm.MsgUpdateParams":                    true,
+	"/elys.amm.MsgUpdatePoolParams":                true,
+	"/elys.assetprofile.MsgAddEntry":               true,
+	"/elys.assetprofile.MsgDeleteEntry":            true,
+	"/elys.assetprofile.MsgUpdateEntry":            true,
+	"/elys.burner.MsgUpdateParams":                 true,
+	"/elys.commitment.MsgUpdateVestingInfo":        true,
+	"/elys.estaking.MsgUpdateParams":               true,
+	"/elys.leveragelp.MsgAddPool":                  true,
+	"/elys.leveragelp.MsgClaimRewards":             true,
+	"/elys.leveragelp.MsgClosePosition":            true,
+	"/elys.leveragelp.MsgDewhitelist":              true,
+	"/elys.leveragelp.MsgRemovePool":               true,
+	"/elys.leveragelp.MsgUpdateParams":             true,
+	"/elys.leveragelp.MsgUpdateStopLoss":           true,
+	"/elys.leveragelp.MsgWhitelist":                true,
+	"/elys.masterchef.MsgAddExternalRewardDenom":   true,
+	"/elys.masterchef.MsgClaimRewards":             true,
+	"/elys.masterchef.MsgTogglePoolEdenRewards":    true,
+	"/elys.masterchef.MsgUpdateParams":             true,
+	"/elys.masterchef.MsgUpdatePoolMultipliers":    true,
+	"/elys.oracle.MsgAddPriceFeeders":              true,
+	"/elys.oracle.MsgCreateAssetInfo":              true,
+	"/elys.oracle.MsgDeletePriceFeeder":            true,
+	"/elys.oracle.MsgFeedMultiplePrices":           true,
+	"/elys.oracle.MsgFeedPrice":                    true,
+	"/elys.oracle.MsgRemoveAssetInfo":              true,
+	"/elys.oracle.MsgRemovePriceFeeders":           true,
+	"/elys.oracle.MsgSetPriceFeeder":               true,
+	"/elys.oracle.MsgUpdateParams":                 true,
+	"/elys.parameter.MsgUpdateMaxVotingPower":      true,
+	"/elys.parameter.MsgUpdateMinCommission":       true,
+	"/elys.parameter.MsgUpdateMinSelfDelegation":   true,
+	"/elys.parameter.MsgUpdateRewardsDataLifetime": true,
+	"/elys.parameter.MsgUpdateTotalBlocksPerYear":  true,
+	"/elys.perpetual.MsgClosePositions":            true,
+	"/elys.perpetual.MsgDewhitelist":               true,
+	"/elys.perpetual.MsgUpdateParams":              true,
+	"/elys.perpetual.MsgUpdateStopLoss":            true,
+	"/elys.perpetual.MsgUpdateTakeProfitPrice":     true,
+	"/elys.perpetual.MsgWhitelist":                 true,
+	"/elys.stablestake.MsgUpdateParams":            true,
+	"/elys.tier.MsgSetPortfolio":                   true,
+	"/elys.tokenomics.MsgCreateAirdrop":            true,
+	"/elys.tokenomics.MsgCreateTimeBasedInflation": true,
+	"/elys.tokenomics.MsgDeleteAirdrop":            true,
+	"/elys.tokenomics.MsgDeleteTimeBasedInflation": true,
+	"/elys.tokenomics.MsgUpdateAirdrop":            true,
+	"/elys.tokenomics.MsgUpdateGenesisInflation":   true,
+	"/elys.tokenomics.MsgUpdateTimeBasedInflation": true,
+	"/elys.tradeshield.MsgCancelPerpetualOrders":   true,
+	"/elys.tradeshield.MsgCancelSpotOrders":        true,
+	"/elys.tradeshield.MsgCreateOrderGroup":        true,
+	"/elys.tradeshield.MsgUpdateParams":            true,
+	"/elys.tradeshield.MsgUpdatePerpetualOrder":    true,
+	"/elys.tradeshield.MsgUpdateSpotOrder":         true,
+}
+
+func TestLedgerMappingCoverage(t *testing.T) {
+	mapper := reflect.TypeOf((*indexerTypes.LedgerMapper)(nil)).Elem()
+	check := func(registry map[string]reflect.Type) {
+		for name, dataType := range registry {
+			implements := dataType.Implements(mapper)
+			require.True(t, implements || nonLedgerTypes[name], "%s has no ledger entries mapping", name)
+			require.False(t, implements && nonLedgerTypes[name], "%s maps ledger entries but is listed as non ledger", name)
+		}
+	}
+	check(txRegistry)
+	check(eventRegistry)
+}
+
+func storeAuthoredTx(t *testing.T, db *LMDBManager, base indexerTypes.BaseTransaction, proc indexerTypes.Processor) {
+	base.IncludedAddresses = []string{base.Author}
+	_, err := proc.Process(db, base)
+	require.NoError(t, err)
+}
+
+func TestExportRecords(t *testing.T) {
+	db := newTestDatabase(t)
+	start := time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC)
+	const owner = "elys1owner"
+
+	storeAuthoredTx(t, db, indexerTypes.BaseTransaction{
+		TxHash:      "swap",
+		TxType:      "/elys.amm.MsgSwapExactAmountIn",
+		Author:      owner,
+		BlockHeight: 10,
+		BlockTime:   start,
+		Fees:        []indexerTypes.FeeDetail{{Amount: "25", Denom: "uelys"}},
+	}, amm.MsgSwapExactAmountIn{
+		Sender:   owner,
+		TokenIn:  indexerTypes.Token{Amount: "100", Denom: "uusdc"},
+		TokenOut: indexerTypes.Token{Amount: "20", Denom: "uatom"},
+	})
+	storeAuthoredTx(t, db, indexerTypes.BaseTransaction{
+		TxHash:      "close",
+		TxType:      "/elys.perpetual.MsgClose",
+		Author:      owner,
+		BlockHeight: 11,
+		BlockTime:   start.Add(time.Hour),
+	}, perpetual.MsgClose{
+		Creator:        owner,
+		Id:             1,
+		ProfitLoss:     "12.500000000000000000",
+		ReturnedAmount: indexerTypes.Token{Amount: "112", Denom: "uusdc"},
+	})
+	_, err := masterchef.ClaimRewardsEvent{
+		Sender:      owner,
+		RewardCoins: []indexerTypes.Token{{Amount: "7", Denom: "ueden"}, {Amount: "3", Denom: "uusdc"}},
+	}.Process(db, indexerTypes.BaseEvent{
+		EventID:     "claim",
+		EventType:   indexerTypes.ElysEventTypes.Masterchef.ClaimRewards,
+		Author:      owner,
+		BlockHeight: 12,
+		BlockTime:   start.Add(2 * time.Hour),
+	})
+	require.NoError(t, err)
+	storeAuthoredTx(t, db, indexerTypes.BaseTransaction{
+		TxHash:      "later",
+		TxType:      "/elys.perpetual.MsgUpdateStopLoss",
+		Author:      owner,
+		BlockHeight: 20,
+		BlockTime:   start.Add(48 * time.Hour),
+	}, perpetual.MsgUpdateStopLoss{Creator: owner, ID: 1, Price: "1"})
+
+	rows, err := db.ExportRecords(owner, start, start.Add(24*time.Hour), false)
+	require.NoError(t, err)
+	require.Len(t, rows, 3)
+	require.Equal(t, "swap", rows[0].Hash)
+	require.Equal(t, "swap", rows[0].Category)
+	require.Equal(t, "20 uatom", rows[0].In)
+	require.Equal(t, "100 uusdc", rows[0].Out)
+	require.Equal(t, "25 uelys", rows[0].Fees)
+	require.Equal(t, "112 uusdc", rows[1].In)
+	require.Equal(t, "12.500000000000000000", rows[1].PnL)
+	require.Equal(t, "claim", rows[2].Hash)
+	require.Equal(t, "7 ueden;3 uusdc", rows[2].In)
+
+	all, err := db.ExportRecords(owner, time.Time{}, time.Time{}, false)
+	require.NoError(t, err)
+	require.Len(t, all, 4)
+	require.Empty(t, all[3].Category)
+
+	lines, err := db.ExportRecords(owner, time.Time{}, time.Time{}, true)
+	require.NoError(t, err)
+	require.Len(t, lines, 6)
+	require.Equal(t, indexerTypes.LedgerFee, lines[0].Category)
+	require.Equal(t, "25 uelys", lines[0].Fees)
+	require.Equal(t, "100 uusdc", lines[1].Out)
+	require.Equal(t, "20 uatom", lines[2].In)
+
+	var csvOut bytes.Buffer
+	require.NoError(t, WriteExport(&csvOut, ExportCSV, rows))
+	csvLines := strings.Split(strings.TrimSpace(csvOut.String()), "\n")
+	require.Len(t, csvLines, 4)
+	require.Equal(t, "time,height,hash,type,category,in,out,fees,pnl", csvLines[0])
+	require.Equal(t, "2024-05-01T09:00:00Z,10,swap,/elys.amm.MsgSwapExactAmountIn,swap,20 uatom,100 uusdc,25 uelys,", csvLines[1])
+
+	var jsonlOut bytes.Buffer
+	require.NoError(t, WriteExport(&jsonlOut, ExportJSONL, rows))
+	require.Len(t, strings.Split(strings.TrimSpace(jsonlOut.String()), "\n"), 3)
+
+	require.Error(t, WriteExport(&bytes.Buffer{}, "xlsx", rows))
+}
+
+func TestWriteParquet(t *testing.T) {
+	rows := []ExportRow{
+		{Time: time.Unix(100, 0).UTC(), Height: 10, Hash: "a", Type: "t", In: "1 uelys"},
+		{Time: time.Unix(200, 0).UTC(), Height: 11, Hash: "b", Type: "t", Out: "2 uusdc", PnL: "-3"},
+	}
+	var out bytes.Buffer
+	require.NoError(t, WriteExport(&out, ExportParquet, rows))
+
+	// The file is read back with a Parquet reader
+	file, err := parquet.OpenFile(bytes.NewReader(out.Bytes()), int64(out.Len()))
+	require.NoError(t, err)
+	require.Equal(t, int64(2), file.NumRows())
+	fields := file.Schema().Fields()
+	require.Len(t, fields, len(exportColumns))
+	for i, name := range exportColumns {
+		require.Equal(t, name, fields[i].Name())
+		kind := parquet.ByteArray
+		if name == "height" {
+			kind = parquet.Int64
+		}
+		require.Equal(t, kind, fields[i].Type().Kind(), name)
+	}
+
+	read, err := parquet.Read[exportParquetRow](bytes.NewReader(out.Bytes()), int64(out.Len()))
+	require.NoError(t, err)
+	require.Equal(t, []exportParquetRow{
+		{Time: "1970-01-01T00:01:40Z", Height: 10, Hash: "a", Type: "t", In: "1 uelys"},
+		{Time: "1970-01-01T00:03:20Z", Height: 11, Hash: "b", Type: "t", Out: "2 uusdc", PnL: "-3"},
+	}, read)
+}
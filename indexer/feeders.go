@@ -0,0 +1,525 @@
+package indexer
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"cosmossdk.io/math"
+	"github.com/bmatsuo/lmdb-go/lmdb"
+
+	"github.com/elys-network/elys/indexer/txs/amm"
+	"github.com/elys-network/elys/indexer/txs/oracle"
+	indexerTypes "github.com/elys-network/elys/indexer/types"
+)
+
+// FeederWindowBlocks is the length in blocks of the windows feeder metrics are
+// kept for. Windows start at multiples of it.
+var FeederWindowBlocks int64 = 1000
+
+// FeederPeerMaxAge is the age in blocks past which the latest price of a feeder
+// is left out of the median the other feeders are compared to
+var FeederPeerMaxAge int64 = 100
+
+// SpotQuoteDenom is the AMM denom oracle prices are compared to, valued at one
+// USD with SpotQuoteDecimal decimals
+var (
+	SpotQuoteDenom   = "uusdc"
+	SpotQuoteDecimal = uint64(6)
+)
+
+// FeederMetrics are the accountability metrics of a price feeder for an asset
+// over a block range.
+//
+// ActiveBlocks are the blocks in which any feeder fed the asset, the feeder
+// missed the ones it did not feed in. Deviations are relative to the median of
+// the other feeders' latest prices and to the AMM spot price, averaged over the
+// feeds they could be computed for. Stale feeds are the feeder's last prices of
+// an asset and source the oracle pruned before a newer price replaced them.
+type FeederMetrics struct {
+	Feeder             string `json:"feeder"`
+	Asset              string `json:"asset"`
+	FromHeight         int64  `json:"from_height"`
+	ToHeight           int64  `json:"to_height"`
+	Feeds              uint64 `json:"feeds"`
+	FedBlocks          uint64 `json:"fed_blocks"`
+	ActiveBlocks       uint64 `json:"active_blocks"`
+	MissedBlocks       uint64 `json:"missed_blocks"`
+	Uptime             string `json:"uptime"`
+	StaleFeeds         uint64 `json:"stale_feeds"`
+	MedianDeviation    string `json:"median_deviation"`
+	MaxMedianDeviation string `json:"max_median_deviation"`
+	SpotDeviation      string `json:"spot_deviation"`
+	MaxSpotDeviation   string `json:"max_spot_deviation"`
+	LastFeedHeight     int64  `json:"last_feed_height"`
+}
+
+// feederWindow holds the counters of one feeder and asset in one window
+type feederWindow struct {
+	Feeds                uint64 `json:"feeds"`
+	FedBlocks            uint64 `json:"fed_blocks"`
+	StaleFeeds           uint64 `json:"stale_feeds"`
+	MedianDeviationSum   string `json:"median_deviation_sum"`
+	MedianDeviationCount uint64 `json:"median_deviation_count"`
+	MaxMedianDeviation   string `json:"max_median_deviation"`
+	SpotDeviationSum     string `json:"spot_deviation_sum"`
+	SpotDeviationCount   uint64 `json:"spot_deviation_count"`
+	MaxSpotDeviation     string `json:"max_spot_deviation"`
+	LastHeight           int64  `json:"last_height"`
+}
+
+// assetWindow counts the blocks of one window in which an asset was fed
+type assetWindow struct {
+	ActiveBlocks uint64 `json:"active_blocks"`
+	LastHeight   int64  `json:"last_height"`
+}
+
+// latestFeed is the latest price a feeder submitted for an asset
+type latestFeed struct {
+	Price  string `json:"price"`
+	Height int64  `json:"height"`
+}
+
+// feederAggregator keeps the per window metrics of the oracle price feeders.
+// - windowDB: "<feeder>/<asset>/<window start>" -> feederWindow
+// - assetWindowDB: "<asset>/<window start>" -> assetWindow
+// - assetFeederDB: asset -> feeders (DupSort)
+// - latestDB: "<asset>/<feeder>" -> latestFeed
+// - spotDB: denom -> latest AMM price in SpotQuoteDenom per unit
+type feederAggregator struct {
+	windowDB      lmdb.DBI
+	assetWindowDB lmdb.DBI
+	assetFeederDB lmdb.DBI
+	latestDB      lmdb.DBI
+	spotDB        lmdb.DBI
+}
+
+var feeders = &feederAggregator{}
+
+func init() {
+	RegisterAggregator(feeders)
+}
+
+func (a *feederAggregator) Name() string {
+	return "feeders"
+}
+
+func (a *feederAggregator) Open(txn *lmdb.Txn) (err error) {
+	if a.windowDB, err = txn.OpenDBI("feederwindows", lmdb.Create); err != nil {
+		return err
+	}
+	if a.assetWindowDB, err = txn.OpenDBI("feederassetwindows", lmdb.Create); err != nil {
+		return err
+	}
+	if a.assetFeederDB, err = txn.OpenDBI("feederassets", lmdb.Create|lmdb.DupSort); err != nil {
+		return err
+	}
+	if a.latestDB, err = txn.OpenDBI("feederlatest", lmdb.Create); err != nil {
+		return err
+	}
+	a.spotDB, err = txn.OpenDBI("feederspot", lmdb.Create)
+	return err
+}
+
+func (a *feederAggregator) Apply(txn *lmdb.Txn, index uint64, record indexerTypes.GenericRecord) error {
+	data, ok := recordData(record)
+	if !ok {
+		return nil
+	}
+
+	height := record.BlockHeight()
+	switch d := data.(type) {
+	case oracle.MsgFeedPrice:
+		return a.applyFeed(txn, d.Provider, oracle.FeedPrice{Asset: d.Asset, Price: d.Price, Denom: d.Denom, Decimal: d.Decimal}, height)
+	case oracle.MsgFeedMultiplePrices:
+		for _, feed := range d.FeedPrices {
+			if err := a.applyFeed(txn, d.Creator, feed, height); err != nil {
+				return err
+			}
+		}
+	case oracle.PricePrunedEvent:
+		return a.updateWindow(txn, d.Provider, d.Asset, height, func(window *feederWindow) {
+			window.StaleFeeds++
+		})
+	case amm.Trade:
+		return a.applyTrade(txn, d)
+	}
+	return nil
+}
+
+func (a *feederAggregator) applyFeed(txn *lmdb.Txn, feeder string, feed oracle.FeedPrice, height int64) error {
+	price, err := math.LegacyNewDecFromStr(feed.Price)
+	if err != nil {
+		return fmt.Errorf("invalid feed price %q: %v", feed.Price, err)
+	}
+
+	median, err := a.peerMedian(txn, feeder, feed.Asset, height)
+	if err != nil {
+		return err
+	}
+	spot, err := a.spotPrice(txn, feed.Denom, feed.Decimal)
+	if err != nil {
+		return err
+	}
+
+	err = a.updateWindow(txn, feeder, feed.Asset, height, func(window *feederWindow) {
+		window.Feeds++
+		if window.LastHeight != height {
+			window.FedBlocks++
+			window.LastHeight = height
+		}
+		if median.IsPositive() {
+			deviation := price.Sub(median).Abs().Quo(median)
+			window.MedianDeviationSum = addDecStrings(window.MedianDeviationSum, deviation.String())
+			window.MedianDeviationCount++
+			window.MaxMedianDeviation = maxDecString(window.MaxMedianDeviation, deviation)
+		}
+		if spot.IsPositive() {
+			deviation := price.Sub(spot).Abs().Quo(spot)
+			window.SpotDeviationSum = addDecStrings(window.SpotDeviationSum, deviation.String())
+			window.SpotDeviationCount++
+			window.MaxSpotDeviation = maxDecString(window.MaxSpotDeviation, deviation)
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	// Count the block as active for the asset
+	assetKey := feederAssetWindowKey(feed.Asset, feederWindowStart(height))
+	var active assetWindow
+	if err := getJSON(txn, a.assetWindowDB, assetKey, &active); err != nil {
+		return err
+	}
+	if active.LastHeight != height {
+		active.ActiveBlocks++
+		active.LastHeight = height
+		if err := putJSON(txn, a.assetWindowDB, assetKey, active); err != nil {
+			return err
+		}
+	}
+
+	if err := txn.Put(a.assetFeederDB, []byte(feed.Asset), []byte(feeder), 0); err != nil {
+		return err
+	}
+	return putJSON(txn, a.latestDB, []byte(feed.Asset+"/"+feeder), latestFeed{Price: price.String(), Height: height})
+}
+
+// updateWindow applies update to the window of a feeder and asset at height
+func (a *feederAggregator) updateWindow(txn *lmdb.Txn, feeder, asset string, height int64, update func(*feederWindow)) error {
+	key := feederWindowKey(feeder, asset, feederWindowStart(height))
+	var window feederWindow
+	if err := getJSON(txn, a.windowDB, key, &window); err != nil {
+		return err
+	}
+	update(&window)
+	return putJSON(txn, a.windowDB, key, window)
+}
+
+// peerMedian returns the median of the latest prices the other feeders
+// submitted for asset within FeederPeerMaxAge blocks, zero when there are none
+func (a *feederAggregator) peerMedian(txn *lmdb.Txn, feeder, asset string, height int64) (math.LegacyDec, error) {
+	cursor, err := txn.OpenCursor(a.latestDB)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+	defer cursor.Close()
+
+	var prices []math.LegacyDec
+	prefix := asset + "/"
+	key, value, err := cursor.Get([]byte(prefix), nil, lmdb.SetRange)
+	for ; err == nil; key, value, err = cursor.Get(nil, nil, lmdb.Next) {
+		if !strings.HasPrefix(string(key), prefix) {
+			break
+		}
+		if string(key[len(prefix):]) == feeder {
+			continue
+		}
+		var latest latestFeed
+		if err := json.Unmarshal(value, &latest); err != nil {
+			return math.LegacyDec{}, err
+		}
+		if latest.Height < height-FeederPeerMaxAge {
+			continue
+		}
+		prices = append(prices, math.LegacyMustNewDecFromStr(latest.Price))
+	}
+	if err != nil && !lmdb.IsNotFound(err) {
+		return math.LegacyDec{}, err
+	}
+
+	if len(prices) == 0 {
+		return math.LegacyZeroDec(), nil
+	}
+	sort.Slice(prices, func(i, j int) bool { return prices[i].LT(prices[j]) })
+	middle := len(prices) / 2
+	if len(prices)%2 == 1 {
+		return prices[middle], nil
+	}
+	return prices[middle-1].Add(prices[middle]).QuoInt64(2), nil
+}
+
+// spotPrice returns the AMM spot price in USD of one whole token of denom,
+// zero when denom has not traded against SpotQuoteDenom
+func (a *feederAggregator) spotPrice(txn *lmdb.Txn, denom string, decimal uint64) (math.LegacyDec, error) {
+	if denom == "" || denom == SpotQuoteDenom {
+		return math.LegacyZeroDec(), nil
+	}
+	value, err := txn.Get(a.spotDB, []byte(denom))
+	if lmdb.IsNotFound(err) {
+		return math.LegacyZeroDec(), nil
+	} else if err != nil {
+		return math.LegacyDec{}, err
+	}
+	spot, err := math.LegacyNewDecFromStr(string(value))
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+	if decimal >= SpotQuoteDecimal {
+		return spot.Mul(math.LegacyNewDec(10).Power(decimal - SpotQuoteDecimal)), nil
+	}
+	return spot.Quo(math.LegacyNewDec(10).Power(SpotQuoteDecimal - decimal)), nil
+}
+
+// applyTrade keeps the latest AMM price of the denoms traded against SpotQuoteDenom
+func (a *feederAggregator) applyTrade(txn *lmdb.Txn, trade amm.Trade) error {
+	price, err := math.LegacyNewDecFromStr(trade.Price)
+	if err != nil || !price.IsPositive() {
+		return nil
+	}
+	switch SpotQuoteDenom {
+	case trade.QuoteDenom:
+		return txn.Put(a.spotDB, []byte(trade.BaseDenom), []byte(price.String()), 0)
+	case trade.BaseDenom:
+		return txn.Put(a.spotDB, []byte(trade.QuoteDenom), []byte(math.LegacyOneDec().Quo(price).String()), 0)
+	}
+	return nil
+}
+
+// GetFeederMetrics returns the metrics of a feeder per window, ordered by asset
+// and window. asset restricts them to one asset when set. Windows overlapping
+// fromHeight to toHeight are returned, toHeight 0 leaves the range open. Windows
+// in which an asset was fed by others only are included as fully missed.
+func (m *LMDBManager) GetFeederMetrics(feeder, asset string, fromHeight, toHeight int64) ([]FeederMetrics, error) {
+	result := []FeederMetrics{}
+	err := m.view(func(txn *lmdb.Txn) error {
+		assets := []string{asset}
+		if asset == "" {
+			var err error
+			if assets, err = feeders.feederAssets(txn, feeder); err != nil {
+				return err
+			}
+		}
+		for _, asset := range assets {
+			err := feeders.scanAssetWindows(txn, feeder, asset, fromHeight, toHeight, func(start int64, activeBlocks uint64, window feederWindow) {
+				result = append(result, newFeederMetrics(feeder, asset, start, start+FeederWindowBlocks-1, activeBlocks, window))
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return result, err
+}
+
+// GetAssetFeederMetrics returns the metrics of every feeder of an asset summed
+// over the windows overlapping fromHeight to toHeight, ordered by feeder
+func (m *LMDBManager) GetAssetFeederMetrics(asset string, fromHeight, toHeight int64) ([]FeederMetrics, error) {
+	result := []FeederMetrics{}
+	err := m.view(func(txn *lmdb.Txn) error {
+		cursor, err := txn.OpenCursor(feeders.assetFeederDB)
+		if err != nil {
+			return err
+		}
+		defer cursor.Close()
+
+		var feederList []string
+		_, value, err := cursor.Get([]byte(asset), nil, lmdb.SetKey)
+		for ; err == nil; _, value, err = cursor.Get(nil, nil, lmdb.NextDup) {
+			feederList = append(feederList, string(value))
+		}
+		if !lmdb.IsNotFound(err) {
+			return err
+		}
+
+		for _, feeder := range feederList {
+			var total feederWindow
+			var activeBlocks uint64
+			from, to := int64(-1), int64(0)
+			err := feeders.scanAssetWindows(txn, feeder, asset, fromHeight, toHeight, func(start int64, active uint64, window feederWindow) {
+				if from < 0 {
+					from = start
+				}
+				to = start + FeederWindowBlocks - 1
+				activeBlocks += active
+				total.add(window)
+			})
+			if err != nil {
+				return err
+			}
+			result = append(result, newFeederMetrics(feeder, asset, max(from, 0), to, activeBlocks, total))
+		}
+		return nil
+	})
+	return result, err
+}
+
+// feederAssets returns the assets a feeder submitted prices for, in order
+func (a *feederAggregator) feederAssets(txn *lmdb.Txn, feeder string) ([]string, error) {
+	cursor, err := txn.OpenCursor(a.windowDB)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close()
+
+	var assets []string
+	prefix := feeder + "/"
+	key, _, err := cursor.Get([]byte(prefix), nil, lmdb.SetRange)
+	for ; err == nil; key, _, err = cursor.Get(nil, nil, lmdb.Next) {
+		if !strings.HasPrefix(string(key), prefix) {
+			return assets, nil
+		}
+		asset, _, _ := strings.Cut(string(key[len(prefix):]), "/")
+		if len(assets) == 0 || assets[len(assets)-1] != asset {
+			assets = append(assets, asset)
+		}
+	}
+	if lmdb.IsNotFound(err) {
+		return assets, nil
+	}
+	return nil, err
+}
+
+// scanAssetWindows calls fn with the window of a feeder for every window of
+// asset overlapping fromHeight to toHeight, along with the blocks the asset
+// was fed in during the window
+func (a *feederAggregator) scanAssetWindows(txn *lmdb.Txn, feeder, asset string, fromHeight, toHeight int64, fn func(start int64, activeBlocks uint64, window feederWindow)) error {
+	cursor, err := txn.OpenCursor(a.assetWindowDB)
+	if err != nil {
+		return err
+	}
+	defer cursor.Close()
+
+	prefix := asset + "/"
+	key, value, err := cursor.Get(feederAssetWindowKey(asset, feederWindowStart(fromHeight)), nil, lmdb.SetRange)
+	for ; err == nil; key, value, err = cursor.Get(nil, nil, lmdb.Next) {
+		if !strings.HasPrefix(string(key), prefix) {
+			return nil
+		}
+		start, err := strconv.ParseInt(string(key[len(prefix):]), 10, 64)
+		if err != nil {
+			return err
+		}
+		if toHeight > 0 && start > toHeight {
+			return nil
+		}
+		var active assetWindow
+		if err := json.Unmarshal(value, &active); err != nil {
+			return err
+		}
+		var window feederWindow
+		if err := getJSON(txn, a.windowDB, feederWindowKey(feeder, asset, start), &window); err != nil {
+			return err
+		}
+		fn(start, active.ActiveBlocks, window)
+	}
+	if lmdb.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+// add sums the counters of another window into w
+func (w *feederWindow) add(other feederWindow) {
+	w.Feeds += other.Feeds
+	w.FedBlocks += other.FedBlocks
+	w.StaleFeeds += other.StaleFeeds
+	w.MedianDeviationSum = addDecStrings(w.MedianDeviationSum, other.MedianDeviationSum)
+	w.MedianDeviationCount += other.MedianDeviationCount
+	if other.MaxMedianDeviation != "" {
+		w.MaxMedianDeviation = maxDecString(w.MaxMedianDeviation, math.LegacyMustNewDecFromStr(other.MaxMedianDeviation))
+	}
+	w.SpotDeviationSum = addDecStrings(w.SpotDeviationSum, other.SpotDeviationSum)
+	w.SpotDeviationCount += other.SpotDeviationCount
+	if other.MaxSpotDeviation != "" {
+		w.MaxSpotDeviation = maxDecString(w.MaxSpotDeviation, math.LegacyMustNewDecFromStr(other.MaxSpotDeviation))
+	}
+	w.LastHeight = max(w.LastHeight, other.LastHeight)
+}
+
+// newFeederMetrics derives the metrics of a feeder from its window counters
+func newFeederMetrics(feeder, asset string, fromHeight, toHeight int64, activeBlocks uint64, window feederWindow) FeederMetrics {
+	metrics := FeederMetrics{
+		Feeder:         feeder,
+		Asset:          asset,
+		FromHeight:     fromHeight,
+		ToHeight:       toHeight,
+		Feeds:          window.Feeds,
+		FedBlocks:      window.FedBlocks,
+		ActiveBlocks:   activeBlocks,
+		Uptime:         math.LegacyZeroDec().String(),
+		StaleFeeds:     window.StaleFeeds,
+		LastFeedHeight: window.LastHeight,
+	}
+	if activeBlocks > window.FedBlocks {
+		metrics.MissedBlocks = activeBlocks - window.FedBlocks
+	}
+	if activeBlocks > 0 {
+		metrics.Uptime = math.LegacyNewDec(int64(window.FedBlocks)).QuoInt64(int64(activeBlocks)).String()
+	}
+	if window.MedianDeviationCount > 0 {
+		metrics.MedianDeviation = math.LegacyMustNewDecFromStr(window.MedianDeviationSum).QuoInt64(int64(window.MedianDeviationCount)).String()
+		metrics.MaxMedianDeviation = window.MaxMedianDeviation
+	}
+	if window.SpotDeviationCount > 0 {
+		metrics.SpotDeviation = math.LegacyMustNewDecFromStr(window.SpotDeviationSum).QuoInt64(int64(window.SpotDeviationCount)).String()
+		metrics.MaxSpotDeviation = window.MaxSpotDeviation
+	}
+	return metrics
+}
+
+func feederWindowStart(height int64) int64 {
+	if height <= 0 {
+		return 0
+	}
+	return height / FeederWindowBlocks * FeederWindowBlocks
+}
+
+func feederWindowKey(feeder, asset string, start int64) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%020d", feeder, asset, start))
+}
+
+func feederAssetWindowKey(asset string, start int64) []byte {
+	return []byte(fmt.Sprintf("%s/%020d", asset, start))
+}
+
+// maxDecString returns the larger of a decimal string and value
+func maxDecString(current string, value math.LegacyDec) string {
+	if current != "" && math.LegacyMustNewDecFromStr(current).GTE(value) {
+		return current
+	}
+	return value.String()
+}
+
+// getJSON decodes the value of key into out, leaving out untouched when the key
+// does not exist
+func getJSON(txn *lmdb.Txn, dbi lmdb.DBI, key []byte, out interface{}) error {
+	value, err := txn.Get(dbi, key)
+	if lmdb.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	return json.Unmarshal(value, out)
+}
+
+func putJSON(txn *lmdb.Txn, dbi lmdb.DBI, key []byte, value interface{}) error {
+	valueBytes, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	return txn.Put(dbi, key, valueBytes, 0)
+}
@@ -0,0 +1,79 @@
+package indexer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/elys-network/elys/indexer/txs/amm"
+	"github.com/elys-network/elys/indexer/txs/oracle"
+	indexerTypes "github.com/elys-network/elys/indexer/types"
+)
+
+func TestFeederMetrics(t *testing.T) {
+	db := newTestDatabase(t)
+	start := time.Date(2024, 9, 1, 0, 0, 0, 0, time.UTC)
+	const feederA, feederB = "elys1feedera", "elys1feederb"
+
+	// 10 uusdc per uatom, $10 per ATOM
+	storeEvent(t, db, "100-tx-amm-1", indexerTypes.ElysEventTypes.Amm.Trade, 100, start,
+		amm.Trade{PoolID: 1, BaseDenom: "uatom", QuoteDenom: "uusdc", Price: "10", VolumeBase: "100", VolumeQuote: "1000"})
+
+	atom := func(price string) oracle.FeedPrice {
+		return oracle.FeedPrice{Asset: "ATOM", Price: price, Source: "elys", Denom: "uatom", Decimal: 6}
+	}
+	storeTx(t, db, "a1", 101, start, oracle.MsgFeedPrice{Provider: feederA, Asset: "ATOM", Price: "10", Source: "elys", Denom: "uatom", Decimal: 6})
+	storeTx(t, db, "b1", 101, start, oracle.MsgFeedMultiplePrices{Creator: feederB, FeedPrices: []oracle.FeedPrice{atom("12")}})
+	storeTx(t, db, "a2", 102, start, oracle.MsgFeedPrice{Provider: feederA, Asset: "ATOM", Price: "9", Source: "elys", Denom: "uatom", Decimal: 6})
+	// The latest price of feederA is too old to be part of the median
+	storeTx(t, db, "b2", 1500, start, oracle.MsgFeedMultiplePrices{Creator: feederB, FeedPrices: []oracle.FeedPrice{atom("10")}})
+	storeEvent(t, db, "1600-end-oracle-1", indexerTypes.ElysEventTypes.Oracle.PricePruned, 1600, start,
+		oracle.PricePrunedEvent{Provider: feederA, Asset: "ATOM", Source: "elys", Price: "9"})
+
+	metrics, err := db.GetFeederMetrics(feederA, "", 0, 0)
+	require.NoError(t, err)
+	require.Equal(t, []FeederMetrics{
+		{
+			Feeder: feederA, Asset: "ATOM", FromHeight: 0, ToHeight: 999,
+			Feeds: 2, FedBlocks: 2, ActiveBlocks: 2, Uptime: "1.000000000000000000",
+			MedianDeviation: "0.250000000000000000", MaxMedianDeviation: "0.250000000000000000",
+			SpotDeviation: "0.050000000000000000", MaxSpotDeviation: "0.100000000000000000",
+			LastFeedHeight: 102,
+		},
+		{
+			Feeder: feederA, Asset: "ATOM", FromHeight: 1000, ToHeight: 1999,
+			ActiveBlocks: 1, MissedBlocks: 1, Uptime: "0.000000000000000000", StaleFeeds: 1,
+		},
+	}, metrics)
+
+	metrics, err = db.GetFeederMetrics(feederA, "ATOM", 1000, 0)
+	require.NoError(t, err)
+	require.Len(t, metrics, 1)
+	require.Equal(t, int64(1000), metrics[0].FromHeight)
+
+	metrics, err = db.GetAssetFeederMetrics("ATOM", 0, 0)
+	require.NoError(t, err)
+	require.Len(t, metrics, 2)
+	require.Equal(t, feederA, metrics[0].Feeder)
+	require.Equal(t, uint64(3), metrics[0].ActiveBlocks)
+	require.Equal(t, uint64(1), metrics[0].MissedBlocks)
+	require.Equal(t, uint64(1), metrics[0].StaleFeeds)
+	b := metrics[1]
+	require.Equal(t, feederB, b.Feeder)
+	require.Equal(t, int64(0), b.FromHeight)
+	require.Equal(t, int64(1999), b.ToHeight)
+	require.Equal(t, uint64(2), b.Feeds)
+	require.Equal(t, uint64(1), b.MissedBlocks)
+	require.Equal(t, "0.666666666666666666", b.Uptime)
+	require.Equal(t, "0.200000000000000000", b.MedianDeviation)
+	require.Equal(t, "0.100000000000000000", b.SpotDeviation)
+	require.Equal(t, "0.200000000000000000", b.MaxSpotDeviation)
+
+	metrics, err = db.GetAssetFeederMetrics("OSMO", 0, 0)
+	require.NoError(t, err)
+	require.Empty(t, metrics)
+
+	require.JSONEq(t, `{"data":{"assetFeeders":[{"feeder":"elys1feedera","missed_blocks":1},{"feeder":"elys1feederb","missed_blocks":1}]}}`,
+		executeGraphQL(t, db, `{ assetFeeders(asset: "ATOM") { feeder missed_blocks } }`, nil))
+}
@@ -0,0 +1,932 @@
+package indexer
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bmatsuo/lmdb-go/lmdb"
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/ast"
+
+	indexerTypes "github.com/elys-network/elys/indexer/types"
+)
+
+// The GraphQL API serves the indexer store with a schema generated from the
+// record types of the transaction and event registries:
+//
+//	records(address: "elys1...", type: "/elys.perpetual.MsgOpen", first: 10) {
+//	  edges { cursor node { height time data { ... on PerpetualMsgOpen { leverage } } } }
+//	  pageInfo { hasNextPage endCursor }
+//	}
+//
+// Object types are named after their Go struct, prefixed with the module for
+// the types of indexer/txs, and their fields after the JSON field names. The
+// schema is executed by graphql-go, this file only maps the Go types and
+// resolves the fields.
+
+// Records page sizes of the records connection
+const (
+	gqlDefaultPageSize = 50
+	gqlMaxPageSize     = 500
+)
+
+// gqlMaxRequestSize bounds the body of GraphQL HTTP requests
+const gqlMaxRequestSize = 1 << 20
+
+// graphQLSchema is built on first use, once every record type is registered
+var graphQLSchema = sync.OnceValue(buildGraphQLSchema)
+
+// gqlDBKey is the context key of the database resolvers read from
+type gqlDBKey struct{}
+
+// gqlDB returns the database of the request being resolved
+func gqlDB(p graphql.ResolveParams) *LMDBManager {
+	return p.Context.Value(gqlDBKey{}).(*LMDBManager)
+}
+
+// gqlRequest is the body of a GraphQL HTTP request
+type gqlRequest struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+// Scalars added to the built-in ones. GraphQL Int values are 32-bit, Go
+// integers wider than that are served as Long.
+var (
+	gqlLong = graphql.NewScalar(graphql.ScalarConfig{
+		Name:         "Long",
+		Description:  "A 64-bit integer, clients on 53-bit number types should read large values with care",
+		Serialize:    coerceLong,
+		ParseValue:   coerceLong,
+		ParseLiteral: parseLongLiteral,
+	})
+	gqlTime = graphql.NewScalar(graphql.ScalarConfig{
+		Name:        "Time",
+		Description: "An RFC3339 timestamp",
+		Serialize: func(value interface{}) interface{} {
+			if t, ok := value.(time.Time); ok {
+				return t.UTC().Format(time.RFC3339Nano)
+			}
+			return nil
+		},
+		ParseValue:   func(value interface{}) interface{} { return value },
+		ParseLiteral: func(valueAST ast.Value) interface{} { return valueAST.GetValue() },
+	})
+	gqlJSON = graphql.NewScalar(graphql.ScalarConfig{
+		Name:         "JSON",
+		Description:  "Any JSON value",
+		Serialize:    func(value interface{}) interface{} { return value },
+		ParseValue:   func(value interface{}) interface{} { return value },
+		ParseLiteral: func(valueAST ast.Value) interface{} { return valueAST.GetValue() },
+	})
+)
+
+// coerceLong converts integers, and floats without a fraction such as JSON
+// numbers, to int64 or uint64. Other values give nil, which graphql-go
+// reports as invalid.
+func coerceLong(value interface{}) interface{} {
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return v.Uint()
+	case reflect.Float32, reflect.Float64:
+		if f := v.Float(); f == math.Trunc(f) && math.Abs(f) < 1<<63 {
+			return int64(f)
+		}
+	}
+	return nil
+}
+
+func parseLongLiteral(valueAST ast.Value) interface{} {
+	if value, ok := valueAST.(*ast.IntValue); ok {
+		if i, err := strconv.ParseInt(value.Value, 10, 64); err == nil {
+			return i
+		}
+	}
+	return nil
+}
+
+// graphQLServer is the running GraphQL API server, if enabled
+var graphQLServer *http.Server
+
+// gqlRecord is the source of Record objects
+type gqlRecord struct {
+	Index  uint64
+	Record indexerTypes.GenericRecord
+}
+
+// gqlRecordConnection is the source of RecordConnection objects
+type gqlRecordConnection struct {
+	Records         []gqlRecord
+	HasNextPage     bool
+	HasPreviousPage bool
+}
+
+// recordFilter selects the records of the records query. Times are bounded as
+// [FromTime, ToTime) and heights as [FromHeight, ToHeight], zero values leave
+// a bound open.
+type recordFilter struct {
+	Address    string
+	Type       string
+	FromTime   time.Time
+	ToTime     time.Time
+	FromHeight int64
+	ToHeight   int64
+}
+
+func (f recordFilter) matches(record indexerTypes.GenericRecord) bool {
+	if f.Type != "" && record.Type() != f.Type {
+		return false
+	}
+	height := record.BlockHeight()
+	if (f.FromHeight > 0 && height < f.FromHeight) || (f.ToHeight > 0 && height > f.ToHeight) {
+		return false
+	}
+	blockTime := record.BlockTime()
+	return (f.FromTime.IsZero() || !blockTime.Before(f.FromTime)) && (f.ToTime.IsZero() || blockTime.Before(f.ToTime))
+}
+
+// gqlSchemaBuilder maps Go types to GraphQL types
+type gqlSchemaBuilder struct {
+	objects map[reflect.Type]*graphql.Object
+	names   map[string]reflect.Type
+}
+
+const txsPackagePath = "github.com/elys-network/elys/indexer/txs/"
+
+var gqlTimeType = reflect.TypeOf(time.Time{})
+
+// gqlTypeName names the object type of a struct, e.g. PerpetualMsgOpen for
+// the MsgOpen struct of indexer/txs/perpetual
+func gqlTypeName(t reflect.Type) string {
+	if strings.HasPrefix(t.PkgPath(), txsPackagePath) {
+		module := strings.SplitN(strings.TrimPrefix(t.PkgPath(), txsPackagePath), "/", 2)[0]
+		return strings.ToUpper(module[:1]) + module[1:] + t.Name()
+	}
+	return t.Name()
+}
+
+// outputType returns the GraphQL type of a Go type. Values are never null
+// unless the Go type is a pointer, slice, map or interface.
+func (b *gqlSchemaBuilder) outputType(t reflect.Type) graphql.Output {
+	if t.Kind() == reflect.Ptr {
+		return b.nullableType(t.Elem())
+	}
+	switch t.Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map, reflect.Interface:
+		return b.nullableType(t)
+	}
+	return graphql.NewNonNull(b.nullableType(t))
+}
+
+func (b *gqlSchemaBuilder) nullableType(t reflect.Type) graphql.Output {
+	switch t.Kind() {
+	case reflect.Ptr:
+		return b.nullableType(t.Elem())
+	case reflect.String:
+		return graphql.String
+	case reflect.Bool:
+		return graphql.Boolean
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint8, reflect.Uint16:
+		return graphql.Int
+	case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint32, reflect.Uint64:
+		return gqlLong
+	case reflect.Float32, reflect.Float64:
+		return graphql.Float
+	case reflect.Slice, reflect.Array:
+		return graphql.NewList(b.outputType(t.Elem()))
+	case reflect.Struct:
+		if t == gqlTimeType {
+			return gqlTime
+		}
+		return b.objectType(t)
+	}
+	return gqlJSON
+}
+
+// objectType generates the object type of a struct from its exported fields,
+// flattening embedded structs the way encoding/json does
+func (b *gqlSchemaBuilder) objectType(t reflect.Type) *graphql.Object {
+	if object, ok := b.objects[t]; ok {
+		return object
+	}
+	name := gqlTypeName(t)
+	if other, ok := b.names[name]; ok {
+		panic(fmt.Sprintf("GraphQL type name %s is used by %s and %s", name, other, t))
+	}
+	// Fields are added once the object is registered, so that recursive
+	// types resolve to it
+	object := graphql.NewObject(graphql.ObjectConfig{Name: name, Fields: graphql.Fields{}})
+	b.objects[t] = object
+	b.names[name] = t
+
+	depth := make(map[string]int)
+	for _, field := range reflect.VisibleFields(t) {
+		if !field.IsExported() {
+			continue
+		}
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		fieldName := strings.Split(tag, ",")[0]
+		if fieldName == "" {
+			if field.Anonymous && field.Type.Kind() == reflect.Struct {
+				continue // Promoted fields are visited on their own
+			}
+			fieldName = field.Name
+		}
+		// A shallower field hides the deeper ones of the same name
+		if d, ok := depth[fieldName]; ok && d <= len(field.Index) {
+			continue
+		}
+		depth[fieldName] = len(field.Index)
+		object.AddFieldConfig(fieldName, &graphql.Field{Type: b.outputType(field.Type), Resolve: gqlStructField(field.Index)})
+	}
+	return object
+}
+
+// gqlStructField resolves a field of a struct source by its index path
+func gqlStructField(index []int) graphql.FieldResolveFn {
+	return func(p graphql.ResolveParams) (interface{}, error) {
+		v := reflect.ValueOf(p.Source)
+		for v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				return nil, nil
+			}
+			v = v.Elem()
+		}
+		field, err := v.FieldByIndexErr(index)
+		if err != nil {
+			return nil, nil // Nil embedded pointer
+		}
+		return field.Interface(), nil
+	}
+}
+
+// buildGraphQLSchema generates the schema from the registered record types
+func buildGraphQLSchema() graphql.Schema {
+	b := &gqlSchemaBuilder{objects: make(map[reflect.Type]*graphql.Object), names: make(map[string]reflect.Type)}
+
+	// Every registered transaction and event type is a member of RecordData
+	var members []*graphql.Object
+	seen := make(map[*graphql.Object]bool)
+	for _, registry := range []map[string]reflect.Type{txRegistry, eventRegistry} {
+		for _, dataType := range registry {
+			if member := b.objectType(dataType); !seen[member] {
+				seen[member] = true
+				members = append(members, member)
+			}
+		}
+	}
+	sort.Slice(members, func(i, j int) bool { return members[i].Name() < members[j].Name() })
+	dataUnion := graphql.NewUnion(graphql.UnionConfig{
+		Name:        "RecordData",
+		Description: "The decoded data of a record, one member per transaction and event type",
+		Types:       members,
+		ResolveType: func(p graphql.ResolveTypeParams) *graphql.Object {
+			t := reflect.TypeOf(p.Value)
+			for t.Kind() == reflect.Ptr {
+				t = t.Elem()
+			}
+			return b.objects[t]
+		},
+	})
+
+	baseTransaction := b.objectType(reflect.TypeOf(indexerTypes.BaseTransaction{}))
+	baseEvent := b.objectType(reflect.TypeOf(indexerTypes.BaseEvent{}))
+	ledgerEntry := b.objectType(reflect.TypeOf(indexerTypes.LedgerEntry{}))
+
+	recordOf := func(p graphql.ResolveParams) indexerTypes.GenericRecord { return p.Source.(gqlRecord).Record }
+	record := graphql.NewObject(graphql.ObjectConfig{Name: "Record", Description: "An indexed transaction or event", Fields: graphql.Fields{
+		"index": &graphql.Field{Type: graphql.NewNonNull(gqlLong), Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			return p.Source.(gqlRecord).Index, nil
+		}},
+		"type": &graphql.Field{Type: graphql.NewNonNull(graphql.String), Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			return recordOf(p).Type(), nil
+		}},
+		"height": &graphql.Field{Type: graphql.NewNonNull(gqlLong), Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			return recordOf(p).BlockHeight(), nil
+		}},
+		"time": &graphql.Field{Type: graphql.NewNonNull(gqlTime), Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			return recordOf(p).BlockTime(), nil
+		}},
+		"hash": &graphql.Field{Description: "Transaction hash or event ID", Type: graphql.NewNonNull(graphql.String), Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			return recordHash(recordOf(p)), nil
+		}},
+		"transaction": &graphql.Field{Type: baseTransaction, Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			if record := recordOf(p); record.IsTransaction() {
+				return record.Transaction.BaseTransaction, nil
+			}
+			return nil, nil
+		}},
+		"event": &graphql.Field{Type: baseEvent, Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			if record := recordOf(p); record.IsEvent() {
+				return record.Event.BaseEvent, nil
+			}
+			return nil, nil
+		}},
+		"data": &graphql.Field{Type: dataUnion, Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			data, ok := recordData(recordOf(p))
+			if !ok {
+				return nil, nil
+			}
+			return data, nil
+		}},
+		"ledger": &graphql.Field{Description: "Debit and credit lines of the record, fees included", Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(ledgerEntry))), Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			entries := RecordLedgerEntries(recordOf(p))
+			if entries == nil {
+				entries = []indexerTypes.LedgerEntry{}
+			}
+			return entries, nil
+		}},
+	}})
+
+	pageInfo := graphql.NewObject(graphql.ObjectConfig{Name: "PageInfo", Fields: graphql.Fields{
+		"hasNextPage": &graphql.Field{Type: graphql.NewNonNull(graphql.Boolean), Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			return p.Source.(gqlRecordConnection).HasNextPage, nil
+		}},
+		"hasPreviousPage": &graphql.Field{Type: graphql.NewNonNull(graphql.Boolean), Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			return p.Source.(gqlRecordConnection).HasPreviousPage, nil
+		}},
+		"startCursor": &graphql.Field{Type: graphql.String, Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			if records := p.Source.(gqlRecordConnection).Records; len(records) > 0 {
+				return encodeRecordCursor(records[0].Index), nil
+			}
+			return nil, nil
+		}},
+		"endCursor": &graphql.Field{Type: graphql.String, Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			if records := p.Source.(gqlRecordConnection).Records; len(records) > 0 {
+				return encodeRecordCursor(records[len(records)-1].Index), nil
+			}
+			return nil, nil
+		}},
+	}})
+	recordEdge := graphql.NewObject(graphql.ObjectConfig{Name: "RecordEdge", Fields: graphql.Fields{
+		"cursor": &graphql.Field{Type: graphql.NewNonNull(graphql.String), Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			return encodeRecordCursor(p.Source.(gqlRecord).Index), nil
+		}},
+		"node": &graphql.Field{Type: graphql.NewNonNull(record), Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			return p.Source, nil
+		}},
+	}})
+	recordConnection := graphql.NewObject(graphql.ObjectConfig{Name: "RecordConnection", Fields: graphql.Fields{
+		"edges": &graphql.Field{Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(recordEdge))), Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			return p.Source.(gqlRecordConnection).Records, nil
+		}},
+		"nodes": &graphql.Field{Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(record))), Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			return p.Source.(gqlRecordConnection).Records, nil
+		}},
+		"pageInfo": &graphql.Field{Type: graphql.NewNonNull(pageInfo), Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			return p.Source, nil
+		}},
+	}})
+
+	// Position entries link to the record they were built from
+	positionHistory := b.objectType(reflect.TypeOf(PositionHistory{}))
+	positionEntry := b.objectType(reflect.TypeOf(PositionEntry{}))
+	positionEntry.AddFieldConfig("record", &graphql.Field{Type: record, Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+		index := p.Source.(PositionEntry).Index
+		data, err := gqlDB(p).GetRecordByIndex(index)
+		if err != nil {
+			return nil, err
+		}
+		return gqlRecord{Index: index, Record: data}, nil
+	}})
+	pnlBucket := b.objectType(reflect.TypeOf(PnLBucket{}))
+	revenueBucket := b.objectType(reflect.TypeOf(RevenueBucket{}))
+	paramHistoryEntry := b.objectType(reflect.TypeOf(ParamHistoryEntry{}))
+	feederMetrics := b.objectType(reflect.TypeOf(FeederMetrics{}))
+	balanceCheckpoint := b.objectType(reflect.TypeOf(BalanceCheckpoint{}))
+
+	query := graphql.NewObject(graphql.ObjectConfig{Name: "Query", Fields: graphql.Fields{
+		"record": &graphql.Field{
+			Type: record,
+			Args: graphql.FieldConfigArgument{"index": &graphql.ArgumentConfig{Type: graphql.NewNonNull(gqlLong)}},
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				index := p.Args["index"].(int64)
+				if index <= 0 {
+					return nil, nil
+				}
+				data, err := gqlDB(p).GetRecordByIndex(uint64(index))
+				if lmdb.IsNotFound(err) {
+					return nil, nil
+				} else if err != nil {
+					return nil, err
+				}
+				return gqlRecord{Index: uint64(index), Record: data}, nil
+			},
+		},
+		"records": &graphql.Field{
+			Description: "Records in index order, paginated forward with first and after or backward with last and before",
+			Type:        graphql.NewNonNull(recordConnection),
+			Args: graphql.FieldConfigArgument{
+				"address":    &graphql.ArgumentConfig{Description: "Address involved in the record", Type: graphql.String},
+				"type":       &graphql.ArgumentConfig{Description: "Transaction or event type, e.g. /elys.amm.MsgSwapByDenom", Type: graphql.String},
+				"fromTime":   &graphql.ArgumentConfig{Description: "Inclusive lower block time bound, unix seconds or RFC3339", Type: graphql.String},
+				"toTime":     &graphql.ArgumentConfig{Description: "Exclusive upper block time bound, unix seconds or RFC3339", Type: graphql.String},
+				"fromHeight": &graphql.ArgumentConfig{Description: "Inclusive lower block height bound", Type: gqlLong},
+				"toHeight":   &graphql.ArgumentConfig{Description: "Inclusive upper block height bound", Type: gqlLong},
+				"first":      &graphql.ArgumentConfig{Type: graphql.Int},
+				"after":      &graphql.ArgumentConfig{Type: graphql.String},
+				"last":       &graphql.ArgumentConfig{Type: graphql.Int},
+				"before":     &graphql.ArgumentConfig{Type: graphql.String},
+			},
+			Resolve: resolveRecords,
+		},
+		"positions": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(positionHistory))),
+			Args: graphql.FieldConfigArgument{
+				"address": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				"status":  &graphql.ArgumentConfig{Description: "open or closed, every position when omitted", Type: graphql.String},
+			},
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				status, _ := p.Args["status"].(string)
+				if status != "" && status != PositionStatusOpen && status != PositionStatusClosed {
+					return nil, fmt.Errorf("invalid status: %s", status)
+				}
+				result, err := gqlDB(p).GetPositions(p.Args["address"].(string), status)
+				if result == nil {
+					result = []PositionHistory{}
+				}
+				return result, err
+			},
+		},
+		"position": &graphql.Field{
+			Type: positionHistory,
+			Args: graphql.FieldConfigArgument{
+				"address": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				"id":      &graphql.ArgumentConfig{Type: graphql.NewNonNull(gqlLong)},
+			},
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				id := p.Args["id"].(int64)
+				if id < 0 {
+					return nil, nil
+				}
+				history, err := gqlDB(p).GetPositionHistory(p.Args["address"].(string), uint64(id))
+				if err != nil {
+					return nil, nil
+				}
+				return history, nil
+			},
+		},
+		"pnl": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(pnlBucket))),
+			Args: graphql.FieldConfigArgument{
+				"address":   &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				"dimension": &graphql.ArgumentConfig{Description: "day, asset or module", Type: graphql.String, DefaultValue: PnLByDay},
+				"from":      &graphql.ArgumentConfig{Description: "First bucket, e.g. the day 2024-01-01", Type: graphql.String},
+				"to":        &graphql.ArgumentConfig{Description: "Last bucket", Type: graphql.String},
+			},
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				from, _ := p.Args["from"].(string)
+				to, _ := p.Args["to"].(string)
+				result, err := gqlDB(p).GetPnL(p.Args["address"].(string), p.Args["dimension"].(string), from, to)
+				if result == nil {
+					result = []PnLBucket{}
+				}
+				return result, err
+			},
+		},
+		"revenue": &graphql.Field{
+			Description: "Daily protocol revenue by source or by pool",
+			Type:        graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(revenueBucket))),
+			Args: graphql.FieldConfigArgument{
+				"dimension": &graphql.ArgumentConfig{Description: "source or pool", Type: graphql.String, DefaultValue: RevenueBySource},
+				"from":      &graphql.ArgumentConfig{Description: "First day, e.g. 2024-01-01", Type: graphql.String},
+				"to":        &graphql.ArgumentConfig{Description: "Last day", Type: graphql.String},
+				"bucket":    &graphql.ArgumentConfig{Description: "A single source (gas, perp or dex) or pool id", Type: graphql.String},
+			},
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				from, _ := p.Args["from"].(string)
+				to, _ := p.Args["to"].(string)
+				bucket, _ := p.Args["bucket"].(string)
+				result, err := gqlDB(p).GetRevenue(p.Args["dimension"].(string), from, to, bucket)
+				if result == nil {
+					result = []RevenueBucket{}
+				}
+				return result, err
+			},
+		},
+		"paramHistory": &graphql.Field{
+			Description: "Parameter changes of a module, newest first",
+			Type:        graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(paramHistoryEntry))),
+			Args: graphql.FieldConfigArgument{
+				"module": &graphql.ArgumentConfig{Description: "Module name, e.g. perpetual", Type: graphql.NewNonNull(graphql.String)},
+				"field":  &graphql.ArgumentConfig{Description: "JSON path of the parameter, e.g. fixed_funding_rate", Type: graphql.String},
+			},
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				field, _ := p.Args["field"].(string)
+				return gqlDB(p).GetParamHistory(p.Args["module"].(string), field)
+			},
+		},
+		"feederMetrics": &graphql.Field{
+			Description: "Metrics of an oracle price feeder per asset and block window",
+			Type:        graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(feederMetrics))),
+			Args: graphql.FieldConfigArgument{
+				"feeder":     &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				"asset":      &graphql.ArgumentConfig{Description: "Restricts the metrics to one asset", Type: graphql.String},
+				"fromHeight": &graphql.ArgumentConfig{Description: "Inclusive lower block height bound", Type: gqlLong},
+				"toHeight":   &graphql.ArgumentConfig{Description: "Inclusive upper block height bound", Type: gqlLong},
+			},
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				asset, _ := p.Args["asset"].(string)
+				fromHeight, _ := p.Args["fromHeight"].(int64)
+				toHeight, _ := p.Args["toHeight"].(int64)
+				return gqlDB(p).GetFeederMetrics(p.Args["feeder"].(string), asset, fromHeight, toHeight)
+			},
+		},
+		"assetFeeders": &graphql.Field{
+			Description: "Metrics of every oracle price feeder of an asset over a block range",
+			Type:        graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(feederMetrics))),
+			Args: graphql.FieldConfigArgument{
+				"asset":      &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				"fromHeight": &graphql.ArgumentConfig{Description: "Inclusive lower block height bound", Type: gqlLong},
+				"toHeight":   &graphql.ArgumentConfig{Description: "Inclusive upper block height bound", Type: gqlLong},
+			},
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				fromHeight, _ := p.Args["fromHeight"].(int64)
+				toHeight, _ := p.Args["toHeight"].(int64)
+				return gqlDB(p).GetAssetFeederMetrics(p.Args["asset"].(string), fromHeight, toHeight)
+			},
+		},
+		"balanceAt": &graphql.Field{
+			Description: "Balance of an address in a denom at a block height or time, the latest one without either. Requires ledger mode.",
+			Type:        graphql.NewNonNull(balanceCheckpoint),
+			Args: graphql.FieldConfigArgument{
+				"address": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				"denom":   &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				"height":  &graphql.ArgumentConfig{Type: gqlLong},
+				"time":    &graphql.ArgumentConfig{Description: "Unix seconds or RFC3339", Type: graphql.String},
+			},
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				height, at, err := gqlBalancePoint(p)
+				if err != nil {
+					return nil, err
+				}
+				if at.IsZero() {
+					return gqlDB(p).GetBalanceAt(p.Args["address"].(string), p.Args["denom"].(string), height)
+				}
+				return gqlDB(p).GetBalanceAtTime(p.Args["address"].(string), p.Args["denom"].(string), at)
+			},
+		},
+		"balances": &graphql.Field{
+			Description: "Non zero balances of an address at a block height or time, the latest ones without either. Requires ledger mode.",
+			Type:        graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(balanceCheckpoint))),
+			Args: graphql.FieldConfigArgument{
+				"address": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				"height":  &graphql.ArgumentConfig{Type: gqlLong},
+				"time":    &graphql.ArgumentConfig{Description: "Unix seconds or RFC3339", Type: graphql.String},
+			},
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				height, at, err := gqlBalancePoint(p)
+				if err != nil {
+					return nil, err
+				}
+				return gqlDB(p).GetBalancesAt(p.Args["address"].(string), height, at)
+			},
+		},
+	}})
+
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{Query: query})
+	if err != nil {
+		panic(fmt.Errorf("invalid GraphQL schema: %w", err))
+	}
+	return schema
+}
+
+// gqlBalancePoint reads the height or time argument of the balance queries
+func gqlBalancePoint(p graphql.ResolveParams) (int64, time.Time, error) {
+	height, _ := p.Args["height"].(int64)
+	value, _ := p.Args["time"].(string)
+	at, err := queryTime(value, time.Time{})
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+	if height != 0 && !at.IsZero() {
+		return 0, time.Time{}, fmt.Errorf("height and time are exclusive")
+	}
+	return height, at, nil
+}
+
+// resolveRecords pages through the records matching the filter arguments
+func resolveRecords(p graphql.ResolveParams) (interface{}, error) {
+	var filter recordFilter
+	var err error
+	filter.Address, _ = p.Args["address"].(string)
+	filter.Type, _ = p.Args["type"].(string)
+	fromTime, _ := p.Args["fromTime"].(string)
+	if filter.FromTime, err = queryTime(fromTime, time.Time{}); err != nil {
+		return nil, err
+	}
+	toTime, _ := p.Args["toTime"].(string)
+	if filter.ToTime, err = queryTime(toTime, time.Time{}); err != nil {
+		return nil, err
+	}
+	filter.FromHeight, _ = p.Args["fromHeight"].(int64)
+	filter.ToHeight, _ = p.Args["toHeight"].(int64)
+
+	first, hasFirst := p.Args["first"].(int)
+	last, hasLast := p.Args["last"].(int)
+	if hasFirst && hasLast {
+		return nil, fmt.Errorf("first and last cannot be used together")
+	}
+	limit := gqlDefaultPageSize
+	if hasFirst {
+		limit = first
+	} else if hasLast {
+		limit = last
+	}
+	if limit < 0 || limit > gqlMaxPageSize {
+		return nil, fmt.Errorf("page size must be between 0 and %d", gqlMaxPageSize)
+	}
+
+	// Backward pagination starts right before the before cursor
+	var after, before uint64
+	if cursor, ok := p.Args["after"].(string); ok {
+		if after, err = decodeRecordCursor(cursor); err != nil {
+			return nil, err
+		}
+	}
+	if cursor, ok := p.Args["before"].(string); ok {
+		if before, err = decodeRecordCursor(cursor); err != nil {
+			return nil, err
+		}
+	}
+	reverse := hasLast || (before > 0 && !hasFirst)
+
+	var records []gqlRecord
+	if reverse {
+		start := uint64(math.MaxUint64)
+		if before > 0 {
+			start = before - 1
+		}
+		records, err = gqlDB(p).scanRecords(filter, start, true, limit+1)
+	} else {
+		records, err = gqlDB(p).scanRecords(filter, after+1, false, limit+1)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// The extra record tells whether another page follows
+	connection := gqlRecordConnection{Records: records}
+	if len(records) > limit {
+		connection.Records = records[:limit]
+		connection.HasNextPage = !reverse
+		connection.HasPreviousPage = reverse
+	}
+	// Stop at the other cursor when both are set
+	if after > 0 && before > 0 {
+		kept := connection.Records[:0]
+		for _, record := range connection.Records {
+			if record.Index > after && record.Index < before {
+				kept = append(kept, record)
+			}
+		}
+		connection.Records = kept
+	}
+	if reverse {
+		for i, j := 0, len(connection.Records)-1; i < j; i, j = i+1, j-1 {
+			connection.Records[i], connection.Records[j] = connection.Records[j], connection.Records[i]
+		}
+	}
+	return connection, nil
+}
+
+// scanRecords returns up to limit records matching filter, walking the
+// indices from start upward, or downward when reverse is set. The address or
+// type index is walked when the filter sets one, the whole store otherwise.
+func (m *LMDBManager) scanRecords(filter recordFilter, start uint64, reverse bool, limit int) ([]gqlRecord, error) {
+	var records []gqlRecord
+	err := m.view(func(txn *lmdb.Txn) error {
+		dbi, key := m.recordDB, []byte(nil)
+		if filter.Address != "" {
+			dbi, key = m.addressDB, []byte(filter.Address)
+		} else if filter.Type != "" {
+			dbi, key = m.typeDB, []byte(filter.Type)
+		}
+		cursor, err := txn.OpenCursor(dbi)
+		if err != nil {
+			return err
+		}
+		defer cursor.Close()
+
+		startBytes := make([]byte, 8)
+		binary.BigEndian.PutUint64(startBytes, start)
+
+		// In the index databases the record index is the duplicate value of
+		// the key, in the record database it is the key itself
+		var k, v []byte
+		var next uint = lmdb.Next
+		if key != nil {
+			next = lmdb.NextDup
+			if reverse {
+				next = lmdb.PrevDup
+			}
+			k, v, err = cursor.Get(key, startBytes, lmdb.GetBothRange)
+			if reverse && err == nil && binary.BigEndian.Uint64(v) > start {
+				k, v, err = cursor.Get(nil, nil, lmdb.PrevDup)
+			} else if reverse && lmdb.IsNotFound(err) {
+				if _, _, err = cursor.Get(key, nil, lmdb.SetKey); err == nil {
+					k, v, err = cursor.Get(nil, nil, lmdb.LastDup)
+				}
+			}
+		} else {
+			if reverse {
+				next = lmdb.Prev
+			}
+			k, v, err = cursor.Get(startBytes, nil, lmdb.SetRange)
+			if reverse && err == nil && binary.BigEndian.Uint64(k) > start {
+				k, v, err = cursor.Get(nil, nil, lmdb.Prev)
+			} else if reverse && lmdb.IsNotFound(err) {
+				k, v, err = cursor.Get(nil, nil, lmdb.Last)
+			}
+		}
+
+		for ; err == nil && len(records) < limit; k, v, err = cursor.Get(nil, nil, next) {
+			indexBytes := k
+			if key != nil {
+				indexBytes = v
+			}
+			index := binary.BigEndian.Uint64(indexBytes)
+			record, err := m.getRecordInTxn(txn, index)
+			if err != nil {
+				return fmt.Errorf("error getting record by index %d: %v", index, err)
+			}
+			if filter.matches(record) {
+				records = append(records, gqlRecord{Index: index, Record: record})
+			}
+		}
+		if err != nil && !lmdb.IsNotFound(err) {
+			return err
+		}
+		return nil
+	})
+	return records, err
+}
+
+const recordCursorPrefix = "record:"
+
+func encodeRecordCursor(index uint64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(recordCursorPrefix + strconv.FormatUint(index, 10)))
+}
+
+func decodeRecordCursor(cursor string) (uint64, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err == nil && strings.HasPrefix(string(decoded), recordCursorPrefix) {
+		if index, err := strconv.ParseUint(strings.TrimPrefix(string(decoded), recordCursorPrefix), 10, 64); err == nil {
+			return index, nil
+		}
+	}
+	return 0, fmt.Errorf("invalid cursor %q", cursor)
+}
+
+// runGraphQL executes a request against db. The schema has no mutation type,
+// the indexer store is read-only.
+func runGraphQL(ctx context.Context, db *LMDBManager, request gqlRequest) *graphql.Result {
+	return graphql.Do(graphql.Params{
+		Schema:         graphQLSchema(),
+		RequestString:  request.Query,
+		VariableValues: request.Variables,
+		OperationName:  request.OperationName,
+		Context:        context.WithValue(ctx, gqlDBKey{}, db),
+	})
+}
+
+// NewGraphQLHandler returns an http.Handler serving the GraphQL API from db on
+// /graphql, and the schema in the schema definition language on /graphql/schema
+func NewGraphQLHandler(db *LMDBManager) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /graphql", func(w http.ResponseWriter, r *http.Request) {
+		var request gqlRequest
+		body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, gqlMaxRequestSize))
+		if err != nil {
+			writeGraphQLError(w, err.Error())
+			return
+		}
+		if strings.HasPrefix(r.Header.Get("Content-Type"), "application/graphql") {
+			request.Query = string(body)
+		} else if err := json.Unmarshal(body, &request); err != nil {
+			writeGraphQLError(w, fmt.Sprintf("invalid request body: %v", err))
+			return
+		}
+		writeGraphQL(w, http.StatusOK, runGraphQL(r.Context(), db, request))
+	})
+	mux.HandleFunc("GET /graphql", func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		request := gqlRequest{Query: query.Get("query"), OperationName: query.Get("operationName")}
+		if variables := query.Get("variables"); variables != "" {
+			if err := json.Unmarshal([]byte(variables), &request.Variables); err != nil {
+				writeGraphQLError(w, fmt.Sprintf("invalid variables: %v", err))
+				return
+			}
+		}
+		writeGraphQL(w, http.StatusOK, runGraphQL(r.Context(), db, request))
+	})
+	mux.HandleFunc("GET /graphql/schema", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		_, _ = io.WriteString(w, printGraphQLSchema(graphQLSchema()))
+	})
+	return mux
+}
+
+func writeGraphQLError(w http.ResponseWriter, message string) {
+	writeGraphQL(w, http.StatusBadRequest, &graphql.Result{Errors: []gqlerrors.FormattedError{{Message: message}}})
+}
+
+func writeGraphQL(w http.ResponseWriter, status int, result *graphql.Result) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(result); err != nil {
+		fmt.Printf("failed to write GraphQL response: %v\n", err)
+	}
+}
+
+// printGraphQLSchema renders the types of a schema in the GraphQL schema
+// definition language, leaving out the built-in and introspection types
+func printGraphQLSchema(schema graphql.Schema) string {
+	builtin := map[string]bool{"String": true, "Int": true, "Float": true, "Boolean": true, "ID": true}
+	typeMap := schema.TypeMap()
+	names := make([]string, 0, len(typeMap))
+	for name := range typeMap {
+		if !builtin[name] && !strings.HasPrefix(name, "__") {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	var b strings.Builder
+	for _, name := range names {
+		t := typeMap[name]
+		if t.Description() != "" {
+			fmt.Fprintf(&b, "%q\n", t.Description())
+		}
+		switch t := t.(type) {
+		case *graphql.Scalar:
+			fmt.Fprintf(&b, "scalar %s\n\n", name)
+		case *graphql.Union:
+			members := make([]string, len(t.Types()))
+			for i, member := range t.Types() {
+				members[i] = member.Name()
+			}
+			fmt.Fprintf(&b, "union %s = %s\n\n", name, strings.Join(members, " | "))
+		case *graphql.Object:
+			fields := t.Fields()
+			fieldNames := make([]string, 0, len(fields))
+			for fieldName := range fields {
+				fieldNames = append(fieldNames, fieldName)
+			}
+			sort.Strings(fieldNames)
+			fmt.Fprintf(&b, "type %s {\n", name)
+			for _, fieldName := range fieldNames {
+				field := fields[fieldName]
+				if field.Description != "" {
+					fmt.Fprintf(&b, "  %q\n", field.Description)
+				}
+				fmt.Fprintf(&b, "  %s", fieldName)
+				if len(field.Args) > 0 {
+					args := make([]string, len(field.Args))
+					for i, arg := range field.Args {
+						args[i] = arg.Name() + ": " + arg.Type.String()
+						if arg.DefaultValue != nil {
+							literal, _ := json.Marshal(arg.DefaultValue)
+							args[i] += " = " + string(literal)
+						}
+					}
+					sort.Strings(args)
+					fmt.Fprintf(&b, "(%s)", strings.Join(args, ", "))
+				}
+				fmt.Fprintf(&b, ": %s\n", field.Type)
+			}
+			b.WriteString("}\n\n")
+		}
+	}
+	return b.String()
+}
+
+// startGraphQLServer serves the GraphQL API on addr in the background
+func startGraphQLServer(addr string, db *LMDBManager) {
+	graphQLServer = startHTTPServer("GraphQL API", addr, NewGraphQLHandler(db))
+}
+
+// stopGraphQLServer shuts the GraphQL API down if it is running
+func stopGraphQLServer() {
+	stopHTTPServer(graphQLServer)
+}
@@ -0,0 +1,177 @@
+package indexer
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/graphql-go/graphql"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elys-network/elys/indexer/txs/perpetual"
+	indexerTypes "github.com/elys-network/elys/indexer/types"
+)
+
+func executeGraphQL(t *testing.T, db *LMDBManager, query string, variables map[string]interface{}) string {
+	response := runGraphQL(context.Background(), db, gqlRequest{Query: query, Variables: variables})
+	result, err := json.Marshal(response)
+	require.NoError(t, err)
+	return string(result)
+}
+
+func TestGraphQLSchema(t *testing.T) {
+	schema := graphQLSchema()
+
+	// Every registered record type is a member of the record data union
+	union := schema.Type("RecordData").(*graphql.Union)
+	for _, registry := range []map[string]reflect.Type{txRegistry, eventRegistry} {
+		for recordType, dataType := range registry {
+			require.Contains(t, union.Types(), schema.Type(gqlTypeName(dataType)), recordType)
+		}
+	}
+
+	// Embedded structs are flattened like in the JSON records
+	marketOrder := schema.Type("TradeshieldMarketOrderExecutionEvent").(*graphql.Object).Fields()
+	require.Contains(t, marketOrder, "order_id")
+	require.Contains(t, marketOrder, "order_amount")
+
+	// Go integers wider than 32 bits are served as Long
+	printed := printGraphQLSchema(schema)
+	require.Contains(t, printed, "type PerpetualMsgOpen {\n  collateral: Token!\n  creator: String!\n")
+	require.Contains(t, printed, "  position: Int!\n  position_id: Long!\n")
+}
+
+func TestGraphQLRecords(t *testing.T) {
+	db := newTestDatabase(t)
+	start := time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC)
+	const owner = "elys1owner"
+
+	for i, hash := range []string{"open1", "open2", "open3"} {
+		storeAuthoredTx(t, db, indexerTypes.BaseTransaction{
+			TxHash:      hash,
+			TxType:      "/elys.perpetual.MsgOpen",
+			Author:      owner,
+			BlockHeight: int64(10 + i),
+			BlockTime:   start.Add(time.Duration(i) * time.Hour),
+		}, perpetual.MsgOpen{
+			Creator:    owner,
+			Position:   perpetual.Position_LONG,
+			Collateral: indexerTypes.Token{Amount: "100", Denom: "uusdc"},
+			PositionID: uint64(i + 1),
+		})
+	}
+	storeAuthoredTx(t, db, indexerTypes.BaseTransaction{
+		TxHash:      "other",
+		TxType:      "/elys.perpetual.MsgUpdateStopLoss",
+		Author:      "elys1other",
+		BlockHeight: 12,
+		BlockTime:   start,
+	}, perpetual.MsgUpdateStopLoss{Creator: "elys1other", ID: 9, Price: "1.5"})
+
+	const query = `
+		query Opens($after: String) {
+			records(address: "elys1owner", type: "/elys.perpetual.MsgOpen", first: 2, after: $after) {
+				edges { node { ...open } }
+				pageInfo { hasNextPage endCursor }
+			}
+		}
+		fragment open on Record {
+			index
+			hash
+			data {
+				__typename
+				... on PerpetualMsgOpen { id: position_id collateral { amount } }
+			}
+		}`
+	require.JSONEq(t, `{"data":{"records":{
+		"edges":[
+			{"node":{"index":1,"hash":"open1","data":{"__typename":"PerpetualMsgOpen","id":1,"collateral":{"amount":"100"}}}},
+			{"node":{"index":2,"hash":"open2","data":{"__typename":"PerpetualMsgOpen","id":2,"collateral":{"amount":"100"}}}}
+		],
+		"pageInfo":{"hasNextPage":true,"endCursor":"`+encodeRecordCursor(2)+`"}
+	}}}`, executeGraphQL(t, db, query, nil))
+
+	require.JSONEq(t, `{"data":{"records":{
+		"edges":[{"node":{"index":3,"hash":"open3","data":{"__typename":"PerpetualMsgOpen","id":3,"collateral":{"amount":"100"}}}}],
+		"pageInfo":{"hasNextPage":false,"endCursor":"`+encodeRecordCursor(3)+`"}
+	}}}`, executeGraphQL(t, db, query, map[string]interface{}{"after": encodeRecordCursor(2)}))
+
+	// Backward pagination, height and time ranges over the whole store
+	require.JSONEq(t, `{"data":{"records":{"nodes":[{"index":3},{"index":4}],"pageInfo":{"hasPreviousPage":true}}}}`,
+		executeGraphQL(t, db, `{ records(last: 2) { nodes { index } pageInfo { hasPreviousPage } } }`, nil))
+	require.JSONEq(t, `{"data":{"records":{"nodes":[{"index":2},{"index":3}]}}}`,
+		executeGraphQL(t, db, `{ records(fromHeight: 11, toHeight: 12, before: "`+encodeRecordCursor(4)+`") { nodes { index } } }`, nil))
+	require.JSONEq(t, `{"data":{"records":{"nodes":[{"index":2}]}}}`,
+		executeGraphQL(t, db, `{ records(fromTime: "2024-07-01T01:00:00Z", toTime: "2024-07-01T02:00:00Z") { nodes { index } } }`, nil))
+
+	// Records carry their base transaction and ledger lines
+	require.JSONEq(t, `{"data":{"record":{"transaction":{"author":"elys1other","block_time":"2024-07-01T00:00:00Z"},"event":null,"ledger":[]}}}`,
+		executeGraphQL(t, db, `{ record(index: 4) { transaction { author block_time } event { EventID } ledger { amount } } }`, nil))
+}
+
+func TestGraphQLErrors(t *testing.T) {
+	db := newTestDatabase(t)
+
+	require.JSONEq(t, `{"data":null,"errors":[{"message":"Cannot query field \"unknown\" on type \"Record\".","locations":[{"line":1,"column":21}]}]}`,
+		executeGraphQL(t, db, `{ records { nodes { unknown } } }`, nil))
+	require.JSONEq(t, `{"data":null,"errors":[{"message":"Field \"data\" of type \"RecordData\" must have a sub selection.","locations":[{"line":1,"column":21}]}]}`,
+		executeGraphQL(t, db, `{ records { nodes { data } } }`, nil))
+	require.JSONEq(t, `{"data":null,"errors":[{"message":"Field \"positions\" argument \"address\" of type \"String!\" is required but not provided.","locations":[{"line":1,"column":3}]}]}`,
+		executeGraphQL(t, db, `{ positions { id } }`, nil))
+	require.JSONEq(t, `{"data":null,"errors":[{"message":"Schema is not configured for mutations","locations":[{"line":1,"column":1}]}]}`,
+		executeGraphQL(t, db, `mutation { records { nodes { index } } }`, nil))
+	require.Contains(t, executeGraphQL(t, db, `{ records(first: 1 { nodes }`, nil), "Syntax Error")
+
+	// Resolver errors null the field, up to the closest nullable parent, and report its path
+	require.JSONEq(t, `{"data":null,"errors":[{"message":"invalid cursor \"x\"","locations":[{"line":1,"column":3}],"path":["records"]}]}`,
+		executeGraphQL(t, db, `{ records(after: "x") { nodes { index } } }`, nil))
+
+	// Record indices are 64-bit
+	require.JSONEq(t, `{"data":{"record":null}}`, executeGraphQL(t, db, `{ record(index: 99999999999) { index } }`, nil))
+}
+
+func TestGraphQLIntrospection(t *testing.T) {
+	db := newTestDatabase(t)
+
+	result := executeGraphQL(t, db, `{
+		__schema { queryType { name } }
+		__type(name: "RecordConnection") { kind fields { name type { kind ofType { name } } } }
+		skipped: __typename @skip(if: true)
+	}`, nil)
+	require.JSONEq(t, `{"data":{
+		"__schema":{"queryType":{"name":"Query"}},
+		"__type":{"kind":"OBJECT","fields":[
+			{"name":"edges","type":{"kind":"NON_NULL","ofType":{"name":null}}},
+			{"name":"nodes","type":{"kind":"NON_NULL","ofType":{"name":null}}},
+			{"name":"pageInfo","type":{"kind":"NON_NULL","ofType":{"name":"PageInfo"}}}
+		]}
+	}}`, result)
+}
+
+func TestGraphQLHandler(t *testing.T) {
+	db := newTestDatabase(t)
+	server := httptest.NewServer(NewGraphQLHandler(db))
+	defer server.Close()
+
+	body := `{"query":"query($address: String!) { positions(address: $address) { id } }","variables":{"address":"elys1owner"}}`
+	resp, err := http.Post(server.URL+"/graphql", "application/json", strings.NewReader(body))
+	require.NoError(t, err)
+	result, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.JSONEq(t, `{"data":{"positions":[]}}`, string(result))
+
+	resp, err = http.Get(server.URL + "/graphql/schema")
+	require.NoError(t, err)
+	result, err = io.ReadAll(resp.Body)
+	resp.Body.Close()
+	require.NoError(t, err)
+	require.Contains(t, string(result), "union RecordData = ")
+}
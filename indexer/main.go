@@ -30,16 +30,19 @@ type queueItem struct {
 	ctx               sdk.Context
 	proc              indexerTypes.Processor
 	includedAddresses []string
+	commitHeight      int64  // Set on the commit marker of a block, proc is nil
+	blockTxID         string // Set for executions outside of a transaction
 }
 
 // eventItem represents an event to be processed by the event worker
 // An event are things like liquidations that happen automatically
 type eventItem struct {
-	ctx       sdk.Context
-	eventType string
-	proc      indexerTypes.EventProcessor
-	addresses []string
-	id        string
+	ctx          sdk.Context
+	eventType    string
+	proc         indexerTypes.EventProcessor
+	addresses    []string
+	id           string
+	commitHeight int64 // Set on the commit marker of a block, proc is nil
 }
 
 // Global variables for managing the indexer state
@@ -54,12 +57,16 @@ var (
 	app              AppI           // Application interface instance
 	workerReady      sync.WaitGroup // WaitGroup for worker initialization
 	dbReady          chan struct{}  // Channel to signal database readiness
+	sequenceMutex    sync.Mutex     // Protects the event sequence counter
+	sequenceHeight   int64          // Block height the event sequence belongs to
+	sequence         uint64         // Number of sequenced events queued at sequenceHeight
 )
 
 // Init initializes the indexer with a single worker and stores the app interface
 func Init(a AppI) {
 	once.Do(func() {
 		app = a
+		configureSinks(config)
 		dbReady = make(chan struct{})
 		workerReady.Add(2) // Add one more for event worker
 
@@ -83,13 +90,22 @@ func Init(a AppI) {
 		// Wait for both the database and the workers to be ready
 		<-dbReady
 		workerReady.Wait()
+		startSinks(database)
+		ready.Store(true)
+
+		if config.QueryAddress != "" {
+			startQueryServer(config.QueryAddress, database)
+		}
+		if config.GraphQLAddress != "" {
+			startGraphQLServer(config.GraphQLAddress, database)
+		}
 	})
 }
 
 // initDatabase initializes the LMDB database and performs test queries
 func initDatabase() {
 	var err error
-	database, err = NewLMDBManager("./lmdb-data", &totalIndexLength)
+	database, err = NewLMDBManager(config.DataDir, &totalIndexLength)
 	if err != nil {
 		panic(err)
 	}
@@ -155,18 +171,32 @@ func initDatabase() {
 	close(dbReady) // Signal that the database is ready
 }
 
-// StopIndexer gracefully stops the indexer workers
+// StopIndexer gracefully stops the indexer workers once they stored the queued
+// records, then closes the database
 func StopIndexer() {
+	ready.Store(false)
+	stopQueryServer()
+	stopGraphQLServer()
 	close(txChan)
 	close(eventChan)
 	<-workerDone
 	<-eventWorkerDone
+	stopSinks()
+	database.Close()
 }
 
 // worker processes transactions from the channel
 func worker() {
 	defer close(workerDone)
 	for item := range txChan {
+		if item.proc == nil {
+			blockQueued(item.commitHeight)
+			continue
+		}
+		if item.blockTxID != "" {
+			processBlockTransactionInternal(item)
+			continue
+		}
 		processTransactionInternal(item.ctx, item.proc, item.includedAddresses)
 	}
 }
@@ -175,17 +205,39 @@ func worker() {
 func eventWorker() {
 	defer close(eventWorkerDone)
 	for event := range eventChan {
+		if event.proc == nil {
+			blockQueued(event.commitHeight)
+			continue
+		}
 		processEventInternal(event)
 	}
 }
 
-// QueueTransaction sends the transaction context and processor to the worker
+// QueueTransaction sends the transaction context and processor to the worker.
+// Transactions of executions that are never committed are dropped.
 func QueueTransaction(ctx sdk.Context, proc indexerTypes.Processor, addresses []string) {
+	if !ShouldIndex(ctx) {
+		return
+	}
+	if deferRecord(ctx, func() { queueTransaction(ctx, proc, addresses) }) {
+		return
+	}
+	queueTransaction(ctx, proc, addresses)
+}
+
+// queueTransaction sends the transaction to the worker
+func queueTransaction(ctx sdk.Context, proc indexerTypes.Processor, addresses []string) {
 	item := queueItem{
 		ctx:               ctx,
 		proc:              proc,
 		includedAddresses: addresses,
 	}
+	// Executions outside of a transaction, e.g. orders executed by an end
+	// blocker, have no tx hash and are recorded under a block level ID
+	if len(ctx.TxBytes()) == 0 {
+		height := ctx.BlockHeight()
+		item.blockTxID = FormatEventID(height, eventPhase(ctx), txModule(txTypeOf(proc)), nextEventSequence(height))
+	}
 
 	// Try to queue transaction, wait if channel is full
 	select {
@@ -197,14 +249,27 @@ func QueueTransaction(ctx sdk.Context, proc indexerTypes.Processor, addresses []
 	}
 }
 
-// QueueEvent sends background events to the event worker
-func QueueEvent(ctx sdk.Context, eventType string, proc indexerTypes.EventProcessor, addresses []string, id string) {
+// QueueEvent sends background events to the event worker. The event ID is
+// assigned here, see NewEventID. Events of executions that are never committed
+// are dropped.
+func QueueEvent(ctx sdk.Context, eventType string, proc indexerTypes.EventProcessor, addresses []string) {
+	if !ShouldIndex(ctx) {
+		return
+	}
+	if deferRecord(ctx, func() { queueEvent(ctx, eventType, proc, addresses) }) {
+		return
+	}
+	queueEvent(ctx, eventType, proc, addresses)
+}
+
+// queueEvent assigns the event ID and sends the event to the event worker
+func queueEvent(ctx sdk.Context, eventType string, proc indexerTypes.EventProcessor, addresses []string) {
 	event := eventItem{
 		ctx:       ctx,
 		eventType: eventType,
 		proc:      proc,
 		addresses: addresses,
-		id:        id,
+		id:        NewEventID(ctx, eventType),
 	}
 
 	// Try to queue event, wait if channel is full
@@ -217,6 +282,72 @@ func QueueEvent(ctx sdk.Context, eventType string, proc indexerTypes.EventProces
 	}
 }
 
+// skipIndexingKey marks contexts whose records must not be indexed
+type skipIndexingKey struct{}
+
+// WithoutIndexing returns ctx marked as a dry run, e.g. a cache context only
+// used to validate a message before it is executed for real
+func WithoutIndexing(ctx sdk.Context) sdk.Context {
+	return ctx.WithValue(skipIndexingKey{}, true)
+}
+
+// deferredRecordsKey holds the records buffered under a DeferIndexing context
+type deferredRecordsKey struct{}
+
+// deferredRecords are the queue calls buffered until the execution is committed
+type deferredRecords struct {
+	queue []func()
+}
+
+// DeferIndexing returns ctx buffering the records queued under it, and a write
+// function that calls write, e.g. the write of a cache context, and then queues
+// the buffered records. Records of an execution whose write function is never
+// called are dropped. write may be nil when the caller only needs the records
+// of a failed execution to be dropped. Nested deferred contexts flush into
+// their parent buffer.
+func DeferIndexing(ctx sdk.Context, write func()) (sdk.Context, func()) {
+	parent, _ := ctx.Value(deferredRecordsKey{}).(*deferredRecords)
+	records := &deferredRecords{}
+	return ctx.WithValue(deferredRecordsKey{}, records), func() {
+		if write != nil {
+			write()
+		}
+		queue := records.queue
+		records.queue = nil
+		if parent != nil {
+			parent.queue = append(parent.queue, queue...)
+			return
+		}
+		for _, record := range queue {
+			record()
+		}
+	}
+}
+
+// deferRecord buffers record when ctx is a DeferIndexing context and reports
+// whether it was buffered
+func deferRecord(ctx sdk.Context, record func()) bool {
+	records, ok := ctx.Value(deferredRecordsKey{}).(*deferredRecords)
+	if !ok {
+		return false
+	}
+	records.queue = append(records.queue, record)
+	return true
+}
+
+// ShouldIndex reports whether records produced under ctx must be indexed.
+// Simulated, CheckTx and dry run executions are never committed, so they are
+// skipped.
+func ShouldIndex(ctx sdk.Context) bool {
+	if !IsReady() || ctx.IsCheckTx() || ctx.IsReCheckTx() {
+		return false
+	}
+	if skip, _ := ctx.Value(skipIndexingKey{}).(bool); skip {
+		return false
+	}
+	return ctx.ExecMode() != sdk.ExecModeSimulate
+}
+
 // processEventInternal handles the processing of a single event
 func processEventInternal(event eventItem) {
 	baseEvent := indexerTypes.BaseEvent{
@@ -326,6 +457,31 @@ func processTransactionInternal(ctx sdk.Context, proc indexerTypes.Processor, in
 	}
 }
 
+// processBlockTransactionInternal handles the processing of a transaction
+// record produced outside of a transaction, its author is the first included
+// address
+func processBlockTransactionInternal(item queueItem) {
+	author := ""
+	if len(item.includedAddresses) > 0 {
+		author = item.includedAddresses[0]
+	}
+
+	baseTx := indexerTypes.BaseTransaction{
+		BlockTime:         item.ctx.BlockTime(),
+		Author:            author,
+		IncludedAddresses: item.includedAddresses,
+		BlockHeight:       item.ctx.BlockHeight(),
+		TxHash:            item.blockTxID,
+		TxType:            txTypeOf(item.proc),
+		GasUsed:           strconv.FormatUint(item.ctx.GasMeter().GasConsumed(), 10),
+	}
+
+	_, err := item.proc.Process(database, baseTx)
+	if err != nil {
+		fmt.Printf("failed to process block transaction: %v", err)
+	}
+}
+
 // retryProcessing attempts to reprocess a transaction after a delay
 func retryProcessing(ctx sdk.Context, proc indexerTypes.Processor, includingAddresses []string) {
 	go func() {
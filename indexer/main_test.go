@@ -0,0 +1,45 @@
+package indexer
+
+import (
+	"testing"
+
+	"cosmossdk.io/log"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeferIndexing(t *testing.T) {
+	base := sdk.NewContext(nil, cmtproto.Header{Height: 1200}, false, log.NewNopLogger())
+	require.False(t, deferRecord(base, func() {}))
+
+	var queued []string
+	record := func(name string) func() {
+		return func() { queued = append(queued, name) }
+	}
+
+	// Records of a cache context that is never written are dropped
+	discarded, _ := DeferIndexing(base, nil)
+	require.True(t, deferRecord(discarded, record("discarded")))
+
+	written := false
+	ctx, write := DeferIndexing(base, func() { written = true })
+	require.True(t, deferRecord(ctx, record("outer")))
+
+	// A nested context flushes into its parent buffer
+	nested, writeNested := DeferIndexing(ctx, nil)
+	require.True(t, deferRecord(nested, record("nested")))
+	writeNested()
+	require.Empty(t, queued)
+
+	dropped, _ := DeferIndexing(ctx, nil)
+	require.True(t, deferRecord(dropped, record("dropped")))
+
+	write()
+	require.True(t, written)
+	require.Equal(t, []string{"outer", "nested"}, queued)
+
+	// A second write does not queue the records again
+	write()
+	require.Equal(t, []string{"outer", "nested"}, queued)
+}
@@ -0,0 +1,140 @@
+package indexer
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/bmatsuo/lmdb-go/lmdb"
+
+	indexerTypes "github.com/elys-network/elys/indexer/types"
+)
+
+// ParamHistoryEntry is one change of a module parameter
+type ParamHistoryEntry struct {
+	Module string    `json:"module"`
+	Field  string    `json:"field"`
+	Before string    `json:"before"`
+	After  string    `json:"after"`
+	Index  uint64    `json:"index"`
+	Height int64     `json:"height"`
+	Time   time.Time `json:"time"`
+	TxHash string    `json:"tx_hash"`
+	Author string    `json:"author"`
+}
+
+// paramHistoryAggregator keeps the field level changes of the parameter
+// update transactions.
+// - paramHistoryDB: "<module>/<field>/<index>" -> ParamHistoryEntry
+//
+// The module is taken from the transaction type, e.g. "perpetual" for
+// "/elys.perpetual.MsgUpdateParams", and the field is the JSON path of the
+// parameter, e.g. "fixed_funding_rate".
+type paramHistoryAggregator struct {
+	paramHistoryDB lmdb.DBI
+}
+
+var paramHistory = &paramHistoryAggregator{}
+
+func init() {
+	RegisterAggregator(paramHistory)
+}
+
+func (a *paramHistoryAggregator) Name() string {
+	return "param history"
+}
+
+func (a *paramHistoryAggregator) Open(txn *lmdb.Txn) (err error) {
+	a.paramHistoryDB, err = txn.OpenDBI("paramhistory", lmdb.Create)
+	return err
+}
+
+func (a *paramHistoryAggregator) Apply(txn *lmdb.Txn, index uint64, record indexerTypes.GenericRecord) error {
+	if !record.IsTransaction() {
+		return nil
+	}
+	data, ok := recordData(record)
+	if !ok {
+		return nil
+	}
+	mapper, ok := data.(indexerTypes.ParamChangeMapper)
+	if !ok {
+		return nil
+	}
+	tx := record.Transaction.BaseTransaction
+	module := txModule(tx.TxType)
+
+	for _, change := range mapper.ParamChanges() {
+		entry := ParamHistoryEntry{
+			Module: module,
+			Field:  change.Field,
+			Before: change.Before,
+			After:  change.After,
+			Index:  index,
+			Height: tx.BlockHeight,
+			Time:   tx.BlockTime,
+			TxHash: tx.TxHash,
+			Author: tx.Author,
+		}
+		entryBytes, err := json.Marshal(entry)
+		if err != nil {
+			return err
+		}
+		if err := txn.Put(a.paramHistoryDB, paramHistoryKey(module, change.Field, index), entryBytes, 0); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetParamHistory returns the parameter changes of a module, newest first.
+// field restricts the history to one parameter when set.
+func (m *LMDBManager) GetParamHistory(module, field string) ([]ParamHistoryEntry, error) {
+	prefix := module + "/"
+	if field != "" {
+		prefix += field + "/"
+	}
+
+	result := []ParamHistoryEntry{}
+	err := m.view(func(txn *lmdb.Txn) error {
+		cursor, err := txn.OpenCursor(paramHistory.paramHistoryDB)
+		if err != nil {
+			return err
+		}
+		defer cursor.Close()
+
+		key, value, err := cursor.Get([]byte(prefix), nil, lmdb.SetRange)
+		for ; err == nil; key, value, err = cursor.Get(nil, nil, lmdb.Next) {
+			if !strings.HasPrefix(string(key), prefix) {
+				return nil
+			}
+			var entry ParamHistoryEntry
+			if err := json.Unmarshal(value, &entry); err != nil {
+				return err
+			}
+			result = append(result, entry)
+		}
+		if lmdb.IsNotFound(err) {
+			return nil
+		}
+		return err
+	})
+	sort.SliceStable(result, func(i, j int) bool { return result[i].Index > result[j].Index })
+	return result, err
+}
+
+func paramHistoryKey(module, field string, index uint64) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%020d", module, field, index))
+}
+
+// txModule returns the module of a transaction type, e.g. "perpetual" for
+// "/elys.perpetual.MsgUpdateParams"
+func txModule(txType string) string {
+	parts := strings.Split(strings.TrimPrefix(txType, "/"), ".")
+	if len(parts) < 3 {
+		return ""
+	}
+	return parts[len(parts)-2]
+}
@@ -0,0 +1,96 @@
+package indexer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/elys-network/elys/indexer/txs/masterchef"
+	"github.com/elys-network/elys/indexer/txs/parameter"
+	"github.com/elys-network/elys/indexer/txs/perpetual"
+	indexerTypes "github.com/elys-network/elys/indexer/types"
+)
+
+func TestDiffParams(t *testing.T) {
+	before := masterchef.Params{
+		LpIncentives:        &masterchef.IncentiveInfo{EdenAmountPerYear: "100", BlocksDistributed: 10},
+		RewardPortionForLps: "0.6",
+		SupportedRewardDenoms: []masterchef.SupportedRewardDenom{
+			{Denom: "uusdc", MinAmount: "1"},
+		},
+	}
+	after := before
+	after.LpIncentives = &masterchef.IncentiveInfo{EdenAmountPerYear: "100", BlocksDistributed: 20}
+	after.RewardPortionForLps = "0.5"
+	after.SupportedRewardDenoms = nil
+
+	require.Equal(t, []indexerTypes.ParamChange{
+		{Field: "lp_incentives.blocks_distributed", Before: "10", After: "20"},
+		{Field: "reward_portion_for_lps", Before: "0.6", After: "0.5"},
+		{Field: "supported_reward_denoms", Before: `[{"denom":"uusdc","min_amount":"1"}]`, After: ""},
+	}, indexerTypes.DiffParams(before, after))
+
+	after.LpIncentives = nil
+	require.Contains(t, indexerTypes.DiffParams(before, after),
+		indexerTypes.ParamChange{Field: "lp_incentives.eden_amount_per_year", Before: "100"})
+	require.Empty(t, indexerTypes.DiffParams(before, before))
+}
+
+func TestParamHistory(t *testing.T) {
+	db := newTestDatabase(t)
+	start := time.Date(2024, 8, 1, 0, 0, 0, 0, time.UTC)
+	const authority = "elys1gov"
+
+	params := perpetual.Params{FixedFundingRate: "0.1", MaxOpenPositions: 10}
+	for i, rate := range []string{"0.2", "0.3"} {
+		previous := params
+		params.FixedFundingRate = rate
+		if i == 1 {
+			params.MaxOpenPositions = 20
+		}
+		storeAuthoredTx(t, db, indexerTypes.BaseTransaction{
+			TxHash:      []string{"update1", "update2"}[i],
+			TxType:      "/elys.perpetual.MsgUpdateParams",
+			Author:      authority,
+			BlockHeight: int64(100 + i),
+			BlockTime:   start.Add(time.Duration(i) * time.Hour),
+		}, perpetual.MsgUpdateParams{Authority: authority, Params: params, PreviousParams: previous})
+	}
+	storeAuthoredTx(t, db, indexerTypes.BaseTransaction{
+		TxHash:      "commission",
+		TxType:      "/elys.parameter.MsgUpdateMinCommission",
+		Author:      authority,
+		BlockHeight: 102,
+		BlockTime:   start,
+	}, parameter.MsgUpdateMinCommission{Creator: authority, MinCommission: "0.05", PreviousMinCommission: "0.05"})
+
+	history, err := db.GetParamHistory("perpetual", "fixed_funding_rate")
+	require.NoError(t, err)
+	require.Equal(t, []ParamHistoryEntry{
+		{Module: "perpetual", Field: "fixed_funding_rate", Before: "0.2", After: "0.3", Index: 2, Height: 101, Time: start.Add(time.Hour), TxHash: "update2", Author: authority},
+		{Module: "perpetual", Field: "fixed_funding_rate", Before: "0.1", After: "0.2", Index: 1, Height: 100, Time: start, TxHash: "update1", Author: authority},
+	}, history)
+
+	history, err = db.GetParamHistory("perpetual", "")
+	require.NoError(t, err)
+	require.Len(t, history, 3)
+	require.Equal(t, uint64(2), history[0].Index)
+	require.Equal(t, uint64(1), history[2].Index)
+
+	// Updates leaving the value unchanged are not part of the history
+	history, err = db.GetParamHistory("parameter", "")
+	require.NoError(t, err)
+	require.Empty(t, history)
+
+	// The stored records carry the diff as well
+	record, err := db.GetRecordByIndex(1)
+	require.NoError(t, err)
+	data, ok := recordData(record)
+	require.True(t, ok)
+	require.Equal(t, []indexerTypes.ParamChange{{Field: "fixed_funding_rate", Before: "0.1", After: "0.2"}},
+		data.(perpetual.MsgUpdateParams).Changes)
+
+	require.JSONEq(t, `{"data":{"paramHistory":[{"before":"0.2","after":"0.3","height":101},{"before":"0.1","after":"0.2","height":100}]}}`,
+		executeGraphQL(t, db, `{ paramHistory(module: "perpetual", field: "fixed_funding_rate") { before after height } }`, nil))
+}
@@ -0,0 +1,195 @@
+package indexer
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/bmatsuo/lmdb-go/lmdb"
+
+	"github.com/elys-network/elys/indexer/txs/leveragelp"
+	"github.com/elys-network/elys/indexer/txs/perpetual"
+	indexerTypes "github.com/elys-network/elys/indexer/types"
+)
+
+// PnL aggregation dimensions
+const (
+	PnLByDay    = "day"
+	PnLByAsset  = "asset"
+	PnLByModule = "module"
+)
+
+// PnLBucket sums the realized PnL of an address for one day, asset or module.
+// Realized maps each collateral denom to its summed PnL as a decimal string.
+type PnLBucket struct {
+	Address   string            `json:"address"`
+	Dimension string            `json:"dimension"`
+	Bucket    string            `json:"bucket"`
+	Realized  map[string]string `json:"realized"`
+	Count     uint64            `json:"count"`
+}
+
+// UnrealizedPnL is the estimated PnL of an open position at the latest price
+type UnrealizedPnL struct {
+	Module            string             `json:"module"`
+	Address           string             `json:"address"`
+	ID                uint64             `json:"id"`
+	PoolID            uint64             `json:"pool_id"`
+	Position          string             `json:"position"`
+	TradingAsset      string             `json:"trading_asset"`
+	TradingAssetPrice string             `json:"trading_asset_price"`
+	OpenPrice         string             `json:"open_price"`
+	Collateral        indexerTypes.Token `json:"collateral"`
+	PnL               indexerTypes.Token `json:"pnl"`
+}
+
+// UnrealizedPnLSource computes the unrealized PnL of the open positions of an
+// address from the latest committed chain state
+type UnrealizedPnLSource func(address string) ([]UnrealizedPnL, error)
+
+var unrealizedPnLSource UnrealizedPnLSource
+
+// SetUnrealizedPnLSource sets the source used by the unrealized PnL query
+func SetUnrealizedPnLSource(source UnrealizedPnLSource) {
+	unrealizedPnLSource = source
+}
+
+// GetUnrealizedPnL returns the unrealized PnL of the open positions of an address
+func GetUnrealizedPnL(address string) ([]UnrealizedPnL, error) {
+	if unrealizedPnLSource == nil {
+		return nil, fmt.Errorf("unrealized PnL is not available")
+	}
+	return unrealizedPnLSource(address)
+}
+
+// pnlAggregator sums the realized PnL of closes, liquidations, stop losses and
+// take profits per address.
+// - pnlDB: "<address>/<dimension>/<bucket>" -> PnLBucket
+//
+// Perpetual MsgClosePositions results are not counted, the positions they close
+// are already counted through the liquidation, stop loss and take profit events.
+type pnlAggregator struct {
+	pnlDB lmdb.DBI
+}
+
+var pnl = &pnlAggregator{}
+
+func init() {
+	RegisterAggregator(pnl)
+}
+
+func (a *pnlAggregator) Name() string {
+	return "pnl"
+}
+
+func (a *pnlAggregator) Open(txn *lmdb.Txn) (err error) {
+	a.pnlDB, err = txn.OpenDBI("pnl", lmdb.Create)
+	return err
+}
+
+func (a *pnlAggregator) Apply(txn *lmdb.Txn, index uint64, record indexerTypes.GenericRecord) error {
+	data, ok := recordData(record)
+	if !ok {
+		return nil
+	}
+
+	var module, address, denom, profitLoss string
+	switch d := data.(type) {
+	case perpetual.MsgClose:
+		module, address, denom, profitLoss = "perpetual", d.Creator, d.CollateralAsset, d.ProfitLoss
+	case perpetual.LiquidationEvent:
+		module, address, denom, profitLoss = "perpetual", d.Address, d.Collateral.Denom, d.ProfitLoss
+	case perpetual.StopLossEvent:
+		module, address, denom, profitLoss = "perpetual", d.Address, d.Collateral.Denom, d.ProfitLoss
+	case perpetual.TakeProfitEvent:
+		module, address, denom, profitLoss = "perpetual", d.Address, d.Collateral.Denom, d.ProfitLoss
+	case leveragelp.MsgClose:
+		module, address, denom, profitLoss = "leveragelp", d.Creator, d.Position.Collateral.Denom, d.ProfitLoss
+	case leveragelp.LiquidationEvent:
+		module, address, denom, profitLoss = "leveragelp", d.Address, d.Collateral.Denom, d.ProfitLoss
+	case leveragelp.StopLossEvent:
+		module, address, denom, profitLoss = "leveragelp", d.Address, d.Collateral.Denom, d.ProfitLoss
+	default:
+		return nil
+	}
+	if address == "" || profitLoss == "" {
+		return nil
+	}
+
+	buckets := map[string]string{
+		PnLByDay:    record.BlockTime().UTC().Format("2006-01-02"),
+		PnLByAsset:  denom,
+		PnLByModule: module,
+	}
+	for dimension, bucket := range buckets {
+		key := pnlKey(address, dimension, bucket)
+		entry := PnLBucket{
+			Address:   address,
+			Dimension: dimension,
+			Bucket:    bucket,
+			Realized:  make(map[string]string),
+		}
+		existing, err := txn.Get(a.pnlDB, key)
+		if err == nil {
+			if err := json.Unmarshal(existing, &entry); err != nil {
+				return err
+			}
+		} else if !lmdb.IsNotFound(err) {
+			return err
+		}
+
+		entry.Realized[denom] = addDecStrings(entry.Realized[denom], profitLoss)
+		entry.Count++
+
+		entryBytes, err := json.Marshal(entry)
+		if err != nil {
+			return err
+		}
+		if err := txn.Put(a.pnlDB, key, entryBytes, 0); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetPnL returns the realized PnL buckets of an address for a dimension,
+// ordered by bucket. from and to bound the bucket names when set, e.g. the
+// days "2024-01-01" to "2024-01-31".
+func (m *LMDBManager) GetPnL(address, dimension, from, to string) ([]PnLBucket, error) {
+	if dimension != PnLByDay && dimension != PnLByAsset && dimension != PnLByModule {
+		return nil, fmt.Errorf("unknown PnL dimension: %s", dimension)
+	}
+
+	var result []PnLBucket
+	err := m.view(func(txn *lmdb.Txn) error {
+		cursor, err := txn.OpenCursor(pnl.pnlDB)
+		if err != nil {
+			return err
+		}
+		defer cursor.Close()
+
+		prefix := string(pnlKey(address, dimension, ""))
+		key, value, err := cursor.Get(pnlKey(address, dimension, from), nil, lmdb.SetRange)
+		for ; err == nil; key, value, err = cursor.Get(nil, nil, lmdb.Next) {
+			if len(key) < len(prefix) || string(key[:len(prefix)]) != prefix {
+				return nil
+			}
+			if to != "" && string(key[len(prefix):]) > to {
+				return nil
+			}
+			var entry PnLBucket
+			if err := json.Unmarshal(value, &entry); err != nil {
+				return err
+			}
+			result = append(result, entry)
+		}
+		if lmdb.IsNotFound(err) {
+			return nil
+		}
+		return err
+	})
+	return result, err
+}
+
+func pnlKey(address, dimension, bucket string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s", address, dimension, bucket))
+}
@@ -0,0 +1,73 @@
+package indexer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/elys-network/elys/indexer/txs/leveragelp"
+	"github.com/elys-network/elys/indexer/txs/perpetual"
+	indexerTypes "github.com/elys-network/elys/indexer/types"
+)
+
+func TestPnLAggregation(t *testing.T) {
+	db := newTestDatabase(t)
+	day1 := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	day2 := day1.Add(24 * time.Hour)
+	const owner = "elys1owner"
+
+	storeTx(t, db, "close", 10, day1, perpetual.MsgClose{
+		Creator:         owner,
+		Id:              1,
+		CollateralAsset: "uusdc",
+		ProfitLoss:      "12.500000000000000000",
+	})
+	storeEvent(t, db, "liquidation", indexerTypes.ElysEventTypes.Perpetual.Liquidation, 11, day1, perpetual.LiquidationEvent{
+		Address:    owner,
+		ID:         2,
+		Collateral: indexerTypes.Token{Amount: "100", Denom: "uatom"},
+		ProfitLoss: "-100.000000000000000000",
+	})
+	storeEvent(t, db, "lp-stop-loss", indexerTypes.ElysEventTypes.Leveragelp.StopLoss, 20, day2, leveragelp.StopLossEvent{
+		Address:    owner,
+		PositionID: 3,
+		Collateral: indexerTypes.Token{Amount: "50", Denom: "uusdc"},
+		ProfitLoss: "-2.000000000000000000",
+	})
+	// Batch closes are counted through their events only
+	storeTx(t, db, "close-positions", 21, day2, perpetual.MsgClosePositions{
+		Creator:   "elys1bot",
+		Liquidate: []perpetual.PositionResult{{Address: owner, ID: 2, ProfitLoss: "-100"}},
+	})
+
+	days, err := db.GetPnL(owner, PnLByDay, "", "")
+	require.NoError(t, err)
+	require.Len(t, days, 2)
+	require.Equal(t, "2024-03-01", days[0].Bucket)
+	require.Equal(t, uint64(2), days[0].Count)
+	require.Equal(t, "12.500000000000000000", days[0].Realized["uusdc"])
+	require.Equal(t, "-100.000000000000000000", days[0].Realized["uatom"])
+	require.Equal(t, "-2.000000000000000000", days[1].Realized["uusdc"])
+
+	days, err = db.GetPnL(owner, PnLByDay, "2024-03-02", "2024-03-31")
+	require.NoError(t, err)
+	require.Len(t, days, 1)
+	require.Equal(t, "2024-03-02", days[0].Bucket)
+
+	assets, err := db.GetPnL(owner, PnLByAsset, "", "")
+	require.NoError(t, err)
+	require.Len(t, assets, 2)
+	require.Equal(t, "uatom", assets[0].Bucket)
+	require.Equal(t, "10.500000000000000000", assets[1].Realized["uusdc"])
+
+	modules, err := db.GetPnL(owner, PnLByModule, "", "")
+	require.NoError(t, err)
+	require.Len(t, modules, 2)
+	require.Equal(t, "leveragelp", modules[0].Bucket)
+	require.Equal(t, "perpetual", modules[1].Bucket)
+	require.Equal(t, uint64(2), modules[1].Count)
+
+	_, err = db.GetPnL(owner, "week", "", "")
+	require.Error(t, err)
+}
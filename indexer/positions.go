@@ -0,0 +1,325 @@
+package indexer
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/bmatsuo/lmdb-go/lmdb"
+
+	"github.com/elys-network/elys/indexer/txs/perpetual"
+	indexerTypes "github.com/elys-network/elys/indexer/types"
+)
+
+// Position lifecycle entry kinds
+const (
+	PositionOpen             = "open"
+	PositionConsolidate      = "consolidate"
+	PositionPartialClose     = "partial_close"
+	PositionClose            = "close"
+	PositionStopLossUpdate   = "stop_loss_update"
+	PositionTakeProfitUpdate = "take_profit_update"
+	PositionFunding          = "funding"
+	PositionInterest         = "interest"
+	PositionLiquidation      = "liquidation"
+	PositionStopLoss         = "stop_loss"
+	PositionTakeProfit       = "take_profit"
+)
+
+// Position statuses
+const (
+	PositionStatusOpen   = "open"
+	PositionStatusClosed = "closed"
+)
+
+// PositionEntry references one record of the lifecycle of a position
+type PositionEntry struct {
+	Index  uint64    `json:"index"`
+	Kind   string    `json:"kind"`
+	Height int64     `json:"height"`
+	Time   time.Time `json:"time"`
+}
+
+// PositionHistory is the lifecycle of a perpetual position (MTP) rebuilt from
+// the indexed opens, consolidations, closes, order updates, settlements and
+// liquidations of that position. Amounts are summed over the whole lifecycle.
+type PositionHistory struct {
+	Address         string          `json:"address"`
+	ID              uint64          `json:"id"`
+	PoolID          uint64          `json:"pool_id"`
+	Position        string          `json:"position"`
+	TradingAsset    string          `json:"trading_asset"`
+	CollateralAsset string          `json:"collateral_asset"`
+	Status          string          `json:"status"`
+	OpenPrice       string          `json:"open_price"`
+	StopLossPrice   string          `json:"stop_loss_price"`
+	TakeProfitPrice string          `json:"take_profit_price"`
+	OpenedHeight    int64           `json:"opened_height"`
+	OpenedAt        time.Time       `json:"opened_at"`
+	ClosedHeight    int64           `json:"closed_height,omitempty"`
+	ClosedAt        *time.Time      `json:"closed_at,omitempty"`
+	CloseReason     string          `json:"close_reason,omitempty"`
+	RealizedPnL     string          `json:"realized_pnl"`
+	FundingPaid     string          `json:"funding_paid"`
+	FundingReceived string          `json:"funding_received"`
+	InterestPaid    string          `json:"interest_paid"`
+	Entries         []PositionEntry `json:"entries"`
+}
+
+// positionAggregator maintains one PositionHistory per (address, MTP id).
+// - positionDB: "<address>/<id>" -> PositionHistory
+type positionAggregator struct {
+	positionDB lmdb.DBI
+}
+
+var positions = &positionAggregator{}
+
+func init() {
+	RegisterAggregator(positions)
+}
+
+func (a *positionAggregator) Name() string {
+	return "positions"
+}
+
+func (a *positionAggregator) Open(txn *lmdb.Txn) (err error) {
+	a.positionDB, err = txn.OpenDBI("positions", lmdb.Create)
+	return err
+}
+
+func (a *positionAggregator) Apply(txn *lmdb.Txn, index uint64, record indexerTypes.GenericRecord) error {
+	data, ok := recordData(record)
+	if !ok {
+		return nil
+	}
+
+	height, blockTime := record.BlockHeight(), record.BlockTime()
+
+	var address, kind string
+	var id uint64
+	var update func(history *PositionHistory)
+
+	switch d := data.(type) {
+	case perpetual.MsgOpen:
+		address, id, kind = d.Creator, d.PositionID, PositionOpen
+		update = func(h *PositionHistory) {
+			h.PoolID = d.PoolID
+			setString(&h.Position, positionName(d.Position))
+			setString(&h.TradingAsset, d.TradingAsset)
+			setString(&h.CollateralAsset, d.Collateral.Denom)
+			setString(&h.OpenPrice, d.OpenPrice)
+			setString(&h.StopLossPrice, d.StopLossPrice)
+			setString(&h.TakeProfitPrice, d.TakeProfitPrice)
+			h.OpenedHeight, h.OpenedAt = height, blockTime
+		}
+	case perpetual.Consolidate:
+		address, id, kind = d.Address, d.ID, PositionConsolidate
+		update = func(h *PositionHistory) {
+			h.PoolID = d.PoolID
+			setString(&h.Position, d.Position)
+			setString(&h.CollateralAsset, d.Collateral.Denom)
+			setString(&h.OpenPrice, d.OpenPrice)
+			setString(&h.StopLossPrice, d.StopLossPrice)
+			setString(&h.TakeProfitPrice, d.TakeProfitPrice)
+		}
+	case perpetual.MsgClose:
+		address, id, kind = d.Creator, d.Id, PositionPartialClose
+		if d.Closed {
+			kind = PositionClose
+		}
+		update = func(h *PositionHistory) {
+			setString(&h.Position, d.Position)
+			setString(&h.TradingAsset, d.TradingAsset)
+			setString(&h.CollateralAsset, d.CollateralAsset)
+			h.RealizedPnL = addDecStrings(h.RealizedPnL, d.ProfitLoss)
+			if d.Closed {
+				h.close(kind, height, blockTime)
+			}
+		}
+	case perpetual.MsgUpdateStopLoss:
+		address, id, kind = d.Creator, d.ID, PositionStopLossUpdate
+		update = func(h *PositionHistory) { setString(&h.StopLossPrice, d.Price) }
+	case perpetual.MsgUpdateTakeProfitPrice:
+		address, id, kind = d.Creator, d.ID, PositionTakeProfitUpdate
+		update = func(h *PositionHistory) { setString(&h.TakeProfitPrice, d.Price) }
+	case perpetual.FundingSettlement:
+		address, id, kind = d.Address, d.ID, PositionFunding
+		update = func(h *PositionHistory) {
+			h.FundingPaid = addDecStrings(h.FundingPaid, d.Paid.Amount)
+			h.FundingReceived = addDecStrings(h.FundingReceived, d.Received.Amount)
+		}
+	case perpetual.InterestSettlement:
+		address, id, kind = d.Address, d.ID, PositionInterest
+		update = func(h *PositionHistory) { h.InterestPaid = addDecStrings(h.InterestPaid, d.Paid.Amount) }
+	case perpetual.LiquidationEvent:
+		address, id, kind = d.Address, d.ID, PositionLiquidation
+		update = func(h *PositionHistory) {
+			h.RealizedPnL = addDecStrings(h.RealizedPnL, d.ProfitLoss)
+			h.close(kind, height, blockTime)
+		}
+	case perpetual.StopLossEvent:
+		address, id, kind = d.Address, d.ID, PositionStopLoss
+		update = func(h *PositionHistory) {
+			h.RealizedPnL = addDecStrings(h.RealizedPnL, d.ProfitLoss)
+			h.close(kind, height, blockTime)
+		}
+	case perpetual.TakeProfitEvent:
+		address, id, kind = d.Address, d.ID, PositionTakeProfit
+		update = func(h *PositionHistory) {
+			h.RealizedPnL = addDecStrings(h.RealizedPnL, d.ProfitLoss)
+			h.close(kind, height, blockTime)
+		}
+	default:
+		return nil
+	}
+	if address == "" {
+		return nil
+	}
+
+	key := positionKey(address, id)
+	history := PositionHistory{
+		Address:         address,
+		ID:              id,
+		Status:          PositionStatusOpen,
+		RealizedPnL:     "0",
+		FundingPaid:     "0",
+		FundingReceived: "0",
+		InterestPaid:    "0",
+	}
+	existing, err := txn.Get(a.positionDB, key)
+	if err == nil {
+		if err := json.Unmarshal(existing, &history); err != nil {
+			return err
+		}
+	} else if !lmdb.IsNotFound(err) {
+		return err
+	}
+
+	update(&history)
+	// Records of one block may reach the store out of order, entries are kept
+	// in chain order instead
+	history.Entries = append(history.Entries, PositionEntry{Index: index, Kind: kind, Height: height, Time: blockTime})
+	sort.SliceStable(history.Entries, func(i, j int) bool {
+		if history.Entries[i].Height != history.Entries[j].Height {
+			return history.Entries[i].Height < history.Entries[j].Height
+		}
+		return history.Entries[i].Index < history.Entries[j].Index
+	})
+
+	historyBytes, err := json.Marshal(history)
+	if err != nil {
+		return err
+	}
+	return txn.Put(a.positionDB, key, historyBytes, 0)
+}
+
+func (h *PositionHistory) close(reason string, height int64, blockTime time.Time) {
+	h.Status = PositionStatusClosed
+	h.CloseReason = reason
+	h.ClosedHeight = height
+	h.ClosedAt = &blockTime
+}
+
+// GetPositionHistory returns the lifecycle of one perpetual position
+func (m *LMDBManager) GetPositionHistory(address string, id uint64) (PositionHistory, error) {
+	var history PositionHistory
+	err := m.view(func(txn *lmdb.Txn) error {
+		value, err := txn.Get(positions.positionDB, positionKey(address, id))
+		if err != nil {
+			if lmdb.IsNotFound(err) {
+				return fmt.Errorf("position %d of %s not found", id, address)
+			}
+			return err
+		}
+		return json.Unmarshal(value, &history)
+	})
+	return history, err
+}
+
+// GetPositionRecords returns the records referenced by the entries of a position history
+func (m *LMDBManager) GetPositionRecords(history PositionHistory) ([]indexerTypes.GenericRecord, error) {
+	records := make([]indexerTypes.GenericRecord, 0, len(history.Entries))
+	err := m.view(func(txn *lmdb.Txn) error {
+		for _, entry := range history.Entries {
+			record, err := m.getRecordInTxn(txn, entry.Index)
+			if err != nil {
+				return err
+			}
+			records = append(records, record)
+		}
+		return nil
+	})
+	return records, err
+}
+
+// GetPositions returns the positions of an address ordered by id, optionally
+// filtered by status
+func (m *LMDBManager) GetPositions(address, status string) ([]PositionHistory, error) {
+	var result []PositionHistory
+	err := m.view(func(txn *lmdb.Txn) error {
+		cursor, err := txn.OpenCursor(positions.positionDB)
+		if err != nil {
+			return err
+		}
+		defer cursor.Close()
+
+		prefix := address + "/"
+		key, value, err := cursor.Get([]byte(prefix), nil, lmdb.SetRange)
+		for ; err == nil; key, value, err = cursor.Get(nil, nil, lmdb.Next) {
+			if len(key) < len(prefix) || string(key[:len(prefix)]) != prefix {
+				return nil
+			}
+			var history PositionHistory
+			if err := json.Unmarshal(value, &history); err != nil {
+				return err
+			}
+			if status == "" || history.Status == status {
+				result = append(result, history)
+			}
+		}
+		if lmdb.IsNotFound(err) {
+			return nil
+		}
+		return err
+	})
+	return result, err
+}
+
+// setString overwrites field with value unless value is empty, records only
+// carry the details known to the code path that produced them
+func setString(field *string, value string) {
+	if value != "" {
+		*field = value
+	}
+}
+
+// addDecStrings adds two decimals stored as strings, treating unparsable
+// values as zero
+func addDecStrings(value, amount string) string {
+	current, err := math.LegacyNewDecFromStr(value)
+	if err != nil {
+		current = math.LegacyZeroDec()
+	}
+	delta, err := math.LegacyNewDecFromStr(amount)
+	if err != nil {
+		return current.String()
+	}
+	return current.Add(delta).String()
+}
+
+func positionName(position perpetual.Position) string {
+	switch position {
+	case perpetual.Position_LONG:
+		return "LONG"
+	case perpetual.Position_SHORT:
+		return "SHORT"
+	default:
+		return "UNSPECIFIED"
+	}
+}
+
+func positionKey(address string, id uint64) []byte {
+	return []byte(fmt.Sprintf("%s/%020d", address, id))
+}
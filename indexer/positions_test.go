@@ -0,0 +1,122 @@
+package indexer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/elys-network/elys/indexer/txs/perpetual"
+	indexerTypes "github.com/elys-network/elys/indexer/types"
+)
+
+func storeTx(t *testing.T, db *LMDBManager, hash string, height int64, blockTime time.Time, proc indexerTypes.Processor) {
+	_, err := proc.Process(db, indexerTypes.BaseTransaction{
+		TxHash:      hash,
+		BlockHeight: height,
+		BlockTime:   blockTime,
+	})
+	require.NoError(t, err)
+}
+
+func storeEvent(t *testing.T, db *LMDBManager, id, eventType string, height int64, blockTime time.Time, proc indexerTypes.EventProcessor) {
+	_, err := proc.Process(db, indexerTypes.BaseEvent{
+		EventID:     id,
+		EventType:   eventType,
+		BlockHeight: height,
+		BlockTime:   blockTime,
+	})
+	require.NoError(t, err)
+}
+
+func TestPositionLifecycle(t *testing.T) {
+	db := newTestDatabase(t)
+	start := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	const owner = "elys1owner"
+	events := indexerTypes.ElysEventTypes.Perpetual
+
+	storeTx(t, db, "open", 10, start, perpetual.MsgOpen{
+		Creator:      owner,
+		Position:     perpetual.Position_LONG,
+		TradingAsset: "uatom",
+		Collateral:   indexerTypes.Token{Amount: "1000", Denom: "uusdc"},
+		PoolID:       1,
+		PositionID:   7,
+		OpenPrice:    "5.000000000000000000",
+	})
+	storeEvent(t, db, "consolidate", events.Consolidate, 11, start.Add(time.Minute), perpetual.Consolidate{
+		Address:   owner,
+		ID:        7,
+		MergedID:  8,
+		PoolID:    1,
+		Position:  "LONG",
+		OpenPrice: "5.500000000000000000",
+	})
+	storeEvent(t, db, "funding", events.FundingSettlement, 12, start.Add(2*time.Minute), perpetual.FundingSettlement{
+		Address: owner,
+		ID:      7,
+		Paid:    indexerTypes.Token{Amount: "3", Denom: "uatom"},
+	})
+	storeEvent(t, db, "interest", events.InterestSettlement, 12, start.Add(2*time.Minute), perpetual.InterestSettlement{
+		Address: owner,
+		ID:      7,
+		Paid:    indexerTypes.Token{Amount: "2", Denom: "uatom"},
+	})
+	storeTx(t, db, "stop-loss", 13, start.Add(3*time.Minute), perpetual.MsgUpdateStopLoss{Creator: owner, ID: 7, Price: "4.000000000000000000"})
+	storeTx(t, db, "partial", 14, start.Add(4*time.Minute), perpetual.MsgClose{
+		Creator:    owner,
+		Id:         7,
+		ProfitLoss: "15.000000000000000000",
+	})
+
+	history, err := db.GetPositionHistory(owner, 7)
+	require.NoError(t, err)
+	require.Equal(t, PositionStatusOpen, history.Status)
+	require.Equal(t, "LONG", history.Position)
+	require.Equal(t, uint64(1), history.PoolID)
+	require.Equal(t, "5.500000000000000000", history.OpenPrice)
+	require.Equal(t, "4.000000000000000000", history.StopLossPrice)
+	require.Equal(t, "3.000000000000000000", history.FundingPaid)
+	require.Equal(t, "2.000000000000000000", history.InterestPaid)
+	require.Equal(t, "15.000000000000000000", history.RealizedPnL)
+
+	storeEvent(t, db, "liquidation", events.Liquidation, 15, start.Add(5*time.Minute), perpetual.LiquidationEvent{
+		Address:    owner,
+		ID:         7,
+		ProfitLoss: "-40.000000000000000000",
+	})
+
+	history, err = db.GetPositionHistory(owner, 7)
+	require.NoError(t, err)
+	require.Equal(t, PositionStatusClosed, history.Status)
+	require.Equal(t, PositionLiquidation, history.CloseReason)
+	require.Equal(t, int64(15), history.ClosedHeight)
+	require.Equal(t, "-25.000000000000000000", history.RealizedPnL)
+
+	kinds := make([]string, 0, len(history.Entries))
+	for _, entry := range history.Entries {
+		kinds = append(kinds, entry.Kind)
+	}
+	require.Equal(t, []string{
+		PositionOpen, PositionConsolidate, PositionFunding, PositionInterest,
+		PositionStopLossUpdate, PositionPartialClose, PositionLiquidation,
+	}, kinds)
+
+	records, err := db.GetPositionRecords(history)
+	require.NoError(t, err)
+	require.Len(t, records, len(history.Entries))
+	require.Equal(t, "open", records[0].Transaction.BaseTransaction.TxHash)
+
+	// A second position of the same owner is listed separately
+	storeTx(t, db, "open-2", 16, start.Add(6*time.Minute), perpetual.MsgOpen{Creator: owner, PositionID: 9, Position: perpetual.Position_SHORT})
+	all, err := db.GetPositions(owner, "")
+	require.NoError(t, err)
+	require.Len(t, all, 2)
+	open, err := db.GetPositions(owner, PositionStatusOpen)
+	require.NoError(t, err)
+	require.Len(t, open, 1)
+	require.Equal(t, uint64(9), open[0].ID)
+
+	_, err = db.GetPositionHistory(owner, 8)
+	require.Error(t, err)
+}
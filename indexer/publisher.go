@@ -0,0 +1,256 @@
+package indexer
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/twmb/franz-go/pkg/kgo"
+)
+
+// Publisher sends messages to a message queue. Subjects map to NATS subjects
+// or to Kafka topics, msgID is the key brokers deduplicate redeliveries on.
+type Publisher interface {
+	Publish(ctx context.Context, subject, msgID string, payload []byte) error
+	// Flush returns once the broker acknowledged every published message
+	Flush(ctx context.Context) error
+}
+
+// PublisherSink publishes every record on a subject derived from its type, see
+// RecordSubject. Messages are keyed on the record index.
+type PublisherSink struct {
+	name      string
+	prefix    string
+	publisher Publisher
+}
+
+// NewPublisherSink returns a sink publishing records with publisher on
+// subjects starting with prefix
+func NewPublisherSink(name, prefix string, publisher Publisher) *PublisherSink {
+	return &PublisherSink{name: name, prefix: prefix, publisher: publisher}
+}
+
+func (s *PublisherSink) Name() string {
+	return s.name
+}
+
+func (s *PublisherSink) Deliver(ctx context.Context, batch SinkBatch) error {
+	for _, record := range batch.Records {
+		payload, err := json.Marshal(record)
+		if err != nil {
+			return err
+		}
+		subject := RecordSubject(s.prefix, record.Type)
+		if err := s.publisher.Publish(ctx, subject, strconv.FormatUint(record.Index, 10), payload); err != nil {
+			return err
+		}
+	}
+	return s.publisher.Flush(ctx)
+}
+
+// RecordSubject returns the subject of a record type, e.g. the prefix
+// "elys.indexer" and "/elys.amm.MsgSwapByDenom" give
+// "elys.indexer.elys.amm.MsgSwapByDenom" and "/elys-event/perpetual/stop-loss"
+// gives "elys.indexer.elys-event.perpetual.stop-loss"
+func RecordSubject(prefix, recordType string) string {
+	subject := strings.ReplaceAll(strings.TrimPrefix(recordType, "/"), "/", ".")
+	if subject == "" {
+		subject = "unknown"
+	}
+	if prefix == "" {
+		return subject
+	}
+	return prefix + "." + subject
+}
+
+// NATSPublisher publishes to JetStream streams. The record index is sent as
+// Nats-Msg-Id so streams drop redelivered messages, and Flush waits for the
+// stream acknowledgement of every message. The connection is opened on first
+// use and reopened once closed.
+type NATSPublisher struct {
+	url     string
+	options []nats.Option
+
+	mu      sync.Mutex
+	conn    *nats.Conn
+	js      jetstream.JetStream
+	pending []jetstream.PubAckFuture
+}
+
+// NewNATSPublisher returns a publisher for a server URL such as nats://localhost:4222,
+// tlsConfig may be nil
+func NewNATSPublisher(serverURL string, tlsConfig *tls.Config) *NATSPublisher {
+	options := []nats.Option{nats.Name("elys-indexer"), nats.MaxReconnects(-1)}
+	if tlsConfig != nil {
+		options = append(options, nats.Secure(tlsConfig))
+	}
+	return &NATSPublisher{url: serverURL, options: options}
+}
+
+func (p *NATSPublisher) Publish(_ context.Context, subject, msgID string, payload []byte) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if err := p.connect(); err != nil {
+		return err
+	}
+	future, err := p.js.PublishMsgAsync(&nats.Msg{Subject: subject, Data: payload}, jetstream.WithMsgID(msgID))
+	if err != nil {
+		return err
+	}
+	p.pending = append(p.pending, future)
+	return nil
+}
+
+// Flush waits for the acknowledgement of every message published since the
+// last Flush and returns the first publish error
+func (p *NATSPublisher) Flush(ctx context.Context) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	pending := p.pending
+	p.pending = nil
+	for _, future := range pending {
+		select {
+		case <-future.Ok():
+		case err := <-future.Err():
+			return fmt.Errorf("message %s not acknowledged: %w", future.Msg().Header.Get(jetstream.MsgIDHeader), err)
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+// Close closes the connection to the server
+func (p *NATSPublisher) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.conn != nil {
+		p.conn.Close()
+	}
+	p.conn = nil
+	p.js = nil
+	p.pending = nil
+	return nil
+}
+
+func (p *NATSPublisher) connect() error {
+	if p.conn != nil && !p.conn.IsClosed() {
+		return nil
+	}
+	conn, err := nats.Connect(p.url, p.options...)
+	if err != nil {
+		return err
+	}
+	js, err := jetstream.New(conn)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	p.conn = conn
+	p.js = js
+	return nil
+}
+
+// KafkaPublisher produces to Kafka topics named after the subjects, keyed on
+// the record index. Records are produced idempotently and acknowledged by all
+// in-sync replicas, Flush returns once every record is acknowledged.
+type KafkaPublisher struct {
+	client *kgo.Client
+
+	mu  sync.Mutex
+	err error // First produce error since the last Flush
+}
+
+// NewKafkaPublisher returns a publisher for the given seed brokers, tlsConfig may be nil
+func NewKafkaPublisher(brokers []string, tlsConfig *tls.Config) (*KafkaPublisher, error) {
+	options := []kgo.Opt{
+		kgo.SeedBrokers(brokers...),
+		kgo.ClientID("elys-indexer"),
+		kgo.RequiredAcks(kgo.AllISRAcks()),
+		kgo.AllowAutoTopicCreation(),
+	}
+	if tlsConfig != nil {
+		options = append(options, kgo.DialTLSConfig(tlsConfig))
+	}
+	client, err := kgo.NewClient(options...)
+	if err != nil {
+		return nil, err
+	}
+	return &KafkaPublisher{client: client}, nil
+}
+
+func (p *KafkaPublisher) Publish(ctx context.Context, subject, msgID string, payload []byte) error {
+	record := &kgo.Record{Topic: subject, Key: []byte(msgID), Value: payload}
+	p.client.Produce(ctx, record, func(_ *kgo.Record, err error) {
+		if err == nil {
+			return
+		}
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		if p.err == nil {
+			p.err = fmt.Errorf("record %s not acknowledged: %w", msgID, err)
+		}
+	})
+	return nil
+}
+
+// Flush waits until every produced record is acknowledged and returns the
+// first produce error
+func (p *KafkaPublisher) Flush(ctx context.Context) error {
+	flushErr := p.client.Flush(ctx)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	err := p.err
+	p.err = nil
+	if flushErr != nil {
+		return flushErr
+	}
+	return err
+}
+
+// Close flushes and closes the client
+func (p *KafkaPublisher) Close() error {
+	p.client.Close()
+	return nil
+}
+
+// publisherTLSConfig returns the TLS configuration of the publishers, nil when
+// TLS is disabled. The CA file replaces the system roots, the certificate and
+// key files enable client authentication.
+func publisherTLSConfig(cfg Config) (*tls.Config, error) {
+	if !cfg.PublisherTLS {
+		return nil, nil
+	}
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if cfg.PublisherTLSCAFile != "" {
+		pem, err := os.ReadFile(cfg.PublisherTLSCAFile)
+		if err != nil {
+			return nil, err
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificate found in %s", cfg.PublisherTLSCAFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+	if cfg.PublisherTLSCertFile != "" || cfg.PublisherTLSKeyFile != "" {
+		certificate, err := tls.LoadX509KeyPair(cfg.PublisherTLSCertFile, cfg.PublisherTLSKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+	return tlsConfig, nil
+}
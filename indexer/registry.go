@@ -7,6 +7,7 @@ import (
 
 	"github.com/elys-network/elys/indexer/txs/amm"
 	"github.com/elys-network/elys/indexer/txs/assetprofile"
+	"github.com/elys-network/elys/indexer/txs/bank"
 	"github.com/elys-network/elys/indexer/txs/burner"
 	"github.com/elys-network/elys/indexer/txs/commitments"
 	"github.com/elys-network/elys/indexer/txs/estaking"
@@ -107,6 +108,10 @@ func init() {
 	RegisterTxType("/elys.tradeshield.MsgUpdateSpotOrder", reflect.TypeOf(tradeshield.MsgUpdateSpotOrder{}))
 	RegisterTxType("/elys.tradeshield.MsgCancelSpotOrder", reflect.TypeOf(tradeshield.MsgCancelSpotOrder{}))
 	RegisterTxType("/elys.tradeshield.MsgCreatePerpetualCloseOrder", reflect.TypeOf(tradeshield.MsgCreatePerpetualCloseOrder{}))
+	RegisterTxType("/elys.tradeshield.MsgCreateOrderGroup", reflect.TypeOf(tradeshield.MsgCreateOrderGroup{}))
+	RegisterTxType("/elys.tradeshield.MsgCancelOrderGroup", reflect.TypeOf(tradeshield.MsgCancelOrderGroup{}))
+	RegisterTxType("/elys.tradeshield.MsgCreateScheduledOrder", reflect.TypeOf(tradeshield.MsgCreateScheduledOrder{}))
+	RegisterTxType("/elys.tradeshield.MsgCancelScheduledOrder", reflect.TypeOf(tradeshield.MsgCancelScheduledOrder{}))
 
 	// Asset Profile
 	RegisterTxType("/elys.assetprofile.MsgAddEntry", reflect.TypeOf(assetprofile.MsgAddEntry{}))
@@ -144,22 +149,56 @@ func init() {
 	RegisterTxType("/elys.tokenomics.MsgDeleteTimeBasedInflation", reflect.TypeOf(tokenomics.MsgDeleteTimeBasedInflation{}))
 
 	// Register Events
+	RegisterEventType("/elys-event/bank/balance-checkpoint", reflect.TypeOf(bank.BalanceCheckpointEvent{}))
 	RegisterEventType("/elys-event/leveragelp/liquidation", reflect.TypeOf(leveragelp.LiquidationEvent{}))
 	RegisterEventType("/elys-event/leveragelp/stop-loss", reflect.TypeOf(leveragelp.StopLossEvent{}))
 	RegisterEventType("/elys-event/masterchef/claim-rewards", reflect.TypeOf(masterchef.ClaimRewardsEvent{}))
+	RegisterEventType("/elys-event/masterchef/revenue-collected", reflect.TypeOf(masterchef.RevenueCollectedEvent{}))
+	RegisterEventType("/elys-event/masterchef/fee-conversion", reflect.TypeOf(masterchef.FeeConversionEvent{}))
+	RegisterEventType("/elys-event/oracle/price-pruned", reflect.TypeOf(oracle.PricePrunedEvent{}))
 	RegisterEventType("/elys-event/perpetual/liquidation", reflect.TypeOf(perpetual.LiquidationEvent{}))
 	RegisterEventType("/elys-event/perpetual/stop-loss", reflect.TypeOf(perpetual.StopLossEvent{}))
 	RegisterEventType("/elys-event/perpetual/take-profit", reflect.TypeOf(perpetual.TakeProfitEvent{}))
+	RegisterEventType("/elys-event/perpetual/consolidate", reflect.TypeOf(perpetual.Consolidate{}))
+	RegisterEventType("/elys-event/perpetual/funding-settlement", reflect.TypeOf(perpetual.FundingSettlement{}))
+	RegisterEventType("/elys-event/perpetual/interest-settlement", reflect.TypeOf(perpetual.InterestSettlement{}))
 	RegisterEventType("/elys-event/tradeshield/stop-loss", reflect.TypeOf(tradeshield.StopLossExecutionEvent{}))
 	RegisterEventType("/elys-event/tradeshield/limit-sell", reflect.TypeOf(tradeshield.LimitSellExecutionEvent{}))
 	RegisterEventType("/elys-event/tradeshield/limit-buy", reflect.TypeOf(tradeshield.LimitOrderExecutionEvent{}))
 	RegisterEventType("/elys-event/tradeshield/market-buy", reflect.TypeOf(tradeshield.MarketOrderExecutionEvent{}))
+	RegisterEventType("/elys-event/tradeshield/perpetual-close", reflect.TypeOf(tradeshield.PerpetualCloseExecutionEvent{}))
+	RegisterEventType("/elys-event/tradeshield/order-execution", reflect.TypeOf(tradeshield.OrderExecutionEvent{}))
+	RegisterEventType("/elys-event/tradeshield/order-expiry", reflect.TypeOf(tradeshield.OrderExpiryEvent{}))
+	RegisterEventType("/elys-event/tradeshield/trailing-stop", reflect.TypeOf(tradeshield.TrailingStopEvent{}))
+	RegisterEventType("/elys-event/tradeshield/bracket-activation", reflect.TypeOf(tradeshield.BracketActivationEvent{}))
+	RegisterEventType("/elys-event/tradeshield/scheduled-order-slice", reflect.TypeOf(tradeshield.ScheduledOrderSliceEvent{}))
+
+	RegisterEventType("/elys-event/amm/trade", reflect.TypeOf(amm.Trade{}))
+
+	// Protocol snapshots
+	RegisterEventType("/elys-event/amm/pool-snapshot", reflect.TypeOf(amm.PoolSnapshot{}))
+	RegisterEventType("/elys-event/perpetual/pool-snapshot", reflect.TypeOf(perpetual.PoolSnapshot{}))
+	RegisterEventType("/elys-event/stablestake/snapshot", reflect.TypeOf(stablestake.Snapshot{}))
+	RegisterEventType("/elys-event/leveragelp/pool-snapshot", reflect.TypeOf(leveragelp.PoolSnapshot{}))
 }
 
 func RegisterTxType(txType string, dataType reflect.Type) {
 	txRegistry[txType] = dataType
 }
 
+// txTypeOf returns the transaction type proc is registered under, the
+// smallest one if several types share its data type
+func txTypeOf(proc types.Processor) string {
+	dataType := reflect.TypeOf(proc)
+	txType := ""
+	for name, registered := range txRegistry {
+		if registered == dataType && (txType == "" || name < txType) {
+			txType = name
+		}
+	}
+	return txType
+}
+
 func RegisterEventType(eventType string, dataType reflect.Type) {
 	eventRegistry[eventType] = dataType
 }
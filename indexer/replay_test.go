@@ -0,0 +1,395 @@
+package indexer_test
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsign "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/stretchr/testify/require"
+
+	elysapp "github.com/elys-network/elys/app"
+	"github.com/elys-network/elys/indexer"
+	indexerTypes "github.com/elys-network/elys/indexer/types"
+	ammtypes "github.com/elys-network/elys/x/amm/types"
+	assetprofiletypes "github.com/elys-network/elys/x/assetprofile/types"
+	oracletypes "github.com/elys-network/elys/x/oracle/types"
+	ptypes "github.com/elys-network/elys/x/parameter/types"
+	perpetualtypes "github.com/elys-network/elys/x/perpetual/types"
+	stablestaketypes "github.com/elys-network/elys/x/stablestake/types"
+	tradeshieldtypes "github.com/elys-network/elys/x/tradeshield/types"
+)
+
+// replayHarness runs blocks of signed transactions through a real ElysApp with
+// the indexer storing its records in a temporary directory
+type replayHarness struct {
+	t         *testing.T
+	app       *elysapp.ElysApp
+	txConfig  client.TxConfig
+	dataDir   string
+	valHash   []byte
+	blockTime time.Time
+}
+
+// replayAccount is a funded account signing the scripted transactions
+type replayAccount struct {
+	key      *secp256k1.PrivKey
+	address  sdk.AccAddress
+	number   uint64
+	sequence uint64
+}
+
+func newReplayHarness(t *testing.T) *replayHarness {
+	h := &replayHarness{
+		t:         t,
+		app:       elysapp.InitiateNewElysApp(t),
+		dataDir:   t.TempDir(),
+		blockTime: time.Date(2024, 11, 1, 0, 0, 0, 0, time.UTC),
+	}
+	h.txConfig = h.app.TxConfig()
+
+	// The indexer starts with the first block
+	cfg := indexer.DefaultConfig()
+	cfg.DataDir = h.dataDir
+	cfg.SnapshotInterval = 0
+	indexer.SetConfig(cfg)
+
+	genesisState, valSet, _, _ := elysapp.GenesisStateWithValSet(h.app)
+	stateBytes, err := json.Marshal(genesisState)
+	require.NoError(t, err)
+	_, err = h.app.InitChain(&abci.RequestInitChain{
+		Validators:      []abci.ValidatorUpdate{},
+		ConsensusParams: simtestutil.DefaultConsensusParams,
+		AppStateBytes:   stateBytes,
+	})
+	require.NoError(t, err)
+	h.valHash = valSet.Hash()
+	h.finalizeBlock()
+	return h
+}
+
+// setup writes state directly to the committed store, outside of any block
+func (h *replayHarness) setup(fn func(ctx sdk.Context)) {
+	ctx := h.app.NewUncachedContext(false, cmtproto.Header{
+		Height: h.app.LastBlockHeight(),
+		Time:   h.blockTime,
+	})
+	fn(ctx.WithExecMode(sdk.ExecModeFinalize))
+}
+
+// newAccount creates an account funded with coins
+func (h *replayHarness) newAccount(coins sdk.Coins) *replayAccount {
+	account := &replayAccount{key: secp256k1.GenPrivKey()}
+	account.address = sdk.AccAddress(account.key.PubKey().Address())
+	h.setup(func(ctx sdk.Context) {
+		require.NoError(h.t, h.app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins))
+		require.NoError(h.t, h.app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, account.address, coins))
+		account.number = h.app.AccountKeeper.GetAccount(ctx, account.address).GetAccountNumber()
+	})
+	return account
+}
+
+// signTx signs msgs by account with a fixed memo, so its hash is reproducible
+func (h *replayHarness) signTx(account *replayAccount, msgs ...sdk.Msg) []byte {
+	signMode, err := authsign.APISignModeToInternal(h.txConfig.SignModeHandler().DefaultMode())
+	require.NoError(h.t, err)
+
+	builder := h.txConfig.NewTxBuilder()
+	require.NoError(h.t, builder.SetMsgs(msgs...))
+	builder.SetMemo("replay")
+	builder.SetGasLimit(10_000_000)
+	signature := signing.SignatureV2{
+		PubKey:   account.key.PubKey(),
+		Data:     &signing.SingleSignatureData{SignMode: signMode},
+		Sequence: account.sequence,
+	}
+	require.NoError(h.t, builder.SetSignatures(signature))
+
+	signBytes, err := authsign.GetSignBytesAdapter(context.Background(), h.txConfig.SignModeHandler(), signMode, authsign.SignerData{
+		Address:       account.address.String(),
+		ChainID:       h.app.ChainID(),
+		AccountNumber: account.number,
+		Sequence:      account.sequence,
+		PubKey:        account.key.PubKey(),
+	}, builder.GetTx())
+	require.NoError(h.t, err)
+	signature.Data.(*signing.SingleSignatureData).Signature, err = account.key.Sign(signBytes)
+	require.NoError(h.t, err)
+	require.NoError(h.t, builder.SetSignatures(signature))
+
+	txBytes, err := h.txConfig.TxEncoder()(builder.GetTx())
+	require.NoError(h.t, err)
+	account.sequence++
+	return txBytes
+}
+
+// finalizeBlock executes and commits a block of transactions, requiring every
+// transaction to succeed
+func (h *replayHarness) finalizeBlock(txs ...[]byte) *abci.ResponseFinalizeBlock {
+	h.blockTime = h.blockTime.Add(5 * time.Second)
+	res, err := h.app.FinalizeBlock(&abci.RequestFinalizeBlock{
+		Height:             h.app.LastBlockHeight() + 1,
+		Time:               h.blockTime,
+		Txs:                txs,
+		NextValidatorsHash: h.valHash,
+	})
+	require.NoError(h.t, err)
+	for i, result := range res.TxResults {
+		require.Zero(h.t, result.Code, "tx %d of block %d failed: %s", i, h.app.LastBlockHeight()+1, result.Log)
+	}
+	_, err = h.app.Commit()
+	require.NoError(h.t, err)
+	return res
+}
+
+// simulate executes msgs like a client estimating gas, without delivering them
+func (h *replayHarness) simulate(account *replayAccount, msgs ...sdk.Msg) {
+	_, _, err := h.app.Simulate(h.signTx(account, msgs...))
+	require.NoError(h.t, err)
+	account.sequence--
+}
+
+// stop drains the indexer and opens its database for the assertions
+func (h *replayHarness) stop() *indexer.LMDBManager {
+	indexer.StopIndexer()
+	var count uint64
+	db, err := indexer.NewLMDBManager(h.dataDir, &count)
+	require.NoError(h.t, err)
+	h.t.Cleanup(func() { db.Close() })
+	return db
+}
+
+// replayRecord is the part of a stored record the replay asserts on
+type replayRecord struct {
+	Type   string
+	Height int64
+	Author string
+}
+
+func TestIndexerReplay(t *testing.T) {
+	h := newReplayHarness(t)
+	funds := sdk.NewCoins(
+		sdk.NewCoin(ptypes.Elys, math.NewInt(1_000_000_000_000)),
+		sdk.NewCoin(ptypes.BaseCurrency, math.NewInt(1_000_000_000_000)),
+		sdk.NewCoin(ptypes.ATOM, math.NewInt(1_000_000_000_000)),
+	)
+	creator := h.newAccount(funds)
+	trader := h.newAccount(funds)
+	bonder := h.newAccount(funds)
+
+	h.setup(func(ctx sdk.Context) {
+		for _, asset := range []struct {
+			denom, display string
+			price          math.LegacyDec
+		}{
+			{ptypes.BaseCurrency, "USDC", math.LegacyOneDec()},
+			{ptypes.ATOM, "ATOM", math.LegacyNewDec(5)},
+			{ptypes.Elys, "ELYS", math.LegacyNewDec(3)},
+		} {
+			h.app.AssetprofileKeeper.SetEntry(ctx, assetprofiletypes.Entry{
+				BaseDenom: asset.denom, Denom: asset.denom, Decimals: 6, DisplayName: asset.display,
+				CommitEnabled: true, WithdrawEnabled: true,
+			})
+			h.app.OracleKeeper.SetAssetInfo(ctx, oracletypes.AssetInfo{Denom: asset.denom, Display: asset.display, Decimal: 6})
+			h.app.OracleKeeper.SetPrice(ctx, oracletypes.Price{
+				Asset: asset.display, Price: asset.price, Source: "elys", Provider: creator.address.String(),
+				Timestamp: uint64(h.blockTime.Add(time.Hour).Unix()), BlockHeight: uint64(ctx.BlockHeight()) + 1000,
+			})
+		}
+
+		ammParams := h.app.AmmKeeper.GetParams(ctx)
+		ammParams.AllowedPoolCreators = []string{creator.address.String()}
+		ammParams.BaseAssets = []string{ptypes.BaseCurrency}
+		h.app.AmmKeeper.SetParams(ctx, ammParams)
+
+		perpetualParams := perpetualtypes.DefaultGenesis().Params
+		require.NoError(t, h.app.PerpetualKeeper.SetParams(ctx, &perpetualParams))
+	})
+
+	// Block 1: a pool of USDC and ATOM
+	h.finalizeBlock(h.signTx(creator, &ammtypes.MsgCreatePool{
+		Sender: creator.address.String(),
+		PoolParams: ammtypes.PoolParams{
+			UseOracle: true,
+			SwapFee:   math.LegacyZeroDec(),
+			FeeDenom:  ptypes.BaseCurrency,
+		},
+		PoolAssets: []ammtypes.PoolAsset{
+			{Token: sdk.NewCoin(ptypes.ATOM, math.NewInt(100_000_000_000)), Weight: math.NewInt(10), ExternalLiquidityRatio: math.LegacyNewDec(2)},
+			{Token: sdk.NewCoin(ptypes.BaseCurrency, math.NewInt(500_000_000_000)), Weight: math.NewInt(10), ExternalLiquidityRatio: math.LegacyNewDec(2)},
+		},
+	}))
+	h.setup(func(ctx sdk.Context) {
+		ammPool, found := h.app.AmmKeeper.GetPool(ctx, 1)
+		require.True(t, found)
+		h.app.PerpetualKeeper.SetPool(ctx, perpetualtypes.NewPool(ammPool))
+		require.NoError(t, h.app.AccountedPoolKeeper.OnLeverageLpPoolEnable(ctx, ammPool))
+	})
+
+	// Simulated messages are never indexed
+	h.simulate(creator, &stablestaketypes.MsgBond{
+		Creator: creator.address.String(),
+		Amount:  math.NewInt(10_000_000),
+	})
+
+	// Block 2: a swap, a stablestake bond and a perpetual position
+	h.finalizeBlock(
+		h.signTx(trader, &ammtypes.MsgSwapExactAmountIn{
+			Sender:            trader.address.String(),
+			Routes:            []ammtypes.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: ptypes.ATOM}},
+			TokenIn:           sdk.NewCoin(ptypes.BaseCurrency, math.NewInt(1_000_000)),
+			TokenOutMinAmount: math.ZeroInt(),
+			Recipient:         trader.address.String(),
+		}),
+		h.signTx(bonder, &stablestaketypes.MsgBond{
+			Creator: bonder.address.String(),
+			Amount:  math.NewInt(10_000_000),
+		}),
+		h.signTx(trader, &perpetualtypes.MsgOpen{
+			Creator:         trader.address.String(),
+			Position:        perpetualtypes.Position_LONG,
+			Leverage:        math.LegacyNewDec(2),
+			Collateral:      sdk.NewCoin(ptypes.BaseCurrency, math.NewInt(10_000_000)),
+			TradingAsset:    ptypes.ATOM,
+			TakeProfitPrice: math.LegacyNewDec(20),
+			StopLossPrice:   math.LegacyZeroDec(),
+			PoolId:          1,
+		}),
+	)
+
+	// Block 3: tradeshield orders and the close of the position
+	h.finalizeBlock(
+		h.signTx(trader, &tradeshieldtypes.MsgCreateSpotOrder{
+			OrderType:        tradeshieldtypes.SpotOrderType_LIMITBUY,
+			OrderPrice:       tradeshieldtypes.OrderPrice{BaseDenom: ptypes.ATOM, QuoteDenom: ptypes.BaseCurrency, Rate: math.LegacyNewDec(4)},
+			OrderAmount:      sdk.NewCoin(ptypes.BaseCurrency, math.NewInt(4_000_000)),
+			OwnerAddress:     trader.address.String(),
+			OrderTargetDenom: ptypes.ATOM,
+		}),
+		h.signTx(trader, &tradeshieldtypes.MsgCancelSpotOrder{
+			OwnerAddress: trader.address.String(),
+			OrderId:      1,
+		}),
+		h.signTx(bonder, &tradeshieldtypes.MsgCreateSpotOrder{
+			OrderType:        tradeshieldtypes.SpotOrderType_MARKETBUY,
+			OrderPrice:       tradeshieldtypes.OrderPrice{BaseDenom: ptypes.ATOM, QuoteDenom: ptypes.BaseCurrency, Rate: math.LegacyNewDec(5)},
+			OrderAmount:      sdk.NewCoin(ptypes.BaseCurrency, math.NewInt(1_000_000)),
+			OwnerAddress:     bonder.address.String(),
+			OrderTargetDenom: ptypes.ATOM,
+		}),
+		h.signTx(trader, &perpetualtypes.MsgClose{
+			Creator: trader.address.String(),
+			Id:      1,
+			Amount:  math.NewInt(1_000_000_000_000),
+		}),
+	)
+
+	db := h.stop()
+	trade := indexerTypes.ElysEventTypes.Amm.Trade
+	marketBuy := indexerTypes.ElysEventTypes.Tradeshield.MarketBuy
+
+	// Every committed message is recorded once. The swap request is only
+	// recorded by the end blocker executing it, the market order by its
+	// execution event and the swap it settles in its transaction.
+	var records []replayRecord
+	for i := uint64(1); i <= db.GetRecordCount(); i++ {
+		record, err := db.GetRecordByIndex(i)
+		require.NoError(t, err)
+		records = append(records, replayRecord{Type: record.Type(), Height: record.BlockHeight(), Author: recordAuthor(record)})
+	}
+	require.Equal(t, sortedReplayRecords(
+		replayRecord{"/elys.amm.MsgCreatePool", 2, creator.address.String()},
+		replayRecord{"/elys.amm.MsgSwapExactAmountIn", 3, trader.address.String()},
+		replayRecord{"/elys.stablestake.MsgBond", 3, bonder.address.String()},
+		replayRecord{"/elys.perpetual.MsgOpen", 3, trader.address.String()},
+		replayRecord{trade, 3, ""},
+		replayRecord{"/elys.tradeshield.MsgCreateSpotOrder", 4, trader.address.String()},
+		replayRecord{"/elys.tradeshield.MsgCancelSpotOrder", 4, trader.address.String()},
+		replayRecord{"/elys.perpetual.MsgClose", 4, trader.address.String()},
+		replayRecord{marketBuy, 4, ""},
+		replayRecord{trade, 4, ""},
+	), sortedReplayRecords(records...))
+
+	// The records are fanned out once to each involved address
+	for _, fanOut := range []struct {
+		account *replayAccount
+		types   []string
+	}{
+		{creator, []string{"/elys.amm.MsgCreatePool"}},
+		{trader, []string{
+			"/elys.amm.MsgSwapExactAmountIn", "/elys.perpetual.MsgOpen", trade,
+			"/elys.tradeshield.MsgCreateSpotOrder", "/elys.tradeshield.MsgCancelSpotOrder", "/elys.perpetual.MsgClose",
+		}},
+		{bonder, []string{"/elys.stablestake.MsgBond", marketBuy, trade}},
+	} {
+		addressRecords, err := db.GetRecordsByAddress(fanOut.account.address.String())
+		require.NoError(t, err)
+		var types []string
+		for _, record := range addressRecords {
+			types = append(types, record.Type())
+		}
+		sort.Strings(types)
+		sort.Strings(fanOut.types)
+		require.Equal(t, fanOut.types, types, fanOut.account.address.String())
+	}
+
+	swaps, err := db.GetRecordsByType(trade)
+	require.NoError(t, err)
+	require.Len(t, swaps, 2)
+	var phases []string
+	for _, swap := range swaps {
+		_, phase, _, _, err := indexer.ParseEventID(swap.Event.BaseEvent.EventID)
+		require.NoError(t, err)
+		phases = append(phases, phase)
+
+		// Trades are quoted in the base currency
+		var data struct {
+			BaseDenom  string `json:"base_denom"`
+			QuoteDenom string `json:"quote_denom"`
+		}
+		bz, err := json.Marshal(swap.Event.Data)
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(bz, &data))
+		require.Equal(t, ptypes.ATOM, data.BaseDenom)
+		require.Equal(t, ptypes.BaseCurrency, data.QuoteDenom)
+	}
+	sort.Strings(phases)
+	require.Equal(t, []string{indexer.EventPhaseEndBlock, indexer.EventPhaseTx}, phases)
+
+	// Replaying stored transactions and events is rejected
+	count := db.GetRecordCount()
+	for i := uint64(1); i <= count; i++ {
+		record, err := db.GetRecordByIndex(i)
+		require.NoError(t, err)
+		require.Error(t, db.ProcessRecord(record, recordAuthor(record)))
+	}
+	require.Equal(t, count, db.GetRecordCount())
+}
+
+func sortedReplayRecords(records ...replayRecord) []replayRecord {
+	sort.Slice(records, func(i, j int) bool {
+		if records[i].Height != records[j].Height {
+			return records[i].Height < records[j].Height
+		}
+		return records[i].Type < records[j].Type
+	})
+	return records
+}
+
+func recordAuthor(record indexerTypes.GenericRecord) string {
+	if record.IsTransaction() {
+		return record.Transaction.BaseTransaction.Author
+	}
+	return record.Event.BaseEvent.Author
+}
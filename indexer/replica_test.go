@@ -0,0 +1,88 @@
+package indexer
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/elys-network/elys/indexer/txs/masterchef"
+	indexerTypes "github.com/elys-network/elys/indexer/types"
+)
+
+const (
+	replicaWriterDirEnv   = "INDEXER_REPLICA_WRITER_DIR"
+	replicaWriterCountEnv = "INDEXER_REPLICA_WRITER_COUNT"
+)
+
+var replicaStart = time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+
+// TestReplicaWriter stores records in the data directory of
+// TestReadOnlyReplica from a separate process
+func TestReplicaWriter(t *testing.T) {
+	dir := os.Getenv(replicaWriterDirEnv)
+	if dir == "" {
+		t.Skip("run by TestReadOnlyReplica")
+	}
+	count, err := strconv.Atoi(os.Getenv(replicaWriterCountEnv))
+	require.NoError(t, err)
+
+	var length uint64
+	db, err := NewLMDBManager(dir, &length)
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Large records to outgrow the map of the reader
+	address := strings.Repeat("a", 8<<10)
+	for i := 0; i < count; i++ {
+		height := int64(length) + 1
+		storeEvent(t, db, FormatEventID(height, EventPhaseEndBlock, "masterchef", 1), indexerTypes.ElysEventTypes.Masterchef.FeeConversion,
+			height, replicaStart, masterchef.FeeConversionEvent{Address: address, PoolID: uint64(height)})
+	}
+}
+
+func runReplicaWriter(t *testing.T, dir string, count int) {
+	cmd := exec.Command(os.Args[0], "-test.run=^TestReplicaWriter$")
+	cmd.Env = append(os.Environ(), replicaWriterDirEnv+"="+dir, fmt.Sprintf("%s=%d", replicaWriterCountEnv, count))
+	output, err := cmd.CombinedOutput()
+	require.NoError(t, err, string(output))
+}
+
+func TestReadOnlyReplica(t *testing.T) {
+	dir := t.TempDir()
+
+	_, err := OpenLMDBReadOnly(dir)
+	require.Error(t, err, "the writer creates the data files")
+
+	runReplicaWriter(t, dir, 2)
+	replica, err := OpenLMDBReadOnly(dir)
+	require.NoError(t, err)
+	defer replica.Close()
+	require.Equal(t, uint64(2), replica.GetRecordCount())
+
+	record, err := replica.GetRecordByIndex(2)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), record.BlockHeight())
+	require.ErrorIs(t, replica.ProcessRecord(record, ""), ErrReadOnly)
+
+	// Shrink the map to the data written so far, as if the replica was opened
+	// before the writer grew it
+	require.NoError(t, replica.env.SetMapSize(1))
+	info, err := replica.env.Info()
+	require.NoError(t, err)
+
+	runReplicaWriter(t, dir, 2*int(info.MapSize)/(8<<10))
+	records, err := replica.GetRecordsByType(indexerTypes.ElysEventTypes.Masterchef.FeeConversion)
+	require.NoError(t, err)
+	require.Len(t, records, int(replica.GetRecordCount()))
+	require.Greater(t, len(records), 2)
+
+	grown, err := replica.env.Info()
+	require.NoError(t, err)
+	require.Greater(t, grown.MapSize, info.MapSize)
+}
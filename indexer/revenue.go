@@ -0,0 +1,152 @@
+package indexer
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"cosmossdk.io/math"
+	"github.com/bmatsuo/lmdb-go/lmdb"
+
+	"github.com/elys-network/elys/indexer/txs/masterchef"
+	indexerTypes "github.com/elys-network/elys/indexer/types"
+)
+
+// Revenue aggregation dimensions
+const (
+	RevenueBySource = "source"
+	RevenueByPool   = "pool"
+)
+
+// RevenueBucket sums the revenue collected on one day for a source or a pool.
+// Collected maps each denom to its summed amount, Lps, Stakers and Protocol
+// sum the USDC portions added to FeeInfo for the same day.
+type RevenueBucket struct {
+	Date      string            `json:"date"`
+	Dimension string            `json:"dimension"`
+	Bucket    string            `json:"bucket"`
+	Collected map[string]string `json:"collected"`
+	Lps       string            `json:"lps"`
+	Stakers   string            `json:"stakers"`
+	Protocol  string            `json:"protocol"`
+	Count     uint64            `json:"count"`
+}
+
+// revenueAggregator sums the collected revenue per day, by source and by pool.
+// Only DEX revenue is collected per pool.
+// - revenueDB: "<dimension>/<date>/<bucket>" -> RevenueBucket
+type revenueAggregator struct {
+	revenueDB lmdb.DBI
+}
+
+var revenue = &revenueAggregator{}
+
+func init() {
+	RegisterAggregator(revenue)
+}
+
+func (a *revenueAggregator) Name() string {
+	return "revenue"
+}
+
+func (a *revenueAggregator) Open(txn *lmdb.Txn) (err error) {
+	a.revenueDB, err = txn.OpenDBI("revenue", lmdb.Create)
+	return err
+}
+
+func (a *revenueAggregator) Apply(txn *lmdb.Txn, index uint64, record indexerTypes.GenericRecord) error {
+	data, ok := recordData(record)
+	if !ok {
+		return nil
+	}
+	event, ok := data.(masterchef.RevenueCollectedEvent)
+	if !ok {
+		return nil
+	}
+
+	date := record.BlockTime().UTC().Format("2006-01-02")
+	buckets := map[string]string{RevenueBySource: event.Source}
+	if event.Source == masterchef.RevenueSourceDex {
+		buckets[RevenueByPool] = strconv.FormatUint(event.PoolID, 10)
+	}
+	for dimension, bucket := range buckets {
+		key := revenueKey(dimension, date, bucket)
+		entry := RevenueBucket{
+			Date:      date,
+			Dimension: dimension,
+			Bucket:    bucket,
+			Collected: make(map[string]string),
+		}
+		if err := getJSON(txn, a.revenueDB, key, &entry); err != nil {
+			return err
+		}
+
+		for _, token := range event.Collected {
+			amount, ok := math.NewIntFromString(token.Amount)
+			if !ok {
+				return fmt.Errorf("invalid revenue amount %q", token.Amount)
+			}
+			entry.Collected[token.Denom] = addIntStrings(entry.Collected[token.Denom], amount)
+		}
+		entry.Lps = addIntStrings(entry.Lps, balanceAmount(event.Lps))
+		entry.Stakers = addIntStrings(entry.Stakers, balanceAmount(event.Stakers))
+		entry.Protocol = addIntStrings(entry.Protocol, balanceAmount(event.Protocol))
+		entry.Count++
+
+		if err := putJSON(txn, a.revenueDB, key, entry); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetRevenue returns the daily revenue buckets of a dimension, ordered by day
+// then bucket. from and to bound the days when set, e.g. "2024-01-01" to
+// "2024-01-31", and bucket selects a single source or pool when set.
+func (m *LMDBManager) GetRevenue(dimension, from, to, bucket string) ([]RevenueBucket, error) {
+	if dimension != RevenueBySource && dimension != RevenueByPool {
+		return nil, fmt.Errorf("unknown revenue dimension: %s", dimension)
+	}
+
+	var result []RevenueBucket
+	err := m.view(func(txn *lmdb.Txn) error {
+		cursor, err := txn.OpenCursor(revenue.revenueDB)
+		if err != nil {
+			return err
+		}
+		defer cursor.Close()
+
+		prefix := dimension + "/"
+		key, value, err := cursor.Get([]byte(prefix+from), nil, lmdb.SetRange)
+		for ; err == nil; key, value, err = cursor.Get(nil, nil, lmdb.Next) {
+			if len(key) < len(prefix) || string(key[:len(prefix)]) != prefix {
+				return nil
+			}
+			var entry RevenueBucket
+			if err := json.Unmarshal(value, &entry); err != nil {
+				return err
+			}
+			if to != "" && entry.Date > to {
+				return nil
+			}
+			if bucket == "" || entry.Bucket == bucket {
+				result = append(result, entry)
+			}
+		}
+		if lmdb.IsNotFound(err) {
+			return nil
+		}
+		return err
+	})
+	return result, err
+}
+
+// revenueKey orders the buckets of a dimension by day, pools by numeric id
+func revenueKey(dimension, date, bucket string) []byte {
+	if dimension == RevenueByPool {
+		if poolID, err := strconv.ParseUint(bucket, 10, 64); err == nil {
+			bucket = fmt.Sprintf("%020d", poolID)
+		}
+	}
+	return []byte(fmt.Sprintf("%s/%s/%s", dimension, date, bucket))
+}
@@ -0,0 +1,72 @@
+package indexer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/elys-network/elys/indexer/txs/masterchef"
+	indexerTypes "github.com/elys-network/elys/indexer/types"
+)
+
+func TestRevenueAggregation(t *testing.T) {
+	db := newTestDatabase(t)
+	day1 := time.Date(2024, 5, 1, 23, 0, 0, 0, time.UTC)
+	day2 := day1.Add(2 * time.Hour)
+	eventType := indexerTypes.ElysEventTypes.Masterchef.RevenueCollected
+
+	collect := func(height int64, blockTime time.Time, sequence uint64, event masterchef.RevenueCollectedEvent) {
+		storeEvent(t, db, FormatEventID(height, EventPhaseEndBlock, "masterchef", sequence), eventType, height, blockTime, event)
+	}
+	usdc := func(amount string) []indexerTypes.Token {
+		return []indexerTypes.Token{{Amount: amount, Denom: "uusdc"}}
+	}
+	collect(10, day1, 1, masterchef.RevenueCollectedEvent{Source: masterchef.RevenueSourceGas, Collected: usdc("100"), Lps: "60", Stakers: "25", Protocol: "15"})
+	collect(10, day1, 2, masterchef.RevenueCollectedEvent{Source: masterchef.RevenueSourceDex, PoolID: 2, Collected: usdc("40"), Lps: "24", Stakers: "10", Protocol: "6"})
+	collect(10, day1, 3, masterchef.RevenueCollectedEvent{Source: masterchef.RevenueSourceDex, PoolID: 10, Collected: usdc("10"), Lps: "6", Stakers: "2", Protocol: "2"})
+	collect(11, day1, 1, masterchef.RevenueCollectedEvent{Source: masterchef.RevenueSourceGas, Collected: usdc("50"), Lps: "30", Stakers: "12", Protocol: "8"})
+	collect(20, day2, 1, masterchef.RevenueCollectedEvent{Source: masterchef.RevenueSourcePerp, Collected: usdc("7"), Lps: "4", Stakers: "1", Protocol: "2"})
+	collect(20, day2, 2, masterchef.RevenueCollectedEvent{
+		Source: masterchef.RevenueSourceDex, PoolID: 2,
+		Collected: []indexerTypes.Token{{Amount: "5", Denom: "uusdc"}, {Amount: "3", Denom: "uatom"}},
+		Lps:       "3", Stakers: "1", Protocol: "1",
+	})
+
+	sources, err := db.GetRevenue(RevenueBySource, "", "", "")
+	require.NoError(t, err)
+	require.Len(t, sources, 4)
+	require.Equal(t, RevenueBucket{
+		Date: "2024-05-01", Dimension: RevenueBySource, Bucket: masterchef.RevenueSourceGas,
+		Collected: map[string]string{"uusdc": "150"}, Lps: "90", Stakers: "37", Protocol: "23", Count: 2,
+	}, sources[1])
+	require.Equal(t, "2024-05-01", sources[0].Date)
+	require.Equal(t, masterchef.RevenueSourceDex, sources[0].Bucket)
+	require.Equal(t, "50", sources[0].Collected["uusdc"])
+	require.Equal(t, "2024-05-02", sources[2].Date)
+	require.Equal(t, map[string]string{"uusdc": "5", "uatom": "3"}, sources[2].Collected)
+
+	// Pools are ordered by id within a day
+	pools, err := db.GetRevenue(RevenueByPool, "2024-05-01", "2024-05-01", "")
+	require.NoError(t, err)
+	require.Len(t, pools, 2)
+	require.Equal(t, "2", pools[0].Bucket)
+	require.Equal(t, "24", pools[0].Lps)
+	require.Equal(t, "10", pools[1].Bucket)
+
+	pools, err = db.GetRevenue(RevenueByPool, "", "", "2")
+	require.NoError(t, err)
+	require.Len(t, pools, 2)
+	require.Equal(t, "2024-05-02", pools[1].Date)
+
+	perp, err := db.GetRevenue(RevenueBySource, "2024-05-02", "", masterchef.RevenueSourcePerp)
+	require.NoError(t, err)
+	require.Len(t, perp, 1)
+	require.Equal(t, "7", perp[0].Collected["uusdc"])
+
+	_, err = db.GetRevenue("asset", "", "", "")
+	require.Error(t, err)
+
+	require.JSONEq(t, `{"data":{"revenue":[{"bucket":"10","protocol":"2"}]}}`,
+		executeGraphQL(t, db, `{ revenue(dimension: "pool", bucket: "10") { bucket protocol } }`, nil))
+}
@@ -0,0 +1,327 @@
+package indexer
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	indexerTypes "github.com/elys-network/elys/indexer/types"
+)
+
+// SchemaVersion is the version of the record schemas. It must be bumped when a
+// field of a record is removed or changes type, which TestSchemaCompatibility
+// enforces against testdata/schemas.json.
+const SchemaVersion = 1
+
+const jsonSchemaDialect = "https://json-schema.org/draft/2020-12/schema"
+
+// JSONSchema is a JSON Schema (draft 2020-12) document or subschema
+type JSONSchema struct {
+	Schema               string                 `json:"$schema,omitempty"`
+	ID                   string                 `json:"$id,omitempty"`
+	Title                string                 `json:"title,omitempty"`
+	Version              int                    `json:"x-schema-version,omitempty"`
+	Type                 SchemaType             `json:"type,omitempty"`
+	Format               string                 `json:"format,omitempty"`
+	Properties           map[string]*JSONSchema `json:"properties,omitempty"`
+	Required             []string               `json:"required,omitempty"`
+	Items                *JSONSchema            `json:"items,omitempty"`
+	AdditionalProperties *JSONSchema            `json:"additionalProperties,omitempty"`
+}
+
+// SchemaType is the "type" keyword, a single type or a list such as
+// ["array", "null"]
+type SchemaType []string
+
+func (t SchemaType) MarshalJSON() ([]byte, error) {
+	if len(t) == 1 {
+		return json.Marshal(t[0])
+	}
+	return json.Marshal([]string(t))
+}
+
+func (t *SchemaType) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*t = SchemaType{single}
+		return nil
+	}
+	return json.Unmarshal(data, (*[]string)(t))
+}
+
+// SchemaSet is the versioned set of record schemas served by the query API
+type SchemaSet struct {
+	Version int                    `json:"version"`
+	Schemas map[string]*JSONSchema `json:"schemas"`
+}
+
+// RecordSchemas returns the schema of every registered transaction and event
+// record, keyed by type
+func RecordSchemas() map[string]*JSONSchema {
+	schemas := make(map[string]*JSONSchema, len(txRegistry)+len(eventRegistry))
+	for recordType := range txRegistry {
+		schemas[recordType], _ = RecordSchema(recordType)
+	}
+	for recordType := range eventRegistry {
+		schemas[recordType], _ = RecordSchema(recordType)
+	}
+	return schemas
+}
+
+// RecordSchema returns the schema of the stored records of a transaction or
+// event type, e.g. "/elys.amm.MsgSwapByDenom"
+func RecordSchema(recordType string) (*JSONSchema, bool) {
+	var envelope, base string
+	var baseType, dataType reflect.Type
+	if t, ok := txRegistry[recordType]; ok {
+		envelope, base, baseType, dataType = "transaction", "base_transaction", reflect.TypeOf(indexerTypes.BaseTransaction{}), t
+	} else if t, ok := eventRegistry[recordType]; ok {
+		envelope, base, baseType, dataType = "event", "base_event", reflect.TypeOf(indexerTypes.BaseEvent{}), t
+	} else {
+		return nil, false
+	}
+
+	record := objectSchema(map[string]*JSONSchema{
+		envelope: objectSchema(map[string]*JSONSchema{
+			base:   schemaOf(baseType),
+			"data": schemaOf(dataType),
+		}),
+	})
+	record.Schema = jsonSchemaDialect
+	record.ID = fmt.Sprintf("elys-indexer/v%d%s", SchemaVersion, recordType)
+	record.Title = recordType
+	record.Version = SchemaVersion
+	return record, true
+}
+
+// objectSchema returns an object schema requiring all of its properties
+func objectSchema(properties map[string]*JSONSchema) *JSONSchema {
+	schema := &JSONSchema{Type: SchemaType{"object"}, Properties: properties}
+	for name := range properties {
+		schema.Required = append(schema.Required, name)
+	}
+	sort.Strings(schema.Required)
+	return schema
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// schemaOf describes the encoding/json output of values of type t
+func schemaOf(t reflect.Type) *JSONSchema {
+	nullable := false
+	for t.Kind() == reflect.Pointer {
+		t, nullable = t.Elem(), true
+	}
+
+	var schema *JSONSchema
+	switch {
+	case t == timeType:
+		schema = &JSONSchema{Type: SchemaType{"string"}, Format: "date-time"}
+	case t.Kind() == reflect.Struct:
+		schema = structSchema(t)
+	case t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8:
+		schema = &JSONSchema{Type: SchemaType{"string"}, Format: "byte"}
+		nullable = true
+	case t.Kind() == reflect.Slice || t.Kind() == reflect.Array:
+		schema = &JSONSchema{Type: SchemaType{"array"}, Items: schemaOf(t.Elem())}
+		nullable = nullable || t.Kind() == reflect.Slice
+	case t.Kind() == reflect.Map:
+		schema = &JSONSchema{Type: SchemaType{"object"}, AdditionalProperties: schemaOf(t.Elem())}
+		nullable = true
+	case t.Kind() == reflect.Interface:
+		// Any JSON value
+		return &JSONSchema{}
+	case t.Kind() == reflect.String:
+		schema = &JSONSchema{Type: SchemaType{"string"}}
+	case t.Kind() == reflect.Bool:
+		schema = &JSONSchema{Type: SchemaType{"boolean"}}
+	case t.Kind() >= reflect.Int && t.Kind() <= reflect.Uintptr:
+		schema = &JSONSchema{Type: SchemaType{"integer"}}
+	case t.Kind() == reflect.Float32 || t.Kind() == reflect.Float64:
+		schema = &JSONSchema{Type: SchemaType{"number"}}
+	default:
+		panic(fmt.Sprintf("no JSON schema for type %s", t))
+	}
+	if nullable {
+		schema.Type = append(schema.Type, "null")
+	}
+	return schema
+}
+
+// structSchema follows the encoding/json field rules: json tags, skipped
+// unexported fields and flattened embedded structs
+func structSchema(t reflect.Type) *JSONSchema {
+	schema := &JSONSchema{Type: SchemaType{"object"}, Properties: map[string]*JSONSchema{}}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, options, _ := strings.Cut(tag, ",")
+
+		fieldType := field.Type
+		for fieldType.Kind() == reflect.Pointer {
+			fieldType = fieldType.Elem()
+		}
+		if field.Anonymous && name == "" && fieldType.Kind() == reflect.Struct {
+			embedded := structSchema(fieldType)
+			for property, propertySchema := range embedded.Properties {
+				if _, ok := schema.Properties[property]; !ok {
+					schema.Properties[property] = propertySchema
+				}
+			}
+			schema.Required = append(schema.Required, embedded.Required...)
+			continue
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+
+		propertySchema := schemaOf(field.Type)
+		if strings.Contains(options, "string") {
+			propertySchema = &JSONSchema{Type: SchemaType{"string"}}
+		}
+		schema.Properties[name] = propertySchema
+		if !strings.Contains(options, "omitempty") {
+			schema.Required = append(schema.Required, name)
+		}
+	}
+	sort.Strings(schema.Required)
+	schema.Required = dedupSorted(schema.Required)
+	return schema
+}
+
+func dedupSorted(values []string) []string {
+	out := values[:0]
+	for _, value := range values {
+		if len(out) == 0 || value != out[len(out)-1] {
+			out = append(out, value)
+		}
+	}
+	return out
+}
+
+// SchemaBreakingChanges lists the changes from old to new that break consumers
+// of old: removed record types, removed properties and changed types.
+// Added record types and properties are compatible.
+func SchemaBreakingChanges(old, new map[string]*JSONSchema) []string {
+	var changes []string
+	for recordType, oldSchema := range old {
+		newSchema, ok := new[recordType]
+		if !ok {
+			changes = append(changes, fmt.Sprintf("%s: record type removed", recordType))
+			continue
+		}
+		changes = append(changes, schemaBreakingChanges(recordType, oldSchema, newSchema)...)
+	}
+	sort.Strings(changes)
+	return changes
+}
+
+func schemaBreakingChanges(path string, old, new *JSONSchema) []string {
+	// A schema accepting any value accepts everything old values were
+	if len(new.Type) == 0 {
+		return nil
+	}
+	if !sameStrings(old.Type, new.Type) || old.Format != new.Format {
+		return []string{fmt.Sprintf("%s: type changed from %s to %s", path, describeSchemaType(old), describeSchemaType(new))}
+	}
+
+	var changes []string
+	for name, oldProperty := range old.Properties {
+		newProperty, ok := new.Properties[name]
+		if !ok {
+			changes = append(changes, fmt.Sprintf("%s.%s: property removed", path, name))
+			continue
+		}
+		changes = append(changes, schemaBreakingChanges(path+"."+name, oldProperty, newProperty)...)
+	}
+	if old.Items != nil && new.Items != nil {
+		changes = append(changes, schemaBreakingChanges(path+"[]", old.Items, new.Items)...)
+	}
+	if old.AdditionalProperties != nil && new.AdditionalProperties != nil {
+		changes = append(changes, schemaBreakingChanges(path+"{}", old.AdditionalProperties, new.AdditionalProperties)...)
+	}
+	return changes
+}
+
+func sameStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func describeSchemaType(schema *JSONSchema) string {
+	if len(schema.Type) == 0 {
+		return "any"
+	}
+	description := strings.Join(schema.Type, "|")
+	if schema.Format != "" {
+		description += " (" + schema.Format + ")"
+	}
+	return description
+}
+
+// OpenAPIDocument describes the record routes of the query API as an OpenAPI
+// 3.1 document, with one component schema per record type
+func OpenAPIDocument() map[string]interface{} {
+	components := make(map[string]interface{})
+	var records []map[string]string
+	for recordType, schema := range RecordSchemas() {
+		name := strings.ReplaceAll(strings.TrimPrefix(recordType, "/"), "/", ".")
+		component := *schema
+		component.Schema, component.ID = "", ""
+		components[name] = &component
+		records = append(records, map[string]string{"$ref": "#/components/schemas/" + name})
+	}
+	sort.Slice(records, func(i, j int) bool { return records[i]["$ref"] < records[j]["$ref"] })
+	components["Record"] = map[string]interface{}{"oneOf": records}
+
+	record := map[string]string{"$ref": "#/components/schemas/Record"}
+	recordList := map[string]interface{}{"type": "array", "items": record}
+	response := func(schema interface{}) map[string]interface{} {
+		return map[string]interface{}{"200": map[string]interface{}{
+			"description": "OK",
+			"content":     map[string]interface{}{"application/json": map[string]interface{}{"schema": schema}},
+		}}
+	}
+	parameter := func(name, in string) map[string]interface{} {
+		return map[string]interface{}{"name": name, "in": in, "required": true, "schema": map[string]string{"type": "string"}}
+	}
+
+	return map[string]interface{}{
+		"openapi": "3.1.0",
+		"info": map[string]interface{}{
+			"title":   "Elys indexer records",
+			"version": fmt.Sprintf("%d", SchemaVersion),
+		},
+		"paths": map[string]interface{}{
+			"/records": map[string]interface{}{"get": map[string]interface{}{
+				"parameters": []interface{}{parameter("type", "query")},
+				"responses":  response(recordList),
+			}},
+			"/records/{index}": map[string]interface{}{"get": map[string]interface{}{
+				"parameters": []interface{}{parameter("index", "path")},
+				"responses":  response(record),
+			}},
+			"/records/address/{address}": map[string]interface{}{"get": map[string]interface{}{
+				"parameters": []interface{}{parameter("address", "path")},
+				"responses":  response(recordList),
+			}},
+		},
+		"components": map[string]interface{}{"schemas": components},
+	}
+}
@@ -0,0 +1,139 @@
+package indexer
+
+import (
+	"encoding/json"
+	"flag"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var updateSchemas = flag.Bool("update-schemas", false, "rewrite testdata/schemas.json from the registered record types")
+
+const schemasFile = "testdata/schemas.json"
+
+// TestSchemaCompatibility compares the record schemas with the last published
+// ones. Removing or retyping a field requires bumping SchemaVersion, and any
+// change requires regenerating the file with -update-schemas.
+func TestSchemaCompatibility(t *testing.T) {
+	current := SchemaSet{Version: SchemaVersion, Schemas: RecordSchemas()}
+	currentBytes, err := json.MarshalIndent(current, "", "  ")
+	require.NoError(t, err)
+
+	if *updateSchemas {
+		require.NoError(t, os.MkdirAll(filepath.Dir(schemasFile), 0o755))
+		require.NoError(t, os.WriteFile(schemasFile, append(currentBytes, '\n'), 0o644))
+		return
+	}
+
+	publishedBytes, err := os.ReadFile(schemasFile)
+	require.NoError(t, err)
+	var published SchemaSet
+	require.NoError(t, json.Unmarshal(publishedBytes, &published))
+
+	require.LessOrEqual(t, published.Version, SchemaVersion, "SchemaVersion is older than the published schemas")
+	if published.Version == SchemaVersion {
+		require.Empty(t, SchemaBreakingChanges(published.Schemas, current.Schemas),
+			"breaking record schema changes, bump SchemaVersion and run go test ./indexer -run TestSchemaCompatibility -update-schemas")
+	}
+	require.JSONEq(t, string(publishedBytes), string(currentBytes),
+		"record schemas changed, run go test ./indexer -run TestSchemaCompatibility -update-schemas")
+}
+
+func TestSchemaBreakingChanges(t *testing.T) {
+	type inner struct {
+		Rate string `json:"rate"`
+	}
+	type v1 struct {
+		Owner  string   `json:"owner"`
+		Amount uint64   `json:"amount"`
+		Ids    []uint64 `json:"ids"`
+		Price  inner    `json:"price"`
+		Extra  string   `json:"extra,omitempty"`
+	}
+	type v2 struct {
+		Amount string   `json:"amount"`
+		Ids    []string `json:"ids"`
+		Price  struct {
+			Rate  string `json:"rate"`
+			Added bool   `json:"added"`
+		} `json:"price"`
+		Extra   *string `json:"extra,omitempty"`
+		NewOnes []int   `json:"new_ones"`
+	}
+
+	old := map[string]*JSONSchema{"msg": schemaOf(reflect.TypeOf(v1{})), "gone": schemaOf(reflect.TypeOf(inner{}))}
+	require.Empty(t, SchemaBreakingChanges(old, old))
+	require.Equal(t, []string{
+		"gone: record type removed",
+		"msg.amount: type changed from integer to string",
+		"msg.extra: type changed from string to string|null",
+		"msg.ids[]: type changed from integer to string",
+		"msg.owner: property removed",
+	}, SchemaBreakingChanges(old, map[string]*JSONSchema{"msg": schemaOf(reflect.TypeOf(v2{}))}))
+
+	// Added properties and record types are compatible
+	require.Empty(t, SchemaBreakingChanges(
+		map[string]*JSONSchema{"msg": schemaOf(reflect.TypeOf(inner{}))},
+		map[string]*JSONSchema{"msg": schemaOf(reflect.TypeOf(v2{}).Field(2).Type), "new": schemaOf(reflect.TypeOf(v1{}))},
+	))
+}
+
+func TestRecordSchema(t *testing.T) {
+	schema, ok := RecordSchema("/elys.tradeshield.MsgCreateSpotOrder")
+	require.True(t, ok)
+	require.Equal(t, "elys-indexer/v1/elys.tradeshield.MsgCreateSpotOrder", schema.ID)
+	data := schema.Properties["transaction"].Properties["data"]
+	require.Equal(t, []string{"base_order", "order_target_denom", "order_type"}, data.Required)
+	require.Equal(t, SchemaType{"object", "null"}, data.Properties["stop_price"].Type)
+	fees := schema.Properties["transaction"].Properties["base_transaction"].Properties["fees"]
+	require.Equal(t, SchemaType{"array", "null"}, fees.Type)
+	require.Equal(t, SchemaType{"string"}, fees.Items.Properties["amount"].Type)
+
+	// Embedded structs are flattened like encoding/json does
+	schema, ok = RecordSchema("/elys-event/tradeshield/market-buy")
+	require.True(t, ok)
+	event := schema.Properties["event"]
+	require.Contains(t, event.Properties["data"].Properties, "order_id")
+	require.Contains(t, event.Properties["base_event"].Properties, "EventID")
+	require.Equal(t, "date-time", event.Properties["base_event"].Properties["block_time"].Format)
+
+	_, ok = RecordSchema("/elys.unknown.Msg")
+	require.False(t, ok)
+
+	server := httptest.NewServer(NewQueryHandler(nil))
+	defer server.Close()
+	res, err := http.Get(server.URL + "/schemas?type=/elys.amm.MsgSwapByDenom")
+	require.NoError(t, err)
+	defer res.Body.Close()
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	var served JSONSchema
+	require.NoError(t, json.NewDecoder(res.Body).Decode(&served))
+	require.Equal(t, "/elys.amm.MsgSwapByDenom", served.Title)
+	require.Equal(t, SchemaVersion, served.Version)
+
+	res, err = http.Get(server.URL + "/schemas?type=/elys.unknown.Msg")
+	require.NoError(t, err)
+	res.Body.Close()
+	require.Equal(t, http.StatusNotFound, res.StatusCode)
+
+	res, err = http.Get(server.URL + "/openapi.json")
+	require.NoError(t, err)
+	defer res.Body.Close()
+	var document struct {
+		OpenAPI    string `json:"openapi"`
+		Components struct {
+			Schemas map[string]json.RawMessage `json:"schemas"`
+		} `json:"components"`
+	}
+	require.NoError(t, json.NewDecoder(res.Body).Decode(&document))
+	require.Equal(t, "3.1.0", document.OpenAPI)
+	require.Contains(t, document.Components.Schemas, "elys.amm.MsgSwapByDenom")
+	require.Contains(t, document.Components.Schemas, "elys-event.tradeshield.market-buy")
+	require.Len(t, document.Components.Schemas, len(txRegistry)+len(eventRegistry)+1)
+}
@@ -0,0 +1,462 @@
+package indexer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	indexerTypes "github.com/elys-network/elys/indexer/types"
+)
+
+// QueryHandler serves one route of the indexer query API
+type QueryHandler func(db *LMDBManager, w http.ResponseWriter, r *http.Request)
+
+var queryRoutes = make(map[string]QueryHandler)
+
+// queryServer is the running query API server, if enabled
+var queryServer *http.Server
+
+func init() {
+	RegisterQueryRoute("GET /records/{index}", handleRecordByIndex)
+	RegisterQueryRoute("GET /records/address/{address}", handleRecordsByAddress)
+	RegisterQueryRoute("GET /records", handleRecordsByType)
+	RegisterQueryRoute("GET /amm/pools/{pool_id}/candles", handlePoolCandles)
+	RegisterQueryRoute("GET /amm/pools/{pool_id}/trades", handlePoolTrades)
+	RegisterQueryRoute("GET /perpetual/positions/{address}", handlePositions)
+	RegisterQueryRoute("GET /perpetual/positions/{address}/{id}", handlePositionHistory)
+	RegisterQueryRoute("GET /pnl/{address}", handlePnL)
+	RegisterQueryRoute("GET /pnl/{address}/unrealized", handleUnrealizedPnL)
+	RegisterQueryRoute("GET /params/{module}/history", handleParamHistory)
+	RegisterQueryRoute("GET /oracle/feeders/{feeder}/metrics", handleFeederMetrics)
+	RegisterQueryRoute("GET /oracle/assets/{asset}/feeders", handleAssetFeeders)
+	RegisterQueryRoute("GET /balances/{address}", handleBalances)
+	RegisterQueryRoute("GET /balances/{address}/{denom...}", handleBalanceAt)
+	RegisterQueryRoute("GET /revenue", handleRevenue)
+	RegisterQueryRoute("GET /schemas", handleSchemas)
+	RegisterQueryRoute("GET /openapi.json", handleOpenAPI)
+}
+
+// RegisterQueryRoute registers a handler for a net/http ServeMux pattern,
+// e.g. "GET /records/{index}"
+func RegisterQueryRoute(pattern string, handler QueryHandler) {
+	queryRoutes[pattern] = handler
+}
+
+// NewQueryHandler returns an http.Handler serving every registered route from db
+func NewQueryHandler(db *LMDBManager) http.Handler {
+	mux := http.NewServeMux()
+	for pattern, handler := range queryRoutes {
+		handler := handler
+		mux.HandleFunc(pattern, func(w http.ResponseWriter, r *http.Request) {
+			handler(db, w, r)
+		})
+	}
+	return mux
+}
+
+// startQueryServer serves the query API on addr in the background
+func startQueryServer(addr string, db *LMDBManager) {
+	queryServer = startHTTPServer("query API", addr, NewQueryHandler(db))
+}
+
+// stopQueryServer shuts the query API down if it is running
+func stopQueryServer() {
+	stopHTTPServer(queryServer)
+}
+
+// startHTTPServer serves handler on addr in the background
+func startHTTPServer(name, addr string, handler http.Handler) *http.Server {
+	server := &http.Server{
+		Addr:              addr,
+		Handler:           handler,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go func() {
+		fmt.Printf("Indexer %s listening on %s\n", name, addr)
+		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			fmt.Printf("indexer %s stopped: %v\n", name, err)
+		}
+	}()
+	return server
+}
+
+// stopHTTPServer shuts server down if it is running
+func stopHTTPServer(server *http.Server) {
+	if server == nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	_ = server.Shutdown(ctx)
+}
+
+// ServeReadOnly serves the query and GraphQL APIs enabled in cfg from the data
+// directory of an indexer running in another process, until ctx is done
+func ServeReadOnly(ctx context.Context, cfg Config) error {
+	if cfg.QueryAddress == "" && cfg.GraphQLAddress == "" {
+		return errors.New("neither the query nor the GraphQL address is set")
+	}
+
+	db, err := OpenLMDBReadOnly(cfg.DataDir)
+	if err != nil {
+		return fmt.Errorf("failed to open indexer data directory %s: %w", cfg.DataDir, err)
+	}
+	defer db.Close()
+
+	apis := []struct {
+		name, addr string
+		handler    http.Handler
+	}{
+		{"query API", cfg.QueryAddress, NewQueryHandler(db)},
+		{"GraphQL API", cfg.GraphQLAddress, NewGraphQLHandler(db)},
+	}
+	var servers []*http.Server
+	defer func() {
+		for _, server := range servers {
+			stopHTTPServer(server)
+		}
+	}()
+
+	serveErr := make(chan error, len(apis))
+	for _, api := range apis {
+		if api.addr == "" {
+			continue
+		}
+		// Listen before serving to report unavailable addresses
+		listener, err := net.Listen("tcp", api.addr)
+		if err != nil {
+			return fmt.Errorf("indexer %s: %w", api.name, err)
+		}
+		server := &http.Server{Handler: api.handler, ReadHeaderTimeout: 10 * time.Second}
+		servers = append(servers, server)
+		fmt.Printf("Indexer %s listening on %s\n", api.name, listener.Addr())
+		go func(name string) {
+			if err := server.Serve(listener); err != nil && err != http.ErrServerClosed {
+				serveErr <- fmt.Errorf("indexer %s stopped: %w", name, err)
+			}
+		}(api.name)
+	}
+
+	select {
+	case <-ctx.Done():
+		return nil
+	case err := <-serveErr:
+		return err
+	}
+}
+
+func handleRecordByIndex(db *LMDBManager, w http.ResponseWriter, r *http.Request) {
+	index, err := strconv.ParseUint(r.PathValue("index"), 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("invalid index: %v", err))
+		return
+	}
+	record, err := db.GetRecordByIndex(index)
+	if err != nil {
+		writeError(w, http.StatusNotFound, err)
+		return
+	}
+	writeJSON(w, record)
+}
+
+func handleRecordsByAddress(db *LMDBManager, w http.ResponseWriter, r *http.Request) {
+	records, err := db.GetRecordsByAddress(r.PathValue("address"))
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	writeJSON(w, records)
+}
+
+func handleRecordsByType(db *LMDBManager, w http.ResponseWriter, r *http.Request) {
+	recordType := r.URL.Query().Get("type")
+	if recordType == "" {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("missing type parameter"))
+		return
+	}
+	records, err := db.GetRecordsByType(recordType)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	writeJSON(w, records)
+}
+
+func handlePoolCandles(db *LMDBManager, w http.ResponseWriter, r *http.Request) {
+	poolID, err := strconv.ParseUint(r.PathValue("pool_id"), 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("invalid pool_id: %v", err))
+		return
+	}
+	query := r.URL.Query()
+	from, err := queryTime(query.Get("from"), time.Unix(0, 0))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	to, err := queryTime(query.Get("to"), time.Now())
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	interval := query.Get("interval")
+	if interval == "" {
+		interval = "1h"
+	}
+
+	result, err := db.GetCandles(poolID, query.Get("base"), query.Get("quote"), interval, from, to)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	writeJSON(w, result)
+}
+
+func handlePoolTrades(db *LMDBManager, w http.ResponseWriter, r *http.Request) {
+	poolID, err := strconv.ParseUint(r.PathValue("pool_id"), 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("invalid pool_id: %v", err))
+		return
+	}
+	limit := 100
+	if v := r.URL.Query().Get("limit"); v != "" {
+		if limit, err = strconv.Atoi(v); err != nil {
+			writeError(w, http.StatusBadRequest, fmt.Errorf("invalid limit: %v", err))
+			return
+		}
+	}
+
+	records, err := db.GetPoolTrades(poolID, limit)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	writeJSON(w, records)
+}
+
+func handlePositions(db *LMDBManager, w http.ResponseWriter, r *http.Request) {
+	status := r.URL.Query().Get("status")
+	if status != "" && status != PositionStatusOpen && status != PositionStatusClosed {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("invalid status: %s", status))
+		return
+	}
+	result, err := db.GetPositions(r.PathValue("address"), status)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	writeJSON(w, result)
+}
+
+func handlePositionHistory(db *LMDBManager, w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseUint(r.PathValue("id"), 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("invalid id: %v", err))
+		return
+	}
+	history, err := db.GetPositionHistory(r.PathValue("address"), id)
+	if err != nil {
+		writeError(w, http.StatusNotFound, err)
+		return
+	}
+	records, err := db.GetPositionRecords(history)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	writeJSON(w, struct {
+		PositionHistory
+		Records []indexerTypes.GenericRecord `json:"records"`
+	}{history, records})
+}
+
+func handlePnL(db *LMDBManager, w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	dimension := query.Get("dimension")
+	if dimension == "" {
+		dimension = PnLByDay
+	}
+	result, err := db.GetPnL(r.PathValue("address"), dimension, query.Get("from"), query.Get("to"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	writeJSON(w, result)
+}
+
+func handleRevenue(db *LMDBManager, w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	dimension := query.Get("dimension")
+	if dimension == "" {
+		dimension = RevenueBySource
+	}
+	result, err := db.GetRevenue(dimension, query.Get("from"), query.Get("to"), query.Get("bucket"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	writeJSON(w, result)
+}
+
+func handleUnrealizedPnL(_ *LMDBManager, w http.ResponseWriter, r *http.Request) {
+	result, err := GetUnrealizedPnL(r.PathValue("address"))
+	if err != nil {
+		writeError(w, http.StatusServiceUnavailable, err)
+		return
+	}
+	writeJSON(w, result)
+}
+
+func handleParamHistory(db *LMDBManager, w http.ResponseWriter, r *http.Request) {
+	result, err := db.GetParamHistory(r.PathValue("module"), r.URL.Query().Get("field"))
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	writeJSON(w, result)
+}
+
+func handleFeederMetrics(db *LMDBManager, w http.ResponseWriter, r *http.Request) {
+	fromHeight, toHeight, err := queryHeightRange(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	result, err := db.GetFeederMetrics(r.PathValue("feeder"), r.URL.Query().Get("asset"), fromHeight, toHeight)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	writeJSON(w, result)
+}
+
+func handleAssetFeeders(db *LMDBManager, w http.ResponseWriter, r *http.Request) {
+	fromHeight, toHeight, err := queryHeightRange(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	result, err := db.GetAssetFeederMetrics(r.PathValue("asset"), fromHeight, toHeight)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	writeJSON(w, result)
+}
+
+func handleBalances(db *LMDBManager, w http.ResponseWriter, r *http.Request) {
+	height, at, err := queryBalancePoint(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	result, err := db.GetBalancesAt(r.PathValue("address"), height, at)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	writeJSON(w, result)
+}
+
+func handleBalanceAt(db *LMDBManager, w http.ResponseWriter, r *http.Request) {
+	height, at, err := queryBalancePoint(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	var result BalanceCheckpoint
+	if at.IsZero() {
+		result, err = db.GetBalanceAt(r.PathValue("address"), r.PathValue("denom"), height)
+	} else {
+		result, err = db.GetBalanceAtTime(r.PathValue("address"), r.PathValue("denom"), at)
+	}
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	writeJSON(w, result)
+}
+
+// queryBalancePoint parses the height or time parameter of the balance queries
+// handleSchemas serves the JSON Schema of every record type, or of a single
+// one with the type parameter
+func handleSchemas(_ *LMDBManager, w http.ResponseWriter, r *http.Request) {
+	if recordType := r.URL.Query().Get("type"); recordType != "" {
+		schema, ok := RecordSchema(recordType)
+		if !ok {
+			writeError(w, http.StatusNotFound, fmt.Errorf("unknown record type: %s", recordType))
+			return
+		}
+		writeJSON(w, schema)
+		return
+	}
+	writeJSON(w, SchemaSet{Version: SchemaVersion, Schemas: RecordSchemas()})
+}
+
+func handleOpenAPI(_ *LMDBManager, w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, OpenAPIDocument())
+}
+
+func queryBalancePoint(r *http.Request) (height int64, at time.Time, err error) {
+	if v := r.URL.Query().Get("height"); v != "" {
+		if height, err = strconv.ParseInt(v, 10, 64); err != nil {
+			return 0, time.Time{}, fmt.Errorf("invalid height: %v", err)
+		}
+	}
+	if at, err = queryTime(r.URL.Query().Get("time"), time.Time{}); err != nil {
+		return 0, time.Time{}, err
+	}
+	if height != 0 && !at.IsZero() {
+		return 0, time.Time{}, fmt.Errorf("height and time are exclusive")
+	}
+	return height, at, nil
+}
+
+// queryHeightRange parses the optional from_height and to_height parameters
+func queryHeightRange(r *http.Request) (fromHeight, toHeight int64, err error) {
+	if v := r.URL.Query().Get("from_height"); v != "" {
+		if fromHeight, err = strconv.ParseInt(v, 10, 64); err != nil {
+			return 0, 0, fmt.Errorf("invalid from_height: %v", err)
+		}
+	}
+	if v := r.URL.Query().Get("to_height"); v != "" {
+		if toHeight, err = strconv.ParseInt(v, 10, 64); err != nil {
+			return 0, 0, fmt.Errorf("invalid to_height: %v", err)
+		}
+	}
+	return fromHeight, toHeight, nil
+}
+
+// queryTime parses a unix timestamp in seconds or an RFC3339 time, returning
+// fallback when value is empty
+func queryTime(value string, fallback time.Time) (time.Time, error) {
+	if value == "" {
+		return fallback, nil
+	}
+	if seconds, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return time.Unix(seconds, 0).UTC(), nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid time %q: expected unix seconds or RFC3339", value)
+	}
+	return t, nil
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		fmt.Printf("failed to write query response: %v\n", err)
+	}
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+}
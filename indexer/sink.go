@@ -0,0 +1,304 @@
+package indexer
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/bmatsuo/lmdb-go/lmdb"
+
+	indexerTypes "github.com/elys-network/elys/indexer/types"
+)
+
+// Sink receives the indexed records pushed to downstream services. Records are
+// delivered in batches holding records of a single block, once every record of
+// that block is stored. Delivery is at-least-once: a batch is retried until
+// Deliver succeeds, so consumers should deduplicate on the record index.
+type Sink interface {
+	// Name identifies the sink in its outbox, it must be stable across restarts
+	Name() string
+	// Deliver pushes a batch downstream
+	Deliver(ctx context.Context, batch SinkBatch) error
+}
+
+// SinkRecord is a record delivered to sinks
+type SinkRecord struct {
+	Index  uint64                     `json:"index"`
+	Type   string                     `json:"type"`
+	Record indexerTypes.GenericRecord `json:"record"`
+}
+
+// SinkBatch holds records of one block in index order
+type SinkBatch struct {
+	Height  int64        `json:"height"`
+	Records []SinkRecord `json:"records"`
+}
+
+const (
+	sinkMaxBatchSize   = 500
+	sinkMinRetryDelay  = time.Second
+	sinkMaxRetryDelay  = time.Minute
+	sinkDeliverTimeout = 30 * time.Second
+)
+
+var (
+	sinks           []Sink
+	sinkDispatchers []*sinkDispatcher
+	committedHeight atomic.Int64 // Highest block whose records are all stored
+	commitMutex     sync.Mutex
+	commitMarkers   = make(map[int64]int) // Workers that passed the commit marker of a height
+)
+
+// RegisterSink adds a sink. Sinks must be registered before Init, records
+// stored afterwards are kept in the sink outbox until delivered.
+func RegisterSink(sink Sink) {
+	sinks = append(sinks, sink)
+}
+
+// configureSinks registers the sinks enabled in the configuration
+func configureSinks(cfg Config) {
+	if cfg.WebhookURL != "" {
+		RegisterSink(NewWebhookSink(cfg.WebhookURL, cfg.WebhookSecret))
+	}
+	if cfg.NATSURL == "" && cfg.KafkaBrokers == "" {
+		return
+	}
+	tlsConfig, err := publisherTLSConfig(cfg)
+	if err != nil {
+		panic(fmt.Errorf("failed to load the publisher TLS configuration: %w", err))
+	}
+	if cfg.NATSURL != "" {
+		RegisterSink(NewPublisherSink("nats", cfg.NATSSubjectPrefix, NewNATSPublisher(cfg.NATSURL, tlsConfig)))
+	}
+	if cfg.KafkaBrokers != "" {
+		brokers := strings.Split(cfg.KafkaBrokers, ",")
+		for i := range brokers {
+			brokers[i] = strings.TrimSpace(brokers[i])
+		}
+		publisher, err := NewKafkaPublisher(brokers, tlsConfig)
+		if err != nil {
+			panic(fmt.Errorf("failed to create the Kafka publisher: %w", err))
+		}
+		RegisterSink(NewPublisherSink("kafka", cfg.KafkaTopicPrefix, publisher))
+	}
+}
+
+// CommitBlock signals that every record of height has been queued. It must be
+// called once per block after the block execution, e.g. from the precommiter.
+// Batches of a block are delivered once both workers processed its records.
+func CommitBlock(height int64) {
+	if !IsReady() || len(sinks) == 0 {
+		return
+	}
+	txChan <- queueItem{commitHeight: height}
+	eventChan <- eventItem{commitHeight: height}
+}
+
+// blockQueued is called by each worker when it reaches the commit marker of height
+func blockQueued(height int64) {
+	commitMutex.Lock()
+	commitMarkers[height]++
+	done := commitMarkers[height] == 2
+	if done {
+		delete(commitMarkers, height)
+	}
+	commitMutex.Unlock()
+
+	if done && height > committedHeight.Load() {
+		committedHeight.Store(height)
+		notifySinks()
+	}
+}
+
+func notifySinks() {
+	for _, dispatcher := range sinkDispatchers {
+		select {
+		case dispatcher.notify <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// outboxAggregator keeps the index of every record not yet delivered to a sink.
+// Entries are written with the record so no record is lost on a crash.
+type outboxAggregator struct {
+	db lmdb.DBI
+}
+
+var outbox = &outboxAggregator{}
+
+func init() {
+	RegisterAggregator(outbox)
+}
+
+func (a *outboxAggregator) Name() string {
+	return "outbox"
+}
+
+func (a *outboxAggregator) Open(txn *lmdb.Txn) (err error) {
+	a.db, err = txn.OpenDBI("outbox", lmdb.Create)
+	return err
+}
+
+func (a *outboxAggregator) Apply(txn *lmdb.Txn, index uint64, record indexerTypes.GenericRecord) error {
+	height := make([]byte, 8)
+	binary.BigEndian.PutUint64(height, uint64(record.BlockHeight()))
+	for _, sink := range sinks {
+		if err := txn.Put(a.db, outboxKey(sink.Name(), index), height, 0); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func outboxKey(sink string, index uint64) []byte {
+	return []byte(fmt.Sprintf("%s/%020d", sink, index))
+}
+
+// sinkDispatcher delivers the outbox of one sink
+type sinkDispatcher struct {
+	sink   Sink
+	db     *LMDBManager
+	notify chan struct{}
+	stop   chan struct{}
+	done   chan struct{}
+}
+
+// startSinks starts a dispatcher for every registered sink
+func startSinks(db *LMDBManager) {
+	for _, sink := range sinks {
+		dispatcher := &sinkDispatcher{
+			sink:   sink,
+			db:     db,
+			notify: make(chan struct{}, 1),
+			stop:   make(chan struct{}),
+			done:   make(chan struct{}),
+		}
+		sinkDispatchers = append(sinkDispatchers, dispatcher)
+		go dispatcher.run()
+	}
+}
+
+// stopSinks stops the dispatchers, undelivered records stay in the outbox
+func stopSinks() {
+	for _, dispatcher := range sinkDispatchers {
+		close(dispatcher.stop)
+		<-dispatcher.done
+	}
+	sinkDispatchers = nil
+}
+
+func (d *sinkDispatcher) run() {
+	defer close(d.done)
+
+	delay := sinkMinRetryDelay
+	var retry <-chan time.Time
+	for {
+		select {
+		case <-d.stop:
+			return
+		case <-d.notify:
+		case <-retry:
+		}
+
+		retry = nil
+		if err := d.dispatch(committedHeight.Load()); err != nil {
+			fmt.Printf("failed to deliver records to sink %s: %v\n", d.sink.Name(), err)
+			retry = time.After(delay)
+			delay = min(delay*2, sinkMaxRetryDelay)
+			continue
+		}
+		delay = sinkMinRetryDelay
+	}
+}
+
+// dispatch delivers the pending records of blocks up to maxHeight, one batch
+// per block in height order, and removes them from the outbox once delivered
+func (d *sinkDispatcher) dispatch(maxHeight int64) error {
+	pending, err := d.db.pendingOutbox(d.sink.Name(), maxHeight)
+	if err != nil {
+		return err
+	}
+
+	heights := make([]int64, 0, len(pending))
+	for height := range pending {
+		heights = append(heights, height)
+	}
+	sort.Slice(heights, func(i, j int) bool { return heights[i] < heights[j] })
+
+	for _, height := range heights {
+		indices := pending[height]
+		for start := 0; start < len(indices); start += sinkMaxBatchSize {
+			chunk := indices[start:min(start+sinkMaxBatchSize, len(indices))]
+			batch := SinkBatch{Height: height, Records: make([]SinkRecord, 0, len(chunk))}
+			for _, index := range chunk {
+				record, err := d.db.GetRecordByIndex(index)
+				if err != nil {
+					return fmt.Errorf("error getting record %d: %v", index, err)
+				}
+				batch.Records = append(batch.Records, SinkRecord{Index: index, Type: record.Type(), Record: record})
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), sinkDeliverTimeout)
+			err := d.sink.Deliver(ctx, batch)
+			cancel()
+			if err != nil {
+				return err
+			}
+			if err := d.db.ackOutbox(d.sink.Name(), chunk); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// pendingOutbox returns the undelivered record indices of a sink by block
+// height, limited to blocks up to maxHeight
+func (m *LMDBManager) pendingOutbox(sink string, maxHeight int64) (map[int64][]uint64, error) {
+	pending := make(map[int64][]uint64)
+	prefix := sink + "/"
+	err := m.view(func(txn *lmdb.Txn) error {
+		cursor, err := txn.OpenCursor(outbox.db)
+		if err != nil {
+			return err
+		}
+		defer cursor.Close()
+
+		key, value, err := cursor.Get([]byte(prefix), nil, lmdb.SetRange)
+		for err == nil && len(key) > len(prefix) && string(key[:len(prefix)]) == prefix {
+			height := int64(binary.BigEndian.Uint64(value))
+			if height <= maxHeight {
+				index, parseErr := strconv.ParseUint(string(key[len(prefix):]), 10, 64)
+				if parseErr != nil {
+					return parseErr
+				}
+				pending[height] = append(pending[height], index)
+			}
+			key, value, err = cursor.Get(nil, nil, lmdb.Next)
+		}
+		if err != nil && !lmdb.IsNotFound(err) {
+			return err
+		}
+		return nil
+	})
+	return pending, err
+}
+
+// ackOutbox removes delivered records from the outbox of a sink
+func (m *LMDBManager) ackOutbox(sink string, indices []uint64) error {
+	return m.env.Update(func(txn *lmdb.Txn) error {
+		for _, index := range indices {
+			if err := txn.Del(outbox.db, outboxKey(sink, index), nil); err != nil && !lmdb.IsNotFound(err) {
+				return err
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,252 @@
+package indexer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/nats-io/nats-server/v2/server"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/stretchr/testify/require"
+	"github.com/twmb/franz-go/pkg/kfake"
+	"github.com/twmb/franz-go/pkg/kgo"
+
+	"github.com/elys-network/elys/indexer/txs/perpetual"
+	indexerTypes "github.com/elys-network/elys/indexer/types"
+)
+
+type recordingSink struct {
+	fail    bool
+	batches []SinkBatch
+}
+
+func (s *recordingSink) Name() string {
+	return "recording"
+}
+
+func (s *recordingSink) Deliver(_ context.Context, batch SinkBatch) error {
+	if s.fail {
+		return errors.New("sink unavailable")
+	}
+	s.batches = append(s.batches, batch)
+	return nil
+}
+
+func useSinks(t *testing.T, registered ...Sink) {
+	previous := sinks
+	sinks = registered
+	t.Cleanup(func() { sinks = previous })
+}
+
+func TestSinkOutboxDelivery(t *testing.T) {
+	sink := &recordingSink{fail: true}
+	useSinks(t, sink)
+	db := newTestDatabase(t)
+	blockTime := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	storeTx(t, db, "a", 10, blockTime, perpetual.MsgUpdateStopLoss{Creator: "elys1a", ID: 1})
+	storeTx(t, db, "b", 10, blockTime, perpetual.MsgUpdateStopLoss{Creator: "elys1b", ID: 2})
+	storeTx(t, db, "c", 11, blockTime, perpetual.MsgUpdateStopLoss{Creator: "elys1c", ID: 3})
+
+	dispatcher := &sinkDispatcher{sink: sink, db: db}
+
+	// Failed deliveries stay in the outbox
+	require.Error(t, dispatcher.dispatch(10))
+	pending, err := db.pendingOutbox(sink.Name(), 11)
+	require.NoError(t, err)
+	require.Equal(t, map[int64][]uint64{10: {1, 2}, 11: {3}}, pending)
+
+	// Only blocks up to the committed height are delivered
+	sink.fail = false
+	require.NoError(t, dispatcher.dispatch(10))
+	require.Len(t, sink.batches, 1)
+	require.Equal(t, int64(10), sink.batches[0].Height)
+	require.Len(t, sink.batches[0].Records, 2)
+	require.Equal(t, uint64(1), sink.batches[0].Records[0].Index)
+	require.Equal(t, "b", sink.batches[0].Records[1].Record.Transaction.BaseTransaction.TxHash)
+
+	require.NoError(t, dispatcher.dispatch(11))
+	require.Len(t, sink.batches, 2)
+	require.Equal(t, uint64(3), sink.batches[1].Records[0].Index)
+
+	pending, err = db.pendingOutbox(sink.Name(), 11)
+	require.NoError(t, err)
+	require.Empty(t, pending)
+}
+
+func TestBlockQueued(t *testing.T) {
+	previous := committedHeight.Load()
+	t.Cleanup(func() { committedHeight.Store(previous) })
+	committedHeight.Store(0)
+
+	blockQueued(5)
+	require.Equal(t, int64(0), committedHeight.Load())
+	blockQueued(5)
+	require.Equal(t, int64(5), committedHeight.Load())
+}
+
+func TestWebhookSink(t *testing.T) {
+	const secret = "s3cret"
+	var (
+		mu       sync.Mutex
+		attempts int
+		received SinkBatch
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		attempts++
+		if attempts == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.Equal(t, "sha256="+WebhookSignature([]byte(secret), body), r.Header.Get(WebhookSignatureHeader))
+		require.Equal(t, "7-4-5", r.Header.Get(WebhookDeliveryHeader))
+		require.NoError(t, json.Unmarshal(body, &received))
+	}))
+	defer server.Close()
+
+	sink := NewWebhookSink(server.URL, secret)
+	sink.backoff = time.Millisecond
+	batch := SinkBatch{Height: 7, Records: []SinkRecord{{Index: 4, Type: "a"}, {Index: 5, Type: "b"}}}
+	require.NoError(t, sink.Deliver(context.Background(), batch))
+	require.Equal(t, 2, attempts)
+	require.Equal(t, batch.Height, received.Height)
+	require.Len(t, received.Records, 2)
+
+	// Requests keep failing after the last attempt
+	unavailable := httptest.NewServer(http.NotFoundHandler())
+	defer unavailable.Close()
+	failing := NewWebhookSink(unavailable.URL, secret)
+	failing.backoff = time.Millisecond
+	require.Error(t, failing.Deliver(context.Background(), batch))
+}
+
+func startJetStream(t *testing.T) *server.Server {
+	srv, err := server.NewServer(&server.Options{
+		Host:      "127.0.0.1",
+		Port:      -1,
+		JetStream: true,
+		StoreDir:  t.TempDir(),
+		NoLog:     true,
+		NoSigs:    true,
+	})
+	require.NoError(t, err)
+	go srv.Start()
+	require.True(t, srv.ReadyForConnections(5*time.Second))
+	t.Cleanup(srv.Shutdown)
+	return srv
+}
+
+func TestNATSPublisherSink(t *testing.T) {
+	srv := startJetStream(t)
+	publisher := NewNATSPublisher(srv.ClientURL(), nil)
+	defer publisher.Close()
+	sink := NewPublisherSink("nats", "elys.indexer", publisher)
+
+	batch := SinkBatch{Height: 3, Records: []SinkRecord{
+		{Index: 8, Type: "/elys.amm.MsgSwapByDenom"},
+		{Index: 9, Type: indexerTypes.ElysEventTypes.Perpetual.StopLoss},
+	}}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// No stream captures the subjects, the messages are not acknowledged
+	require.Error(t, sink.Deliver(ctx, batch))
+
+	conn, err := nats.Connect(srv.ClientURL())
+	require.NoError(t, err)
+	defer conn.Close()
+	js, err := jetstream.New(conn)
+	require.NoError(t, err)
+	stream, err := js.CreateStream(ctx, jetstream.StreamConfig{Name: "INDEXER", Subjects: []string{"elys.indexer.>"}})
+	require.NoError(t, err)
+
+	// Redelivered messages are dropped on their msg id
+	require.NoError(t, sink.Deliver(ctx, batch))
+	require.NoError(t, sink.Deliver(ctx, batch))
+	info, err := stream.Info(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), info.State.Msgs)
+
+	first, err := stream.GetMsg(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, "elys.indexer.elys.amm.MsgSwapByDenom", first.Subject)
+	require.Equal(t, "8", first.Header.Get(jetstream.MsgIDHeader))
+	require.True(t, strings.HasPrefix(string(first.Data), `{"index":8,`))
+	second, err := stream.GetMsg(ctx, 2)
+	require.NoError(t, err)
+	require.Equal(t, "elys.indexer.elys-event.perpetual.stop-loss", second.Subject)
+	require.Equal(t, "9", second.Header.Get(jetstream.MsgIDHeader))
+}
+
+func TestKafkaPublisherSink(t *testing.T) {
+	batch := SinkBatch{Height: 3, Records: []SinkRecord{
+		{Index: 8, Type: "/elys.amm.MsgSwapByDenom"},
+		{Index: 9, Type: indexerTypes.ElysEventTypes.Perpetual.StopLoss},
+	}}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// The cluster refuses to create the topics, the records are not acknowledged
+	refusing, err := kfake.NewCluster(kfake.NumBrokers(1))
+	require.NoError(t, err)
+	defer refusing.Close()
+	failing, err := NewKafkaPublisher(refusing.ListenAddrs(), nil)
+	require.NoError(t, err)
+	defer failing.Close()
+	require.Error(t, NewPublisherSink("kafka", "elys.indexer", failing).Deliver(ctx, batch))
+
+	cluster, err := kfake.NewCluster(kfake.NumBrokers(1), kfake.AllowAutoTopicCreation())
+	require.NoError(t, err)
+	defer cluster.Close()
+	publisher, err := NewKafkaPublisher(cluster.ListenAddrs(), nil)
+	require.NoError(t, err)
+	defer publisher.Close()
+	require.NoError(t, NewPublisherSink("kafka", "elys.indexer", publisher).Deliver(ctx, batch))
+
+	consumer, err := kgo.NewClient(
+		kgo.SeedBrokers(cluster.ListenAddrs()...),
+		kgo.ConsumeTopics("elys.indexer.elys.amm.MsgSwapByDenom", "elys.indexer.elys-event.perpetual.stop-loss"),
+		kgo.ConsumeResetOffset(kgo.NewOffset().AtStart()),
+	)
+	require.NoError(t, err)
+	defer consumer.Close()
+	records := make(map[string]*kgo.Record)
+	for len(records) < 2 {
+		fetches := consumer.PollFetches(ctx)
+		require.NoError(t, fetches.Err())
+		fetches.EachRecord(func(record *kgo.Record) {
+			records[record.Topic] = record
+		})
+	}
+	swap := records["elys.indexer.elys.amm.MsgSwapByDenom"]
+	require.Equal(t, "8", string(swap.Key))
+	require.True(t, strings.HasPrefix(string(swap.Value), `{"index":8,`))
+	require.Equal(t, "9", string(records["elys.indexer.elys-event.perpetual.stop-loss"].Key))
+}
+
+func TestPublisherTLSConfig(t *testing.T) {
+	tlsConfig, err := publisherTLSConfig(Config{PublisherTLSCAFile: "missing.pem"})
+	require.NoError(t, err)
+	require.Nil(t, tlsConfig)
+
+	tlsConfig, err = publisherTLSConfig(Config{PublisherTLS: true})
+	require.NoError(t, err)
+	require.NotNil(t, tlsConfig)
+	require.Nil(t, tlsConfig.RootCAs)
+
+	_, err = publisherTLSConfig(Config{PublisherTLS: true, PublisherTLSCAFile: "missing.pem"})
+	require.Error(t, err)
+}
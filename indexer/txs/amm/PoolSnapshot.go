@@ -0,0 +1,32 @@
+package amm
+
+import (
+	"fmt"
+
+	"github.com/elys-network/elys/indexer/types"
+)
+
+// PoolSnapshot records the reserves and TVL of an AMM pool at a block height
+type PoolSnapshot struct {
+	PoolID       uint64        `json:"pool_id"`
+	Reserves     []types.Token `json:"reserves"`
+	TotalShares  types.Token   `json:"total_shares"`
+	TVL          string        `json:"tvl"`
+	LpTokenPrice string        `json:"lp_token_price"`
+	UseOracle    bool          `json:"use_oracle"`
+	SwapFee      string        `json:"swap_fee"`
+}
+
+func (e PoolSnapshot) Process(database types.DatabaseManager, event types.BaseEvent) (types.Response, error) {
+	mergedData := types.GenericEvent{
+		BaseEvent: event,
+		Data:      e,
+	}
+
+	err := database.ProcessNewEvent(mergedData, event.Author)
+	if err != nil {
+		return types.Response{}, fmt.Errorf("error processing pool snapshot: %w", err)
+	}
+
+	return types.Response{}, nil
+}
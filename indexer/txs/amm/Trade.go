@@ -0,0 +1,46 @@
+package amm
+
+import (
+	"fmt"
+
+	"github.com/elys-network/elys/indexer/types"
+)
+
+// Trade is a single swap hop through one AMM pool. Routed swaps produce one
+// trade per pool so intermediate pools keep their own price history.
+//
+// The pair is oriented with the base currency as QuoteDenom, or Elys when the
+// base currency is not traded, other pairs so that BaseDenom < QuoteDenom.
+// Price is the amount of QuoteDenom paid or received per unit of BaseDenom,
+// in base units.
+type Trade struct {
+	PoolID            uint64      `json:"pool_id"`
+	Sender            string      `json:"sender"`
+	Recipient         string      `json:"recipient"`
+	TokenIn           types.Token `json:"token_in"`
+	TokenOut          types.Token `json:"token_out"`
+	BaseDenom         string      `json:"base_denom"`
+	QuoteDenom        string      `json:"quote_denom"`
+	Side              string      `json:"side"` // "buy" when base is received, "sell" when base is sent
+	Price             string      `json:"price"`
+	VolumeBase        string      `json:"volume_base"`
+	VolumeQuote       string      `json:"volume_quote"`
+	SwapFeeIn         types.Token `json:"swap_fee_in"`
+	SwapFeeOut        types.Token `json:"swap_fee_out"`
+	WeightBreakingFee string      `json:"weight_breaking_fee"`
+	WeightBonus       types.Token `json:"weight_bonus"`
+}
+
+func (e Trade) Process(database types.DatabaseManager, event types.BaseEvent) (types.Response, error) {
+	mergedData := types.GenericEvent{
+		BaseEvent: event,
+		Data:      e,
+	}
+
+	err := database.ProcessNewEvent(mergedData, event.Author)
+	if err != nil {
+		return types.Response{}, fmt.Errorf("error processing trade: %w", err)
+	}
+
+	return types.Response{}, nil
+}
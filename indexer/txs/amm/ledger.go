@@ -0,0 +1,75 @@
+package amm
+
+import (
+	"fmt"
+
+	"github.com/elys-network/elys/indexer/types"
+)
+
+// poolShareDenom mirrors the share denom of amm pools
+func poolShareDenom(poolID uint64) string {
+	return fmt.Sprintf("amm/pool/%d", poolID)
+}
+
+// recipientOrSender returns the account receiving the output of a swap
+func recipientOrSender(recipient, sender string) string {
+	if recipient != "" {
+		return recipient
+	}
+	return sender
+}
+
+func (m MsgCreatePool) LedgerEntries() []types.LedgerEntry {
+	entries := make([]types.LedgerEntry, 0, len(m.PoolAssets))
+	for _, asset := range m.PoolAssets {
+		entries = append(entries, types.Debit(m.Sender, types.LedgerLiquidity, asset.Token))
+	}
+	return entries
+}
+
+func (m MsgJoinPool) LedgerEntries() []types.LedgerEntry {
+	entries := make([]types.LedgerEntry, 0, len(m.TokenIn)+1)
+	for _, token := range m.TokenIn {
+		entries = append(entries, types.Debit(m.Sender, types.LedgerLiquidity, token))
+	}
+	shares := types.Token{Amount: m.ShareAmountOut, Denom: poolShareDenom(m.PoolID)}
+	return append(entries, types.Credit(m.Sender, types.LedgerLiquidity, shares))
+}
+
+func (m MsgExitPool) LedgerEntries() []types.LedgerEntry {
+	shares := types.Token{Amount: m.ShareAmountIn, Denom: poolShareDenom(m.PoolID)}
+	entries := []types.LedgerEntry{types.Debit(m.Sender, types.LedgerLiquidity, shares)}
+	for _, token := range m.TokenOut {
+		entries = append(entries, types.Credit(m.Sender, types.LedgerLiquidity, token))
+	}
+	return entries
+}
+
+func (m MsgSwapExactAmountIn) LedgerEntries() []types.LedgerEntry {
+	return []types.LedgerEntry{
+		types.Debit(m.Sender, types.LedgerSwap, m.TokenIn),
+		types.Credit(recipientOrSender(m.Recipient, m.Sender), types.LedgerSwap, m.TokenOut),
+	}
+}
+
+func (m MsgSwapExactAmountOut) LedgerEntries() []types.LedgerEntry {
+	return []types.LedgerEntry{
+		types.Debit(m.Sender, types.LedgerSwap, m.TokenInAmount),
+		types.Credit(recipientOrSender(m.Recipient, m.Sender), types.LedgerSwap, m.TokenOut),
+	}
+}
+
+func (m MsgSwapByDenom) LedgerEntries() []types.LedgerEntry {
+	recipient := recipientOrSender(m.Recipient, m.Sender)
+	if m.Amount.Denom == m.DenomIn {
+		return []types.LedgerEntry{
+			types.Debit(m.Sender, types.LedgerSwap, m.Amount),
+			types.Credit(recipient, types.LedgerSwap, m.TokenOut),
+		}
+	}
+	// For exact amount out swaps the response amount holds the input spent
+	return []types.LedgerEntry{
+		types.Debit(m.Sender, types.LedgerSwap, types.Token{Amount: m.TokenOut.Amount, Denom: m.DenomIn}),
+		types.Credit(recipient, types.LedgerSwap, m.Amount),
+	}
+}
@@ -0,0 +1,41 @@
+package bank
+
+import (
+	"fmt"
+
+	"github.com/elys-network/elys/indexer/types"
+)
+
+// DenomBalance is the balance of an address in one denom. Bank is the spendable
+// bank balance, Committed, Claimed and Vesting are held by the commitment
+// module: committed tokens, claimed rewards not committed yet and the part of
+// vesting tokens not claimed yet.
+type DenomBalance struct {
+	Denom     string `json:"denom"`
+	Bank      string `json:"bank"`
+	Committed string `json:"committed"`
+	Claimed   string `json:"claimed"`
+	Vesting   string `json:"vesting"`
+}
+
+// BalanceCheckpointEvent holds the balances of an address at the end of a block
+// in which they may have changed. Denoms the address no longer holds are left
+// out. It is only emitted in ledger mode.
+type BalanceCheckpointEvent struct {
+	Address  string         `json:"address"`
+	Balances []DenomBalance `json:"balances"`
+}
+
+func (e BalanceCheckpointEvent) Process(database types.DatabaseManager, event types.BaseEvent) (types.Response, error) {
+	mergedData := types.GenericEvent{
+		BaseEvent: event,
+		Data:      e,
+	}
+
+	err := database.ProcessNewEvent(mergedData, event.Author)
+	if err != nil {
+		return types.Response{}, fmt.Errorf("error processing balance checkpoint: %w", err)
+	}
+
+	return types.Response{}, nil
+}
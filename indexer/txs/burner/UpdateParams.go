@@ -11,11 +11,19 @@ type Params struct {
 }
 
 type MsgUpdateParams struct {
-	Authority string `json:"authority"`
-	Params    Params `json:"params"`
+	Authority      string              `json:"authority"`
+	Params         Params              `json:"params"`
+	PreviousParams Params              `json:"previous_params"`
+	Changes        []types.ParamChange `json:"changes"`
+}
+
+// ParamChanges returns the parameters changed by the update
+func (m MsgUpdateParams) ParamChanges() []types.ParamChange {
+	return types.DiffParams(m.PreviousParams, m.Params)
 }
 
 func (m MsgUpdateParams) Process(database types.DatabaseManager, transaction types.BaseTransaction) (types.Response, error) {
+	m.Changes = m.ParamChanges()
 	mergedData := types.GenericTransaction{
 		BaseTransaction: transaction,
 		Data:            m,
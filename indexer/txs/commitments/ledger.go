@@ -0,0 +1,48 @@
+package commitments
+
+import (
+	"github.com/elys-network/elys/indexer/types"
+)
+
+func (m MsgStake) LedgerEntries() []types.LedgerEntry {
+	return []types.LedgerEntry{types.Debit(m.Creator, types.LedgerStake, m.Token)}
+}
+
+func (m MsgUnstake) LedgerEntries() []types.LedgerEntry {
+	return []types.LedgerEntry{types.Credit(m.Creator, types.LedgerStake, m.Token)}
+}
+
+func (m MsgVest) LedgerEntries() []types.LedgerEntry {
+	return []types.LedgerEntry{types.Debit(m.Creator, types.LedgerVesting, m.Token)}
+}
+
+func (m MsgVestLiquid) LedgerEntries() []types.LedgerEntry {
+	return []types.LedgerEntry{types.Debit(m.Creator, types.LedgerVesting, m.Token)}
+}
+
+func (m MsgCancelVest) LedgerEntries() []types.LedgerEntry {
+	return []types.LedgerEntry{types.Credit(m.Creator, types.LedgerVesting, m.Token)}
+}
+
+func (m MsgClaimVesting) LedgerEntries() []types.LedgerEntry {
+	entries := make([]types.LedgerEntry, 0, len(m.Claims))
+	for _, claim := range m.Claims {
+		entries = append(entries, types.Credit(m.Sender, types.LedgerVesting, claim))
+	}
+	return entries
+}
+
+func (m MsgCommitClaimedRewards) LedgerEntries() []types.LedgerEntry {
+	return []types.LedgerEntry{types.Debit(m.Creator, types.LedgerCommit, m.Token)}
+}
+
+func (m MsgUncommitTokens) LedgerEntries() []types.LedgerEntry {
+	return []types.LedgerEntry{types.Credit(m.Creator, types.LedgerCommit, m.Token)}
+}
+
+func (m MsgVestNow) LedgerEntries() []types.LedgerEntry {
+	return []types.LedgerEntry{
+		types.Debit(m.Creator, types.LedgerVesting, types.Token{Amount: m.Amount, Denom: m.Denom}),
+		types.Credit(m.Creator, types.LedgerVesting, m.VestAmount),
+	}
+}
@@ -0,0 +1,25 @@
+package estaking
+
+import (
+	"github.com/elys-network/elys/indexer/types"
+)
+
+func rewardEntries(address string, amount []types.Token) []types.LedgerEntry {
+	entries := make([]types.LedgerEntry, 0, len(amount))
+	for _, token := range amount {
+		entries = append(entries, types.Credit(address, types.LedgerReward, token))
+	}
+	return entries
+}
+
+func (m MsgWithdrawReward) LedgerEntries() []types.LedgerEntry {
+	return rewardEntries(m.DelegatorAddress, m.Amount)
+}
+
+func (m MsgWithdrawElysStakingRewards) LedgerEntries() []types.LedgerEntry {
+	return rewardEntries(m.DelegatorAddress, m.Amount)
+}
+
+func (m MsgWithdrawAllRewards) LedgerEntries() []types.LedgerEntry {
+	return rewardEntries(m.DelegatorAddress, m.Amount)
+}
@@ -12,6 +12,11 @@ type MsgClose struct {
 	LpAmount    string   `json:"lp_amount"`
 	RepayAmount string   `json:"repay_amount"`
 	Position    Position `json:"position"`
+	// Profit/Loss of the closed share, in the collateral denom
+	ReturnedAmount string `json:"returned_amount"`
+	InitialValue   string `json:"initial_value"`
+	ProfitLoss     string `json:"profit_loss"`
+	ProfitLossPerc string `json:"profit_loss_perc"`
 }
 
 type Position struct {
@@ -17,6 +17,7 @@ type LiquidationEvent struct {
 	FinalValue     string      `json:"final_value"`      // Final value (repayAmount - liabilities)
 	ProfitLoss     string      `json:"profit_loss"`      // Actual P/L
 	ProfitLossPerc string      `json:"profit_loss_perc"` // P/L as percentage
+	ReturnedAmount types.Token `json:"returned_amount"`  // Collateral asset returned to the owner
 }
 
 type StopLossEvent struct {
@@ -33,6 +34,7 @@ type StopLossEvent struct {
 	ProfitLoss      string      `json:"profit_loss"`      // Calculated P&L
 	ProfitLossPerc  string      `json:"profit_loss_perc"` // P&L as percentage
 	RemainingAmount string      `json:"remaining_amount"` // Amount returned to user after closure
+	ReturnedAmount  types.Token `json:"returned_amount"`  // Collateral asset returned to the owner
 }
 
 func (e LiquidationEvent) Process(database types.DatabaseManager, event types.BaseEvent) (types.Response, error) {
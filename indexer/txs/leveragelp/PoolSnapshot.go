@@ -0,0 +1,32 @@
+package leveragelp
+
+import (
+	"fmt"
+
+	"github.com/elys-network/elys/indexer/types"
+)
+
+// PoolSnapshot records the leverage state of a leveragelp pool
+type PoolSnapshot struct {
+	PoolID             uint64 `json:"pool_id"`
+	Health             string `json:"health"`
+	LeveragedLpAmount  string `json:"leveraged_lp_amount"`
+	AmmPoolTotalShares string `json:"amm_pool_total_shares"`
+	LeveragedLpRatio   string `json:"leveraged_lp_ratio"` // LeveragedLpAmount / AmmPoolTotalShares
+	LeverageMax        string `json:"leverage_max"`
+	MaxLeveragelpRatio string `json:"max_leveragelp_ratio"`
+}
+
+func (e PoolSnapshot) Process(database types.DatabaseManager, event types.BaseEvent) (types.Response, error) {
+	mergedData := types.GenericEvent{
+		BaseEvent: event,
+		Data:      e,
+	}
+
+	err := database.ProcessNewEvent(mergedData, event.Author)
+	if err != nil {
+		return types.Response{}, fmt.Errorf("error processing pool snapshot: %w", err)
+	}
+
+	return types.Response{}, nil
+}
@@ -0,0 +1,21 @@
+package leveragelp
+
+import (
+	"github.com/elys-network/elys/indexer/types"
+)
+
+func (m MsgOpen) LedgerEntries() []types.LedgerEntry {
+	return []types.LedgerEntry{types.Debit(m.Creator, types.LedgerPosition, types.Token{Amount: m.CollateralAmount, Denom: m.CollateralAsset})}
+}
+
+func (m MsgClose) LedgerEntries() []types.LedgerEntry {
+	return []types.LedgerEntry{types.Credit(m.Creator, types.LedgerPosition, types.Token{Amount: m.ReturnedAmount, Denom: m.Position.Collateral.Denom})}
+}
+
+func (e LiquidationEvent) LedgerEntries() []types.LedgerEntry {
+	return []types.LedgerEntry{types.Credit(e.Address, types.LedgerLiquidation, e.ReturnedAmount)}
+}
+
+func (e StopLossEvent) LedgerEntries() []types.LedgerEntry {
+	return []types.LedgerEntry{types.Credit(e.Address, types.LedgerStopLoss, e.ReturnedAmount)}
+}
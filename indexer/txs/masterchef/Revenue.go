@@ -0,0 +1,64 @@
+package masterchef
+
+import (
+	"fmt"
+
+	"github.com/elys-network/elys/indexer/types"
+)
+
+// Revenue sources
+const (
+	RevenueSourceGas  = "gas"
+	RevenueSourcePerp = "perp"
+	RevenueSourceDex  = "dex"
+)
+
+// RevenueCollectedEvent is revenue collected by the masterchef end blocker.
+// Gas and perp revenue is collected once converted to USDC, DEX revenue per
+// pool as held by the pool revenue address. Lps, Stakers and Protocol are the
+// USDC portions added to FeeInfo.
+type RevenueCollectedEvent struct {
+	Source    string        `json:"source"`
+	PoolID    uint64        `json:"pool_id"`
+	Collected []types.Token `json:"collected"`
+	Lps       string        `json:"lps"`
+	Stakers   string        `json:"stakers"`
+	Protocol  string        `json:"protocol"`
+}
+
+// FeeConversionEvent is a collected fee swapped to USDC in a pool before its
+// distribution
+type FeeConversionEvent struct {
+	Address  string      `json:"address"`
+	PoolID   uint64      `json:"pool_id"`
+	TokenIn  types.Token `json:"token_in"`
+	TokenOut types.Token `json:"token_out"`
+}
+
+func (e RevenueCollectedEvent) Process(database types.DatabaseManager, event types.BaseEvent) (types.Response, error) {
+	mergedData := types.GenericEvent{
+		BaseEvent: event,
+		Data:      e,
+	}
+
+	err := database.ProcessNewEvent(mergedData, event.Author)
+	if err != nil {
+		return types.Response{}, fmt.Errorf("error processing revenue collected event: %w", err)
+	}
+
+	return types.Response{}, nil
+}
+
+func (e FeeConversionEvent) Process(database types.DatabaseManager, event types.BaseEvent) (types.Response, error) {
+	mergedData := types.GenericEvent{
+		BaseEvent: event,
+		Data:      e,
+	}
+
+	err := database.ProcessNewEvent(mergedData, event.Author)
+	if err != nil {
+		return types.Response{}, fmt.Errorf("error processing fee conversion event: %w", err)
+	}
+
+	return types.Response{}, nil
+}
@@ -0,0 +1,23 @@
+package masterchef
+
+import (
+	"github.com/elys-network/elys/indexer/types"
+)
+
+func (m MsgAddExternalIncentive) LedgerEntries() []types.LedgerEntry {
+	return []types.LedgerEntry{types.Debit(m.Sender, types.LedgerIncentive, types.Token{Amount: m.TotalAmount, Denom: m.RewardDenom})}
+}
+
+// Claimed rewards are booked from the claim event, which is also emitted for
+// claims made through other modules
+func (e ClaimRewardsEvent) LedgerEntries() []types.LedgerEntry {
+	recipient := e.Recipient
+	if recipient == "" {
+		recipient = e.Sender
+	}
+	entries := make([]types.LedgerEntry, 0, len(e.RewardCoins))
+	for _, coin := range e.RewardCoins {
+		entries = append(entries, types.Credit(recipient, types.LedgerReward, coin))
+	}
+	return entries
+}
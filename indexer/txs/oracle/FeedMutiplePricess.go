@@ -7,9 +7,11 @@ import (
 )
 
 type FeedPrice struct {
-	Asset  string `json:"asset"`
-	Price  string `json:"price"`
-	Source string `json:"source"`
+	Asset   string `json:"asset"`
+	Price   string `json:"price"`
+	Source  string `json:"source"`
+	Denom   string `json:"denom,omitempty"`   // Denom of the asset info, when one exists
+	Decimal uint64 `json:"decimal,omitempty"` // Decimals of the asset info denom
 }
 
 type MsgFeedMultiplePrices struct {
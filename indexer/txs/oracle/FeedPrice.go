@@ -20,6 +20,8 @@ type MsgFeedPrice struct {
 	Source      string `json:"source"`
 	Timestamp   uint64 `json:"timestamp"`
 	BlockHeight uint64 `json:"block_height"`
+	Denom       string `json:"denom,omitempty"`   // Denom of the asset info, when one exists
+	Decimal     uint64 `json:"decimal,omitempty"` // Decimals of the asset info denom
 }
 
 func (m MsgFeedPrice) Process(database types.DatabaseManager, transaction types.BaseTransaction) (types.Response, error) {
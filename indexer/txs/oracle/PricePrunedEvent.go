@@ -0,0 +1,33 @@
+package oracle
+
+import (
+	"fmt"
+
+	"github.com/elys-network/elys/indexer/types"
+)
+
+// PricePrunedEvent is the last price of an asset and source removed by the
+// oracle end blocker once expired, with no newer price to replace it. Provider
+// is the feeder that submitted it and let it go stale.
+type PricePrunedEvent struct {
+	Provider    string `json:"provider"`
+	Asset       string `json:"asset"`
+	Source      string `json:"source"`
+	Price       string `json:"price"`
+	Timestamp   uint64 `json:"timestamp"`
+	BlockHeight uint64 `json:"block_height"`
+}
+
+func (e PricePrunedEvent) Process(database types.DatabaseManager, event types.BaseEvent) (types.Response, error) {
+	mergedData := types.GenericEvent{
+		BaseEvent: event,
+		Data:      e,
+	}
+
+	err := database.ProcessNewEvent(mergedData, event.Author)
+	if err != nil {
+		return types.Response{}, fmt.Errorf("error processing pruned price: %w", err)
+	}
+
+	return types.Response{}, nil
+}
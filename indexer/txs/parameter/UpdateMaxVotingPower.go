@@ -7,11 +7,19 @@ import (
 )
 
 type MsgUpdateMaxVotingPower struct {
-	Creator        string `json:"creator"`
-	MaxVotingPower string `json:"max_voting_power"`
+	Creator                string              `json:"creator"`
+	MaxVotingPower         string              `json:"max_voting_power"`
+	PreviousMaxVotingPower string              `json:"previous_max_voting_power"`
+	Changes                []types.ParamChange `json:"changes"`
+}
+
+// ParamChanges returns the parameters changed by the update
+func (m MsgUpdateMaxVotingPower) ParamChanges() []types.ParamChange {
+	return types.DiffParam("max_voting_power", m.PreviousMaxVotingPower, m.MaxVotingPower)
 }
 
 func (m MsgUpdateMaxVotingPower) Process(database types.DatabaseManager, transaction types.BaseTransaction) (types.Response, error) {
+	m.Changes = m.ParamChanges()
 	mergedData := types.GenericTransaction{
 		BaseTransaction: transaction,
 		Data:            m,
@@ -7,11 +7,19 @@ import (
 )
 
 type MsgUpdateMinCommission struct {
-	Creator       string `json:"creator"`
-	MinCommission string `json:"min_commission"`
+	Creator               string              `json:"creator"`
+	MinCommission         string              `json:"min_commission"`
+	PreviousMinCommission string              `json:"previous_min_commission"`
+	Changes               []types.ParamChange `json:"changes"`
+}
+
+// ParamChanges returns the parameters changed by the update
+func (m MsgUpdateMinCommission) ParamChanges() []types.ParamChange {
+	return types.DiffParam("min_commission", m.PreviousMinCommission, m.MinCommission)
 }
 
 func (m MsgUpdateMinCommission) Process(database types.DatabaseManager, transaction types.BaseTransaction) (types.Response, error) {
+	m.Changes = m.ParamChanges()
 	mergedData := types.GenericTransaction{
 		BaseTransaction: transaction,
 		Data:            m,
@@ -7,11 +7,19 @@ import (
 )
 
 type MsgUpdateMinSelfDelegation struct {
-	Creator           string `json:"creator"`
-	MinSelfDelegation string `json:"min_self_delegation"`
+	Creator                   string              `json:"creator"`
+	MinSelfDelegation         string              `json:"min_self_delegation"`
+	PreviousMinSelfDelegation string              `json:"previous_min_self_delegation"`
+	Changes                   []types.ParamChange `json:"changes"`
+}
+
+// ParamChanges returns the parameters changed by the update
+func (m MsgUpdateMinSelfDelegation) ParamChanges() []types.ParamChange {
+	return types.DiffParam("min_self_delegation", m.PreviousMinSelfDelegation, m.MinSelfDelegation)
 }
 
 func (m MsgUpdateMinSelfDelegation) Process(database types.DatabaseManager, transaction types.BaseTransaction) (types.Response, error) {
+	m.Changes = m.ParamChanges()
 	mergedData := types.GenericTransaction{
 		BaseTransaction: transaction,
 		Data:            m,
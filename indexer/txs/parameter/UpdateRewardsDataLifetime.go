@@ -7,11 +7,19 @@ import (
 )
 
 type MsgUpdateRewardsDataLifetime struct {
-	Creator             string `json:"creator"`
-	RewardsDataLifetime uint64 `json:"rewards_data_lifetime"`
+	Creator                     string              `json:"creator"`
+	RewardsDataLifetime         uint64              `json:"rewards_data_lifetime"`
+	PreviousRewardsDataLifetime uint64              `json:"previous_rewards_data_lifetime"`
+	Changes                     []types.ParamChange `json:"changes"`
+}
+
+// ParamChanges returns the parameters changed by the update
+func (m MsgUpdateRewardsDataLifetime) ParamChanges() []types.ParamChange {
+	return types.DiffParam("rewards_data_lifetime", m.PreviousRewardsDataLifetime, m.RewardsDataLifetime)
 }
 
 func (m MsgUpdateRewardsDataLifetime) Process(database types.DatabaseManager, transaction types.BaseTransaction) (types.Response, error) {
+	m.Changes = m.ParamChanges()
 	mergedData := types.GenericTransaction{
 		BaseTransaction: transaction,
 		Data:            m,
@@ -7,11 +7,19 @@ import (
 )
 
 type MsgUpdateTotalBlocksPerYear struct {
-	Creator            string `json:"creator"`
-	TotalBlocksPerYear uint64 `json:"total_blocks_per_year"`
+	Creator                    string              `json:"creator"`
+	TotalBlocksPerYear         uint64              `json:"total_blocks_per_year"`
+	PreviousTotalBlocksPerYear uint64              `json:"previous_total_blocks_per_year"`
+	Changes                    []types.ParamChange `json:"changes"`
+}
+
+// ParamChanges returns the parameters changed by the update
+func (m MsgUpdateTotalBlocksPerYear) ParamChanges() []types.ParamChange {
+	return types.DiffParam("total_blocks_per_year", m.PreviousTotalBlocksPerYear, m.TotalBlocksPerYear)
 }
 
 func (m MsgUpdateTotalBlocksPerYear) Process(database types.DatabaseManager, transaction types.BaseTransaction) (types.Response, error) {
+	m.Changes = m.ParamChanges()
 	mergedData := types.GenericTransaction{
 		BaseTransaction: transaction,
 		Data:            m,
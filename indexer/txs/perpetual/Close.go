@@ -26,6 +26,11 @@ type MsgClose struct {
 	LiabilitiesAsset string `json:"liabilities_asset"`
 	MtpHealth        string `json:"mtp_health"`
 	OpenPrice        string `json:"open_price"`
+	// Share of the position closed, the position is removed when Closed is set
+	ClosingRatio string `json:"closing_ratio"`
+	Closed       bool   `json:"closed"`
+	// Custody asset returned to the owner
+	ReturnedAmount types.Token `json:"returned_amount"`
 }
 
 func (m MsgClose) Process(database types.DatabaseManager, transaction types.BaseTransaction) (types.Response, error) {
@@ -0,0 +1,42 @@
+package perpetual
+
+import (
+	"fmt"
+
+	"github.com/elys-network/elys/indexer/types"
+)
+
+// Consolidate records an open that was merged into an existing position. The
+// added amounts belong to the transient position that was destroyed by the
+// merge, the remaining fields describe the position after the merge.
+type Consolidate struct {
+	Address          string      `json:"address"`
+	ID               uint64      `json:"id"`
+	MergedID         uint64      `json:"merged_id"`
+	PoolID           uint64      `json:"pool_id"`
+	Position         string      `json:"position"`
+	AddedCollateral  types.Token `json:"added_collateral"`
+	AddedCustody     types.Token `json:"added_custody"`
+	AddedLiabilities types.Token `json:"added_liabilities"`
+	Collateral       types.Token `json:"collateral"`
+	Custody          types.Token `json:"custody"`
+	Liabilities      types.Token `json:"liabilities"`
+	OpenPrice        string      `json:"open_price"`
+	TakeProfitPrice  string      `json:"take_profit_price"`
+	StopLossPrice    string      `json:"stop_loss_price"`
+	Health           string      `json:"health"`
+}
+
+func (e Consolidate) Process(database types.DatabaseManager, event types.BaseEvent) (types.Response, error) {
+	mergedData := types.GenericEvent{
+		BaseEvent: event,
+		Data:      e,
+	}
+
+	err := database.ProcessNewEvent(mergedData, event.Author)
+	if err != nil {
+		return types.Response{}, fmt.Errorf("error processing consolidate event: %w", err)
+	}
+
+	return types.Response{}, nil
+}
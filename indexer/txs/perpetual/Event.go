@@ -19,6 +19,7 @@ type LiquidationEvent struct {
 	ProfitLossPerc string      `json:"profit_loss_perc"`
 	OpenPrice      string      `json:"open_price"`
 	Position       string      `json:"position"` // Long or Short
+	ReturnedAmount types.Token `json:"returned_amount"`
 }
 
 type TakeProfitEvent struct {
@@ -36,6 +37,7 @@ type TakeProfitEvent struct {
 	Health                 string      `json:"health"`
 	ProfitLoss             string      `json:"profit_loss"`
 	ProfitLossPerc         string      `json:"profit_loss_perc"`
+	ReturnedAmount         types.Token `json:"returned_amount"`
 }
 
 type StopLossEvent struct {
@@ -50,6 +52,7 @@ type StopLossEvent struct {
 	Health         string      `json:"health"`
 	ProfitLoss     string      `json:"profit_loss"`
 	ProfitLossPerc string      `json:"profit_loss_perc"`
+	ReturnedAmount types.Token `json:"returned_amount"`
 }
 
 func (e LiquidationEvent) Process(database types.DatabaseManager, event types.BaseEvent) (types.Response, error) {
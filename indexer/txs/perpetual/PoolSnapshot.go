@@ -0,0 +1,36 @@
+package perpetual
+
+import (
+	"fmt"
+
+	"github.com/elys-network/elys/indexer/types"
+)
+
+// PoolSnapshot records the open interest, funding rate and borrow interest
+// rate of a perpetual pool as computed by the begin blocker
+type PoolSnapshot struct {
+	PoolID                 uint64 `json:"pool_id"`
+	Health                 string `json:"health"`
+	TotalLongOpenInterest  string `json:"total_long_open_interest"`
+	TotalShortOpenInterest string `json:"total_short_open_interest"`
+	BorrowInterestRate     string `json:"borrow_interest_rate"`
+	FundingRate            string `json:"funding_rate"`
+	FundingRateLong        string `json:"funding_rate_long"`
+	FundingRateShort       string `json:"funding_rate_short"`
+	FundingAmountLong      string `json:"funding_amount_long"`
+	FundingAmountShort     string `json:"funding_amount_short"`
+}
+
+func (e PoolSnapshot) Process(database types.DatabaseManager, event types.BaseEvent) (types.Response, error) {
+	mergedData := types.GenericEvent{
+		BaseEvent: event,
+		Data:      e,
+	}
+
+	err := database.ProcessNewEvent(mergedData, event.Author)
+	if err != nil {
+		return types.Response{}, fmt.Errorf("error processing pool snapshot: %w", err)
+	}
+
+	return types.Response{}, nil
+}
@@ -0,0 +1,57 @@
+package perpetual
+
+import (
+	"fmt"
+
+	"github.com/elys-network/elys/indexer/types"
+)
+
+// FundingSettlement records the funding fee paid and received by a position
+// when its funding is settled
+type FundingSettlement struct {
+	Address  string      `json:"address"`
+	ID       uint64      `json:"id"`
+	PoolID   uint64      `json:"pool_id"`
+	Position string      `json:"position"`
+	Paid     types.Token `json:"paid"`
+	Received types.Token `json:"received"`
+}
+
+// InterestSettlement records the borrow interest paid out of the custody of a
+// position when its unpaid interest is settled
+type InterestSettlement struct {
+	Address  string      `json:"address"`
+	ID       uint64      `json:"id"`
+	PoolID   uint64      `json:"pool_id"`
+	Position string      `json:"position"`
+	Paid     types.Token `json:"paid"`
+	Unpaid   types.Token `json:"unpaid"`
+}
+
+func (e FundingSettlement) Process(database types.DatabaseManager, event types.BaseEvent) (types.Response, error) {
+	mergedData := types.GenericEvent{
+		BaseEvent: event,
+		Data:      e,
+	}
+
+	err := database.ProcessNewEvent(mergedData, event.Author)
+	if err != nil {
+		return types.Response{}, fmt.Errorf("error processing funding settlement: %w", err)
+	}
+
+	return types.Response{}, nil
+}
+
+func (e InterestSettlement) Process(database types.DatabaseManager, event types.BaseEvent) (types.Response, error) {
+	mergedData := types.GenericEvent{
+		BaseEvent: event,
+		Data:      e,
+	}
+
+	err := database.ProcessNewEvent(mergedData, event.Author)
+	if err != nil {
+		return types.Response{}, fmt.Errorf("error processing interest settlement: %w", err)
+	}
+
+	return types.Response{}, nil
+}
@@ -0,0 +1,29 @@
+package perpetual
+
+import (
+	"github.com/elys-network/elys/indexer/types"
+)
+
+func (m MsgOpen) LedgerEntries() []types.LedgerEntry {
+	return []types.LedgerEntry{types.Debit(m.Creator, types.LedgerPosition, m.Collateral)}
+}
+
+func (e Consolidate) LedgerEntries() []types.LedgerEntry {
+	return []types.LedgerEntry{types.Debit(e.Address, types.LedgerPosition, e.AddedCollateral)}
+}
+
+func (m MsgClose) LedgerEntries() []types.LedgerEntry {
+	return []types.LedgerEntry{types.Credit(m.Creator, types.LedgerPosition, m.ReturnedAmount)}
+}
+
+func (e LiquidationEvent) LedgerEntries() []types.LedgerEntry {
+	return []types.LedgerEntry{types.Credit(e.Address, types.LedgerLiquidation, e.ReturnedAmount)}
+}
+
+func (e StopLossEvent) LedgerEntries() []types.LedgerEntry {
+	return []types.LedgerEntry{types.Credit(e.Address, types.LedgerStopLoss, e.ReturnedAmount)}
+}
+
+func (e TakeProfitEvent) LedgerEntries() []types.LedgerEntry {
+	return []types.LedgerEntry{types.Credit(e.Address, types.LedgerTakeProfit, e.ReturnedAmount)}
+}
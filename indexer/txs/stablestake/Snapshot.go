@@ -0,0 +1,34 @@
+package stablestake
+
+import (
+	"fmt"
+
+	"github.com/elys-network/elys/indexer/types"
+)
+
+// Snapshot records the stablestake lending state at a block height
+type Snapshot struct {
+	PoolID         uint64 `json:"pool_id"` // Reserved pool id the stablestake pool is tracked under by masterchef
+	DepositDenom   string `json:"deposit_denom"`
+	InterestRate   string `json:"interest_rate"`
+	RedemptionRate string `json:"redemption_rate"`
+	TotalValue     string `json:"total_value"`
+	TVL            string `json:"tvl"` // USD value of TotalValue
+	TotalBorrow    string `json:"total_borrow"`
+	BorrowRatio    string `json:"borrow_ratio"`
+	EpochComputed  bool   `json:"epoch_computed"` // True when InterestRateComputation ran at this height
+}
+
+func (e Snapshot) Process(database types.DatabaseManager, event types.BaseEvent) (types.Response, error) {
+	mergedData := types.GenericEvent{
+		BaseEvent: event,
+		Data:      e,
+	}
+
+	err := database.ProcessNewEvent(mergedData, event.Author)
+	if err != nil {
+		return types.Response{}, fmt.Errorf("error processing snapshot: %w", err)
+	}
+
+	return types.Response{}, nil
+}
@@ -0,0 +1,19 @@
+package stablestake
+
+import (
+	"github.com/elys-network/elys/indexer/types"
+)
+
+func (m MsgBond) LedgerEntries() []types.LedgerEntry {
+	return []types.LedgerEntry{
+		types.Debit(m.Creator, types.LedgerDeposit, types.Token{Amount: m.Amount, Denom: m.DepositDenom}),
+		types.Credit(m.Creator, types.LedgerDeposit, types.Token{Amount: m.ShareAmount, Denom: m.ShareDenom}),
+	}
+}
+
+func (m MsgUnbond) LedgerEntries() []types.LedgerEntry {
+	return []types.LedgerEntry{
+		types.Debit(m.Creator, types.LedgerWithdrawal, types.Token{Amount: m.Amount, Denom: m.ShareDenom}),
+		types.Credit(m.Creator, types.LedgerWithdrawal, m.RedemptionToken),
+	}
+}
@@ -0,0 +1,11 @@
+package tokenomics
+
+import (
+	"github.com/elys-network/elys/indexer/types"
+)
+
+// CommitsClaimed holds the resulting claimed balance, only the airdrop amount
+// is booked
+func (m MsgClaimAirdrop) LedgerEntries() []types.LedgerEntry {
+	return []types.LedgerEntry{types.Credit(m.Sender, types.LedgerAirdrop, m.AmountClaimed)}
+}
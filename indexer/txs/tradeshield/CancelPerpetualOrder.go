@@ -9,7 +9,8 @@ import (
 type MsgCancelPerpetualOrder struct {
 	OwnerAddress string      `json:"owner_address"`
 	OrderID      uint64      `json:"order_id"`
-	Collateral   types.Token `json:"collateral"` // Include collateral information for tracking returned funds
+	Collateral   types.Token `json:"collateral"`        // Include collateral information for tracking returned funds
+	Deposit      types.Token `json:"deposit,omitempty"` // Deposit returned to the owner
 }
 
 func (m MsgCancelPerpetualOrder) Process(database types.DatabaseManager, transaction types.BaseTransaction) (types.Response, error) {
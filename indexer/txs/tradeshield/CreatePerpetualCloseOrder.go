@@ -8,10 +8,16 @@ import (
 )
 
 type MsgCreatePerpetualCloseOrder struct {
-	OwnerAddress string              `json:"owner_address"`
-	TriggerPrice common.TriggerPrice `json:"trigger_price"`
-	PositionID   uint64              `json:"position_id"`
-	OrderID      uint64              `json:"order_id"`
+	OwnerAddress string               `json:"owner_address"`
+	TriggerPrice common.TriggerPrice  `json:"trigger_price"`
+	PositionID   uint64               `json:"position_id"`
+	OrderType    string               `json:"order_type"`
+	CloseAmount  string               `json:"close_amount"`
+	OrderID      uint64               `json:"order_id"`
+	Deposit      types.Token          `json:"deposit,omitempty"` // Deposit escrowed for the executor of the order
+	TimeInForce  string               `json:"time_in_force,omitempty"`
+	Expiry       *common.OrderDate    `json:"expiry,omitempty"` // Expiry of GTT orders
+	TrailingStop *common.TrailingStop `json:"trailing_stop,omitempty"`
 }
 
 func (m MsgCreatePerpetualCloseOrder) Process(database types.DatabaseManager, transaction types.BaseTransaction) (types.Response, error) {
@@ -8,16 +8,21 @@ import (
 )
 
 type MsgCreatePerpetualOpenOrder struct {
-	OwnerAddress    string              `json:"owner_address"`
-	TriggerPrice    common.TriggerPrice `json:"trigger_price"`
-	Collateral      types.Token         `json:"collateral"`
-	TradingAsset    string              `json:"trading_asset"`
-	Position        int32               `json:"position"`
-	Leverage        string              `json:"leverage"`
-	TakeProfitPrice string              `json:"take_profit_price"`
-	StopLossPrice   string              `json:"stop_loss_price"`
-	PoolID          uint64              `json:"pool_id"`
-	OrderID         uint64              `json:"order_id"`
+	OwnerAddress    string                `json:"owner_address"`
+	TriggerPrice    common.TriggerPrice   `json:"trigger_price"`
+	Collateral      types.Token           `json:"collateral"`
+	TradingAsset    string                `json:"trading_asset"`
+	Position        int32                 `json:"position"`
+	Leverage        string                `json:"leverage"`
+	TakeProfitPrice string                `json:"take_profit_price"`
+	StopLossPrice   string                `json:"stop_loss_price"`
+	PoolID          uint64                `json:"pool_id"`
+	OrderID         uint64                `json:"order_id"`
+	Deposit         types.Token           `json:"deposit,omitempty"` // Deposit escrowed for the executor of the order and of its bracket orders
+	TimeInForce     string                `json:"time_in_force,omitempty"`
+	Expiry          *common.OrderDate     `json:"expiry,omitempty"`         // Expiry of GTT orders
+	GroupID         uint64                `json:"group_id,omitempty"`       // Group of the bracket orders
+	BracketOrders   []common.BracketOrder `json:"bracket_orders,omitempty"` // Close orders created once the position is opened
 }
 
 func (m MsgCreatePerpetualOpenOrder) Process(database types.DatabaseManager, transaction types.BaseTransaction) (types.Response, error) {
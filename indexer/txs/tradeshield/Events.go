@@ -51,6 +51,8 @@ type LimitSellExecutionEvent struct {
 	SwapFee          string             `json:"swap_fee"`
 	Discount         string             `json:"discount"`
 	Recipient        string             `json:"recipient"`
+	FillAmount       types.Token        `json:"fill_amount,omitempty"`      // Part of the order amount swapped by the execution
+	RemainingAmount  types.Token        `json:"remaining_amount,omitempty"` // Amount left to fill of partially filled orders
 }
 
 func (e LimitSellExecutionEvent) Process(database types.DatabaseManager, event types.BaseEvent) (types.Response, error) {
@@ -110,6 +112,8 @@ type LimitOrderExecutionEvent struct {
 	SwapFee          string             `json:"swap_fee"`
 	Discount         string             `json:"discount"`
 	Recipient        string             `json:"recipient"`
+	FillAmount       types.Token        `json:"fill_amount,omitempty"`      // Part of the order amount swapped by the execution
+	RemainingAmount  types.Token        `json:"remaining_amount,omitempty"` // Amount left to fill of partially filled orders
 }
 
 func (e LimitOrderExecutionEvent) Process(database types.DatabaseManager, event types.BaseEvent) (types.Response, error) {
@@ -184,6 +188,159 @@ func (e MarketOrderExecutionEvent) Process(database types.DatabaseManager, event
 	return types.Response{}, nil
 }
 
+// PerpetualCloseExecutionEvent represents the event for the execution of a
+// limit close or stop loss order of a perpetual position, the close itself is
+// recorded by the perpetual close transaction
+type PerpetualCloseExecutionEvent struct {
+	OrderID      uint64              `json:"order_id"`
+	OwnerAddress string              `json:"owner_address"`
+	OrderType    string              `json:"order_type"`
+	Position     string              `json:"position"`
+	PositionID   uint64              `json:"position_id"`
+	Status       common.OrderStatus  `json:"status"`
+	Date         common.OrderDate    `json:"date"`
+	MarketPrice  string              `json:"market_price"`
+	TriggerPrice common.TriggerPrice `json:"trigger_price"`
+	CloseAmount  string              `json:"close_amount"`
+}
+
+func (e PerpetualCloseExecutionEvent) Process(database types.DatabaseManager, event types.BaseEvent) (types.Response, error) {
+	mergedData := types.GenericEvent{
+		BaseEvent: event,
+		Data:      e,
+	}
+
+	err := database.ProcessNewEvent(mergedData, event.Author)
+	if err != nil {
+		return types.Response{}, fmt.Errorf("error processing perpetual close execution event: %w", err)
+	}
+
+	return types.Response{}, nil
+}
+
+// OrderExecutionEvent represents the orders executed automatically at the end
+// of a block, the executions themselves are recorded by their own records
+type OrderExecutionEvent struct {
+	SpotLogs      []OrderExecutionLog `json:"spot_logs"`
+	PerpetualLogs []OrderExecutionLog `json:"perpetual_logs"`
+}
+
+func (e OrderExecutionEvent) Process(database types.DatabaseManager, event types.BaseEvent) (types.Response, error) {
+	mergedData := types.GenericEvent{
+		BaseEvent: event,
+		Data:      e,
+	}
+
+	err := database.ProcessNewEvent(mergedData, event.Author)
+	if err != nil {
+		return types.Response{}, fmt.Errorf("error processing order execution event: %w", err)
+	}
+
+	return types.Response{}, nil
+}
+
+// ExpiredOrder represents a pending order cancelled by its time in force and
+// the funds returned to its owner
+type ExpiredOrder struct {
+	OrderID      uint64      `json:"order_id"`
+	OwnerAddress string      `json:"owner_address"`
+	TimeInForce  string      `json:"time_in_force"`
+	Reason       string      `json:"reason"`
+	Refund       types.Token `json:"refund"`
+	Deposit      types.Token `json:"deposit"`
+}
+
+func (o ExpiredOrder) GetOwnerAddress() string {
+	return o.OwnerAddress
+}
+
+// OrderExpiryEvent represents the GTT orders expired at the end of a block,
+// the IOC orders cancelled when they could not be executed and the orders
+// cancelled because another order of their group was executed
+type OrderExpiryEvent struct {
+	SpotOrders      []ExpiredOrder `json:"spot_orders"`
+	PerpetualOrders []ExpiredOrder `json:"perpetual_orders"`
+}
+
+func (e OrderExpiryEvent) Process(database types.DatabaseManager, event types.BaseEvent) (types.Response, error) {
+	mergedData := types.GenericEvent{
+		BaseEvent: event,
+		Data:      e,
+	}
+
+	err := database.ProcessNewEvent(mergedData, event.Author)
+	if err != nil {
+		return types.Response{}, fmt.Errorf("error processing order expiry event: %w", err)
+	}
+
+	return types.Response{}, nil
+}
+
+// TrailingStopUpdate represents a trailing stop order whose high-water mark
+// followed the market price
+type TrailingStopUpdate struct {
+	OrderID       uint64 `json:"order_id"`
+	OwnerAddress  string `json:"owner_address"`
+	HighWaterMark string `json:"high_water_mark"`
+	StopPrice     string `json:"stop_price"`
+}
+
+func (o TrailingStopUpdate) GetOwnerAddress() string {
+	return o.OwnerAddress
+}
+
+// TrailingStopEvent represents the trailing stop orders moved at the end of a
+// block
+type TrailingStopEvent struct {
+	SpotOrders      []TrailingStopUpdate `json:"spot_orders"`
+	PerpetualOrders []TrailingStopUpdate `json:"perpetual_orders"`
+}
+
+func (e TrailingStopEvent) Process(database types.DatabaseManager, event types.BaseEvent) (types.Response, error) {
+	mergedData := types.GenericEvent{
+		BaseEvent: event,
+		Data:      e,
+	}
+
+	err := database.ProcessNewEvent(mergedData, event.Author)
+	if err != nil {
+		return types.Response{}, fmt.Errorf("error processing trailing stop event: %w", err)
+	}
+
+	return types.Response{}, nil
+}
+
+// BracketCloseOrder represents a close order created from a bracket order
+type BracketCloseOrder struct {
+	OrderID uint64 `json:"order_id"`
+	common.BracketOrder
+}
+
+// BracketActivationEvent represents the close orders created once the limit
+// open order of a bracket opened its position, the group is zero when a
+// single close order was attached
+type BracketActivationEvent struct {
+	GroupID       uint64              `json:"group_id"`
+	ParentOrderID uint64              `json:"parent_order_id"`
+	OwnerAddress  string              `json:"owner_address"`
+	PositionID    uint64              `json:"position_id"`
+	Orders        []BracketCloseOrder `json:"orders"`
+}
+
+func (e BracketActivationEvent) Process(database types.DatabaseManager, event types.BaseEvent) (types.Response, error) {
+	mergedData := types.GenericEvent{
+		BaseEvent: event,
+		Data:      e,
+	}
+
+	err := database.ProcessNewEvent(mergedData, event.Author)
+	if err != nil {
+		return types.Response{}, fmt.Errorf("error processing bracket activation event: %w", err)
+	}
+
+	return types.Response{}, nil
+}
+
 // ExecutionLog represents the log for batch execution processing
 type ExecutionLog struct {
 	OrderID uint64 `json:"order_id"`
@@ -7,8 +7,10 @@ import (
 )
 
 type OrderExecutionLog struct {
-	OrderID uint64 `json:"order_id"`
-	Error   string `json:"error,omitempty"`
+	OrderID  uint64        `json:"order_id"`
+	Executed bool          `json:"executed"` // False when the order failed or its trigger is not reached
+	Error    string        `json:"error,omitempty"`
+	Reward   []types.Token `json:"reward,omitempty"` // Paid to the executor of the order
 }
 
 type MsgExecuteOrders struct {
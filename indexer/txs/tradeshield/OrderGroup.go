@@ -0,0 +1,57 @@
+package tradeshield
+
+import (
+	"fmt"
+
+	"github.com/elys-network/elys/indexer/types"
+)
+
+type MsgCreateOrderGroup struct {
+	OwnerAddress      string   `json:"owner_address"`
+	GroupID           uint64   `json:"group_id"`
+	SpotOrderIds      []uint64 `json:"spot_order_ids"`
+	PerpetualOrderIds []uint64 `json:"perpetual_order_ids"`
+}
+
+func (m MsgCreateOrderGroup) Process(database types.DatabaseManager, transaction types.BaseTransaction) (types.Response, error) {
+	mergedData := types.GenericTransaction{
+		BaseTransaction: transaction,
+		Data:            m,
+	}
+
+	err := database.ProcessNewTx(mergedData, transaction.Author)
+	if err != nil {
+		return types.Response{}, fmt.Errorf("error processing transaction: %w", err)
+	}
+
+	return types.Response{}, nil
+}
+
+// CancelledOrder represents an order of a cancelled group and the funds
+// returned to its owner
+type CancelledOrder struct {
+	OrderID uint64      `json:"order_id"`
+	Refund  types.Token `json:"refund"`
+	Deposit types.Token `json:"deposit"`
+}
+
+type MsgCancelOrderGroup struct {
+	OwnerAddress    string           `json:"owner_address"`
+	GroupID         uint64           `json:"group_id"`
+	SpotOrders      []CancelledOrder `json:"spot_orders"`
+	PerpetualOrders []CancelledOrder `json:"perpetual_orders"`
+}
+
+func (m MsgCancelOrderGroup) Process(database types.DatabaseManager, transaction types.BaseTransaction) (types.Response, error) {
+	mergedData := types.GenericTransaction{
+		BaseTransaction: transaction,
+		Data:            m,
+	}
+
+	err := database.ProcessNewTx(mergedData, transaction.Author)
+	if err != nil {
+		return types.Response{}, fmt.Errorf("error processing transaction: %w", err)
+	}
+
+	return types.Response{}, nil
+}
@@ -0,0 +1,86 @@
+package tradeshield
+
+import (
+	"fmt"
+
+	"github.com/elys-network/elys/indexer/txs/tradeshield/common"
+	"github.com/elys-network/elys/indexer/types"
+)
+
+type MsgCreateScheduledOrder struct {
+	OwnerAddress string      `json:"owner_address"`
+	OrderID      uint64      `json:"order_id"`
+	Amount       types.Token `json:"amount"`
+	TargetDenom  string      `json:"target_denom"`
+	SliceCount   uint64      `json:"slice_count"`
+	Interval     uint64      `json:"interval"`
+	IntervalUnit string      `json:"interval_unit"`
+	MaxSlippage  string      `json:"max_slippage"`
+}
+
+func (m MsgCreateScheduledOrder) Process(database types.DatabaseManager, transaction types.BaseTransaction) (types.Response, error) {
+	mergedData := types.GenericTransaction{
+		BaseTransaction: transaction,
+		Data:            m,
+	}
+
+	err := database.ProcessNewTx(mergedData, transaction.Author)
+	if err != nil {
+		return types.Response{}, fmt.Errorf("error processing transaction: %w", err)
+	}
+
+	return types.Response{}, nil
+}
+
+type MsgCancelScheduledOrder struct {
+	OwnerAddress   string      `json:"owner_address"`
+	OrderID        uint64      `json:"order_id"`
+	ExecutedSlices uint64      `json:"executed_slices"`
+	Refund         types.Token `json:"refund"`
+}
+
+func (m MsgCancelScheduledOrder) Process(database types.DatabaseManager, transaction types.BaseTransaction) (types.Response, error) {
+	mergedData := types.GenericTransaction{
+		BaseTransaction: transaction,
+		Data:            m,
+	}
+
+	err := database.ProcessNewTx(mergedData, transaction.Author)
+	if err != nil {
+		return types.Response{}, fmt.Errorf("error processing transaction: %w", err)
+	}
+
+	return types.Response{}, nil
+}
+
+// ScheduledOrderSliceEvent represents a slice of a scheduled order executed,
+// or skipped with an error, at the end of a block. The amount left to swap is
+// refunded with the last slice.
+type ScheduledOrderSliceEvent struct {
+	OrderID         uint64             `json:"order_id"`
+	OwnerAddress    string             `json:"owner_address"`
+	Index           uint64             `json:"index"`
+	SliceCount      uint64             `json:"slice_count"`
+	Status          common.OrderStatus `json:"status"`
+	Date            common.OrderDate   `json:"date"`
+	AmountIn        types.Token        `json:"amount_in"`
+	AmountOut       types.Token        `json:"amount_out"`
+	MinAmountOut    string             `json:"min_amount_out,omitempty"`
+	RemainingAmount types.Token        `json:"remaining_amount"`
+	Refund          types.Token        `json:"refund,omitempty"`
+	Error           string             `json:"error,omitempty"`
+}
+
+func (e ScheduledOrderSliceEvent) Process(database types.DatabaseManager, event types.BaseEvent) (types.Response, error) {
+	mergedData := types.GenericEvent{
+		BaseEvent: event,
+		Data:      e,
+	}
+
+	err := database.ProcessNewEvent(mergedData, event.Author)
+	if err != nil {
+		return types.Response{}, fmt.Errorf("error processing scheduled order slice event: %w", err)
+	}
+
+	return types.Response{}, nil
+}
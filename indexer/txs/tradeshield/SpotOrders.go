@@ -10,10 +10,14 @@ import (
 
 // Update MsgCreateSpotOrder to use BaseOrder
 type MsgCreateSpotOrder struct {
-	BaseOrder        common.BaseOrder `json:"base_order"`
-	OrderType        common.OrderType `json:"order_type"`
-	OrderTargetDenom string           `json:"order_target_denom"`
-	StopPrice        *decimal.Decimal `json:"stop_price,omitempty"`
+	BaseOrder        common.BaseOrder     `json:"base_order"`
+	OrderType        common.OrderType     `json:"order_type"`
+	OrderTargetDenom string               `json:"order_target_denom"`
+	StopPrice        *decimal.Decimal     `json:"stop_price,omitempty"`
+	Deposit          types.Token          `json:"deposit,omitempty"` // Deposit escrowed for the executor of the order
+	TimeInForce      string               `json:"time_in_force,omitempty"`
+	Expiry           *common.OrderDate    `json:"expiry,omitempty"` // Expiry of GTT orders
+	TrailingStop     *common.TrailingStop `json:"trailing_stop,omitempty"`
 }
 
 type MsgUpdateSpotOrder struct {
@@ -24,8 +28,10 @@ type MsgUpdateSpotOrder struct {
 }
 
 type MsgCancelSpotOrder struct {
-	OwnerAddress string `json:"owner_address"`
-	OrderId      uint64 `json:"order_id"`
+	OwnerAddress string      `json:"owner_address"`
+	OrderId      uint64      `json:"order_id"`
+	OrderAmount  types.Token `json:"order_amount"`      // Escrowed amount returned to the owner
+	Deposit      types.Token `json:"deposit,omitempty"` // Deposit returned to the owner
 }
 
 type MsgCancelSpotOrders struct {
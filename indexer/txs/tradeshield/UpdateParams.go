@@ -8,11 +8,19 @@ import (
 )
 
 type MsgUpdateParams struct {
-	Authority string        `json:"authority"`
-	Params    common.Params `json:"params"`
+	Authority      string              `json:"authority"`
+	Params         common.Params       `json:"params"`
+	PreviousParams common.Params       `json:"previous_params"`
+	Changes        []types.ParamChange `json:"changes"`
+}
+
+// ParamChanges returns the parameters changed by the update
+func (m MsgUpdateParams) ParamChanges() []types.ParamChange {
+	return types.DiffParams(m.PreviousParams, m.Params)
 }
 
 func (m MsgUpdateParams) Process(database types.DatabaseManager, transaction types.BaseTransaction) (types.Response, error) {
+	m.Changes = m.ParamChanges()
 	mergedData := types.GenericTransaction{
 		BaseTransaction: transaction,
 		Data:            m,
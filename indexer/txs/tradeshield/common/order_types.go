@@ -15,6 +15,22 @@ type OrderDate struct {
 	Timestamp uint64 `json:"timestamp"`
 }
 
+// TrailingStop is the trail of a trailing stop order
+type TrailingStop struct {
+	TrailPercent  string `json:"trail_percent,omitempty"`
+	TrailAmount   string `json:"trail_amount,omitempty"`
+	HighWaterMark string `json:"high_water_mark"`
+}
+
+// BracketOrder is a take profit or stop loss order attached to a limit open
+// order, created once the position is opened
+type BracketOrder struct {
+	OrderType    string      `json:"order_type"`
+	TriggerPrice string      `json:"trigger_price"`
+	CloseAmount  string      `json:"close_amount"`
+	Deposit      types.Token `json:"deposit"`
+}
+
 type BaseOrder struct {
 	OrderID      uint64      `json:"order_id"`
 	OwnerAddress string      `json:"owner_address"`
@@ -0,0 +1,118 @@
+package tradeshield
+
+import (
+	"github.com/elys-network/elys/indexer/types"
+)
+
+// Spot orders escrow the order amount and the executor deposit when created
+// and return them on cancel, executions of pending orders only credit the swap
+// output
+
+func (m MsgCreateSpotOrder) LedgerEntries() []types.LedgerEntry {
+	return []types.LedgerEntry{
+		types.Debit(m.BaseOrder.OwnerAddress, types.LedgerOrder, m.BaseOrder.OrderAmount),
+		types.Debit(m.BaseOrder.OwnerAddress, types.LedgerDeposit, m.Deposit),
+	}
+}
+
+func (m MsgCancelSpotOrder) LedgerEntries() []types.LedgerEntry {
+	return []types.LedgerEntry{
+		types.Credit(m.OwnerAddress, types.LedgerOrder, m.OrderAmount),
+		types.Credit(m.OwnerAddress, types.LedgerDeposit, m.Deposit),
+	}
+}
+
+func (m MsgCreatePerpetualOpenOrder) LedgerEntries() []types.LedgerEntry {
+	return []types.LedgerEntry{
+		types.Debit(m.OwnerAddress, types.LedgerOrder, m.Collateral),
+		types.Debit(m.OwnerAddress, types.LedgerDeposit, m.Deposit),
+	}
+}
+
+func (m MsgCreatePerpetualCloseOrder) LedgerEntries() []types.LedgerEntry {
+	return []types.LedgerEntry{types.Debit(m.OwnerAddress, types.LedgerDeposit, m.Deposit)}
+}
+
+func (m MsgCancelPerpetualOrder) LedgerEntries() []types.LedgerEntry {
+	return []types.LedgerEntry{
+		types.Credit(m.OwnerAddress, types.LedgerOrder, m.Collateral),
+		types.Credit(m.OwnerAddress, types.LedgerDeposit, m.Deposit),
+	}
+}
+
+// Cancelled groups return the funds and deposits of their orders
+func (m MsgCancelOrderGroup) LedgerEntries() []types.LedgerEntry {
+	var entries []types.LedgerEntry
+	for _, orders := range [][]CancelledOrder{m.SpotOrders, m.PerpetualOrders} {
+		for _, order := range orders {
+			entries = append(entries,
+				types.Credit(m.OwnerAddress, types.LedgerOrder, order.Refund),
+				types.Credit(m.OwnerAddress, types.LedgerDeposit, order.Deposit),
+			)
+		}
+	}
+	return entries
+}
+
+// Scheduled orders escrow the whole amount when created and return what is
+// left to swap on cancel or after their last slice
+func (m MsgCreateScheduledOrder) LedgerEntries() []types.LedgerEntry {
+	return []types.LedgerEntry{types.Debit(m.OwnerAddress, types.LedgerOrder, m.Amount)}
+}
+
+func (m MsgCancelScheduledOrder) LedgerEntries() []types.LedgerEntry {
+	return []types.LedgerEntry{types.Credit(m.OwnerAddress, types.LedgerOrder, m.Refund)}
+}
+
+func (e ScheduledOrderSliceEvent) LedgerEntries() []types.LedgerEntry {
+	return []types.LedgerEntry{
+		types.Credit(e.OwnerAddress, types.LedgerSwap, e.AmountOut),
+		types.Credit(e.OwnerAddress, types.LedgerOrder, e.Refund),
+	}
+}
+
+// Expired orders return their funds and deposit to their owners
+func (e OrderExpiryEvent) LedgerEntries() []types.LedgerEntry {
+	var entries []types.LedgerEntry
+	for _, orders := range [][]ExpiredOrder{e.SpotOrders, e.PerpetualOrders} {
+		for _, order := range orders {
+			entries = append(entries,
+				types.Credit(order.OwnerAddress, types.LedgerOrder, order.Refund),
+				types.Credit(order.OwnerAddress, types.LedgerDeposit, order.Deposit),
+			)
+		}
+	}
+	return entries
+}
+
+// Executors are credited the rewards of the orders they filled
+func (m MsgExecuteOrders) LedgerEntries() []types.LedgerEntry {
+	var entries []types.LedgerEntry
+	for _, logs := range [][]OrderExecutionLog{m.SpotLogs, m.PerpetualLogs} {
+		for _, log := range logs {
+			for _, reward := range log.Reward {
+				entries = append(entries, types.Credit(m.Creator, types.LedgerReward, reward))
+			}
+		}
+	}
+	return entries
+}
+
+func (e MarketOrderExecutionEvent) LedgerEntries() []types.LedgerEntry {
+	return []types.LedgerEntry{
+		types.Debit(e.OwnerAddress, types.LedgerSwap, e.OrderAmount),
+		types.Credit(e.OwnerAddress, types.LedgerSwap, e.SwapOutput),
+	}
+}
+
+func (e LimitOrderExecutionEvent) LedgerEntries() []types.LedgerEntry {
+	return []types.LedgerEntry{types.Credit(e.OwnerAddress, types.LedgerSwap, e.SwapOutput)}
+}
+
+func (e LimitSellExecutionEvent) LedgerEntries() []types.LedgerEntry {
+	return []types.LedgerEntry{types.Credit(e.OwnerAddress, types.LedgerSwap, e.SwapOutput)}
+}
+
+func (e StopLossExecutionEvent) LedgerEntries() []types.LedgerEntry {
+	return []types.LedgerEntry{types.Credit(e.OwnerAddress, types.LedgerSwap, e.SwapOutput)}
+}
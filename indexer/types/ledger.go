@@ -0,0 +1,57 @@
+package types
+
+// Ledger entry directions, seen from the account of the entry
+const (
+	LedgerCredit = "credit" // Tokens received by the account
+	LedgerDebit  = "debit"  // Tokens sent by the account
+)
+
+// Ledger entry categories
+const (
+	LedgerFee         = "fee"
+	LedgerSwap        = "swap"
+	LedgerLiquidity   = "liquidity"
+	LedgerStake       = "stake"
+	LedgerCommit      = "commit"
+	LedgerVesting     = "vesting"
+	LedgerReward      = "reward"
+	LedgerAirdrop     = "airdrop"
+	LedgerDeposit     = "deposit"
+	LedgerWithdrawal  = "withdrawal"
+	LedgerPosition    = "position"
+	LedgerLiquidation = "liquidation"
+	LedgerStopLoss    = "stop_loss"
+	LedgerTakeProfit  = "take_profit"
+	LedgerOrder       = "order"
+	LedgerIncentive   = "incentive"
+)
+
+// LedgerEntry is one debit or credit line of an account produced by a record
+type LedgerEntry struct {
+	Address   string `json:"address"`
+	Direction string `json:"direction"`
+	Category  string `json:"category"`
+	Amount    string `json:"amount"`
+	Denom     string `json:"denom"`
+}
+
+// LedgerMapper is implemented by the record types that move tokens in or out
+// of an account. Records of other types produce no ledger entries.
+type LedgerMapper interface {
+	LedgerEntries() []LedgerEntry
+}
+
+// Credit returns a line for tokens received by address
+func Credit(address, category string, token Token) LedgerEntry {
+	return LedgerEntry{Address: address, Direction: LedgerCredit, Category: category, Amount: token.Amount, Denom: token.Denom}
+}
+
+// Debit returns a line for tokens sent by address
+func Debit(address, category string, token Token) LedgerEntry {
+	return LedgerEntry{Address: address, Direction: LedgerDebit, Category: category, Amount: token.Amount, Denom: token.Denom}
+}
+
+// IsEmpty reports whether the entry moves no tokens
+func (e LedgerEntry) IsEmpty() bool {
+	return e.Denom == "" || e.Amount == "" || e.Amount == "0"
+}
@@ -0,0 +1,89 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+)
+
+// ParamChange is the change of one module parameter. Field is the JSON path
+// of the parameter, e.g. "fixed_funding_rate" or "lp_incentives.blocks_distributed".
+// Values hold strings as is and other values JSON encoded, an unset parameter
+// is empty.
+type ParamChange struct {
+	Field  string `json:"field"`
+	Before string `json:"before"`
+	After  string `json:"after"`
+}
+
+// ParamChangeMapper is implemented by the record types updating module
+// parameters. The previous parameters are read from the keeper by the handler
+// before it stores the new ones.
+type ParamChangeMapper interface {
+	ParamChanges() []ParamChange
+}
+
+// DiffParams compares two parameter sets field by field through their JSON
+// encoding and returns the changed fields ordered by path. Nested objects are
+// compared per field, lists as a whole.
+func DiffParams(before, after interface{}) []ParamChange {
+	beforeFields := flattenParams(before)
+	afterFields := flattenParams(after)
+
+	var changes []ParamChange
+	for field, value := range afterFields {
+		if beforeFields[field] != value {
+			changes = append(changes, ParamChange{Field: field, Before: beforeFields[field], After: value})
+		}
+	}
+	for field, value := range beforeFields {
+		if _, ok := afterFields[field]; !ok && value != "" {
+			changes = append(changes, ParamChange{Field: field, Before: value})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Field < changes[j].Field })
+	return changes
+}
+
+// DiffParam returns the change of a single parameter, if any
+func DiffParam(field string, before, after interface{}) []ParamChange {
+	return DiffParams(map[string]interface{}{field: before}, map[string]interface{}{field: after})
+}
+
+// flattenParams maps the JSON path of every leaf of params to its value
+func flattenParams(params interface{}) map[string]string {
+	fields := make(map[string]string)
+	data, err := json.Marshal(params)
+	if err != nil {
+		return fields
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var tree interface{}
+	if err := decoder.Decode(&tree); err != nil {
+		return fields
+	}
+	flattenParamValue("", tree, fields)
+	return fields
+}
+
+func flattenParamValue(path string, value interface{}, fields map[string]string) {
+	switch v := value.(type) {
+	case nil:
+		if path != "" {
+			fields[path] = ""
+		}
+	case map[string]interface{}:
+		for key, child := range v {
+			if path != "" {
+				key = path + "." + key
+			}
+			flattenParamValue(key, child, fields)
+		}
+	case string:
+		fields[path] = v
+	default:
+		encoded, _ := json.Marshal(v)
+		fields[path] = string(encoded)
+	}
+}
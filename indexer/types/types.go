@@ -75,52 +75,142 @@ func (r GenericRecord) IsEvent() bool {
 	return r.Event != nil
 }
 
+// Type returns the transaction or event type of the record
+func (r GenericRecord) Type() string {
+	if r.IsTransaction() {
+		return r.Transaction.BaseTransaction.TxType
+	} else if r.IsEvent() {
+		return r.Event.BaseEvent.EventType
+	}
+	return ""
+}
+
+// BlockHeight returns the height of the block the record was produced in
+func (r GenericRecord) BlockHeight() int64 {
+	if r.IsTransaction() {
+		return r.Transaction.BaseTransaction.BlockHeight
+	} else if r.IsEvent() {
+		return r.Event.BaseEvent.BlockHeight
+	}
+	return 0
+}
+
+// BlockTime returns the time of the block the record was produced in
+func (r GenericRecord) BlockTime() time.Time {
+	if r.IsTransaction() {
+		return r.Transaction.BaseTransaction.BlockTime
+	} else if r.IsEvent() {
+		return r.Event.BaseEvent.BlockTime
+	}
+	return time.Time{}
+}
+
 type ElysEvent struct {
+	Amm         AmmEvent
+	Bank        BankEvent
 	Leveragelp  LeveragelpEvent
 	Masterchef  MasterchefEvent
+	Oracle      OracleEvent
 	Perpetual   PerpetualEvent
+	Stablestake StablestakeEvent
 	Tradeshield TradeshieldEvent
 }
 
+type AmmEvent struct {
+	PoolSnapshot string
+	Trade        string
+}
+
+type BankEvent struct {
+	BalanceCheckpoint string
+}
+
 type LeveragelpEvent struct {
-	Liquidation string
-	StopLoss    string
+	Liquidation  string
+	StopLoss     string
+	PoolSnapshot string
 }
 
 type MasterchefEvent struct {
-	ClaimRewards string
+	ClaimRewards     string
+	RevenueCollected string
+	FeeConversion    string
+}
+
+type OracleEvent struct {
+	PricePruned string
 }
 
 type PerpetualEvent struct {
-	Liquidation string
-	StopLoss    string
-	TakeProfit  string
+	Liquidation        string
+	StopLoss           string
+	TakeProfit         string
+	PoolSnapshot       string
+	Consolidate        string
+	FundingSettlement  string
+	InterestSettlement string
+}
+
+type StablestakeEvent struct {
+	Snapshot string
 }
 
 type TradeshieldEvent struct {
-	StopLoss  string
-	LimitSell string
-	LimitBuy  string
-	MarketBuy string
+	StopLoss            string
+	LimitSell           string
+	LimitBuy            string
+	MarketBuy           string
+	PerpetualClose      string
+	OrderExecution      string
+	OrderExpiry         string
+	TrailingStop        string
+	BracketActivation   string
+	ScheduledOrderSlice string
 }
 
 var ElysEventTypes = ElysEvent{
+	Amm: AmmEvent{
+		PoolSnapshot: "/elys-event/amm/pool-snapshot",
+		Trade:        "/elys-event/amm/trade",
+	},
+	Bank: BankEvent{
+		BalanceCheckpoint: "/elys-event/bank/balance-checkpoint",
+	},
 	Leveragelp: LeveragelpEvent{
-		Liquidation: "/elys-event/leveragelp/liquidation",
-		StopLoss:    "/elys-event/leveragelp/stop-loss",
+		Liquidation:  "/elys-event/leveragelp/liquidation",
+		StopLoss:     "/elys-event/leveragelp/stop-loss",
+		PoolSnapshot: "/elys-event/leveragelp/pool-snapshot",
 	},
 	Masterchef: MasterchefEvent{
-		ClaimRewards: "/elys-event/masterchef/claim-rewards",
+		ClaimRewards:     "/elys-event/masterchef/claim-rewards",
+		RevenueCollected: "/elys-event/masterchef/revenue-collected",
+		FeeConversion:    "/elys-event/masterchef/fee-conversion",
+	},
+	Oracle: OracleEvent{
+		PricePruned: "/elys-event/oracle/price-pruned",
 	},
 	Perpetual: PerpetualEvent{
-		Liquidation: "/elys-event/perpetual/liquidation",
-		StopLoss:    "/elys-event/perpetual/stop-loss",
-		TakeProfit:  "/elys-event/perpetual/take-profit",
+		Liquidation:        "/elys-event/perpetual/liquidation",
+		StopLoss:           "/elys-event/perpetual/stop-loss",
+		TakeProfit:         "/elys-event/perpetual/take-profit",
+		PoolSnapshot:       "/elys-event/perpetual/pool-snapshot",
+		Consolidate:        "/elys-event/perpetual/consolidate",
+		FundingSettlement:  "/elys-event/perpetual/funding-settlement",
+		InterestSettlement: "/elys-event/perpetual/interest-settlement",
+	},
+	Stablestake: StablestakeEvent{
+		Snapshot: "/elys-event/stablestake/snapshot",
 	},
 	Tradeshield: TradeshieldEvent{
-		StopLoss:  "/elys-event/tradeshield/stop-loss",
-		LimitSell: "/elys-event/tradeshield/limit-sell",
-		LimitBuy:  "/elys-event/tradeshield/limit-buy",
-		MarketBuy: "/elys-event/tradeshield/market-buy",
+		StopLoss:            "/elys-event/tradeshield/stop-loss",
+		LimitSell:           "/elys-event/tradeshield/limit-sell",
+		LimitBuy:            "/elys-event/tradeshield/limit-buy",
+		MarketBuy:           "/elys-event/tradeshield/market-buy",
+		PerpetualClose:      "/elys-event/tradeshield/perpetual-close",
+		OrderExecution:      "/elys-event/tradeshield/order-execution",
+		OrderExpiry:         "/elys-event/tradeshield/order-expiry",
+		TrailingStop:        "/elys-event/tradeshield/trailing-stop",
+		BracketActivation:   "/elys-event/tradeshield/bracket-activation",
+		ScheduledOrderSlice: "/elys-event/tradeshield/scheduled-order-slice",
 	},
 }
@@ -0,0 +1,105 @@
+package indexer
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// Webhook request headers
+const (
+	WebhookSignatureHeader = "X-Elys-Signature"   // "sha256=" followed by the hex HMAC-SHA256 of the body
+	WebhookDeliveryHeader  = "X-Elys-Delivery-Id" // "<height>-<first index>-<last index>", stable across retries
+)
+
+// WebhookSink posts every batch as JSON to an HTTP endpoint. Failed requests
+// are retried with an exponential backoff before the batch is left in the
+// outbox for the next dispatch.
+type WebhookSink struct {
+	url         string
+	secret      []byte
+	client      *http.Client
+	maxAttempts int
+	backoff     time.Duration
+}
+
+// NewWebhookSink returns a sink posting to url, signing payloads when secret is set
+func NewWebhookSink(url, secret string) *WebhookSink {
+	return &WebhookSink{
+		url:         url,
+		secret:      []byte(secret),
+		client:      &http.Client{Timeout: 10 * time.Second},
+		maxAttempts: 3,
+		backoff:     500 * time.Millisecond,
+	}
+}
+
+func (s *WebhookSink) Name() string {
+	return "webhook"
+}
+
+func (s *WebhookSink) Deliver(ctx context.Context, batch SinkBatch) error {
+	body, err := json.Marshal(batch)
+	if err != nil {
+		return err
+	}
+
+	deliveryID := fmt.Sprintf("%d", batch.Height)
+	if len(batch.Records) > 0 {
+		deliveryID = fmt.Sprintf("%d-%d-%d", batch.Height, batch.Records[0].Index, batch.Records[len(batch.Records)-1].Index)
+	}
+
+	backoff := s.backoff
+	for attempt := 1; ; attempt++ {
+		err = s.post(ctx, body, deliveryID)
+		if err == nil || attempt == s.maxAttempts {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+func (s *WebhookSink) post(ctx context.Context, body []byte, deliveryID string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(WebhookDeliveryHeader, deliveryID)
+	if len(s.secret) > 0 {
+		req.Header.Set(WebhookSignatureHeader, "sha256="+WebhookSignature(s.secret, body))
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// WebhookSignature returns the hex HMAC-SHA256 of body, receivers compare it
+// with the X-Elys-Signature header to authenticate a request
+func WebhookSignature(secret, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
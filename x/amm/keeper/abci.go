@@ -1,6 +1,13 @@
 package keeper
 
 import (
+	/* *************************************************************************** */
+	/* Start of kwak-indexer node implementation*/
+	indexer "github.com/elys-network/elys/indexer"
+
+	/* End of kwak-indexer node implementation*/
+	/* *************************************************************************** */
+
 	"encoding/json"
 	"strings"
 	"time"
@@ -122,6 +129,14 @@ func (k Keeper) ExecuteSwapRequests(ctx sdk.Context) []sdk.Msg {
 		msg2, index2 = k.SelectReverseSwapRequest(ctx, msg1)
 		if index2 == 0 {
 			cachedCtx, write := ctx.CacheContext()
+
+			/* *************************************************************************** */
+			/* Start of kwak-indexer node implementation*/
+			// Trades are only indexed for the swap requests that are written
+			cachedCtx, write = indexer.DeferIndexing(cachedCtx, write)
+			/* End of kwak-indexer node implementation*/
+			/* *************************************************************************** */
+
 			err := k.ApplySwapRequest(cachedCtx, msg1)
 			if err == nil {
 				write()
@@ -134,10 +149,19 @@ func (k Keeper) ExecuteSwapRequests(ctx sdk.Context) []sdk.Msg {
 
 		poolId := k.FirstPoolId(msg1)
 		cachedCtx1, write1 := ctx.CacheContext()
+		cachedCtx2, write2 := ctx.CacheContext()
+
+		/* *************************************************************************** */
+		/* Start of kwak-indexer node implementation*/
+		// Only the trades of the swap request that is written are indexed
+		cachedCtx1, write1 = indexer.DeferIndexing(cachedCtx1, write1)
+		cachedCtx2, write2 = indexer.DeferIndexing(cachedCtx2, write2)
+		/* End of kwak-indexer node implementation*/
+		/* *************************************************************************** */
+
 		err1 := k.ApplySwapRequest(cachedCtx1, msg1)
 		stackedSlippage1 := k.GetStackedSlippage(cachedCtx1, poolId)
 
-		cachedCtx2, write2 := ctx.CacheContext()
 		err2 := k.ApplySwapRequest(cachedCtx2, msg2)
 		stackedSlippage2 := k.GetStackedSlippage(cachedCtx2, poolId)
 
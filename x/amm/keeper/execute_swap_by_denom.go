@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/elys-network/elys/x/amm/types"
+	assetprofiletypes "github.com/elys-network/elys/x/assetprofile/types"
+	ptypes "github.com/elys-network/elys/x/parameter/types"
+)
+
+// ExecuteSwapByDenom swaps the exact amount in of msg through the in route
+// right away. Unlike SwapByDenom, the swap is not queued as a swap request
+// settled by the end blocker, so modules swapping on behalf of their accounts,
+// e.g. from their own end blocker, get the actual output in the same execution.
+func (k Keeper) ExecuteSwapByDenom(ctx sdk.Context, msg *types.MsgSwapByDenom) (*types.MsgSwapByDenomResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	recipient, err := sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		recipient = sender
+	}
+
+	if msg.Amount.Denom != msg.DenomIn {
+		return nil, errorsmod.Wrapf(types.ErrInvalidDenom, "amount denom %s is not equals to denom in %s", msg.Amount.Denom, msg.DenomIn)
+	}
+	// check min amount denom is equals to denom out
+	if msg.MinAmount.Denom != msg.DenomOut {
+		return nil, errorsmod.Wrapf(types.ErrInvalidDenom, "min amount denom %s is not equals to denom out %s", msg.MinAmount.Denom, msg.DenomOut)
+	}
+
+	// retrieve base currency denom
+	baseCurrency, found := k.assetProfileKeeper.GetUsdcDenom(ctx)
+	if !found {
+		return nil, errorsmod.Wrapf(assetprofiletypes.ErrAssetProfileNotFound, "asset %s not found", ptypes.BaseCurrency)
+	}
+
+	inRoute, _, _, spotPrice, _, _, _, _, _, _, err := k.CalcSwapEstimationByDenom(ctx, msg.Amount, msg.DenomIn, msg.DenomOut, baseCurrency, msg.Sender, sdkmath.LegacyZeroDec(), 0)
+	if err != nil {
+		return nil, err
+	}
+
+	// convert route []*types.SwapAmountInRoute to []types.SwapAmountInRoute
+	route := make([]types.SwapAmountInRoute, len(inRoute))
+	for i, r := range inRoute {
+		route[i] = *r
+	}
+
+	tokenOutAmount, swapFee, discount, err := k.RouteExactAmountIn(ctx, sender, recipient, route, msg.Amount, msg.MinAmount.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSwapByDenomResponse{
+		Amount:    sdk.NewCoin(msg.DenomOut, tokenOutAmount),
+		InRoute:   inRoute,
+		OutRoute:  nil,
+		SpotPrice: spotPrice,
+		SwapFee:   swapFee,
+		Discount:  discount,
+		Recipient: recipient.String(),
+	}, nil
+}
@@ -0,0 +1,121 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/elys-network/elys/x/amm/types"
+	assetprofiletypes "github.com/elys-network/elys/x/assetprofile/types"
+	ptypes "github.com/elys-network/elys/x/parameter/types"
+)
+
+func (suite *AmmKeeperTestSuite) TestExecuteSwapByDenom() {
+	for _, tc := range []struct {
+		desc             string
+		tokenIn          sdk.Coin
+		minAmount        sdk.Coin
+		tokenOut         sdk.Coin
+		expSenderBalance sdk.Coins
+		expPass          bool
+	}{
+		{
+			desc:             "swap settled without an end blocker",
+			tokenIn:          sdk.NewInt64Coin(ptypes.Elys, 10000),
+			minAmount:        sdk.NewInt64Coin(ptypes.BaseCurrency, 9000),
+			tokenOut:         sdk.NewInt64Coin(ptypes.BaseCurrency, 9802),
+			expSenderBalance: sdk.Coins{sdk.NewInt64Coin(ptypes.Elys, 990000), sdk.NewInt64Coin(ptypes.BaseCurrency, 1009802)},
+			expPass:          true,
+		},
+		{
+			desc:      "output below the min amount",
+			tokenIn:   sdk.NewInt64Coin(ptypes.Elys, 10000),
+			minAmount: sdk.NewInt64Coin(ptypes.BaseCurrency, 10000),
+			expPass:   false,
+		},
+		{
+			desc:      "min amount denom is not denom out",
+			tokenIn:   sdk.NewInt64Coin(ptypes.Elys, 10000),
+			minAmount: sdk.NewInt64Coin(ptypes.Elys, 0),
+			expPass:   false,
+		},
+	} {
+		suite.Run(tc.desc, func() {
+			suite.SetupTest()
+
+			suite.app.AssetprofileKeeper.SetEntry(suite.ctx, assetprofiletypes.Entry{
+				BaseDenom: ptypes.Elys,
+				Denom:     ptypes.Elys,
+				Decimals:  6,
+			})
+			suite.app.AssetprofileKeeper.SetEntry(suite.ctx, assetprofiletypes.Entry{
+				BaseDenom: ptypes.BaseCurrency,
+				Denom:     ptypes.BaseCurrency,
+				Decimals:  6,
+			})
+
+			sender := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+			poolAddr := types.NewPoolAddress(uint64(1))
+			treasuryAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+			senderCoins := sdk.Coins{sdk.NewInt64Coin(ptypes.Elys, 1000000), sdk.NewInt64Coin(ptypes.BaseCurrency, 1000000)}
+			poolCoins := sdk.Coins{sdk.NewInt64Coin(ptypes.Elys, 1000000), sdk.NewInt64Coin(ptypes.BaseCurrency, 1000000)}
+
+			err := suite.app.BankKeeper.MintCoins(suite.ctx, minttypes.ModuleName, senderCoins.Add(poolCoins...))
+			suite.Require().NoError(err)
+			err = suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, minttypes.ModuleName, sender, senderCoins)
+			suite.Require().NoError(err)
+			err = suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, minttypes.ModuleName, poolAddr, poolCoins)
+			suite.Require().NoError(err)
+
+			suite.app.AmmKeeper.SetDenomLiquidity(suite.ctx, types.DenomLiquidity{
+				Denom:     ptypes.Elys,
+				Liquidity: sdkmath.NewInt(1000000),
+			})
+			suite.app.AmmKeeper.SetDenomLiquidity(suite.ctx, types.DenomLiquidity{
+				Denom:     ptypes.BaseCurrency,
+				Liquidity: sdkmath.NewInt(1000000),
+			})
+			suite.app.AmmKeeper.SetPool(suite.ctx, types.Pool{
+				PoolId:            1,
+				Address:           poolAddr.String(),
+				RebalanceTreasury: treasuryAddr.String(),
+				PoolParams: types.PoolParams{
+					SwapFee:  sdkmath.LegacyNewDecWithPrec(1, 2), // 1%
+					FeeDenom: ptypes.BaseCurrency,
+				},
+				TotalShares: sdk.Coin{},
+				PoolAssets: []types.PoolAsset{
+					{
+						Token:  poolCoins[0],
+						Weight: sdkmath.NewInt(10),
+					},
+					{
+						Token:  poolCoins[1],
+						Weight: sdkmath.NewInt(10),
+					},
+				},
+				TotalWeight: sdkmath.ZeroInt(),
+			})
+
+			resp, err := suite.app.AmmKeeper.ExecuteSwapByDenom(suite.ctx, &types.MsgSwapByDenom{
+				Sender:    sender.String(),
+				Amount:    tc.tokenIn,
+				MinAmount: tc.minAmount,
+				DenomIn:   tc.tokenIn.Denom,
+				DenomOut:  ptypes.BaseCurrency,
+			})
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.tokenOut.String(), resp.Amount.String())
+			suite.Require().Equal(sender.String(), resp.Recipient)
+
+			// no swap request is left for the end blocker
+			suite.Require().Empty(suite.app.AmmKeeper.GetAllSwapExactAmountInRequests(suite.ctx))
+			balances := suite.app.BankKeeper.GetAllBalances(suite.ctx, sender)
+			suite.Require().Equal(tc.expSenderBalance.String(), balances.String())
+		})
+	}
+}
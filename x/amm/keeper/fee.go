@@ -1,6 +1,13 @@
 package keeper
 
 import (
+	/* *************************************************************************** */
+	/* Start of kwak-indexer node implementation*/
+	indexer "github.com/elys-network/elys/indexer"
+
+	/* End of kwak-indexer node implementation*/
+	/* *************************************************************************** */
+
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -34,6 +41,14 @@ func (k Keeper) OnCollectFee(ctx sdk.Context, pool types.Pool, fee sdk.Coins) er
 
 	// handling the case, pool does not enough liquidity to swap fees to revenue token when liquidity is being fully removed
 	cacheCtx, write := ctx.CacheContext()
+
+	/* *************************************************************************** */
+	/* Start of kwak-indexer node implementation*/
+	// Trades of a rolled back fee swap are not indexed
+	cacheCtx, write = indexer.DeferIndexing(cacheCtx, write)
+	/* End of kwak-indexer node implementation*/
+	/* *************************************************************************** */
+
 	err = k.SwapFeesToRevenueToken(cacheCtx, pool, revenueAmount)
 	if err == nil {
 		write()
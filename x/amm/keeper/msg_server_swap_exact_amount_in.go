@@ -78,6 +78,14 @@ func (k Keeper) SwapExactAmountIn(ctx sdk.Context, msg *types.MsgSwapExactAmount
 	}
 	// Try executing the tx on cached context environment, to filter invalid transactions out
 	cacheCtx, _ := ctx.CacheContext()
+
+	/* *************************************************************************** */
+	/* Start of kwak-indexer node implementation*/
+	// The swap is executed for real by the end blocker, its trade is indexed there
+	cacheCtx = indexer.WithoutIndexing(cacheCtx)
+	/* End of kwak-indexer node implementation*/
+	/* *************************************************************************** */
+
 	tokenOutAmount, swapFee, discount, err := k.RouteExactAmountIn(cacheCtx, sender, recipient, msg.Routes, msg.TokenIn, sdkmath.Int(msg.TokenOutMinAmount))
 	if err != nil {
 		return nil, err
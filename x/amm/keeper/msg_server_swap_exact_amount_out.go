@@ -86,6 +86,14 @@ func (k Keeper) SwapExactAmountOut(ctx sdk.Context, msg *types.MsgSwapExactAmoun
 	}
 	// Try executing the tx on cached context environment, to filter invalid transactions out
 	cacheCtx, _ := ctx.CacheContext()
+
+	/* *************************************************************************** */
+	/* Start of kwak-indexer node implementation*/
+	// The swap is executed for real by the end blocker, its trade is indexed there
+	cacheCtx = indexer.WithoutIndexing(cacheCtx)
+	/* End of kwak-indexer node implementation*/
+	/* *************************************************************************** */
+
 	tokenInAmount, swapFee, discount, err := k.RouteExactAmountOut(cacheCtx, sender, recipient, msg.Routes, msg.TokenInMaxAmount, msg.TokenOut)
 	if err != nil {
 		return nil, err
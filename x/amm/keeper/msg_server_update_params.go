@@ -24,26 +24,42 @@ func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParam
 		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
 	}
 
+	/* *************************************************************************** */
+	/* Start of kwak-indexer node implementation*/
+	previousParams := k.GetParams(ctx)
+	/* End of kwak-indexer node implementation*/
+	/* *************************************************************************** */
+
 	k.Keeper.SetParams(ctx, *msg.Params)
 
 	/* *************************************************************************** */
 	/* Start of kwak-indexer node implementation*/
 	indexer.QueueTransaction(ctx, indexerAmmTypes.MsgUpdateParams{
-		Authority: msg.Authority,
-		Params: indexerAmmTypes.Params{
-			PoolCreationFee:             msg.Params.PoolCreationFee.String(),
-			SlippageTrackDuration:       msg.Params.SlippageTrackDuration,
-			BaseAssets:                  msg.Params.BaseAssets,
-			WeightBreakingFeeExponent:   msg.Params.WeightBreakingFeeExponent.String(),
-			WeightBreakingFeeMultiplier: msg.Params.WeightBreakingFeeMultiplier.String(),
-			WeightBreakingFeePortion:    msg.Params.WeightBreakingFeePortion.String(),
-			WeightRecoveryFeePortion:    msg.Params.WeightRecoveryFeePortion.String(),
-			ThresholdWeightDifference:   msg.Params.ThresholdWeightDifference.String(),
-			AllowedPoolCreators:         msg.Params.AllowedPoolCreators,
-		},
+		Authority:      msg.Authority,
+		Params:         indexerParams(*msg.Params),
+		PreviousParams: indexerParams(previousParams),
 	}, []string{})
 	/* End of kwak-indexer node implementation*/
 	/* *************************************************************************** */
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+/* *************************************************************************** */
+/* Start of kwak-indexer node implementation*/
+func indexerParams(params types.Params) indexerAmmTypes.Params {
+	return indexerAmmTypes.Params{
+		PoolCreationFee:             params.PoolCreationFee.String(),
+		SlippageTrackDuration:       params.SlippageTrackDuration,
+		BaseAssets:                  params.BaseAssets,
+		WeightBreakingFeeExponent:   params.WeightBreakingFeeExponent.String(),
+		WeightBreakingFeeMultiplier: params.WeightBreakingFeeMultiplier.String(),
+		WeightBreakingFeePortion:    params.WeightBreakingFeePortion.String(),
+		WeightRecoveryFeePortion:    params.WeightRecoveryFeePortion.String(),
+		ThresholdWeightDifference:   params.ThresholdWeightDifference.String(),
+		AllowedPoolCreators:         params.AllowedPoolCreators,
+	}
+}
+
+/* End of kwak-indexer node implementation*/
+/* *************************************************************************** */
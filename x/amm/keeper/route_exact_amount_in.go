@@ -1,6 +1,13 @@
 package keeper
 
 import (
+	/* *************************************************************************** */
+	/* Start of kwak-indexer node implementation*/
+	indexer "github.com/elys-network/elys/indexer"
+
+	/* End of kwak-indexer node implementation*/
+	/* *************************************************************************** */
+
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/elys-network/elys/x/amm/types"
@@ -23,6 +30,13 @@ func (k Keeper) RouteExactAmountIn(
 		return math.Int{}, math.LegacyZeroDec(), math.LegacyZeroDec(), err
 	}
 
+	/* *************************************************************************** */
+	/* Start of kwak-indexer node implementation*/
+	// The trades of the hops are only indexed once every hop succeeded
+	ctx, commitTrades := indexer.DeferIndexing(ctx, nil)
+	/* End of kwak-indexer node implementation*/
+	/* *************************************************************************** */
+
 	// In this loop, we check if:
 	// - the route is of length 2
 	// - route 1 and route 2 don't trade via the same pool
@@ -96,5 +110,11 @@ func (k Keeper) RouteExactAmountIn(
 		tokenIn = sdk.NewCoin(route.TokenOutDenom, tokenOutAmount)
 	}
 
+	/* *************************************************************************** */
+	/* Start of kwak-indexer node implementation*/
+	commitTrades()
+	/* End of kwak-indexer node implementation*/
+	/* *************************************************************************** */
+
 	return tokenOutAmount, totalDiscountedSwapFee, tier.Discount, nil
 }
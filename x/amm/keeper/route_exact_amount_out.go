@@ -1,6 +1,13 @@
 package keeper
 
 import (
+	/* *************************************************************************** */
+	/* Start of kwak-indexer node implementation*/
+	indexer "github.com/elys-network/elys/indexer"
+
+	/* End of kwak-indexer node implementation*/
+	/* *************************************************************************** */
+
 	"fmt"
 
 	"cosmossdk.io/math"
@@ -25,6 +32,13 @@ func (k Keeper) RouteExactAmountOut(ctx sdk.Context,
 		return math.Int{}, math.LegacyZeroDec(), math.LegacyZeroDec(), err
 	}
 
+	/* *************************************************************************** */
+	/* Start of kwak-indexer node implementation*/
+	// The trades of the hops are only indexed once every hop succeeded
+	ctx, commitTrades := indexer.DeferIndexing(ctx, nil)
+	/* End of kwak-indexer node implementation*/
+	/* *************************************************************************** */
+
 	defer func() {
 		if r := recover(); r != nil {
 			tokenInAmount = math.Int{}
@@ -119,5 +133,11 @@ func (k Keeper) RouteExactAmountOut(ctx sdk.Context,
 		}
 	}
 
+	/* *************************************************************************** */
+	/* Start of kwak-indexer node implementation*/
+	commitTrades()
+	/* End of kwak-indexer node implementation*/
+	/* *************************************************************************** */
+
 	return tokenInAmount, totalDiscountedSwapFee, discount, nil
 }
@@ -1,9 +1,20 @@
 package keeper
 
 import (
+	/* *************************************************************************** */
+	/* Start of kwak-indexer node implementation*/
+	indexer "github.com/elys-network/elys/indexer"
+	indexerAmmTypes "github.com/elys-network/elys/indexer/txs/amm"
+	indexerTypes "github.com/elys-network/elys/indexer/types"
+
+	/* End of kwak-indexer node implementation*/
+	/* *************************************************************************** */
+
 	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/elys-network/elys/x/amm/types"
+	ptypes "github.com/elys-network/elys/x/parameter/types"
 )
 
 // UpdatePoolForSwap takes a pool, sender, and tokenIn, tokenOut amounts
@@ -105,6 +116,16 @@ func (k Keeper) UpdatePoolForSwap(
 		}
 	}
 
+	/* *************************************************************************** */
+	/* Start of kwak-indexer node implementation*/
+	if indexer.ShouldIndex(ctx) {
+		// the quote asset is read without gas, indexing must not change the gas of the swap
+		usdcDenom, _ := k.assetProfileKeeper.GetUsdcDenom(ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()))
+		queueTrade(ctx, usdcDenom, pool, sender, recipient, tokenIn, tokenOut, swapFeeInCoins, swapFeeOutCoins, weightBalanceBonus, bonusTokenAmount)
+	}
+	/* End of kwak-indexer node implementation*/
+	/* *************************************************************************** */
+
 	// record tokenIn amount as total liquidity increase
 	err = k.RecordTotalLiquidityIncrease(ctx, tokensIn)
 	if err != nil {
@@ -133,3 +154,88 @@ func (k Keeper) UpdatePoolForSwap(
 	// return swap fee out amount
 	return swapFeeOutCoins.AmountOf(tokenOut.Denom), nil
 }
+
+/* *************************************************************************** */
+/* Start of kwak-indexer node implementation*/
+// tradeQuotePriority returns the priority of a denom as the quote denom of a
+// trade, the base currency first, then Elys
+func tradeQuotePriority(denom, usdcDenom string) int {
+	switch denom {
+	case usdcDenom:
+		return 2
+	case ptypes.Elys:
+		return 1
+	}
+	return 0
+}
+
+// queueTrade queues one trade per pool hop to the indexer, with the pair
+// oriented so that the quote denom is the base currency, or Elys, whichever
+// is traded. Other pairs are quoted in the denom sorting last.
+func queueTrade(
+	ctx sdk.Context,
+	usdcDenom string,
+	pool types.Pool,
+	sender sdk.AccAddress,
+	recipient sdk.AccAddress,
+	tokenIn sdk.Coin,
+	tokenOut sdk.Coin,
+	swapFeeInCoins sdk.Coins,
+	swapFeeOutCoins sdk.Coins,
+	weightBalanceBonus sdkmath.LegacyDec,
+	bonusTokenAmount sdkmath.Int,
+) {
+	baseDenom, quoteDenom := tokenIn.Denom, tokenOut.Denom
+	side, volumeBase, volumeQuote := "sell", tokenIn.Amount, tokenOut.Amount
+	basePriority, quotePriority := tradeQuotePriority(baseDenom, usdcDenom), tradeQuotePriority(quoteDenom, usdcDenom)
+	if basePriority > quotePriority || (basePriority == quotePriority && quoteDenom < baseDenom) {
+		baseDenom, quoteDenom = quoteDenom, baseDenom
+		side, volumeBase, volumeQuote = "buy", tokenOut.Amount, tokenIn.Amount
+	}
+	if !volumeBase.IsPositive() {
+		return
+	}
+	price := volumeQuote.ToLegacyDec().Quo(volumeBase.ToLegacyDec())
+
+	// A negative weight balance bonus is the weight breaking fee charged on the swap
+	weightBreakingFee := sdkmath.LegacyZeroDec()
+	if weightBalanceBonus.IsNegative() {
+		weightBreakingFee = weightBalanceBonus.Neg()
+	}
+
+	indexer.QueueEvent(ctx, indexerTypes.ElysEventTypes.Amm.Trade, indexerAmmTypes.Trade{
+		PoolID:    pool.PoolId,
+		Sender:    sender.String(),
+		Recipient: recipient.String(),
+		TokenIn: indexerTypes.Token{
+			Amount: tokenIn.Amount.String(),
+			Denom:  tokenIn.Denom,
+		},
+		TokenOut: indexerTypes.Token{
+			Amount: tokenOut.Amount.String(),
+			Denom:  tokenOut.Denom,
+		},
+		BaseDenom:   baseDenom,
+		QuoteDenom:  quoteDenom,
+		Side:        side,
+		Price:       price.String(),
+		VolumeBase:  volumeBase.String(),
+		VolumeQuote: volumeQuote.String(),
+		SwapFeeIn: indexerTypes.Token{
+			Amount: swapFeeInCoins.AmountOf(tokenIn.Denom).String(),
+			Denom:  tokenIn.Denom,
+		},
+		SwapFeeOut: indexerTypes.Token{
+			Amount: swapFeeOutCoins.AmountOf(tokenOut.Denom).String(),
+			Denom:  tokenOut.Denom,
+		},
+		WeightBreakingFee: weightBreakingFee.String(),
+		WeightBonus: indexerTypes.Token{
+			Amount: bonusTokenAmount.String(),
+			Denom:  tokenOut.Denom,
+		},
+	}, []string{sender.String(), recipient.String()})
+}
+
+/* End of kwak-indexer node implementation*/
+/* *************************************************************************** */
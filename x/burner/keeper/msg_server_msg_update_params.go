@@ -29,6 +29,13 @@ func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParam
 	}
 
 	params := k.GetParams(ctx)
+	/* *************************************************************************** */
+	/* Start of kwak-indexer node implementation*/
+	previousParams := indexerBurnerTypes.Params{
+		EpochIdentifier: params.EpochIdentifier,
+	}
+	/* End of kwak-indexer node implementation*/
+	/* *************************************************************************** */
 	params.EpochIdentifier = msg.Params.EpochIdentifier
 	k.SetParams(ctx, &params)
 
@@ -39,6 +46,7 @@ func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParam
 		Params: indexerBurnerTypes.Params{
 			EpochIdentifier: msg.Params.EpochIdentifier,
 		},
+		PreviousParams: previousParams,
 	}, []string{})
 	/* End of kwak-indexer node implementation*/
 	/* *************************************************************************** */
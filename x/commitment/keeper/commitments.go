@@ -3,6 +3,13 @@ package keeper
 import (
 	"fmt"
 
+	/* *************************************************************************** */
+	/* Start of kwak-indexer node implementation*/
+	indexer "github.com/elys-network/elys/indexer"
+
+	/* End of kwak-indexer node implementation*/
+	/* *************************************************************************** */
+
 	"cosmossdk.io/math"
 	"cosmossdk.io/store/prefix"
 	storetypes "cosmossdk.io/store/types"
@@ -25,6 +32,12 @@ func (k Keeper) SetCommitments(ctx sdk.Context, commitments types.Commitments) {
 	key := types.GetCommitmentsKey(commitments.GetCreatorAccount())
 	b := k.cdc.MustMarshal(&commitments)
 	store.Set(key, b)
+
+	/* *************************************************************************** */
+	/* Start of kwak-indexer node implementation*/
+	indexer.TouchBalances(ctx, commitments.Creator)
+	/* End of kwak-indexer node implementation*/
+	/* *************************************************************************** */
 }
 
 // GetAllCommitments returns all commitments
@@ -105,6 +118,12 @@ func (k Keeper) RemoveCommitments(ctx sdk.Context, creator sdk.AccAddress) {
 	}
 	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
 	store.Delete(types.GetCommitmentsKey(creator))
+
+	/* *************************************************************************** */
+	/* Start of kwak-indexer node implementation*/
+	indexer.TouchBalances(ctx, creator.String())
+	/* End of kwak-indexer node implementation*/
+	/* *************************************************************************** */
 }
 
 // IterateCommitments iterates over all Commitments and performs a
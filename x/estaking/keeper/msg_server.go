@@ -37,13 +37,21 @@ func (k msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParam
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	/* *************************************************************************** */
+	/* Start of kwak-indexer node implementation*/
+	previousParams := k.GetParams(ctx)
+	/* End of kwak-indexer node implementation*/
+	/* *************************************************************************** */
+
 	k.SetParams(ctx, req.Params)
 
 	/* *************************************************************************** */
 	/* Start of kwak-indexer node implementation*/
 	indexer.QueueTransaction(ctx, indexerEstakingTypes.MsgUpdateParams{
-		Authority: req.Authority,
-		Params:    req.Params,
+		Authority:      req.Authority,
+		Params:         req.Params,
+		PreviousParams: previousParams,
 	}, []string{})
 	/* End of kwak-indexer node implementation*/
 	/* *************************************************************************** */
@@ -7,7 +7,7 @@ import (
 	/* *************************************************************************** */
 	/* Start of kwak-indexer node implementation*/
 	indexer "github.com/elys-network/elys/indexer"
-	indexerPerpetualTypes "github.com/elys-network/elys/indexer/txs/leveragelp"
+	indexerLeveragelpTypes "github.com/elys-network/elys/indexer/txs/leveragelp"
 	indexerTypes "github.com/elys-network/elys/indexer/types"
 
 	/* End of kwak-indexer node implementation*/
@@ -29,6 +29,14 @@ func (k Keeper) BeginBlocker(ctx sdk.Context) {
 	epochPosition := k.GetEpochPosition(ctx, epochLength)
 	params := k.GetParams(ctx)
 
+	/* *************************************************************************** */
+	/* Start of kwak-indexer node implementation*/
+	if indexer.ShouldSnapshot(ctx.BlockHeight()) {
+		k.queuePoolSnapshots(ctx)
+	}
+	/* End of kwak-indexer node implementation*/
+	/* *************************************************************************** */
+
 	if epochPosition == 0 && params.FallbackEnabled { // if epoch has passed
 		pageReq := &query.PageRequest{
 			Limit:      uint64(params.NumberPerBlock),
@@ -95,6 +103,12 @@ func (k Keeper) CheckAndLiquidateUnhealthyPosition(ctx sdk.Context, position *ty
 		return true, false, h, fmt.Errorf("position is healthy to close")
 	}
 
+	/* *************************************************************************** */
+	/* Start of kwak-indexer node implementation*/
+	balanceBefore := k.bankKeeper.GetBalance(ctx, position.GetOwnerAddress(), position.Collateral.Denom).Amount
+	/* End of kwak-indexer node implementation*/
+	/* *************************************************************************** */
+
 	repayAmount, err := k.ForceCloseLong(ctx, *position, pool, position.LeveragedLpAmount, true)
 	if err != nil {
 		ctx.Logger().Debug(errorsmod.Wrap(err, "error executing liquidation").Error())
@@ -111,14 +125,12 @@ func (k Keeper) CheckAndLiquidateUnhealthyPosition(ctx sdk.Context, position *ty
 
 	/* *************************************************************************** */
 	/* Start of kwak-indexer node implementation*/
-	eventID := fmt.Sprintf("%d-%d-%s-%s", ctx.BlockHeight(), position.Id, position.Address, indexerTypes.ElysEventTypes.Leveragelp.Liquidation)
-
 	initialValue := math.LegacyNewDecFromInt(position.Collateral.Amount)
 	finalValue := math.LegacyNewDecFromInt(repayAmount).Sub(math.LegacyNewDecFromInt(position.Liabilities))
 	profitLoss, profitLossPerc := calculateProfitLoss(initialValue, finalValue)
 
 	// Queue the liquidation event
-	indexer.QueueEvent(ctx, indexerTypes.ElysEventTypes.Leveragelp.Liquidation, indexerPerpetualTypes.LiquidationEvent{
+	indexer.QueueEvent(ctx, indexerTypes.ElysEventTypes.Leveragelp.Liquidation, indexerLeveragelpTypes.LiquidationEvent{
 		PositionID: position.Id,
 		Address:    position.Address,
 		Collateral: indexerTypes.Token{
@@ -132,7 +144,8 @@ func (k Keeper) CheckAndLiquidateUnhealthyPosition(ctx sdk.Context, position *ty
 		FinalValue:     finalValue.String(),
 		ProfitLoss:     profitLoss.String(),
 		ProfitLossPerc: profitLossPerc.String(),
-	}, []string{position.Address}, eventID)
+		ReturnedAmount: k.returnedCollateral(ctx, position, balanceBefore),
+	}, []string{position.Address})
 	/* End of kwak-indexer node implementation*/
 	/* *************************************************************************** */
 
@@ -165,6 +178,12 @@ func (k Keeper) CheckAndCloseAtStopLoss(ctx sdk.Context, position *types.Positio
 		return underStopLossPrice, false, fmt.Errorf("position stop loss price is not <= lp token price")
 	}
 
+	/* *************************************************************************** */
+	/* Start of kwak-indexer node implementation*/
+	balanceBefore := k.bankKeeper.GetBalance(ctx, position.GetOwnerAddress(), position.Collateral.Denom).Amount
+	/* End of kwak-indexer node implementation*/
+	/* *************************************************************************** */
+
 	repayAmount, err := k.ForceCloseLong(ctx, *position, pool, position.LeveragedLpAmount, false)
 	if err != nil {
 		ctx.Logger().Error(errorsmod.Wrap(err, "error executing close for stopLossPrice").Error())
@@ -181,14 +200,12 @@ func (k Keeper) CheckAndCloseAtStopLoss(ctx sdk.Context, position *types.Positio
 
 	/* *************************************************************************** */
 	/* Start of kwak-indexer node implementation*/
-	eventID := fmt.Sprintf("%d-%d-%s-%s", ctx.BlockHeight(), position.Id, position.Address, indexerTypes.ElysEventTypes.Leveragelp.StopLoss)
-
 	initialValue := math.LegacyNewDecFromInt(position.Collateral.Amount)
 	finalValue := math.LegacyNewDecFromInt(repayAmount).Sub(math.LegacyNewDecFromInt(position.Liabilities))
 	profitLoss, profitLossPerc := calculateProfitLoss(initialValue, finalValue)
 
 	// Queue the stop loss event
-	indexer.QueueEvent(ctx, indexerTypes.ElysEventTypes.Leveragelp.StopLoss, indexerPerpetualTypes.StopLossEvent{
+	indexer.QueueEvent(ctx, indexerTypes.ElysEventTypes.Leveragelp.StopLoss, indexerLeveragelpTypes.StopLossEvent{
 		PositionID: position.Id,
 		Address:    position.Address,
 		Collateral: indexerTypes.Token{
@@ -205,7 +222,8 @@ func (k Keeper) CheckAndCloseAtStopLoss(ctx sdk.Context, position *types.Positio
 		ProfitLoss:      profitLoss.String(),
 		ProfitLossPerc:  profitLossPerc.String(),
 		RemainingAmount: finalValue.String(),
-	}, []string{position.Address}, eventID)
+		ReturnedAmount:  k.returnedCollateral(ctx, position, balanceBefore),
+	}, []string{position.Address})
 	/* End of kwak-indexer node implementation*/
 	/* *************************************************************************** */
 
@@ -214,6 +232,39 @@ func (k Keeper) CheckAndCloseAtStopLoss(ctx sdk.Context, position *types.Positio
 
 /* *************************************************************************** */
 /* Start of kwak-indexer node implementation*/
+// returnedCollateral is the collateral asset received by the owner since balanceBefore
+func (k Keeper) returnedCollateral(ctx sdk.Context, position *types.Position, balanceBefore math.Int) indexerTypes.Token {
+	returned := k.bankKeeper.GetBalance(ctx, position.GetOwnerAddress(), position.Collateral.Denom).Amount.Sub(balanceBefore)
+	if returned.IsNegative() {
+		returned = math.ZeroInt()
+	}
+	return indexerTypes.Token{Amount: returned.String(), Denom: position.Collateral.Denom}
+}
+
+func (k Keeper) queuePoolSnapshots(ctx sdk.Context) {
+	for _, pool := range k.GetAllPools(ctx) {
+		ammPool, err := k.GetAmmPool(ctx, pool.AmmPoolId)
+		if err != nil {
+			continue
+		}
+
+		leveragedLpRatio := math.LegacyZeroDec()
+		if ammPool.TotalShares.Amount.IsPositive() {
+			leveragedLpRatio = pool.LeveragedLpAmount.ToLegacyDec().Quo(ammPool.TotalShares.Amount.ToLegacyDec())
+		}
+
+		indexer.QueueEvent(ctx, indexerTypes.ElysEventTypes.Leveragelp.PoolSnapshot, indexerLeveragelpTypes.PoolSnapshot{
+			PoolID:             pool.AmmPoolId,
+			Health:             pool.Health.String(),
+			LeveragedLpAmount:  pool.LeveragedLpAmount.String(),
+			AmmPoolTotalShares: ammPool.TotalShares.Amount.String(),
+			LeveragedLpRatio:   leveragedLpRatio.String(),
+			LeverageMax:        pool.LeverageMax.String(),
+			MaxLeveragelpRatio: pool.MaxLeveragelpRatio.String(),
+		}, []string{})
+	}
+}
+
 func calculateProfitLoss(
 	initialValue math.LegacyDec,
 	finalValue math.LegacyDec,
@@ -6,6 +6,7 @@ import (
 
 	/* *************************************************************************** */
 	/* Start of kwak-indexer node implementation*/
+	"cosmossdk.io/math"
 	indexer "github.com/elys-network/elys/indexer"
 	indexerLeveragelpTypes "github.com/elys-network/elys/indexer/txs/leveragelp"
 	indexerTypes "github.com/elys-network/elys/indexer/types"
@@ -24,6 +25,17 @@ func (k msgServer) Close(goCtx context.Context, msg *types.MsgClose) (*types.Msg
 }
 
 func (k Keeper) Close(ctx sdk.Context, msg *types.MsgClose) (*types.MsgCloseResponse, error) {
+	/* *************************************************************************** */
+	/* Start of kwak-indexer node implementation*/
+	// The amount returned to the owner is the change of its collateral balance
+	owner := sdk.MustAccAddressFromBech32(msg.Creator)
+	balanceBefore := math.ZeroInt()
+	if position, err := k.GetPosition(ctx, owner, msg.Id); err == nil {
+		balanceBefore = k.bankKeeper.GetBalance(ctx, owner, position.Collateral.Denom).Amount
+	}
+	/* End of kwak-indexer node implementation*/
+	/* *************************************************************************** */
+
 	closedPosition, repayAmount, err := k.CloseLong(ctx, msg)
 	if err != nil {
 		return nil, err
@@ -51,6 +63,15 @@ func (k Keeper) Close(ctx sdk.Context, msg *types.MsgClose) (*types.MsgCloseResp
 
 	/* *************************************************************************** */
 	/* Start of kwak-indexer node implementation*/
+	// closedPosition holds the position as it was before closing
+	closedCollateral := closedPosition.Collateral.Amount
+	if remaining, err := k.GetPosition(ctx, owner, msg.Id); err == nil {
+		closedCollateral = closedCollateral.Sub(remaining.Collateral.Amount)
+	}
+	returnedAmount := k.bankKeeper.GetBalance(ctx, owner, closedPosition.Collateral.Denom).Amount.Sub(balanceBefore)
+	initialValue := math.LegacyNewDecFromInt(closedCollateral)
+	profitLoss, profitLossPerc := calculateProfitLoss(initialValue, math.LegacyNewDecFromInt(returnedAmount))
+
 	indexer.QueueTransaction(ctx, indexerLeveragelpTypes.MsgClose{
 		Creator:     msg.Creator,
 		ID:          msg.Id,
@@ -66,6 +87,10 @@ func (k Keeper) Close(ctx sdk.Context, msg *types.MsgClose) (*types.MsgCloseResp
 			Liabilities:    closedPosition.Liabilities.String(),
 			PositionHealth: closedPosition.PositionHealth.String(),
 		},
+		ReturnedAmount: returnedAmount.String(),
+		InitialValue:   initialValue.String(),
+		ProfitLoss:     profitLoss.String(),
+		ProfitLossPerc: profitLossPerc.String(),
 	}, []string{msg.Creator})
 	/* End of kwak-indexer node implementation*/
 	/* *************************************************************************** */
@@ -25,6 +25,12 @@ func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParam
 		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
 	}
 
+	/* *************************************************************************** */
+	/* Start of kwak-indexer node implementation*/
+	previousParams := k.GetParams(ctx)
+	/* End of kwak-indexer node implementation*/
+	/* *************************************************************************** */
+
 	// store params
 	if err := k.SetParams(ctx, msg.Params); err != nil {
 		return nil, err
@@ -33,20 +39,30 @@ func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParam
 	/* *************************************************************************** */
 	/* Start of kwak-indexer node implementation*/
 	indexer.QueueTransaction(ctx, indexerLeveragelpTypes.MsgUpdateParams{
-		Authority: msg.Authority,
-		Params: indexerLeveragelpTypes.Params{
-			LeverageMax:         msg.Params.LeverageMax.String(),
-			MaxOpenPositions:    msg.Params.MaxOpenPositions,
-			PoolOpenThreshold:   msg.Params.PoolOpenThreshold.String(),
-			SafetyFactor:        msg.Params.SafetyFactor.String(),
-			WhitelistingEnabled: msg.Params.WhitelistingEnabled,
-			EpochLength:         msg.Params.EpochLength,
-			FallbackEnabled:     msg.Params.FallbackEnabled,
-			NumberPerBlock:      msg.Params.NumberPerBlock,
-		},
+		Authority:      msg.Authority,
+		Params:         indexerParams(*msg.Params),
+		PreviousParams: indexerParams(previousParams),
 	}, []string{msg.Authority})
 	/* End of kwak-indexer node implementation*/
 	/* *************************************************************************** */
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+/* *************************************************************************** */
+/* Start of kwak-indexer node implementation*/
+func indexerParams(params types.Params) indexerLeveragelpTypes.Params {
+	return indexerLeveragelpTypes.Params{
+		LeverageMax:         params.LeverageMax.String(),
+		MaxOpenPositions:    params.MaxOpenPositions,
+		PoolOpenThreshold:   params.PoolOpenThreshold.String(),
+		SafetyFactor:        params.SafetyFactor.String(),
+		WhitelistingEnabled: params.WhitelistingEnabled,
+		EpochLength:         params.EpochLength,
+		FallbackEnabled:     params.FallbackEnabled,
+		NumberPerBlock:      params.NumberPerBlock,
+	}
+}
+
+/* End of kwak-indexer node implementation*/
+/* *************************************************************************** */
@@ -1,6 +1,16 @@
 package keeper
 
 import (
+	/* *************************************************************************** */
+	/* Start of kwak-indexer node implementation*/
+	indexer "github.com/elys-network/elys/indexer"
+	indexerAmmTypes "github.com/elys-network/elys/indexer/txs/amm"
+	indexerMasterchefTypes "github.com/elys-network/elys/indexer/txs/masterchef"
+	indexerTypes "github.com/elys-network/elys/indexer/types"
+
+	/* End of kwak-indexer node implementation*/
+	/* *************************************************************************** */
+
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -24,9 +34,78 @@ func (k Keeper) EndBlocker(ctx sdk.Context) error {
 	}
 	// distribute external rewards
 	k.ProcessExternalRewardsDistribution(ctx)
+
+	/* *************************************************************************** */
+	/* Start of kwak-indexer node implementation*/
+	if indexer.ShouldSnapshot(ctx.BlockHeight()) {
+		k.queuePoolSnapshots(ctx)
+	}
+	/* End of kwak-indexer node implementation*/
+	/* *************************************************************************** */
 	return nil
 }
 
+/* *************************************************************************** */
+/* Start of kwak-indexer node implementation*/
+// queuePoolSnapshots queues the reserves and TVL of every AMM pool to the indexer,
+// the stablestake pool is snapshotted by the stablestake module
+func (k Keeper) queuePoolSnapshots(ctx sdk.Context) {
+	for _, pool := range k.amm.GetAllPool(ctx) {
+		reserves := make([]indexerTypes.Token, len(pool.PoolAssets))
+		for i, asset := range pool.PoolAssets {
+			reserves[i] = indexerTypes.Token{
+				Amount: asset.Token.Amount.String(),
+				Denom:  asset.Token.Denom,
+			}
+		}
+
+		tvl := k.GetPoolTVL(ctx, pool.PoolId)
+		lpTokenPrice := math.LegacyOneDec()
+		if pool.TotalShares.Amount.IsPositive() {
+			lpTokenPrice = tvl.MulInt(ammtypes.OneShare).QuoInt(pool.TotalShares.Amount)
+		}
+
+		indexer.QueueEvent(ctx, indexerTypes.ElysEventTypes.Amm.PoolSnapshot, indexerAmmTypes.PoolSnapshot{
+			PoolID:   pool.PoolId,
+			Reserves: reserves,
+			TotalShares: indexerTypes.Token{
+				Amount: pool.TotalShares.Amount.String(),
+				Denom:  pool.TotalShares.Denom,
+			},
+			TVL:          tvl.String(),
+			LpTokenPrice: lpTokenPrice.String(),
+			UseOracle:    pool.PoolParams.UseOracle,
+			SwapFee:      pool.PoolParams.SwapFee.String(),
+		}, []string{})
+	}
+}
+
+// queueRevenue queues revenue collected from a source with the USDC portions
+// added to FeeInfo, nothing is queued when no revenue was collected
+func (k Keeper) queueRevenue(ctx sdk.Context, source string, poolId uint64, collected sdk.Coins, lps, stakers, protocol math.LegacyDec) {
+	if collected.IsZero() {
+		return
+	}
+	tokens := make([]indexerTypes.Token, len(collected))
+	for i, coin := range collected {
+		tokens[i] = indexerTypes.Token{
+			Amount: coin.Amount.String(),
+			Denom:  coin.Denom,
+		}
+	}
+	indexer.QueueEvent(ctx, indexerTypes.ElysEventTypes.Masterchef.RevenueCollected, indexerMasterchefTypes.RevenueCollectedEvent{
+		Source:    source,
+		PoolID:    poolId,
+		Collected: tokens,
+		Lps:       lps.TruncateInt().String(),
+		Stakers:   stakers.TruncateInt().String(),
+		Protocol:  protocol.TruncateInt().String(),
+	}, []string{})
+}
+
+/* End of kwak-indexer node implementation*/
+/* *************************************************************************** */
+
 func (k Keeper) GetPoolTVL(ctx sdk.Context, poolId uint64) math.LegacyDec {
 	if poolId == stabletypes.PoolId {
 		baseCurrency, found := k.assetProfileKeeper.GetUsdcDenom(ctx)
@@ -345,12 +424,37 @@ func (k Keeper) ConvertGasFeesToUsdc(ctx sdk.Context, baseCurrency string, addre
 		// Settles balances between the tx sender and the pool to match the swap that was executed earlier.
 		// Also emits a swap event and updates related liquidity metrics.
 		cacheCtx, write := ctx.CacheContext()
+
+		/* *************************************************************************** */
+		/* Start of kwak-indexer node implementation*/
+		// The trade of a failed fee conversion is not indexed
+		cacheCtx, write = indexer.DeferIndexing(cacheCtx, write)
+		/* End of kwak-indexer node implementation*/
+		/* *************************************************************************** */
+
 		_, err = k.amm.UpdatePoolForSwap(cacheCtx, pool, address, address, tokenIn, tokenOutCoin, math.LegacyZeroDec(), math.LegacyZeroDec(), math.LegacyZeroDec())
 		if err != nil {
 			continue
 		}
 		write()
 
+		/* *************************************************************************** */
+		/* Start of kwak-indexer node implementation*/
+		indexer.QueueEvent(ctx, indexerTypes.ElysEventTypes.Masterchef.FeeConversion, indexerMasterchefTypes.FeeConversionEvent{
+			Address: address.String(),
+			PoolID:  pool.PoolId,
+			TokenIn: indexerTypes.Token{
+				Amount: tokenIn.Amount.String(),
+				Denom:  tokenIn.Denom,
+			},
+			TokenOut: indexerTypes.Token{
+				Amount: tokenOutAmount.String(),
+				Denom:  baseCurrency,
+			},
+		}, []string{address.String()})
+		/* End of kwak-indexer node implementation*/
+		/* *************************************************************************** */
+
 		// Swapped USDC coin
 		swappedCoins := sdk.NewCoins(sdk.NewCoin(baseCurrency, tokenOutAmount))
 
@@ -398,6 +502,13 @@ func (k Keeper) CollectGasFees(ctx sdk.Context, baseCurrency string) (sdk.DecCoi
 			return sdk.DecCoins{}, err
 		}
 	}
+
+	/* *************************************************************************** */
+	/* Start of kwak-indexer node implementation*/
+	k.queueRevenue(ctx, indexerMasterchefTypes.RevenueSourceGas, 0, fees, gasFeesForLpsDec.AmountOf(baseCurrency), gasFeesForStakersDec.AmountOf(baseCurrency), gasFeesForProtocolDec.AmountOf(baseCurrency))
+	/* End of kwak-indexer node implementation*/
+	/* *************************************************************************** */
+
 	return gasFeesForLpsDec, nil
 }
 
@@ -442,6 +553,13 @@ func (k Keeper) CollectPerpRevenue(ctx sdk.Context, baseCurrency string) (sdk.De
 			return sdk.DecCoins{}, err
 		}
 	}
+
+	/* *************************************************************************** */
+	/* Start of kwak-indexer node implementation*/
+	k.queueRevenue(ctx, indexerMasterchefTypes.RevenueSourcePerp, 0, fees, perpFeesForLpsDec.AmountOf(baseCurrency), perpFeesForStakersDec.AmountOf(baseCurrency), perpFeesForProtocolDec.AmountOf(baseCurrency))
+	/* End of kwak-indexer node implementation*/
+	/* *************************************************************************** */
+
 	return perpFeesForLpsDec, nil
 }
 
@@ -511,6 +629,12 @@ func (k Keeper) CollectDEXRevenue(ctx sdk.Context) (sdk.Coins, sdk.DecCoins, map
 			}
 		}
 
+		/* *************************************************************************** */
+		/* Start of kwak-indexer node implementation*/
+		k.queueRevenue(ctx, indexerMasterchefTypes.RevenueSourceDex, poolId, revenue, revenuePortionForLPs.AmountOf(baseCurrency), revenuePortionForStakers.AmountOf(baseCurrency), revenuePortionForProtocol.AmountOf(baseCurrency))
+		/* End of kwak-indexer node implementation*/
+		/* *************************************************************************** */
+
 		// Store revenue portion for Lps temporarily
 		if found {
 			rewardsPerPool[poolId] = revenuePortionForLPs.AmountOf(baseCurrency)
@@ -3,7 +3,6 @@ package keeper
 import (
 	"context"
 	"fmt"
-	"sort"
 	"strconv"
 	"strings"
 
@@ -207,22 +206,6 @@ func (k Keeper) ClaimRewards(ctx sdk.Context, sender sdk.AccAddress, poolIds []u
 
 	/* *************************************************************************** */
 	/* Start of kwak-indexer node implementation*/
-	// Create unique event ID using:
-	// - Block height
-	// - Block time (unix nano)
-	// - Sender address
-	// - Pool IDs (sorted and joined)
-	// - Event Type
-	sort.Slice(poolIds, func(i, j int) bool { return poolIds[i] < poolIds[j] })
-	poolIDsStr := strings.Trim(strings.Join(strings.Fields(fmt.Sprint(poolIds)), "-"), "[]")
-	eventID := fmt.Sprintf("%d-%d-%s-%s-%s",
-		ctx.BlockHeight(),
-		ctx.BlockTime().UnixNano(),
-		sender.String(),
-		poolIDsStr,
-		indexerTypes.ElysEventTypes.Masterchef.ClaimRewards,
-	)
-
 	// Convert coins to indexer token type
 	rewardTokens := make([]indexerTypes.Token, len(coins))
 	for i, coin := range coins {
@@ -246,7 +229,7 @@ func (k Keeper) ClaimRewards(ctx sdk.Context, sender sdk.AccAddress, poolIds []u
 		Recipient:   recipient.String(),
 		PoolIDs:     poolIDsUint,
 		RewardCoins: rewardTokens,
-	}, []string{recipient.String()}, eventID)
+	}, []string{recipient.String()})
 	/* End of kwak-indexer node implementation*/
 	/* *************************************************************************** */
 
@@ -297,12 +280,32 @@ func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParam
 		return nil, fmt.Errorf("protocol revenue address is blocked")
 	}
 
+	/* *************************************************************************** */
+	/* Start of kwak-indexer node implementation*/
+	previousParams := k.GetParams(ctx)
+	/* End of kwak-indexer node implementation*/
+	/* *************************************************************************** */
+
 	k.SetParams(ctx, msg.Params)
 
 	/* *************************************************************************** */
 	/* Start of kwak-indexer node implementation*/
-	supportedRewardDenoms := make([]indexerMasterchefTypes.SupportedRewardDenom, len(msg.Params.SupportedRewardDenoms))
-	for i, denom := range msg.Params.SupportedRewardDenoms {
+	indexer.QueueTransaction(ctx, indexerMasterchefTypes.MsgUpdateParams{
+		Authority:      msg.Authority,
+		Params:         indexerParams(msg.Params),
+		PreviousParams: indexerParams(previousParams),
+	}, []string{msg.Authority})
+	/* End of kwak-indexer node implementation*/
+	/* *************************************************************************** */
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+/* *************************************************************************** */
+/* Start of kwak-indexer node implementation*/
+func indexerParams(params types.Params) indexerMasterchefTypes.Params {
+	supportedRewardDenoms := make([]indexerMasterchefTypes.SupportedRewardDenom, len(params.SupportedRewardDenoms))
+	for i, denom := range params.SupportedRewardDenoms {
 		supportedRewardDenoms[i] = indexerMasterchefTypes.SupportedRewardDenom{
 			Denom:     denom.Denom,
 			MinAmount: denom.MinAmount.String(),
@@ -310,30 +313,26 @@ func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParam
 	}
 
 	var lpIncentives *indexerMasterchefTypes.IncentiveInfo
-	if msg.Params.LpIncentives != nil {
+	if params.LpIncentives != nil {
 		lpIncentives = &indexerMasterchefTypes.IncentiveInfo{
-			EdenAmountPerYear: msg.Params.LpIncentives.EdenAmountPerYear.String(),
-			BlocksDistributed: msg.Params.LpIncentives.BlocksDistributed,
+			EdenAmountPerYear: params.LpIncentives.EdenAmountPerYear.String(),
+			BlocksDistributed: params.LpIncentives.BlocksDistributed,
 		}
 	}
 
-	indexer.QueueTransaction(ctx, indexerMasterchefTypes.MsgUpdateParams{
-		Authority: msg.Authority,
-		Params: indexerMasterchefTypes.Params{
-			LpIncentives:            lpIncentives,
-			RewardPortionForLps:     msg.Params.RewardPortionForLps.String(),
-			RewardPortionForStakers: msg.Params.RewardPortionForStakers.String(),
-			MaxEdenRewardAprLps:     msg.Params.MaxEdenRewardAprLps.String(),
-			SupportedRewardDenoms:   supportedRewardDenoms,
-			ProtocolRevenueAddress:  msg.Params.ProtocolRevenueAddress,
-		},
-	}, []string{msg.Authority})
-	/* End of kwak-indexer node implementation*/
-	/* *************************************************************************** */
-
-	return &types.MsgUpdateParamsResponse{}, nil
+	return indexerMasterchefTypes.Params{
+		LpIncentives:            lpIncentives,
+		RewardPortionForLps:     params.RewardPortionForLps.String(),
+		RewardPortionForStakers: params.RewardPortionForStakers.String(),
+		MaxEdenRewardAprLps:     params.MaxEdenRewardAprLps.String(),
+		SupportedRewardDenoms:   supportedRewardDenoms,
+		ProtocolRevenueAddress:  params.ProtocolRevenueAddress,
+	}
 }
 
+/* End of kwak-indexer node implementation*/
+/* *************************************************************************** */
+
 func (k msgServer) UpdatePoolMultipliers(goCtx context.Context, msg *types.MsgUpdatePoolMultipliers) (*types.MsgUpdatePoolMultipliersResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	if k.authority != msg.Authority {
@@ -1,10 +1,26 @@
 package keeper
 
 import (
+	/* *************************************************************************** */
+	/* Start of kwak-indexer node implementation*/
+	indexer "github.com/elys-network/elys/indexer"
+	indexerOracleTypes "github.com/elys-network/elys/indexer/txs/oracle"
+	indexerTypes "github.com/elys-network/elys/indexer/types"
+	/* End of kwak-indexer node implementation*/
+	/* *************************************************************************** */
+
+	storetypes "cosmossdk.io/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/elys-network/elys/x/oracle/types"
 )
 
 func (k Keeper) EndBlock(ctx sdk.Context) {
+	/* *************************************************************************** */
+	/* Start of kwak-indexer node implementation*/
+	var pruned []types.Price
+	/* End of kwak-indexer node implementation*/
+	/* *************************************************************************** */
+
 	// Remove outdated prices
 	params := k.GetParams(ctx)
 	for _, price := range k.GetAllPrice(ctx) {
@@ -15,5 +31,66 @@ func (k Keeper) EndBlock(ctx sdk.Context) {
 		if price.BlockHeight+params.LifeTimeInBlocks < uint64(ctx.BlockHeight()) {
 			k.RemovePrice(ctx, price.Asset, price.Source, price.Timestamp)
 		}
+
+		/* *************************************************************************** */
+		/* Start of kwak-indexer node implementation*/
+		if price.Timestamp+params.PriceExpiryTime < uint64(ctx.BlockTime().Unix()) ||
+			price.BlockHeight+params.LifeTimeInBlocks < uint64(ctx.BlockHeight()) {
+			pruned = append(pruned, price)
+		}
+		/* End of kwak-indexer node implementation*/
+		/* *************************************************************************** */
 	}
+
+	/* *************************************************************************** */
+	/* Start of kwak-indexer node implementation*/
+	k.queuePrunedPrices(ctx, pruned)
+	/* End of kwak-indexer node implementation*/
+	/* *************************************************************************** */
 }
+
+/* *************************************************************************** */
+/* Start of kwak-indexer node implementation*/
+// queuePrunedPrices indexes the latest pruned price of every asset and source
+// left without a price, the submission its feeder let go stale. Prices are
+// iterated by asset, source and timestamp, so the last one of a group is the
+// latest.
+func (k Keeper) queuePrunedPrices(ctx sdk.Context, pruned []types.Price) {
+	if !indexer.ShouldIndex(ctx) {
+		return
+	}
+
+	for i, price := range pruned {
+		if i+1 < len(pruned) && pruned[i+1].Asset == price.Asset && pruned[i+1].Source == price.Source {
+			continue
+		}
+		if _, found := k.GetLatestPriceFromAssetAndSource(ctx, price.Asset, price.Source); found {
+			continue
+		}
+		indexer.QueueEvent(ctx, indexerTypes.ElysEventTypes.Oracle.PricePruned, indexerOracleTypes.PricePrunedEvent{
+			Provider:    price.Provider,
+			Asset:       price.Asset,
+			Source:      price.Source,
+			Price:       price.Price.String(),
+			Timestamp:   price.Timestamp,
+			BlockHeight: price.BlockHeight,
+		}, []string{price.Provider})
+	}
+}
+
+// indexerAssetInfos returns the asset infos by display name, e.g. "ATOM", for
+// the indexer records of the price feeds. The store is read on an infinite gas
+// meter so that indexing nodes charge the same gas as the others.
+func (k Keeper) indexerAssetInfos(ctx sdk.Context) map[string]types.AssetInfo {
+	if !indexer.ShouldIndex(ctx) {
+		return nil
+	}
+	infos := make(map[string]types.AssetInfo)
+	for _, info := range k.GetAllAssetInfo(ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())) {
+		infos[info.Display] = info
+	}
+	return infos
+}
+
+/* End of kwak-indexer node implementation*/
+/* *************************************************************************** */
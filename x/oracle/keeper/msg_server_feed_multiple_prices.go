@@ -43,12 +43,15 @@ func (k msgServer) FeedMultiplePrices(goCtx context.Context, msg *types.MsgFeedM
 	/* *************************************************************************** */
 	/* Start of kwak-indexer node implementation*/
 	// Convert feed prices to indexer format
+	assetInfos := k.indexerAssetInfos(ctx)
 	indexerFeedPrices := make([]indexerOracleTypes.FeedPrice, len(msg.FeedPrices))
 	for i, fp := range msg.FeedPrices {
 		indexerFeedPrices[i] = indexerOracleTypes.FeedPrice{
-			Asset:  fp.Asset,
-			Price:  fp.Price.String(),
-			Source: fp.Source,
+			Asset:   fp.Asset,
+			Price:   fp.Price.String(),
+			Source:  fp.Source,
+			Denom:   assetInfos[fp.Asset].Denom,
+			Decimal: assetInfos[fp.Asset].Decimal,
 		}
 	}
 
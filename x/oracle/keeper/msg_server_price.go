@@ -41,6 +41,7 @@ func (k msgServer) FeedPrice(goCtx context.Context, msg *types.MsgFeedPrice) (*t
 
 	/* *************************************************************************** */
 	/* Start of kwak-indexer node implementation*/
+	assetInfo := k.indexerAssetInfos(ctx)[msg.FeedPrice.Asset]
 	indexer.QueueTransaction(ctx, indexerOracleTypes.MsgFeedPrice{
 		Provider:    msg.Provider,
 		Asset:       msg.FeedPrice.Asset,
@@ -48,6 +49,8 @@ func (k msgServer) FeedPrice(goCtx context.Context, msg *types.MsgFeedPrice) (*t
 		Source:      msg.FeedPrice.Source,
 		Timestamp:   uint64(ctx.BlockTime().Unix()),
 		BlockHeight: uint64(ctx.BlockHeight()),
+		Denom:       assetInfo.Denom,
+		Decimal:     assetInfo.Decimal,
 	}, []string{msg.Provider})
 	/* End of kwak-indexer node implementation*/
 	/* *************************************************************************** */
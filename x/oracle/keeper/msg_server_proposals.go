@@ -24,34 +24,20 @@ func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParam
 		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
 	}
 
+	/* *************************************************************************** */
+	/* Start of kwak-indexer node implementation*/
+	previousParams := k.GetParams(ctx)
+	/* End of kwak-indexer node implementation*/
+	/* *************************************************************************** */
+
 	k.Keeper.SetParams(ctx, msg.Params)
 
 	/* *************************************************************************** */
 	/* Start of kwak-indexer node implementation*/
-	feeLimit := make([]indexerTypes.Token, len(msg.Params.FeeLimit))
-	for i, coin := range msg.Params.FeeLimit {
-		feeLimit[i] = indexerTypes.Token{
-			Amount: coin.Amount.String(),
-			Denom:  coin.Denom,
-		}
-	}
-
 	indexer.QueueTransaction(ctx, indexerOracleTypes.MsgUpdateParams{
-		Authority: msg.Authority,
-		Params: indexerOracleTypes.Params{
-			BandChannelSource: msg.Params.BandChannelSource,
-			OracleScriptID:    msg.Params.OracleScriptID,
-			Multiplier:        msg.Params.Multiplier,
-			AskCount:          msg.Params.AskCount,
-			MinCount:          msg.Params.MinCount,
-			FeeLimit:          feeLimit,
-			PrepareGas:        msg.Params.PrepareGas,
-			ExecuteGas:        msg.Params.ExecuteGas,
-			ClientID:          msg.Params.ClientID,
-			BandEpoch:         msg.Params.BandEpoch,
-			PriceExpiryTime:   msg.Params.PriceExpiryTime,
-			LifeTimeInBlocks:  msg.Params.LifeTimeInBlocks,
-		},
+		Authority:      msg.Authority,
+		Params:         indexerParams(msg.Params),
+		PreviousParams: indexerParams(previousParams),
 	}, []string{msg.Authority})
 	/* End of kwak-indexer node implementation*/
 	/* *************************************************************************** */
@@ -59,6 +45,36 @@ func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParam
 	return &types.MsgUpdateParamsResponse{}, nil
 }
 
+/* *************************************************************************** */
+/* Start of kwak-indexer node implementation*/
+func indexerParams(params types.Params) indexerOracleTypes.Params {
+	feeLimit := make([]indexerTypes.Token, len(params.FeeLimit))
+	for i, coin := range params.FeeLimit {
+		feeLimit[i] = indexerTypes.Token{
+			Amount: coin.Amount.String(),
+			Denom:  coin.Denom,
+		}
+	}
+
+	return indexerOracleTypes.Params{
+		BandChannelSource: params.BandChannelSource,
+		OracleScriptID:    params.OracleScriptID,
+		Multiplier:        params.Multiplier,
+		AskCount:          params.AskCount,
+		MinCount:          params.MinCount,
+		FeeLimit:          feeLimit,
+		PrepareGas:        params.PrepareGas,
+		ExecuteGas:        params.ExecuteGas,
+		ClientID:          params.ClientID,
+		BandEpoch:         params.BandEpoch,
+		PriceExpiryTime:   params.PriceExpiryTime,
+		LifeTimeInBlocks:  params.LifeTimeInBlocks,
+	}
+}
+
+/* End of kwak-indexer node implementation*/
+/* *************************************************************************** */
+
 func (k msgServer) RemoveAssetInfo(goCtx context.Context, msg *types.MsgRemoveAssetInfo) (*types.MsgRemoveAssetInfoResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	if k.authority != msg.Authority {
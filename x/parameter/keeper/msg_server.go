@@ -34,14 +34,20 @@ func (k msgServer) UpdateMinCommission(goCtx context.Context, msg *types.MsgUpda
 	}
 
 	params := k.GetParams(ctx)
+	/* *************************************************************************** */
+	/* Start of kwak-indexer node implementation*/
+	previousMinCommission := params.MinCommissionRate.String()
+	/* End of kwak-indexer node implementation*/
+	/* *************************************************************************** */
 	params.MinCommissionRate = msg.MinCommission
 	k.SetParams(ctx, params)
 
 	/* *************************************************************************** */
 	/* Start of kwak-indexer node implementation*/
 	indexer.QueueTransaction(ctx, indexerParamTypes.MsgUpdateMinCommission{
-		Creator:       msg.Creator,
-		MinCommission: msg.MinCommission.String(),
+		Creator:               msg.Creator,
+		MinCommission:         msg.MinCommission.String(),
+		PreviousMinCommission: previousMinCommission,
 	}, []string{msg.Creator})
 	/* End of kwak-indexer node implementation*/
 	/* *************************************************************************** */
@@ -57,14 +63,20 @@ func (k msgServer) UpdateMaxVotingPower(goCtx context.Context, msg *types.MsgUpd
 	}
 
 	params := k.GetParams(ctx)
+	/* *************************************************************************** */
+	/* Start of kwak-indexer node implementation*/
+	previousMaxVotingPower := params.MaxVotingPower.String()
+	/* End of kwak-indexer node implementation*/
+	/* *************************************************************************** */
 	params.MaxVotingPower = msg.MaxVotingPower
 	k.SetParams(ctx, params)
 
 	/* *************************************************************************** */
 	/* Start of kwak-indexer node implementation*/
 	indexer.QueueTransaction(ctx, indexerParamTypes.MsgUpdateMaxVotingPower{
-		Creator:        msg.Creator,
-		MaxVotingPower: msg.MaxVotingPower.String(),
+		Creator:                msg.Creator,
+		MaxVotingPower:         msg.MaxVotingPower.String(),
+		PreviousMaxVotingPower: previousMaxVotingPower,
 	}, []string{msg.Creator})
 	/* End of kwak-indexer node implementation*/
 	/* *************************************************************************** */
@@ -80,14 +92,20 @@ func (k msgServer) UpdateMinSelfDelegation(goCtx context.Context, msg *types.Msg
 	}
 
 	params := k.GetParams(ctx)
+	/* *************************************************************************** */
+	/* Start of kwak-indexer node implementation*/
+	previousMinSelfDelegation := params.MinSelfDelegation.String()
+	/* End of kwak-indexer node implementation*/
+	/* *************************************************************************** */
 	params.MinSelfDelegation = msg.MinSelfDelegation
 	k.SetParams(ctx, params)
 
 	/* *************************************************************************** */
 	/* Start of kwak-indexer node implementation*/
 	indexer.QueueTransaction(ctx, indexerParamTypes.MsgUpdateMinSelfDelegation{
-		Creator:           msg.Creator,
-		MinSelfDelegation: msg.MinSelfDelegation.String(),
+		Creator:                   msg.Creator,
+		MinSelfDelegation:         msg.MinSelfDelegation.String(),
+		PreviousMinSelfDelegation: previousMinSelfDelegation,
 	}, []string{msg.Creator})
 	/* End of kwak-indexer node implementation*/
 	/* *************************************************************************** */
@@ -103,14 +121,20 @@ func (k msgServer) UpdateTotalBlocksPerYear(goCtx context.Context, msg *types.Ms
 	}
 
 	params := k.GetParams(ctx)
+	/* *************************************************************************** */
+	/* Start of kwak-indexer node implementation*/
+	previousTotalBlocksPerYear := params.TotalBlocksPerYear
+	/* End of kwak-indexer node implementation*/
+	/* *************************************************************************** */
 	params.TotalBlocksPerYear = msg.TotalBlocksPerYear
 	k.SetParams(ctx, params)
 
 	/* *************************************************************************** */
 	/* Start of kwak-indexer node implementation*/
 	indexer.QueueTransaction(ctx, indexerParamTypes.MsgUpdateTotalBlocksPerYear{
-		Creator:            msg.Creator,
-		TotalBlocksPerYear: msg.TotalBlocksPerYear,
+		Creator:                    msg.Creator,
+		TotalBlocksPerYear:         msg.TotalBlocksPerYear,
+		PreviousTotalBlocksPerYear: previousTotalBlocksPerYear,
 	}, []string{msg.Creator})
 	/* End of kwak-indexer node implementation*/
 	/* *************************************************************************** */
@@ -24,14 +24,20 @@ func (k msgServer) UpdateRewardsDataLifetime(goCtx context.Context, msg *types.M
 	}
 
 	params := k.GetParams(ctx)
+	/* *************************************************************************** */
+	/* Start of kwak-indexer node implementation*/
+	previousRewardsDataLifetime := params.RewardsDataLifetime
+	/* End of kwak-indexer node implementation*/
+	/* *************************************************************************** */
 	params.RewardsDataLifetime = msg.RewardsDataLifetime
 	k.SetParams(ctx, params)
 
 	/* *************************************************************************** */
 	/* Start of kwak-indexer node implementation*/
 	indexer.QueueTransaction(ctx, indexerParamTypes.MsgUpdateRewardsDataLifetime{
-		Creator:             msg.Creator,
-		RewardsDataLifetime: msg.RewardsDataLifetime,
+		Creator:                     msg.Creator,
+		RewardsDataLifetime:         msg.RewardsDataLifetime,
+		PreviousRewardsDataLifetime: previousRewardsDataLifetime,
 	}, []string{msg.Creator})
 	/* End of kwak-indexer node implementation*/
 	/* *************************************************************************** */
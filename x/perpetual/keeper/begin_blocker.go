@@ -1,6 +1,15 @@
 package keeper
 
 import (
+	/* *************************************************************************** */
+	/* Start of kwak-indexer node implementation*/
+	indexer "github.com/elys-network/elys/indexer"
+	indexerPerpetualTypes "github.com/elys-network/elys/indexer/txs/perpetual"
+	indexerTypes "github.com/elys-network/elys/indexer/types"
+
+	/* End of kwak-indexer node implementation*/
+	/* *************************************************************************** */
+
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/elys-network/elys/x/perpetual/types"
@@ -53,6 +62,25 @@ func (k Keeper) BeginBlocker(ctx sdk.Context) {
 			FundingAmountLong:  fundingAmountLong,
 		})
 		k.SetPool(ctx, pool)
+
+		/* *************************************************************************** */
+		/* Start of kwak-indexer node implementation*/
+		if indexer.ShouldSnapshot(currentHeight) {
+			indexer.QueueEvent(ctx, indexerTypes.ElysEventTypes.Perpetual.PoolSnapshot, indexerPerpetualTypes.PoolSnapshot{
+				PoolID:                 pool.AmmPoolId,
+				Health:                 pool.Health.String(),
+				TotalLongOpenInterest:  totalLongOpenInterest.String(),
+				TotalShortOpenInterest: totalShortOpenInterest.String(),
+				BorrowInterestRate:     rate.String(),
+				FundingRate:            pool.FundingRate.String(),
+				FundingRateLong:        fundingRateLong.String(),
+				FundingRateShort:       fundingRateShort.String(),
+				FundingAmountLong:      fundingAmountLong.String(),
+				FundingAmountShort:     fundingAmountShort.String(),
+			}, []string{})
+		}
+		/* End of kwak-indexer node implementation*/
+		/* *************************************************************************** */
 	}
 }
 
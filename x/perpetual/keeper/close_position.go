@@ -23,6 +23,7 @@ func (k Keeper) ClosePosition(ctx sdk.Context, msg *types.MsgClose, baseCurrency
 	/* *************************************************************************** */
 	/* Start of kwak-indexer node implementation*/
 	var initialCollateral math.LegacyDec
+	var balanceBefore math.Int
 	/* End of kwak-indexer node implementation*/
 	/* *************************************************************************** */
 
@@ -36,6 +37,7 @@ func (k Keeper) ClosePosition(ctx sdk.Context, msg *types.MsgClose, baseCurrency
 	/* *************************************************************************** */
 	/* Start of kwak-indexer node implementation*/
 	initialCollateral = math.LegacyNewDecFromInt(mtp.Collateral)
+	balanceBefore = k.custodyBalance(ctx, &mtp)
 	/* End of kwak-indexer node implementation*/
 	/* *************************************************************************** */
 
@@ -119,6 +121,9 @@ func (k Keeper) ClosePosition(ctx sdk.Context, msg *types.MsgClose, baseCurrency
 		LiabilitiesAsset: mtp.LiabilitiesAsset,
 		MtpHealth:        mtp.MtpHealth.String(),
 		OpenPrice:        mtp.OpenPrice.String(),
+		ClosingRatio:     closingRatio.String(),
+		Closed:           !mtp.Custody.IsPositive(),
+		ReturnedAmount:   k.returnedAmount(ctx, &mtp, balanceBefore),
 	}, []string{msg.Creator})
 	/* End of kwak-indexer node implementation*/
 	/* *************************************************************************** */
@@ -26,6 +26,12 @@ func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParam
 		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
 	}
 
+	/* *************************************************************************** */
+	/* Start of kwak-indexer node implementation*/
+	previousParams := k.GetParams(ctx)
+	/* End of kwak-indexer node implementation*/
+	/* *************************************************************************** */
+
 	// store params
 	if err := k.SetParams(ctx, msg.Params); err != nil {
 		return nil, err
@@ -50,35 +56,45 @@ func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParam
 	/* Start of kwak-indexer node implementation*/
 	// Queue the params update transaction
 	indexer.QueueTransaction(ctx, indexerPerpetualTypes.MsgUpdateParams{
-		Authority: msg.Authority,
-		Params: indexerPerpetualTypes.Params{
-			LeverageMax:                                    msg.Params.LeverageMax.String(),
-			BorrowInterestRateMax:                          msg.Params.BorrowInterestRateMax.String(),
-			BorrowInterestRateMin:                          msg.Params.BorrowInterestRateMin.String(),
-			BorrowInterestRateIncrease:                     msg.Params.BorrowInterestRateIncrease.String(),
-			BorrowInterestRateDecrease:                     msg.Params.BorrowInterestRateDecrease.String(),
-			HealthGainFactor:                               msg.Params.HealthGainFactor.String(),
-			MaxOpenPositions:                               msg.Params.MaxOpenPositions,
-			PoolOpenThreshold:                              msg.Params.PoolOpenThreshold.String(),
-			ForceCloseFundPercentage:                       msg.Params.ForceCloseFundPercentage.String(),
-			ForceCloseFundAddress:                          msg.Params.ForceCloseFundAddress,
-			IncrementalBorrowInterestPaymentFundPercentage: msg.Params.IncrementalBorrowInterestPaymentFundPercentage.String(),
-			IncrementalBorrowInterestPaymentFundAddress:    msg.Params.IncrementalBorrowInterestPaymentFundAddress,
-			SafetyFactor:                                   msg.Params.SafetyFactor.String(),
-			IncrementalBorrowInterestPaymentEnabled:        msg.Params.IncrementalBorrowInterestPaymentEnabled,
-			WhitelistingEnabled:                            msg.Params.WhitelistingEnabled,
-			PerpetualSwapFee:                               msg.Params.PerpetualSwapFee.String(),
-			MaxLimitOrder:                                  msg.Params.MaxLimitOrder,
-			FixedFundingRate:                               msg.Params.FixedFundingRate.String(),
-			MinimumLongTakeProfitPriceRatio:                msg.Params.MinimumLongTakeProfitPriceRatio.String(),
-			MaximumLongTakeProfitPriceRatio:                msg.Params.MaximumLongTakeProfitPriceRatio.String(),
-			MaximumShortTakeProfitPriceRatio:               msg.Params.MaximumShortTakeProfitPriceRatio.String(),
-			EnableTakeProfitCustodyLiabilities:             msg.Params.EnableTakeProfitCustodyLiabilities,
-			WeightBreakingFeeFactor:                        msg.Params.WeightBreakingFeeFactor.String(),
-		},
+		Authority:      msg.Authority,
+		Params:         indexerParams(*msg.Params),
+		PreviousParams: indexerParams(previousParams),
 	}, []string{msg.Authority})
 	/* End of kwak-indexer node implementation*/
 	/* *************************************************************************** */
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+/* *************************************************************************** */
+/* Start of kwak-indexer node implementation*/
+func indexerParams(params types.Params) indexerPerpetualTypes.Params {
+	return indexerPerpetualTypes.Params{
+		LeverageMax:                                    params.LeverageMax.String(),
+		BorrowInterestRateMax:                          params.BorrowInterestRateMax.String(),
+		BorrowInterestRateMin:                          params.BorrowInterestRateMin.String(),
+		BorrowInterestRateIncrease:                     params.BorrowInterestRateIncrease.String(),
+		BorrowInterestRateDecrease:                     params.BorrowInterestRateDecrease.String(),
+		HealthGainFactor:                               params.HealthGainFactor.String(),
+		MaxOpenPositions:                               params.MaxOpenPositions,
+		PoolOpenThreshold:                              params.PoolOpenThreshold.String(),
+		ForceCloseFundPercentage:                       params.ForceCloseFundPercentage.String(),
+		ForceCloseFundAddress:                          params.ForceCloseFundAddress,
+		IncrementalBorrowInterestPaymentFundPercentage: params.IncrementalBorrowInterestPaymentFundPercentage.String(),
+		IncrementalBorrowInterestPaymentFundAddress:    params.IncrementalBorrowInterestPaymentFundAddress,
+		SafetyFactor:                                   params.SafetyFactor.String(),
+		IncrementalBorrowInterestPaymentEnabled:        params.IncrementalBorrowInterestPaymentEnabled,
+		WhitelistingEnabled:                            params.WhitelistingEnabled,
+		PerpetualSwapFee:                               params.PerpetualSwapFee.String(),
+		MaxLimitOrder:                                  params.MaxLimitOrder,
+		FixedFundingRate:                               params.FixedFundingRate.String(),
+		MinimumLongTakeProfitPriceRatio:                params.MinimumLongTakeProfitPriceRatio.String(),
+		MaximumLongTakeProfitPriceRatio:                params.MaximumLongTakeProfitPriceRatio.String(),
+		MaximumShortTakeProfitPriceRatio:               params.MaximumShortTakeProfitPriceRatio.String(),
+		EnableTakeProfitCustodyLiabilities:             params.EnableTakeProfitCustodyLiabilities,
+		WeightBreakingFeeFactor:                        params.WeightBreakingFeeFactor.String(),
+	}
+}
+
+/* End of kwak-indexer node implementation*/
+/* *************************************************************************** */
@@ -1,6 +1,15 @@
 package keeper
 
 import (
+	/* *************************************************************************** */
+	/* Start of kwak-indexer node implementation*/
+	indexer "github.com/elys-network/elys/indexer"
+	indexerPerpetualTypes "github.com/elys-network/elys/indexer/txs/perpetual"
+	indexerTypes "github.com/elys-network/elys/indexer/types"
+
+	/* End of kwak-indexer node implementation*/
+	/* *************************************************************************** */
+
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	ammtypes "github.com/elys-network/elys/x/amm/types"
@@ -94,6 +103,22 @@ func (k Keeper) SettleMTPBorrowInterestUnpaidLiability(ctx sdk.Context, mtp *typ
 		return math.ZeroInt(), err
 	}
 
+	/* *************************************************************************** */
+	/* Start of kwak-indexer node implementation*/
+	if indexer.ShouldIndex(ctx) && !borrowInterestPaymentInCustody.IsZero() {
+		eventType := indexerTypes.ElysEventTypes.Perpetual.InterestSettlement
+		indexer.QueueEvent(ctx, eventType, indexerPerpetualTypes.InterestSettlement{
+			Address:  mtp.Address,
+			ID:       mtp.Id,
+			PoolID:   mtp.AmmPoolId,
+			Position: mtp.Position.String(),
+			Paid:     indexerTypes.Token{Amount: borrowInterestPaymentInCustody.String(), Denom: mtp.CustodyAsset},
+			Unpaid:   indexerTypes.Token{Amount: mtp.BorrowInterestUnpaidLiability.String(), Denom: mtp.LiabilitiesAsset},
+		}, []string{mtp.Address})
+	}
+	/* End of kwak-indexer node implementation*/
+	/* *************************************************************************** */
+
 	return actualBorrowInterestPaymentCustody, nil
 
 }
@@ -5,6 +5,16 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	/* *************************************************************************** */
+	/* Start of kwak-indexer node implementation*/
+	indexer "github.com/elys-network/elys/indexer"
+	indexerPerpetualTypes "github.com/elys-network/elys/indexer/txs/perpetual"
+	indexerTypes "github.com/elys-network/elys/indexer/types"
+
+	/* End of kwak-indexer node implementation*/
+	/* *************************************************************************** */
+
 	"github.com/elys-network/elys/x/perpetual/types"
 )
 
@@ -69,6 +79,31 @@ func (k Keeper) OpenConsolidate(ctx sdk.Context, existingMtp *types.MTP, newMtp
 
 	k.EmitOpenEvent(ctx, existingMtp)
 
+	/* *************************************************************************** */
+	/* Start of kwak-indexer node implementation*/
+	if indexer.ShouldIndex(ctx) {
+		eventType := indexerTypes.ElysEventTypes.Perpetual.Consolidate
+		indexer.QueueEvent(ctx, eventType, indexerPerpetualTypes.Consolidate{
+			Address:          existingMtp.Address,
+			ID:               existingMtp.Id,
+			MergedID:         newMtp.Id,
+			PoolID:           existingMtp.AmmPoolId,
+			Position:         existingMtp.Position.String(),
+			AddedCollateral:  indexerTypes.Token{Amount: newMtp.Collateral.String(), Denom: newMtp.CollateralAsset},
+			AddedCustody:     indexerTypes.Token{Amount: newMtp.Custody.String(), Denom: newMtp.CustodyAsset},
+			AddedLiabilities: indexerTypes.Token{Amount: newMtp.Liabilities.String(), Denom: newMtp.LiabilitiesAsset},
+			Collateral:       indexerTypes.Token{Amount: existingMtp.Collateral.String(), Denom: existingMtp.CollateralAsset},
+			Custody:          indexerTypes.Token{Amount: existingMtp.Custody.String(), Denom: existingMtp.CustodyAsset},
+			Liabilities:      indexerTypes.Token{Amount: existingMtp.Liabilities.String(), Denom: existingMtp.LiabilitiesAsset},
+			OpenPrice:        existingMtp.OpenPrice.String(),
+			TakeProfitPrice:  existingMtp.TakeProfitPrice.String(),
+			StopLossPrice:    existingMtp.StopLossPrice.String(),
+			Health:           existingMtp.MtpHealth.String(),
+		}, []string{existingMtp.Address})
+	}
+	/* End of kwak-indexer node implementation*/
+	/* *************************************************************************** */
+
 	creator := sdk.MustAccAddressFromBech32(msg.Creator)
 	if k.hooks != nil {
 		params := k.GetParams(ctx)
@@ -6,6 +6,15 @@ import (
 	"cosmossdk.io/errors"
 	"cosmossdk.io/math"
 
+	/* *************************************************************************** */
+	/* Start of kwak-indexer node implementation*/
+	indexer "github.com/elys-network/elys/indexer"
+	indexerPerpetualTypes "github.com/elys-network/elys/indexer/txs/perpetual"
+	indexerTypes "github.com/elys-network/elys/indexer/types"
+
+	/* End of kwak-indexer node implementation*/
+	/* *************************************************************************** */
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	ammtypes "github.com/elys-network/elys/x/amm/types"
@@ -55,6 +64,13 @@ func (k Keeper) CheckAndLiquidateUnhealthyPosition(ctx sdk.Context, mtp *types.M
 	safetyFactor := k.GetSafetyFactor(ctx)
 
 	if mtp.MtpHealth.LTE(safetyFactor) {
+		/* *************************************************************************** */
+		/* Start of kwak-indexer node implementation*/
+		initialCollateral := mtp.Collateral
+		balanceBefore := k.custodyBalance(ctx, mtp)
+		/* End of kwak-indexer node implementation*/
+		/* *************************************************************************** */
+
 		var repayAmount math.Int
 		switch mtp.Position {
 		case types.Position_LONG:
@@ -68,6 +84,11 @@ func (k Keeper) CheckAndLiquidateUnhealthyPosition(ctx sdk.Context, mtp *types.M
 		if err == nil {
 			// Emit event if position was closed
 			k.EmitForceClose(ctx, types.EventForceCloseUnhealthy, mtp, repayAmount, "")
+			/* *************************************************************************** */
+			/* Start of kwak-indexer node implementation*/
+			k.queueForceCloseEvent(ctx, indexerTypes.ElysEventTypes.Perpetual.Liquidation, mtp, initialCollateral, repayAmount, balanceBefore)
+			/* End of kwak-indexer node implementation*/
+			/* *************************************************************************** */
 		} else {
 			return errors.Wrap(err, "error executing force close")
 		}
@@ -104,6 +125,13 @@ func (k Keeper) CheckAndCloseAtStopLoss(ctx sdk.Context, mtp *types.MTP, pool ty
 		}
 	}
 
+	/* *************************************************************************** */
+	/* Start of kwak-indexer node implementation*/
+	initialCollateral := mtp.Collateral
+	balanceBefore := k.custodyBalance(ctx, mtp)
+	/* End of kwak-indexer node implementation*/
+	/* *************************************************************************** */
+
 	var repayAmount math.Int
 	switch mtp.Position {
 	case types.Position_LONG:
@@ -117,6 +145,11 @@ func (k Keeper) CheckAndCloseAtStopLoss(ctx sdk.Context, mtp *types.MTP, pool ty
 	if err == nil {
 		// Emit event if position was closed
 		k.EmitForceClose(ctx, types.EventForceCloseStopLoss, mtp, repayAmount, "")
+		/* *************************************************************************** */
+		/* Start of kwak-indexer node implementation*/
+		k.queueForceCloseEvent(ctx, indexerTypes.ElysEventTypes.Perpetual.StopLoss, mtp, initialCollateral, repayAmount, balanceBefore)
+		/* End of kwak-indexer node implementation*/
+		/* *************************************************************************** */
 	} else {
 		return errors.Wrap(err, "error executing force close")
 	}
@@ -148,6 +181,13 @@ func (k Keeper) CheckAndCloseAtTakeProfit(ctx sdk.Context, mtp *types.MTP, pool
 		}
 	}
 
+	/* *************************************************************************** */
+	/* Start of kwak-indexer node implementation*/
+	initialCollateral := mtp.Collateral
+	balanceBefore := k.custodyBalance(ctx, mtp)
+	/* End of kwak-indexer node implementation*/
+	/* *************************************************************************** */
+
 	var repayAmount math.Int
 	switch mtp.Position {
 	case types.Position_LONG:
@@ -161,9 +201,107 @@ func (k Keeper) CheckAndCloseAtTakeProfit(ctx sdk.Context, mtp *types.MTP, pool
 	if err == nil {
 		// Emit event if position was closed
 		k.EmitForceClose(ctx, types.EventForceCloseTakeprofit, mtp, repayAmount, "")
+		/* *************************************************************************** */
+		/* Start of kwak-indexer node implementation*/
+		k.queueForceCloseEvent(ctx, indexerTypes.ElysEventTypes.Perpetual.TakeProfit, mtp, initialCollateral, repayAmount, balanceBefore)
+		/* End of kwak-indexer node implementation*/
+		/* *************************************************************************** */
 	} else {
 		return errors.Wrap(err, "error executing force close")
 	}
 
 	return nil
 }
+
+/* *************************************************************************** */
+/* Start of kwak-indexer node implementation*/
+// queueForceCloseEvent indexes a position closed by a liquidation, stop loss or
+// take profit. initialCollateral is the collateral and balanceBefore the custody
+// asset balance of the owner before the position was closed.
+func (k Keeper) queueForceCloseEvent(ctx sdk.Context, eventType string, mtp *types.MTP, initialCollateral, repayAmount, balanceBefore math.Int) {
+	if !indexer.ShouldIndex(ctx) {
+		return
+	}
+
+	initialValue := initialCollateral.ToLegacyDec()
+	finalValue := repayAmount.ToLegacyDec().Sub(mtp.Liabilities.ToLegacyDec())
+	profitLoss, profitLossPerc := calculateProfitLoss(initialValue, finalValue)
+
+	collateral := indexerTypes.Token{Amount: initialCollateral.String(), Denom: mtp.CollateralAsset}
+	custody := indexerTypes.Token{Amount: mtp.Custody.String(), Denom: mtp.CustodyAsset}
+	liabilities := indexerTypes.Token{Amount: mtp.Liabilities.String(), Denom: mtp.LiabilitiesAsset}
+	returned := k.returnedAmount(ctx, mtp, balanceBefore)
+
+	var proc indexerTypes.EventProcessor
+	switch eventType {
+	case indexerTypes.ElysEventTypes.Perpetual.StopLoss:
+		proc = indexerPerpetualTypes.StopLossEvent{
+			Address:        mtp.Address,
+			ID:             mtp.Id,
+			Position:       mtp.Position.String(),
+			Collateral:     collateral,
+			Custody:        custody,
+			Liabilities:    liabilities,
+			StopLossPrice:  mtp.StopLossPrice.String(),
+			OpenPrice:      mtp.OpenPrice.String(),
+			Health:         mtp.MtpHealth.String(),
+			ProfitLoss:     profitLoss.String(),
+			ProfitLossPerc: profitLossPerc.String(),
+			ReturnedAmount: returned,
+		}
+	case indexerTypes.ElysEventTypes.Perpetual.TakeProfit:
+		proc = indexerPerpetualTypes.TakeProfitEvent{
+			Address:                mtp.Address,
+			ID:                     mtp.Id,
+			Position:               mtp.Position.String(),
+			Collateral:             collateral,
+			Custody:                custody,
+			Liabilities:            liabilities,
+			TakeProfitPrice:        mtp.TakeProfitPrice.String(),
+			TakeProfitLiabilities:  mtp.TakeProfitLiabilities.String(),
+			TakeProfitCustody:      mtp.TakeProfitCustody.String(),
+			TakeProfitBorrowFactor: mtp.TakeProfitBorrowFactor.String(),
+			OpenPrice:              mtp.OpenPrice.String(),
+			Health:                 mtp.MtpHealth.String(),
+			ProfitLoss:             profitLoss.String(),
+			ProfitLossPerc:         profitLossPerc.String(),
+			ReturnedAmount:         returned,
+		}
+	default:
+		proc = indexerPerpetualTypes.LiquidationEvent{
+			Address:        mtp.Address,
+			ID:             mtp.Id,
+			Collateral:     collateral,
+			Custody:        custody,
+			Liabilities:    liabilities,
+			Health:         mtp.MtpHealth.String(),
+			InitialValue:   initialValue.String(),
+			FinalValue:     finalValue.String(),
+			ProfitLoss:     profitLoss.String(),
+			ProfitLossPerc: profitLossPerc.String(),
+			OpenPrice:      mtp.OpenPrice.String(),
+			Position:       mtp.Position.String(),
+			ReturnedAmount: returned,
+		}
+	}
+
+	indexer.QueueEvent(ctx, eventType, proc, []string{mtp.Address})
+}
+
+// custodyBalance returns the owner balance of the custody asset, which is the
+// asset returned to the owner when a position is closed
+func (k Keeper) custodyBalance(ctx sdk.Context, mtp *types.MTP) math.Int {
+	return k.bankKeeper.GetBalance(ctx, mtp.GetAccountAddress(), mtp.CustodyAsset).Amount
+}
+
+// returnedAmount is the custody asset received by the owner since balanceBefore
+func (k Keeper) returnedAmount(ctx sdk.Context, mtp *types.MTP, balanceBefore math.Int) indexerTypes.Token {
+	returned := k.custodyBalance(ctx, mtp).Sub(balanceBefore)
+	if returned.IsNegative() {
+		returned = math.ZeroInt()
+	}
+	return indexerTypes.Token{Amount: returned.String(), Denom: mtp.CustodyAsset}
+}
+
+/* End of kwak-indexer node implementation*/
+/* *************************************************************************** */
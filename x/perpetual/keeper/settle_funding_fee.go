@@ -1,6 +1,15 @@
 package keeper
 
 import (
+	/* *************************************************************************** */
+	/* Start of kwak-indexer node implementation*/
+	indexer "github.com/elys-network/elys/indexer"
+	indexerPerpetualTypes "github.com/elys-network/elys/indexer/txs/perpetual"
+	indexerTypes "github.com/elys-network/elys/indexer/types"
+
+	/* End of kwak-indexer node implementation*/
+	/* *************************************************************************** */
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	ammtypes "github.com/elys-network/elys/x/amm/types"
 	"github.com/elys-network/elys/x/perpetual/types"
@@ -26,6 +35,11 @@ func (k Keeper) UpdateFundingFee(ctx sdk.Context, mtp *types.MTP, pool *types.Po
 
 // SettleFunding handles funding fee collection and distribution
 func (k Keeper) SettleFunding(ctx sdk.Context, mtp *types.MTP, pool *types.Pool, ammPool ammtypes.Pool) error {
+	/* *************************************************************************** */
+	/* Start of kwak-indexer node implementation*/
+	paidBefore, receivedBefore := mtp.FundingFeePaidCustody, mtp.FundingFeeReceivedCustody
+	/* End of kwak-indexer node implementation*/
+	/* *************************************************************************** */
 
 	err := k.UpdateFundingFee(ctx, mtp, pool, ammPool)
 	if err != nil {
@@ -41,5 +55,23 @@ func (k Keeper) SettleFunding(ctx sdk.Context, mtp *types.MTP, pool *types.Pool,
 	// apply changes to pool object
 	k.SetPool(ctx, *pool)
 
+	/* *************************************************************************** */
+	/* Start of kwak-indexer node implementation*/
+	paid := mtp.FundingFeePaidCustody.Sub(paidBefore)
+	received := mtp.FundingFeeReceivedCustody.Sub(receivedBefore)
+	if indexer.ShouldIndex(ctx) && (!paid.IsZero() || !received.IsZero()) {
+		eventType := indexerTypes.ElysEventTypes.Perpetual.FundingSettlement
+		indexer.QueueEvent(ctx, eventType, indexerPerpetualTypes.FundingSettlement{
+			Address:  mtp.Address,
+			ID:       mtp.Id,
+			PoolID:   mtp.AmmPoolId,
+			Position: mtp.Position.String(),
+			Paid:     indexerTypes.Token{Amount: paid.String(), Denom: mtp.CustodyAsset},
+			Received: indexerTypes.Token{Amount: received.String(), Denom: mtp.CustodyAsset},
+		}, []string{mtp.Address})
+	}
+	/* End of kwak-indexer node implementation*/
+	/* *************************************************************************** */
+
 	return nil
 }
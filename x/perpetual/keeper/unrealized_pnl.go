@@ -0,0 +1,57 @@
+package keeper
+
+/* *************************************************************************** */
+/* Start of kwak-indexer node implementation*/
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	indexer "github.com/elys-network/elys/indexer"
+	indexerTypes "github.com/elys-network/elys/indexer/types"
+	ptypes "github.com/elys-network/elys/x/parameter/types"
+)
+
+// GetUnrealizedPnL returns the estimated PnL of every open MTP of an address
+// at the latest oracle price, with interest and funding accrued up to ctx
+func (k Keeper) GetUnrealizedPnL(ctx sdk.Context, address string) ([]indexer.UnrealizedPnL, error) {
+	owner, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return nil, err
+	}
+
+	entry, found := k.assetProfileKeeper.GetEntry(ctx, ptypes.BaseCurrency)
+	if !found {
+		return nil, fmt.Errorf("base currency not found")
+	}
+
+	result := make([]indexer.UnrealizedPnL, 0)
+	for _, mtp := range k.GetAllMTPsForAddress(ctx, owner) {
+		mtpAndPrice, err := k.fillMTPData(ctx, *mtp, entry.Denom)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, indexer.UnrealizedPnL{
+			Module:            "perpetual",
+			Address:           address,
+			ID:                mtp.Id,
+			PoolID:            mtp.AmmPoolId,
+			Position:          mtp.Position.String(),
+			TradingAsset:      mtp.TradingAsset,
+			TradingAssetPrice: mtpAndPrice.TradingAssetPrice.String(),
+			OpenPrice:         mtp.OpenPrice.String(),
+			Collateral: indexerTypes.Token{
+				Amount: mtp.Collateral.String(),
+				Denom:  mtp.CollateralAsset,
+			},
+			PnL: indexerTypes.Token{
+				Amount: mtpAndPrice.Pnl.Amount.String(),
+				Denom:  mtpAndPrice.Pnl.Denom,
+			},
+		})
+	}
+	return result, nil
+}
+
+/* End of kwak-indexer node implementation*/
+/* *************************************************************************** */
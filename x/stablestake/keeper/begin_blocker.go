@@ -1,7 +1,18 @@
 package keeper
 
 import (
+	/* *************************************************************************** */
+	/* Start of kwak-indexer node implementation*/
+	indexer "github.com/elys-network/elys/indexer"
+	indexerStablestakeTypes "github.com/elys-network/elys/indexer/txs/stablestake"
+	indexerTypes "github.com/elys-network/elys/indexer/types"
+
+	/* End of kwak-indexer node implementation*/
+	/* *************************************************************************** */
+
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/elys-network/elys/x/stablestake/types"
 )
 
@@ -26,4 +37,30 @@ func (k Keeper) BeginBlocker(ctx sdk.Context) {
 		delBlock := ctx.BlockHeight() - int64(numBlocks)
 		k.DeleteInterest(ctx, delBlock)
 	}
+
+	/* *************************************************************************** */
+	/* Start of kwak-indexer node implementation*/
+	if indexer.ShouldSnapshot(ctx.BlockHeight()) {
+		depositDenom := k.GetDepositDenom(ctx)
+		balance := k.bk.GetBalance(ctx, authtypes.NewModuleAddress(types.ModuleName), depositDenom)
+		borrowed := params.TotalValue.Sub(balance.Amount)
+		borrowRatio := sdkmath.LegacyZeroDec()
+		if params.TotalValue.IsPositive() {
+			borrowRatio = borrowed.ToLegacyDec().Quo(params.TotalValue.ToLegacyDec())
+		}
+
+		indexer.QueueEvent(ctx, indexerTypes.ElysEventTypes.Stablestake.Snapshot, indexerStablestakeTypes.Snapshot{
+			PoolID:         types.PoolId,
+			DepositDenom:   depositDenom,
+			InterestRate:   params.InterestRate.String(),
+			RedemptionRate: params.RedemptionRate.String(),
+			TotalValue:     params.TotalValue.String(),
+			TVL:            k.TVL(ctx, k.oracleKeeper, depositDenom).String(),
+			TotalBorrow:    borrowed.String(),
+			BorrowRatio:    borrowRatio.String(),
+			EpochComputed:  epochPosition == 0,
+		}, []string{})
+	}
+	/* End of kwak-indexer node implementation*/
+	/* *************************************************************************** */
 }
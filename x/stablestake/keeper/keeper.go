@@ -19,6 +19,7 @@ type (
 		bk                 types.BankKeeper
 		commitmentKeeper   *commitmentkeeper.Keeper
 		assetProfileKeeper types.AssetProfileKeeper
+		oracleKeeper       types.OracleKeeper
 		hooks              types.StableStakeHooks
 	}
 )
@@ -30,6 +31,7 @@ func NewKeeper(
 	bk types.BankKeeper,
 	commitmentKeeper *commitmentkeeper.Keeper,
 	assetProfileKeeper types.AssetProfileKeeper,
+	oracleKeeper types.OracleKeeper,
 ) *Keeper {
 
 	// ensure that authority is a valid AccAddress
@@ -44,6 +46,7 @@ func NewKeeper(
 		bk:                 bk,
 		commitmentKeeper:   commitmentKeeper,
 		assetProfileKeeper: assetProfileKeeper,
+		oracleKeeper:       oracleKeeper,
 	}
 }
 
@@ -35,23 +35,33 @@ func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParam
 	/* *************************************************************************** */
 	/* Start of kwak-indexer node implementation*/
 	indexer.QueueTransaction(ctx, indexerStableStakeTypes.MsgUpdateParams{
-		Authority: msg.Authority,
-		Params: indexerStableStakeTypes.Params{
-			DepositDenom:         msg.Params.DepositDenom,
-			RedemptionRate:       msg.Params.RedemptionRate.String(),
-			EpochLength:          msg.Params.EpochLength,
-			InterestRate:         msg.Params.InterestRate.String(),
-			InterestRateMax:      msg.Params.InterestRateMax.String(),
-			InterestRateMin:      msg.Params.InterestRateMin.String(),
-			InterestRateIncrease: msg.Params.InterestRateIncrease.String(),
-			InterestRateDecrease: msg.Params.InterestRateDecrease.String(),
-			HealthGainFactor:     msg.Params.HealthGainFactor.String(),
-			TotalValue:           msg.Params.TotalValue.String(),
-			MaxLeverageRatio:     msg.Params.MaxLeverageRatio.String(),
-		},
+		Authority:      msg.Authority,
+		Params:         indexerParams(*msg.Params),
+		PreviousParams: indexerParams(params),
 	}, []string{msg.Authority})
 	/* End of kwak-indexer node implementation*/
 	/* *************************************************************************** */
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+/* *************************************************************************** */
+/* Start of kwak-indexer node implementation*/
+func indexerParams(params types.Params) indexerStableStakeTypes.Params {
+	return indexerStableStakeTypes.Params{
+		DepositDenom:         params.DepositDenom,
+		RedemptionRate:       params.RedemptionRate.String(),
+		EpochLength:          params.EpochLength,
+		InterestRate:         params.InterestRate.String(),
+		InterestRateMax:      params.InterestRateMax.String(),
+		InterestRateMin:      params.InterestRateMin.String(),
+		InterestRateIncrease: params.InterestRateIncrease.String(),
+		InterestRateDecrease: params.InterestRateDecrease.String(),
+		HealthGainFactor:     params.HealthGainFactor.String(),
+		TotalValue:           params.TotalValue.String(),
+		MaxLeverageRatio:     params.MaxLeverageRatio.String(),
+	}
+}
+
+/* End of kwak-indexer node implementation*/
+/* *************************************************************************** */
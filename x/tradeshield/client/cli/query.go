@@ -32,6 +32,12 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdPendingPerpetualOrderForAddress())
 
 	cmd.AddCommand(CmdPendingSpotOrderForAddress())
+	cmd.AddCommand(CmdShowOrderGroup())
+	cmd.AddCommand(CmdShowScheduledOrder())
+	cmd.AddCommand(CmdShowSpotOrderBook())
+	cmd.AddCommand(CmdShowPerpetualOrderBook())
+	cmd.AddCommand(CmdSimulateSpotOrder())
+	cmd.AddCommand(CmdSimulatePerpetualOrder())
 
 	// this line is used by starport scaffolding # 1
 
@@ -0,0 +1,137 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	perptypes "github.com/elys-network/elys/x/perpetual/types"
+	"github.com/elys-network/elys/x/tradeshield/types"
+	"github.com/spf13/cobra"
+)
+
+const flagBucketSize = "bucket-size"
+
+func getBucketSizeFlag(cmd *cobra.Command) (math.LegacyDec, error) {
+	bucketSizeStr, err := cmd.Flags().GetString(flagBucketSize)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+	return math.LegacyNewDecFromStr(bucketSizeStr)
+}
+
+func CmdShowSpotOrderBook() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "show-spot-order-book [base-denom] [quote-denom] [buy|sell]",
+		Short:   "shows the pending spot limit orders of a denom pair aggregated by price level",
+		Example: `elysd q tradeshield show-spot-order-book uusdc uatom sell --bucket-size=0.1`,
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			side, ok := types.OrderBookSide_value[strings.ToUpper(args[2])]
+			if !ok {
+				return fmt.Errorf("unknown order book side %s", args[2])
+			}
+
+			bucketSize, err := getBucketSizeFlag(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QuerySpotOrderBookRequest{
+				BaseDenom:  args[0],
+				QuoteDenom: args[1],
+				Side:       types.OrderBookSide(side),
+				BucketSize: bucketSize,
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.SpotOrderBook(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagBucketSize, "0", "Width of the price levels, zero aggregates orders of the same price")
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowPerpetualOrderBook() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "show-perpetual-order-book [trading-asset] [long|short]",
+		Short:   "shows the pending perpetual orders of a trading asset aggregated by trigger price level",
+		Example: `elysd q tradeshield show-perpetual-order-book uatom long --order-type=limitopen --bucket-size=0.1`,
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			position := types.PerpetualPosition(perptypes.GetPositionFromString(args[1]))
+			if position == types.PerpetualPosition_UNSPECIFIED {
+				return errors.New("position must be long or short")
+			}
+
+			orderTypeStr, err := cmd.Flags().GetString(flagOrderType)
+			if err != nil {
+				return err
+			}
+			orderType := types.GetPerpetualOrderTypeFromString(orderTypeStr)
+
+			bucketSize, err := getBucketSizeFlag(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryPerpetualOrderBookRequest{
+				TradingAsset: args[0],
+				Position:     position,
+				OrderType:    orderType,
+				BucketSize:   bucketSize,
+				Pagination:   pageReq,
+			}
+
+			res, err := queryClient.PerpetualOrderBook(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagOrderType, "limitopen", "Order type (limitopen, limitclose, stoploss or trailingstop)")
+	cmd.Flags().String(flagBucketSize, "0", "Width of the trigger price levels, zero aggregates orders of the same trigger price")
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
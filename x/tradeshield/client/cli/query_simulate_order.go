@@ -0,0 +1,156 @@
+package cli
+
+import (
+	"errors"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	perpcli "github.com/elys-network/elys/x/perpetual/client/cli"
+	perptypes "github.com/elys-network/elys/x/perpetual/types"
+	"github.com/elys-network/elys/x/tradeshield/types"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+const flagOwner = "owner"
+
+func CmdSimulateSpotOrder() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "simulate-spot-order [order-type] [order-amount] [order-target-denom] [order-price]",
+		Short:   "estimates the execution of a spot order at the current state",
+		Example: `elysd q tradeshield simulate-spot-order limitsell 1000000uatom uusdc 4.8 --owner=elys1...`,
+		Args:    cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			orderAmount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+			rate, err := math.LegacyNewDecFromStr(args[3])
+			if err != nil {
+				return err
+			}
+
+			owner, err := cmd.Flags().GetString(flagOwner)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QuerySimulateSpotOrderRequest{
+				OwnerAddress: owner,
+				OrderType:    types.GetSpotOrderTypeFromString(args[0]),
+				OrderPrice: types.OrderPrice{
+					BaseDenom:  orderAmount.Denom,
+					QuoteDenom: args[2],
+					Rate:       rate,
+				},
+				OrderAmount:      orderAmount,
+				OrderTargetDenom: args[2],
+			}
+
+			res, err := queryClient.SimulateSpotOrder(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagOwner, "", "Optional owner of the order, used for the swap fee discount")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdSimulatePerpetualOrder() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "simulate-perpetual-order [position] [leverage] [pool-id] [trading-asset] [collateral] [trigger-price]",
+		Short:   "estimates the execution of a perpetual open order at the current state, a zero trigger price opens at the market price",
+		Example: `elysd q tradeshield simulate-perpetual-order long 5 1 uatom 1000000uusdc 4.5 --take-profit=10`,
+		Args:    cobra.ExactArgs(6),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			position := types.PerpetualPosition(perptypes.GetPositionFromString(args[0]))
+			if position == types.PerpetualPosition_UNSPECIFIED {
+				return errors.New("position must be long or short")
+			}
+
+			leverage, err := math.LegacyNewDecFromStr(args[1])
+			if err != nil {
+				return err
+			}
+
+			poolId, err := cast.ToUint64E(args[2])
+			if err != nil {
+				return err
+			}
+
+			collateral, err := sdk.ParseCoinNormalized(args[4])
+			if err != nil {
+				return err
+			}
+
+			triggerPrice, err := math.LegacyNewDecFromStr(args[5])
+			if err != nil {
+				return err
+			}
+
+			takeProfitPriceStr, err := cmd.Flags().GetString(perpcli.FlagTakeProfitPrice)
+			if err != nil {
+				return err
+			}
+
+			takeProfitPrice := perptypes.TakeProfitPriceDefault
+			if takeProfitPriceStr != perptypes.InfinitePriceString {
+				takeProfitPrice, err = math.LegacyNewDecFromStr(takeProfitPriceStr)
+				if err != nil {
+					return errors.New("invalid take profit price")
+				}
+			}
+
+			owner, err := cmd.Flags().GetString(flagOwner)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QuerySimulatePerpetualOrderRequest{
+				OwnerAddress:    owner,
+				Position:        position,
+				Leverage:        leverage,
+				TradingAsset:    args[3],
+				Collateral:      collateral,
+				TriggerPrice:    triggerPrice,
+				TakeProfitPrice: takeProfitPrice,
+				PoolId:          poolId,
+			}
+
+			res, err := queryClient.SimulatePerpetualOrder(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(perpcli.FlagTakeProfitPrice, perptypes.InfinitePriceString, "Optional take profit price")
+	cmd.Flags().String(flagOwner, "", "Optional owner of the order")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
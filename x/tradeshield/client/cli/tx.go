@@ -41,6 +41,12 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdCancelPerpetualOrder())
 	cmd.AddCommand(CmdCancelPerpetualOrders())
 
+	cmd.AddCommand(CmdCreateOrderGroup())
+	cmd.AddCommand(CmdCancelOrderGroup())
+
+	cmd.AddCommand(CmdCreateScheduledOrder())
+	cmd.AddCommand(CmdCancelScheduledOrder())
+
 	cmd.AddCommand(CmdUpdateParams())
 	cmd.AddCommand(CmdExecuteOrders())
 	// this line is used by starport scaffolding # 1
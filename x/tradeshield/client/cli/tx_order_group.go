@@ -0,0 +1,147 @@
+package cli
+
+import (
+	"strings"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/elys-network/elys/x/tradeshield/types"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagBracketTakeProfit = "bracket-take-profit"
+	flagBracketStopLoss   = "bracket-stop-loss"
+	flagBracketAmount     = "bracket-close-amount"
+)
+
+func CmdCreateOrderGroup() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "create-order-group [spot-order-ids] [perpetual-order-ids]",
+		Short:   "Group pending orders so that executing one of them cancels the others",
+		Example: `elysd tx tradeshield create-order-group 1,2 "" --from=treasury --keyring-backend=test --chain-id=elystestnet-1 --yes`,
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			spotOrderIds, err := parseOrderIds(args[0])
+			if err != nil {
+				return err
+			}
+			perpetualOrderIds, err := parseOrderIds(args[1])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateOrderGroup(clientCtx.GetFromAddress().String(), spotOrderIds, perpetualOrderIds)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdCancelOrderGroup() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-order-group [group-id]",
+		Short: "Cancel every order of an order group",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			groupId, err := cast.ToUint64E(args[0])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelOrderGroup(clientCtx.GetFromAddress().String(), groupId)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// parseOrderIds parses a comma separated list of order ids, an empty string is
+// an empty list
+func parseOrderIds(arg string) ([]uint64, error) {
+	if arg == "" {
+		return nil, nil
+	}
+	values := strings.Split(arg, listSeparator)
+	ids := make([]uint64, len(values))
+	for i, value := range values {
+		id, err := cast.ToUint64E(value)
+		if err != nil {
+			return nil, err
+		}
+		ids[i] = id
+	}
+	return ids, nil
+}
+
+// addBracketOrderFlags adds the bracket order flags of the perpetual open order
+// creation command
+func addBracketOrderFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flagBracketTakeProfit, "", "Trigger price of a limit close order created once the position is opened")
+	cmd.Flags().String(flagBracketStopLoss, "", "Trigger price of a stop loss order created once the position is opened")
+	cmd.Flags().String(flagBracketAmount, "0", "Amount of the position closed by the bracket orders, the whole position when zero")
+}
+
+// getBracketOrderFlags returns the bracket orders of the open order
+func getBracketOrderFlags(cmd *cobra.Command) ([]types.BracketOrder, error) {
+	closeAmountStr, err := cmd.Flags().GetString(flagBracketAmount)
+	if err != nil {
+		return nil, err
+	}
+	closeAmount, ok := math.NewIntFromString(closeAmountStr)
+	if !ok {
+		return nil, types.ErrInvalidBracketOrder.Wrapf("invalid close amount %s", closeAmountStr)
+	}
+
+	var brackets []types.BracketOrder
+	for _, leg := range []struct {
+		flag      string
+		orderType types.PerpetualOrderType
+	}{
+		{flagBracketTakeProfit, types.PerpetualOrderType_LIMITCLOSE},
+		{flagBracketStopLoss, types.PerpetualOrderType_STOPLOSSPERP},
+	} {
+		priceStr, err := cmd.Flags().GetString(leg.flag)
+		if err != nil {
+			return nil, err
+		}
+		if priceStr == "" {
+			continue
+		}
+		price, err := math.LegacyNewDecFromStr(priceStr)
+		if err != nil {
+			return nil, err
+		}
+		brackets = append(brackets, types.BracketOrder{
+			OrderType:    leg.orderType,
+			TriggerPrice: price,
+			CloseAmount:  closeAmount,
+		})
+	}
+	return brackets, nil
+}
@@ -97,6 +97,14 @@ func CmdCreatePerpetualOpenOrder() *cobra.Command {
 				stopLossPrice,
 				poolId,
 			)
+			msg.TimeInForce, msg.Expiry, err = getTimeInForceFlags(cmd)
+			if err != nil {
+				return err
+			}
+			msg.BracketOrders, err = getBracketOrderFlags(cmd)
+			if err != nil {
+				return err
+			}
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -106,16 +114,23 @@ func CmdCreatePerpetualOpenOrder() *cobra.Command {
 
 	cmd.Flags().String(perpcli.FlagTakeProfitPrice, perptypes.InfinitePriceString, "Optional take profit price")
 	cmd.Flags().String(perpcli.FlagStopLossPrice, perptypes.ZeroPriceString, "Optional stop loss price")
+	addTimeInForceFlags(cmd)
+	addBracketOrderFlags(cmd)
 
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
 
+const (
+	flagOrderType   = "order-type"
+	flagCloseAmount = "close-amount"
+)
+
 func CmdCreatePerpetualCloseOrder() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "create-perpetual-close-order [trigger-price] [position-id]",
-		Short: "Create a new perpetual close order",
+		Short: "Create a new perpetual limit close, stop loss or trailing stop order",
 		Args:  cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -136,7 +151,30 @@ func CmdCreatePerpetualCloseOrder() *cobra.Command {
 				return err
 			}
 
-			msg := types.NewMsgCreatePerpetualCloseOrder(addr, triggerPrice, positionId)
+			orderTypeStr, err := cmd.Flags().GetString(flagOrderType)
+			if err != nil {
+				return err
+			}
+			orderType := types.GetPerpetualOrderTypeFromString(orderTypeStr)
+
+			closeAmountStr, err := cmd.Flags().GetString(flagCloseAmount)
+			if err != nil {
+				return err
+			}
+			closeAmount, ok := math.NewIntFromString(closeAmountStr)
+			if !ok {
+				return errors.New("invalid close amount")
+			}
+
+			msg := types.NewMsgCreatePerpetualCloseOrder(addr, triggerPrice, positionId, orderType, closeAmount)
+			msg.TimeInForce, msg.Expiry, err = getTimeInForceFlags(cmd)
+			if err != nil {
+				return err
+			}
+			msg.TrailingStop, err = getTrailingStopFlags(cmd)
+			if err != nil {
+				return err
+			}
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -144,6 +182,10 @@ func CmdCreatePerpetualCloseOrder() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(flagOrderType, "limitclose", "Close order type (limitclose, stoploss or trailingstop)")
+	cmd.Flags().String(flagCloseAmount, "0", "Optional amount of the position to close, the whole position when zero")
+	addTimeInForceFlags(cmd)
+	addTrailingStopFlags(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
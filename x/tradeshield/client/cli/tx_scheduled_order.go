@@ -0,0 +1,107 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/elys-network/elys/x/tradeshield/types"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagIntervalUnit = "interval-unit"
+	flagMaxSlippage  = "max-slippage"
+)
+
+func CmdCreateScheduledOrder() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "create-scheduled-order [amount] [target-denom] [slice-count] [interval]",
+		Short:   "Swap an amount in slices executed at a regular interval",
+		Example: `elysd tx tradeshield create-scheduled-order 1000000000uatom uusdc 10 100 --interval-unit=blocks --max-slippage=0.01 --from=treasury --keyring-backend=test --chain-id=elystestnet-1 --yes`,
+		Args:    cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+			sliceCount, err := cast.ToUint64E(args[2])
+			if err != nil {
+				return err
+			}
+			interval, err := cast.ToUint64E(args[3])
+			if err != nil {
+				return err
+			}
+
+			unitStr, err := cmd.Flags().GetString(flagIntervalUnit)
+			if err != nil {
+				return err
+			}
+			unit, ok := types.ScheduleIntervalUnit_value[strings.ToUpper(unitStr)]
+			if !ok {
+				return fmt.Errorf("unknown interval unit %s", unitStr)
+			}
+
+			slippageStr, err := cmd.Flags().GetString(flagMaxSlippage)
+			if err != nil {
+				return err
+			}
+			maxSlippage, err := math.LegacyNewDecFromStr(slippageStr)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateScheduledOrder(clientCtx.GetFromAddress().String(), amount, args[1], sliceCount, interval, types.ScheduleIntervalUnit(unit), maxSlippage)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagIntervalUnit, "blocks", "Unit of the interval between slices (blocks or seconds)")
+	cmd.Flags().String(flagMaxSlippage, "0.01", "Maximum slippage of a slice from the oracle price")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdCancelScheduledOrder() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-scheduled-order [order-id]",
+		Short: "Cancel a scheduled order and refund the amount left to swap",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			orderId, err := cast.ToUint64E(args[0])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelScheduledOrder(clientCtx.GetFromAddress().String(), orderId)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
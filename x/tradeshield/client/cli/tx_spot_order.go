@@ -40,7 +40,20 @@ func CmdCreateSpotOrder() *cobra.Command {
 				Rate:       math.LegacyMustNewDecFromStr(args[3]),
 			}
 
+			timeInForce, expiry, err := getTimeInForceFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			trailingStop, err := getTrailingStopFlags(cmd)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgCreateSpotOrder(addr, orderType, orderPrice, orderAmount, orderTargetDenom)
+			msg.TimeInForce = timeInForce
+			msg.Expiry = expiry
+			msg.TrailingStop = trailingStop
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -48,6 +61,8 @@ func CmdCreateSpotOrder() *cobra.Command {
 		},
 	}
 
+	addTimeInForceFlags(cmd)
+	addTrailingStopFlags(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
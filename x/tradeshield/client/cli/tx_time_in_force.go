@@ -0,0 +1,41 @@
+package cli
+
+import (
+	"github.com/elys-network/elys/x/tradeshield/types"
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagTimeInForce  = "time-in-force"
+	flagExpiryHeight = "expiry-height"
+	flagExpiryTime   = "expiry-time"
+)
+
+// addTimeInForceFlags adds the time in force flags of the order creation commands
+func addTimeInForceFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flagTimeInForce, "gtc", "Time in force of the order (gtc, gtt, ioc or fok)")
+	cmd.Flags().Uint64(flagExpiryHeight, 0, "Block height at which a gtt order expires")
+	cmd.Flags().Uint64(flagExpiryTime, 0, "Unix timestamp at which a gtt order expires")
+}
+
+// getTimeInForceFlags returns the time in force and the expiry of the order
+func getTimeInForceFlags(cmd *cobra.Command) (types.TimeInForce, *types.Date, error) {
+	timeInForceStr, err := cmd.Flags().GetString(flagTimeInForce)
+	if err != nil {
+		return types.TimeInForce_GTC, nil, err
+	}
+	timeInForce := types.GetTimeInForceFromString(timeInForceStr)
+
+	height, err := cmd.Flags().GetUint64(flagExpiryHeight)
+	if err != nil {
+		return types.TimeInForce_GTC, nil, err
+	}
+	timestamp, err := cmd.Flags().GetUint64(flagExpiryTime)
+	if err != nil {
+		return types.TimeInForce_GTC, nil, err
+	}
+	if height == 0 && timestamp == 0 {
+		return timeInForce, nil, nil
+	}
+	return timeInForce, &types.Date{Height: height, Timestamp: timestamp}, nil
+}
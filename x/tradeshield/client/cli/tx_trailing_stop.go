@@ -0,0 +1,53 @@
+package cli
+
+import (
+	"cosmossdk.io/math"
+	"github.com/elys-network/elys/x/tradeshield/types"
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagTrailPercent = "trail-percent"
+	flagTrailAmount  = "trail-amount"
+)
+
+// addTrailingStopFlags adds the trail flags of the trailing stop order creation
+// commands
+func addTrailingStopFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flagTrailPercent, "", "Trail of a trailingstop order as a fraction of the best price (e.g. 0.05)")
+	cmd.Flags().String(flagTrailAmount, "", "Trail of a trailingstop order as a price distance")
+}
+
+// getTrailingStopFlags returns the trail of the order, nil when none is set
+func getTrailingStopFlags(cmd *cobra.Command) (*types.TrailingStop, error) {
+	percentStr, err := cmd.Flags().GetString(flagTrailPercent)
+	if err != nil {
+		return nil, err
+	}
+	amountStr, err := cmd.Flags().GetString(flagTrailAmount)
+	if err != nil {
+		return nil, err
+	}
+	if percentStr == "" && amountStr == "" {
+		return nil, nil
+	}
+
+	trailingStop := &types.TrailingStop{
+		TrailPercent:  math.LegacyZeroDec(),
+		TrailAmount:   math.LegacyZeroDec(),
+		HighWaterMark: math.LegacyZeroDec(),
+	}
+	if percentStr != "" {
+		trailingStop.TrailPercent, err = math.LegacyNewDecFromStr(percentStr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if amountStr != "" {
+		trailingStop.TrailAmount, err = math.LegacyNewDecFromStr(amountStr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return trailingStop, nil
+}
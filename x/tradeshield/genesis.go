@@ -22,6 +22,24 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 
 	// Set pendingPerpetualOrder count
 	k.SetPendingPerpetualOrderCount(ctx, genState.PendingPerpetualOrderCount)
+	// Set all the orderGroup
+	for _, elem := range genState.OrderGroupList {
+		k.SetOrderGroup(ctx, elem)
+	}
+
+	// Set orderGroup count
+	k.SetOrderGroupCount(ctx, genState.OrderGroupCount)
+	// Set all the scheduledOrder
+	for _, elem := range genState.ScheduledOrderList {
+		k.SetScheduledOrder(ctx, elem)
+	}
+
+	// Set scheduledOrder count
+	k.SetScheduledOrderCount(ctx, genState.ScheduledOrderCount)
+	// Set all the scheduledOrderSlice
+	for _, elem := range genState.ScheduledOrderSliceList {
+		k.SetScheduledOrderSlice(ctx, elem)
+	}
 	// this line is used by starport scaffolding # genesis/module/init
 	k.SetParams(ctx, &genState.Params)
 }
@@ -35,6 +53,11 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis.PendingSpotOrderCount = k.GetPendingSpotOrderCount(ctx)
 	genesis.PendingPerpetualOrderList = k.GetAllPendingPerpetualOrder(ctx)
 	genesis.PendingPerpetualOrderCount = k.GetPendingPerpetualOrderCount(ctx)
+	genesis.OrderGroupList = k.GetAllOrderGroup(ctx)
+	genesis.OrderGroupCount = k.GetOrderGroupCount(ctx)
+	genesis.ScheduledOrderList = k.GetAllScheduledOrder(ctx)
+	genesis.ScheduledOrderCount = k.GetScheduledOrderCount(ctx)
+	genesis.ScheduledOrderSliceList = k.GetAllScheduledOrderSlice(ctx)
 	// this line is used by starport scaffolding # genesis/module/export
 
 	return genesis
@@ -32,6 +32,30 @@ func TestGenesis(t *testing.T) {
 			},
 		},
 		PendingPerpetualOrderCount: 2,
+		OrderGroupList: []types.OrderGroup{
+			{
+				Id: 0,
+			},
+			{
+				Id: 1,
+			},
+		},
+		OrderGroupCount: 2,
+		ScheduledOrderList: []types.ScheduledOrder{
+			{
+				Id: 0,
+			},
+			{
+				Id: 1,
+			},
+		},
+		ScheduledOrderCount: 2,
+		ScheduledOrderSliceList: []types.ScheduledOrderSlice{
+			{
+				OrderId: 1,
+				Index:   1,
+			},
+		},
 		// this line is used by starport scaffolding # genesis/test/state
 	}
 
@@ -47,5 +71,10 @@ func TestGenesis(t *testing.T) {
 	require.Equal(t, genesisState.PendingSpotOrderCount, got.PendingSpotOrderCount)
 	require.ElementsMatch(t, genesisState.PendingPerpetualOrderList, got.PendingPerpetualOrderList)
 	require.Equal(t, genesisState.PendingPerpetualOrderCount, got.PendingPerpetualOrderCount)
+	require.ElementsMatch(t, genesisState.OrderGroupList, got.OrderGroupList)
+	require.Equal(t, genesisState.OrderGroupCount, got.OrderGroupCount)
+	require.ElementsMatch(t, genesisState.ScheduledOrderList, got.ScheduledOrderList)
+	require.Equal(t, genesisState.ScheduledOrderCount, got.ScheduledOrderCount)
+	require.ElementsMatch(t, genesisState.ScheduledOrderSliceList, got.ScheduledOrderSliceList)
 	// this line is used by starport scaffolding # genesis/test/assert
 }
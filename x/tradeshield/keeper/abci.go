@@ -0,0 +1,335 @@
+package keeper
+
+import (
+	"fmt"
+
+	/* *************************************************************************** */
+	/* Start of kwak-indexer node implementation*/
+	indexer "github.com/elys-network/elys/indexer"
+	indexerTradeshieldTypes "github.com/elys-network/elys/indexer/txs/tradeshield"
+	indexerTypes "github.com/elys-network/elys/indexer/types"
+
+	/* End of kwak-indexer node implementation*/
+	/* *************************************************************************** */
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/elys-network/elys/x/tradeshield/types"
+)
+
+// OrderExecutionGasLimit is the gas a single order may consume when it is
+// executed at the end of a block
+const OrderExecutionGasLimit = 5_000_000
+
+// OrderProcessingGasBudget is the gas the pending orders executed at the end of
+// a block may consume together. It is checked before every execution, so it is
+// exceeded by at most one execution.
+const OrderProcessingGasBudget = 100_000_000
+
+// OrderBudget bounds the pending orders executed at the end of a block
+type OrderBudget struct {
+	Orders uint64 // Executions left, see the LimitProcessOrder param
+	Gas    uint64 // Gas left for the executions
+}
+
+// take reserves an execution, it fails once the executions or the gas are used up
+func (b *OrderBudget) take() bool {
+	if b.Orders == 0 || b.Gas == 0 {
+		return false
+	}
+	b.Orders--
+	return true
+}
+
+func (b *OrderBudget) consumeGas(gas uint64) {
+	if gas >= b.Gas {
+		b.Gas = 0
+		return
+	}
+	b.Gas -= gas
+}
+
+// EndBlocker cancels the GTT orders whose expiry is reached, moves the
+// trailing stops with the market price, at most MaxTrailingStopUpdatesPerBlock
+// of them, executes the due slices of the scheduled orders, then executes the
+// pending orders whose trigger price is reached, at most LimitProcessOrder of
+// them and OrderProcessingGasBudget gas per block
+func (k Keeper) EndBlocker(ctx sdk.Context) {
+	expiredSpotOrders, expiredPerpOrders := k.ExpireOrders(ctx)
+	trailingSpotOrders, trailingPerpOrders := k.UpdateTrailingStops(ctx, MaxTrailingStopUpdatesPerBlock)
+
+	/* *************************************************************************** */
+	/* Start of kwak-indexer node implementation*/
+	if len(expiredSpotOrders) > 0 || len(expiredPerpOrders) > 0 {
+		indexer.QueueEvent(ctx, indexerTypes.ElysEventTypes.Tradeshield.OrderExpiry, indexerTradeshieldTypes.OrderExpiryEvent{
+			SpotOrders:      expiredSpotOrders,
+			PerpetualOrders: expiredPerpOrders,
+		}, orderOwners(expiredSpotOrders, expiredPerpOrders))
+	}
+	if len(trailingSpotOrders) > 0 || len(trailingPerpOrders) > 0 {
+		indexer.QueueEvent(ctx, indexerTypes.ElysEventTypes.Tradeshield.TrailingStop, indexerTradeshieldTypes.TrailingStopEvent{
+			SpotOrders:      trailingSpotOrders,
+			PerpetualOrders: trailingPerpOrders,
+		}, orderOwners(trailingSpotOrders, trailingPerpOrders))
+	}
+	/* End of kwak-indexer node implementation*/
+	/* *************************************************************************** */
+
+	// scheduled orders cannot be executed by executors, their slices run even
+	// when the processing of pending orders is disabled
+	k.ExecuteScheduledOrders(ctx)
+
+	params := k.GetParams(ctx)
+	if !params.ProcessOrdersEnabled {
+		return
+	}
+
+	budget := OrderBudget{Orders: params.LimitProcessOrder, Gas: OrderProcessingGasBudget}
+	spotLogs, droppedSpotOrders := k.ProcessSpotOrders(ctx, &budget)
+	perpLogs, droppedPerpOrders := k.ProcessPerpetualOrders(ctx, &budget)
+
+	/* *************************************************************************** */
+	/* Start of kwak-indexer node implementation*/
+	if len(spotLogs) > 0 || len(perpLogs) > 0 {
+		indexer.QueueEvent(ctx, indexerTypes.ElysEventTypes.Tradeshield.OrderExecution, indexerTradeshieldTypes.OrderExecutionEvent{
+			SpotLogs:      spotLogs,
+			PerpetualLogs: perpLogs,
+		}, []string{})
+	}
+	if len(droppedSpotOrders) > 0 || len(droppedPerpOrders) > 0 {
+		indexer.QueueEvent(ctx, indexerTypes.ElysEventTypes.Tradeshield.OrderExpiry, indexerTradeshieldTypes.OrderExpiryEvent{
+			SpotOrders:      droppedSpotOrders,
+			PerpetualOrders: droppedPerpOrders,
+		}, orderOwners(droppedSpotOrders, droppedPerpOrders))
+	}
+	/* End of kwak-indexer node implementation*/
+	/* *************************************************************************** */
+}
+
+/* *************************************************************************** */
+/* Start of kwak-indexer node implementation*/
+// orderOwners returns the distinct owners of the orders, in order of appearance
+func orderOwners[T interface{ GetOwnerAddress() string }](orderLists ...[]T) []string {
+	owners := []string{}
+	seen := make(map[string]bool)
+	for _, orders := range orderLists {
+		for _, order := range orders {
+			if owner := order.GetOwnerAddress(); !seen[owner] {
+				seen[owner] = true
+				owners = append(owners, owner)
+			}
+		}
+	}
+	return owners
+}
+
+/* End of kwak-indexer node implementation*/
+/* *************************************************************************** */
+
+// ProcessSpotOrders executes the spot orders whose rate is reached, walking
+// each sorted list from the order closest to the market price, until the
+// budget is used up. Failed orders wait for their retry height and are
+// cancelled after MaxOrderExecutionFailures failures.
+func (k Keeper) ProcessSpotOrders(ctx sdk.Context, budget *OrderBudget) (logs []indexerTradeshieldTypes.OrderExecutionLog, dropped []indexerTradeshieldTypes.ExpiredOrder) {
+	lists, err := k.GetAllSortedSpotOrder(ctx)
+	if err != nil {
+		ctx.Logger().Error(fmt.Sprintf("failed to get sorted spot orders: %s", err))
+		return nil, nil
+	}
+
+	for _, orderIds := range lists {
+		if len(orderIds) == 0 {
+			continue
+		}
+		first, found := k.GetPendingSpotOrder(ctx, orderIds[0])
+		if !found {
+			continue
+		}
+		// all orders of a list share the order type and the denoms
+		marketPrice, err := k.spotOrderMarketPrice(ctx, first)
+		if err != nil || marketPrice.IsZero() {
+			continue
+		}
+		below := spotOrderExecutesBelowRate(first)
+
+		for i := range orderIds {
+			id := orderIds[i]
+			if below {
+				id = orderIds[len(orderIds)-1-i]
+			}
+			order, found := k.GetPendingSpotOrder(ctx, id)
+			if !found {
+				continue
+			}
+			if !rateReached(marketPrice, order.OrderPrice.Rate, below) {
+				break
+			}
+			if k.orderRetryPending(ctx, types.SpotOrderFailureKey, id) {
+				continue
+			}
+			if !budget.take() {
+				return logs, dropped
+			}
+
+			gasConsumed := ctx.GasMeter().GasConsumed()
+			_, executed, err := k.FillSpotOrder(ctx, order, nil)
+			budget.consumeGas(ctx.GasMeter().GasConsumed() - gasConsumed)
+			if err != nil {
+				ctx.Logger().Debug(fmt.Sprintf("spot order %d cannot be executed: %s", id, err))
+				logs = append(logs, indexerTradeshieldTypes.OrderExecutionLog{OrderID: id, Error: err.Error()})
+				if k.failedSpotOrderDropped(ctx, id) {
+					if expired, ok := k.dropSpotOrder(ctx, order); ok {
+						dropped = append(dropped, expired)
+					}
+				}
+			} else if executed {
+				logs = append(logs, indexerTradeshieldTypes.OrderExecutionLog{OrderID: id, Executed: true})
+			}
+		}
+	}
+
+	return logs, dropped
+}
+
+// ProcessPerpetualOrders executes the perpetual orders whose trigger price is
+// reached, walking each sorted list from the order closest to the market
+// price, until the budget is used up. Failed orders wait for their retry
+// height and are cancelled after MaxOrderExecutionFailures failures.
+func (k Keeper) ProcessPerpetualOrders(ctx sdk.Context, budget *OrderBudget) (logs []indexerTradeshieldTypes.OrderExecutionLog, dropped []indexerTradeshieldTypes.ExpiredOrder) {
+	lists, err := k.GetAllSortedPerpetualOrder(ctx)
+	if err != nil {
+		ctx.Logger().Error(fmt.Sprintf("failed to get sorted perpetual orders: %s", err))
+		return nil, nil
+	}
+
+	for _, orderIds := range lists {
+		if len(orderIds) == 0 {
+			continue
+		}
+		first, found := k.GetPendingPerpetualOrder(ctx, orderIds[0])
+		if !found {
+			continue
+		}
+		// all orders of a list share the position, the order type and the asset
+		marketPrice, err := k.perpetual.GetAssetPrice(ctx, first.TradingAsset)
+		if err != nil || marketPrice.IsZero() {
+			continue
+		}
+		below := perpetualOrderExecutesBelowRate(first)
+
+		for i := range orderIds {
+			id := orderIds[i]
+			if below {
+				id = orderIds[len(orderIds)-1-i]
+			}
+			order, found := k.GetPendingPerpetualOrder(ctx, id)
+			if !found {
+				continue
+			}
+			if !rateReached(marketPrice, order.TriggerPrice.Rate, below) {
+				break
+			}
+			if k.orderRetryPending(ctx, types.PerpetualOrderFailureKey, id) {
+				continue
+			}
+			if !budget.take() {
+				return logs, dropped
+			}
+
+			gasConsumed := ctx.GasMeter().GasConsumed()
+			_, executed, err := k.FillPerpetualOrder(ctx, order, nil)
+			budget.consumeGas(ctx.GasMeter().GasConsumed() - gasConsumed)
+			if err != nil {
+				ctx.Logger().Debug(fmt.Sprintf("perpetual order %d cannot be executed: %s", id, err))
+				logs = append(logs, indexerTradeshieldTypes.OrderExecutionLog{OrderID: id, Error: err.Error()})
+				if k.failedPerpetualOrderDropped(ctx, id) {
+					if expired, ok := k.dropPerpetualOrder(ctx, order); ok {
+						dropped = append(dropped, expired)
+					}
+				}
+			} else if executed {
+				logs = append(logs, indexerTradeshieldTypes.OrderExecutionLog{OrderID: id, Executed: true})
+			}
+		}
+	}
+
+	return logs, dropped
+}
+
+// spotOrderExecutesBelowRate reports whether the order executes once the market
+// price is at or below its rate, otherwise it executes at or above it. Stop
+// losses and trailing stops sell when the price falls.
+func spotOrderExecutesBelowRate(order types.SpotOrder) bool {
+	return order.OrderType != types.SpotOrderType_LIMITSELL
+}
+
+// spotOrderMarketPrice returns the market price the rate of the order is
+// compared to. A limit buy is priced as its target quote denom in the paid base
+// denom, the other orders as their base denom in the quote denom.
+func (k Keeper) spotOrderMarketPrice(ctx sdk.Context, order types.SpotOrder) (sdkmath.LegacyDec, error) {
+	if order.OrderType == types.SpotOrderType_LIMITBUY {
+		return k.GetAssetPriceFromDenomInToDenomOut(ctx, order.OrderPrice.QuoteDenom, order.OrderPrice.BaseDenom)
+	}
+	return k.GetAssetPriceFromDenomInToDenomOut(ctx, order.OrderPrice.BaseDenom, order.OrderPrice.QuoteDenom)
+}
+
+// perpetualOrderExecutesBelowRate reports whether the order executes once the
+// market price is at or below its trigger price, otherwise it executes at or
+// above it
+func perpetualOrderExecutesBelowRate(order types.PerpetualOrder) bool {
+	long := order.Position == types.PerpetualPosition_LONG
+	if order.PerpetualOrderType == types.PerpetualOrderType_LIMITCLOSE {
+		return !long
+	}
+	// limit open, stop loss and trailing stop
+	return long
+}
+
+func rateReached(marketPrice, rate sdkmath.LegacyDec, below bool) bool {
+	rate = sortRate(rate)
+	if below {
+		return marketPrice.LTE(rate)
+	}
+	return marketPrice.GTE(rate)
+}
+
+// executeCached runs fn on a cache context with its own gas meter and only
+// writes its changes, and queues its indexer records, when it succeeds and
+// reports the order as executed. Panics, e.g. running out of gas, are returned
+// as errors. The gas used is charged to ctx whatever the outcome.
+func executeCached(ctx sdk.Context, fn func(ctx sdk.Context) (bool, error)) (err error) {
+	gasLimit := uint64(OrderExecutionGasLimit)
+	if remaining := ctx.GasMeter().GasRemaining(); remaining < gasLimit {
+		gasLimit = remaining
+	}
+	cacheCtx, write := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(storetypes.NewGasMeter(gasLimit))
+
+	/* *************************************************************************** */
+	/* Start of kwak-indexer node implementation*/
+	// The records of an execution that is not written are dropped
+	cacheCtx, write = indexer.DeferIndexing(cacheCtx, write)
+	/* End of kwak-indexer node implementation*/
+	/* *************************************************************************** */
+
+	defer func() {
+		ctx.GasMeter().ConsumeGas(cacheCtx.GasMeter().GasConsumedToLimit(), "order execution")
+		if r := recover(); r != nil {
+			if outOfGas, ok := r.(storetypes.ErrorOutOfGas); ok {
+				err = errorsmod.Wrapf(sdkerrors.ErrOutOfGas, "out of gas in location: %s", outOfGas.Descriptor)
+				return
+			}
+			err = fmt.Errorf("order execution panicked: %v", r)
+		}
+	}()
+
+	executed, err := fn(cacheCtx)
+	if err != nil || !executed {
+		return err
+	}
+	write()
+	return nil
+}
@@ -0,0 +1,201 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	oracletypes "github.com/elys-network/elys/x/oracle/types"
+	ptypes "github.com/elys-network/elys/x/parameter/types"
+	keeper "github.com/elys-network/elys/x/tradeshield/keeper"
+	"github.com/elys-network/elys/x/tradeshield/types"
+)
+
+func (suite *TradeshieldKeeperTestSuite) createPerpetualOpenOrder(owner sdk.AccAddress, triggerPrice int64) uint64 {
+	msgSrvr := keeper.NewMsgServerImpl(suite.app.TradeshieldKeeper)
+	res, err := msgSrvr.CreatePerpetualOpenOrder(suite.ctx, &types.MsgCreatePerpetualOpenOrder{
+		OwnerAddress: owner.String(),
+		TriggerPrice: types.TriggerPrice{
+			TradingAssetDenom: "uatom",
+			Rate:              math.LegacyNewDec(triggerPrice),
+		},
+		Collateral:      sdk.Coin{Denom: "uatom", Amount: math.NewInt(100)},
+		TradingAsset:    "uatom",
+		Position:        types.PerpetualPosition_LONG,
+		Leverage:        math.LegacyNewDec(5),
+		TakeProfitPrice: math.LegacyNewDec(15),
+		StopLossPrice:   math.LegacyZeroDec(),
+		PoolId:          1,
+	})
+	suite.Require().NoError(err)
+	return res.OrderId
+}
+
+func (suite *TradeshieldKeeperTestSuite) setLimitProcessOrder(enabled bool, limit uint64) {
+	params := suite.app.TradeshieldKeeper.GetParams(suite.ctx)
+	params.ProcessOrdersEnabled = enabled
+	params.LimitProcessOrder = limit
+	suite.Require().NoError(suite.app.TradeshieldKeeper.SetParams(suite.ctx, &params))
+}
+
+func (suite *TradeshieldKeeperTestSuite) TestEndBlockerExecutesPerpetualOrders() {
+	addr := suite.AddAccounts(3, nil)
+	_, _, _ = suite.SetPerpetualPool(1)
+
+	// Market price is 5, long limit opens execute at or below their trigger
+	first := suite.createPerpetualOpenOrder(addr[0], 8)
+	second := suite.createPerpetualOpenOrder(addr[1], 10)
+	untriggered := suite.createPerpetualOpenOrder(addr[2], 4)
+
+	lists, err := suite.app.TradeshieldKeeper.GetAllSortedPerpetualOrder(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal([][]uint64{{untriggered, first, second}}, lists)
+
+	suite.setLimitProcessOrder(false, 1)
+	suite.app.TradeshieldKeeper.EndBlocker(suite.ctx)
+	_, found := suite.app.TradeshieldKeeper.GetPendingPerpetualOrder(suite.ctx, second)
+	suite.Require().True(found)
+
+	// The order closest to the market price is executed first
+	suite.setLimitProcessOrder(true, 1)
+	suite.app.TradeshieldKeeper.EndBlocker(suite.ctx)
+	_, found = suite.app.TradeshieldKeeper.GetPendingPerpetualOrder(suite.ctx, second)
+	suite.Require().False(found)
+	_, found = suite.app.TradeshieldKeeper.GetPendingPerpetualOrder(suite.ctx, first)
+	suite.Require().True(found)
+
+	suite.app.TradeshieldKeeper.EndBlocker(suite.ctx)
+	_, found = suite.app.TradeshieldKeeper.GetPendingPerpetualOrder(suite.ctx, first)
+	suite.Require().False(found)
+	_, found = suite.app.TradeshieldKeeper.GetPendingPerpetualOrder(suite.ctx, untriggered)
+	suite.Require().True(found)
+
+	mtps, _, err := suite.app.PerpetualKeeper.GetMTPsForAddressWithPagination(suite.ctx, addr[1], nil)
+	suite.Require().NoError(err)
+	suite.Require().Len(mtps, 1)
+
+	lists, err = suite.app.TradeshieldKeeper.GetAllSortedPerpetualOrder(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal([][]uint64{{untriggered}}, lists)
+}
+
+func (suite *TradeshieldKeeperTestSuite) TestEndBlockerExecutesStopLoss() {
+	addr := suite.AddAccounts(1, nil)
+	_, _, _ = suite.SetPerpetualPool(1)
+	positionId := suite.openPerpetualPosition(addr[0])
+	order := suite.closeOrder(addr[0], types.PerpetualOrderType_STOPLOSSPERP, positionId, math.LegacyNewDec(4), math.ZeroInt())
+
+	suite.app.TradeshieldKeeper.EndBlocker(suite.ctx)
+	_, found := suite.app.TradeshieldKeeper.GetPendingPerpetualOrder(suite.ctx, order.OrderId)
+	suite.Require().True(found)
+
+	suite.app.OracleKeeper.SetPrice(suite.ctx, oracletypes.Price{
+		Asset:     "ATOM",
+		Price:     math.LegacyMustNewDecFromStr("3.5"),
+		Source:    "elys",
+		Provider:  oracleProvider.String(),
+		Timestamp: uint64(suite.ctx.BlockTime().Unix()),
+	})
+	suite.app.TradeshieldKeeper.EndBlocker(suite.ctx)
+	_, found = suite.app.TradeshieldKeeper.GetPendingPerpetualOrder(suite.ctx, order.OrderId)
+	suite.Require().False(found)
+	_, err := suite.app.PerpetualKeeper.GetMTP(suite.ctx, addr[0], positionId)
+	suite.Require().Error(err)
+}
+
+func (suite *TradeshieldKeeperTestSuite) TestEndBlockerDropsOrdersOfClosedPositions() {
+	addr := suite.AddAccounts(1, nil)
+	_, _, _ = suite.SetPerpetualPool(1)
+	order := suite.closeOrder(addr[0], types.PerpetualOrderType_LIMITCLOSE, 100, math.LegacyNewDec(4), math.ZeroInt())
+
+	suite.app.TradeshieldKeeper.EndBlocker(suite.ctx)
+	_, found := suite.app.TradeshieldKeeper.GetPendingPerpetualOrder(suite.ctx, order.OrderId)
+	suite.Require().False(found)
+}
+
+func (suite *TradeshieldKeeperTestSuite) TestFillDropsOrdersOfClosedPositions() {
+	addr := suite.AddAccounts(2, nil)
+	owner, executor := addr[0], addr[1]
+	_, _, _ = suite.SetPerpetualPool(1)
+	order := suite.closeOrder(owner, types.PerpetualOrderType_LIMITCLOSE, 100, math.LegacyNewDec(4), math.ZeroInt())
+
+	// The order is dropped without being executed or paying the executor
+	executorBalances := suite.app.BankKeeper.GetAllBalances(suite.ctx, executor)
+	reward, executed, err := suite.app.TradeshieldKeeper.FillPerpetualOrder(suite.ctx, order, executor)
+	suite.Require().NoError(err)
+	suite.Require().False(executed)
+	suite.Require().True(reward.IsZero())
+	suite.Require().Equal(executorBalances, suite.app.BankKeeper.GetAllBalances(suite.ctx, executor))
+	_, found := suite.app.TradeshieldKeeper.GetPendingPerpetualOrder(suite.ctx, order.OrderId)
+	suite.Require().False(found)
+}
+
+func (suite *TradeshieldKeeperTestSuite) TestEndBlockerKeepsFailedOrders() {
+	addr := suite.AddAccounts(1, nil)
+	_, _, _ = suite.SetPerpetualPool(1)
+	id := suite.createPerpetualOpenOrder(addr[0], 10)
+
+	// The collateral held by the order is gone, the execution fails and its
+	// writes are discarded
+	order, found := suite.app.TradeshieldKeeper.GetPendingPerpetualOrder(suite.ctx, id)
+	suite.Require().True(found)
+	order.Collateral.Amount = math.NewInt(1_000_000_000_000)
+	suite.app.TradeshieldKeeper.SetPendingPerpetualOrder(suite.ctx, order)
+
+	balanceBefore := suite.app.BankKeeper.GetAllBalances(suite.ctx, order.GetOrderAddress())
+	suite.app.TradeshieldKeeper.EndBlocker(suite.ctx)
+	_, found = suite.app.TradeshieldKeeper.GetPendingPerpetualOrder(suite.ctx, id)
+	suite.Require().True(found)
+	suite.Require().Equal(balanceBefore, suite.app.BankKeeper.GetAllBalances(suite.ctx, order.GetOrderAddress()))
+}
+
+func (suite *TradeshieldKeeperTestSuite) TestEndBlockerCancelsFailingOrders() {
+	addr := suite.AddAccounts(1, nil)
+	_, _, _ = suite.SetPerpetualPool(1)
+	suite.setLimitProcessOrder(true, 10)
+	id := suite.createPerpetualOpenOrder(addr[0], 10)
+
+	order, found := suite.app.TradeshieldKeeper.GetPendingPerpetualOrder(suite.ctx, id)
+	suite.Require().True(found)
+	// The stop loss is above the price, the execution fails but the order can be
+	// refunded
+	order.StopLossPrice = math.LegacyNewDec(20)
+	suite.app.TradeshieldKeeper.SetPendingPerpetualOrder(suite.ctx, order)
+
+	// The order is retried after 2, 4, 8 and 16 blocks and cancelled at its
+	// fifth failure
+	attempts := 0
+	for height := suite.ctx.BlockHeight(); height < suite.ctx.BlockHeight()+100; height++ {
+		ctx := suite.ctx.WithBlockHeight(height)
+		logs, dropped := suite.app.TradeshieldKeeper.ProcessPerpetualOrders(ctx, &keeper.OrderBudget{Orders: 10, Gas: keeper.OrderProcessingGasBudget})
+		if len(logs) > 0 {
+			attempts++
+		}
+		if len(dropped) > 0 {
+			suite.Require().Equal(keeper.ExpiryReasonExecutionFailed, dropped[0].Reason)
+			break
+		}
+	}
+	suite.Require().Equal(keeper.MaxOrderExecutionFailures, attempts)
+	_, found = suite.app.TradeshieldKeeper.GetPendingPerpetualOrder(suite.ctx, id)
+	suite.Require().False(found)
+}
+
+func (suite *TradeshieldKeeperTestSuite) TestEndBlockerSettlesSpotSwaps() {
+	addr := suite.AddAccounts(1, nil)
+	owner := addr[0]
+	_, _, _ = suite.SetPerpetualPool(1)
+	msgSrvr := keeper.NewMsgServerImpl(suite.app.TradeshieldKeeper)
+	res, err := msgSrvr.CreateSpotOrder(suite.ctx, suite.limitSellOrderMsg(owner, 1000000, "4.8", types.TimeInForce_GTC))
+	suite.Require().NoError(err)
+
+	suite.setLimitProcessOrder(true, 1)
+	usdcBefore := suite.app.BankKeeper.GetBalance(suite.ctx, owner, ptypes.BaseCurrency)
+	suite.app.TradeshieldKeeper.EndBlocker(suite.ctx)
+	_, found := suite.app.TradeshieldKeeper.GetPendingSpotOrder(suite.ctx, res.OrderId)
+	suite.Require().False(found)
+
+	// The swap is settled with the execution, within the order rate, and is
+	// not left for the amm end blocker
+	suite.Require().Empty(suite.app.AmmKeeper.GetAllSwapExactAmountInRequests(suite.ctx))
+	received := suite.app.BankKeeper.GetBalance(suite.ctx, owner, ptypes.BaseCurrency).Sub(usdcBefore)
+	suite.Require().True(received.Amount.GTE(math.NewInt(4800000)))
+}
@@ -0,0 +1,234 @@
+package keeper
+
+import (
+	/* *************************************************************************** */
+	/* Start of kwak-indexer node implementation*/
+	indexer "github.com/elys-network/elys/indexer"
+	indexerTradeshieldTypes "github.com/elys-network/elys/indexer/txs/tradeshield"
+	indexerTypes "github.com/elys-network/elys/indexer/types"
+
+	/* End of kwak-indexer node implementation*/
+	/* *************************************************************************** */
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ptypes "github.com/elys-network/elys/x/parameter/types"
+	"github.com/elys-network/elys/x/tradeshield/types"
+)
+
+// NewOrderDeposit returns the deposit a new pending order escrows
+func (k Keeper) NewOrderDeposit(ctx sdk.Context) sdk.Coin {
+	return sdk.NewCoin(ptypes.Elys, k.GetParams(ctx).MinimumDeposit)
+}
+
+// depositCoins returns the escrowed deposit, orders created before deposits
+// were introduced have none
+func depositCoins(deposit sdk.Coin) sdk.Coins {
+	if deposit.Amount.IsNil() || !deposit.Amount.IsPositive() {
+		return sdk.NewCoins()
+	}
+	return sdk.NewCoins(deposit)
+}
+
+// EscrowOrderDeposit sends the deposit of a new order from its owner to the
+// order address
+func (k Keeper) EscrowOrderDeposit(ctx sdk.Context, owner, orderAddress sdk.AccAddress, deposit sdk.Coin) error {
+	coins := depositCoins(deposit)
+	if coins.IsZero() {
+		return nil
+	}
+	return k.bank.SendCoins(ctx, owner, orderAddress, coins)
+}
+
+// RefundOrderDeposit returns the deposit of an order to its owner
+func (k Keeper) RefundOrderDeposit(ctx sdk.Context, orderAddress sdk.AccAddress, owner string, deposit sdk.Coin) error {
+	coins := depositCoins(deposit)
+	if coins.IsZero() {
+		return nil
+	}
+	return k.bank.SendCoins(ctx, orderAddress, sdk.MustAccAddressFromBech32(owner), coins)
+}
+
+// ExecutorShare returns the share of amount paid to the executor of an order
+func (k Keeper) ExecutorShare(ctx sdk.Context, amount sdk.Coin) sdk.Coin {
+	params := k.GetParams(ctx)
+	if !params.RewardEnabled || amount.Amount.IsNil() {
+		return sdk.NewCoin(amount.Denom, sdkmath.ZeroInt())
+	}
+	return sdk.NewCoin(amount.Denom, params.RewardPercentage.MulInt(amount.Amount).TruncateInt())
+}
+
+// settleFilledOrder pays the executor reward of a filled order, its share of
+// the order funds and the deposit. Without an executor, or when rewards are
+// disabled, the deposit is refunded to the owner.
+func (k Keeper) settleFilledOrder(ctx sdk.Context, orderId uint64, orderAddress sdk.AccAddress, owner string, deposit, share sdk.Coin, executor sdk.AccAddress) (sdk.Coins, error) {
+	if executor == nil || !k.GetParams(ctx).RewardEnabled {
+		return sdk.NewCoins(), k.RefundOrderDeposit(ctx, orderAddress, owner, deposit)
+	}
+
+	reward := depositCoins(deposit)
+	if share.Amount.IsPositive() {
+		reward = reward.Add(share)
+	}
+	if reward.IsZero() {
+		return reward, nil
+	}
+	err := k.bank.SendCoins(ctx, orderAddress, executor, reward)
+	if err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvent(types.NewExecutorRewardEvt(orderId, executor, reward))
+	return reward, nil
+}
+
+// FillSpotOrder executes a spot order on a cache context and pays the
+// executor, executor is nil for orders executed by the chain. Nothing is
+// written and nothing is paid when the execution fails or the rate is not
+// reached. Limit orders may be partially filled, they pay the share of the
+// filled amount and keep their deposit until they are fully filled. The other
+// orders of its group are cancelled with the first execution.
+func (k Keeper) FillSpotOrder(ctx sdk.Context, order types.SpotOrder, executor sdk.AccAddress) (reward sdk.Coins, executed bool, err error) {
+	if err := checkOrderNotExpired(ctx, order.OrderId, order.Expiry); err != nil {
+		return nil, false, err
+	}
+
+	var cancelledSpot, cancelledPerpetual []indexerTradeshieldTypes.ExpiredOrder
+	err = executeCached(ctx, func(cacheCtx sdk.Context) (bool, error) {
+		share := sdk.NewCoin(order.OrderAmount.Denom, sdkmath.ZeroInt())
+		if executor != nil {
+			share = k.ExecutorShare(cacheCtx, order.OrderAmount)
+		}
+		filled := order
+		filled.OrderAmount = order.OrderAmount.Sub(share)
+
+		res, err := k.ExecuteSpotOrder(cacheCtx, filled)
+		if err != nil || res == nil {
+			return false, err
+		}
+
+		deposit := order.Deposit
+		if rest, pending := k.GetPendingSpotOrder(cacheCtx, order.OrderId); pending {
+			// a partially filled limit order pays the share of its filled
+			// part out of the amount left to fill, the rest of the share and
+			// the deposit stay escrowed
+			filled.OrderAmount = filled.OrderAmount.Sub(rest.OrderAmount)
+			paid := sdk.NewCoin(share.Denom, sdkmath.ZeroInt())
+			if executor != nil {
+				paid = k.ExecutorShare(cacheCtx, filled.OrderAmount)
+			}
+			rest.OrderAmount = rest.OrderAmount.Add(share).Sub(paid)
+			k.SetPendingSpotOrder(cacheCtx, rest)
+			share, deposit = paid, sdk.Coin{}
+		}
+
+		reward, err = k.settleFilledOrder(cacheCtx, order.OrderId, order.GetOrderAddress(), order.OwnerAddress, deposit, share, executor)
+		if err != nil {
+			return false, err
+		}
+		cacheCtx.EventManager().EmitEvent(types.NewExecuteSpotOrderEvt(filled, res))
+
+		cancelledSpot, cancelledPerpetual, err = k.cancelOtherGroupOrders(cacheCtx, order.GroupId, true, order.OrderId)
+		if err != nil {
+			return false, err
+		}
+		executed = true
+		return true, nil
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	/* *************************************************************************** */
+	/* Start of kwak-indexer node implementation*/
+	queueGroupCancellations(ctx, order.OwnerAddress, cancelledSpot, cancelledPerpetual)
+	/* End of kwak-indexer node implementation*/
+	/* *************************************************************************** */
+
+	return reward, executed, nil
+}
+
+// FillPerpetualOrder executes a perpetual order on a cache context and pays
+// the executor, executor is nil for orders executed by the chain. Only limit
+// open orders hold collateral to take a share of, close orders pay their
+// deposit. Close orders of closed positions are dropped without being executed
+// and their deposit is refunded. The other orders of its group are cancelled
+// with the execution.
+func (k Keeper) FillPerpetualOrder(ctx sdk.Context, order types.PerpetualOrder, executor sdk.AccAddress) (reward sdk.Coins, executed bool, err error) {
+	if err := checkOrderNotExpired(ctx, order.OrderId, order.Expiry); err != nil {
+		return nil, false, err
+	}
+
+	var cancelledSpot, cancelledPerpetual []indexerTradeshieldTypes.ExpiredOrder
+	err = executeCached(ctx, func(cacheCtx sdk.Context) (bool, error) {
+		if order.PerpetualOrderType != types.PerpetualOrderType_LIMITOPEN {
+			_, err := k.perpetual.GetMTP(cacheCtx, sdk.MustAccAddressFromBech32(order.OwnerAddress), order.PositionId)
+			if err != nil {
+				// the position was closed since the order was placed, the
+				// order is dropped without being executed
+				if err := k.RefundOrderDeposit(cacheCtx, order.GetOrderAddress(), order.OwnerAddress, order.Deposit); err != nil {
+					return false, err
+				}
+				k.RemovePendingPerpetualOrder(cacheCtx, order.OrderId)
+				if err := k.removeFromOrderGroup(cacheCtx, order.GroupId, false, order.OrderId); err != nil {
+					return false, err
+				}
+				return true, nil
+			}
+		}
+
+		share := sdk.NewCoin(order.Collateral.Denom, sdkmath.ZeroInt())
+		if executor != nil && order.PerpetualOrderType == types.PerpetualOrderType_LIMITOPEN {
+			share = k.ExecutorShare(cacheCtx, order.Collateral)
+		}
+		filled := order
+		filled.Collateral = order.Collateral.Sub(share)
+
+		if err := k.ExecutePerpetualOrder(cacheCtx, filled); err != nil {
+			return false, err
+		}
+		if _, pending := k.GetPendingPerpetualOrder(cacheCtx, order.OrderId); pending {
+			return false, nil
+		}
+
+		var err error
+		reward, err = k.settleFilledOrder(cacheCtx, order.OrderId, order.GetOrderAddress(), order.OwnerAddress, order.Deposit, share, executor)
+		if err != nil {
+			return false, err
+		}
+
+		cancelledSpot, cancelledPerpetual, err = k.cancelOtherGroupOrders(cacheCtx, order.GroupId, false, order.OrderId)
+		if err != nil {
+			return false, err
+		}
+		executed = true
+		return true, nil
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	/* *************************************************************************** */
+	/* Start of kwak-indexer node implementation*/
+	queueGroupCancellations(ctx, order.OwnerAddress, cancelledSpot, cancelledPerpetual)
+	/* End of kwak-indexer node implementation*/
+	/* *************************************************************************** */
+
+	return reward, executed, nil
+}
+
+/* *************************************************************************** */
+/* Start of kwak-indexer node implementation*/
+// queueGroupCancellations records the orders cancelled because an order of
+// their group was executed
+func queueGroupCancellations(ctx sdk.Context, owner string, spotOrders, perpetualOrders []indexerTradeshieldTypes.ExpiredOrder) {
+	if len(spotOrders) == 0 && len(perpetualOrders) == 0 {
+		return
+	}
+	indexer.QueueEvent(ctx, indexerTypes.ElysEventTypes.Tradeshield.OrderExpiry, indexerTradeshieldTypes.OrderExpiryEvent{
+		SpotOrders:      spotOrders,
+		PerpetualOrders: perpetualOrders,
+	}, []string{owner})
+}
+
+/* End of kwak-indexer node implementation*/
+/* *************************************************************************** */
@@ -0,0 +1,117 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ptypes "github.com/elys-network/elys/x/parameter/types"
+	keeper "github.com/elys-network/elys/x/tradeshield/keeper"
+	"github.com/elys-network/elys/x/tradeshield/types"
+)
+
+func (suite *TradeshieldKeeperTestSuite) setExecutorReward(percentage math.LegacyDec, deposit int64) {
+	params := suite.app.TradeshieldKeeper.GetParams(suite.ctx)
+	params.RewardEnabled = true
+	params.RewardPercentage = percentage
+	params.MinimumDeposit = math.NewInt(deposit)
+	suite.Require().NoError(suite.app.TradeshieldKeeper.SetParams(suite.ctx, &params))
+}
+
+func (suite *TradeshieldKeeperTestSuite) TestExecutorRewardPerpetualOrder() {
+	addr := suite.AddAccounts(2, nil)
+	owner, executor := addr[0], addr[1]
+	_, _, _ = suite.SetPerpetualPool(1)
+	suite.setExecutorReward(math.LegacyMustNewDecFromStr("0.1"), 1000)
+
+	ownerElys := suite.app.BankKeeper.GetBalance(suite.ctx, owner, ptypes.Elys)
+	id := suite.createPerpetualOpenOrder(owner, 8)
+	order, found := suite.app.TradeshieldKeeper.GetPendingPerpetualOrder(suite.ctx, id)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewInt64Coin(ptypes.Elys, 1000), order.Deposit)
+	suite.Require().Equal(ownerElys.SubAmount(math.NewInt(1000)), suite.app.BankKeeper.GetBalance(suite.ctx, owner, ptypes.Elys))
+	suite.Require().Equal(sdk.NewInt64Coin(ptypes.Elys, 1000), suite.app.BankKeeper.GetBalance(suite.ctx, order.GetOrderAddress(), ptypes.Elys))
+
+	executorBalances := suite.app.BankKeeper.GetAllBalances(suite.ctx, executor)
+	gasConsumed := suite.ctx.GasMeter().GasConsumed()
+	msgSrvr := keeper.NewMsgServerImpl(suite.app.TradeshieldKeeper)
+	_, err := msgSrvr.ExecuteOrders(suite.ctx, &types.MsgExecuteOrders{
+		Creator:           executor.String(),
+		PerpetualOrderIds: []uint64{id},
+	})
+	suite.Require().NoError(err)
+	// The execution gas is charged to the transaction
+	suite.Require().Greater(suite.ctx.GasMeter().GasConsumed(), gasConsumed+10_000)
+
+	// The executor gets 10% of the collateral and the deposit
+	reward := sdk.NewCoins(sdk.NewInt64Coin(ptypes.Elys, 1000), sdk.NewInt64Coin(ptypes.ATOM, 10))
+	suite.Require().Equal(executorBalances.Add(reward...), suite.app.BankKeeper.GetAllBalances(suite.ctx, executor))
+	suite.Require().True(suite.app.BankKeeper.GetAllBalances(suite.ctx, order.GetOrderAddress()).IsZero())
+
+	mtps, _, err := suite.app.PerpetualKeeper.GetMTPsForAddressWithPagination(suite.ctx, owner, nil)
+	suite.Require().NoError(err)
+	suite.Require().Len(mtps, 1)
+	suite.Require().Equal(math.NewInt(90), mtps[0].Mtp.Collateral)
+}
+
+func (suite *TradeshieldKeeperTestSuite) TestExecutorRewardNotPaid() {
+	addr := suite.AddAccounts(2, nil)
+	owner, executor := addr[0], addr[1]
+	_, _, _ = suite.SetPerpetualPool(1)
+	suite.setExecutorReward(math.LegacyMustNewDecFromStr("0.1"), 1000)
+
+	// Market price is 5, the order isn't triggered
+	id := suite.createPerpetualOpenOrder(owner, 4)
+	order, _ := suite.app.TradeshieldKeeper.GetPendingPerpetualOrder(suite.ctx, id)
+
+	executorBalances := suite.app.BankKeeper.GetAllBalances(suite.ctx, executor)
+	orderBalances := suite.app.BankKeeper.GetAllBalances(suite.ctx, order.GetOrderAddress())
+	reward, executed, err := suite.app.TradeshieldKeeper.FillPerpetualOrder(suite.ctx, order, executor)
+	suite.Require().NoError(err)
+	suite.Require().False(executed)
+	suite.Require().True(reward.IsZero())
+	suite.Require().Equal(executorBalances, suite.app.BankKeeper.GetAllBalances(suite.ctx, executor))
+	suite.Require().Equal(orderBalances, suite.app.BankKeeper.GetAllBalances(suite.ctx, order.GetOrderAddress()))
+
+	// A failing execution doesn't pay either
+	failing := order
+	failing.Collateral = sdk.NewInt64Coin(ptypes.ATOM, 1000)
+	failing.TriggerPrice.Rate = math.LegacyNewDec(10)
+	_, executed, err = suite.app.TradeshieldKeeper.FillPerpetualOrder(suite.ctx, failing, executor)
+	suite.Require().Error(err)
+	suite.Require().False(executed)
+	suite.Require().Equal(executorBalances, suite.app.BankKeeper.GetAllBalances(suite.ctx, executor))
+	suite.Require().Equal(orderBalances, suite.app.BankKeeper.GetAllBalances(suite.ctx, order.GetOrderAddress()))
+	_, found := suite.app.TradeshieldKeeper.GetPendingPerpetualOrder(suite.ctx, id)
+	suite.Require().True(found)
+
+	// Cancelling refunds the deposit
+	ownerElys := suite.app.BankKeeper.GetBalance(suite.ctx, owner, ptypes.Elys)
+	msgSrvr := keeper.NewMsgServerImpl(suite.app.TradeshieldKeeper)
+	_, err = msgSrvr.CancelPerpetualOrder(suite.ctx, &types.MsgCancelPerpetualOrder{
+		OwnerAddress: owner.String(),
+		OrderId:      id,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(ownerElys.AddAmount(math.NewInt(1000)), suite.app.BankKeeper.GetBalance(suite.ctx, owner, ptypes.Elys))
+}
+
+func (suite *TradeshieldKeeperTestSuite) TestEndBlockerRefundsDeposit() {
+	addr := suite.AddAccounts(1, nil)
+	owner := addr[0]
+	_, _, _ = suite.SetPerpetualPool(1)
+	suite.setExecutorReward(math.LegacyMustNewDecFromStr("0.1"), 1000)
+
+	ownerElys := suite.app.BankKeeper.GetBalance(suite.ctx, owner, ptypes.Elys)
+	id := suite.createPerpetualOpenOrder(owner, 8)
+
+	suite.setLimitProcessOrder(true, 10)
+	suite.app.TradeshieldKeeper.EndBlocker(suite.ctx)
+	_, found := suite.app.TradeshieldKeeper.GetPendingPerpetualOrder(suite.ctx, id)
+	suite.Require().False(found)
+
+	// Orders executed by the chain pay no reward, the position gets the full collateral
+	suite.Require().Equal(ownerElys, suite.app.BankKeeper.GetBalance(suite.ctx, owner, ptypes.Elys))
+	mtps, _, err := suite.app.PerpetualKeeper.GetMTPsForAddressWithPagination(suite.ctx, owner, nil)
+	suite.Require().NoError(err)
+	suite.Require().Len(mtps, 1)
+	suite.Require().Equal(math.NewInt(100), mtps[0].Mtp.Collateral)
+}
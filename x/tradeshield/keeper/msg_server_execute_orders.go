@@ -9,17 +9,18 @@ import (
 	/* Start of kwak-indexer node implementation*/
 	indexer "github.com/elys-network/elys/indexer"
 	indexerTradeshieldTypes "github.com/elys-network/elys/indexer/txs/tradeshield"
+	indexerTypes "github.com/elys-network/elys/indexer/types"
 
 	/* End of kwak-indexer node implementation*/
 	/* *************************************************************************** */
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	ammtypes "github.com/elys-network/elys/x/amm/types"
 	"github.com/elys-network/elys/x/tradeshield/types"
 )
 
 func (k msgServer) ExecuteOrders(goCtx context.Context, msg *types.MsgExecuteOrders) (*types.MsgExecuteOrdersResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	executor := sdk.MustAccAddressFromBech32(msg.Creator)
 
 	/* *************************************************************************** */
 	/* Start of kwak-indexer node implementation*/
@@ -37,20 +38,7 @@ func (k msgServer) ExecuteOrders(goCtx context.Context, msg *types.MsgExecuteOrd
 			return nil, types.ErrSpotOrderNotFound
 		}
 
-		var err error
-		var res *ammtypes.MsgSwapByDenomResponse
-
-		// dispatch based on the order type
-		switch spotOrder.OrderType {
-		case types.SpotOrderType_STOPLOSS:
-			res, err = k.ExecuteStopLossOrder(ctx, spotOrder)
-		case types.SpotOrderType_LIMITSELL:
-			res, err = k.ExecuteLimitSellOrder(ctx, spotOrder)
-		case types.SpotOrderType_LIMITBUY:
-			res, err = k.ExecuteLimitBuyOrder(ctx, spotOrder)
-		case types.SpotOrderType_MARKETBUY:
-			res, err = k.ExecuteMarketBuyOrder(ctx, spotOrder)
-		}
+		reward, executed, err := k.FillSpotOrder(ctx, spotOrder, executor)
 
 		if err != nil {
 			errLog := fmt.Sprintf("Spot order Id:%d cannot be executed due to err: %s", spotOrderId, err.Error())
@@ -63,8 +51,10 @@ func (k msgServer) ExecuteOrders(goCtx context.Context, msg *types.MsgExecuteOrd
 			})
 			/* End of kwak-indexer node implementation*/
 			/* *************************************************************************** */
-		} else {
-			ctx.EventManager().EmitEvent(types.NewExecuteSpotOrderEvt(spotOrder, res))
+		} else if !executed {
+			// the trigger is not reached or the order was dropped, nothing
+			// was executed and nothing is paid
+			spotLog = append(spotLog, fmt.Sprintf("Spot order Id:%d was not executed", spotOrderId))
 			/* *************************************************************************** */
 			/* Start of kwak-indexer node implementation*/
 			spotExecutionLogs = append(spotExecutionLogs, indexerTradeshieldTypes.OrderExecutionLog{
@@ -72,6 +62,16 @@ func (k msgServer) ExecuteOrders(goCtx context.Context, msg *types.MsgExecuteOrd
 			})
 			/* End of kwak-indexer node implementation*/
 			/* *************************************************************************** */
+		} else {
+			/* *************************************************************************** */
+			/* Start of kwak-indexer node implementation*/
+			spotExecutionLogs = append(spotExecutionLogs, indexerTradeshieldTypes.OrderExecutionLog{
+				OrderID:  spotOrderId,
+				Executed: true,
+				Reward:   indexerRewardTokens(reward),
+			})
+			/* End of kwak-indexer node implementation*/
+			/* *************************************************************************** */
 		}
 	}
 
@@ -83,12 +83,7 @@ func (k msgServer) ExecuteOrders(goCtx context.Context, msg *types.MsgExecuteOrd
 			return nil, types.ErrPerpetualOrderNotFound
 		}
 
-		var err error
-
-		switch perpetualOrder.PerpetualOrderType {
-		case types.PerpetualOrderType_LIMITOPEN:
-			err = k.ExecuteLimitOpenOrder(ctx, perpetualOrder)
-		}
+		reward, executed, err := k.FillPerpetualOrder(ctx, perpetualOrder, executor)
 
 		if err != nil {
 			errLog := fmt.Sprintf("Perpetual order Id:%d cannot be executed due to err: %s", perpetualOrderId, err.Error())
@@ -101,7 +96,10 @@ func (k msgServer) ExecuteOrders(goCtx context.Context, msg *types.MsgExecuteOrd
 			})
 			/* End of kwak-indexer node implementation*/
 			/* *************************************************************************** */
-		} else {
+		} else if !executed {
+			// the trigger is not reached or the order was dropped, nothing
+			// was executed and nothing is paid
+			perpLog = append(perpLog, fmt.Sprintf("Perpetual order Id:%d was not executed", perpetualOrderId))
 			/* *************************************************************************** */
 			/* Start of kwak-indexer node implementation*/
 			perpExecutionLogs = append(perpExecutionLogs, indexerTradeshieldTypes.OrderExecutionLog{
@@ -109,6 +107,16 @@ func (k msgServer) ExecuteOrders(goCtx context.Context, msg *types.MsgExecuteOrd
 			})
 			/* End of kwak-indexer node implementation*/
 			/* *************************************************************************** */
+		} else {
+			/* *************************************************************************** */
+			/* Start of kwak-indexer node implementation*/
+			perpExecutionLogs = append(perpExecutionLogs, indexerTradeshieldTypes.OrderExecutionLog{
+				OrderID:  perpetualOrderId,
+				Executed: true,
+				Reward:   indexerRewardTokens(reward),
+			})
+			/* End of kwak-indexer node implementation*/
+			/* *************************************************************************** */
 		}
 	}
 
@@ -131,3 +139,19 @@ func (k msgServer) ExecuteOrders(goCtx context.Context, msg *types.MsgExecuteOrd
 
 	return &types.MsgExecuteOrdersResponse{}, nil
 }
+
+/* *************************************************************************** */
+/* Start of kwak-indexer node implementation*/
+func indexerRewardTokens(reward sdk.Coins) []indexerTypes.Token {
+	tokens := make([]indexerTypes.Token, len(reward))
+	for i, coin := range reward {
+		tokens[i] = indexerTypes.Token{
+			Amount: coin.Amount.String(),
+			Denom:  coin.Denom,
+		}
+	}
+	return tokens
+}
+
+/* End of kwak-indexer node implementation*/
+/* *************************************************************************** */
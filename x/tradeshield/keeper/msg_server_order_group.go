@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"context"
+
+	/* *************************************************************************** */
+	/* Start of kwak-indexer node implementation*/
+	indexer "github.com/elys-network/elys/indexer"
+	indexerTradeshieldTypes "github.com/elys-network/elys/indexer/txs/tradeshield"
+
+	/* End of kwak-indexer node implementation*/
+	/* *************************************************************************** */
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/elys-network/elys/x/tradeshield/types"
+)
+
+func (k msgServer) CreateOrderGroup(goCtx context.Context, msg *types.MsgCreateOrderGroup) (*types.MsgCreateOrderGroupResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	group, err := k.Keeper.CreateOrderGroup(ctx, msg.OwnerAddress, msg.SpotOrderIds, msg.PerpetualOrderIds)
+	if err != nil {
+		return nil, err
+	}
+
+	/* *************************************************************************** */
+	/* Start of kwak-indexer node implementation*/
+	indexer.QueueTransaction(ctx, indexerTradeshieldTypes.MsgCreateOrderGroup{
+		OwnerAddress:      msg.OwnerAddress,
+		GroupID:           group.Id,
+		SpotOrderIds:      msg.SpotOrderIds,
+		PerpetualOrderIds: msg.PerpetualOrderIds,
+	}, []string{msg.OwnerAddress})
+	/* End of kwak-indexer node implementation*/
+	/* *************************************************************************** */
+
+	return &types.MsgCreateOrderGroupResponse{
+		GroupId: group.Id,
+	}, nil
+}
+
+func (k msgServer) CancelOrderGroup(goCtx context.Context, msg *types.MsgCancelOrderGroup) (*types.MsgCancelOrderGroupResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	group, found := k.GetOrderGroup(ctx, msg.GroupId)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrOrderGroupNotFound, "group %d", msg.GroupId)
+	}
+
+	if group.OwnerAddress != msg.OwnerAddress {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
+	}
+
+	// send the funds and the deposits of every order back to the owner
+	spotOrders, perpetualOrders, err := k.Keeper.CancelOrderGroup(ctx, group)
+	if err != nil {
+		return nil, err
+	}
+
+	/* *************************************************************************** */
+	/* Start of kwak-indexer node implementation*/
+	indexer.QueueTransaction(ctx, indexerTradeshieldTypes.MsgCancelOrderGroup{
+		OwnerAddress:    msg.OwnerAddress,
+		GroupID:         msg.GroupId,
+		SpotOrders:      spotOrders,
+		PerpetualOrders: perpetualOrders,
+	}, []string{msg.OwnerAddress})
+	/* End of kwak-indexer node implementation*/
+	/* *************************************************************************** */
+
+	return &types.MsgCancelOrderGroupResponse{}, nil
+}
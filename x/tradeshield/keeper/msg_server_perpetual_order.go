@@ -15,6 +15,7 @@ import (
 	/* *************************************************************************** */
 
 	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -25,6 +26,10 @@ import (
 func (k msgServer) CreatePerpetualOpenOrder(goCtx context.Context, msg *types.MsgCreatePerpetualOpenOrder) (*types.MsgCreatePerpetualOpenOrderResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	if err := validateOrderExpiry(ctx, msg.TimeInForce, msg.Expiry); err != nil {
+		return nil, err
+	}
+
 	// Verify if perpetual pool exists
 	_, found := k.perpetual.GetPool(ctx, msg.PoolId)
 	if !found {
@@ -44,6 +49,10 @@ func (k msgServer) CreatePerpetualOpenOrder(goCtx context.Context, msg *types.Ms
 		PoolId:             msg.PoolId,
 		PositionId:         0,
 		Status:             types.Status_PENDING,
+		CloseAmount:        sdkmath.ZeroInt(),
+		Deposit:            k.NewOrderDeposit(ctx),
+		TimeInForce:        msg.TimeInForce,
+		Expiry:             orderExpiry(msg.TimeInForce, msg.Expiry),
 	}
 
 	// Verify if user hasn't created a order for same pool with pending status
@@ -104,8 +113,26 @@ func (k msgServer) CreatePerpetualOpenOrder(goCtx context.Context, msg *types.Ms
 		return nil, err
 	}
 
+	// escrow the deposit paid to the executor of the order
+	err = k.EscrowOrderDeposit(ctx, ownerAddress, pendingPerpetualOrder.GetOrderAddress(), pendingPerpetualOrder.Deposit)
+	if err != nil {
+		return nil, err
+	}
+
+	// the take profit and stop loss orders are created once the position is opened
+	if len(msg.BracketOrders) > 0 {
+		err = k.CreateBracketOrderGroup(ctx, &pendingPerpetualOrder, msg.BracketOrders)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	/* *************************************************************************** */
 	/* Start of kwak-indexer node implementation*/
+	// The order is only indexed once its immediate fill succeeded, a fill or
+	// kill order that cannot be filled leaves no record
+	ctx, commitOrder := indexer.DeferIndexing(ctx, nil)
+	group, _ := k.GetOrderGroup(ctx, pendingPerpetualOrder.GroupId)
 	indexer.QueueTransaction(ctx, indexerTradeshieldTypes.MsgCreatePerpetualOpenOrder{
 		OwnerAddress: msg.OwnerAddress,
 		TriggerPrice: common.TriggerPrice{
@@ -123,58 +150,195 @@ func (k msgServer) CreatePerpetualOpenOrder(goCtx context.Context, msg *types.Ms
 		StopLossPrice:   msg.StopLossPrice.String(),
 		PoolID:          msg.PoolId,
 		OrderID:         id,
+		Deposit:         indexerToken(k.perpetualOrderDeposit(ctx, pendingPerpetualOrder)),
+		TimeInForce:     pendingPerpetualOrder.TimeInForce.String(),
+		Expiry:          indexerOrderExpiry(pendingPerpetualOrder.Expiry),
+		GroupID:         pendingPerpetualOrder.GroupId,
+		BracketOrders:   indexerBracketOrders(group),
 	}, []string{msg.OwnerAddress})
 	/* End of kwak-indexer node implementation*/
 	/* *************************************************************************** */
 
+	if pendingPerpetualOrder.TimeInForce.IsImmediate() {
+		err = k.fillImmediatePerpetualOrder(ctx, pendingPerpetualOrder)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	/* *************************************************************************** */
+	/* Start of kwak-indexer node implementation*/
+	commitOrder()
+	/* End of kwak-indexer node implementation*/
+	/* *************************************************************************** */
+
 	return &types.MsgCreatePerpetualOpenOrderResponse{
 		OrderId: pendingPerpetualOrder.OrderId,
 	}, nil
 }
 
 func (k msgServer) CreatePerpetualCloseOrder(goCtx context.Context, msg *types.MsgCreatePerpetualCloseOrder) (*types.MsgCreatePerpetualCloseOrderResponse, error) {
-	// Disable for v1
-	return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "disabled for v1")
-	// ctx := sdk.UnwrapSDKContext(goCtx)
-
-	// // check if the position owner address matches the msg owner address
-	// position, err := k.perpetual.GetMTP(ctx, sdk.MustAccAddressFromBech32(msg.OwnerAddress), msg.PositionId)
-	// if err != nil {
-	// 	return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("position %d not found", msg.PositionId))
-	// }
-
-	// var pendingPerpetualOrder = types.PerpetualOrder{
-	// 	PerpetualOrderType: types.PerpetualOrderType_LIMITCLOSE,
-	// 	TriggerPrice: types.TriggerPrice{
-	// 		TradingAssetDenom: position.TradingAsset,
-	// 		Rate:              msg.TriggerPrice.Rate,
-	// 	},
-	// 	OwnerAddress: position.Address,
-	// 	PositionId:   position.Id,
-	// }
-
-	// id := k.AppendPendingPerpetualOrder(
-	// 	ctx,
-	// 	pendingPerpetualOrder,
-	// )
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := validateOrderExpiry(ctx, msg.TimeInForce, msg.Expiry); err != nil {
+		return nil, err
+	}
+
+	// check if the position owner address matches the msg owner address
+	position, err := k.perpetual.GetMTP(ctx, sdk.MustAccAddressFromBech32(msg.OwnerAddress), msg.PositionId)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("position %d not found", msg.PositionId))
+	}
+
+	if msg.TriggerPrice.TradingAssetDenom != "" && msg.TriggerPrice.TradingAssetDenom != position.TradingAsset {
+		return nil, errorsmod.Wrapf(types.ErrInvalidTriggerPrice, "trigger price denom %s doesn't match the position trading asset %s", msg.TriggerPrice.TradingAssetDenom, position.TradingAsset)
+	}
+
+	closeAmount := msg.CloseAmount
+	if closeAmount.IsNil() {
+		closeAmount = sdkmath.ZeroInt()
+	}
+
+	var pendingPerpetualOrder = types.PerpetualOrder{
+		PerpetualOrderType: msg.OrderType,
+		TriggerPrice: types.TriggerPrice{
+			TradingAssetDenom: position.TradingAsset,
+			Rate:              msg.TriggerPrice.Rate,
+		},
+		Collateral:      sdk.NewCoin(position.CollateralAsset, sdkmath.ZeroInt()),
+		OwnerAddress:    position.Address,
+		TradingAsset:    position.TradingAsset,
+		Position:        types.PerpetualPosition(position.Position),
+		Leverage:        sdkmath.LegacyZeroDec(),
+		TakeProfitPrice: sdkmath.LegacyZeroDec(),
+		StopLossPrice:   sdkmath.LegacyZeroDec(),
+		PoolId:          position.AmmPoolId,
+		PositionId:      position.Id,
+		Status:          types.Status_PENDING,
+		CloseAmount:     closeAmount,
+		Deposit:         k.NewOrderDeposit(ctx),
+		TimeInForce:     msg.TimeInForce,
+		Expiry:          orderExpiry(msg.TimeInForce, msg.Expiry),
+	}
+
+	if msg.OrderType.IsTrailingStop() {
+		// the stop price of a trailing stop starts from the market price
+		if err := k.startPerpetualTrailingStop(ctx, &pendingPerpetualOrder, msg.TrailingStop); err != nil {
+			return nil, err
+		}
+	} else if err := k.validateCloseOrderTriggerPrice(ctx, pendingPerpetualOrder, msg.TriggerPrice.Rate); err != nil {
+		return nil, err
+	}
+
+	// Verify if user hasn't created a close order of the same type for the position
+	pendingStatus := types.Status_PENDING
+	orders, _, err := k.GetPendingPerpetualOrdersForAddress(ctx, msg.OwnerAddress, &pendingStatus, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, order := range orders {
+		if order.PositionId == msg.PositionId && order.PerpetualOrderType == msg.OrderType {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("user already has a %s order for position %d", msg.OrderType, msg.PositionId))
+		}
+	}
+
+	id := k.AppendPendingPerpetualOrder(
+		ctx,
+		pendingPerpetualOrder,
+	)
+	pendingPerpetualOrder.OrderId = id
+
+	// escrow the deposit paid to the executor of the order
+	err = k.EscrowOrderDeposit(ctx, sdk.MustAccAddressFromBech32(msg.OwnerAddress), pendingPerpetualOrder.GetOrderAddress(), pendingPerpetualOrder.Deposit)
+	if err != nil {
+		return nil, err
+	}
+
+	/* *************************************************************************** */
+	/* Start of kwak-indexer node implementation*/
+	// The order is only indexed once its immediate fill succeeded, a fill or
+	// kill order that cannot be filled leaves no record
+	ctx, commitOrder := indexer.DeferIndexing(ctx, nil)
+	indexer.QueueTransaction(ctx, indexerTradeshieldTypes.MsgCreatePerpetualCloseOrder{
+		OwnerAddress: msg.OwnerAddress,
+		TriggerPrice: common.TriggerPrice{
+			TradingAssetDenom: position.TradingAsset,
+			Rate:              pendingPerpetualOrder.TriggerPrice.Rate.String(),
+		},
+		PositionID:  msg.PositionId,
+		OrderType:   msg.OrderType.String(),
+		CloseAmount: closeAmount.String(),
+		OrderID:     id,
+		Deposit: indexerTypes.Token{
+			Amount: pendingPerpetualOrder.Deposit.Amount.String(),
+			Denom:  pendingPerpetualOrder.Deposit.Denom,
+		},
+		TimeInForce:  pendingPerpetualOrder.TimeInForce.String(),
+		Expiry:       indexerOrderExpiry(pendingPerpetualOrder.Expiry),
+		TrailingStop: indexerTrailingStop(pendingPerpetualOrder.TrailingStop),
+	}, []string{msg.OwnerAddress})
+	/* End of kwak-indexer node implementation*/
+	/* *************************************************************************** */
+
+	if pendingPerpetualOrder.TimeInForce.IsImmediate() {
+		err = k.fillImmediatePerpetualOrder(ctx, pendingPerpetualOrder)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	/* *************************************************************************** */
 	/* Start of kwak-indexer node implementation*/
-	// indexer.QueueTransaction(ctx, indexerTradeshieldTypes.MsgCreatePerpetualCloseOrder{
-	// 	OwnerAddress: msg.OwnerAddress,
-	// 	TriggerPrice: indexerTradeshieldTypes.TriggerPrice{
-	// 		TradingAssetDenom: msg.TriggerPrice.TradingAssetDenom,
-	// 		Rate:             msg.TriggerPrice.Rate.String(),
-	// 	},
-	// 	PositionID: msg.PositionId,
-	// 	OrderID:    id,
-	// }, []string{msg.OwnerAddress})
+	commitOrder()
 	/* End of kwak-indexer node implementation*/
 	/* *************************************************************************** */
 
-	// return &types.MsgCreatePerpetualCloseOrderResponse{
-	// 	OrderId: id,
-	// }, nil
+	return &types.MsgCreatePerpetualCloseOrderResponse{
+		OrderId: id,
+	}, nil
+}
+
+// validateCloseOrderTriggerPrice checks that a close order doesn't execute
+// right away: limit close orders trigger on a profitable price move and stop
+// loss orders on an adverse one. IOC and FOK orders are meant to execute
+// right away and skip the check.
+func (k msgServer) validateCloseOrderTriggerPrice(ctx sdk.Context, order types.PerpetualOrder, triggerPrice sdkmath.LegacyDec) error {
+	if triggerPrice.IsNil() || !triggerPrice.IsPositive() {
+		return errorsmod.Wrap(types.ErrInvalidTriggerPrice, "trigger price must be positive")
+	}
+
+	marketPrice, err := k.perpetual.GetAssetPrice(ctx, order.TradingAsset)
+	if err != nil {
+		return err
+	}
+	if marketPrice.IsZero() {
+		return types.ErrZeroMarketPrice
+	}
+	if order.TimeInForce.IsImmediate() {
+		return nil
+	}
+
+	above := triggerPrice.GT(marketPrice)
+	switch order.PerpetualOrderType {
+	case types.PerpetualOrderType_LIMITCLOSE:
+		if order.Position == types.PerpetualPosition_LONG && !above {
+			return errorsmod.Wrapf(types.ErrInvalidTriggerPrice, "limit close price %s must be above the market price %s for long", triggerPrice, marketPrice)
+		}
+		if order.Position == types.PerpetualPosition_SHORT && !triggerPrice.LT(marketPrice) {
+			return errorsmod.Wrapf(types.ErrInvalidTriggerPrice, "limit close price %s must be below the market price %s for short", triggerPrice, marketPrice)
+		}
+	case types.PerpetualOrderType_STOPLOSSPERP:
+		if order.Position == types.PerpetualPosition_LONG && !triggerPrice.LT(marketPrice) {
+			return errorsmod.Wrapf(types.ErrInvalidTriggerPrice, "stop loss price %s must be below the market price %s for long", triggerPrice, marketPrice)
+		}
+		if order.Position == types.PerpetualPosition_SHORT && !above {
+			return errorsmod.Wrapf(types.ErrInvalidTriggerPrice, "stop loss price %s must be above the market price %s for short", triggerPrice, marketPrice)
+		}
+	default:
+		return errorsmod.Wrapf(types.ErrInvalidOrderType, "%s is not a close order type", order.PerpetualOrderType)
+	}
+
+	return nil
 }
 
 func (k msgServer) UpdatePerpetualOrder(goCtx context.Context, msg *types.MsgUpdatePerpetualOrder) (*types.MsgUpdatePerpetualOrderResponse, error) {
@@ -191,22 +355,35 @@ func (k msgServer) UpdatePerpetualOrder(goCtx context.Context, msg *types.MsgUpd
 		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
 	}
 
-	perpetualParams := k.perpetual.GetParams(ctx)
+	if order.PerpetualOrderType.IsTrailingStop() {
+		return nil, errorsmod.Wrap(types.ErrInvalidOrderType, "the trigger price of trailing stop orders follows the market price")
+	}
+
+	if order.PerpetualOrderType == types.PerpetualOrderType_LIMITOPEN {
+		perpetualParams := k.perpetual.GetParams(ctx)
 
-	ratio := order.TakeProfitPrice.Quo(msg.TriggerPrice.Rate)
-	if order.Position == types.PerpetualPosition_LONG {
-		if ratio.LT(perpetualParams.MinimumLongTakeProfitPriceRatio) || ratio.GT(perpetualParams.MaximumLongTakeProfitPriceRatio) {
-			return nil, fmt.Errorf("invalid trigger price, take profit price should be between %s and %s times of current market price for long (current ratio: %s)", perpetualParams.MinimumLongTakeProfitPriceRatio.String(), perpetualParams.MaximumLongTakeProfitPriceRatio.String(), ratio.String())
+		ratio := order.TakeProfitPrice.Quo(msg.TriggerPrice.Rate)
+		if order.Position == types.PerpetualPosition_LONG {
+			if ratio.LT(perpetualParams.MinimumLongTakeProfitPriceRatio) || ratio.GT(perpetualParams.MaximumLongTakeProfitPriceRatio) {
+				return nil, fmt.Errorf("invalid trigger price, take profit price should be between %s and %s times of current market price for long (current ratio: %s)", perpetualParams.MinimumLongTakeProfitPriceRatio.String(), perpetualParams.MaximumLongTakeProfitPriceRatio.String(), ratio.String())
+			}
 		}
-	}
-	if order.Position == types.PerpetualPosition_SHORT {
-		if ratio.GT(perpetualParams.MaximumShortTakeProfitPriceRatio) {
-			return nil, fmt.Errorf("invalid trigger price, take profit price should be less than %s times of current market price for short (current ratio: %s)", perpetualParams.MaximumShortTakeProfitPriceRatio.String(), ratio.String())
+		if order.Position == types.PerpetualPosition_SHORT {
+			if ratio.GT(perpetualParams.MaximumShortTakeProfitPriceRatio) {
+				return nil, fmt.Errorf("invalid trigger price, take profit price should be less than %s times of current market price for short (current ratio: %s)", perpetualParams.MaximumShortTakeProfitPriceRatio.String(), ratio.String())
+			}
+		}
+
+		// update the order
+		order.TriggerPrice = msg.TriggerPrice
+	} else {
+		if err := k.validateCloseOrderTriggerPrice(ctx, order, msg.TriggerPrice.Rate); err != nil {
+			return nil, err
 		}
-	}
 
-	// update the order
-	order.TriggerPrice = msg.TriggerPrice
+		// the trigger price of close orders stays in the trading asset of the position
+		order.TriggerPrice.Rate = msg.TriggerPrice.Rate
+	}
 	k.SetPendingPerpetualOrder(ctx, order)
 
 	/* *************************************************************************** */
@@ -239,14 +416,13 @@ func (k msgServer) CancelPerpetualOrder(goCtx context.Context, msg *types.MsgCan
 		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
 	}
 
-	// send the collateral amount back to the owner
-	ownerAddress := sdk.MustAccAddressFromBech32(order.OwnerAddress)
-	err := k.Keeper.bank.SendCoins(ctx, order.GetOrderAddress(), ownerAddress, sdk.NewCoins(order.Collateral))
+	// send the collateral amount and the deposits back to the owner
+	deposit := k.perpetualOrderDeposit(ctx, order)
+	err := k.RefundPerpetualOrder(ctx, order)
 	if err != nil {
 		return nil, err
 	}
 
-	k.RemovePendingPerpetualOrder(ctx, msg.OrderId)
 	types.EmitCancelPerpetualOrderEvent(ctx, order)
 
 	/* *************************************************************************** */
@@ -258,6 +434,7 @@ func (k msgServer) CancelPerpetualOrder(goCtx context.Context, msg *types.MsgCan
 			Amount: order.Collateral.Amount.String(),
 			Denom:  order.Collateral.Denom,
 		},
+		Deposit: indexerToken(deposit),
 	}, []string{msg.OwnerAddress})
 	/* End of kwak-indexer node implementation*/
 	/* *************************************************************************** */
@@ -8,7 +8,6 @@ import (
 	"github.com/elys-network/elys/x/tradeshield/types"
 )
 
-// TODO: Add test for CreatePerpetualCloseOrder after enabling the code
 func (suite *TradeshieldKeeperTestSuite) TestMsgServerPerpetualOpenOrder() {
 	addr := suite.AddAccounts(3, nil)
 
@@ -147,6 +146,155 @@ func (suite *TradeshieldKeeperTestSuite) TestMsgServerPerpetualOpenOrder() {
 	}
 }
 
+func (suite *TradeshieldKeeperTestSuite) TestMsgServerPerpetualCloseOrder() {
+	addr := suite.AddAccounts(3, nil)
+	_, _, _ = suite.SetPerpetualPool(1)
+	positionId := suite.openPerpetualPosition(addr[0])
+
+	closeOrderMsg := func(orderType types.PerpetualOrderType, rate math.LegacyDec) *types.MsgCreatePerpetualCloseOrder {
+		return &types.MsgCreatePerpetualCloseOrder{
+			OwnerAddress: addr[0].String(),
+			TriggerPrice: types.TriggerPrice{
+				TradingAssetDenom: "uatom",
+				Rate:              rate,
+			},
+			PositionId:  positionId,
+			OrderType:   orderType,
+			CloseAmount: math.ZeroInt(),
+		}
+	}
+
+	testCases := []struct {
+		name                 string
+		expectErrMsg         string
+		prerequisiteFunction func() *types.MsgCreatePerpetualCloseOrder
+	}{
+		{
+			"Position not found",
+			"position 100 not found",
+			func() *types.MsgCreatePerpetualCloseOrder {
+				msg := closeOrderMsg(types.PerpetualOrderType_LIMITCLOSE, math.LegacyNewDec(10))
+				msg.PositionId = 100
+				return msg
+			},
+		},
+		{
+			"Trigger denom differs from the trading asset",
+			"trigger price denom",
+			func() *types.MsgCreatePerpetualCloseOrder {
+				msg := closeOrderMsg(types.PerpetualOrderType_LIMITCLOSE, math.LegacyNewDec(10))
+				msg.TriggerPrice.TradingAssetDenom = "uusdc"
+				return msg
+			},
+		},
+		{
+			"Limit close of a long below the market price",
+			"invalid trigger price",
+			func() *types.MsgCreatePerpetualCloseOrder {
+				return closeOrderMsg(types.PerpetualOrderType_LIMITCLOSE, math.LegacyNewDec(4))
+			},
+		},
+		{
+			"Stop loss of a long above the market price",
+			"invalid trigger price",
+			func() *types.MsgCreatePerpetualCloseOrder {
+				return closeOrderMsg(types.PerpetualOrderType_STOPLOSSPERP, math.LegacyNewDec(6))
+			},
+		},
+		{
+			"Success: Create Perpetual Limit Close Order",
+			"",
+			func() *types.MsgCreatePerpetualCloseOrder {
+				return closeOrderMsg(types.PerpetualOrderType_LIMITCLOSE, math.LegacyNewDec(10))
+			},
+		},
+		{
+			"Limit close order already pending for the position", // From above test case
+			"user already has a LIMITCLOSE order for position",
+			func() *types.MsgCreatePerpetualCloseOrder {
+				return closeOrderMsg(types.PerpetualOrderType_LIMITCLOSE, math.LegacyNewDec(12))
+			},
+		},
+		{
+			"Success: Create Perpetual Stop Loss Order",
+			"",
+			func() *types.MsgCreatePerpetualCloseOrder {
+				return closeOrderMsg(types.PerpetualOrderType_STOPLOSSPERP, math.LegacyNewDec(4))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			msg := tc.prerequisiteFunction()
+			balanceBefore := suite.app.BankKeeper.GetBalance(suite.ctx, sdk.MustAccAddressFromBech32(msg.OwnerAddress), "uatom")
+			msgSrvr := keeper.NewMsgServerImpl(suite.app.TradeshieldKeeper)
+			res, err := msgSrvr.CreatePerpetualCloseOrder(suite.ctx, msg)
+			balanceAfter := suite.app.BankKeeper.GetBalance(suite.ctx, sdk.MustAccAddressFromBech32(msg.OwnerAddress), "uatom")
+			if tc.expectErrMsg != "" {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.expectErrMsg)
+			} else {
+				suite.Require().NoError(err)
+				order, found := suite.app.TradeshieldKeeper.GetPendingPerpetualOrder(suite.ctx, res.OrderId)
+				suite.Require().True(found)
+				suite.Require().Equal(msg.OrderType, order.PerpetualOrderType)
+				suite.Require().Equal(positionId, order.PositionId)
+				// Close orders do not lock collateral
+				suite.Require().Equal(balanceBefore, balanceAfter)
+			}
+		})
+	}
+
+	msgSrvr := keeper.NewMsgServerImpl(suite.app.TradeshieldKeeper)
+
+	// Updating a close order validates the trigger against the order type
+	_, err := msgSrvr.UpdatePerpetualOrder(suite.ctx, &types.MsgUpdatePerpetualOrder{
+		OwnerAddress: addr[0].String(),
+		OrderId:      1,
+		TriggerPrice: types.TriggerPrice{TradingAssetDenom: "uatom", Rate: math.LegacyNewDec(3)},
+	})
+	suite.Require().ErrorIs(err, types.ErrInvalidTriggerPrice)
+	_, err = msgSrvr.UpdatePerpetualOrder(suite.ctx, &types.MsgUpdatePerpetualOrder{
+		OwnerAddress: addr[0].String(),
+		OrderId:      1,
+		TriggerPrice: types.TriggerPrice{TradingAssetDenom: "uatom", Rate: math.LegacyNewDec(8)},
+	})
+	suite.Require().NoError(err)
+	order, found := suite.app.TradeshieldKeeper.GetPendingPerpetualOrder(suite.ctx, 1)
+	suite.Require().True(found)
+	suite.Require().Equal(math.LegacyNewDec(8), order.TriggerPrice.Rate)
+
+	// Cancelling a close order refunds nothing
+	balanceBefore := suite.app.BankKeeper.GetBalance(suite.ctx, addr[0], "uatom")
+	_, err = msgSrvr.CancelPerpetualOrder(suite.ctx, &types.MsgCancelPerpetualOrder{
+		OwnerAddress: addr[0].String(),
+		OrderId:      1,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(balanceBefore, suite.app.BankKeeper.GetBalance(suite.ctx, addr[0], "uatom"))
+	_, found = suite.app.TradeshieldKeeper.GetPendingPerpetualOrder(suite.ctx, 1)
+	suite.Require().False(found)
+
+	// The stop loss executes once the market drops below the trigger
+	suite.app.OracleKeeper.SetPrice(suite.ctx, oracletypes.Price{
+		Asset:     "ATOM",
+		Price:     math.LegacyMustNewDecFromStr("3.5"),
+		Source:    "elys",
+		Provider:  oracleProvider.String(),
+		Timestamp: uint64(suite.ctx.BlockTime().Unix()),
+	})
+	_, err = msgSrvr.ExecuteOrders(suite.ctx, &types.MsgExecuteOrders{
+		Creator:           addr[1].String(),
+		PerpetualOrderIds: []uint64{2},
+	})
+	suite.Require().NoError(err)
+	_, found = suite.app.TradeshieldKeeper.GetPendingPerpetualOrder(suite.ctx, 2)
+	suite.Require().False(found)
+	_, err = suite.app.PerpetualKeeper.GetMTP(suite.ctx, addr[0], positionId)
+	suite.Require().Error(err)
+}
+
 func (suite *TradeshieldKeeperTestSuite) TestMsgServerUpdatePerpetualOrder() {
 	addr := suite.AddAccounts(3, nil)
 
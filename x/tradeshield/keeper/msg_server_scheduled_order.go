@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"context"
+
+	/* *************************************************************************** */
+	/* Start of kwak-indexer node implementation*/
+	indexer "github.com/elys-network/elys/indexer"
+	indexerTradeshieldTypes "github.com/elys-network/elys/indexer/txs/tradeshield"
+
+	/* End of kwak-indexer node implementation*/
+	/* *************************************************************************** */
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/elys-network/elys/x/tradeshield/types"
+)
+
+func (k msgServer) CreateScheduledOrder(goCtx context.Context, msg *types.MsgCreateScheduledOrder) (*types.MsgCreateScheduledOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	order, err := k.Keeper.CreateScheduledOrder(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	/* *************************************************************************** */
+	/* Start of kwak-indexer node implementation*/
+	indexer.QueueTransaction(ctx, indexerTradeshieldTypes.MsgCreateScheduledOrder{
+		OwnerAddress: msg.OwnerAddress,
+		OrderID:      order.Id,
+		Amount:       indexerToken(msg.Amount),
+		TargetDenom:  msg.TargetDenom,
+		SliceCount:   msg.SliceCount,
+		Interval:     msg.Interval,
+		IntervalUnit: msg.IntervalUnit.String(),
+		MaxSlippage:  msg.MaxSlippage.String(),
+	}, []string{msg.OwnerAddress})
+	/* End of kwak-indexer node implementation*/
+	/* *************************************************************************** */
+
+	return &types.MsgCreateScheduledOrderResponse{
+		OrderId: order.Id,
+	}, nil
+}
+
+func (k msgServer) CancelScheduledOrder(goCtx context.Context, msg *types.MsgCancelScheduledOrder) (*types.MsgCancelScheduledOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	order, found := k.GetScheduledOrder(ctx, msg.OrderId)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrScheduledOrderNotFound, "order %d", msg.OrderId)
+	}
+
+	if order.OwnerAddress != msg.OwnerAddress {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
+	}
+
+	// send the amount left to swap back to the owner
+	refund, err := k.CloseScheduledOrder(ctx, order)
+	if err != nil {
+		return nil, err
+	}
+
+	/* *************************************************************************** */
+	/* Start of kwak-indexer node implementation*/
+	indexer.QueueTransaction(ctx, indexerTradeshieldTypes.MsgCancelScheduledOrder{
+		OwnerAddress:   msg.OwnerAddress,
+		OrderID:        msg.OrderId,
+		ExecutedSlices: order.ExecutedSlices,
+		Refund:         indexerToken(refund),
+	}, []string{msg.OwnerAddress})
+	/* End of kwak-indexer node implementation*/
+	/* *************************************************************************** */
+
+	return &types.MsgCancelScheduledOrderResponse{}, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 
 	/* *************************************************************************** */
 	/* Start of kwak-indexer node implementation*/
@@ -22,6 +23,10 @@ import (
 func (k msgServer) CreateSpotOrder(goCtx context.Context, msg *types.MsgCreateSpotOrder) (*types.MsgCreateSpotOrderResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	if err := validateOrderExpiry(ctx, msg.TimeInForce, msg.Expiry); err != nil {
+		return nil, err
+	}
+
 	var pendingSpotOrder = types.SpotOrder{
 		OrderType:        msg.OrderType,
 		OrderId:          uint64(0),
@@ -30,6 +35,17 @@ func (k msgServer) CreateSpotOrder(goCtx context.Context, msg *types.MsgCreateSp
 		OwnerAddress:     msg.OwnerAddress,
 		OrderTargetDenom: msg.OrderTargetDenom,
 		Date:             &types.Date{Height: uint64(ctx.BlockHeight()), Timestamp: uint64(ctx.BlockTime().Unix())},
+		Deposit:          k.NewOrderDeposit(ctx),
+		TimeInForce:      msg.TimeInForce,
+		Expiry:           orderExpiry(msg.TimeInForce, msg.Expiry),
+		FilledAmount:     sdk.NewCoin(msg.OrderAmount.Denom, sdkmath.ZeroInt()),
+	}
+
+	// the stop price of a trailing stop starts from the market price
+	if msg.OrderType.IsTrailingStop() {
+		if err := k.startSpotTrailingStop(ctx, &pendingSpotOrder, msg.TrailingStop); err != nil {
+			return nil, err
+		}
 	}
 
 	// if the order is market buy, execute it immediately
@@ -57,8 +73,17 @@ func (k msgServer) CreateSpotOrder(goCtx context.Context, msg *types.MsgCreateSp
 		return nil, err
 	}
 
+	// escrow the deposit paid to the executor of the order
+	err = k.EscrowOrderDeposit(ctx, ownerAddress, pendingSpotOrder.GetOrderAddress(), pendingSpotOrder.Deposit)
+	if err != nil {
+		return nil, err
+	}
+
 	/* *************************************************************************** */
 	/* Start of kwak-indexer node implementation*/
+	// The order is only indexed once its immediate fill succeeded, a fill or
+	// kill order that cannot be filled leaves no record
+	ctx, commitOrder := indexer.DeferIndexing(ctx, nil)
 	indexer.QueueTransaction(ctx, indexerTradeShieldTypes.MsgCreateSpotOrder{
 		BaseOrder: common.BaseOrder{
 			OrderID:      id,
@@ -66,7 +91,7 @@ func (k msgServer) CreateSpotOrder(goCtx context.Context, msg *types.MsgCreateSp
 			OrderPrice: common.OrderPrice{
 				BaseDenom:  msg.OrderPrice.BaseDenom,
 				QuoteDenom: msg.OrderPrice.QuoteDenom,
-				Rate:       msg.OrderPrice.Rate.String(),
+				Rate:       pendingSpotOrder.OrderPrice.Rate.String(),
 			},
 			OrderAmount: indexerTypes.Token{
 				Amount: msg.OrderAmount.Amount.String(),
@@ -75,10 +100,30 @@ func (k msgServer) CreateSpotOrder(goCtx context.Context, msg *types.MsgCreateSp
 		},
 		OrderType:        common.OrderType(msg.OrderType),
 		OrderTargetDenom: msg.OrderTargetDenom,
+		Deposit: indexerTypes.Token{
+			Amount: pendingSpotOrder.Deposit.Amount.String(),
+			Denom:  pendingSpotOrder.Deposit.Denom,
+		},
+		TimeInForce:  pendingSpotOrder.TimeInForce.String(),
+		Expiry:       indexerOrderExpiry(pendingSpotOrder.Expiry),
+		TrailingStop: indexerTrailingStop(pendingSpotOrder.TrailingStop),
 	}, []string{msg.OwnerAddress})
 	/* End of kwak-indexer node implementation*/
 	/* *************************************************************************** */
 
+	if pendingSpotOrder.TimeInForce.IsImmediate() {
+		err = k.fillImmediateSpotOrder(ctx, pendingSpotOrder)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	/* *************************************************************************** */
+	/* Start of kwak-indexer node implementation*/
+	commitOrder()
+	/* End of kwak-indexer node implementation*/
+	/* *************************************************************************** */
+
 	// return the order id
 	return &types.MsgCreateSpotOrderResponse{
 		OrderId: pendingSpotOrder.OrderId,
@@ -99,6 +144,10 @@ func (k msgServer) UpdateSpotOrder(goCtx context.Context, msg *types.MsgUpdateSp
 		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
 	}
 
+	if order.OrderType.IsTrailingStop() {
+		return nil, errorsmod.Wrap(types.ErrInvalidOrderType, "the rate of trailing stop orders follows the market price")
+	}
+
 	// update the order
 	order.OrderPrice = msg.OrderPrice
 	k.SetPendingSpotOrder(ctx, order)
@@ -133,14 +182,12 @@ func (k msgServer) CancelSpotOrder(goCtx context.Context, msg *types.MsgCancelSp
 		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
 	}
 
-	// send the order amount back to the owner
-	ownerAddress := sdk.MustAccAddressFromBech32(spotOrder.OwnerAddress)
-	err := k.Keeper.bank.SendCoins(ctx, spotOrder.GetOrderAddress(), ownerAddress, sdk.NewCoins(spotOrder.OrderAmount))
+	// send the order amount and the deposit back to the owner
+	err := k.RefundSpotOrder(ctx, spotOrder)
 	if err != nil {
 		return nil, err
 	}
 
-	k.RemovePendingSpotOrder(ctx, msg.OrderId)
 	types.EmitCloseSpotOrderEvent(ctx, spotOrder)
 
 	/* *************************************************************************** */
@@ -148,6 +195,14 @@ func (k msgServer) CancelSpotOrder(goCtx context.Context, msg *types.MsgCancelSp
 	indexer.QueueTransaction(ctx, indexerTradeShieldTypes.MsgCancelSpotOrder{
 		OwnerAddress: msg.OwnerAddress,
 		OrderId:      msg.OrderId,
+		OrderAmount: indexerTypes.Token{
+			Amount: spotOrder.OrderAmount.Amount.String(),
+			Denom:  spotOrder.OrderAmount.Denom,
+		},
+		Deposit: indexerTypes.Token{
+			Amount: spotOrder.Deposit.Amount.String(),
+			Denom:  spotOrder.Deposit.Denom,
+		},
 	}, []string{msg.OwnerAddress})
 	/* End of kwak-indexer node implementation*/
 	/* *************************************************************************** */
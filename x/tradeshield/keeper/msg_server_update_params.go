@@ -25,6 +25,12 @@ func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParam
 		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
 	}
 
+	/* *************************************************************************** */
+	/* Start of kwak-indexer node implementation*/
+	previousParams := k.GetParams(ctx)
+	/* End of kwak-indexer node implementation*/
+	/* *************************************************************************** */
+
 	// store params
 	if err := k.SetParams(ctx, msg.Params); err != nil {
 		return nil, err
@@ -33,23 +39,33 @@ func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParam
 	/* *************************************************************************** */
 	/* Start of kwak-indexer node implementation*/
 	indexer.QueueTransaction(ctx, indexerTradeShieldTypes.MsgUpdateParams{
-		Authority: msg.Authority,
-		Params: commonTradeShieldIndxer.Params{
-			MarketOrderEnabled:   msg.Params.MarketOrderEnabled,
-			StakeEnabled:         msg.Params.StakeEnabled,
-			ProcessOrdersEnabled: msg.Params.ProcessOrdersEnabled,
-			SwapEnabled:          msg.Params.SwapEnabled,
-			PerpetualEnabled:     msg.Params.PerpetualEnabled,
-			RewardEnabled:        msg.Params.RewardEnabled,
-			LeverageEnabled:      msg.Params.LeverageEnabled,
-			LimitProcessOrder:    msg.Params.LimitProcessOrder,
-			RewardPercentage:     msg.Params.RewardPercentage.String(),
-			MarginError:          msg.Params.MarginError.String(),
-			MinimumDeposit:       msg.Params.MinimumDeposit.String(),
-		},
+		Authority:      msg.Authority,
+		Params:         indexerParams(*msg.Params),
+		PreviousParams: indexerParams(previousParams),
 	}, []string{msg.Authority})
 	/* End of kwak-indexer node implementation*/
 	/* *************************************************************************** */
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+/* *************************************************************************** */
+/* Start of kwak-indexer node implementation*/
+func indexerParams(params types.Params) commonTradeShieldIndxer.Params {
+	return commonTradeShieldIndxer.Params{
+		MarketOrderEnabled:   params.MarketOrderEnabled,
+		StakeEnabled:         params.StakeEnabled,
+		ProcessOrdersEnabled: params.ProcessOrdersEnabled,
+		SwapEnabled:          params.SwapEnabled,
+		PerpetualEnabled:     params.PerpetualEnabled,
+		RewardEnabled:        params.RewardEnabled,
+		LeverageEnabled:      params.LeverageEnabled,
+		LimitProcessOrder:    params.LimitProcessOrder,
+		RewardPercentage:     params.RewardPercentage.String(),
+		MarginError:          params.MarginError.String(),
+		MinimumDeposit:       params.MinimumDeposit.String(),
+	}
+}
+
+/* End of kwak-indexer node implementation*/
+/* *************************************************************************** */
@@ -0,0 +1,184 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/elys-network/elys/x/tradeshield/types"
+)
+
+// orderBookBucket returns the lower bound of the price bucket of rate, rate
+// itself when bucketSize is not positive
+func orderBookBucket(rate, bucketSize sdkmath.LegacyDec) sdkmath.LegacyDec {
+	rate = sortRate(rate)
+	if bucketSize.IsNil() || !bucketSize.IsPositive() {
+		return rate
+	}
+	return rate.Quo(bucketSize).TruncateDec().Mul(bucketSize)
+}
+
+// addToOrderBook adds an order to the last level of levels, or to a new
+// level when the order falls in another bucket. Orders are walked in sorted
+// order so that the orders of a bucket are always consecutive.
+func addToOrderBook(levels []types.OrderBookLevel, price sdkmath.LegacyDec, amount sdk.Coin) []types.OrderBookLevel {
+	if n := len(levels); n > 0 && levels[n-1].Price.Equal(price) {
+		levels[n-1].OrderCount++
+		levels[n-1].Amount = levels[n-1].Amount.Add(amount)
+		return levels
+	}
+	return append(levels, types.OrderBookLevel{
+		Price:      price,
+		OrderCount: 1,
+		Amount:     sdk.NewCoins(amount),
+	})
+}
+
+// buildOrderBook walks count sorted orders, from the last one when descending,
+// and aggregates them by price bucket. Orders are read only until maxLevels
+// levels are complete, more then reports that other levels follow. All the
+// levels are built when maxLevels is zero.
+func buildOrderBook(count int, descending bool, maxLevels uint64, order func(i int) (sdkmath.LegacyDec, sdk.Coin, bool)) (levels []types.OrderBookLevel, more bool) {
+	for i := 0; i < count; i++ {
+		index := i
+		if descending {
+			index = count - 1 - i
+		}
+		price, amount, found := order(index)
+		if !found {
+			continue
+		}
+		if n := len(levels); maxLevels > 0 && uint64(n) == maxLevels && !levels[n-1].Price.Equal(price) {
+			return levels, true
+		}
+		levels = addToOrderBook(levels, price, amount)
+	}
+	return levels, false
+}
+
+// GetSpotOrderBook aggregates the pending limit orders of a denom pair and
+// side by price bucket, the limit buys of the bids pay the quote denom. Levels are read from the sorted order list of the
+// pair and returned from the price closest to the market, or from the
+// farthest one when reverse. At most maxLevels levels are built, more reports
+// whether other levels follow, see buildOrderBook.
+func (k Keeper) GetSpotOrderBook(ctx sdk.Context, baseDenom, quoteDenom string, side types.OrderBookSide, bucketSize sdkmath.LegacyDec, reverse bool, maxLevels uint64) (levels []types.OrderBookLevel, more bool) {
+	// asks sell the base denom, bids buy it with the quote denom so that both
+	// sides are priced in the quote denom
+	orderType, paidDenom, targetDenom := types.SpotOrderType_LIMITBUY, quoteDenom, baseDenom
+	if side == types.OrderBookSide_SELL {
+		orderType, paidDenom, targetDenom = types.SpotOrderType_LIMITSELL, baseDenom, quoteDenom
+	}
+
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.SortedSpotOrderKey)
+	key := fmt.Sprintf("%s%s\n%s", types.GenSpotKeyPrefix(orderType), paidDenom, targetDenom)
+	orderIds := mustGetSortedOrderIds(store, []byte(key))
+
+	// buy orders execute below their rate, the best bid is the highest one
+	descending := spotOrderExecutesBelowRate(types.SpotOrder{OrderType: orderType})
+
+	return buildOrderBook(len(orderIds), descending != reverse, maxLevels, func(i int) (sdkmath.LegacyDec, sdk.Coin, bool) {
+		order, found := k.GetPendingSpotOrder(ctx, orderIds[i])
+		if !found {
+			return sdkmath.LegacyDec{}, sdk.Coin{}, false
+		}
+		return orderBookBucket(order.OrderPrice.Rate, bucketSize), order.OrderAmount, true
+	})
+}
+
+// GetPerpetualOrderBook aggregates the pending perpetual orders of a trading
+// asset, position and order type by trigger price bucket. Levels are read
+// from the sorted order list of the asset and returned from the trigger price
+// closest to the market, or from the farthest one when reverse. At most
+// maxLevels levels are built, more reports whether other levels follow.
+func (k Keeper) GetPerpetualOrderBook(ctx sdk.Context, tradingAsset string, position types.PerpetualPosition, orderType types.PerpetualOrderType, bucketSize sdkmath.LegacyDec, reverse bool, maxLevels uint64) (levels []types.OrderBookLevel, more bool) {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.SortedPerpetualOrderKey)
+	key := types.GenPerpKeyPrefix(position, orderType) + tradingAsset
+	orderIds := mustGetSortedOrderIds(store, []byte(key))
+
+	descending := perpetualOrderExecutesBelowRate(types.PerpetualOrder{
+		Position:           position,
+		PerpetualOrderType: orderType,
+	})
+
+	return buildOrderBook(len(orderIds), descending != reverse, maxLevels, func(i int) (sdkmath.LegacyDec, sdk.Coin, bool) {
+		order, found := k.GetPendingPerpetualOrder(ctx, orderIds[i])
+		if !found {
+			return sdkmath.LegacyDec{}, sdk.Coin{}, false
+		}
+		return orderBookBucket(order.TriggerPrice.Rate, bucketSize), order.Collateral, true
+	})
+}
+
+// orderBookPage is a page of order book levels, the offset is read from the
+// key of the request when it is set
+type orderBookPage struct {
+	offset     uint64
+	limit      uint64
+	countTotal bool
+	reverse    bool
+}
+
+// newOrderBookPage returns the page of levels requested by pageReq
+func newOrderBookPage(pageReq *query.PageRequest) (orderBookPage, error) {
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+	if len(pageReq.Key) > 0 && pageReq.Offset > 0 {
+		return orderBookPage{}, fmt.Errorf("invalid request, either offset or key is expected, got both")
+	}
+
+	offset := pageReq.Offset
+	if len(pageReq.Key) > 0 {
+		if len(pageReq.Key) != 8 {
+			return orderBookPage{}, fmt.Errorf("invalid pagination key")
+		}
+		offset = binary.BigEndian.Uint64(pageReq.Key)
+	}
+	limit := pageReq.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+
+	return orderBookPage{
+		offset:     offset,
+		limit:      limit,
+		countTotal: pageReq.CountTotal,
+		reverse:    pageReq.Reverse,
+	}, nil
+}
+
+// maxLevels returns the levels to build to serve the page, zero when every
+// level is needed to count them
+func (p orderBookPage) maxLevels() uint64 {
+	if p.countTotal || p.offset+p.limit < p.offset {
+		return 0
+	}
+	return p.offset + p.limit
+}
+
+// paginate returns the page of levels, built from the first level of the page
+// direction, the next key of the response encodes the offset of the next page
+func (p orderBookPage) paginate(levels []types.OrderBookLevel, more bool) ([]types.OrderBookLevel, *query.PageResponse) {
+	total := uint64(len(levels))
+	offset := p.offset
+	if offset > total {
+		offset = total
+	}
+	end := total
+	if p.limit < total-offset {
+		end = offset + p.limit
+	}
+
+	pageRes := &query.PageResponse{}
+	if end < total || more {
+		pageRes.NextKey = sdk.Uint64ToBigEndian(end)
+	}
+	if p.countTotal {
+		pageRes.Total = total
+	}
+	return levels[offset:end], pageRes
+}
@@ -0,0 +1,357 @@
+package keeper
+
+import (
+	"fmt"
+
+	/* *************************************************************************** */
+	/* Start of kwak-indexer node implementation*/
+	indexer "github.com/elys-network/elys/indexer"
+	indexerTradeshieldTypes "github.com/elys-network/elys/indexer/txs/tradeshield"
+	"github.com/elys-network/elys/indexer/txs/tradeshield/common"
+	indexerTypes "github.com/elys-network/elys/indexer/types"
+
+	/* End of kwak-indexer node implementation*/
+	/* *************************************************************************** */
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/elys-network/elys/x/tradeshield/types"
+)
+
+// MaxExpiredOrdersPerBlock bounds the orders expired at the end of a block,
+// the remaining ones expire in the next blocks
+const MaxExpiredOrdersPerBlock = 500
+
+// Reasons for which a pending order is cancelled by its time in force
+const (
+	ExpiryReasonExpired           = "expired"
+	ExpiryReasonImmediateOrCancel = "immediate_or_cancel"
+)
+
+func (k Keeper) orderExpiryStore(ctx sdk.Context, key []byte) prefix.Store {
+	return prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), key)
+}
+
+// setOrderExpiry queues an order in the expiry queues of its height and
+// timestamp, orders without expiry are not queued
+func (k Keeper) setOrderExpiry(ctx sdk.Context, heightKey, timeKey []byte, orderId uint64, timeInForce types.TimeInForce, expiry *types.Date) {
+	if timeInForce != types.TimeInForce_GTT || expiry == nil {
+		return
+	}
+	if expiry.Height != 0 {
+		k.orderExpiryStore(ctx, heightKey).Set(types.GetOrderExpiryKey(expiry.Height, orderId), []byte{1})
+	}
+	if expiry.Timestamp != 0 {
+		k.orderExpiryStore(ctx, timeKey).Set(types.GetOrderExpiryKey(expiry.Timestamp, orderId), []byte{1})
+	}
+}
+
+func (k Keeper) removeOrderExpiry(ctx sdk.Context, heightKey, timeKey []byte, orderId uint64, expiry *types.Date) {
+	if expiry == nil {
+		return
+	}
+	k.orderExpiryStore(ctx, heightKey).Delete(types.GetOrderExpiryKey(expiry.Height, orderId))
+	k.orderExpiryStore(ctx, timeKey).Delete(types.GetOrderExpiryKey(expiry.Timestamp, orderId))
+}
+
+// expiredOrderIds returns at most limit ids of the orders whose expiry height
+// or timestamp is reached, earliest expiries first
+func (k Keeper) expiredOrderIds(ctx sdk.Context, heightKey, timeKey []byte, limit int) []uint64 {
+	var ids []uint64
+	seen := make(map[uint64]bool)
+	queues := []struct {
+		key []byte
+		at  uint64
+	}{
+		{heightKey, uint64(ctx.BlockHeight())},
+		{timeKey, uint64(ctx.BlockTime().Unix())},
+	}
+	for _, queue := range queues {
+		iterator := k.orderExpiryStore(ctx, queue.key).Iterator(nil, types.GetUint64Bytes(queue.at+1))
+		for ; iterator.Valid() && len(ids) < limit; iterator.Next() {
+			id := types.GetUint64FromBytes(iterator.Key()[8:])
+			if !seen[id] {
+				seen[id] = true
+				ids = append(ids, id)
+			}
+		}
+		iterator.Close()
+	}
+	return ids
+}
+
+func (k Keeper) setSpotOrderExpiry(ctx sdk.Context, order types.SpotOrder) {
+	k.setOrderExpiry(ctx, types.SpotOrderExpiryHeightKey, types.SpotOrderExpiryTimeKey, order.OrderId, order.TimeInForce, order.Expiry)
+}
+
+func (k Keeper) removeSpotOrderExpiry(ctx sdk.Context, order types.SpotOrder) {
+	k.removeOrderExpiry(ctx, types.SpotOrderExpiryHeightKey, types.SpotOrderExpiryTimeKey, order.OrderId, order.Expiry)
+}
+
+func (k Keeper) setPerpetualOrderExpiry(ctx sdk.Context, order types.PerpetualOrder) {
+	k.setOrderExpiry(ctx, types.PerpetualOrderExpiryHeightKey, types.PerpetualOrderExpiryTimeKey, order.OrderId, order.TimeInForce, order.Expiry)
+}
+
+func (k Keeper) removePerpetualOrderExpiry(ctx sdk.Context, order types.PerpetualOrder) {
+	k.removeOrderExpiry(ctx, types.PerpetualOrderExpiryHeightKey, types.PerpetualOrderExpiryTimeKey, order.OrderId, order.Expiry)
+}
+
+// deleteAllOrderExpiries clears the expiry queues of the given keys
+func (k Keeper) deleteAllOrderExpiries(ctx sdk.Context, keys ...[]byte) {
+	for _, key := range keys {
+		deleteAllSortedOrders(k.orderExpiryStore(ctx, key))
+	}
+}
+
+// validateOrderExpiry checks that a new GTT order doesn't expire right away
+func validateOrderExpiry(ctx sdk.Context, timeInForce types.TimeInForce, expiry *types.Date) error {
+	if err := types.ValidateTimeInForce(timeInForce, expiry); err != nil {
+		return err
+	}
+	if expiry.IsReached(uint64(ctx.BlockHeight()), uint64(ctx.BlockTime().Unix())) {
+		return errorsmod.Wrapf(types.ErrInvalidTimeInForce, "expiry %s already reached", expiry)
+	}
+	return nil
+}
+
+// orderExpiry returns the expiry stored with a new order, only GTT orders
+// keep theirs
+func orderExpiry(timeInForce types.TimeInForce, expiry *types.Date) *types.Date {
+	if timeInForce != types.TimeInForce_GTT {
+		return nil
+	}
+	return expiry
+}
+
+// checkOrderNotExpired fails for orders whose expiry is reached but that are
+// not expired yet by the end blocker
+func checkOrderNotExpired(ctx sdk.Context, orderId uint64, expiry *types.Date) error {
+	if expiry.IsReached(uint64(ctx.BlockHeight()), uint64(ctx.BlockTime().Unix())) {
+		return errorsmod.Wrapf(types.ErrOrderExpired, "order %d", orderId)
+	}
+	return nil
+}
+
+// RefundSpotOrder returns the order amount and the deposit of a spot order to
+// its owner and removes the order from the pending orders and its group
+func (k Keeper) RefundSpotOrder(ctx sdk.Context, order types.SpotOrder) error {
+	ownerAddress := sdk.MustAccAddressFromBech32(order.OwnerAddress)
+	err := k.bank.SendCoins(ctx, order.GetOrderAddress(), ownerAddress, sdk.NewCoins(order.OrderAmount))
+	if err != nil {
+		return err
+	}
+	err = k.RefundOrderDeposit(ctx, order.GetOrderAddress(), order.OwnerAddress, order.Deposit)
+	if err != nil {
+		return err
+	}
+	k.RemovePendingSpotOrder(ctx, order.OrderId)
+	return k.removeFromOrderGroup(ctx, order.GroupId, true, order.OrderId)
+}
+
+// RefundPerpetualOrder returns the collateral of a limit open order and the
+// deposit of a perpetual order to its owner and removes the order from the
+// pending orders and its group, along with the deposits of its bracket orders
+func (k Keeper) RefundPerpetualOrder(ctx sdk.Context, order types.PerpetualOrder) error {
+	// close orders don't hold any collateral
+	if order.PerpetualOrderType == types.PerpetualOrderType_LIMITOPEN {
+		ownerAddress := sdk.MustAccAddressFromBech32(order.OwnerAddress)
+		err := k.bank.SendCoins(ctx, order.GetOrderAddress(), ownerAddress, sdk.NewCoins(order.Collateral))
+		if err != nil {
+			return err
+		}
+	}
+	err := k.RefundOrderDeposit(ctx, order.GetOrderAddress(), order.OwnerAddress, order.Deposit)
+	if err != nil {
+		return err
+	}
+	k.RemovePendingPerpetualOrder(ctx, order.OrderId)
+	return k.removeFromOrderGroup(ctx, order.GroupId, false, order.OrderId)
+}
+
+// ExpireSpotOrder cancels a spot order because of its time in force, or because
+// another order of its group was executed, and refunds its owner
+func (k Keeper) ExpireSpotOrder(ctx sdk.Context, order types.SpotOrder, reason string) (indexerTradeshieldTypes.ExpiredOrder, error) {
+	if err := k.RefundSpotOrder(ctx, order); err != nil {
+		return indexerTradeshieldTypes.ExpiredOrder{}, err
+	}
+	ctx.EventManager().EmitEvent(types.NewExpireSpotOrderEvt(order, reason))
+
+	/* *************************************************************************** */
+	/* Start of kwak-indexer node implementation*/
+	return indexerTradeshieldTypes.ExpiredOrder{
+		OrderID:      order.OrderId,
+		OwnerAddress: order.OwnerAddress,
+		TimeInForce:  order.TimeInForce.String(),
+		Reason:       reason,
+		Refund:       indexerToken(order.OrderAmount),
+		Deposit:      indexerToken(order.Deposit),
+	}, nil
+	/* End of kwak-indexer node implementation*/
+	/* *************************************************************************** */
+}
+
+// ExpirePerpetualOrder cancels a perpetual order because of its time in force,
+// or because another order of its group was executed, and refunds its owner
+func (k Keeper) ExpirePerpetualOrder(ctx sdk.Context, order types.PerpetualOrder, reason string) (indexerTradeshieldTypes.ExpiredOrder, error) {
+	deposit := k.perpetualOrderDeposit(ctx, order)
+	if err := k.RefundPerpetualOrder(ctx, order); err != nil {
+		return indexerTradeshieldTypes.ExpiredOrder{}, err
+	}
+	ctx.EventManager().EmitEvent(types.NewExpirePerpetualOrderEvt(order, reason))
+
+	/* *************************************************************************** */
+	/* Start of kwak-indexer node implementation*/
+	refund := indexerTypes.Token{}
+	if order.PerpetualOrderType == types.PerpetualOrderType_LIMITOPEN {
+		refund = indexerToken(order.Collateral)
+	}
+	return indexerTradeshieldTypes.ExpiredOrder{
+		OrderID:      order.OrderId,
+		OwnerAddress: order.OwnerAddress,
+		TimeInForce:  order.TimeInForce.String(),
+		Reason:       reason,
+		Refund:       refund,
+		Deposit:      indexerToken(deposit),
+	}, nil
+	/* End of kwak-indexer node implementation*/
+	/* *************************************************************************** */
+}
+
+// ExpireOrders cancels the GTT orders whose expiry is reached, at most
+// MaxExpiredOrdersPerBlock of them. An order that cannot be refunded is left
+// pending for its owner to cancel.
+func (k Keeper) ExpireOrders(ctx sdk.Context) (spotOrders, perpetualOrders []indexerTradeshieldTypes.ExpiredOrder) {
+	limit := MaxExpiredOrdersPerBlock
+
+	for _, id := range k.expiredOrderIds(ctx, types.SpotOrderExpiryHeightKey, types.SpotOrderExpiryTimeKey, limit) {
+		order, found := k.GetPendingSpotOrder(ctx, id)
+		if !found {
+			continue
+		}
+		cacheCtx, write := ctx.CacheContext()
+		expired, err := k.ExpireSpotOrder(cacheCtx, order, ExpiryReasonExpired)
+		if err != nil {
+			ctx.Logger().Error(fmt.Sprintf("failed to expire spot order %d: %s", id, err))
+			k.removeSpotOrderExpiry(ctx, order)
+			continue
+		}
+		write()
+		spotOrders = append(spotOrders, expired)
+	}
+
+	limit -= len(spotOrders)
+	if limit <= 0 {
+		return spotOrders, perpetualOrders
+	}
+	for _, id := range k.expiredOrderIds(ctx, types.PerpetualOrderExpiryHeightKey, types.PerpetualOrderExpiryTimeKey, limit) {
+		order, found := k.GetPendingPerpetualOrder(ctx, id)
+		if !found {
+			continue
+		}
+		cacheCtx, write := ctx.CacheContext()
+		expired, err := k.ExpirePerpetualOrder(cacheCtx, order, ExpiryReasonExpired)
+		if err != nil {
+			ctx.Logger().Error(fmt.Sprintf("failed to expire perpetual order %d: %s", id, err))
+			k.removePerpetualOrderExpiry(ctx, order)
+			continue
+		}
+		write()
+		perpetualOrders = append(perpetualOrders, expired)
+	}
+
+	return spotOrders, perpetualOrders
+}
+
+// fillImmediateSpotOrder executes an IOC or FOK spot order right after its
+// creation. The part of an IOC order that cannot be executed is cancelled and
+// refunded, a FOK order that is not fully filled fails the creation.
+func (k Keeper) fillImmediateSpotOrder(ctx sdk.Context, order types.SpotOrder) error {
+	_, executed, err := k.FillSpotOrder(ctx, order, nil)
+	rest, pending := k.GetPendingSpotOrder(ctx, order.OrderId)
+	if !pending {
+		return nil
+	}
+	if order.TimeInForce == types.TimeInForce_FOK {
+		if executed {
+			return errorsmod.Wrap(types.ErrOrderNotFilled, "order only partially filled")
+		}
+		return immediateOrderError(err)
+	}
+
+	expired, err := k.ExpireSpotOrder(ctx, rest, ExpiryReasonImmediateOrCancel)
+	if err != nil {
+		return err
+	}
+
+	/* *************************************************************************** */
+	/* Start of kwak-indexer node implementation*/
+	indexer.QueueEvent(ctx, indexerTypes.ElysEventTypes.Tradeshield.OrderExpiry, indexerTradeshieldTypes.OrderExpiryEvent{
+		SpotOrders: []indexerTradeshieldTypes.ExpiredOrder{expired},
+	}, []string{order.OwnerAddress})
+	/* End of kwak-indexer node implementation*/
+	/* *************************************************************************** */
+
+	return nil
+}
+
+// fillImmediatePerpetualOrder executes an IOC or FOK perpetual order right
+// after its creation. An IOC order that cannot be executed is cancelled and
+// refunded, a FOK one fails the creation.
+func (k Keeper) fillImmediatePerpetualOrder(ctx sdk.Context, order types.PerpetualOrder) error {
+	_, executed, err := k.FillPerpetualOrder(ctx, order, nil)
+	if executed {
+		return nil
+	}
+	if order.TimeInForce == types.TimeInForce_FOK {
+		return immediateOrderError(err)
+	}
+
+	expired, err := k.ExpirePerpetualOrder(ctx, order, ExpiryReasonImmediateOrCancel)
+	if err != nil {
+		return err
+	}
+
+	/* *************************************************************************** */
+	/* Start of kwak-indexer node implementation*/
+	indexer.QueueEvent(ctx, indexerTypes.ElysEventTypes.Tradeshield.OrderExpiry, indexerTradeshieldTypes.OrderExpiryEvent{
+		PerpetualOrders: []indexerTradeshieldTypes.ExpiredOrder{expired},
+	}, []string{order.OwnerAddress})
+	/* End of kwak-indexer node implementation*/
+	/* *************************************************************************** */
+
+	return nil
+}
+
+func immediateOrderError(err error) error {
+	if err != nil {
+		return errorsmod.Wrap(types.ErrOrderNotFilled, err.Error())
+	}
+	return errorsmod.Wrap(types.ErrOrderNotFilled, "trigger price not reached")
+}
+
+/* *************************************************************************** */
+/* Start of kwak-indexer node implementation*/
+func indexerToken(coin sdk.Coin) indexerTypes.Token {
+	if coin.Amount.IsNil() {
+		return indexerTypes.Token{Denom: coin.Denom}
+	}
+	return indexerTypes.Token{
+		Amount: coin.Amount.String(),
+		Denom:  coin.Denom,
+	}
+}
+
+func indexerOrderExpiry(expiry *types.Date) *common.OrderDate {
+	if expiry == nil {
+		return nil
+	}
+	return &common.OrderDate{
+		Height:    expiry.Height,
+		Timestamp: expiry.Timestamp,
+	}
+}
+
+/* End of kwak-indexer node implementation*/
+/* *************************************************************************** */
@@ -0,0 +1,117 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ptypes "github.com/elys-network/elys/x/parameter/types"
+	keeper "github.com/elys-network/elys/x/tradeshield/keeper"
+	"github.com/elys-network/elys/x/tradeshield/types"
+)
+
+func (suite *TradeshieldKeeperTestSuite) perpetualOpenOrderMsg(owner sdk.AccAddress, triggerPrice int64, timeInForce types.TimeInForce, expiry *types.Date) *types.MsgCreatePerpetualOpenOrder {
+	return &types.MsgCreatePerpetualOpenOrder{
+		OwnerAddress: owner.String(),
+		TriggerPrice: types.TriggerPrice{
+			TradingAssetDenom: "uatom",
+			Rate:              math.LegacyNewDec(triggerPrice),
+		},
+		Collateral:      sdk.Coin{Denom: "uatom", Amount: math.NewInt(100)},
+		TradingAsset:    "uatom",
+		Position:        types.PerpetualPosition_LONG,
+		Leverage:        math.LegacyNewDec(5),
+		TakeProfitPrice: math.LegacyNewDec(15),
+		StopLossPrice:   math.LegacyZeroDec(),
+		PoolId:          1,
+		TimeInForce:     timeInForce,
+		Expiry:          expiry,
+	}
+}
+
+func (suite *TradeshieldKeeperTestSuite) TestGoodTilTimeOrderExpires() {
+	addr := suite.AddAccounts(2, nil)
+	_, _, _ = suite.SetPerpetualPool(1)
+	suite.setExecutorReward(math.LegacyZeroDec(), 1000)
+	suite.ctx = suite.ctx.WithBlockHeight(10).WithBlockTime(time.Unix(1000, 0))
+
+	ownerBalances := suite.app.BankKeeper.GetAllBalances(suite.ctx, addr[0])
+	msgSrvr := keeper.NewMsgServerImpl(suite.app.TradeshieldKeeper)
+	byHeight, err := msgSrvr.CreatePerpetualOpenOrder(suite.ctx, suite.perpetualOpenOrderMsg(addr[0], 4, types.TimeInForce_GTT, &types.Date{Height: 12}))
+	suite.Require().NoError(err)
+	byTime, err := msgSrvr.CreatePerpetualOpenOrder(suite.ctx, suite.perpetualOpenOrderMsg(addr[1], 4, types.TimeInForce_GTT, &types.Date{Timestamp: 2000}))
+	suite.Require().NoError(err)
+
+	// Neither expiry is reached
+	suite.ctx = suite.ctx.WithBlockHeight(11).WithBlockTime(time.Unix(1500, 0))
+	suite.app.TradeshieldKeeper.EndBlocker(suite.ctx)
+	suite.Require().Len(suite.app.TradeshieldKeeper.GetAllPendingPerpetualOrder(suite.ctx), 2)
+
+	suite.ctx = suite.ctx.WithBlockHeight(12)
+	suite.app.TradeshieldKeeper.EndBlocker(suite.ctx)
+	_, found := suite.app.TradeshieldKeeper.GetPendingPerpetualOrder(suite.ctx, byHeight.OrderId)
+	suite.Require().False(found)
+	_, found = suite.app.TradeshieldKeeper.GetPendingPerpetualOrder(suite.ctx, byTime.OrderId)
+	suite.Require().True(found)
+
+	suite.ctx = suite.ctx.WithBlockHeight(13).WithBlockTime(time.Unix(2000, 0))
+	suite.app.TradeshieldKeeper.EndBlocker(suite.ctx)
+	suite.Require().Empty(suite.app.TradeshieldKeeper.GetAllPendingPerpetualOrder(suite.ctx))
+
+	// The collateral and the deposits are refunded
+	for _, owner := range addr {
+		suite.Require().Equal(ownerBalances, suite.app.BankKeeper.GetAllBalances(suite.ctx, owner))
+	}
+}
+
+func (suite *TradeshieldKeeperTestSuite) TestExpiredOrderNotExecuted() {
+	addr := suite.AddAccounts(2, nil)
+	owner, executor := addr[0], addr[1]
+	_, _, _ = suite.SetPerpetualPool(1)
+	suite.ctx = suite.ctx.WithBlockHeight(10)
+
+	msgSrvr := keeper.NewMsgServerImpl(suite.app.TradeshieldKeeper)
+	res, err := msgSrvr.CreatePerpetualOpenOrder(suite.ctx, suite.perpetualOpenOrderMsg(owner, 8, types.TimeInForce_GTT, &types.Date{Height: 11}))
+	suite.Require().NoError(err)
+
+	// The order is triggered but its expiry is reached before the end blocker
+	suite.ctx = suite.ctx.WithBlockHeight(11)
+	order, _ := suite.app.TradeshieldKeeper.GetPendingPerpetualOrder(suite.ctx, res.OrderId)
+	_, executed, err := suite.app.TradeshieldKeeper.FillPerpetualOrder(suite.ctx, order, executor)
+	suite.Require().ErrorIs(err, types.ErrOrderExpired)
+	suite.Require().False(executed)
+
+	// An expiry already reached is rejected
+	_, err = msgSrvr.CreatePerpetualOpenOrder(suite.ctx, suite.perpetualOpenOrderMsg(owner, 8, types.TimeInForce_GTT, &types.Date{Height: 11}))
+	suite.Require().ErrorIs(err, types.ErrInvalidTimeInForce)
+}
+
+func (suite *TradeshieldKeeperTestSuite) TestImmediateOrders() {
+	addr := suite.AddAccounts(1, nil)
+	owner := addr[0]
+	_, _, _ = suite.SetPerpetualPool(1)
+	suite.setExecutorReward(math.LegacyZeroDec(), 1000)
+
+	ownerBalances := suite.app.BankKeeper.GetAllBalances(suite.ctx, owner)
+	msgSrvr := keeper.NewMsgServerImpl(suite.app.TradeshieldKeeper)
+
+	// Market price is 5, an untriggered IOC order is cancelled and refunded
+	_, err := msgSrvr.CreatePerpetualOpenOrder(suite.ctx, suite.perpetualOpenOrderMsg(owner, 4, types.TimeInForce_IOC, nil))
+	suite.Require().NoError(err)
+	suite.Require().Empty(suite.app.TradeshieldKeeper.GetAllPendingPerpetualOrder(suite.ctx))
+	suite.Require().Equal(ownerBalances, suite.app.BankKeeper.GetAllBalances(suite.ctx, owner))
+
+	// An untriggered FOK order fails the transaction
+	cacheCtx, _ := suite.ctx.CacheContext()
+	_, err = msgSrvr.CreatePerpetualOpenOrder(cacheCtx, suite.perpetualOpenOrderMsg(owner, 4, types.TimeInForce_FOK, nil))
+	suite.Require().ErrorIs(err, types.ErrOrderNotFilled)
+
+	// A triggered IOC order is executed right away
+	_, err = msgSrvr.CreatePerpetualOpenOrder(suite.ctx, suite.perpetualOpenOrderMsg(owner, 8, types.TimeInForce_IOC, nil))
+	suite.Require().NoError(err)
+	suite.Require().Empty(suite.app.TradeshieldKeeper.GetAllPendingPerpetualOrder(suite.ctx))
+	suite.Require().Equal(ownerBalances.Sub(sdk.NewInt64Coin(ptypes.ATOM, 100)), suite.app.BankKeeper.GetAllBalances(suite.ctx, owner))
+	mtps, _, err := suite.app.PerpetualKeeper.GetMTPsForAddressWithPagination(suite.ctx, owner, nil)
+	suite.Require().NoError(err)
+	suite.Require().Len(mtps, 1)
+}
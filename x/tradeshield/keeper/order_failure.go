@@ -0,0 +1,116 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	/* *************************************************************************** */
+	/* Start of kwak-indexer node implementation*/
+	indexerTradeshieldTypes "github.com/elys-network/elys/indexer/txs/tradeshield"
+
+	/* End of kwak-indexer node implementation*/
+	/* *************************************************************************** */
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/elys-network/elys/x/tradeshield/types"
+)
+
+// MaxOrderExecutionFailures is the number of failed executions after which the
+// end blocker cancels a pending order and refunds its owner. After a failure
+// the order is retried once a number of blocks doubling with every failure has
+// passed, so unexecutable orders stop taking the end blocker budget.
+const MaxOrderExecutionFailures = 5
+
+// ExpiryReasonExecutionFailed cancels an order that failed to execute
+// MaxOrderExecutionFailures times
+const ExpiryReasonExecutionFailed = "execution_failed"
+
+// orderFailure is the failure record of a pending order, stored as its failure
+// count followed by the height it may be retried at
+type orderFailure struct {
+	Failures    uint64
+	RetryHeight uint64
+}
+
+func (k Keeper) orderFailureStore(ctx sdk.Context, key []byte) prefix.Store {
+	return prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), key)
+}
+
+func (k Keeper) getOrderFailure(ctx sdk.Context, key []byte, orderId uint64) orderFailure {
+	bz := k.orderFailureStore(ctx, key).Get(types.GetUint64Bytes(orderId))
+	if len(bz) != 16 {
+		return orderFailure{}
+	}
+	return orderFailure{
+		Failures:    binary.BigEndian.Uint64(bz[:8]),
+		RetryHeight: binary.BigEndian.Uint64(bz[8:]),
+	}
+}
+
+// orderRetryPending reports whether a failed order waits for its retry height
+func (k Keeper) orderRetryPending(ctx sdk.Context, key []byte, orderId uint64) bool {
+	return uint64(ctx.BlockHeight()) < k.getOrderFailure(ctx, key, orderId).RetryHeight
+}
+
+// recordOrderFailure counts a failed execution of an order, schedules its retry
+// and returns its failure count
+func (k Keeper) recordOrderFailure(ctx sdk.Context, key []byte, orderId uint64) uint64 {
+	failure := k.getOrderFailure(ctx, key, orderId)
+	failure.Failures++
+	failure.RetryHeight = uint64(ctx.BlockHeight()) + 1<<failure.Failures
+
+	bz := append(types.GetUint64Bytes(failure.Failures), types.GetUint64Bytes(failure.RetryHeight)...)
+	k.orderFailureStore(ctx, key).Set(types.GetUint64Bytes(orderId), bz)
+	return failure.Failures
+}
+
+func (k Keeper) removeOrderFailure(ctx sdk.Context, key []byte, orderId uint64) {
+	k.orderFailureStore(ctx, key).Delete(types.GetUint64Bytes(orderId))
+}
+
+// failedSpotOrderDropped records a failed execution of a spot order that is
+// still pending and reports whether it must be cancelled
+func (k Keeper) failedSpotOrderDropped(ctx sdk.Context, orderId uint64) bool {
+	if _, pending := k.GetPendingSpotOrder(ctx, orderId); !pending {
+		return false
+	}
+	return k.recordOrderFailure(ctx, types.SpotOrderFailureKey, orderId) >= MaxOrderExecutionFailures
+}
+
+// failedPerpetualOrderDropped records a failed execution of a perpetual order
+// that is still pending and reports whether it must be cancelled
+func (k Keeper) failedPerpetualOrderDropped(ctx sdk.Context, orderId uint64) bool {
+	if _, pending := k.GetPendingPerpetualOrder(ctx, orderId); !pending {
+		return false
+	}
+	return k.recordOrderFailure(ctx, types.PerpetualOrderFailureKey, orderId) >= MaxOrderExecutionFailures
+}
+
+// dropSpotOrder cancels a spot order that failed to execute too many times. An
+// order that cannot be refunded is left pending for its owner to cancel.
+func (k Keeper) dropSpotOrder(ctx sdk.Context, order types.SpotOrder) (indexerTradeshieldTypes.ExpiredOrder, bool) {
+	cacheCtx, write := ctx.CacheContext()
+	expired, err := k.ExpireSpotOrder(cacheCtx, order, ExpiryReasonExecutionFailed)
+	if err != nil {
+		ctx.Logger().Error(fmt.Sprintf("failed to cancel spot order %d: %s", order.OrderId, err))
+		return expired, false
+	}
+	write()
+	return expired, true
+}
+
+// dropPerpetualOrder cancels a perpetual order that failed to execute too many
+// times. An order that cannot be refunded is left pending for its owner to
+// cancel.
+func (k Keeper) dropPerpetualOrder(ctx sdk.Context, order types.PerpetualOrder) (indexerTradeshieldTypes.ExpiredOrder, bool) {
+	cacheCtx, write := ctx.CacheContext()
+	expired, err := k.ExpirePerpetualOrder(cacheCtx, order, ExpiryReasonExecutionFailed)
+	if err != nil {
+		ctx.Logger().Error(fmt.Sprintf("failed to cancel perpetual order %d: %s", order.OrderId, err))
+		return expired, false
+	}
+	write()
+	return expired, true
+}
@@ -0,0 +1,450 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"slices"
+
+	/* *************************************************************************** */
+	/* Start of kwak-indexer node implementation*/
+	indexer "github.com/elys-network/elys/indexer"
+	indexerTradeshieldTypes "github.com/elys-network/elys/indexer/txs/tradeshield"
+	"github.com/elys-network/elys/indexer/txs/tradeshield/common"
+	indexerTypes "github.com/elys-network/elys/indexer/types"
+
+	/* End of kwak-indexer node implementation*/
+	/* *************************************************************************** */
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/elys-network/elys/x/tradeshield/types"
+)
+
+// ExpiryReasonOneCancelsOther is the reason of the orders cancelled because
+// another order of their group was executed
+const ExpiryReasonOneCancelsOther = "one_cancels_other"
+
+// GetOrderGroupCount get the total number of orderGroup
+func (k Keeper) GetOrderGroupCount(ctx sdk.Context) uint64 {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), []byte{})
+	bz := store.Get(types.OrderGroupCountKey)
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetOrderGroupCount set the total number of orderGroup
+func (k Keeper) SetOrderGroupCount(ctx sdk.Context, count uint64) {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), []byte{})
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, count)
+	store.Set(types.OrderGroupCountKey, bz)
+}
+
+// AppendOrderGroup appends an orderGroup in the store with a new id and update the count
+func (k Keeper) AppendOrderGroup(ctx sdk.Context, group types.OrderGroup) uint64 {
+	count := k.GetOrderGroupCount(ctx)
+	if count == 0 {
+		count = 1
+	}
+
+	group.Id = count
+	k.SetOrderGroup(ctx, group)
+	k.SetOrderGroupCount(ctx, count+1)
+
+	return count
+}
+
+// SetOrderGroup set a specific orderGroup in the store
+func (k Keeper) SetOrderGroup(ctx sdk.Context, group types.OrderGroup) {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.OrderGroupKey)
+	store.Set(types.GetUint64Bytes(group.Id), k.cdc.MustMarshal(&group))
+}
+
+// GetOrderGroup returns an orderGroup from its id
+func (k Keeper) GetOrderGroup(ctx sdk.Context, id uint64) (val types.OrderGroup, found bool) {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.OrderGroupKey)
+	b := store.Get(types.GetUint64Bytes(id))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveOrderGroup removes an orderGroup from the store
+func (k Keeper) RemoveOrderGroup(ctx sdk.Context, id uint64) {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.OrderGroupKey)
+	store.Delete(types.GetUint64Bytes(id))
+}
+
+// GetAllOrderGroup returns all orderGroup
+func (k Keeper) GetAllOrderGroup(ctx sdk.Context) (list []types.OrderGroup) {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.OrderGroupKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.OrderGroup
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// CreateOrderGroup groups pending orders of an owner, the orders cannot be in
+// another group already
+func (k Keeper) CreateOrderGroup(ctx sdk.Context, owner string, spotOrderIds, perpetualOrderIds []uint64) (types.OrderGroup, error) {
+	var spotOrders []types.SpotOrder
+	for _, id := range spotOrderIds {
+		order, found := k.GetPendingSpotOrder(ctx, id)
+		if !found {
+			return types.OrderGroup{}, errorsmod.Wrapf(types.ErrSpotOrderNotFound, "order %d", id)
+		}
+		if order.OwnerAddress != owner {
+			return types.OrderGroup{}, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "incorrect owner of spot order %d", id)
+		}
+		if order.GroupId != 0 {
+			return types.OrderGroup{}, errorsmod.Wrapf(types.ErrInvalidOrderGroup, "spot order %d is already in group %d", id, order.GroupId)
+		}
+		spotOrders = append(spotOrders, order)
+	}
+
+	var perpetualOrders []types.PerpetualOrder
+	for _, id := range perpetualOrderIds {
+		order, found := k.GetPendingPerpetualOrder(ctx, id)
+		if !found {
+			return types.OrderGroup{}, errorsmod.Wrapf(types.ErrPerpetualOrderNotFound, "order %d", id)
+		}
+		if order.OwnerAddress != owner {
+			return types.OrderGroup{}, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "incorrect owner of perpetual order %d", id)
+		}
+		if order.GroupId != 0 {
+			return types.OrderGroup{}, errorsmod.Wrapf(types.ErrInvalidOrderGroup, "perpetual order %d is already in group %d", id, order.GroupId)
+		}
+		perpetualOrders = append(perpetualOrders, order)
+	}
+
+	group := types.OrderGroup{
+		OwnerAddress:      owner,
+		SpotOrderIds:      spotOrderIds,
+		PerpetualOrderIds: perpetualOrderIds,
+	}
+	group.Id = k.AppendOrderGroup(ctx, group)
+
+	for _, order := range spotOrders {
+		order.GroupId = group.Id
+		k.SetPendingSpotOrder(ctx, order)
+	}
+	for _, order := range perpetualOrders {
+		order.GroupId = group.Id
+		k.SetPendingPerpetualOrder(ctx, order)
+	}
+
+	ctx.EventManager().EmitEvent(types.NewCreateOrderGroupEvt(group))
+	return group, nil
+}
+
+// CreateBracketOrderGroup groups a limit open order with the close orders
+// created once it opens its position. The deposits of the close orders are
+// escrowed on the open order.
+func (k Keeper) CreateBracketOrderGroup(ctx sdk.Context, parent *types.PerpetualOrder, brackets []types.BracketOrder) error {
+	group := types.OrderGroup{
+		OwnerAddress:      parent.OwnerAddress,
+		PerpetualOrderIds: []uint64{parent.OrderId},
+		ParentOrderId:     parent.OrderId,
+	}
+	for _, bracket := range brackets {
+		if bracket.CloseAmount.IsNil() {
+			bracket.CloseAmount = sdkmath.ZeroInt()
+		}
+		bracket.Deposit = k.NewOrderDeposit(ctx)
+		group.BracketOrders = append(group.BracketOrders, bracket)
+	}
+	group.Id = k.AppendOrderGroup(ctx, group)
+
+	err := k.bank.SendCoins(ctx, sdk.MustAccAddressFromBech32(parent.OwnerAddress), parent.GetOrderAddress(), group.BracketDeposits())
+	if err != nil {
+		return err
+	}
+
+	parent.GroupId = group.Id
+	k.SetPendingPerpetualOrder(ctx, *parent)
+
+	ctx.EventManager().EmitEvent(types.NewCreateOrderGroupEvt(group))
+	return nil
+}
+
+// activateBracketOrders creates the close orders of a bracket once its limit
+// open order opened the position, they are then managed as a one-cancels-other
+// group
+func (k Keeper) activateBracketOrders(ctx sdk.Context, parent types.PerpetualOrder, positionId uint64) error {
+	group, found := k.GetOrderGroup(ctx, parent.GroupId)
+	if !found || group.ParentOrderId != parent.OrderId {
+		return nil
+	}
+
+	// a single close order needs no group
+	groupId := group.Id
+	if len(group.BracketOrders) < 2 {
+		groupId = 0
+	}
+
+	var orders []types.PerpetualOrder
+	for _, bracket := range group.BracketOrders {
+		order := types.PerpetualOrder{
+			PerpetualOrderType: bracket.OrderType,
+			TriggerPrice: types.TriggerPrice{
+				TradingAssetDenom: parent.TradingAsset,
+				Rate:              bracket.TriggerPrice,
+			},
+			Collateral:      sdk.NewCoin(parent.Collateral.Denom, sdkmath.ZeroInt()),
+			OwnerAddress:    parent.OwnerAddress,
+			TradingAsset:    parent.TradingAsset,
+			Position:        parent.Position,
+			Leverage:        sdkmath.LegacyZeroDec(),
+			TakeProfitPrice: sdkmath.LegacyZeroDec(),
+			StopLossPrice:   sdkmath.LegacyZeroDec(),
+			PoolId:          parent.PoolId,
+			PositionId:      positionId,
+			Status:          types.Status_PENDING,
+			CloseAmount:     bracket.CloseAmount,
+			Deposit:         bracket.Deposit,
+			GroupId:         groupId,
+		}
+		order.OrderId = k.AppendPendingPerpetualOrder(ctx, order)
+
+		deposit := depositCoins(order.Deposit)
+		if !deposit.IsZero() {
+			err := k.bank.SendCoins(ctx, parent.GetOrderAddress(), order.GetOrderAddress(), deposit)
+			if err != nil {
+				return err
+			}
+		}
+		orders = append(orders, order)
+	}
+
+	group.ParentOrderId = 0
+	group.BracketOrders = nil
+	group.PerpetualOrderIds = nil
+	for _, order := range orders {
+		group.PerpetualOrderIds = append(group.PerpetualOrderIds, order.OrderId)
+	}
+	if groupId == 0 {
+		k.RemoveOrderGroup(ctx, group.Id)
+	} else {
+		k.SetOrderGroup(ctx, group)
+	}
+
+	ctx.EventManager().EmitEvent(types.NewActivateBracketOrdersEvt(group, parent.OrderId, positionId))
+
+	/* *************************************************************************** */
+	/* Start of kwak-indexer node implementation*/
+	var activated []indexerTradeshieldTypes.BracketCloseOrder
+	for _, order := range orders {
+		activated = append(activated, indexerTradeshieldTypes.BracketCloseOrder{
+			OrderID:      order.OrderId,
+			BracketOrder: indexerBracketOrder(order.PerpetualOrderType, order.TriggerPrice.Rate, order.CloseAmount, order.Deposit),
+		})
+	}
+	indexer.QueueEvent(ctx, indexerTypes.ElysEventTypes.Tradeshield.BracketActivation, indexerTradeshieldTypes.BracketActivationEvent{
+		GroupID:       groupId,
+		ParentOrderID: parent.OrderId,
+		OwnerAddress:  parent.OwnerAddress,
+		PositionID:    positionId,
+		Orders:        activated,
+	}, []string{parent.OwnerAddress})
+	/* End of kwak-indexer node implementation*/
+	/* *************************************************************************** */
+
+	return nil
+}
+
+// removeFromOrderGroup removes a cancelled order from its group. Groups left
+// with a single order are dissolved, the deposits of the close orders of a
+// bracket whose open order is cancelled are refunded.
+func (k Keeper) removeFromOrderGroup(ctx sdk.Context, groupId uint64, spot bool, orderId uint64) error {
+	group, found := k.GetOrderGroup(ctx, groupId)
+	if !found {
+		return nil
+	}
+
+	if group.IsBracket() {
+		if spot || group.ParentOrderId != orderId {
+			return nil
+		}
+		k.RemoveOrderGroup(ctx, group.Id)
+		deposits := group.BracketDeposits()
+		if deposits.IsZero() {
+			return nil
+		}
+		return k.bank.SendCoins(ctx, types.GetPerpOrderAddress(orderId), sdk.MustAccAddressFromBech32(group.OwnerAddress), deposits)
+	}
+
+	if spot {
+		group.SpotOrderIds = slices.DeleteFunc(group.SpotOrderIds, func(id uint64) bool { return id == orderId })
+	} else {
+		group.PerpetualOrderIds = slices.DeleteFunc(group.PerpetualOrderIds, func(id uint64) bool { return id == orderId })
+	}
+	if group.OrderCount() >= 2 {
+		k.SetOrderGroup(ctx, group)
+		return nil
+	}
+
+	k.RemoveOrderGroup(ctx, group.Id)
+	for _, id := range group.SpotOrderIds {
+		if order, found := k.GetPendingSpotOrder(ctx, id); found {
+			order.GroupId = 0
+			k.SetPendingSpotOrder(ctx, order)
+		}
+	}
+	for _, id := range group.PerpetualOrderIds {
+		if order, found := k.GetPendingPerpetualOrder(ctx, id); found {
+			order.GroupId = 0
+			k.SetPendingPerpetualOrder(ctx, order)
+		}
+	}
+	return nil
+}
+
+// cancelOtherGroupOrders cancels and refunds the orders grouped with an
+// executed order, a partially filled order is left pending without a group
+func (k Keeper) cancelOtherGroupOrders(ctx sdk.Context, groupId uint64, spot bool, orderId uint64) (spotOrders, perpetualOrders []indexerTradeshieldTypes.ExpiredOrder, err error) {
+	group, found := k.GetOrderGroup(ctx, groupId)
+	if !found || group.IsBracket() {
+		return nil, nil, nil
+	}
+	if spot && !slices.Contains(group.SpotOrderIds, orderId) || !spot && !slices.Contains(group.PerpetualOrderIds, orderId) {
+		return nil, nil, nil
+	}
+
+	// the group is removed first so that the refunds leave it untouched
+	k.RemoveOrderGroup(ctx, group.Id)
+	for _, id := range group.SpotOrderIds {
+		order, found := k.GetPendingSpotOrder(ctx, id)
+		if !found {
+			continue
+		}
+		// a partially filled order stays pending out of the group
+		if spot && id == orderId {
+			order.GroupId = 0
+			k.SetPendingSpotOrder(ctx, order)
+			continue
+		}
+		expired, err := k.ExpireSpotOrder(ctx, order, ExpiryReasonOneCancelsOther)
+		if err != nil {
+			return nil, nil, err
+		}
+		spotOrders = append(spotOrders, expired)
+	}
+	for _, id := range group.PerpetualOrderIds {
+		order, found := k.GetPendingPerpetualOrder(ctx, id)
+		if !found {
+			continue
+		}
+		expired, err := k.ExpirePerpetualOrder(ctx, order, ExpiryReasonOneCancelsOther)
+		if err != nil {
+			return nil, nil, err
+		}
+		perpetualOrders = append(perpetualOrders, expired)
+	}
+	return spotOrders, perpetualOrders, nil
+}
+
+// CancelOrderGroup cancels every order of a group and refunds them to the
+// owner
+func (k Keeper) CancelOrderGroup(ctx sdk.Context, group types.OrderGroup) (spotOrders, perpetualOrders []indexerTradeshieldTypes.CancelledOrder, err error) {
+	for _, id := range group.SpotOrderIds {
+		order, found := k.GetPendingSpotOrder(ctx, id)
+		if !found {
+			continue
+		}
+		if err := k.RefundSpotOrder(ctx, order); err != nil {
+			return nil, nil, err
+		}
+		types.EmitCloseSpotOrderEvent(ctx, order)
+
+		/* *************************************************************************** */
+		/* Start of kwak-indexer node implementation*/
+		spotOrders = append(spotOrders, indexerTradeshieldTypes.CancelledOrder{
+			OrderID: order.OrderId,
+			Refund:  indexerToken(order.OrderAmount),
+			Deposit: indexerToken(order.Deposit),
+		})
+		/* End of kwak-indexer node implementation*/
+		/* *************************************************************************** */
+	}
+	for _, id := range group.PerpetualOrderIds {
+		order, found := k.GetPendingPerpetualOrder(ctx, id)
+		if !found {
+			continue
+		}
+		deposit := k.perpetualOrderDeposit(ctx, order)
+		if err := k.RefundPerpetualOrder(ctx, order); err != nil {
+			return nil, nil, err
+		}
+		types.EmitCancelPerpetualOrderEvent(ctx, order)
+
+		/* *************************************************************************** */
+		/* Start of kwak-indexer node implementation*/
+		refund := indexerTypes.Token{}
+		if order.PerpetualOrderType == types.PerpetualOrderType_LIMITOPEN {
+			refund = indexerToken(order.Collateral)
+		}
+		perpetualOrders = append(perpetualOrders, indexerTradeshieldTypes.CancelledOrder{
+			OrderID: order.OrderId,
+			Refund:  refund,
+			Deposit: indexerToken(deposit),
+		})
+		/* End of kwak-indexer node implementation*/
+		/* *************************************************************************** */
+	}
+
+	// the last refunds already dissolved the group
+	k.RemoveOrderGroup(ctx, group.Id)
+	ctx.EventManager().EmitEvent(types.NewCancelOrderGroupEvt(group))
+	return spotOrders, perpetualOrders, nil
+}
+
+// perpetualOrderDeposit returns the deposit escrowed on a perpetual order,
+// including the deposits of its bracket orders
+func (k Keeper) perpetualOrderDeposit(ctx sdk.Context, order types.PerpetualOrder) sdk.Coin {
+	group, found := k.GetOrderGroup(ctx, order.GroupId)
+	if !found || group.ParentOrderId != order.OrderId {
+		return order.Deposit
+	}
+	deposit := order.Deposit
+	for _, coin := range group.BracketDeposits() {
+		deposit = deposit.Add(coin)
+	}
+	return deposit
+}
+
+/* *************************************************************************** */
+/* Start of kwak-indexer node implementation*/
+func indexerBracketOrder(orderType types.PerpetualOrderType, triggerPrice sdkmath.LegacyDec, closeAmount sdkmath.Int, deposit sdk.Coin) common.BracketOrder {
+	return common.BracketOrder{
+		OrderType:    orderType.String(),
+		TriggerPrice: triggerPrice.String(),
+		CloseAmount:  closeAmount.String(),
+		Deposit:      indexerToken(deposit),
+	}
+}
+
+func indexerBracketOrders(group types.OrderGroup) []common.BracketOrder {
+	var brackets []common.BracketOrder
+	for _, bracket := range group.BracketOrders {
+		brackets = append(brackets, indexerBracketOrder(bracket.OrderType, bracket.TriggerPrice, bracket.CloseAmount, bracket.Deposit))
+	}
+	return brackets
+}
+
+/* End of kwak-indexer node implementation*/
+/* *************************************************************************** */
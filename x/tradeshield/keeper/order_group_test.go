@@ -0,0 +1,175 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	ptypes "github.com/elys-network/elys/x/parameter/types"
+	keeper "github.com/elys-network/elys/x/tradeshield/keeper"
+	"github.com/elys-network/elys/x/tradeshield/types"
+)
+
+func (suite *TradeshieldKeeperTestSuite) createSpotLimitSellOrder(owner sdk.AccAddress, rate int64) uint64 {
+	msgSrvr := keeper.NewMsgServerImpl(suite.app.TradeshieldKeeper)
+	res, err := msgSrvr.CreateSpotOrder(suite.ctx, &types.MsgCreateSpotOrder{
+		OwnerAddress: owner.String(),
+		OrderType:    types.SpotOrderType_LIMITSELL,
+		OrderPrice: types.OrderPrice{
+			BaseDenom:  ptypes.ATOM,
+			QuoteDenom: ptypes.BaseCurrency,
+			Rate:       math.LegacyNewDec(rate),
+		},
+		OrderAmount:      sdk.NewInt64Coin(ptypes.ATOM, 1000),
+		OrderTargetDenom: ptypes.BaseCurrency,
+	})
+	suite.Require().NoError(err)
+	return res.OrderId
+}
+
+func (suite *TradeshieldKeeperTestSuite) TestOrderGroupOneCancelsOther() {
+	addr := suite.AddAccounts(2, nil)
+	owner, executor := addr[0], addr[1]
+	_, _, _ = suite.SetPerpetualPool(1)
+	suite.setExecutorReward(math.LegacyZeroDec(), 1000)
+
+	ownerBalances := suite.app.BankKeeper.GetAllBalances(suite.ctx, owner)
+	spotId := suite.createSpotLimitSellOrder(owner, 10)
+	perpId := suite.createPerpetualOpenOrder(owner, 8)
+
+	msgSrvr := keeper.NewMsgServerImpl(suite.app.TradeshieldKeeper)
+	// Orders of another owner cannot be grouped
+	cacheCtx, _ := suite.ctx.CacheContext()
+	_, err := msgSrvr.CreateOrderGroup(cacheCtx, types.NewMsgCreateOrderGroup(executor.String(), []uint64{spotId}, []uint64{perpId}))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	res, err := msgSrvr.CreateOrderGroup(suite.ctx, types.NewMsgCreateOrderGroup(owner.String(), []uint64{spotId}, []uint64{perpId}))
+	suite.Require().NoError(err)
+	spotOrder, _ := suite.app.TradeshieldKeeper.GetPendingSpotOrder(suite.ctx, spotId)
+	suite.Require().Equal(res.GroupId, spotOrder.GroupId)
+
+	// An order belongs to a single group
+	cacheCtx, _ = suite.ctx.CacheContext()
+	_, err = msgSrvr.CreateOrderGroup(cacheCtx, types.NewMsgCreateOrderGroup(owner.String(), []uint64{spotId}, []uint64{perpId}))
+	suite.Require().ErrorIs(err, types.ErrInvalidOrderGroup)
+
+	query, err := suite.app.TradeshieldKeeper.OrderGroup(suite.ctx, &types.QueryGetOrderGroupRequest{Id: res.GroupId})
+	suite.Require().NoError(err)
+	suite.Require().Len(query.SpotOrders, 1)
+	suite.Require().Len(query.PerpetualOrders, 1)
+
+	// Opening the position cancels the spot order and refunds it
+	perpOrder, _ := suite.app.TradeshieldKeeper.GetPendingPerpetualOrder(suite.ctx, perpId)
+	_, executed, err := suite.app.TradeshieldKeeper.FillPerpetualOrder(suite.ctx, perpOrder, executor)
+	suite.Require().NoError(err)
+	suite.Require().True(executed)
+
+	_, found := suite.app.TradeshieldKeeper.GetPendingSpotOrder(suite.ctx, spotId)
+	suite.Require().False(found)
+	_, found = suite.app.TradeshieldKeeper.GetOrderGroup(suite.ctx, res.GroupId)
+	suite.Require().False(found)
+	suite.Require().Equal(
+		ownerBalances.Sub(sdk.NewInt64Coin(ptypes.ATOM, 100), sdk.NewInt64Coin(ptypes.Elys, 1000)),
+		suite.app.BankKeeper.GetAllBalances(suite.ctx, owner),
+	)
+}
+
+func (suite *TradeshieldKeeperTestSuite) TestCancelOrderGroup() {
+	addr := suite.AddAccounts(2, nil)
+	owner, other := addr[0], addr[1]
+	_, _, _ = suite.SetPerpetualPool(1)
+	suite.setExecutorReward(math.LegacyZeroDec(), 1000)
+
+	ownerBalances := suite.app.BankKeeper.GetAllBalances(suite.ctx, owner)
+	spotId := suite.createSpotLimitSellOrder(owner, 10)
+	perpId := suite.createPerpetualOpenOrder(owner, 4)
+
+	msgSrvr := keeper.NewMsgServerImpl(suite.app.TradeshieldKeeper)
+	res, err := msgSrvr.CreateOrderGroup(suite.ctx, types.NewMsgCreateOrderGroup(owner.String(), []uint64{spotId}, []uint64{perpId}))
+	suite.Require().NoError(err)
+
+	cacheCtx, _ := suite.ctx.CacheContext()
+	_, err = msgSrvr.CancelOrderGroup(cacheCtx, types.NewMsgCancelOrderGroup(other.String(), res.GroupId))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// Cancelling one order dissolves a group of two
+	cacheCtx, _ = suite.ctx.CacheContext()
+	_, err = msgSrvr.CancelSpotOrder(cacheCtx, &types.MsgCancelSpotOrder{OwnerAddress: owner.String(), OrderId: spotId})
+	suite.Require().NoError(err)
+	_, found := suite.app.TradeshieldKeeper.GetOrderGroup(cacheCtx, res.GroupId)
+	suite.Require().False(found)
+	perpOrder, _ := suite.app.TradeshieldKeeper.GetPendingPerpetualOrder(cacheCtx, perpId)
+	suite.Require().Zero(perpOrder.GroupId)
+
+	_, err = msgSrvr.CancelOrderGroup(suite.ctx, types.NewMsgCancelOrderGroup(owner.String(), res.GroupId))
+	suite.Require().NoError(err)
+	suite.Require().Empty(suite.app.TradeshieldKeeper.GetAllPendingSpotOrder(suite.ctx))
+	suite.Require().Empty(suite.app.TradeshieldKeeper.GetAllPendingPerpetualOrder(suite.ctx))
+	suite.Require().Empty(suite.app.TradeshieldKeeper.GetAllOrderGroup(suite.ctx))
+	suite.Require().Equal(ownerBalances, suite.app.BankKeeper.GetAllBalances(suite.ctx, owner))
+
+	_, err = msgSrvr.CancelOrderGroup(suite.ctx, types.NewMsgCancelOrderGroup(owner.String(), res.GroupId))
+	suite.Require().ErrorIs(err, types.ErrOrderGroupNotFound)
+}
+
+func (suite *TradeshieldKeeperTestSuite) TestBracketOrders() {
+	addr := suite.AddAccounts(3, nil)
+	owner, other, executor := addr[0], addr[1], addr[2]
+	_, _, _ = suite.SetPerpetualPool(1)
+	suite.setExecutorReward(math.LegacyZeroDec(), 1000)
+
+	brackets := []types.BracketOrder{
+		{OrderType: types.PerpetualOrderType_LIMITCLOSE, TriggerPrice: math.LegacyNewDec(12), CloseAmount: math.ZeroInt()},
+		{OrderType: types.PerpetualOrderType_STOPLOSSPERP, TriggerPrice: math.LegacyNewDec(3), CloseAmount: math.ZeroInt()},
+	}
+	msgSrvr := keeper.NewMsgServerImpl(suite.app.TradeshieldKeeper)
+
+	// A stop loss above the open price of a long position is rejected
+	msg := suite.perpetualOpenOrderMsg(owner, 8, types.TimeInForce_GTC, nil)
+	msg.BracketOrders = []types.BracketOrder{{OrderType: types.PerpetualOrderType_STOPLOSSPERP, TriggerPrice: math.LegacyNewDec(9), CloseAmount: math.ZeroInt()}}
+	suite.Require().ErrorIs(msg.ValidateBasic(), types.ErrInvalidBracketOrder)
+
+	// Cancelling the open order refunds the deposits of its bracket orders
+	otherBalances := suite.app.BankKeeper.GetAllBalances(suite.ctx, other)
+	msg = suite.perpetualOpenOrderMsg(other, 4, types.TimeInForce_GTC, nil)
+	msg.BracketOrders = brackets
+	cancelled, err := msgSrvr.CreatePerpetualOpenOrder(suite.ctx, msg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(otherBalances.Sub(sdk.NewInt64Coin(ptypes.ATOM, 100), sdk.NewInt64Coin(ptypes.Elys, 3000)), suite.app.BankKeeper.GetAllBalances(suite.ctx, other))
+	_, err = msgSrvr.CancelPerpetualOrder(suite.ctx, &types.MsgCancelPerpetualOrder{OwnerAddress: other.String(), OrderId: cancelled.OrderId})
+	suite.Require().NoError(err)
+	suite.Require().Equal(otherBalances, suite.app.BankKeeper.GetAllBalances(suite.ctx, other))
+	suite.Require().Empty(suite.app.TradeshieldKeeper.GetAllOrderGroup(suite.ctx))
+
+	// The close orders are created once the position is opened
+	ownerElys := suite.app.BankKeeper.GetBalance(suite.ctx, owner, ptypes.Elys)
+	msg = suite.perpetualOpenOrderMsg(owner, 8, types.TimeInForce_GTC, nil)
+	msg.BracketOrders = brackets
+	res, err := msgSrvr.CreatePerpetualOpenOrder(suite.ctx, msg)
+	suite.Require().NoError(err)
+	parent, _ := suite.app.TradeshieldKeeper.GetPendingPerpetualOrder(suite.ctx, res.OrderId)
+	_, executed, err := suite.app.TradeshieldKeeper.FillPerpetualOrder(suite.ctx, parent, executor)
+	suite.Require().NoError(err)
+	suite.Require().True(executed)
+
+	group, found := suite.app.TradeshieldKeeper.GetOrderGroup(suite.ctx, parent.GroupId)
+	suite.Require().True(found)
+	suite.Require().False(group.IsBracket())
+	suite.Require().Len(group.PerpetualOrderIds, 2)
+	takeProfit, _ := suite.app.TradeshieldKeeper.GetPendingPerpetualOrder(suite.ctx, group.PerpetualOrderIds[0])
+	stopLoss, _ := suite.app.TradeshieldKeeper.GetPendingPerpetualOrder(suite.ctx, group.PerpetualOrderIds[1])
+	suite.Require().Equal(types.PerpetualOrderType_LIMITCLOSE, takeProfit.PerpetualOrderType)
+	suite.Require().Equal(types.PerpetualOrderType_STOPLOSSPERP, stopLoss.PerpetualOrderType)
+	suite.Require().Equal(stopLoss.PositionId, takeProfit.PositionId)
+	suite.Require().Equal(sdk.NewInt64Coin(ptypes.Elys, 1000), suite.app.BankKeeper.GetBalance(suite.ctx, stopLoss.GetOrderAddress(), ptypes.Elys))
+
+	// The stop loss closes the position and cancels the take profit
+	suite.setLimitProcessOrder(true, 10)
+	suite.setAtomPrice("2.9")
+	suite.app.TradeshieldKeeper.EndBlocker(suite.ctx)
+	suite.Require().Empty(suite.app.TradeshieldKeeper.GetAllPendingPerpetualOrder(suite.ctx))
+	suite.Require().Empty(suite.app.TradeshieldKeeper.GetAllOrderGroup(suite.ctx))
+	_, err = suite.app.PerpetualKeeper.GetMTP(suite.ctx, owner, stopLoss.PositionId)
+	suite.Require().Error(err)
+	// Only the deposit of the open order went to its executor
+	suite.Require().Equal(ownerElys.SubAmount(math.NewInt(1000)), suite.app.BankKeeper.GetBalance(suite.ctx, owner, ptypes.Elys))
+}
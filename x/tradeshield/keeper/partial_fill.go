@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	/* *************************************************************************** */
+	/* Start of kwak-indexer node implementation*/
+	"github.com/elys-network/elys/indexer/txs/tradeshield/common"
+
+	/* End of kwak-indexer node implementation*/
+	/* *************************************************************************** */
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ammtypes "github.com/elys-network/elys/x/amm/types"
+	"github.com/elys-network/elys/x/tradeshield/types"
+)
+
+// maxFillSearchSteps bounds the swap estimations of a partial fill, the filled
+// amount is found within 1/2^16 of the amount left to fill
+const maxFillSearchSteps = 16
+
+// LimitOrderFillAmount returns the largest part of the amount left to fill of
+// a limit order whose swap keeps the effective price within the order rate,
+// zero when no part does. The effective price only worsens as the swapped
+// amount grows, the amount is found by bisection over the AMM estimations.
+func (k Keeper) LimitOrderFillAmount(ctx sdk.Context, order types.SpotOrder) sdkmath.Int {
+	remaining := order.OrderAmount.Amount
+	if remaining.IsNil() || !remaining.IsPositive() {
+		return sdkmath.ZeroInt()
+	}
+	if k.fillWithinLimit(ctx, order, remaining) {
+		return remaining
+	}
+
+	low, high := sdkmath.ZeroInt(), remaining
+	for i := 0; i < maxFillSearchSteps && high.Sub(low).GT(sdkmath.OneInt()); i++ {
+		mid := low.Add(high).QuoRaw(2)
+		if k.fillWithinLimit(ctx, order, mid) {
+			low = mid
+		} else {
+			high = mid
+		}
+	}
+	return low
+}
+
+// fillWithinLimit reports whether swapping amount of the order reaches its
+// rate. A limit sell compares the output received per unit sold, a limit buy
+// the amount paid per unit bought, like the market price of its trigger.
+func (k Keeper) fillWithinLimit(ctx sdk.Context, order types.SpotOrder, amount sdkmath.Int) bool {
+	res, err := k.amm.SwapEstimationByDenom(ctx, &ammtypes.QuerySwapEstimationByDenomRequest{
+		Amount:   sdk.NewCoin(order.OrderAmount.Denom, amount),
+		DenomIn:  order.OrderPrice.BaseDenom,
+		DenomOut: order.OrderPrice.QuoteDenom,
+		Address:  order.OwnerAddress,
+	})
+	if err != nil || res.Amount.Amount.IsNil() || !res.Amount.Amount.IsPositive() {
+		return false
+	}
+	if order.OrderType == types.SpotOrderType_LIMITBUY {
+		paidPrice := sdkmath.LegacyNewDecFromInt(amount).QuoInt(res.Amount.Amount)
+		return rateReached(paidPrice, order.OrderPrice.Rate, true)
+	}
+	effectivePrice := sdkmath.LegacyNewDecFromInt(res.Amount.Amount).QuoInt(amount)
+	return rateReached(effectivePrice, order.OrderPrice.Rate, false)
+}
+
+// limitOrderMinAmountOut returns the least swap output of amount whose
+// effective price reaches the order rate, see fillWithinLimit
+func limitOrderMinAmountOut(order types.SpotOrder, amount sdkmath.Int) sdkmath.Int {
+	rate := sortRate(order.OrderPrice.Rate)
+	if order.OrderType == types.SpotOrderType_LIMITBUY {
+		if !rate.IsPositive() {
+			// no output is within a rate that is not positive, nothing is filled
+			return sdkmath.ZeroInt()
+		}
+		return sdkmath.LegacyNewDecFromInt(amount).Quo(rate).Ceil().TruncateInt()
+	}
+	return rate.MulInt(amount).Ceil().TruncateInt()
+}
+
+/* *************************************************************************** */
+/* Start of kwak-indexer node implementation*/
+
+// limitOrderStatus returns the indexer status of a limit order once fill is
+// swapped, partially filled orders are still pending
+func limitOrderStatus(order types.SpotOrder, fill sdk.Coin) common.OrderStatus {
+	if order.OrderAmount.Amount.GT(fill.Amount) {
+		return common.Status_PENDING
+	}
+	return common.Status_EXECUTED
+}
+
+/* End of kwak-indexer node implementation*/
+/* *************************************************************************** */
@@ -0,0 +1,159 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ptypes "github.com/elys-network/elys/x/parameter/types"
+	keeper "github.com/elys-network/elys/x/tradeshield/keeper"
+	"github.com/elys-network/elys/x/tradeshield/types"
+)
+
+func (suite *TradeshieldKeeperTestSuite) limitSellOrderMsg(owner sdk.AccAddress, amount int64, rate string, timeInForce types.TimeInForce) *types.MsgCreateSpotOrder {
+	return &types.MsgCreateSpotOrder{
+		OwnerAddress: owner.String(),
+		OrderType:    types.SpotOrderType_LIMITSELL,
+		OrderPrice: types.OrderPrice{
+			BaseDenom:  ptypes.ATOM,
+			QuoteDenom: ptypes.BaseCurrency,
+			Rate:       math.LegacyMustNewDecFromStr(rate),
+		},
+		OrderAmount:      sdk.NewInt64Coin(ptypes.ATOM, amount),
+		OrderTargetDenom: ptypes.BaseCurrency,
+		TimeInForce:      timeInForce,
+	}
+}
+
+func (suite *TradeshieldKeeperTestSuite) TestLimitOrderPartialFill() {
+	addr := suite.AddAccounts(2, nil)
+	owner, executor := addr[0], addr[1]
+	_, _, _ = suite.SetPerpetualPool(1)
+	suite.setExecutorReward(math.LegacyMustNewDecFromStr("0.1"), 1000)
+
+	// Market price is 5, a small order is filled at once
+	msgSrvr := keeper.NewMsgServerImpl(suite.app.TradeshieldKeeper)
+	small, err := msgSrvr.CreateSpotOrder(suite.ctx, suite.limitSellOrderMsg(owner, 1000000, "4.8", types.TimeInForce_GTC))
+	suite.Require().NoError(err)
+	order, _ := suite.app.TradeshieldKeeper.GetPendingSpotOrder(suite.ctx, small.OrderId)
+	suite.Require().Equal(order.OrderAmount.Amount, suite.app.TradeshieldKeeper.LimitOrderFillAmount(suite.ctx, order))
+	_, executed, err := suite.app.TradeshieldKeeper.FillSpotOrder(suite.ctx, order, executor)
+	suite.Require().NoError(err)
+	suite.Require().True(executed)
+	_, found := suite.app.TradeshieldKeeper.GetPendingSpotOrder(suite.ctx, small.OrderId)
+	suite.Require().False(found)
+
+	// A large order would break its rate, only a part of it is swapped
+	total := math.NewInt(100000000000)
+	large, err := msgSrvr.CreateSpotOrder(suite.ctx, suite.limitSellOrderMsg(owner, total.Int64(), "4.8", types.TimeInForce_GTC))
+	suite.Require().NoError(err)
+	order, _ = suite.app.TradeshieldKeeper.GetPendingSpotOrder(suite.ctx, large.OrderId)
+	executorAtom := suite.app.BankKeeper.GetBalance(suite.ctx, executor, ptypes.ATOM)
+	reward, executed, err := suite.app.TradeshieldKeeper.FillSpotOrder(suite.ctx, order, executor)
+	suite.Require().NoError(err)
+	suite.Require().True(executed)
+
+	order, found = suite.app.TradeshieldKeeper.GetPendingSpotOrder(suite.ctx, large.OrderId)
+	suite.Require().True(found)
+	suite.Require().True(order.FilledAmount.IsPositive())
+	suite.Require().True(order.OrderAmount.IsPositive())
+
+	// The executor is paid the share of the filled part out of the amount
+	// left to fill, the filled amount only counts the swapped part. The
+	// deposit and the amount left to fill stay escrowed.
+	suite.Require().Equal(sdk.NewCoins(suite.app.BankKeeper.GetBalance(suite.ctx, executor, ptypes.ATOM).Sub(executorAtom)), reward)
+	suite.Require().Equal(sdk.NewCoins(suite.app.TradeshieldKeeper.ExecutorShare(suite.ctx, order.FilledAmount)), reward)
+	suite.Require().Equal(total, order.OrderAmount.Amount.Add(order.FilledAmount.Amount).Add(reward.AmountOf(ptypes.ATOM)))
+	suite.Require().Equal(order.OrderAmount, suite.app.BankKeeper.GetBalance(suite.ctx, order.GetOrderAddress(), ptypes.ATOM))
+	suite.Require().Equal(sdk.NewInt64Coin(ptypes.Elys, 1000), suite.app.BankKeeper.GetBalance(suite.ctx, order.GetOrderAddress(), ptypes.Elys))
+
+	// Cancelling refunds the amount left to fill
+	ownerAtom := suite.app.BankKeeper.GetBalance(suite.ctx, owner, ptypes.ATOM)
+	_, err = msgSrvr.CancelSpotOrder(suite.ctx, &types.MsgCancelSpotOrder{OwnerAddress: owner.String(), OrderId: large.OrderId})
+	suite.Require().NoError(err)
+	suite.Require().Equal(ownerAtom.Add(order.OrderAmount), suite.app.BankKeeper.GetBalance(suite.ctx, owner, ptypes.ATOM))
+}
+
+func (suite *TradeshieldKeeperTestSuite) TestLimitBuyOrderPartialFill() {
+	addr := suite.AddAccounts(2, nil)
+	owner, executor := addr[0], addr[1]
+	_, _, _ = suite.SetPerpetualPool(1)
+
+	limitBuyOrderMsg := func(amount int64, rate string) *types.MsgCreateSpotOrder {
+		return &types.MsgCreateSpotOrder{
+			OwnerAddress: owner.String(),
+			OrderType:    types.SpotOrderType_LIMITBUY,
+			OrderPrice: types.OrderPrice{
+				BaseDenom:  ptypes.BaseCurrency,
+				QuoteDenom: ptypes.ATOM,
+				Rate:       math.LegacyMustNewDecFromStr(rate),
+			},
+			OrderAmount:      sdk.NewInt64Coin(ptypes.BaseCurrency, amount),
+			OrderTargetDenom: ptypes.ATOM,
+			TimeInForce:      types.TimeInForce_GTC,
+		}
+	}
+
+	// ATOM is worth 5 USDC, a small order buying it at up to 5.2 is filled
+	// at once
+	msgSrvr := keeper.NewMsgServerImpl(suite.app.TradeshieldKeeper)
+	small, err := msgSrvr.CreateSpotOrder(suite.ctx, limitBuyOrderMsg(1000000, "5.2"))
+	suite.Require().NoError(err)
+	order, _ := suite.app.TradeshieldKeeper.GetPendingSpotOrder(suite.ctx, small.OrderId)
+	suite.Require().Equal(order.OrderAmount.Amount, suite.app.TradeshieldKeeper.LimitOrderFillAmount(suite.ctx, order))
+	ownerAtom := suite.app.BankKeeper.GetBalance(suite.ctx, owner, ptypes.ATOM)
+	_, executed, err := suite.app.TradeshieldKeeper.FillSpotOrder(suite.ctx, order, executor)
+	suite.Require().NoError(err)
+	suite.Require().True(executed)
+	_, found := suite.app.TradeshieldKeeper.GetPendingSpotOrder(suite.ctx, small.OrderId)
+	suite.Require().False(found)
+	bought := suite.app.BankKeeper.GetBalance(suite.ctx, owner, ptypes.ATOM).Sub(ownerAtom).Amount
+	suite.Require().True(math.LegacyNewDec(1000000).QuoInt(bought).LTE(math.LegacyMustNewDecFromStr("5.2")))
+
+	// A large order would pay more than its rate, only a part of it is swapped
+	total := math.NewInt(1000000000000)
+	large, err := msgSrvr.CreateSpotOrder(suite.ctx, limitBuyOrderMsg(total.Int64(), "5.2"))
+	suite.Require().NoError(err)
+	order, _ = suite.app.TradeshieldKeeper.GetPendingSpotOrder(suite.ctx, large.OrderId)
+	fill := suite.app.TradeshieldKeeper.LimitOrderFillAmount(suite.ctx, order)
+	suite.Require().True(fill.IsPositive())
+	suite.Require().True(fill.LT(total))
+
+	ownerAtom = suite.app.BankKeeper.GetBalance(suite.ctx, owner, ptypes.ATOM)
+	_, executed, err = suite.app.TradeshieldKeeper.FillSpotOrder(suite.ctx, order, executor)
+	suite.Require().NoError(err)
+	suite.Require().True(executed)
+	order, found = suite.app.TradeshieldKeeper.GetPendingSpotOrder(suite.ctx, large.OrderId)
+	suite.Require().True(found)
+	suite.Require().Equal(fill, order.FilledAmount.Amount)
+	bought = suite.app.BankKeeper.GetBalance(suite.ctx, owner, ptypes.ATOM).Sub(ownerAtom).Amount
+	suite.Require().True(math.LegacyNewDecFromInt(fill).QuoInt(bought).LTE(math.LegacyMustNewDecFromStr("5.2")))
+
+	// Nothing is bought well below the market price
+	cheap, err := msgSrvr.CreateSpotOrder(suite.ctx, limitBuyOrderMsg(1000000, "4.5"))
+	suite.Require().NoError(err)
+	order, _ = suite.app.TradeshieldKeeper.GetPendingSpotOrder(suite.ctx, cheap.OrderId)
+	suite.Require().True(suite.app.TradeshieldKeeper.LimitOrderFillAmount(suite.ctx, order).IsZero())
+}
+
+func (suite *TradeshieldKeeperTestSuite) TestImmediateLimitOrderPartialFill() {
+	addr := suite.AddAccounts(1, nil)
+	owner := addr[0]
+	_, _, _ = suite.SetPerpetualPool(1)
+	msgSrvr := keeper.NewMsgServerImpl(suite.app.TradeshieldKeeper)
+
+	// A FOK order that cannot be fully filled fails
+	cacheCtx, _ := suite.ctx.CacheContext()
+	_, err := msgSrvr.CreateSpotOrder(cacheCtx, suite.limitSellOrderMsg(owner, 100000000000, "4.8", types.TimeInForce_FOK))
+	suite.Require().ErrorIs(err, types.ErrOrderNotFilled)
+
+	// The part of an IOC order that cannot be filled is refunded
+	ownerAtom := suite.app.BankKeeper.GetBalance(suite.ctx, owner, ptypes.ATOM)
+	_, err = msgSrvr.CreateSpotOrder(suite.ctx, suite.limitSellOrderMsg(owner, 100000000000, "4.8", types.TimeInForce_IOC))
+	suite.Require().NoError(err)
+	suite.Require().Empty(suite.app.TradeshieldKeeper.GetAllPendingSpotOrder(suite.ctx))
+
+	// the filled part is swapped with the order execution
+	suite.Require().Empty(suite.app.AmmKeeper.GetAllSwapExactAmountInRequests(suite.ctx))
+	spent := ownerAtom.Sub(suite.app.BankKeeper.GetBalance(suite.ctx, owner, ptypes.ATOM))
+	suite.Require().True(spent.IsPositive())
+	suite.Require().True(spent.Amount.LT(math.NewInt(100000000000)))
+}
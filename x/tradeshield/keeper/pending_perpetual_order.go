@@ -3,6 +3,18 @@ package keeper
 import (
 	"encoding/binary"
 	"math"
+	"slices"
+	"sort"
+
+	/* *************************************************************************** */
+	/* Start of kwak-indexer node implementation*/
+	indexer "github.com/elys-network/elys/indexer"
+	indexerTradeshieldTypes "github.com/elys-network/elys/indexer/txs/tradeshield"
+	"github.com/elys-network/elys/indexer/txs/tradeshield/common"
+	indexerTypes "github.com/elys-network/elys/indexer/types"
+
+	/* End of kwak-indexer node implementation*/
+	/* *************************************************************************** */
 
 	sdkmath "cosmossdk.io/math"
 	"cosmossdk.io/store/prefix"
@@ -58,6 +70,8 @@ func (k Keeper) AppendPendingPerpetualOrder(
 	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.PendingPerpetualOrderKey)
 	appendedValue := k.cdc.MustMarshal(&pendingPerpetualOrder)
 	store.Set(GetPendingPerpetualOrderIDBytes(pendingPerpetualOrder.OrderId), appendedValue)
+	k.InsertSortedPerpetualOrder(ctx, pendingPerpetualOrder)
+	k.setPerpetualOrderExpiry(ctx, pendingPerpetualOrder)
 
 	// Update pendingPerpetualOrder count
 	k.SetPendingPerpetualOrderCount(ctx, count+1)
@@ -67,9 +81,17 @@ func (k Keeper) AppendPendingPerpetualOrder(
 
 // SetPendingPerpetualOrder set a specific pendingPerpetualOrder in the store
 func (k Keeper) SetPendingPerpetualOrder(ctx sdk.Context, pendingPerpetualOrder types.PerpetualOrder) {
+	// the trigger price may change, move the order in the sorted lists
+	if previous, found := k.GetPendingPerpetualOrder(ctx, pendingPerpetualOrder.OrderId); found {
+		k.RemoveSortedPerpetualOrder(ctx, previous)
+		k.removePerpetualOrderExpiry(ctx, previous)
+	}
+
 	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.PendingPerpetualOrderKey)
 	b := k.cdc.MustMarshal(&pendingPerpetualOrder)
 	store.Set(GetPendingPerpetualOrderIDBytes(pendingPerpetualOrder.OrderId), b)
+	k.InsertSortedPerpetualOrder(ctx, pendingPerpetualOrder)
+	k.setPerpetualOrderExpiry(ctx, pendingPerpetualOrder)
 }
 
 // GetPendingPerpetualOrder returns a pendingPerpetualOrder from its id
@@ -115,6 +137,11 @@ func (k Keeper) GetPendingPerpetualOrdersForAddress(ctx sdk.Context, address str
 
 // RemovePendingPerpetualOrder removes a pendingPerpetualOrder from the store
 func (k Keeper) RemovePendingPerpetualOrder(ctx sdk.Context, id uint64) {
+	if order, found := k.GetPendingPerpetualOrder(ctx, id); found {
+		k.RemoveSortedPerpetualOrder(ctx, order)
+		k.removePerpetualOrderExpiry(ctx, order)
+	}
+	k.removeOrderFailure(ctx, types.PerpetualOrderFailureKey, id)
 	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.PendingPerpetualOrderKey)
 	store.Delete(GetPendingPerpetualOrderIDBytes(id))
 }
@@ -146,6 +173,10 @@ func (k Keeper) DeleteAllPendingPerpetualOrder(ctx sdk.Context) (list []types.Pe
 		store.Delete(iterator.Key())
 	}
 
+	deleteAllSortedOrders(prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.SortedPerpetualOrderKey))
+	k.deleteAllOrderExpiries(ctx, types.PerpetualOrderExpiryHeightKey, types.PerpetualOrderExpiryTimeKey)
+	deleteAllSortedOrders(k.orderFailureStore(ctx, types.PerpetualOrderFailureKey))
+
 	return
 }
 
@@ -176,6 +207,9 @@ func GetPendingPerpetualOrderIDFromBytes(bz []byte) uint64 {
 	return binary.BigEndian.Uint64(bz)
 }
 
+// GetAllSortedPerpetualOrder returns the ids of the pending perpetual orders,
+// one list per position, order type and trading asset sorted by ascending
+// trigger price
 func (k Keeper) GetAllSortedPerpetualOrder(ctx sdk.Context) (list [][]uint64, err error) {
 	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.SortedPerpetualOrderKey)
 	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
@@ -194,6 +228,52 @@ func (k Keeper) GetAllSortedPerpetualOrder(ctx sdk.Context) (list [][]uint64, er
 	return
 }
 
+// PerpetualBinarySearch returns the index at which an order of the given
+// trigger price is inserted in orderIds, after the orders of the same price
+func (k Keeper) PerpetualBinarySearch(ctx sdk.Context, rate sdkmath.LegacyDec, orderIds []uint64) int {
+	rate = sortRate(rate)
+	return sort.Search(len(orderIds), func(i int) bool {
+		order, found := k.GetPendingPerpetualOrder(ctx, orderIds[i])
+		return !found || sortRate(order.TriggerPrice.Rate).GT(rate)
+	})
+}
+
+// InsertSortedPerpetualOrder adds a pending order to the sorted list of its
+// position, order type and trading asset
+func (k Keeper) InsertSortedPerpetualOrder(ctx sdk.Context, order types.PerpetualOrder) {
+	if order.Status != types.Status_PENDING {
+		return
+	}
+
+	key := []byte(types.GenPerpKey(order))
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.SortedPerpetualOrderKey)
+	orderIds := mustGetSortedOrderIds(store, key)
+	index := k.PerpetualBinarySearch(ctx, order.TriggerPrice.Rate, orderIds)
+	orderIds = slices.Insert(orderIds, index, order.OrderId)
+	store.Set(key, types.EncodeUint64Slice(orderIds))
+}
+
+// RemoveSortedPerpetualOrder removes an order from the sorted list of its
+// position, order type and trading asset
+func (k Keeper) RemoveSortedPerpetualOrder(ctx sdk.Context, order types.PerpetualOrder) {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.SortedPerpetualOrderKey)
+	removeSortedOrderId(store, []byte(types.GenPerpKey(order)), order.OrderId)
+}
+
+// ExecutePerpetualOrder dispatches the execution of a perpetual order based on
+// its type
+func (k Keeper) ExecutePerpetualOrder(ctx sdk.Context, order types.PerpetualOrder) error {
+	switch order.PerpetualOrderType {
+	case types.PerpetualOrderType_LIMITOPEN:
+		return k.ExecuteLimitOpenOrder(ctx, order)
+	case types.PerpetualOrderType_LIMITCLOSE:
+		return k.ExecuteLimitCloseOrder(ctx, order)
+	case types.PerpetualOrderType_STOPLOSSPERP, types.PerpetualOrderType_TRAILINGSTOPPERP:
+		return k.ExecuteStopLossPerpOrder(ctx, order)
+	}
+	return nil
+}
+
 // ExecuteLimitOpenOrder executes a limit open order
 func (k Keeper) ExecuteLimitOpenOrder(ctx sdk.Context, order types.PerpetualOrder) error {
 	marketPrice, err := k.perpetual.GetAssetPrice(ctx, order.TradingAsset)
@@ -240,7 +320,8 @@ func (k Keeper) ExecuteLimitOpenOrder(ctx sdk.Context, order types.PerpetualOrde
 
 	ctx.EventManager().EmitEvent(types.NewExecuteLimitOpenPerpetualOrderEvt(order, res.Id))
 
-	return nil
+	// the take profit and stop loss orders of a bracket close the new position
+	return k.activateBracketOrders(ctx, order, res.Id)
 }
 
 // ExecuteLimitCloseOrder executes a limit close order
@@ -263,10 +344,47 @@ func (k Keeper) ExecuteLimitCloseOrder(ctx sdk.Context, order types.PerpetualOrd
 		}
 	}
 
+	return k.executeCloseOrder(ctx, order, marketPrice)
+}
+
+// ExecuteStopLossPerpOrder executes a stop loss order of a perpetual position,
+// or a trailing stop order whose trigger price is its current stop price
+func (k Keeper) ExecuteStopLossPerpOrder(ctx sdk.Context, order types.PerpetualOrder) error {
+	marketPrice, err := k.perpetual.GetAssetPrice(ctx, order.TradingAsset)
+	if err != nil {
+		return err
+	}
+
+	switch order.Position {
+	case types.PerpetualPosition_LONG:
+		if marketPrice.GT(order.TriggerPrice.Rate) {
+			// skip the order
+			return nil
+		}
+	case types.PerpetualPosition_SHORT:
+		if marketPrice.LT(order.TriggerPrice.Rate) {
+			// skip the order
+			return nil
+		}
+	}
+
+	return k.executeCloseOrder(ctx, order, marketPrice)
+}
+
+// executeCloseOrder closes the order amount of the position and removes the order
+func (k Keeper) executeCloseOrder(ctx sdk.Context, order types.PerpetualOrder, marketPrice sdkmath.LegacyDec) error {
+	mtp, err := k.perpetual.GetMTP(ctx, sdk.MustAccAddressFromBech32(order.OwnerAddress), order.PositionId)
+	if err != nil {
+		// The position was closed or liquidated since the order was placed
+		k.RemovePendingPerpetualOrder(ctx, order.OrderId)
+		return err
+	}
+
+	amount := CloseOrderAmount(order, mtp)
 	_, err = k.perpetual.Close(ctx, &perpetualtypes.MsgClose{
 		Creator: order.OwnerAddress,
 		Id:      order.PositionId,
-		Amount:  sdkmath.ZeroInt(),
+		Amount:  amount,
 	})
 	if err != nil {
 		return err
@@ -275,9 +393,47 @@ func (k Keeper) ExecuteLimitCloseOrder(ctx sdk.Context, order types.PerpetualOrd
 	// Remove the order from the pending order list
 	k.RemovePendingPerpetualOrder(ctx, order.OrderId)
 
+	ctx.EventManager().EmitEvent(types.NewExecuteClosePerpetualOrderEvt(order, amount))
+
+	/* *************************************************************************** */
+	/* Start of kwak-indexer node implementation*/
+	indexer.QueueEvent(ctx, indexerTypes.ElysEventTypes.Tradeshield.PerpetualClose, indexerTradeshieldTypes.PerpetualCloseExecutionEvent{
+		OrderID:      order.OrderId,
+		OwnerAddress: order.OwnerAddress,
+		OrderType:    order.PerpetualOrderType.String(),
+		Position:     order.Position.String(),
+		PositionID:   order.PositionId,
+		Status:       common.Status_EXECUTED,
+		Date: common.OrderDate{
+			Height:    uint64(ctx.BlockHeight()),
+			Timestamp: uint64(ctx.BlockTime().Unix()),
+		},
+		MarketPrice: marketPrice.String(),
+		TriggerPrice: common.TriggerPrice{
+			TradingAssetDenom: order.TriggerPrice.TradingAssetDenom,
+			Rate:              order.TriggerPrice.Rate.String(),
+		},
+		CloseAmount: amount.String(),
+	}, []string{order.OwnerAddress})
+	/* End of kwak-indexer node implementation*/
+	/* *************************************************************************** */
+
 	return nil
 }
 
+// CloseOrderAmount returns the amount of the position closed by the order, the
+// whole position when the order amount is zero or exceeds the position
+func CloseOrderAmount(order types.PerpetualOrder, mtp perpetualtypes.MTP) sdkmath.Int {
+	positionAmount := mtp.Custody
+	if mtp.Position == perpetualtypes.Position_SHORT {
+		positionAmount = mtp.Liabilities
+	}
+	if order.CloseAmount.IsNil() || !order.CloseAmount.IsPositive() || order.CloseAmount.GT(positionAmount) {
+		return positionAmount
+	}
+	return order.CloseAmount
+}
+
 // ExecuteMarketOpenOrder executes a market open order
 func (k Keeper) ExecuteMarketOpenOrder(ctx sdk.Context, order types.PerpetualOrder) error {
 	_, err := k.perpetual.Open(ctx, &perpetualtypes.MsgOpen{
@@ -302,19 +458,12 @@ func (k Keeper) ExecuteMarketOpenOrder(ctx sdk.Context, order types.PerpetualOrd
 
 // ExecuteMarketCloseOrder executes a market close order
 func (k Keeper) ExecuteMarketCloseOrder(ctx sdk.Context, order types.PerpetualOrder) error {
-	_, err := k.perpetual.Close(ctx, &perpetualtypes.MsgClose{
-		Creator: order.OwnerAddress,
-		Id:      order.PositionId,
-		Amount:  sdkmath.ZeroInt(),
-	})
+	marketPrice, err := k.perpetual.GetAssetPrice(ctx, order.TradingAsset)
 	if err != nil {
 		return err
 	}
 
-	// Remove the order from the pending order list
-	k.RemovePendingPerpetualOrder(ctx, order.OrderId)
-
-	return nil
+	return k.executeCloseOrder(ctx, order, marketPrice)
 }
 
 // ConstructPerpetualOrderExtraInfo fills up the extra information of the perpetual order and returns it
@@ -352,7 +501,7 @@ func (k Keeper) ConstructPerpetualOrderExtraInfo(ctx sdk.Context, order types.Pe
 	}
 
 	// otherwise retrieve the position info from existing position
-	mtp, err := k.perpetual.GetMTP(ctx, sdk.AccAddress(order.OwnerAddress), order.PositionId)
+	mtp, err := k.perpetual.GetMTP(ctx, sdk.MustAccAddressFromBech32(order.OwnerAddress), order.PositionId)
 	if err != nil {
 		return nil, err
 	}
@@ -363,8 +512,9 @@ func (k Keeper) ConstructPerpetualOrderExtraInfo(ctx sdk.Context, order types.Pe
 	}
 
 	res, err := k.perpetual.HandleCloseEstimation(ctx, &perpetualtypes.QueryCloseEstimationRequest{
-		Address:    order.OwnerAddress,
-		PositionId: order.PositionId,
+		Address:     order.OwnerAddress,
+		PositionId:  order.PositionId,
+		CloseAmount: CloseOrderAmount(order, mtp),
 	})
 	if err != nil {
 		return nil, err
@@ -7,6 +7,9 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/elys-network/elys/testutil/nullify"
+	oracletypes "github.com/elys-network/elys/x/oracle/types"
+	perpetualtypes "github.com/elys-network/elys/x/perpetual/types"
+	"github.com/elys-network/elys/x/tradeshield/keeper"
 	"github.com/elys-network/elys/x/tradeshield/types"
 )
 
@@ -26,6 +29,7 @@ func (suite *TradeshieldKeeperTestSuite) createNPendingPerpetualOrder(n int) []t
 			PositionId:         uint64(i),
 			Status:             types.Status_PENDING,
 			StopLossPrice:      math.LegacyNewDec(1),
+			CloseAmount:        math.ZeroInt(),
 		}
 		items[i].OrderId = suite.app.TradeshieldKeeper.AppendPendingPerpetualOrder(suite.ctx, items[i])
 	}
@@ -104,51 +108,112 @@ func (suite *TradeshieldKeeperTestSuite) TestExecuteLimitOpenOrder() {
 	suite.Require().False(found)
 }
 
-// TODO: Update it when close is supported from tradeshield
-func (suite *TradeshieldKeeperTestSuite) TestExecuteLimitCloseOrder() {
-	address := suite.AddAccounts(3, nil)
-	_, _, _ = suite.SetPerpetualPool(1)
+// openPerpetualPosition opens a long uatom position of owner in pool 1 and
+// returns its id
+func (suite *TradeshieldKeeperTestSuite) openPerpetualPosition(owner sdk.AccAddress) uint64 {
+	res, err := suite.app.PerpetualKeeper.Open(suite.ctx, &perpetualtypes.MsgOpen{
+		Creator:         owner.String(),
+		Position:        perpetualtypes.Position_LONG,
+		Leverage:        math.LegacyNewDec(5),
+		TradingAsset:    "uatom",
+		Collateral:      sdk.Coin{Denom: "uatom", Amount: math.NewInt(1000)},
+		TakeProfitPrice: math.LegacyNewDec(10),
+		StopLossPrice:   math.LegacyZeroDec(),
+		PoolId:          1,
+	})
+	suite.Require().NoError(err)
+	return res.Id
+}
 
-	perpetualOrder := types.PerpetualOrder{
-		OwnerAddress:       address[2].String(),
-		OrderId:            1,
-		PerpetualOrderType: types.PerpetualOrderType_LIMITOPEN,
+func (suite *TradeshieldKeeperTestSuite) closeOrder(owner sdk.AccAddress, orderType types.PerpetualOrderType, positionId uint64, triggerPrice math.LegacyDec, closeAmount math.Int) types.PerpetualOrder {
+	order := types.PerpetualOrder{
+		OwnerAddress:       owner.String(),
+		PerpetualOrderType: orderType,
 		TriggerPrice: types.TriggerPrice{
 			TradingAssetDenom: "uatom",
-			Rate:              math.LegacyMustNewDecFromStr("10"),
+			Rate:              triggerPrice,
 		},
 		Position:        types.PerpetualPosition_LONG,
-		Collateral:      sdk.Coin{Denom: "uatom", Amount: math.NewInt(10)},
+		Collateral:      sdk.NewCoin("uatom", math.ZeroInt()),
 		TradingAsset:    "uatom",
-		Leverage:        math.LegacyNewDec(10),
-		TakeProfitPrice: math.LegacyNewDec(10),
+		Leverage:        math.LegacyZeroDec(),
+		TakeProfitPrice: math.LegacyZeroDec(),
 		StopLossPrice:   math.LegacyZeroDec(),
 		PoolId:          1,
+		PositionId:      positionId,
+		Status:          types.Status_PENDING,
+		CloseAmount:     closeAmount,
 	}
+	order.OrderId = suite.app.TradeshieldKeeper.AppendPendingPerpetualOrder(suite.ctx, order)
+	return order
+}
 
-	// Fund orderAddress
-	orderAddress := perpetualOrder.GetOrderAddress()
-	suite.AddAccounts(1, []sdk.AccAddress{orderAddress})
+func (suite *TradeshieldKeeperTestSuite) TestExecuteLimitCloseOrder() {
+	address := suite.AddAccounts(1, nil)
+	_, _, _ = suite.SetPerpetualPool(1)
+	positionId := suite.openPerpetualPosition(address[0])
 
-	suite.app.TradeshieldKeeper.AppendPendingPerpetualOrder(suite.ctx, perpetualOrder)
-	order, _ := suite.app.TradeshieldKeeper.GetPendingPerpetualOrder(suite.ctx, 1)
-	err := suite.app.TradeshieldKeeper.ExecuteLimitOpenOrder(suite.ctx, order)
+	// Market price of 5 is below the limit
+	order := suite.closeOrder(address[0], types.PerpetualOrderType_LIMITCLOSE, positionId, math.LegacyNewDec(6), math.ZeroInt())
+	err := suite.app.TradeshieldKeeper.ExecuteLimitCloseOrder(suite.ctx, order)
 	suite.Require().NoError(err)
-
-	perpetualOrder.PerpetualOrderType = types.PerpetualOrderType_LIMITCLOSE
-	perpetualOrder.TriggerPrice.Rate = math.LegacyZeroDec()
-	perpetualOrder.PositionId = 1
-	orderId := suite.app.TradeshieldKeeper.AppendPendingPerpetualOrder(suite.ctx, perpetualOrder)
-
-	order, found := suite.app.TradeshieldKeeper.GetPendingPerpetualOrder(suite.ctx, orderId)
+	_, found := suite.app.TradeshieldKeeper.GetPendingPerpetualOrder(suite.ctx, order.OrderId)
 	suite.Require().True(found)
 
+	// A zero close amount closes the whole position
+	order = suite.closeOrder(address[0], types.PerpetualOrderType_LIMITCLOSE, positionId, math.LegacyNewDec(4), math.ZeroInt())
 	err = suite.app.TradeshieldKeeper.ExecuteLimitCloseOrder(suite.ctx, order)
+	suite.Require().NoError(err)
+	_, found = suite.app.TradeshieldKeeper.GetPendingPerpetualOrder(suite.ctx, order.OrderId)
+	suite.Require().False(found)
+	_, err = suite.app.PerpetualKeeper.GetMTP(suite.ctx, address[0], positionId)
 	suite.Require().Error(err)
-	suite.Require().Contains(err.Error(), "invalid closing ratio (0.000000000000000000)")
+}
 
-	_, found = suite.app.TradeshieldKeeper.GetPendingPerpetualOrder(suite.ctx, orderId)
+func (suite *TradeshieldKeeperTestSuite) TestExecuteStopLossPerpOrder() {
+	address := suite.AddAccounts(1, nil)
+	_, _, _ = suite.SetPerpetualPool(1)
+	positionId := suite.openPerpetualPosition(address[0])
+
+	mtp, err := suite.app.PerpetualKeeper.GetMTP(suite.ctx, address[0], positionId)
+	suite.Require().NoError(err)
+	closeAmount := mtp.Custody.QuoRaw(2)
+
+	// Market price of 5 is above the stop loss
+	order := suite.closeOrder(address[0], types.PerpetualOrderType_STOPLOSSPERP, positionId, math.LegacyNewDec(4), closeAmount)
+	err = suite.app.TradeshieldKeeper.ExecuteStopLossPerpOrder(suite.ctx, order)
+	suite.Require().NoError(err)
+	_, found := suite.app.TradeshieldKeeper.GetPendingPerpetualOrder(suite.ctx, order.OrderId)
 	suite.Require().True(found)
+
+	suite.app.OracleKeeper.SetPrice(suite.ctx, oracletypes.Price{
+		Asset:     "ATOM",
+		Price:     math.LegacyMustNewDecFromStr("3.9"),
+		Source:    "elys",
+		Provider:  oracleProvider.String(),
+		Timestamp: uint64(suite.ctx.BlockTime().Unix()),
+	})
+	err = suite.app.TradeshieldKeeper.ExecuteStopLossPerpOrder(suite.ctx, order)
+	suite.Require().NoError(err)
+	_, found = suite.app.TradeshieldKeeper.GetPendingPerpetualOrder(suite.ctx, order.OrderId)
+	suite.Require().False(found)
+
+	// Only the order amount was closed
+	closed, err := suite.app.PerpetualKeeper.GetMTP(suite.ctx, address[0], positionId)
+	suite.Require().NoError(err)
+	suite.Require().True(closed.Custody.LT(mtp.Custody))
+	suite.Require().True(closed.Custody.IsPositive())
+}
+
+func (suite *TradeshieldKeeperTestSuite) TestCloseOrderAmount() {
+	order := types.PerpetualOrder{CloseAmount: math.NewInt(40)}
+	long := perpetualtypes.MTP{Position: perpetualtypes.Position_LONG, Custody: math.NewInt(100), Liabilities: math.NewInt(30)}
+	short := perpetualtypes.MTP{Position: perpetualtypes.Position_SHORT, Custody: math.NewInt(100), Liabilities: math.NewInt(30)}
+
+	suite.Require().Equal(math.NewInt(40), keeper.CloseOrderAmount(order, long))
+	suite.Require().Equal(math.NewInt(30), keeper.CloseOrderAmount(order, short))
+	suite.Require().Equal(math.NewInt(100), keeper.CloseOrderAmount(types.PerpetualOrder{CloseAmount: math.ZeroInt()}, long))
+	suite.Require().Equal(math.NewInt(100), keeper.CloseOrderAmount(types.PerpetualOrder{}, long))
 }
 
 func (suite *TradeshieldKeeperTestSuite) TestExecuteMarketOpenOrder() {
@@ -181,44 +246,24 @@ func (suite *TradeshieldKeeperTestSuite) TestExecuteMarketOpenOrder() {
 	suite.Require().False(found)
 }
 
-// TODO: Update tests when close is supported from tradeshield
 func (suite *TradeshieldKeeperTestSuite) TestExecuteMarketCloseOrder() {
-	address := suite.AddAccounts(3, nil)
+	address := suite.AddAccounts(1, nil)
 	_, _, _ = suite.SetPerpetualPool(1)
+	positionId := suite.openPerpetualPosition(address[0])
 
-	perpetualOrder := types.PerpetualOrder{
-		OwnerAddress:       address[2].String(),
-		OrderId:            0,
-		PerpetualOrderType: types.PerpetualOrderType_LIMITOPEN,
-		TriggerPrice: types.TriggerPrice{
-			TradingAssetDenom: "uatom",
-			Rate:              math.LegacyMustNewDecFromStr("10"),
-		},
-		Position:        types.PerpetualPosition_LONG,
-		Collateral:      sdk.Coin{Denom: "uatom", Amount: math.NewInt(10)},
-		TradingAsset:    "uatom",
-		Leverage:        math.LegacyNewDec(10),
-		TakeProfitPrice: math.LegacyNewDec(10),
-		StopLossPrice:   math.LegacyZeroDec(),
-		PoolId:          1,
-	}
-	suite.app.TradeshieldKeeper.AppendPendingPerpetualOrder(suite.ctx, perpetualOrder)
-	order, _ := suite.app.TradeshieldKeeper.GetPendingPerpetualOrder(suite.ctx, 1)
-	err := suite.app.TradeshieldKeeper.ExecuteMarketOpenOrder(suite.ctx, order)
+	order := suite.closeOrder(address[0], types.PerpetualOrderType_LIMITCLOSE, positionId, math.LegacyNewDec(6), math.ZeroInt())
+	err := suite.app.TradeshieldKeeper.ExecuteMarketCloseOrder(suite.ctx, order)
 	suite.Require().NoError(err)
 
-	perpetualOrder.PerpetualOrderType = types.PerpetualOrderType_LIMITCLOSE
-	perpetualOrder.TriggerPrice.Rate = math.LegacyZeroDec()
-	perpetualOrder.PositionId = 1
-	orderId := suite.app.TradeshieldKeeper.AppendPendingPerpetualOrder(suite.ctx, perpetualOrder)
-
-	order, found := suite.app.TradeshieldKeeper.GetPendingPerpetualOrder(suite.ctx, orderId)
-	suite.Require().True(found)
-
-	err = suite.app.TradeshieldKeeper.ExecuteMarketCloseOrder(suite.ctx, order)
+	_, found := suite.app.TradeshieldKeeper.GetPendingPerpetualOrder(suite.ctx, order.OrderId)
+	suite.Require().False(found)
+	_, err = suite.app.PerpetualKeeper.GetMTP(suite.ctx, address[0], positionId)
 	suite.Require().Error(err)
-	suite.Require().Contains(err.Error(), "invalid closing ratio (0.000000000000000000)")
 
-	_, found = suite.app.TradeshieldKeeper.GetPendingPerpetualOrder(suite.ctx, orderId)
-	suite.Require().True(found)
+	// Orders of closed positions are dropped
+	order = suite.closeOrder(address[0], types.PerpetualOrderType_LIMITCLOSE, positionId, math.LegacyNewDec(4), math.ZeroInt())
+	err = suite.app.TradeshieldKeeper.ExecuteLimitCloseOrder(suite.ctx, order)
+	suite.Require().Error(err)
+	_, found = suite.app.TradeshieldKeeper.GetPendingPerpetualOrder(suite.ctx, order.OrderId)
+	suite.Require().False(found)
 }
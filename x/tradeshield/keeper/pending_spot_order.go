@@ -2,8 +2,9 @@ package keeper
 
 import (
 	"encoding/binary"
-	"fmt"
 	"math"
+	"slices"
+	"sort"
 
 	/* *************************************************************************** */
 	/* Start of kwak-indexer node implementation*/
@@ -71,6 +72,8 @@ func (k Keeper) AppendPendingSpotOrder(
 	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.PendingSpotOrderKey)
 	appendedValue := k.cdc.MustMarshal(&pendingSpotOrder)
 	store.Set(GetPendingSpotOrderIDBytes(pendingSpotOrder.OrderId), appendedValue)
+	k.InsertSortedSpotOrder(ctx, pendingSpotOrder)
+	k.setSpotOrderExpiry(ctx, pendingSpotOrder)
 
 	// Update pendingSpotOrder count
 	k.SetPendingSpotOrderCount(ctx, count+1)
@@ -80,9 +83,17 @@ func (k Keeper) AppendPendingSpotOrder(
 
 // SetPendingSpotOrder set a specific pendingSpotOrder in the store
 func (k Keeper) SetPendingSpotOrder(ctx sdk.Context, pendingSpotOrder types.SpotOrder) {
+	// the rate or the denoms may change, move the order in the sorted lists
+	if previous, found := k.GetPendingSpotOrder(ctx, pendingSpotOrder.OrderId); found {
+		k.RemoveSortedSpotOrder(ctx, previous)
+		k.removeSpotOrderExpiry(ctx, previous)
+	}
+
 	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.PendingSpotOrderKey)
 	b := k.cdc.MustMarshal(&pendingSpotOrder)
 	store.Set(GetPendingSpotOrderIDBytes(pendingSpotOrder.OrderId), b)
+	k.InsertSortedSpotOrder(ctx, pendingSpotOrder)
+	k.setSpotOrderExpiry(ctx, pendingSpotOrder)
 }
 
 // GetPendingSpotOrder returns a pendingSpotOrder from its id
@@ -128,6 +139,11 @@ func (k Keeper) GetPendingSpotOrdersForAddress(ctx sdk.Context, address string,
 
 // RemovePendingSpotOrder removes a pendingSpotOrder from the store
 func (k Keeper) RemovePendingSpotOrder(ctx sdk.Context, id uint64) {
+	if order, found := k.GetPendingSpotOrder(ctx, id); found {
+		k.RemoveSortedSpotOrder(ctx, order)
+		k.removeSpotOrderExpiry(ctx, order)
+	}
+	k.removeOrderFailure(ctx, types.SpotOrderFailureKey, id)
 	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.PendingSpotOrderKey)
 	store.Delete(GetPendingSpotOrderIDBytes(id))
 }
@@ -160,7 +176,8 @@ func GetPendingSpotOrderIDFromBytes(bz []byte) uint64 {
 	return binary.BigEndian.Uint64(bz)
 }
 
-// GetAllPendingSpotOrder returns all pendingSpotOrder
+// GetAllSortedSpotOrder returns the ids of the pending spot orders, one list
+// per order type and denom pair sorted by ascending rate
 func (k Keeper) GetAllSortedSpotOrder(ctx sdk.Context) (list [][]uint64, err error) {
 	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.SortedSpotOrderKey)
 	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
@@ -179,6 +196,44 @@ func (k Keeper) GetAllSortedSpotOrder(ctx sdk.Context) (list [][]uint64, err err
 	return
 }
 
+// SpotBinarySearch returns the index at which an order of the given rate is
+// inserted in orderIds, after the orders of the same rate
+func (k Keeper) SpotBinarySearch(ctx sdk.Context, rate sdkmath.LegacyDec, orderIds []uint64) int {
+	rate = sortRate(rate)
+	return sort.Search(len(orderIds), func(i int) bool {
+		order, found := k.GetPendingSpotOrder(ctx, orderIds[i])
+		return !found || sortRate(order.OrderPrice.Rate).GT(rate)
+	})
+}
+
+// InsertSortedSpotOrder adds a pending order to the sorted list of its order
+// type and denom pair
+func (k Keeper) InsertSortedSpotOrder(ctx sdk.Context, order types.SpotOrder) {
+	key, err := types.GenSpotKey(order)
+	if err != nil || order.Status != types.Status_PENDING {
+		// market orders are never pending
+		return
+	}
+
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.SortedSpotOrderKey)
+	orderIds := mustGetSortedOrderIds(store, []byte(key))
+	index := k.SpotBinarySearch(ctx, order.OrderPrice.Rate, orderIds)
+	orderIds = slices.Insert(orderIds, index, order.OrderId)
+	store.Set([]byte(key), types.EncodeUint64Slice(orderIds))
+}
+
+// RemoveSortedSpotOrder removes an order from the sorted list of its order
+// type and denom pair
+func (k Keeper) RemoveSortedSpotOrder(ctx sdk.Context, order types.SpotOrder) {
+	key, err := types.GenSpotKey(order)
+	if err != nil {
+		return
+	}
+
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.SortedSpotOrderKey)
+	removeSortedOrderId(store, []byte(key), order.OrderId)
+}
+
 // DeleteAllPendingSpotOrder deleted all pendingSpotOrder
 func (k Keeper) DeleteAllPendingSpotOrder(ctx sdk.Context) (list []types.SpotOrder) {
 	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.PendingSpotOrderKey)
@@ -190,10 +245,30 @@ func (k Keeper) DeleteAllPendingSpotOrder(ctx sdk.Context) (list []types.SpotOrd
 		store.Delete(iterator.Key())
 	}
 
+	deleteAllSortedOrders(prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.SortedSpotOrderKey))
+	k.deleteAllOrderExpiries(ctx, types.SpotOrderExpiryHeightKey, types.SpotOrderExpiryTimeKey)
+	deleteAllSortedOrders(k.orderFailureStore(ctx, types.SpotOrderFailureKey))
+
 	return
 }
 
-// ExecuteStopLossSpotOrder executes a stop loss order
+// ExecuteSpotOrder dispatches the execution of a spot order based on its type
+func (k Keeper) ExecuteSpotOrder(ctx sdk.Context, order types.SpotOrder) (*ammtypes.MsgSwapByDenomResponse, error) {
+	switch order.OrderType {
+	case types.SpotOrderType_STOPLOSS, types.SpotOrderType_TRAILINGSTOP:
+		return k.ExecuteStopLossOrder(ctx, order)
+	case types.SpotOrderType_LIMITSELL:
+		return k.ExecuteLimitSellOrder(ctx, order)
+	case types.SpotOrderType_LIMITBUY:
+		return k.ExecuteLimitBuyOrder(ctx, order)
+	case types.SpotOrderType_MARKETBUY:
+		return k.ExecuteMarketBuyOrder(ctx, order)
+	}
+	return nil, nil
+}
+
+// ExecuteStopLossOrder executes a stop loss order, or a trailing stop order
+// whose rate is its current stop price
 func (k Keeper) ExecuteStopLossOrder(ctx sdk.Context, order types.SpotOrder) (*ammtypes.MsgSwapByDenomResponse, error) {
 	marketPrice, err := k.GetAssetPriceFromDenomInToDenomOut(ctx, order.OrderPrice.BaseDenom, order.OrderPrice.QuoteDenom)
 	if err != nil {
@@ -215,8 +290,9 @@ func (k Keeper) ExecuteStopLossOrder(ctx sdk.Context, order types.SpotOrder) (*a
 		return nil, err
 	}
 
-	// Swap the order amount with the target denom
-	res, err := k.amm.SwapByDenom(ctx, &ammtypes.MsgSwapByDenom{
+	// Swap the order amount with the target denom, a stop loss sells at the
+	// market price whatever it is
+	res, err := k.amm.ExecuteSwapByDenom(ctx, &ammtypes.MsgSwapByDenom{
 		Sender:    order.OwnerAddress,
 		Recipient: order.OwnerAddress,
 		Amount:    order.OrderAmount,
@@ -231,8 +307,6 @@ func (k Keeper) ExecuteStopLossOrder(ctx sdk.Context, order types.SpotOrder) (*a
 	/* *************************************************************************** */
 	/* Start of kwak-indexer node implementation*/
 
-	eventId := fmt.Sprintf("%d-%d-%d-%s-%s", ctx.BlockHeight(), order.OrderId, order.Status, order.OwnerAddress, indexerTypes.ElysEventTypes.Tradeshield.StopLoss)
-
 	indexer.QueueEvent(ctx, indexerTypes.ElysEventTypes.Tradeshield.StopLoss, indexerTradeshieldTypes.StopLossExecutionEvent{
 		BaseOrder: common.BaseOrder{
 			OrderID:      order.OrderId,
@@ -264,7 +338,7 @@ func (k Keeper) ExecuteStopLossOrder(ctx sdk.Context, order types.SpotOrder) (*a
 		SwapFee:      res.SwapFee.String(),
 		Discount:     res.Discount.String(),
 		Recipient:    res.Recipient,
-	}, []string{order.OwnerAddress}, eventId)
+	}, []string{order.OwnerAddress})
 	/* End of kwak-indexer node implementation*/
 	/* *************************************************************************** */
 
@@ -289,21 +363,29 @@ func (k Keeper) ExecuteLimitSellOrder(ctx sdk.Context, order types.SpotOrder) (*
 		return nil, nil
 	}
 
-	// send the order amount back to the owner
+	// only the part of the order whose effective price stays within the rate
+	// is swapped, the rest stays pending
+	fill := sdk.NewCoin(order.OrderAmount.Denom, k.LimitOrderFillAmount(ctx, order))
+	if !fill.IsPositive() {
+		return nil, nil
+	}
+
+	// send the filled amount back to the owner
 	ownerAddress := sdk.MustAccAddressFromBech32(order.OwnerAddress)
-	err = k.bank.SendCoins(ctx, order.GetOrderAddress(), ownerAddress, sdk.NewCoins(order.OrderAmount))
+	err = k.bank.SendCoins(ctx, order.GetOrderAddress(), ownerAddress, sdk.NewCoins(fill))
 	if err != nil {
 		return nil, err
 	}
 
-	// Swap the order amount with the target denom
-	res, err := k.amm.SwapByDenom(ctx, &ammtypes.MsgSwapByDenom{
+	// Swap the filled amount with the target denom, the output must keep the
+	// effective price within the rate
+	res, err := k.amm.ExecuteSwapByDenom(ctx, &ammtypes.MsgSwapByDenom{
 		Sender:    order.OwnerAddress,
 		Recipient: order.OwnerAddress,
-		Amount:    order.OrderAmount,
+		Amount:    fill,
 		DenomIn:   order.OrderPrice.BaseDenom,
 		DenomOut:  order.OrderPrice.QuoteDenom,
-		MinAmount: sdk.NewCoin(order.OrderTargetDenom, sdkmath.ZeroInt()),
+		MinAmount: sdk.NewCoin(order.OrderTargetDenom, limitOrderMinAmountOut(order, fill.Amount)),
 	})
 	if err != nil {
 		return res, err
@@ -311,7 +393,6 @@ func (k Keeper) ExecuteLimitSellOrder(ctx sdk.Context, order types.SpotOrder) (*
 
 	/* *************************************************************************** */
 	/* Start of kwak-indexer node implementation*/
-	eventId := fmt.Sprintf("%d-%d-%d-%s-%s", ctx.BlockHeight(), order.OrderId, order.Status, order.OwnerAddress, indexerTypes.ElysEventTypes.Tradeshield.LimitSell)
 
 	// Queue the limit sell order execution event
 	indexer.QueueEvent(ctx, indexerTypes.ElysEventTypes.Tradeshield.LimitSell, indexerTradeshieldTypes.LimitSellExecutionEvent{
@@ -330,7 +411,7 @@ func (k Keeper) ExecuteLimitSellOrder(ctx sdk.Context, order types.SpotOrder) (*
 		},
 		OrderType:        common.OrderType_SPOT,
 		OrderTargetDenom: order.OrderTargetDenom,
-		Status:           common.Status_EXECUTED,
+		Status:           limitOrderStatus(order, fill),
 		Date: common.OrderDate{
 			Height:    uint64(ctx.BlockHeight()),
 			Timestamp: uint64(ctx.BlockTime().Unix()),
@@ -340,23 +421,25 @@ func (k Keeper) ExecuteLimitSellOrder(ctx sdk.Context, order types.SpotOrder) (*
 			Amount: res.Amount.Amount.String(),
 			Denom:  res.Amount.Denom,
 		},
-		SpotPrice: res.SpotPrice.String(),
-		SwapFee:   res.SwapFee.String(),
-		Discount:  res.Discount.String(),
-		Recipient: res.Recipient,
-	}, []string{order.OwnerAddress}, eventId)
+		SpotPrice:       res.SpotPrice.String(),
+		SwapFee:         res.SwapFee.String(),
+		Discount:        res.Discount.String(),
+		Recipient:       res.Recipient,
+		FillAmount:      indexerToken(fill),
+		RemainingAmount: indexerToken(order.OrderAmount.Sub(fill)),
+	}, []string{order.OwnerAddress})
 	/* End of kwak-indexer node implementation*/
 	/* *************************************************************************** */
 
-	// Remove the order from the pending order list
-	k.RemovePendingSpotOrder(ctx, order.OrderId)
+	k.settleLimitOrderFill(ctx, order, fill)
 
 	return res, nil
 }
 
-// ExecuteLimitBuyOrder executes a limit buy order
+// ExecuteLimitBuyOrder executes a limit buy order, its rate is the price of
+// the target quote denom in the paid base denom
 func (k Keeper) ExecuteLimitBuyOrder(ctx sdk.Context, order types.SpotOrder) (*ammtypes.MsgSwapByDenomResponse, error) {
-	marketPrice, err := k.GetAssetPriceFromDenomInToDenomOut(ctx, order.OrderPrice.BaseDenom, order.OrderPrice.QuoteDenom)
+	marketPrice, err := k.spotOrderMarketPrice(ctx, order)
 	if err != nil {
 		return nil, err
 	}
@@ -369,21 +452,29 @@ func (k Keeper) ExecuteLimitBuyOrder(ctx sdk.Context, order types.SpotOrder) (*a
 		return nil, nil
 	}
 
-	// send the order amount back to the owner
+	// only the part of the order whose effective price stays within the rate
+	// is swapped, the rest stays pending
+	fill := sdk.NewCoin(order.OrderAmount.Denom, k.LimitOrderFillAmount(ctx, order))
+	if !fill.IsPositive() {
+		return nil, nil
+	}
+
+	// send the filled amount back to the owner
 	ownerAddress := sdk.MustAccAddressFromBech32(order.OwnerAddress)
-	err = k.bank.SendCoins(ctx, order.GetOrderAddress(), ownerAddress, sdk.NewCoins(order.OrderAmount))
+	err = k.bank.SendCoins(ctx, order.GetOrderAddress(), ownerAddress, sdk.NewCoins(fill))
 	if err != nil {
 		return nil, err
 	}
 
-	// Swap the order amount with the target denom
-	res, err := k.amm.SwapByDenom(ctx, &ammtypes.MsgSwapByDenom{
+	// Swap the filled amount with the target denom, the output must keep the
+	// effective price within the rate
+	res, err := k.amm.ExecuteSwapByDenom(ctx, &ammtypes.MsgSwapByDenom{
 		Sender:    order.OwnerAddress,
 		Recipient: order.OwnerAddress,
-		Amount:    order.OrderAmount,
+		Amount:    fill,
 		DenomIn:   order.OrderPrice.BaseDenom,
 		DenomOut:  order.OrderPrice.QuoteDenom,
-		MinAmount: sdk.NewCoin(order.OrderTargetDenom, sdkmath.ZeroInt()),
+		MinAmount: sdk.NewCoin(order.OrderTargetDenom, limitOrderMinAmountOut(order, fill.Amount)),
 	})
 	if err != nil {
 		return res, err
@@ -391,7 +482,6 @@ func (k Keeper) ExecuteLimitBuyOrder(ctx sdk.Context, order types.SpotOrder) (*a
 
 	/* *************************************************************************** */
 	/* Start of kwak-indexer node implementation*/
-	eventId := fmt.Sprintf("%d-%d-%d-%s-%s", ctx.BlockHeight(), order.OrderId, order.Status, order.OwnerAddress, indexerTypes.ElysEventTypes.Tradeshield.LimitBuy)
 
 	indexer.QueueEvent(ctx, indexerTypes.ElysEventTypes.Tradeshield.LimitBuy, indexerTradeshieldTypes.LimitOrderExecutionEvent{
 		BaseOrder: common.BaseOrder{
@@ -409,7 +499,7 @@ func (k Keeper) ExecuteLimitBuyOrder(ctx sdk.Context, order types.SpotOrder) (*a
 		},
 		OrderType:        common.OrderType_SPOT,
 		OrderTargetDenom: order.OrderTargetDenom,
-		Status:           common.Status_EXECUTED,
+		Status:           limitOrderStatus(order, fill),
 		Date: common.OrderDate{
 			Height:    uint64(ctx.BlockHeight()),
 			Timestamp: uint64(ctx.BlockTime().Unix()),
@@ -419,24 +509,38 @@ func (k Keeper) ExecuteLimitBuyOrder(ctx sdk.Context, order types.SpotOrder) (*a
 			Amount: res.Amount.Amount.String(),
 			Denom:  res.Amount.Denom,
 		},
-		SpotPrice: res.SpotPrice.String(),
-		SwapFee:   res.SwapFee.String(),
-		Discount:  res.Discount.String(),
-		Recipient: res.Recipient,
-	}, []string{order.OwnerAddress}, eventId)
+		SpotPrice:       res.SpotPrice.String(),
+		SwapFee:         res.SwapFee.String(),
+		Discount:        res.Discount.String(),
+		Recipient:       res.Recipient,
+		FillAmount:      indexerToken(fill),
+		RemainingAmount: indexerToken(order.OrderAmount.Sub(fill)),
+	}, []string{order.OwnerAddress})
 	/* End of kwak-indexer node implementation*/
 	/* *************************************************************************** */
 
-	// Remove the order from the pending order list
-	k.RemovePendingSpotOrder(ctx, order.OrderId)
+	k.settleLimitOrderFill(ctx, order, fill)
 
 	return res, nil
 }
 
+// settleLimitOrderFill records the filled amount of a limit order, a partially
+// filled order stays pending with the amount left to fill
+func (k Keeper) settleLimitOrderFill(ctx sdk.Context, order types.SpotOrder, fill sdk.Coin) {
+	order.AddFill(fill)
+	if order.OrderAmount.IsPositive() {
+		k.SetPendingSpotOrder(ctx, order)
+		return
+	}
+
+	// Remove the order from the pending order list
+	k.RemovePendingSpotOrder(ctx, order.OrderId)
+}
+
 // ExecuteMarketBuyOrder executes a market buy order
 func (k Keeper) ExecuteMarketBuyOrder(ctx sdk.Context, order types.SpotOrder) (*ammtypes.MsgSwapByDenomResponse, error) {
 	// Swap the order amount with the target denom
-	res, err := k.amm.SwapByDenom(ctx, &ammtypes.MsgSwapByDenom{
+	res, err := k.amm.ExecuteSwapByDenom(ctx, &ammtypes.MsgSwapByDenom{
 		Sender:    order.OwnerAddress,
 		Recipient: order.OwnerAddress,
 		Amount:    order.OrderAmount,
@@ -450,7 +554,6 @@ func (k Keeper) ExecuteMarketBuyOrder(ctx sdk.Context, order types.SpotOrder) (*
 
 	/* *************************************************************************** */
 	/* Start of kwak-indexer node implementation*/
-	eventId := fmt.Sprintf("%d-%d-%d-%s-%s", ctx.BlockHeight(), order.OrderId, order.Status, order.OwnerAddress, indexerTypes.ElysEventTypes.Tradeshield.MarketBuy)
 
 	indexer.QueueEvent(ctx, indexerTypes.ElysEventTypes.Tradeshield.MarketBuy, indexerTradeshieldTypes.MarketOrderExecutionEvent{
 		BaseOrder: common.BaseOrder{
@@ -482,7 +585,7 @@ func (k Keeper) ExecuteMarketBuyOrder(ctx sdk.Context, order types.SpotOrder) (*
 		SwapFee:   res.SwapFee.String(),
 		Discount:  res.Discount.String(),
 		Recipient: res.Recipient,
-	}, []string{order.OwnerAddress}, eventId)
+	}, []string{order.OwnerAddress})
 	/* End of kwak-indexer node implementation*/
 	/* *************************************************************************** */
 
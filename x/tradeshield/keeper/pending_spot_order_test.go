@@ -189,7 +189,8 @@ func (suite *TradeshieldKeeperTestSuite) TestExecuteLimitBuyOrder() {
 		OrderPrice: types.OrderPrice{
 			BaseDenom:  "uusdc",
 			QuoteDenom: "uatom",
-			Rate:       math.LegacyNewDec(1),
+			// ATOM is bought at up to 6 USDC, its market price is 5
+			Rate: math.LegacyNewDec(6),
 		},
 		OrderTargetDenom: "uatom",
 		OrderAmount:      sdk.NewCoin("uusdc", math.NewInt(100000)),
@@ -258,3 +259,47 @@ func (suite *TradeshieldKeeperTestSuite) TestExecuteMarketBuyOrder() {
 	_, found := suite.app.TradeshieldKeeper.GetPendingSpotOrder(suite.ctx, 1)
 	suite.Assert().False(found)
 }
+
+func (suite *TradeshieldKeeperTestSuite) TestSortedSpotOrder() {
+	var ids []uint64
+	for _, rate := range []int64{3, 1, 2} {
+		ids = append(ids, suite.app.TradeshieldKeeper.AppendPendingSpotOrder(suite.ctx, types.SpotOrder{
+			OrderType: types.SpotOrderType_LIMITSELL,
+			OrderPrice: types.OrderPrice{
+				BaseDenom:  "uatom",
+				QuoteDenom: "uusdc",
+				Rate:       math.LegacyNewDec(rate),
+			},
+		}))
+	}
+	// Orders of another type are kept in their own list
+	other := suite.app.TradeshieldKeeper.AppendPendingSpotOrder(suite.ctx, types.SpotOrder{
+		OrderType: types.SpotOrderType_STOPLOSS,
+		OrderPrice: types.OrderPrice{
+			BaseDenom:  "uatom",
+			QuoteDenom: "uusdc",
+			Rate:       math.LegacyNewDec(1),
+		},
+	})
+
+	lists, err := suite.app.TradeshieldKeeper.GetAllSortedSpotOrder(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal([][]uint64{{ids[1], ids[2], ids[0]}, {other}}, lists)
+
+	// Updating the rate moves the order
+	order, found := suite.app.TradeshieldKeeper.GetPendingSpotOrder(suite.ctx, ids[1])
+	suite.Require().True(found)
+	order.OrderPrice.Rate = math.LegacyNewDec(5)
+	suite.app.TradeshieldKeeper.SetPendingSpotOrder(suite.ctx, order)
+
+	lists, err = suite.app.TradeshieldKeeper.GetAllSortedSpotOrder(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal([][]uint64{{ids[2], ids[0], ids[1]}, {other}}, lists)
+
+	suite.app.TradeshieldKeeper.RemovePendingSpotOrder(suite.ctx, ids[0])
+	suite.app.TradeshieldKeeper.RemovePendingSpotOrder(suite.ctx, other)
+
+	lists, err = suite.app.TradeshieldKeeper.GetAllSortedSpotOrder(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal([][]uint64{{ids[2], ids[1]}}, lists)
+}
@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/elys-network/elys/x/tradeshield/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) SpotOrderBook(goCtx context.Context, req *types.QuerySpotOrderBookRequest) (*types.QuerySpotOrderBookResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if !req.BucketSize.IsNil() && req.BucketSize.IsNegative() {
+		return nil, status.Error(codes.InvalidArgument, "bucket size cannot be negative")
+	}
+
+	page, err := newOrderBookPage(req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	levels, pageRes := page.paginate(k.GetSpotOrderBook(ctx, req.BaseDenom, req.QuoteDenom, req.Side, req.BucketSize, page.reverse, page.maxLevels()))
+
+	return &types.QuerySpotOrderBookResponse{Levels: levels, Pagination: pageRes}, nil
+}
+
+func (k Keeper) PerpetualOrderBook(goCtx context.Context, req *types.QueryPerpetualOrderBookRequest) (*types.QueryPerpetualOrderBookResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.Position != types.PerpetualPosition_LONG && req.Position != types.PerpetualPosition_SHORT {
+		return nil, status.Error(codes.InvalidArgument, "invalid position")
+	}
+	if !req.BucketSize.IsNil() && req.BucketSize.IsNegative() {
+		return nil, status.Error(codes.InvalidArgument, "bucket size cannot be negative")
+	}
+
+	page, err := newOrderBookPage(req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	levels, pageRes := page.paginate(k.GetPerpetualOrderBook(ctx, req.TradingAsset, req.Position, req.OrderType, req.BucketSize, page.reverse, page.maxLevels()))
+
+	return &types.QueryPerpetualOrderBookResponse{Levels: levels, Pagination: pageRes}, nil
+}
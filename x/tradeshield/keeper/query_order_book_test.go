@@ -0,0 +1,279 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	ptypes "github.com/elys-network/elys/x/parameter/types"
+	"github.com/elys-network/elys/x/tradeshield/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (suite *TradeshieldKeeperTestSuite) TestSpotOrderBook() {
+	k := suite.app.TradeshieldKeeper
+
+	// limit buys pay the quote denom of the book for its base denom
+	appendOrder := func(orderType types.SpotOrderType, rate string, amount int64) {
+		paidDenom, targetDenom := "uatom", "uusdc"
+		if orderType == types.SpotOrderType_LIMITBUY {
+			paidDenom, targetDenom = "uusdc", "uatom"
+		}
+		k.AppendPendingSpotOrder(suite.ctx, types.SpotOrder{
+			OwnerAddress: "valid_address",
+			OrderType:    orderType,
+			OrderPrice: types.OrderPrice{
+				BaseDenom:  paidDenom,
+				QuoteDenom: targetDenom,
+				Rate:       math.LegacyMustNewDecFromStr(rate),
+			},
+			OrderAmount:      sdk.NewInt64Coin(paidDenom, amount),
+			OrderTargetDenom: targetDenom,
+			Deposit:          sdk.NewCoin(ptypes.Elys, math.ZeroInt()),
+			FilledAmount:     sdk.NewCoin(paidDenom, math.ZeroInt()),
+			Status:           types.Status_PENDING,
+		})
+	}
+	appendOrder(types.SpotOrderType_LIMITSELL, "1.2", 30)
+	appendOrder(types.SpotOrderType_LIMITSELL, "1.05", 10)
+	appendOrder(types.SpotOrderType_LIMITSELL, "1.09", 20)
+	appendOrder(types.SpotOrderType_LIMITSELL, "2.5", 40)
+	appendOrder(types.SpotOrderType_LIMITBUY, "0.5", 50)
+	appendOrder(types.SpotOrderType_LIMITBUY, "0.95", 60)
+	appendOrder(types.SpotOrderType_LIMITBUY, "0.95", 70)
+	// not part of the order book
+	appendOrder(types.SpotOrderType_STOPLOSS, "0.8", 80)
+
+	level := func(price string, count uint64, amount int64) types.OrderBookLevel {
+		return types.OrderBookLevel{
+			Price:      math.LegacyMustNewDecFromStr(price),
+			OrderCount: count,
+			Amount:     sdk.NewCoins(sdk.NewInt64Coin("uatom", amount)),
+		}
+	}
+	bid := func(price string, count uint64, amount int64) types.OrderBookLevel {
+		return types.OrderBookLevel{
+			Price:      math.LegacyMustNewDecFromStr(price),
+			OrderCount: count,
+			Amount:     sdk.NewCoins(sdk.NewInt64Coin("uusdc", amount)),
+		}
+	}
+
+	tests := []struct {
+		desc     string
+		request  *types.QuerySpotOrderBookRequest
+		response *types.QuerySpotOrderBookResponse
+		err      error
+	}{
+		{
+			desc: "sell side by exact price",
+			request: &types.QuerySpotOrderBookRequest{
+				BaseDenom:  "uatom",
+				QuoteDenom: "uusdc",
+				Side:       types.OrderBookSide_SELL,
+			},
+			response: &types.QuerySpotOrderBookResponse{
+				Levels: []types.OrderBookLevel{
+					level("1.05", 1, 10),
+					level("1.09", 1, 20),
+					level("1.2", 1, 30),
+					level("2.5", 1, 40),
+				},
+				Pagination: &query.PageResponse{},
+			},
+		},
+		{
+			desc: "sell side by bucket",
+			request: &types.QuerySpotOrderBookRequest{
+				BaseDenom:  "uatom",
+				QuoteDenom: "uusdc",
+				Side:       types.OrderBookSide_SELL,
+				BucketSize: math.LegacyMustNewDecFromStr("0.1"),
+				Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+			},
+			response: &types.QuerySpotOrderBookResponse{
+				Levels: []types.OrderBookLevel{
+					level("1.0", 2, 30),
+					level("1.2", 1, 30),
+				},
+				Pagination: &query.PageResponse{
+					NextKey: sdk.Uint64ToBigEndian(2),
+					Total:   3,
+				},
+			},
+		},
+		{
+			desc: "sell side next page",
+			request: &types.QuerySpotOrderBookRequest{
+				BaseDenom:  "uatom",
+				QuoteDenom: "uusdc",
+				Side:       types.OrderBookSide_SELL,
+				BucketSize: math.LegacyMustNewDecFromStr("0.1"),
+				Pagination: &query.PageRequest{Key: sdk.Uint64ToBigEndian(2), Limit: 2},
+			},
+			response: &types.QuerySpotOrderBookResponse{
+				Levels:     []types.OrderBookLevel{level("2.5", 1, 40)},
+				Pagination: &query.PageResponse{},
+			},
+		},
+		{
+			desc: "sell side first page without total",
+			request: &types.QuerySpotOrderBookRequest{
+				BaseDenom:  "uatom",
+				QuoteDenom: "uusdc",
+				Side:       types.OrderBookSide_SELL,
+				Pagination: &query.PageRequest{Limit: 3},
+			},
+			response: &types.QuerySpotOrderBookResponse{
+				Levels: []types.OrderBookLevel{
+					level("1.05", 1, 10),
+					level("1.09", 1, 20),
+					level("1.2", 1, 30),
+				},
+				Pagination: &query.PageResponse{NextKey: sdk.Uint64ToBigEndian(3)},
+			},
+		},
+		{
+			desc: "sell side from the farthest ask",
+			request: &types.QuerySpotOrderBookRequest{
+				BaseDenom:  "uatom",
+				QuoteDenom: "uusdc",
+				Side:       types.OrderBookSide_SELL,
+				BucketSize: math.LegacyMustNewDecFromStr("0.1"),
+				Pagination: &query.PageRequest{Offset: 1, Limit: 1, Reverse: true},
+			},
+			response: &types.QuerySpotOrderBookResponse{
+				Levels:     []types.OrderBookLevel{level("1.2", 1, 30)},
+				Pagination: &query.PageResponse{NextKey: sdk.Uint64ToBigEndian(2)},
+			},
+		},
+		{
+			desc: "buy side from the best bid",
+			request: &types.QuerySpotOrderBookRequest{
+				BaseDenom:  "uatom",
+				QuoteDenom: "uusdc",
+				Side:       types.OrderBookSide_BUY,
+				BucketSize: math.LegacyMustNewDecFromStr("0.1"),
+			},
+			response: &types.QuerySpotOrderBookResponse{
+				Levels: []types.OrderBookLevel{
+					bid("0.9", 2, 130),
+					bid("0.5", 1, 50),
+				},
+				Pagination: &query.PageResponse{},
+			},
+		},
+		{
+			desc: "unknown pair",
+			request: &types.QuerySpotOrderBookRequest{
+				BaseDenom:  "uusdc",
+				QuoteDenom: "uatom",
+				Side:       types.OrderBookSide_SELL,
+			},
+			response: &types.QuerySpotOrderBookResponse{
+				Pagination: &query.PageResponse{},
+			},
+		},
+		{
+			desc: "negative bucket size",
+			request: &types.QuerySpotOrderBookRequest{
+				BaseDenom:  "uatom",
+				QuoteDenom: "uusdc",
+				BucketSize: math.LegacyNewDec(-1),
+			},
+			err: status.Error(codes.InvalidArgument, "bucket size cannot be negative"),
+		},
+		{
+			desc:    "invalid request",
+			request: nil,
+			err:     status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.desc, func() {
+			response, err := k.SpotOrderBook(suite.ctx, tc.request)
+			if tc.err != nil {
+				suite.Require().ErrorIs(err, tc.err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.response, response)
+			}
+		})
+	}
+}
+
+func (suite *TradeshieldKeeperTestSuite) TestPerpetualOrderBook() {
+	k := suite.app.TradeshieldKeeper
+
+	appendOrder := func(position types.PerpetualPosition, rate string, collateral int64) {
+		k.AppendPendingPerpetualOrder(suite.ctx, types.PerpetualOrder{
+			OwnerAddress:       "valid_address",
+			PerpetualOrderType: types.PerpetualOrderType_LIMITOPEN,
+			TriggerPrice: types.TriggerPrice{
+				TradingAssetDenom: "uatom",
+				Rate:              math.LegacyMustNewDecFromStr(rate),
+			},
+			Position:        position,
+			Collateral:      sdk.NewInt64Coin("uusdc", collateral),
+			TradingAsset:    "uatom",
+			Leverage:        math.LegacyNewDec(2),
+			TakeProfitPrice: math.LegacyZeroDec(),
+			StopLossPrice:   math.LegacyZeroDec(),
+			PoolId:          1,
+			CloseAmount:     math.ZeroInt(),
+			Deposit:         sdk.NewCoin(ptypes.Elys, math.ZeroInt()),
+			Status:          types.Status_PENDING,
+		})
+	}
+	appendOrder(types.PerpetualPosition_LONG, "4", 100)
+	appendOrder(types.PerpetualPosition_LONG, "4.8", 200)
+	appendOrder(types.PerpetualPosition_LONG, "4.6", 300)
+	appendOrder(types.PerpetualPosition_SHORT, "6", 400)
+	appendOrder(types.PerpetualPosition_SHORT, "5.5", 500)
+
+	level := func(price string, count uint64, collateral int64) types.OrderBookLevel {
+		return types.OrderBookLevel{
+			Price:      math.LegacyMustNewDecFromStr(price),
+			OrderCount: count,
+			Amount:     sdk.NewCoins(sdk.NewInt64Coin("uusdc", collateral)),
+		}
+	}
+
+	// long limit open orders execute below their trigger price, the closest
+	// to the market is the highest one
+	res, err := k.PerpetualOrderBook(suite.ctx, &types.QueryPerpetualOrderBookRequest{
+		TradingAsset: "uatom",
+		Position:     types.PerpetualPosition_LONG,
+		OrderType:    types.PerpetualOrderType_LIMITOPEN,
+		BucketSize:   math.LegacyOneDec(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.OrderBookLevel{level("4", 3, 600)}, res.Levels)
+
+	res, err = k.PerpetualOrderBook(suite.ctx, &types.QueryPerpetualOrderBookRequest{
+		TradingAsset: "uatom",
+		Position:     types.PerpetualPosition_LONG,
+		OrderType:    types.PerpetualOrderType_LIMITOPEN,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.OrderBookLevel{
+		level("4.8", 1, 200),
+		level("4.6", 1, 300),
+		level("4", 1, 100),
+	}, res.Levels)
+
+	res, err = k.PerpetualOrderBook(suite.ctx, &types.QueryPerpetualOrderBookRequest{
+		TradingAsset: "uatom",
+		Position:     types.PerpetualPosition_SHORT,
+		OrderType:    types.PerpetualOrderType_LIMITOPEN,
+		Pagination:   &query.PageRequest{Offset: 1},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.OrderBookLevel{level("6", 1, 400)}, res.Levels)
+
+	_, err = k.PerpetualOrderBook(suite.ctx, &types.QueryPerpetualOrderBookRequest{
+		TradingAsset: "uatom",
+	})
+	suite.Require().ErrorIs(err, status.Error(codes.InvalidArgument, "invalid position"))
+}
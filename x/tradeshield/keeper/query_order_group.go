@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/elys-network/elys/x/tradeshield/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) OrderGroup(goCtx context.Context, req *types.QueryGetOrderGroupRequest) (*types.QueryGetOrderGroupResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	group, found := k.GetOrderGroup(ctx, req.Id)
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	res := &types.QueryGetOrderGroupResponse{OrderGroup: group}
+	for _, id := range group.SpotOrderIds {
+		if order, found := k.GetPendingSpotOrder(ctx, id); found {
+			res.SpotOrders = append(res.SpotOrders, order)
+		}
+	}
+	for _, id := range group.PerpetualOrderIds {
+		order, found := k.GetPendingPerpetualOrder(ctx, id)
+		if !found {
+			continue
+		}
+		extraInfo, err := k.ConstructPerpetualOrderExtraInfo(ctx, order)
+		if err != nil {
+			return nil, err
+		}
+		res.PerpetualOrders = append(res.PerpetualOrders, *extraInfo)
+	}
+
+	return res, nil
+}
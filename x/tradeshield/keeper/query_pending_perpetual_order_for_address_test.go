@@ -44,6 +44,8 @@ func (suite *TradeshieldKeeperTestSuite) TestPendingPerpetualOrderForAddress() {
 			PositionId:         positionId,
 			Status:             types.Status_PENDING,
 			StopLossPrice:      math.LegacyNewDec(1),
+			CloseAmount:        math.ZeroInt(),
+			Deposit:            sdk.NewCoin(ptypes.Elys, math.ZeroInt()),
 			PoolId:             1,
 		},
 		LiquidationPrice:   math.LegacyZeroDec(),
@@ -65,6 +67,8 @@ func (suite *TradeshieldKeeperTestSuite) TestPendingPerpetualOrderForAddress() {
 			PositionId:         positionId,
 			Status:             types.Status_EXECUTED,
 			StopLossPrice:      math.LegacyNewDec(1),
+			CloseAmount:        math.ZeroInt(),
+			Deposit:            sdk.NewCoin(ptypes.Elys, math.ZeroInt()),
 		},
 		LiquidationPrice:   math.LegacyZeroDec(),
 		FundingRate:        math.LegacyZeroDec(),
@@ -4,6 +4,7 @@ import (
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	ptypes "github.com/elys-network/elys/x/parameter/types"
 	"github.com/elys-network/elys/x/tradeshield/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -26,6 +27,8 @@ func (suite *TradeshieldKeeperTestSuite) TestPendingPerpetualtOrder() {
 		TakeProfitPrice: math.LegacyNewDec(10),
 		StopLossPrice:   math.LegacyZeroDec(),
 		PoolId:          1,
+		CloseAmount:     math.ZeroInt(),
+		Deposit:         sdk.NewCoin(ptypes.Elys, math.ZeroInt()),
 	}
 
 	tests := []struct {
@@ -89,6 +92,8 @@ func (suite *TradeshieldKeeperTestSuite) TestPendingPerpetualOrderAll() {
 		TakeProfitPrice: math.LegacyNewDec(10),
 		StopLossPrice:   math.LegacyZeroDec(),
 		PoolId:          1,
+		CloseAmount:     math.ZeroInt(),
+		Deposit:         sdk.NewCoin(ptypes.Elys, math.ZeroInt()),
 	}
 
 	order2 := order
@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	ptypes "github.com/elys-network/elys/x/parameter/types"
 	"github.com/elys-network/elys/x/tradeshield/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -21,6 +22,8 @@ func (suite *TradeshieldKeeperTestSuite) TestPendingSpotOrderForAddress() {
 		},
 		OrderAmount:      sdk.NewCoin("base", math.NewInt(1)),
 		OrderTargetDenom: "quote",
+		Deposit:          sdk.NewCoin(ptypes.Elys, math.ZeroInt()),
+		FilledAmount:     sdk.NewCoin("base", math.ZeroInt()),
 		Status:           types.Status_PENDING,
 	}
 
@@ -35,6 +38,8 @@ func (suite *TradeshieldKeeperTestSuite) TestPendingSpotOrderForAddress() {
 		},
 		OrderAmount:      sdk.NewCoin("base", math.NewInt(1)),
 		OrderTargetDenom: "quote",
+		Deposit:          sdk.NewCoin(ptypes.Elys, math.ZeroInt()),
+		FilledAmount:     sdk.NewCoin("base", math.ZeroInt()),
 		Status:           types.Status_EXECUTED,
 	}
 
@@ -4,6 +4,7 @@ import (
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	ptypes "github.com/elys-network/elys/x/parameter/types"
 	"github.com/elys-network/elys/x/tradeshield/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -22,6 +23,8 @@ func (suite *TradeshieldKeeperTestSuite) TestPendingSpotOrder() {
 		},
 		OrderAmount:      sdk.NewCoin("base", math.NewInt(1)),
 		OrderTargetDenom: "quote",
+		Deposit:          sdk.NewCoin(ptypes.Elys, math.ZeroInt()),
+		FilledAmount:     sdk.NewCoin("base", math.ZeroInt()),
 		Status:           types.Status_PENDING,
 	}
 
@@ -77,6 +80,8 @@ func (suite *TradeshieldKeeperTestSuite) TestPendingSpotOrderAll() {
 		},
 		OrderAmount:      sdk.NewCoin("base", math.NewInt(1)),
 		OrderTargetDenom: "quote",
+		Deposit:          sdk.NewCoin(ptypes.Elys, math.ZeroInt()),
+		FilledAmount:     sdk.NewCoin("base", math.ZeroInt()),
 		Status:           types.Status_PENDING,
 	}
 
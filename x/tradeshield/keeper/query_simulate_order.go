@@ -0,0 +1,153 @@
+package keeper
+
+import (
+	"context"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ammtypes "github.com/elys-network/elys/x/amm/types"
+	perpetualtypes "github.com/elys-network/elys/x/perpetual/types"
+	"github.com/elys-network/elys/x/tradeshield/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) SimulateSpotOrder(goCtx context.Context, req *types.QuerySimulateSpotOrderRequest) (*types.QuerySimulateSpotOrderResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if !req.OrderAmount.IsValid() || !req.OrderAmount.IsPositive() {
+		return nil, status.Error(codes.InvalidArgument, "invalid order amount")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	order := types.SpotOrder{
+		OrderType:        req.OrderType,
+		OrderPrice:       req.OrderPrice,
+		OrderAmount:      req.OrderAmount,
+		OwnerAddress:     req.OwnerAddress,
+		OrderTargetDenom: req.OrderTargetDenom,
+	}
+
+	// market orders swap the order amount into the target denom, the other
+	// orders swap from the base denom to the quote denom of their price
+	denomIn, denomOut := order.OrderPrice.BaseDenom, order.OrderPrice.QuoteDenom
+	if order.OrderType == types.SpotOrderType_MARKETBUY {
+		denomIn, denomOut = order.OrderAmount.Denom, order.OrderTargetDenom
+	}
+
+	estimation, err := k.amm.SwapEstimationByDenom(ctx, &ammtypes.QuerySwapEstimationByDenomRequest{
+		Amount:   order.OrderAmount,
+		DenomIn:  denomIn,
+		DenomOut: denomOut,
+		Address:  order.OwnerAddress,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// the market price is the one the rate of the order is compared to, a
+	// limit buy is priced as its target denom in the paid denom
+	var marketPrice sdkmath.LegacyDec
+	if order.OrderType == types.SpotOrderType_MARKETBUY {
+		marketPrice, err = k.GetAssetPriceFromDenomInToDenomOut(ctx, denomIn, denomOut)
+	} else {
+		marketPrice, err = k.spotOrderMarketPrice(ctx, order)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	fill := sdk.NewCoin(order.OrderAmount.Denom, sdkmath.ZeroInt())
+	executable := order.OrderType == types.SpotOrderType_MARKETBUY ||
+		rateReached(marketPrice, order.OrderPrice.Rate, spotOrderExecutesBelowRate(order))
+	if executable {
+		switch order.OrderType {
+		case types.SpotOrderType_LIMITBUY, types.SpotOrderType_LIMITSELL:
+			fill.Amount = k.LimitOrderFillAmount(ctx, order)
+		default:
+			fill = order.OrderAmount
+		}
+	}
+
+	return &types.QuerySimulateSpotOrderResponse{
+		AmountOut:          estimation.Amount,
+		SpotPrice:          estimation.SpotPrice,
+		SwapFee:            estimation.SwapFee,
+		Discount:           estimation.Discount,
+		Slippage:           estimation.Slippage,
+		PriceImpact:        estimation.PriceImpact,
+		WeightBalanceRatio: estimation.WeightBalanceRatio,
+		MarketPrice:        marketPrice,
+		Executable:         executable,
+		FillAmount:         fill,
+	}, nil
+}
+
+func (k Keeper) SimulatePerpetualOrder(goCtx context.Context, req *types.QuerySimulatePerpetualOrderRequest) (*types.QuerySimulatePerpetualOrderResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.Position != types.PerpetualPosition_LONG && req.Position != types.PerpetualPosition_SHORT {
+		return nil, status.Error(codes.InvalidArgument, "invalid position")
+	}
+	if !req.Collateral.IsValid() || !req.Collateral.IsPositive() {
+		return nil, status.Error(codes.InvalidArgument, "invalid collateral")
+	}
+	if err := types.CheckLegacyDecNilAndNegative(req.Leverage, "Leverage"); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	takeProfitPrice := req.TakeProfitPrice
+	if takeProfitPrice.IsNil() {
+		takeProfitPrice = perpetualtypes.TakeProfitPriceDefault
+	}
+	if err := types.CheckLegacyDecNilAndNegative(takeProfitPrice, "TakeProfitPrice"); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	triggerPrice := sortRate(req.TriggerPrice)
+
+	estimation, err := k.perpetual.HandleOpenEstimation(ctx, &perpetualtypes.QueryOpenEstimationRequest{
+		Position:        perpetualtypes.Position(req.Position),
+		Leverage:        req.Leverage,
+		TradingAsset:    req.TradingAsset,
+		Collateral:      req.Collateral,
+		Address:         req.OwnerAddress,
+		TakeProfitPrice: takeProfitPrice,
+		PoolId:          req.PoolId,
+		LimitPrice:      triggerPrice,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	marketPrice, err := k.perpetual.GetAssetPrice(ctx, req.TradingAsset)
+	if err != nil {
+		return nil, err
+	}
+
+	// a zero trigger price opens at the market price
+	executable := triggerPrice.IsZero() || rateReached(marketPrice, triggerPrice, perpetualOrderExecutesBelowRate(types.PerpetualOrder{
+		Position:           req.Position,
+		PerpetualOrderType: types.PerpetualOrderType_LIMITOPEN,
+	}))
+
+	return &types.QuerySimulatePerpetualOrderResponse{
+		PositionSize:       estimation.PositionSize,
+		OpenPrice:          estimation.OpenPrice,
+		LiquidationPrice:   estimation.LiquidationPrice,
+		EffectiveLeverage:  estimation.EffectiveLeverage,
+		Custody:            estimation.Custody,
+		Liabilities:        estimation.Liabilities,
+		Slippage:           estimation.Slippage,
+		PriceImpact:        estimation.PriceImpact,
+		WeightBreakingFee:  estimation.WeightBreakingFee,
+		FundingRate:        estimation.FundingRate,
+		BorrowInterestRate: estimation.BorrowInterestRate,
+		HourlyInterestRate: estimation.HourlyInterestRate,
+		AvailableLiquidity: estimation.AvailableLiquidity,
+		MarketPrice:        marketPrice,
+		Executable:         executable,
+	}, nil
+}
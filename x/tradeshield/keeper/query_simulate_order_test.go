@@ -0,0 +1,142 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ptypes "github.com/elys-network/elys/x/parameter/types"
+	"github.com/elys-network/elys/x/tradeshield/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (suite *TradeshieldKeeperTestSuite) TestSimulateSpotOrder() {
+	addr := suite.AddAccounts(1, nil)
+	_, _, _ = suite.SetPerpetualPool(1)
+	k := suite.app.TradeshieldKeeper
+
+	limitSell := func(rate string) *types.QuerySimulateSpotOrderRequest {
+		return &types.QuerySimulateSpotOrderRequest{
+			OwnerAddress: addr[0].String(),
+			OrderType:    types.SpotOrderType_LIMITSELL,
+			OrderPrice: types.OrderPrice{
+				BaseDenom:  ptypes.ATOM,
+				QuoteDenom: ptypes.BaseCurrency,
+				Rate:       math.LegacyMustNewDecFromStr(rate),
+			},
+			OrderAmount:      sdk.NewInt64Coin(ptypes.ATOM, 1000000),
+			OrderTargetDenom: ptypes.BaseCurrency,
+		}
+	}
+
+	// Market price is 5, the limit sell order executes right now
+	res, err := k.SimulateSpotOrder(suite.ctx, limitSell("4.8"))
+	suite.Require().NoError(err)
+	suite.Require().True(res.Executable)
+	suite.Require().Equal(math.LegacyNewDec(5), res.MarketPrice)
+	suite.Require().Equal(sdk.NewInt64Coin(ptypes.ATOM, 1000000), res.FillAmount)
+	suite.Require().Equal(ptypes.BaseCurrency, res.AmountOut.Denom)
+	suite.Require().True(res.AmountOut.IsPositive())
+
+	// Above the market price the order would stay pending
+	pending, err := k.SimulateSpotOrder(suite.ctx, limitSell("6"))
+	suite.Require().NoError(err)
+	suite.Require().False(pending.Executable)
+	suite.Require().True(pending.FillAmount.IsZero())
+	suite.Require().Equal(res.AmountOut, pending.AmountOut)
+
+	// A limit buy pays at most its rate for each ATOM, a small order is
+	// filled at once
+	limitBuy := func(amount int64, rate string) *types.QuerySimulateSpotOrderRequest {
+		return &types.QuerySimulateSpotOrderRequest{
+			OwnerAddress: addr[0].String(),
+			OrderType:    types.SpotOrderType_LIMITBUY,
+			OrderPrice: types.OrderPrice{
+				BaseDenom:  ptypes.BaseCurrency,
+				QuoteDenom: ptypes.ATOM,
+				Rate:       math.LegacyMustNewDecFromStr(rate),
+			},
+			OrderAmount:      sdk.NewInt64Coin(ptypes.BaseCurrency, amount),
+			OrderTargetDenom: ptypes.ATOM,
+		}
+	}
+	res, err = k.SimulateSpotOrder(suite.ctx, limitBuy(1000000, "5.2"))
+	suite.Require().NoError(err)
+	suite.Require().True(res.Executable)
+	suite.Require().Equal(math.LegacyNewDec(5), res.MarketPrice)
+	suite.Require().Equal(sdk.NewInt64Coin(ptypes.BaseCurrency, 1000000), res.FillAmount)
+	suite.Require().Equal(ptypes.ATOM, res.AmountOut.Denom)
+
+	// A large one would pay more than its rate, only a part of it is filled
+	res, err = k.SimulateSpotOrder(suite.ctx, limitBuy(1000000000000, "5.2"))
+	suite.Require().NoError(err)
+	suite.Require().True(res.Executable)
+	suite.Require().True(res.FillAmount.IsPositive())
+	suite.Require().True(res.FillAmount.Amount.LT(math.NewInt(1000000000000)))
+
+	// Below the market price the limit buy would stay pending
+	pending, err = k.SimulateSpotOrder(suite.ctx, limitBuy(1000000, "4.5"))
+	suite.Require().NoError(err)
+	suite.Require().False(pending.Executable)
+	suite.Require().True(pending.FillAmount.IsZero())
+
+	// Market orders swap the order amount into the target denom
+	res, err = k.SimulateSpotOrder(suite.ctx, &types.QuerySimulateSpotOrderRequest{
+		OrderType:        types.SpotOrderType_MARKETBUY,
+		OrderAmount:      sdk.NewInt64Coin(ptypes.BaseCurrency, 1000000),
+		OrderTargetDenom: ptypes.ATOM,
+	})
+	suite.Require().NoError(err)
+	suite.Require().True(res.Executable)
+	suite.Require().Equal(ptypes.ATOM, res.AmountOut.Denom)
+	suite.Require().True(res.AmountOut.IsPositive())
+
+	_, err = k.SimulateSpotOrder(suite.ctx, &types.QuerySimulateSpotOrderRequest{OrderType: types.SpotOrderType_MARKETBUY})
+	suite.Require().ErrorIs(err, status.Error(codes.InvalidArgument, "invalid order amount"))
+
+	_, err = k.SimulateSpotOrder(suite.ctx, nil)
+	suite.Require().ErrorIs(err, status.Error(codes.InvalidArgument, "invalid request"))
+}
+
+func (suite *TradeshieldKeeperTestSuite) TestSimulatePerpetualOrder() {
+	addr := suite.AddAccounts(1, nil)
+	_, _, _ = suite.SetPerpetualPool(1)
+	k := suite.app.TradeshieldKeeper
+
+	req := &types.QuerySimulatePerpetualOrderRequest{
+		OwnerAddress:    addr[0].String(),
+		Position:        types.PerpetualPosition_LONG,
+		Leverage:        math.LegacyNewDec(5),
+		TradingAsset:    ptypes.ATOM,
+		Collateral:      sdk.NewInt64Coin(ptypes.BaseCurrency, 1000000),
+		TriggerPrice:    math.LegacyZeroDec(),
+		TakeProfitPrice: math.LegacyNewDec(15),
+		PoolId:          1,
+	}
+
+	// A zero trigger price opens at the market price
+	res, err := k.SimulatePerpetualOrder(suite.ctx, req)
+	suite.Require().NoError(err)
+	suite.Require().True(res.Executable)
+	suite.Require().Equal(math.LegacyNewDec(5), res.MarketPrice)
+	suite.Require().True(res.PositionSize.IsPositive())
+	suite.Require().True(res.LiquidationPrice.IsPositive())
+	suite.Require().True(res.LiquidationPrice.LT(res.MarketPrice))
+
+	// A long limit open order below the market price stays pending and is
+	// estimated at its trigger price
+	req.TriggerPrice = math.LegacyNewDec(4)
+	limit, err := k.SimulatePerpetualOrder(suite.ctx, req)
+	suite.Require().NoError(err)
+	suite.Require().False(limit.Executable)
+	suite.Require().Equal(math.LegacyNewDec(4), limit.OpenPrice)
+	suite.Require().True(limit.LiquidationPrice.LT(res.LiquidationPrice))
+
+	req.Position = types.PerpetualPosition_UNSPECIFIED
+	_, err = k.SimulatePerpetualOrder(suite.ctx, req)
+	suite.Require().ErrorIs(err, status.Error(codes.InvalidArgument, "invalid position"))
+
+	req.Position = types.PerpetualPosition_LONG
+	req.Leverage = math.LegacyDec{}
+	_, err = k.SimulatePerpetualOrder(suite.ctx, req)
+	suite.Require().ErrorIs(err, status.Error(codes.InvalidArgument, "Leverage is nil"))
+}
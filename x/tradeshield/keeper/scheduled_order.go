@@ -0,0 +1,332 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	/* *************************************************************************** */
+	/* Start of kwak-indexer node implementation*/
+	indexer "github.com/elys-network/elys/indexer"
+	indexerTradeshieldTypes "github.com/elys-network/elys/indexer/txs/tradeshield"
+	"github.com/elys-network/elys/indexer/txs/tradeshield/common"
+	indexerTypes "github.com/elys-network/elys/indexer/types"
+
+	/* End of kwak-indexer node implementation*/
+	/* *************************************************************************** */
+
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ammtypes "github.com/elys-network/elys/x/amm/types"
+	"github.com/elys-network/elys/x/tradeshield/types"
+)
+
+// MaxScheduledSlicesPerBlock bounds the slices executed at the end of a block,
+// the remaining ones are executed in the next blocks
+const MaxScheduledSlicesPerBlock = 100
+
+// GetScheduledOrderCount get the total number of scheduledOrder
+func (k Keeper) GetScheduledOrderCount(ctx sdk.Context) uint64 {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), []byte{})
+	bz := store.Get(types.ScheduledOrderCountKey)
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetScheduledOrderCount set the total number of scheduledOrder
+func (k Keeper) SetScheduledOrderCount(ctx sdk.Context, count uint64) {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), []byte{})
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, count)
+	store.Set(types.ScheduledOrderCountKey, bz)
+}
+
+// AppendScheduledOrder appends a scheduledOrder in the store with a new id and update the count
+func (k Keeper) AppendScheduledOrder(ctx sdk.Context, order types.ScheduledOrder) uint64 {
+	count := k.GetScheduledOrderCount(ctx)
+	if count == 0 {
+		count = 1
+	}
+
+	order.Id = count
+	k.SetScheduledOrder(ctx, order)
+	k.SetScheduledOrderCount(ctx, count+1)
+
+	return count
+}
+
+// SetScheduledOrder set a specific scheduledOrder in the store and queues its
+// next slice
+func (k Keeper) SetScheduledOrder(ctx sdk.Context, order types.ScheduledOrder) {
+	// the next slice moves after every execution
+	if previous, found := k.GetScheduledOrder(ctx, order.Id); found {
+		k.removeScheduledOrderQueue(ctx, previous)
+	}
+
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.ScheduledOrderKey)
+	store.Set(types.GetUint64Bytes(order.Id), k.cdc.MustMarshal(&order))
+	k.orderExpiryStore(ctx, scheduledOrderQueueKey(order.IntervalUnit)).Set(types.GetOrderExpiryKey(order.NextExecution, order.Id), []byte{1})
+}
+
+// GetScheduledOrder returns a scheduledOrder from its id
+func (k Keeper) GetScheduledOrder(ctx sdk.Context, id uint64) (val types.ScheduledOrder, found bool) {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.ScheduledOrderKey)
+	b := store.Get(types.GetUint64Bytes(id))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveScheduledOrder removes a scheduledOrder and the records of its slices
+// from the store
+func (k Keeper) RemoveScheduledOrder(ctx sdk.Context, id uint64) {
+	if order, found := k.GetScheduledOrder(ctx, id); found {
+		k.removeScheduledOrderQueue(ctx, order)
+	}
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.ScheduledOrderKey)
+	store.Delete(types.GetUint64Bytes(id))
+
+	sliceStore := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.ScheduledOrderSliceKey)
+	deleteAllSortedOrders(prefix.NewStore(sliceStore, types.GetUint64Bytes(id)))
+}
+
+// GetAllScheduledOrder returns all scheduledOrder
+func (k Keeper) GetAllScheduledOrder(ctx sdk.Context) (list []types.ScheduledOrder) {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.ScheduledOrderKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ScheduledOrder
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// SetScheduledOrderSlice records the execution of a slice
+func (k Keeper) SetScheduledOrderSlice(ctx sdk.Context, slice types.ScheduledOrderSlice) {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.ScheduledOrderSliceKey)
+	store.Set(types.GetScheduledOrderSliceKey(slice.OrderId, slice.Index), k.cdc.MustMarshal(&slice))
+}
+
+// GetScheduledOrderSlices returns the records of the slices of an order in
+// their execution order
+func (k Keeper) GetScheduledOrderSlices(ctx sdk.Context, orderId uint64) []types.ScheduledOrderSlice {
+	return k.getScheduledOrderSlices(ctx, types.GetUint64Bytes(orderId))
+}
+
+// GetAllScheduledOrderSlice returns the records of the slices of all orders
+func (k Keeper) GetAllScheduledOrderSlice(ctx sdk.Context) []types.ScheduledOrderSlice {
+	return k.getScheduledOrderSlices(ctx, []byte{})
+}
+
+func (k Keeper) getScheduledOrderSlices(ctx sdk.Context, keyPrefix []byte) (list []types.ScheduledOrderSlice) {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.ScheduledOrderSliceKey)
+	iterator := storetypes.KVStorePrefixIterator(store, keyPrefix)
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ScheduledOrderSlice
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+func scheduledOrderQueueKey(unit types.ScheduleIntervalUnit) []byte {
+	if unit == types.ScheduleIntervalUnit_SECONDS {
+		return types.ScheduledOrderTimeQueueKey
+	}
+	return types.ScheduledOrderHeightQueueKey
+}
+
+func (k Keeper) removeScheduledOrderQueue(ctx sdk.Context, order types.ScheduledOrder) {
+	k.orderExpiryStore(ctx, scheduledOrderQueueKey(order.IntervalUnit)).Delete(types.GetOrderExpiryKey(order.NextExecution, order.Id))
+}
+
+// CreateScheduledOrder escrows the amount of a new scheduled order, its first
+// slice is executed at the end of the block
+func (k Keeper) CreateScheduledOrder(ctx sdk.Context, msg *types.MsgCreateScheduledOrder) (types.ScheduledOrder, error) {
+	height, timestamp := uint64(ctx.BlockHeight()), uint64(ctx.BlockTime().Unix())
+	order := types.ScheduledOrder{
+		OwnerAddress:    msg.OwnerAddress,
+		TotalAmount:     msg.Amount,
+		RemainingAmount: msg.Amount,
+		TargetDenom:     msg.TargetDenom,
+		SliceCount:      msg.SliceCount,
+		Interval:        msg.Interval,
+		IntervalUnit:    msg.IntervalUnit,
+		MaxSlippage:     msg.MaxSlippage,
+		NextExecution:   msg.IntervalUnit.Now(height, timestamp),
+		ReceivedAmount:  sdk.NewCoin(msg.TargetDenom, sdkmath.ZeroInt()),
+		Date:            &types.Date{Height: height, Timestamp: timestamp},
+	}
+	order.Id = k.AppendScheduledOrder(ctx, order)
+
+	ownerAddress := sdk.MustAccAddressFromBech32(order.OwnerAddress)
+	err := k.bank.SendCoins(ctx, ownerAddress, order.GetOrderAddress(), sdk.NewCoins(order.TotalAmount))
+	if err != nil {
+		return types.ScheduledOrder{}, err
+	}
+
+	ctx.EventManager().EmitEvent(types.NewCreateScheduledOrderEvt(order))
+	return order, nil
+}
+
+// CloseScheduledOrder returns the amount left to swap to the owner and removes
+// the order
+func (k Keeper) CloseScheduledOrder(ctx sdk.Context, order types.ScheduledOrder) (sdk.Coin, error) {
+	refund := order.RemainingAmount
+	if refund.IsPositive() {
+		ownerAddress := sdk.MustAccAddressFromBech32(order.OwnerAddress)
+		err := k.bank.SendCoins(ctx, order.GetOrderAddress(), ownerAddress, sdk.NewCoins(refund))
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+	k.RemoveScheduledOrder(ctx, order.Id)
+
+	ctx.EventManager().EmitEvent(types.NewCloseScheduledOrderEvt(order, refund))
+	return refund, nil
+}
+
+// ExecuteScheduledOrders executes the slices whose time has come, at most
+// MaxScheduledSlicesPerBlock of them
+func (k Keeper) ExecuteScheduledOrders(ctx sdk.Context) {
+	now := []struct {
+		unit types.ScheduleIntervalUnit
+		at   uint64
+	}{
+		{types.ScheduleIntervalUnit_BLOCKS, uint64(ctx.BlockHeight())},
+		{types.ScheduleIntervalUnit_SECONDS, uint64(ctx.BlockTime().Unix())},
+	}
+
+	var ids []uint64
+	for _, queue := range now {
+		iterator := k.orderExpiryStore(ctx, scheduledOrderQueueKey(queue.unit)).Iterator(nil, types.GetUint64Bytes(queue.at+1))
+		for ; iterator.Valid() && len(ids) < MaxScheduledSlicesPerBlock; iterator.Next() {
+			ids = append(ids, types.GetUint64FromBytes(iterator.Key()[8:]))
+		}
+		iterator.Close()
+	}
+
+	for _, id := range ids {
+		order, found := k.GetScheduledOrder(ctx, id)
+		if !found {
+			continue
+		}
+		if err := k.ExecuteScheduledOrderSlice(ctx, order); err != nil {
+			ctx.Logger().Error(fmt.Sprintf("scheduled order %d cannot be executed: %s", id, err))
+		}
+	}
+}
+
+// ExecuteScheduledOrderSlice swaps the next slice of an order through the AMM.
+// A slice whose output would be below the oracle price minus the max slippage
+// is skipped and its amount is spread over the next slices. The amount left to
+// swap after the last slice is refunded.
+func (k Keeper) ExecuteScheduledOrderSlice(ctx sdk.Context, order types.ScheduledOrder) error {
+	slice := types.ScheduledOrderSlice{
+		OrderId:   order.Id,
+		Index:     order.ExecutedSlices + 1,
+		Date:      &types.Date{Height: uint64(ctx.BlockHeight()), Timestamp: uint64(ctx.BlockTime().Unix())},
+		AmountIn:  order.NextSliceAmount(),
+		AmountOut: sdk.NewCoin(order.TargetDenom, sdkmath.ZeroInt()),
+	}
+
+	minAmountOut, err := k.scheduledSliceMinAmountOut(ctx, order, slice.AmountIn)
+	if err == nil {
+		err = executeCached(ctx, func(cacheCtx sdk.Context) (bool, error) {
+			res, err := k.swapScheduledOrderSlice(cacheCtx, order, slice.AmountIn, minAmountOut)
+			if err != nil {
+				return false, err
+			}
+			slice.AmountOut = res.Amount
+			return true, nil
+		})
+	}
+	if err != nil {
+		slice.Error = err.Error()
+	} else {
+		order.RemainingAmount = order.RemainingAmount.Sub(slice.AmountIn)
+		order.ReceivedAmount = order.ReceivedAmount.Add(slice.AmountOut)
+	}
+	order.ExecutedSlices++
+
+	k.SetScheduledOrderSlice(ctx, slice)
+	ctx.EventManager().EmitEvent(types.NewExecuteScheduledOrderSliceEvt(order, slice))
+
+	refund := sdk.NewCoin(order.RemainingAmount.Denom, sdkmath.ZeroInt())
+	if order.RemainingSlices() == 0 {
+		refund, err = k.CloseScheduledOrder(ctx, order)
+		if err != nil {
+			return err
+		}
+	} else {
+		order.NextExecution = order.IntervalUnit.Now(uint64(ctx.BlockHeight()), uint64(ctx.BlockTime().Unix())) + order.Interval
+		k.SetScheduledOrder(ctx, order)
+	}
+
+	/* *************************************************************************** */
+	/* Start of kwak-indexer node implementation*/
+	status := common.Status_PENDING
+	if order.RemainingSlices() == 0 {
+		status = common.Status_EXECUTED
+	}
+	indexer.QueueEvent(ctx, indexerTypes.ElysEventTypes.Tradeshield.ScheduledOrderSlice, indexerTradeshieldTypes.ScheduledOrderSliceEvent{
+		OrderID:      order.Id,
+		OwnerAddress: order.OwnerAddress,
+		Index:        slice.Index,
+		SliceCount:   order.SliceCount,
+		Status:       status,
+		Date: common.OrderDate{
+			Height:    slice.Date.Height,
+			Timestamp: slice.Date.Timestamp,
+		},
+		AmountIn:        indexerToken(slice.AmountIn),
+		AmountOut:       indexerToken(slice.AmountOut),
+		MinAmountOut:    minAmountOut.String(),
+		RemainingAmount: indexerToken(order.RemainingAmount.Sub(refund)),
+		Refund:          indexerToken(refund),
+		Error:           slice.Error,
+	}, []string{order.OwnerAddress})
+	/* End of kwak-indexer node implementation*/
+	/* *************************************************************************** */
+
+	return nil
+}
+
+// scheduledSliceMinAmountOut returns the minimum output of a slice, its value
+// at the oracle price less the max slippage of the order
+func (k Keeper) scheduledSliceMinAmountOut(ctx sdk.Context, order types.ScheduledOrder, amountIn sdk.Coin) (sdkmath.Int, error) {
+	price, err := k.GetAssetPriceFromDenomInToDenomOut(ctx, amountIn.Denom, order.TargetDenom)
+	if err != nil {
+		return sdkmath.ZeroInt(), err
+	}
+	return price.MulInt(amountIn.Amount).Mul(sdkmath.LegacyOneDec().Sub(order.MaxSlippage)).TruncateInt(), nil
+}
+
+// swapScheduledOrderSlice swaps the slice from the order address with the
+// target denom and sends the output to the owner. The swap is settled right
+// away so the actual output is returned.
+func (k Keeper) swapScheduledOrderSlice(ctx sdk.Context, order types.ScheduledOrder, amountIn sdk.Coin, minAmountOut sdkmath.Int) (*ammtypes.MsgSwapByDenomResponse, error) {
+	return k.amm.ExecuteSwapByDenom(ctx, &ammtypes.MsgSwapByDenom{
+		Sender:    order.GetOrderAddress().String(),
+		Recipient: order.OwnerAddress,
+		Amount:    amountIn,
+		DenomIn:   amountIn.Denom,
+		DenomOut:  order.TargetDenom,
+		MinAmount: sdk.NewCoin(order.TargetDenom, minAmountOut),
+	})
+}
@@ -0,0 +1,115 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	ptypes "github.com/elys-network/elys/x/parameter/types"
+	keeper "github.com/elys-network/elys/x/tradeshield/keeper"
+	"github.com/elys-network/elys/x/tradeshield/types"
+)
+
+func (suite *TradeshieldKeeperTestSuite) createScheduledOrder(owner sdk.AccAddress, amount int64, slices, interval uint64, maxSlippage string) uint64 {
+	msgSrvr := keeper.NewMsgServerImpl(suite.app.TradeshieldKeeper)
+	res, err := msgSrvr.CreateScheduledOrder(suite.ctx, types.NewMsgCreateScheduledOrder(owner.String(), sdk.NewInt64Coin(ptypes.ATOM, amount), ptypes.BaseCurrency,
+		slices, interval, types.ScheduleIntervalUnit_BLOCKS, math.LegacyMustNewDecFromStr(maxSlippage)))
+	suite.Require().NoError(err)
+	return res.OrderId
+}
+
+// executeScheduledSlices runs the tradeshield end blocker at the given height,
+// the swaps of the slices are settled right away
+func (suite *TradeshieldKeeperTestSuite) executeScheduledSlices(height int64) {
+	suite.ctx = suite.ctx.WithBlockHeight(height)
+	suite.app.TradeshieldKeeper.EndBlocker(suite.ctx)
+	suite.Require().Empty(suite.app.AmmKeeper.GetAllSwapExactAmountInRequests(suite.ctx))
+}
+
+func (suite *TradeshieldKeeperTestSuite) TestScheduledOrderSlices() {
+	addr := suite.AddAccounts(1, nil)
+	owner := addr[0]
+	_, _, _ = suite.SetPerpetualPool(1)
+	start := suite.ctx.BlockHeight()
+
+	ownerAtom := suite.app.BankKeeper.GetBalance(suite.ctx, owner, ptypes.ATOM)
+	ownerUsdc := suite.app.BankKeeper.GetBalance(suite.ctx, owner, ptypes.BaseCurrency)
+	id := suite.createScheduledOrder(owner, 1000000, 3, 10, "0.05")
+	order, found := suite.app.TradeshieldKeeper.GetScheduledOrder(suite.ctx, id)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewInt64Coin(ptypes.ATOM, 1000000), suite.app.BankKeeper.GetBalance(suite.ctx, order.GetOrderAddress(), ptypes.ATOM))
+
+	// The first slice is executed at the end of the block
+	suite.executeScheduledSlices(start)
+	order, _ = suite.app.TradeshieldKeeper.GetScheduledOrder(suite.ctx, id)
+	suite.Require().Equal(uint64(1), order.ExecutedSlices)
+	suite.Require().Equal(math.NewInt(666667), order.RemainingAmount.Amount)
+	suite.Require().True(order.ReceivedAmount.IsPositive())
+	suite.Require().Equal(uint64(start+10), order.NextExecution)
+
+	// The actual output of the swap is received and recorded
+	suite.Require().Equal(ownerUsdc.Add(order.ReceivedAmount), suite.app.BankKeeper.GetBalance(suite.ctx, owner, ptypes.BaseCurrency))
+	slices := suite.app.TradeshieldKeeper.GetScheduledOrderSlices(suite.ctx, id)
+	suite.Require().Len(slices, 1)
+	suite.Require().Equal(order.ReceivedAmount, slices[0].AmountOut)
+
+	// Nothing happens before the interval elapsed
+	suite.executeScheduledSlices(start + 5)
+	order, _ = suite.app.TradeshieldKeeper.GetScheduledOrder(suite.ctx, id)
+	suite.Require().Equal(uint64(1), order.ExecutedSlices)
+
+	suite.executeScheduledSlices(start + 10)
+	query, err := suite.app.TradeshieldKeeper.ScheduledOrder(suite.ctx, &types.QueryGetScheduledOrderRequest{Id: id})
+	suite.Require().NoError(err)
+	suite.Require().Len(query.Slices, 2)
+	suite.Require().Equal(math.NewInt(333333), query.Slices[1].AmountIn.Amount)
+	suite.Require().Empty(query.Slices[1].Error)
+
+	// The last slice swaps everything that is left and closes the order
+	suite.executeScheduledSlices(start + 20)
+	_, found = suite.app.TradeshieldKeeper.GetScheduledOrder(suite.ctx, id)
+	suite.Require().False(found)
+	suite.Require().Empty(suite.app.TradeshieldKeeper.GetScheduledOrderSlices(suite.ctx, id))
+	suite.Require().Equal(ownerAtom.SubAmount(math.NewInt(1000000)), suite.app.BankKeeper.GetBalance(suite.ctx, owner, ptypes.ATOM))
+	suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, owner, ptypes.BaseCurrency).IsGTE(ownerUsdc.AddAmount(math.NewInt(4500000))))
+}
+
+func (suite *TradeshieldKeeperTestSuite) TestScheduledOrderMaxSlippage() {
+	addr := suite.AddAccounts(2, nil)
+	owner, other := addr[0], addr[1]
+	_, _, _ = suite.SetPerpetualPool(1)
+	start := suite.ctx.BlockHeight()
+
+	// The weight breaking fee of the pool exceeds the max slippage, the slice
+	// is skipped and its amount spread over the next slices
+	id := suite.createScheduledOrder(owner, 1000000, 2, 1, "0.001")
+	suite.executeScheduledSlices(start)
+	order, _ := suite.app.TradeshieldKeeper.GetScheduledOrder(suite.ctx, id)
+	suite.Require().Equal(uint64(1), order.ExecutedSlices)
+	suite.Require().Equal(math.NewInt(1000000), order.RemainingAmount.Amount)
+	slices := suite.app.TradeshieldKeeper.GetScheduledOrderSlices(suite.ctx, id)
+	suite.Require().Len(slices, 1)
+	suite.Require().NotEmpty(slices[0].Error)
+	suite.Require().True(slices[0].AmountOut.IsZero())
+
+	// Only the owner can cancel the order
+	msgSrvr := keeper.NewMsgServerImpl(suite.app.TradeshieldKeeper)
+	cacheCtx, _ := suite.ctx.CacheContext()
+	_, err := msgSrvr.CancelScheduledOrder(cacheCtx, types.NewMsgCancelScheduledOrder(other.String(), id))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// Cancelling refunds the amount left to swap
+	ownerAtom := suite.app.BankKeeper.GetBalance(suite.ctx, owner, ptypes.ATOM)
+	_, err = msgSrvr.CancelScheduledOrder(suite.ctx, types.NewMsgCancelScheduledOrder(owner.String(), id))
+	suite.Require().NoError(err)
+	suite.Require().Equal(ownerAtom.AddAmount(math.NewInt(1000000)), suite.app.BankKeeper.GetBalance(suite.ctx, owner, ptypes.ATOM))
+	_, found := suite.app.TradeshieldKeeper.GetScheduledOrder(suite.ctx, id)
+	suite.Require().False(found)
+
+	// The last slice refunds what could not be swapped
+	id = suite.createScheduledOrder(owner, 1000000, 1, 1, "0.001")
+	ownerAtom = suite.app.BankKeeper.GetBalance(suite.ctx, owner, ptypes.ATOM)
+	suite.executeScheduledSlices(start + 1)
+	_, found = suite.app.TradeshieldKeeper.GetScheduledOrder(suite.ctx, id)
+	suite.Require().False(found)
+	suite.Require().Equal(ownerAtom.AddAmount(math.NewInt(1000000)), suite.app.BankKeeper.GetBalance(suite.ctx, owner, ptypes.ATOM))
+}
@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	"bytes"
+	"slices"
+
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/elys-network/elys/x/tradeshield/types"
+)
+
+// mustGetSortedOrderIds returns the order ids stored under key
+func mustGetSortedOrderIds(store prefix.Store, key []byte) []uint64 {
+	bz := store.Get(key)
+	if bz == nil {
+		return nil
+	}
+	orderIds, err := types.DecodeUint64Slice(bz)
+	if err != nil {
+		panic(err)
+	}
+	return orderIds
+}
+
+// removeSortedOrderId removes id from the order ids stored under key, the
+// list is deleted once empty
+func removeSortedOrderId(store prefix.Store, key []byte, id uint64) {
+	orderIds := mustGetSortedOrderIds(store, key)
+	index := slices.Index(orderIds, id)
+	if index < 0 {
+		return
+	}
+	orderIds = slices.Delete(orderIds, index, index+1)
+	if len(orderIds) == 0 {
+		store.Delete(key)
+		return
+	}
+	store.Set(key, types.EncodeUint64Slice(orderIds))
+}
+
+// deleteAllSortedOrders deletes every sorted order list of store
+func deleteAllSortedOrders(store prefix.Store) {
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// sortRate returns the rate an order is sorted by, a missing rate sorts as zero
+func sortRate(rate sdkmath.LegacyDec) sdkmath.LegacyDec {
+	if rate.IsNil() {
+		return sdkmath.LegacyZeroDec()
+	}
+	return rate
+}
+
+// nextSortedOrderList returns the first sorted order list of store whose key
+// is in [start, end). The iterator is closed before returning, so the caller
+// may write to store.
+func nextSortedOrderList(store prefix.Store, start, end []byte) (key []byte, orderIds []uint64, found bool) {
+	if bytes.Compare(start, end) >= 0 {
+		return nil, nil, false
+	}
+	iterator := store.Iterator(start, end)
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return nil, nil, false
+	}
+	orderIds, err := types.DecodeUint64Slice(iterator.Value())
+	if err != nil {
+		panic(err)
+	}
+	return bytes.Clone(iterator.Key()), orderIds, true
+}
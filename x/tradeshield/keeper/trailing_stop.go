@@ -0,0 +1,289 @@
+package keeper
+
+import (
+	"bytes"
+	"encoding/binary"
+	"slices"
+
+	/* *************************************************************************** */
+	/* Start of kwak-indexer node implementation*/
+	indexerTradeshieldTypes "github.com/elys-network/elys/indexer/txs/tradeshield"
+	"github.com/elys-network/elys/indexer/txs/tradeshield/common"
+
+	/* End of kwak-indexer node implementation*/
+	/* *************************************************************************** */
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/elys-network/elys/x/tradeshield/types"
+)
+
+// startSpotTrailingStop sets the high-water mark of a new trailing stop spot
+// order to the market price and its rate to the stop price
+func (k Keeper) startSpotTrailingStop(ctx sdk.Context, order *types.SpotOrder, trail *types.TrailingStop) error {
+	if err := types.ValidateTrailingStop(true, trail); err != nil {
+		return err
+	}
+	trailingStop := *trail
+
+	marketPrice, err := k.GetAssetPriceFromDenomInToDenomOut(ctx, order.OrderPrice.BaseDenom, order.OrderPrice.QuoteDenom)
+	if err != nil {
+		return err
+	}
+	if marketPrice.IsZero() {
+		return errorsmod.Wrapf(types.ErrZeroMarketPrice, "Base denom: %s, Quote denom: %s", order.OrderPrice.BaseDenom, order.OrderPrice.QuoteDenom)
+	}
+
+	trailingStop.HighWaterMark = marketPrice
+	order.TrailingStop = &trailingStop
+	order.OrderPrice.Rate = trailingStop.StopPrice(spotOrderExecutesBelowRate(*order))
+	return nil
+}
+
+// startPerpetualTrailingStop sets the high-water mark of a new trailing stop
+// perpetual order to the market price and its trigger price to the stop price
+func (k Keeper) startPerpetualTrailingStop(ctx sdk.Context, order *types.PerpetualOrder, trail *types.TrailingStop) error {
+	if err := types.ValidateTrailingStop(true, trail); err != nil {
+		return err
+	}
+	trailingStop := *trail
+
+	marketPrice, err := k.perpetual.GetAssetPrice(ctx, order.TradingAsset)
+	if err != nil {
+		return err
+	}
+	if marketPrice.IsZero() {
+		return types.ErrZeroMarketPrice
+	}
+
+	trailingStop.HighWaterMark = marketPrice
+	order.TrailingStop = &trailingStop
+	order.TriggerPrice.Rate = trailingStop.StopPrice(perpetualOrderExecutesBelowRate(*order))
+	return nil
+}
+
+// MaxTrailingStopUpdatesPerBlock bounds the trailing stop orders visited at the
+// end of a block, the next block resumes after the last visited order
+const MaxTrailingStopUpdatesPerBlock = 500
+
+// TrailingStopCursor is the position of the next trailing stop order to
+// visit, the key of its sorted list and its order id. The spot lists are
+// visited before the perpetual ones, each in key order, and the orders of a
+// list in id order since moving a stop reorders its list.
+type TrailingStopCursor struct {
+	Perpetual bool
+	ListKey   []byte
+	OrderId   uint64
+}
+
+// trailingStopLists are the trailing stop sorted lists of a store
+type trailingStopLists struct {
+	perpetual bool
+	store     prefix.Store
+	keyPrefix []byte
+}
+
+// GetTrailingStopCursor returns the position of the next trailing stop order
+// to visit, the zero cursor starts from the first list
+func (k Keeper) GetTrailingStopCursor(ctx sdk.Context) TrailingStopCursor {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), []byte{})
+	bz := store.Get(types.TrailingStopCursorKey)
+	if len(bz) < 9 {
+		return TrailingStopCursor{}
+	}
+	return TrailingStopCursor{
+		Perpetual: bz[0] == 1,
+		OrderId:   binary.BigEndian.Uint64(bz[1:9]),
+		ListKey:   bz[9:],
+	}
+}
+
+// SetTrailingStopCursor sets the position of the next trailing stop order to
+// visit, stored as the list kind, the order id and the list key
+func (k Keeper) SetTrailingStopCursor(ctx sdk.Context, cursor TrailingStopCursor) {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), []byte{})
+	bz := make([]byte, 9, 9+len(cursor.ListKey))
+	if cursor.Perpetual {
+		bz[0] = 1
+	}
+	binary.BigEndian.PutUint64(bz[1:9], cursor.OrderId)
+	store.Set(types.TrailingStopCursorKey, append(bz, cursor.ListKey...))
+}
+
+// UpdateTrailingStops moves the high-water mark and the stop price of the
+// trailing stop orders whose market price moved away from their stop. At most
+// limit orders are visited, starting from the cursor left by the previous
+// block. The sorted lists are read one at a time from the cursor, so the lists
+// read by a block are bounded by limit too, and once the last list is visited
+// the next block starts over. Orders of a sorted list share their market and are updated
+// with a single price.
+func (k Keeper) UpdateTrailingStops(ctx sdk.Context, limit int) (spotOrders, perpetualOrders []indexerTradeshieldTypes.TrailingStopUpdate) {
+	if limit <= 0 {
+		return nil, nil
+	}
+
+	kvStore := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	spotStore := prefix.NewStore(kvStore, types.SortedSpotOrderKey)
+	perpStore := prefix.NewStore(kvStore, types.SortedPerpetualOrderKey)
+	sources := []trailingStopLists{
+		{store: spotStore, keyPrefix: []byte(types.GenSpotKeyPrefix(types.SpotOrderType_TRAILINGSTOP))},
+		{perpetual: true, store: perpStore, keyPrefix: []byte(types.GenPerpKeyPrefix(types.PerpetualPosition_LONG, types.PerpetualOrderType_TRAILINGSTOPPERP))},
+		{perpetual: true, store: perpStore, keyPrefix: []byte(types.GenPerpKeyPrefix(types.PerpetualPosition_SHORT, types.PerpetualOrderType_TRAILINGSTOPPERP))},
+	}
+
+	cursor := k.GetTrailingStopCursor(ctx)
+	visited := 0
+	for _, source := range sources {
+		if cursor.Perpetual && !source.perpetual {
+			continue
+		}
+		start, end := source.keyPrefix, storetypes.PrefixEndBytes(source.keyPrefix)
+		if cursor.Perpetual == source.perpetual && bytes.Compare(cursor.ListKey, start) > 0 {
+			start = cursor.ListKey
+		}
+
+		for {
+			key, orderIds, found := nextSortedOrderList(source.store, start, end)
+			if !found {
+				break
+			}
+			ids := slices.Clone(orderIds)
+			slices.Sort(ids)
+			// the list of the cursor resumes at the order id of the cursor
+			index := 0
+			if cursor.Perpetual == source.perpetual && bytes.Equal(key, cursor.ListKey) {
+				index, _ = slices.BinarySearch(ids, cursor.OrderId)
+			}
+
+			// the market price of a list is read once, from its first order
+			var marketPrice sdkmath.LegacyDec
+			for ; index < len(ids); index++ {
+				if visited == limit {
+					k.SetTrailingStopCursor(ctx, TrailingStopCursor{Perpetual: source.perpetual, ListKey: key, OrderId: ids[index]})
+					return spotOrders, perpetualOrders
+				}
+				visited++
+
+				if source.perpetual {
+					if marketPrice.IsNil() {
+						marketPrice = k.perpetualTrailingStopPrice(ctx, orderIds[0])
+					}
+					if update, moved := k.ratchetPerpetualTrailingStop(ctx, ids[index], marketPrice); moved {
+						perpetualOrders = append(perpetualOrders, update)
+					}
+					continue
+				}
+
+				if marketPrice.IsNil() {
+					marketPrice = k.spotTrailingStopPrice(ctx, orderIds[0])
+				}
+				if update, moved := k.ratchetSpotTrailingStop(ctx, ids[index], marketPrice); moved {
+					spotOrders = append(spotOrders, update)
+				}
+			}
+			start = append(key, 0)
+		}
+	}
+
+	// every list was visited, the next block starts over
+	k.SetTrailingStopCursor(ctx, TrailingStopCursor{})
+	return spotOrders, perpetualOrders
+}
+
+// spotTrailingStopPrice returns the market price of the spot order id, zero
+// when it is not known
+func (k Keeper) spotTrailingStopPrice(ctx sdk.Context, id uint64) sdkmath.LegacyDec {
+	order, found := k.GetPendingSpotOrder(ctx, id)
+	if !found {
+		return sdkmath.LegacyZeroDec()
+	}
+	marketPrice, err := k.GetAssetPriceFromDenomInToDenomOut(ctx, order.OrderPrice.BaseDenom, order.OrderPrice.QuoteDenom)
+	if err != nil {
+		return sdkmath.LegacyZeroDec()
+	}
+	return marketPrice
+}
+
+// perpetualTrailingStopPrice returns the market price of the perpetual order
+// id, zero when it is not known
+func (k Keeper) perpetualTrailingStopPrice(ctx sdk.Context, id uint64) sdkmath.LegacyDec {
+	order, found := k.GetPendingPerpetualOrder(ctx, id)
+	if !found {
+		return sdkmath.LegacyZeroDec()
+	}
+	marketPrice, err := k.perpetual.GetAssetPrice(ctx, order.TradingAsset)
+	if err != nil {
+		return sdkmath.LegacyZeroDec()
+	}
+	return marketPrice
+}
+
+// ratchetSpotTrailingStop moves the stop of the spot trailing stop order id
+// with the market price and reports whether it moved
+func (k Keeper) ratchetSpotTrailingStop(ctx sdk.Context, id uint64, marketPrice sdkmath.LegacyDec) (indexerTradeshieldTypes.TrailingStopUpdate, bool) {
+	order, found := k.GetPendingSpotOrder(ctx, id)
+	if !found || order.TrailingStop == nil || marketPrice.IsZero() {
+		return indexerTradeshieldTypes.TrailingStopUpdate{}, false
+	}
+	below := spotOrderExecutesBelowRate(order)
+	if !order.TrailingStop.Ratchet(marketPrice, below) {
+		return indexerTradeshieldTypes.TrailingStopUpdate{}, false
+	}
+	order.OrderPrice.Rate = order.TrailingStop.StopPrice(below)
+	k.SetPendingSpotOrder(ctx, order)
+
+	return indexerTrailingStopUpdate(order.OrderId, order.OwnerAddress, *order.TrailingStop, order.OrderPrice.Rate), true
+}
+
+// ratchetPerpetualTrailingStop moves the trigger of the perpetual trailing
+// stop order id with the market price and reports whether it moved
+func (k Keeper) ratchetPerpetualTrailingStop(ctx sdk.Context, id uint64, marketPrice sdkmath.LegacyDec) (indexerTradeshieldTypes.TrailingStopUpdate, bool) {
+	order, found := k.GetPendingPerpetualOrder(ctx, id)
+	if !found || order.TrailingStop == nil || marketPrice.IsZero() {
+		return indexerTradeshieldTypes.TrailingStopUpdate{}, false
+	}
+	below := perpetualOrderExecutesBelowRate(order)
+	if !order.TrailingStop.Ratchet(marketPrice, below) {
+		return indexerTradeshieldTypes.TrailingStopUpdate{}, false
+	}
+	order.TriggerPrice.Rate = order.TrailingStop.StopPrice(below)
+	k.SetPendingPerpetualOrder(ctx, order)
+
+	return indexerTrailingStopUpdate(order.OrderId, order.OwnerAddress, *order.TrailingStop, order.TriggerPrice.Rate), true
+}
+
+/* *************************************************************************** */
+/* Start of kwak-indexer node implementation*/
+func indexerTrailingStop(trailingStop *types.TrailingStop) *common.TrailingStop {
+	if trailingStop == nil {
+		return nil
+	}
+	return &common.TrailingStop{
+		TrailPercent:  decString(trailingStop.TrailPercent),
+		TrailAmount:   decString(trailingStop.TrailAmount),
+		HighWaterMark: decString(trailingStop.HighWaterMark),
+	}
+}
+
+func indexerTrailingStopUpdate(orderId uint64, owner string, trailingStop types.TrailingStop, stopPrice sdkmath.LegacyDec) indexerTradeshieldTypes.TrailingStopUpdate {
+	return indexerTradeshieldTypes.TrailingStopUpdate{
+		OrderID:       orderId,
+		OwnerAddress:  owner,
+		HighWaterMark: trailingStop.HighWaterMark.String(),
+		StopPrice:     stopPrice.String(),
+	}
+}
+
+func decString(dec sdkmath.LegacyDec) string {
+	if dec.IsNil() || dec.IsZero() {
+		return ""
+	}
+	return dec.String()
+}
+
+/* End of kwak-indexer node implementation*/
+/* *************************************************************************** */
@@ -0,0 +1,168 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	oracletypes "github.com/elys-network/elys/x/oracle/types"
+	ptypes "github.com/elys-network/elys/x/parameter/types"
+	keeper "github.com/elys-network/elys/x/tradeshield/keeper"
+	"github.com/elys-network/elys/x/tradeshield/types"
+)
+
+func (suite *TradeshieldKeeperTestSuite) setAtomPrice(price string) {
+	suite.app.OracleKeeper.SetPrice(suite.ctx, oracletypes.Price{
+		Asset:     "ATOM",
+		Price:     math.LegacyMustNewDecFromStr(price),
+		Source:    "elys",
+		Provider:  oracleProvider.String(),
+		Timestamp: uint64(suite.ctx.BlockTime().Unix()),
+	})
+}
+
+func (suite *TradeshieldKeeperTestSuite) TestTrailingStopPerpetualOrder() {
+	addr := suite.AddAccounts(1, nil)
+	owner := addr[0]
+	_, _, _ = suite.SetPerpetualPool(1)
+	positionId := suite.openPerpetualPosition(owner)
+
+	msgSrvr := keeper.NewMsgServerImpl(suite.app.TradeshieldKeeper)
+	res, err := msgSrvr.CreatePerpetualCloseOrder(suite.ctx, &types.MsgCreatePerpetualCloseOrder{
+		OwnerAddress: owner.String(),
+		PositionId:   positionId,
+		OrderType:    types.PerpetualOrderType_TRAILINGSTOPPERP,
+		TrailingStop: &types.TrailingStop{
+			TrailPercent:  math.LegacyMustNewDecFromStr("0.05"),
+			TrailAmount:   math.LegacyZeroDec(),
+			HighWaterMark: math.LegacyZeroDec(),
+		},
+	})
+	suite.Require().NoError(err)
+
+	// The stop starts 5% below the market price of 5
+	order, found := suite.app.TradeshieldKeeper.GetPendingPerpetualOrder(suite.ctx, res.OrderId)
+	suite.Require().True(found)
+	suite.Require().Equal(math.LegacyNewDec(5), order.TrailingStop.HighWaterMark)
+	suite.Require().Equal(math.LegacyMustNewDecFromStr("4.75"), order.TriggerPrice.Rate)
+
+	// The trigger price cannot be updated by hand
+	_, err = msgSrvr.UpdatePerpetualOrder(suite.ctx, &types.MsgUpdatePerpetualOrder{
+		OwnerAddress: owner.String(),
+		OrderId:      res.OrderId,
+		TriggerPrice: types.TriggerPrice{TradingAssetDenom: "uatom", Rate: math.LegacyNewDec(3)},
+	})
+	suite.Require().ErrorIs(err, types.ErrInvalidOrderType)
+
+	// The stop follows the price up
+	suite.setAtomPrice("6")
+	suite.app.TradeshieldKeeper.EndBlocker(suite.ctx)
+	order, _ = suite.app.TradeshieldKeeper.GetPendingPerpetualOrder(suite.ctx, res.OrderId)
+	suite.Require().Equal(math.LegacyNewDec(6), order.TrailingStop.HighWaterMark)
+	suite.Require().Equal(math.LegacyMustNewDecFromStr("5.7"), order.TriggerPrice.Rate)
+
+	// but not down
+	suite.setAtomPrice("5.8")
+	suite.app.TradeshieldKeeper.EndBlocker(suite.ctx)
+	order, _ = suite.app.TradeshieldKeeper.GetPendingPerpetualOrder(suite.ctx, res.OrderId)
+	suite.Require().Equal(math.LegacyNewDec(6), order.TrailingStop.HighWaterMark)
+	suite.Require().Equal(math.LegacyMustNewDecFromStr("5.7"), order.TriggerPrice.Rate)
+
+	// The position is closed once the price falls through the stop
+	suite.setLimitProcessOrder(true, 10)
+	suite.setAtomPrice("5.6")
+	suite.app.TradeshieldKeeper.EndBlocker(suite.ctx)
+	_, found = suite.app.TradeshieldKeeper.GetPendingPerpetualOrder(suite.ctx, res.OrderId)
+	suite.Require().False(found)
+	_, err = suite.app.PerpetualKeeper.GetMTP(suite.ctx, owner, positionId)
+	suite.Require().Error(err)
+}
+
+func (suite *TradeshieldKeeperTestSuite) TestTrailingStopSpotOrder() {
+	addr := suite.AddAccounts(1, nil)
+	owner := addr[0]
+	_, _, _ = suite.SetPerpetualPool(1)
+
+	msgSrvr := keeper.NewMsgServerImpl(suite.app.TradeshieldKeeper)
+	res, err := msgSrvr.CreateSpotOrder(suite.ctx, &types.MsgCreateSpotOrder{
+		OwnerAddress: owner.String(),
+		OrderType:    types.SpotOrderType_TRAILINGSTOP,
+		OrderPrice: types.OrderPrice{
+			BaseDenom:  ptypes.ATOM,
+			QuoteDenom: ptypes.BaseCurrency,
+		},
+		OrderAmount:      sdk.NewInt64Coin(ptypes.ATOM, 1000),
+		OrderTargetDenom: ptypes.BaseCurrency,
+		TrailingStop: &types.TrailingStop{
+			TrailPercent:  math.LegacyZeroDec(),
+			TrailAmount:   math.LegacyOneDec(),
+			HighWaterMark: math.LegacyZeroDec(),
+		},
+	})
+	suite.Require().NoError(err)
+
+	order, found := suite.app.TradeshieldKeeper.GetPendingSpotOrder(suite.ctx, res.OrderId)
+	suite.Require().True(found)
+	suite.Require().Equal(math.LegacyNewDec(5), order.TrailingStop.HighWaterMark)
+	suite.Require().Equal(math.LegacyNewDec(4), order.OrderPrice.Rate)
+
+	suite.setAtomPrice("7")
+	suite.app.TradeshieldKeeper.EndBlocker(suite.ctx)
+	order, _ = suite.app.TradeshieldKeeper.GetPendingSpotOrder(suite.ctx, res.OrderId)
+	suite.Require().Equal(math.LegacyNewDec(7), order.TrailingStop.HighWaterMark)
+	suite.Require().Equal(math.LegacyNewDec(6), order.OrderPrice.Rate)
+
+	// The order is moved in its sorted list
+	lists, err := suite.app.TradeshieldKeeper.GetAllSortedSpotOrder(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal([][]uint64{{res.OrderId}}, lists)
+}
+
+func (suite *TradeshieldKeeperTestSuite) TestUpdateTrailingStopsLimit() {
+	addr := suite.AddAccounts(1, nil)
+	owner := addr[0]
+	_, _, _ = suite.SetPerpetualPool(1)
+
+	msgSrvr := keeper.NewMsgServerImpl(suite.app.TradeshieldKeeper)
+	var orderIds []uint64
+	for _, trail := range []string{"1", "1.5", "2"} {
+		res, err := msgSrvr.CreateSpotOrder(suite.ctx, &types.MsgCreateSpotOrder{
+			OwnerAddress: owner.String(),
+			OrderType:    types.SpotOrderType_TRAILINGSTOP,
+			OrderPrice: types.OrderPrice{
+				BaseDenom:  ptypes.ATOM,
+				QuoteDenom: ptypes.BaseCurrency,
+			},
+			OrderAmount:      sdk.NewInt64Coin(ptypes.ATOM, 1000),
+			OrderTargetDenom: ptypes.BaseCurrency,
+			TrailingStop: &types.TrailingStop{
+				TrailPercent:  math.LegacyZeroDec(),
+				TrailAmount:   math.LegacyMustNewDecFromStr(trail),
+				HighWaterMark: math.LegacyZeroDec(),
+			},
+		})
+		suite.Require().NoError(err)
+		orderIds = append(orderIds, res.OrderId)
+	}
+
+	// Only two of the three orders are visited in a block
+	suite.setAtomPrice("7")
+	spotOrders, perpetualOrders := suite.app.TradeshieldKeeper.UpdateTrailingStops(suite.ctx, 2)
+	suite.Require().Len(spotOrders, 2)
+	suite.Require().Empty(perpetualOrders)
+	cursor := suite.app.TradeshieldKeeper.GetTrailingStopCursor(suite.ctx)
+	suite.Require().False(cursor.Perpetual)
+	suite.Require().Equal(orderIds[2], cursor.OrderId)
+
+	// The next block resumes after them, moves the last one and the block
+	// after starts over
+	updated, _ := suite.app.TradeshieldKeeper.UpdateTrailingStops(suite.ctx, 2)
+	suite.Require().Len(updated, 1)
+	cursor = suite.app.TradeshieldKeeper.GetTrailingStopCursor(suite.ctx)
+	suite.Require().Empty(cursor.ListKey)
+	suite.Require().Zero(cursor.OrderId)
+
+	for _, id := range orderIds {
+		order, found := suite.app.TradeshieldKeeper.GetPendingSpotOrder(suite.ctx, id)
+		suite.Require().True(found)
+		suite.Require().Equal(math.LegacyNewDec(7), order.TrailingStop.HighWaterMark)
+	}
+}
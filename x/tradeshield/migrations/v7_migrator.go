@@ -0,0 +1,19 @@
+package migrations
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// V7Migration builds the sorted order lists of the pending orders, they are
+// used to execute the orders at the end of each block
+func (m Migrator) V7Migration(ctx sdk.Context) error {
+
+	for _, order := range m.keeper.GetAllPendingSpotOrder(ctx) {
+		m.keeper.InsertSortedSpotOrder(ctx, order)
+	}
+	for _, order := range m.keeper.GetAllPendingPerpetualOrder(ctx) {
+		m.keeper.InsertSortedPerpetualOrder(ctx, order)
+	}
+
+	return nil
+}
@@ -53,6 +53,7 @@ func SimulateMsgCreatePerpetualCloseOrder(
 
 		msg := &types.MsgCreatePerpetualCloseOrder{
 			OwnerAddress: simAccount.Address.String(),
+			OrderType:    types.PerpetualOrderType_LIMITCLOSE,
 		}
 
 		txCtx := simulation.OperationInput{
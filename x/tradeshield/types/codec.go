@@ -23,6 +23,12 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "tradeshield/MsgUpdateParams")
 	legacy.RegisterAminoMsg(cdc, &MsgExecuteOrders{}, "tradeshield/MsgExecuteOrders")
+
+	legacy.RegisterAminoMsg(cdc, &MsgCreateOrderGroup{}, "tradeshield/MsgCreateOrderGroup")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelOrderGroup{}, "tradeshield/MsgCancelOrderGroup")
+
+	legacy.RegisterAminoMsg(cdc, &MsgCreateScheduledOrder{}, "tradeshield/MsgCreateScheduledOrder")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelScheduledOrder{}, "tradeshield/MsgCancelScheduledOrder")
 	// this line is used by starport scaffolding # 2
 }
 
@@ -41,6 +47,12 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 
 		&MsgUpdateParams{},
 		&MsgExecuteOrders{},
+
+		&MsgCreateOrderGroup{},
+		&MsgCancelOrderGroup{},
+
+		&MsgCreateScheduledOrder{},
+		&MsgCancelScheduledOrder{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -13,4 +13,15 @@ var (
 	ErrSizeZero               = errors.Register(ModuleName, 1104, "zero order ids ")
 	ErrInvalidStatus          = errors.Register(ModuleName, 1105, "invalid status")
 	ErrZeroMarketPrice        = errors.Register(ModuleName, 1106, "market price is zero")
+	ErrInvalidOrderType       = errors.Register(ModuleName, 1107, "invalid order type")
+	ErrInvalidTriggerPrice    = errors.Register(ModuleName, 1108, "invalid trigger price")
+	ErrInvalidTimeInForce     = errors.Register(ModuleName, 1109, "invalid time in force")
+	ErrOrderNotFilled         = errors.Register(ModuleName, 1110, "fill or kill order cannot be filled")
+	ErrOrderExpired           = errors.Register(ModuleName, 1111, "order expired")
+	ErrInvalidTrailingStop    = errors.Register(ModuleName, 1112, "invalid trailing stop")
+	ErrOrderGroupNotFound     = errors.Register(ModuleName, 1113, "order group not found")
+	ErrInvalidOrderGroup      = errors.Register(ModuleName, 1114, "invalid order group")
+	ErrInvalidBracketOrder    = errors.Register(ModuleName, 1115, "invalid bracket order")
+	ErrScheduledOrderNotFound = errors.Register(ModuleName, 1116, "scheduled order not found")
+	ErrInvalidScheduledOrder  = errors.Register(ModuleName, 1117, "invalid scheduled order")
 )
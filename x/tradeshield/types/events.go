@@ -1,8 +1,11 @@
 package types
 
 import (
+	"fmt"
 	"strconv"
 
+	"cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	ammtypes "github.com/elys-network/elys/x/amm/types"
 )
@@ -12,7 +15,17 @@ const (
 	TypeEvtCancelPerpetualOrder           = "tradeshield/cancel_perpetual_order"
 	TypeEvtExecuteOrders                  = "tradeshield/execute_orders"
 	TypeEvtExecuteLimitOpenPerpetualOrder = "tradeshield/execute_perpetual_limit_open_order"
+	TypeEvtExecuteClosePerpetualOrder     = "tradeshield/execute_perpetual_close_order"
 	TypeEvtExecuteSpotOrder               = "tradeshield/execute_spot_order"
+	TypeEvtExecutorReward                 = "tradeshield/executor_reward"
+	TypeEvtExpireSpotOrder                = "tradeshield/expire_spot_order"
+	TypeEvtExpirePerpetualOrder           = "tradeshield/expire_perpetual_order"
+	TypeEvtCreateOrderGroup               = "tradeshield/create_order_group"
+	TypeEvtCancelOrderGroup               = "tradeshield/cancel_order_group"
+	TypeEvtActivateBracketOrders          = "tradeshield/activate_bracket_orders"
+	TypeEvtCreateScheduledOrder           = "tradeshield/create_scheduled_order"
+	TypeEvtExecuteScheduledOrderSlice     = "tradeshield/execute_scheduled_order_slice"
+	TypeEvtCloseScheduledOrder            = "tradeshield/close_scheduled_order"
 )
 
 func EmitCloseSpotOrderEvent(ctx sdk.Context, order SpotOrder) {
@@ -84,3 +97,106 @@ func NewExecuteLimitOpenPerpetualOrderEvt(order PerpetualOrder, positionId uint6
 		sdk.NewAttribute("trigger_price", order.TriggerPrice.String()),
 	)
 }
+
+func NewExecuteClosePerpetualOrderEvt(order PerpetualOrder, closeAmount math.Int) sdk.Event {
+	return sdk.NewEvent(TypeEvtExecuteClosePerpetualOrder,
+		sdk.NewAttribute("order_id", strconv.FormatInt(int64(order.OrderId), 10)),
+		sdk.NewAttribute("owner_address", order.OwnerAddress),
+		sdk.NewAttribute("order_type", order.PerpetualOrderType.String()),
+		sdk.NewAttribute("position", order.Position.String()),
+		sdk.NewAttribute("position_id", strconv.FormatInt(int64(order.PositionId), 10)),
+		sdk.NewAttribute("trigger_price", order.TriggerPrice.String()),
+		sdk.NewAttribute("close_amount", closeAmount.String()),
+	)
+}
+
+func NewExecutorRewardEvt(orderId uint64, executor sdk.AccAddress, reward sdk.Coins) sdk.Event {
+	return sdk.NewEvent(TypeEvtExecutorReward,
+		sdk.NewAttribute("order_id", strconv.FormatInt(int64(orderId), 10)),
+		sdk.NewAttribute("executor", executor.String()),
+		sdk.NewAttribute("reward", reward.String()),
+	)
+}
+
+func NewExpireSpotOrderEvt(order SpotOrder, reason string) sdk.Event {
+	return sdk.NewEvent(TypeEvtExpireSpotOrder,
+		sdk.NewAttribute("order_id", strconv.FormatInt(int64(order.OrderId), 10)),
+		sdk.NewAttribute("order_type", order.OrderType.String()),
+		sdk.NewAttribute("owner_address", order.OwnerAddress),
+		sdk.NewAttribute("time_in_force", order.TimeInForce.String()),
+		sdk.NewAttribute("reason", reason),
+	)
+}
+
+func NewExpirePerpetualOrderEvt(order PerpetualOrder, reason string) sdk.Event {
+	return sdk.NewEvent(TypeEvtExpirePerpetualOrder,
+		sdk.NewAttribute("order_id", strconv.FormatInt(int64(order.OrderId), 10)),
+		sdk.NewAttribute("order_type", order.PerpetualOrderType.String()),
+		sdk.NewAttribute("owner_address", order.OwnerAddress),
+		sdk.NewAttribute("time_in_force", order.TimeInForce.String()),
+		sdk.NewAttribute("reason", reason),
+	)
+}
+
+func NewCreateOrderGroupEvt(group OrderGroup) sdk.Event {
+	return sdk.NewEvent(TypeEvtCreateOrderGroup,
+		sdk.NewAttribute("group_id", strconv.FormatUint(group.Id, 10)),
+		sdk.NewAttribute("owner_address", group.OwnerAddress),
+		sdk.NewAttribute("spot_order_ids", fmt.Sprint(group.SpotOrderIds)),
+		sdk.NewAttribute("perpetual_order_ids", fmt.Sprint(group.PerpetualOrderIds)),
+		sdk.NewAttribute("parent_order_id", strconv.FormatUint(group.ParentOrderId, 10)),
+	)
+}
+
+func NewCancelOrderGroupEvt(group OrderGroup) sdk.Event {
+	return sdk.NewEvent(TypeEvtCancelOrderGroup,
+		sdk.NewAttribute("group_id", strconv.FormatUint(group.Id, 10)),
+		sdk.NewAttribute("owner_address", group.OwnerAddress),
+		sdk.NewAttribute("spot_order_ids", fmt.Sprint(group.SpotOrderIds)),
+		sdk.NewAttribute("perpetual_order_ids", fmt.Sprint(group.PerpetualOrderIds)),
+	)
+}
+
+func NewActivateBracketOrdersEvt(group OrderGroup, parentOrderId, positionId uint64) sdk.Event {
+	return sdk.NewEvent(TypeEvtActivateBracketOrders,
+		sdk.NewAttribute("group_id", strconv.FormatUint(group.Id, 10)),
+		sdk.NewAttribute("owner_address", group.OwnerAddress),
+		sdk.NewAttribute("parent_order_id", strconv.FormatUint(parentOrderId, 10)),
+		sdk.NewAttribute("position_id", strconv.FormatUint(positionId, 10)),
+		sdk.NewAttribute("perpetual_order_ids", fmt.Sprint(group.PerpetualOrderIds)),
+	)
+}
+
+func NewCreateScheduledOrderEvt(order ScheduledOrder) sdk.Event {
+	return sdk.NewEvent(TypeEvtCreateScheduledOrder,
+		sdk.NewAttribute("order_id", strconv.FormatUint(order.Id, 10)),
+		sdk.NewAttribute("owner_address", order.OwnerAddress),
+		sdk.NewAttribute("total_amount", order.TotalAmount.String()),
+		sdk.NewAttribute("target_denom", order.TargetDenom),
+		sdk.NewAttribute("slice_count", strconv.FormatUint(order.SliceCount, 10)),
+		sdk.NewAttribute("interval", strconv.FormatUint(order.Interval, 10)),
+		sdk.NewAttribute("interval_unit", order.IntervalUnit.String()),
+		sdk.NewAttribute("max_slippage", order.MaxSlippage.String()),
+	)
+}
+
+func NewExecuteScheduledOrderSliceEvt(order ScheduledOrder, slice ScheduledOrderSlice) sdk.Event {
+	return sdk.NewEvent(TypeEvtExecuteScheduledOrderSlice,
+		sdk.NewAttribute("order_id", strconv.FormatUint(order.Id, 10)),
+		sdk.NewAttribute("owner_address", order.OwnerAddress),
+		sdk.NewAttribute("index", strconv.FormatUint(slice.Index, 10)),
+		sdk.NewAttribute("amount_in", slice.AmountIn.String()),
+		sdk.NewAttribute("amount_out", slice.AmountOut.String()),
+		sdk.NewAttribute("error", slice.Error),
+	)
+}
+
+func NewCloseScheduledOrderEvt(order ScheduledOrder, refund sdk.Coin) sdk.Event {
+	return sdk.NewEvent(TypeEvtCloseScheduledOrder,
+		sdk.NewAttribute("order_id", strconv.FormatUint(order.Id, 10)),
+		sdk.NewAttribute("owner_address", order.OwnerAddress),
+		sdk.NewAttribute("executed_slices", strconv.FormatUint(order.ExecutedSlices, 10)),
+		sdk.NewAttribute("received_amount", order.ReceivedAmount.String()),
+		sdk.NewAttribute("refund", refund.String()),
+	)
+}
@@ -31,8 +31,10 @@ type BankKeeper interface {
 //go:generate mockery --srcpkg . --name AmmKeeper --structname AmmKeeper --filename amm_keeper.go --with-expecter
 type AmmKeeper interface {
 	SwapByDenom(ctx sdk.Context, msg *ammtypes.MsgSwapByDenom) (*ammtypes.MsgSwapByDenomResponse, error)
+	ExecuteSwapByDenom(ctx sdk.Context, msg *ammtypes.MsgSwapByDenom) (*ammtypes.MsgSwapByDenomResponse, error)
 	CalculateUSDValue(ctx sdk.Context, denom string, amount sdkmath.Int) sdkmath.LegacyDec
 	CalcAmmPrice(ctx sdk.Context, denom string, decimal uint64) sdkmath.LegacyDec
+	SwapEstimationByDenom(goCtx context.Context, req *ammtypes.QuerySwapEstimationByDenomRequest) (*ammtypes.QuerySwapEstimationByDenomResponse, error)
 }
 
 // PerpetualKeeper defines the expected interface needed to open and close perpetual positions
@@ -12,6 +12,9 @@ func DefaultGenesis() *GenesisState {
 	return &GenesisState{
 		PendingSpotOrderList:      []SpotOrder{},
 		PendingPerpetualOrderList: []PerpetualOrder{},
+		OrderGroupList:            []OrderGroup{},
+		ScheduledOrderList:        []ScheduledOrder{},
+		ScheduledOrderSliceList:   []ScheduledOrderSlice{},
 		// this line is used by starport scaffolding # genesis/types/default
 		Params: DefaultParams(),
 	}
@@ -44,6 +47,36 @@ func (gs GenesisState) Validate() error {
 		}
 		pendingPerpetualOrderIdMap[elem.OrderId] = true
 	}
+	// Check for duplicated ID in orderGroup
+	orderGroupIdMap := make(map[uint64]bool)
+	orderGroupCount := gs.GetOrderGroupCount()
+	for _, elem := range gs.OrderGroupList {
+		if _, ok := orderGroupIdMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for orderGroup")
+		}
+		if elem.Id >= orderGroupCount {
+			return fmt.Errorf("orderGroup id should be lower or equal than the last id")
+		}
+		orderGroupIdMap[elem.Id] = true
+	}
+	// Check for duplicated ID in scheduledOrder
+	scheduledOrderIdMap := make(map[uint64]bool)
+	scheduledOrderCount := gs.GetScheduledOrderCount()
+	for _, elem := range gs.ScheduledOrderList {
+		if _, ok := scheduledOrderIdMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for scheduledOrder")
+		}
+		if elem.Id >= scheduledOrderCount {
+			return fmt.Errorf("scheduledOrder id should be lower or equal than the last id")
+		}
+		scheduledOrderIdMap[elem.Id] = true
+	}
+	// Check that slices belong to a scheduled order
+	for _, elem := range gs.ScheduledOrderSliceList {
+		if !scheduledOrderIdMap[elem.OrderId] {
+			return fmt.Errorf("scheduledOrderSlice of unknown scheduledOrder %d", elem.OrderId)
+		}
+	}
 	// this line is used by starport scaffolding # genesis/types/validate
 
 	return gs.Params.Validate()
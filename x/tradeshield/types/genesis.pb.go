@@ -25,11 +25,16 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the tradeshield module's genesis state.
 type GenesisState struct {
-	Params                     Params           `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	PendingSpotOrderList       []SpotOrder      `protobuf:"bytes,2,rep,name=pending_spot_order_list,json=pendingSpotOrderList,proto3" json:"pending_spot_order_list"`
-	PendingSpotOrderCount      uint64           `protobuf:"varint,3,opt,name=pending_spot_order_count,json=pendingSpotOrderCount,proto3" json:"pending_spot_order_count,omitempty"`
-	PendingPerpetualOrderList  []PerpetualOrder `protobuf:"bytes,4,rep,name=pending_perpetual_order_list,json=pendingPerpetualOrderList,proto3" json:"pending_perpetual_order_list"`
-	PendingPerpetualOrderCount uint64           `protobuf:"varint,5,opt,name=pending_perpetual_order_count,json=pendingPerpetualOrderCount,proto3" json:"pending_perpetual_order_count,omitempty"`
+	Params                     Params                `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	PendingSpotOrderList       []SpotOrder           `protobuf:"bytes,2,rep,name=pending_spot_order_list,json=pendingSpotOrderList,proto3" json:"pending_spot_order_list"`
+	PendingSpotOrderCount      uint64                `protobuf:"varint,3,opt,name=pending_spot_order_count,json=pendingSpotOrderCount,proto3" json:"pending_spot_order_count,omitempty"`
+	PendingPerpetualOrderList  []PerpetualOrder      `protobuf:"bytes,4,rep,name=pending_perpetual_order_list,json=pendingPerpetualOrderList,proto3" json:"pending_perpetual_order_list"`
+	PendingPerpetualOrderCount uint64                `protobuf:"varint,5,opt,name=pending_perpetual_order_count,json=pendingPerpetualOrderCount,proto3" json:"pending_perpetual_order_count,omitempty"`
+	OrderGroupList             []OrderGroup          `protobuf:"bytes,6,rep,name=order_group_list,json=orderGroupList,proto3" json:"order_group_list"`
+	OrderGroupCount            uint64                `protobuf:"varint,7,opt,name=order_group_count,json=orderGroupCount,proto3" json:"order_group_count,omitempty"`
+	ScheduledOrderList         []ScheduledOrder      `protobuf:"bytes,8,rep,name=scheduled_order_list,json=scheduledOrderList,proto3" json:"scheduled_order_list"`
+	ScheduledOrderCount        uint64                `protobuf:"varint,9,opt,name=scheduled_order_count,json=scheduledOrderCount,proto3" json:"scheduled_order_count,omitempty"`
+	ScheduledOrderSliceList    []ScheduledOrderSlice `protobuf:"bytes,10,rep,name=scheduled_order_slice_list,json=scheduledOrderSliceList,proto3" json:"scheduled_order_slice_list"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -100,6 +105,41 @@ func (m *GenesisState) GetPendingPerpetualOrderCount() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetOrderGroupList() []OrderGroup {
+	if m != nil {
+		return m.OrderGroupList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetOrderGroupCount() uint64 {
+	if m != nil {
+		return m.OrderGroupCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetScheduledOrderList() []ScheduledOrder {
+	if m != nil {
+		return m.ScheduledOrderList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetScheduledOrderCount() uint64 {
+	if m != nil {
+		return m.ScheduledOrderCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetScheduledOrderSliceList() []ScheduledOrderSlice {
+	if m != nil {
+		return m.ScheduledOrderSliceList
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "elys.tradeshield.GenesisState")
 }
@@ -107,28 +147,35 @@ func init() {
 func init() { proto.RegisterFile("elys/tradeshield/genesis.proto", fileDescriptor_da92b96d2e7e2f05) }
 
 var fileDescriptor_da92b96d2e7e2f05 = []byte{
-	// 330 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0x31, 0x4f, 0xc2, 0x40,
-	0x14, 0x80, 0x5b, 0x41, 0x86, 0xc3, 0xc1, 0x34, 0x18, 0x6b, 0x85, 0xb3, 0x71, 0x62, 0xb1, 0x35,
-	0x98, 0xe8, 0x2c, 0x0e, 0x26, 0xc6, 0x44, 0x03, 0x8b, 0x71, 0x21, 0x85, 0xbe, 0x94, 0xc6, 0xd2,
-	0xbb, 0xdc, 0x3d, 0xa2, 0xfc, 0x00, 0x77, 0x7f, 0x16, 0x23, 0xa3, 0x93, 0x31, 0xf0, 0x47, 0x4c,
-	0xaf, 0x87, 0x41, 0x5a, 0xb7, 0xb6, 0xdf, 0x7b, 0xdf, 0x7d, 0xcd, 0x11, 0x0a, 0xc9, 0x4c, 0xfa,
-	0x28, 0x82, 0x10, 0xe4, 0x38, 0x86, 0x24, 0xf4, 0x23, 0x48, 0x41, 0xc6, 0xd2, 0xe3, 0x82, 0x21,
-	0xb3, 0xf6, 0x33, 0xee, 0x6d, 0x70, 0xa7, 0x11, 0xb1, 0x88, 0x29, 0xe8, 0x67, 0x4f, 0xf9, 0x9c,
-	0xd3, 0x2a, 0x78, 0x78, 0x20, 0x82, 0x89, 0xd6, 0x38, 0xcd, 0x02, 0xc6, 0x19, 0x07, 0x4d, 0x4f,
-	0xdf, 0x2b, 0x64, 0xef, 0x36, 0x3f, 0xb6, 0x8f, 0x01, 0x82, 0x75, 0x49, 0x6a, 0xf9, 0xba, 0x6d,
-	0xba, 0x66, 0xbb, 0xde, 0xb1, 0xbd, 0xed, 0x0c, 0xef, 0x51, 0xf1, 0x6e, 0x75, 0xfe, 0x75, 0x62,
-	0xf4, 0xf4, 0xb4, 0xf5, 0x44, 0x0e, 0x39, 0xa4, 0x61, 0x9c, 0x46, 0x03, 0xc9, 0x19, 0x0e, 0x98,
-	0x08, 0x41, 0x0c, 0x92, 0x58, 0xa2, 0xbd, 0xe3, 0x56, 0xda, 0xf5, 0xce, 0x71, 0x51, 0xd4, 0xe7,
-	0x0c, 0x1f, 0xb2, 0x39, 0xed, 0x6a, 0x68, 0xc3, 0xef, 0xf7, 0xfb, 0x58, 0xa2, 0x75, 0x45, 0xec,
-	0x12, 0xf3, 0x88, 0x4d, 0x53, 0xb4, 0x2b, 0xae, 0xd9, 0xae, 0xf6, 0x0e, 0xb6, 0xf7, 0x6e, 0x32,
-	0x68, 0x45, 0xa4, 0xb9, 0x5e, 0xe4, 0x20, 0x38, 0xe0, 0x34, 0x48, 0x36, 0xbb, 0xaa, 0xaa, 0xcb,
-	0x2d, 0xf9, 0xc1, 0xf5, 0xf4, 0x66, 0xdc, 0x91, 0x76, 0xfd, 0x85, 0xaa, 0xf0, 0x9a, 0xb4, 0xfe,
-	0x3b, 0x28, 0xcf, 0xdc, 0x55, 0x99, 0x4e, 0xa9, 0x41, 0xb5, 0x76, 0xef, 0xe6, 0x4b, 0x6a, 0x2e,
-	0x96, 0xd4, 0xfc, 0x5e, 0x52, 0xf3, 0x63, 0x45, 0x8d, 0xc5, 0x8a, 0x1a, 0x9f, 0x2b, 0x6a, 0x3c,
-	0x9f, 0x47, 0x31, 0x8e, 0xa7, 0x43, 0x6f, 0xc4, 0x26, 0x7e, 0x56, 0x7a, 0x96, 0x02, 0xbe, 0x32,
-	0xf1, 0xa2, 0x5e, 0xfc, 0xb7, 0xe2, 0xcd, 0x0e, 0x6b, 0xea, 0x6a, 0x2f, 0x7e, 0x02, 0x00, 0x00,
-	0xff, 0xff, 0x4a, 0xea, 0xc1, 0x2c, 0x61, 0x02, 0x00, 0x00,
+	// 438 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0x5f, 0xeb, 0xd2, 0x50,
+	0x18, 0xc7, 0xb7, 0x7e, 0x6a, 0x75, 0x8c, 0xb2, 0xa5, 0xb8, 0x96, 0xae, 0x11, 0x04, 0x12, 0xb4,
+	0x85, 0x41, 0x5d, 0x67, 0x17, 0x42, 0x08, 0x85, 0xbb, 0x91, 0x6e, 0x64, 0x6e, 0x87, 0x6d, 0x34,
+	0x77, 0x0e, 0xe7, 0x9c, 0x51, 0xbe, 0x8b, 0x5e, 0x96, 0x97, 0x5e, 0x76, 0x15, 0xa1, 0x2f, 0xa2,
+	0xdb, 0x38, 0x7f, 0xb4, 0xb9, 0x29, 0xbf, 0x3b, 0x7d, 0xbe, 0x7f, 0x9e, 0xcf, 0x1e, 0x38, 0xc0,
+	0x86, 0xd9, 0x86, 0x7a, 0x8c, 0x04, 0x11, 0xa4, 0x49, 0x0a, 0xb3, 0xc8, 0x8b, 0x61, 0x0e, 0x69,
+	0x4a, 0x5d, 0x4c, 0x10, 0x43, 0x46, 0x87, 0xeb, 0x6e, 0x49, 0xb7, 0xba, 0x31, 0x8a, 0x91, 0x10,
+	0x3d, 0xfe, 0x4b, 0xfa, 0xac, 0x61, 0xad, 0x07, 0x07, 0x24, 0x58, 0xab, 0x1a, 0x6b, 0x50, 0x93,
+	0xd9, 0x06, 0x43, 0xa5, 0xbe, 0xf8, 0xdb, 0x04, 0x0f, 0xa6, 0x72, 0xad, 0xcf, 0x02, 0x06, 0x8d,
+	0x77, 0xa0, 0x25, 0xe3, 0xa6, 0xee, 0xe8, 0xa3, 0xf6, 0xd8, 0x74, 0xab, 0x18, 0xee, 0x17, 0xa1,
+	0x4f, 0x1a, 0xdb, 0xdf, 0xcf, 0xb5, 0xb9, 0x72, 0x1b, 0x0b, 0xd0, 0xc7, 0x30, 0x8f, 0xd2, 0x3c,
+	0x5e, 0x52, 0x8c, 0xd8, 0x12, 0x91, 0x08, 0x92, 0x65, 0x96, 0x52, 0x66, 0xde, 0x71, 0x6e, 0x46,
+	0xed, 0xf1, 0xb3, 0x7a, 0x91, 0x8f, 0x11, 0xfb, 0xcc, 0x7d, 0xaa, 0xab, 0xab, 0x1a, 0x4e, 0xf3,
+	0x59, 0x4a, 0x99, 0xf1, 0x1e, 0x98, 0x17, 0x9a, 0x43, 0x54, 0xe4, 0xcc, 0xbc, 0x71, 0xf4, 0x51,
+	0x63, 0xde, 0xab, 0xe6, 0x3e, 0x72, 0xd1, 0x88, 0xc1, 0xe0, 0x18, 0xc4, 0x90, 0x60, 0xc8, 0x8a,
+	0x20, 0x2b, 0x73, 0x35, 0x04, 0x97, 0x73, 0xe1, 0x03, 0x8f, 0xee, 0x32, 0xdc, 0x53, 0xd5, 0x75,
+	0x2e, 0x0a, 0xc2, 0x0f, 0x60, 0x78, 0x6d, 0x91, 0xc4, 0x6c, 0x0a, 0x4c, 0xeb, 0x62, 0x83, 0x64,
+	0x9d, 0x81, 0x8e, 0x0c, 0xc4, 0x04, 0x15, 0x58, 0xf2, 0xb5, 0x04, 0xdf, 0xa0, 0xce, 0x27, 0x72,
+	0x53, 0x6e, 0x54, 0x6c, 0x0f, 0xd1, 0x69, 0x22, 0x80, 0x5e, 0x81, 0xc7, 0xe5, 0x36, 0x09, 0x71,
+	0x57, 0x40, 0x3c, 0xfa, 0x6f, 0x95, 0x9b, 0x17, 0xa0, 0x4b, 0xc3, 0x04, 0x46, 0x45, 0x06, 0xa3,
+	0xf2, 0x75, 0xee, 0x5d, 0xbb, 0x8e, 0x7f, 0x74, 0x97, 0xaf, 0x63, 0xd0, 0xb3, 0xa9, 0xa0, 0x18,
+	0x83, 0x5e, 0xb5, 0x59, 0x92, 0xdc, 0x17, 0x24, 0x4f, 0xce, 0x23, 0x92, 0x26, 0x01, 0x56, 0x35,
+	0x43, 0xb3, 0x34, 0x84, 0x92, 0x09, 0x08, 0xa6, 0x97, 0xb7, 0x31, 0xf9, 0x3c, 0xa1, 0xc0, 0xfa,
+	0xb4, 0x2e, 0x71, 0xba, 0xc9, 0xa7, 0xed, 0xde, 0xd6, 0x77, 0x7b, 0x5b, 0xff, 0xb3, 0xb7, 0xf5,
+	0x9f, 0x07, 0x5b, 0xdb, 0x1d, 0x6c, 0xed, 0xd7, 0xc1, 0xd6, 0xbe, 0xbe, 0x89, 0x53, 0x96, 0x14,
+	0x2b, 0x37, 0x44, 0x6b, 0x8f, 0x6f, 0x7a, 0x9d, 0x43, 0xf6, 0x1d, 0x91, 0x6f, 0xe2, 0x8f, 0xf7,
+	0xa3, 0xfe, 0x96, 0x56, 0x2d, 0xf1, 0x98, 0xde, 0xfe, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x4f, 0x30,
+	0xd0, 0xc0, 0xd3, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -151,6 +198,58 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ScheduledOrderSliceList) > 0 {
+		for iNdEx := len(m.ScheduledOrderSliceList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScheduledOrderSliceList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.ScheduledOrderCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ScheduledOrderCount))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.ScheduledOrderList) > 0 {
+		for iNdEx := len(m.ScheduledOrderList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScheduledOrderList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.OrderGroupCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.OrderGroupCount))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.OrderGroupList) > 0 {
+		for iNdEx := len(m.OrderGroupList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OrderGroupList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if m.PendingPerpetualOrderCount != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.PendingPerpetualOrderCount))
 		i--
@@ -239,6 +338,30 @@ func (m *GenesisState) Size() (n int) {
 	if m.PendingPerpetualOrderCount != 0 {
 		n += 1 + sovGenesis(uint64(m.PendingPerpetualOrderCount))
 	}
+	if len(m.OrderGroupList) > 0 {
+		for _, e := range m.OrderGroupList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.OrderGroupCount != 0 {
+		n += 1 + sovGenesis(uint64(m.OrderGroupCount))
+	}
+	if len(m.ScheduledOrderList) > 0 {
+		for _, e := range m.ScheduledOrderList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.ScheduledOrderCount != 0 {
+		n += 1 + sovGenesis(uint64(m.ScheduledOrderCount))
+	}
+	if len(m.ScheduledOrderSliceList) > 0 {
+		for _, e := range m.ScheduledOrderSliceList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -416,6 +539,146 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderGroupList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderGroupList = append(m.OrderGroupList, OrderGroup{})
+			if err := m.OrderGroupList[len(m.OrderGroupList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderGroupCount", wireType)
+			}
+			m.OrderGroupCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderGroupCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledOrderList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduledOrderList = append(m.ScheduledOrderList, ScheduledOrder{})
+			if err := m.ScheduledOrderList[len(m.ScheduledOrderList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledOrderCount", wireType)
+			}
+			m.ScheduledOrderCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduledOrderCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledOrderSliceList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduledOrderSliceList = append(m.ScheduledOrderSliceList, ScheduledOrderSlice{})
+			if err := m.ScheduledOrderSliceList[len(m.ScheduledOrderSliceList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -41,6 +41,30 @@ func TestGenesisState_Validate(t *testing.T) {
 					},
 				},
 				PendingPerpetualOrderCount: 2,
+				OrderGroupList: []types.OrderGroup{
+					{
+						Id: 0,
+					},
+					{
+						Id: 1,
+					},
+				},
+				OrderGroupCount: 2,
+				ScheduledOrderList: []types.ScheduledOrder{
+					{
+						Id: 0,
+					},
+					{
+						Id: 1,
+					},
+				},
+				ScheduledOrderCount: 2,
+				ScheduledOrderSliceList: []types.ScheduledOrderSlice{
+					{
+						OrderId: 1,
+						Index:   1,
+					},
+				},
 				// this line is used by starport scaffolding # types/genesis/validField
 			},
 			valid: true,
@@ -97,6 +121,65 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "duplicated orderGroup",
+			genState: &types.GenesisState{
+				OrderGroupList: []types.OrderGroup{
+					{
+						Id: 0,
+					},
+					{
+						Id: 0,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid orderGroup count",
+			genState: &types.GenesisState{
+				OrderGroupList: []types.OrderGroup{
+					{
+						Id: 1,
+					},
+				},
+				OrderGroupCount: 0,
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated scheduledOrder",
+			genState: &types.GenesisState{
+				ScheduledOrderList: []types.ScheduledOrder{
+					{
+						Id: 0,
+					},
+					{
+						Id: 0,
+					},
+				},
+				ScheduledOrderCount: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "slice of unknown scheduledOrder",
+			genState: &types.GenesisState{
+				ScheduledOrderList: []types.ScheduledOrder{
+					{
+						Id: 1,
+					},
+				},
+				ScheduledOrderCount: 2,
+				ScheduledOrderSliceList: []types.ScheduledOrderSlice{
+					{
+						OrderId: 2,
+						Index:   1,
+					},
+				},
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	}
 	for _, tc := range tests {
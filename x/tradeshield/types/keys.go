@@ -26,6 +26,20 @@ var (
 	PendingPerpetualOrderKey      = []byte{0x05}
 	PendingPerpetualOrderCountKey = []byte{0x06}
 	SortedPerpetualOrderKey       = []byte{0x07}
+	SpotOrderExpiryHeightKey      = []byte{0x08}
+	SpotOrderExpiryTimeKey        = []byte{0x09}
+	PerpetualOrderExpiryHeightKey = []byte{0x0a}
+	PerpetualOrderExpiryTimeKey   = []byte{0x0b}
+	OrderGroupKey                 = []byte{0x0c}
+	OrderGroupCountKey            = []byte{0x0d}
+	ScheduledOrderKey             = []byte{0x0e}
+	ScheduledOrderCountKey        = []byte{0x0f}
+	ScheduledOrderHeightQueueKey  = []byte{0x10}
+	ScheduledOrderTimeQueueKey    = []byte{0x11}
+	ScheduledOrderSliceKey        = []byte{0x12}
+	TrailingStopCursorKey         = []byte{0x13}
+	SpotOrderFailureKey           = []byte{0x14}
+	PerpetualOrderFailureKey      = []byte{0x15}
 )
 
 func GenSpotKey(order SpotOrder) (string, error) {
@@ -45,6 +59,17 @@ func GenPerpKey(order PerpetualOrder) string {
 		order.TriggerPrice.TradingAssetDenom)
 }
 
+// GenSpotKeyPrefix returns the prefix of the sorted lists of an order type
+func GenSpotKeyPrefix(orderType SpotOrderType) string {
+	return fmt.Sprintf("%s\n", orderType)
+}
+
+// GenPerpKeyPrefix returns the prefix of the sorted lists of a position and
+// an order type
+func GenPerpKeyPrefix(position PerpetualPosition, orderType PerpetualOrderType) string {
+	return fmt.Sprintf("%s\n%s\n", position, orderType)
+}
+
 func EncodeUint64Slice(slice []uint64) []byte {
 	buf := make([]byte, 8*len(slice))
 	for i, v := range slice {
@@ -64,6 +89,18 @@ func DecodeUint64Slice(bz []byte) ([]uint64, error) {
 	return slice, nil
 }
 
+// GetOrderExpiryKey returns the key of an order in an expiry queue, ordered by
+// the expiry height or timestamp first
+func GetOrderExpiryKey(at, orderId uint64) []byte {
+	return append(GetUint64Bytes(at), GetUint64Bytes(orderId)...)
+}
+
+// GetScheduledOrderSliceKey returns the key of a slice record, the slices of
+// an order are stored in their execution order
+func GetScheduledOrderSliceKey(orderId, index uint64) []byte {
+	return append(GetUint64Bytes(orderId), GetUint64Bytes(index)...)
+}
+
 // GetUint64Bytes returns the byte representation of the ID
 func GetUint64Bytes(ID uint64) []byte {
 	IDBz := make([]byte, 8)
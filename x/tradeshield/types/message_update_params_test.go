@@ -74,6 +74,26 @@ func TestMsgUpdateParams_ValidateBasic(t *testing.T) {
 			},
 			err: fmt.Errorf("RewardPercentage is negative"),
 		},
+		{
+			name: "RewardPercentage greater than 1",
+			msg: MsgUpdateParams{
+				Authority: sample.AccAddress(),
+				Params: &Params{
+					MarketOrderEnabled:   true,
+					StakeEnabled:         true,
+					ProcessOrdersEnabled: true,
+					SwapEnabled:          true,
+					PerpetualEnabled:     true,
+					RewardEnabled:        true,
+					LeverageEnabled:      true,
+					LimitProcessOrder:    1,
+					RewardPercentage:     sdkmath.LegacyMustNewDecFromStr("1.5"),
+					MarginError:          sdkmath.LegacyMustNewDecFromStr("0.1"),
+					MinimumDeposit:       sdkmath.NewInt(100),
+				},
+			},
+			err: fmt.Errorf("RewardPercentage is greater than 1"),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
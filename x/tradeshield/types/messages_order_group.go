@@ -0,0 +1,64 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgCreateOrderGroup{}
+
+func NewMsgCreateOrderGroup(ownerAddress string, spotOrderIds, perpetualOrderIds []uint64) *MsgCreateOrderGroup {
+	return &MsgCreateOrderGroup{
+		OwnerAddress:      ownerAddress,
+		SpotOrderIds:      spotOrderIds,
+		PerpetualOrderIds: perpetualOrderIds,
+	}
+}
+
+func (msg *MsgCreateOrderGroup) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.OwnerAddress)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address (%s)", err)
+	}
+
+	size := len(msg.SpotOrderIds) + len(msg.PerpetualOrderIds)
+	if size < 2 || size > MaxOrderGroupSize {
+		return errorsmod.Wrapf(ErrInvalidOrderGroup, "a group holds between 2 and %d orders", MaxOrderGroupSize)
+	}
+
+	for _, ids := range [][]uint64{msg.SpotOrderIds, msg.PerpetualOrderIds} {
+		seen := make(map[uint64]bool)
+		for _, id := range ids {
+			if id == 0 {
+				return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "order ID cannot be zero")
+			}
+			if seen[id] {
+				return errorsmod.Wrapf(ErrInvalidOrderGroup, "duplicated order %d", id)
+			}
+			seen[id] = true
+		}
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgCancelOrderGroup{}
+
+func NewMsgCancelOrderGroup(ownerAddress string, groupId uint64) *MsgCancelOrderGroup {
+	return &MsgCancelOrderGroup{
+		OwnerAddress: ownerAddress,
+		GroupId:      groupId,
+	}
+}
+
+func (msg *MsgCancelOrderGroup) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.OwnerAddress)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address (%s)", err)
+	}
+
+	if msg.GroupId == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "group ID cannot be zero")
+	}
+	return nil
+}
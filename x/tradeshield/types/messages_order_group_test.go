@@ -0,0 +1,109 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/elys-network/elys/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgCreateOrderGroup_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgCreateOrderGroup
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgCreateOrderGroup{
+				OwnerAddress: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid group",
+			msg: MsgCreateOrderGroup{
+				OwnerAddress:      sample.AccAddress(),
+				SpotOrderIds:      []uint64{1},
+				PerpetualOrderIds: []uint64{1},
+			},
+		}, {
+			name: "single order",
+			msg: MsgCreateOrderGroup{
+				OwnerAddress: sample.AccAddress(),
+				SpotOrderIds: []uint64{1},
+			},
+			err: ErrInvalidOrderGroup,
+		}, {
+			name: "too many orders",
+			msg: MsgCreateOrderGroup{
+				OwnerAddress:      sample.AccAddress(),
+				SpotOrderIds:      []uint64{1, 2, 3, 4, 5, 6},
+				PerpetualOrderIds: []uint64{1, 2, 3, 4, 5},
+			},
+			err: ErrInvalidOrderGroup,
+		}, {
+			name: "duplicated order",
+			msg: MsgCreateOrderGroup{
+				OwnerAddress:      sample.AccAddress(),
+				PerpetualOrderIds: []uint64{1, 1},
+			},
+			err: ErrInvalidOrderGroup,
+		}, {
+			name: "zero order id",
+			msg: MsgCreateOrderGroup{
+				OwnerAddress: sample.AccAddress(),
+				SpotOrderIds: []uint64{0, 1},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgCancelOrderGroup_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgCancelOrderGroup
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgCancelOrderGroup{
+				OwnerAddress: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "zero group id",
+			msg: MsgCancelOrderGroup{
+				OwnerAddress: sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid group",
+			msg: MsgCancelOrderGroup{
+				OwnerAddress: sample.AccAddress(),
+				GroupId:      1,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
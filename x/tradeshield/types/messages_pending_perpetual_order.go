@@ -84,6 +84,14 @@ func (msg *MsgCreatePerpetualOpenOrder) ValidateBasic() error {
 	if msg.Position == PerpetualPosition_SHORT && !msg.StopLossPrice.IsZero() && msg.TakeProfitPrice.GTE(msg.StopLossPrice) {
 		return fmt.Errorf("TakeProfitPrice cannot be >= StopLossPrice for SHORT")
 	}
+
+	if err = ValidateTimeInForce(msg.TimeInForce, msg.Expiry); err != nil {
+		return err
+	}
+
+	if err = ValidateBracketOrders(msg.Position, msg.TriggerPrice.Rate, msg.BracketOrders); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -93,11 +101,15 @@ func NewMsgCreatePerpetualCloseOrder(
 	ownerAddress string,
 	triggerPrice TriggerPrice,
 	positionId uint64,
+	orderType PerpetualOrderType,
+	closeAmount math.Int,
 ) *MsgCreatePerpetualCloseOrder {
 	return &MsgCreatePerpetualCloseOrder{
 		TriggerPrice: triggerPrice,
 		OwnerAddress: ownerAddress,
 		PositionId:   positionId,
+		OrderType:    orderType,
+		CloseAmount:  closeAmount,
 	}
 }
 
@@ -107,19 +119,45 @@ func (msg *MsgCreatePerpetualCloseOrder) ValidateBasic() error {
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address (%s)", err)
 	}
 
-	if err = CheckLegacyDecNilAndNegative(msg.TriggerPrice.Rate, "TriggerPrice Rate"); err != nil {
-		return err
+	// The trigger price of trailing stop orders is set from the market price
+	if !msg.OrderType.IsTrailingStop() {
+		if err = CheckLegacyDecNilAndNegative(msg.TriggerPrice.Rate, "TriggerPrice Rate"); err != nil {
+			return err
+		}
+		if msg.TriggerPrice.Rate.IsZero() {
+			return errorsmod.Wrap(ErrInvalidTriggerPrice, "trigger price cannot be zero")
+		}
 	}
 
-	err = sdk.ValidateDenom(msg.TriggerPrice.TradingAssetDenom)
-	if err != nil {
-		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid trading asset denom (%s)", err)
+	// The trading asset denom is filled from the position when empty
+	if msg.TriggerPrice.TradingAssetDenom != "" {
+		err = sdk.ValidateDenom(msg.TriggerPrice.TradingAssetDenom)
+		if err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid trading asset denom (%s)", err)
+		}
 	}
 
 	// Validate PositionId
 	if msg.PositionId == 0 {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "position ID cannot be zero")
 	}
+
+	if msg.OrderType != PerpetualOrderType_LIMITCLOSE && msg.OrderType != PerpetualOrderType_STOPLOSSPERP && msg.OrderType != PerpetualOrderType_TRAILINGSTOPPERP {
+		return errorsmod.Wrapf(ErrInvalidOrderType, "%s is not a close order type", msg.OrderType)
+	}
+
+	if err = ValidateTimeInForce(msg.TimeInForce, msg.Expiry); err != nil {
+		return err
+	}
+
+	if err = ValidateTrailingStop(msg.OrderType.IsTrailingStop(), msg.TrailingStop); err != nil {
+		return err
+	}
+
+	// A zero close amount closes the whole position
+	if !msg.CloseAmount.IsNil() && msg.CloseAmount.IsNegative() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "close amount cannot be negative")
+	}
 	return nil
 }
 
@@ -67,7 +67,45 @@ func TestMsgCreatePerpetualCloseOrder_ValidateBasic(t *testing.T) {
 				OwnerAddress: sample.AccAddress(),
 				TriggerPrice: TriggerPrice{Rate: math.LegacyNewDec(100), TradingAssetDenom: "base"},
 				PositionId:   1,
+				OrderType:    PerpetualOrderType_LIMITCLOSE,
 			},
+		}, {
+			name: "stop loss with trading asset filled by the handler",
+			msg: MsgCreatePerpetualCloseOrder{
+				OwnerAddress: sample.AccAddress(),
+				TriggerPrice: TriggerPrice{Rate: math.LegacyNewDec(100)},
+				PositionId:   1,
+				OrderType:    PerpetualOrderType_STOPLOSSPERP,
+				CloseAmount:  math.NewInt(50),
+			},
+		}, {
+			name: "open order type",
+			msg: MsgCreatePerpetualCloseOrder{
+				OwnerAddress: sample.AccAddress(),
+				TriggerPrice: TriggerPrice{Rate: math.LegacyNewDec(100)},
+				PositionId:   1,
+				OrderType:    PerpetualOrderType_LIMITOPEN,
+			},
+			err: ErrInvalidOrderType,
+		}, {
+			name: "zero trigger price",
+			msg: MsgCreatePerpetualCloseOrder{
+				OwnerAddress: sample.AccAddress(),
+				TriggerPrice: TriggerPrice{Rate: math.LegacyZeroDec()},
+				PositionId:   1,
+				OrderType:    PerpetualOrderType_LIMITCLOSE,
+			},
+			err: ErrInvalidTriggerPrice,
+		}, {
+			name: "negative close amount",
+			msg: MsgCreatePerpetualCloseOrder{
+				OwnerAddress: sample.AccAddress(),
+				TriggerPrice: TriggerPrice{Rate: math.LegacyNewDec(100)},
+				PositionId:   1,
+				OrderType:    PerpetualOrderType_LIMITCLOSE,
+				CloseAmount:  math.NewInt(-1),
+			},
+			err: sdkerrors.ErrInvalidRequest,
 		},
 	}
 	for _, tt := range tests {
@@ -26,8 +26,11 @@ func (msg *MsgCreateSpotOrder) ValidateBasic() error {
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
 	}
 
-	if err = CheckLegacyDecNilAndNegative(msg.OrderPrice.Rate, "OrderPrice Rate"); err != nil {
-		return err
+	// The rate of trailing stop orders is set from the market price
+	if !msg.OrderType.IsTrailingStop() {
+		if err = CheckLegacyDecNilAndNegative(msg.OrderPrice.Rate, "OrderPrice Rate"); err != nil {
+			return err
+		}
 	}
 	if err = sdk.ValidateDenom(msg.OrderPrice.BaseDenom); err != nil {
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid base asset denom (%s)", err)
@@ -46,9 +49,17 @@ func (msg *MsgCreateSpotOrder) ValidateBasic() error {
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid order target asset denom (%s)", err)
 	}
 
-	if msg.OrderType != SpotOrderType_STOPLOSS && msg.OrderType != SpotOrderType_LIMITSELL && msg.OrderType != SpotOrderType_LIMITBUY && msg.OrderType != SpotOrderType_MARKETBUY {
+	if msg.OrderType != SpotOrderType_STOPLOSS && msg.OrderType != SpotOrderType_LIMITSELL && msg.OrderType != SpotOrderType_LIMITBUY && msg.OrderType != SpotOrderType_MARKETBUY && msg.OrderType != SpotOrderType_TRAILINGSTOP {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "invalid order type")
 	}
+
+	if err = ValidateTimeInForce(msg.TimeInForce, msg.Expiry); err != nil {
+		return err
+	}
+
+	if err = ValidateTrailingStop(msg.OrderType.IsTrailingStop(), msg.TrailingStop); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -35,6 +35,115 @@ func TestMsgCreateSpotOrder_ValidateBasic(t *testing.T) {
 				OrderAmount:      sdk.Coin{Denom: "base", Amount: sdkmath.OneInt()},
 				OrderTargetDenom: "base_denom",
 			},
+		}, {
+			name: "gtt order without expiry",
+			msg: MsgCreateSpotOrder{
+				OwnerAddress: sample.AccAddress(),
+				OrderPrice: OrderPrice{
+					BaseDenom:  "base_denom",
+					QuoteDenom: "quote_denom",
+					Rate:       sdkmath.LegacyOneDec(),
+				},
+				OrderType:        SpotOrderType_LIMITBUY,
+				OrderAmount:      sdk.Coin{Denom: "base", Amount: sdkmath.OneInt()},
+				OrderTargetDenom: "base_denom",
+				TimeInForce:      TimeInForce_GTT,
+				Expiry:           &Date{},
+			},
+			err: ErrInvalidTimeInForce,
+		}, {
+			name: "ioc order with expiry",
+			msg: MsgCreateSpotOrder{
+				OwnerAddress: sample.AccAddress(),
+				OrderPrice: OrderPrice{
+					BaseDenom:  "base_denom",
+					QuoteDenom: "quote_denom",
+					Rate:       sdkmath.LegacyOneDec(),
+				},
+				OrderType:        SpotOrderType_LIMITBUY,
+				OrderAmount:      sdk.Coin{Denom: "base", Amount: sdkmath.OneInt()},
+				OrderTargetDenom: "base_denom",
+				TimeInForce:      TimeInForce_IOC,
+				Expiry:           &Date{Height: 10},
+			},
+			err: ErrInvalidTimeInForce,
+		}, {
+			name: "gtt order",
+			msg: MsgCreateSpotOrder{
+				OwnerAddress: sample.AccAddress(),
+				OrderPrice: OrderPrice{
+					BaseDenom:  "base_denom",
+					QuoteDenom: "quote_denom",
+					Rate:       sdkmath.LegacyOneDec(),
+				},
+				OrderType:        SpotOrderType_LIMITBUY,
+				OrderAmount:      sdk.Coin{Denom: "base", Amount: sdkmath.OneInt()},
+				OrderTargetDenom: "base_denom",
+				TimeInForce:      TimeInForce_GTT,
+				Expiry:           &Date{Timestamp: 1700000000},
+			},
+		}, {
+			name: "trailing stop without trail",
+			msg: MsgCreateSpotOrder{
+				OwnerAddress: sample.AccAddress(),
+				OrderPrice: OrderPrice{
+					BaseDenom:  "base_denom",
+					QuoteDenom: "quote_denom",
+				},
+				OrderType:        SpotOrderType_TRAILINGSTOP,
+				OrderAmount:      sdk.Coin{Denom: "base", Amount: sdkmath.OneInt()},
+				OrderTargetDenom: "base_denom",
+			},
+			err: ErrInvalidTrailingStop,
+		}, {
+			name: "trailing stop with percent and amount",
+			msg: MsgCreateSpotOrder{
+				OwnerAddress: sample.AccAddress(),
+				OrderPrice: OrderPrice{
+					BaseDenom:  "base_denom",
+					QuoteDenom: "quote_denom",
+				},
+				OrderType:        SpotOrderType_TRAILINGSTOP,
+				OrderAmount:      sdk.Coin{Denom: "base", Amount: sdkmath.OneInt()},
+				OrderTargetDenom: "base_denom",
+				TrailingStop: &TrailingStop{
+					TrailPercent: sdkmath.LegacyMustNewDecFromStr("0.05"),
+					TrailAmount:  sdkmath.LegacyOneDec(),
+				},
+			},
+			err: ErrInvalidTrailingStop,
+		}, {
+			name: "trail on a limit order",
+			msg: MsgCreateSpotOrder{
+				OwnerAddress: sample.AccAddress(),
+				OrderPrice: OrderPrice{
+					BaseDenom:  "base_denom",
+					QuoteDenom: "quote_denom",
+					Rate:       sdkmath.LegacyOneDec(),
+				},
+				OrderType:        SpotOrderType_LIMITBUY,
+				OrderAmount:      sdk.Coin{Denom: "base", Amount: sdkmath.OneInt()},
+				OrderTargetDenom: "base_denom",
+				TrailingStop: &TrailingStop{
+					TrailAmount: sdkmath.LegacyOneDec(),
+				},
+			},
+			err: ErrInvalidTrailingStop,
+		}, {
+			name: "trailing stop",
+			msg: MsgCreateSpotOrder{
+				OwnerAddress: sample.AccAddress(),
+				OrderPrice: OrderPrice{
+					BaseDenom:  "base_denom",
+					QuoteDenom: "quote_denom",
+				},
+				OrderType:        SpotOrderType_TRAILINGSTOP,
+				OrderAmount:      sdk.Coin{Denom: "base", Amount: sdkmath.OneInt()},
+				OrderTargetDenom: "base_denom",
+				TrailingStop: &TrailingStop{
+					TrailPercent: sdkmath.LegacyMustNewDecFromStr("0.05"),
+				},
+			},
 		},
 	}
 	for _, tt := range tests {
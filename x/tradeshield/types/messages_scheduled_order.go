@@ -0,0 +1,82 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgCreateScheduledOrder{}
+
+func NewMsgCreateScheduledOrder(ownerAddress string, amount sdk.Coin, targetDenom string,
+	sliceCount, interval uint64, intervalUnit ScheduleIntervalUnit,
+	maxSlippage sdkmath.LegacyDec) *MsgCreateScheduledOrder {
+	return &MsgCreateScheduledOrder{
+		OwnerAddress: ownerAddress,
+		Amount:       amount,
+		TargetDenom:  targetDenom,
+		SliceCount:   sliceCount,
+		Interval:     interval,
+		IntervalUnit: intervalUnit,
+		MaxSlippage:  maxSlippage,
+	}
+}
+
+func (msg *MsgCreateScheduledOrder) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.OwnerAddress)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address (%s)", err)
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "invalid amount")
+	}
+
+	if err = sdk.ValidateDenom(msg.TargetDenom); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid target asset denom (%s)", err)
+	}
+	if msg.TargetDenom == msg.Amount.Denom {
+		return errorsmod.Wrap(ErrInvalidScheduledOrder, "target denom must differ from the amount denom")
+	}
+
+	if msg.SliceCount == 0 || msg.SliceCount > MaxScheduledOrderSlices {
+		return errorsmod.Wrapf(ErrInvalidScheduledOrder, "slice count must be between 1 and %d", MaxScheduledOrderSlices)
+	}
+	if msg.Amount.Amount.LT(sdkmath.NewIntFromUint64(msg.SliceCount)) {
+		return errorsmod.Wrap(ErrInvalidScheduledOrder, "amount is too small for the slice count")
+	}
+
+	if msg.Interval == 0 {
+		return errorsmod.Wrap(ErrInvalidScheduledOrder, "interval cannot be zero")
+	}
+	if _, ok := ScheduleIntervalUnit_name[int32(msg.IntervalUnit)]; !ok {
+		return errorsmod.Wrapf(ErrInvalidScheduledOrder, "unknown interval unit %d", msg.IntervalUnit)
+	}
+
+	if msg.MaxSlippage.IsNil() || msg.MaxSlippage.IsNegative() || msg.MaxSlippage.GTE(sdkmath.LegacyOneDec()) {
+		return errorsmod.Wrap(ErrInvalidScheduledOrder, "max slippage must be between 0 and 1")
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgCancelScheduledOrder{}
+
+func NewMsgCancelScheduledOrder(ownerAddress string, orderId uint64) *MsgCancelScheduledOrder {
+	return &MsgCancelScheduledOrder{
+		OwnerAddress: ownerAddress,
+		OrderId:      orderId,
+	}
+}
+
+func (msg *MsgCancelScheduledOrder) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.OwnerAddress)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address (%s)", err)
+	}
+
+	if msg.OrderId == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "order ID cannot be zero")
+	}
+	return nil
+}
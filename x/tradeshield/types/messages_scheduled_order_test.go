@@ -0,0 +1,125 @@
+package types
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/elys-network/elys/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgCreateScheduledOrder_ValidateBasic(t *testing.T) {
+	validMsg := func() MsgCreateScheduledOrder {
+		return MsgCreateScheduledOrder{
+			OwnerAddress: sample.AccAddress(),
+			Amount:       sdk.NewInt64Coin("uatom", 1000),
+			TargetDenom:  "uusdc",
+			SliceCount:   10,
+			Interval:     100,
+			IntervalUnit: ScheduleIntervalUnit_BLOCKS,
+			MaxSlippage:  sdkmath.LegacyMustNewDecFromStr("0.01"),
+		}
+	}
+	tests := []struct {
+		name   string
+		update func(msg *MsgCreateScheduledOrder)
+		err    error
+	}{
+		{
+			name:   "valid order",
+			update: func(msg *MsgCreateScheduledOrder) {},
+		}, {
+			name:   "invalid address",
+			update: func(msg *MsgCreateScheduledOrder) { msg.OwnerAddress = "invalid_address" },
+			err:    sdkerrors.ErrInvalidAddress,
+		}, {
+			name:   "zero amount",
+			update: func(msg *MsgCreateScheduledOrder) { msg.Amount = sdk.NewInt64Coin("uatom", 0) },
+			err:    sdkerrors.ErrInvalidRequest,
+		}, {
+			name:   "same denoms",
+			update: func(msg *MsgCreateScheduledOrder) { msg.TargetDenom = "uatom" },
+			err:    ErrInvalidScheduledOrder,
+		}, {
+			name:   "zero slices",
+			update: func(msg *MsgCreateScheduledOrder) { msg.SliceCount = 0 },
+			err:    ErrInvalidScheduledOrder,
+		}, {
+			name:   "too many slices",
+			update: func(msg *MsgCreateScheduledOrder) { msg.SliceCount = MaxScheduledOrderSlices + 1 },
+			err:    ErrInvalidScheduledOrder,
+		}, {
+			name:   "slices smaller than one unit",
+			update: func(msg *MsgCreateScheduledOrder) { msg.Amount = sdk.NewInt64Coin("uatom", 9) },
+			err:    ErrInvalidScheduledOrder,
+		}, {
+			name:   "zero interval",
+			update: func(msg *MsgCreateScheduledOrder) { msg.Interval = 0 },
+			err:    ErrInvalidScheduledOrder,
+		}, {
+			name:   "unknown interval unit",
+			update: func(msg *MsgCreateScheduledOrder) { msg.IntervalUnit = 5 },
+			err:    ErrInvalidScheduledOrder,
+		}, {
+			name:   "max slippage of one",
+			update: func(msg *MsgCreateScheduledOrder) { msg.MaxSlippage = sdkmath.LegacyOneDec() },
+			err:    ErrInvalidScheduledOrder,
+		}, {
+			name:   "negative max slippage",
+			update: func(msg *MsgCreateScheduledOrder) { msg.MaxSlippage = sdkmath.LegacyNewDec(-1) },
+			err:    ErrInvalidScheduledOrder,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg := validMsg()
+			tt.update(&msg)
+			err := msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgCancelScheduledOrder_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgCancelScheduledOrder
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgCancelScheduledOrder{
+				OwnerAddress: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "zero order id",
+			msg: MsgCancelScheduledOrder{
+				OwnerAddress: sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid order",
+			msg: MsgCancelScheduledOrder{
+				OwnerAddress: sample.AccAddress(),
+				OrderId:      1,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
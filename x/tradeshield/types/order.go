@@ -3,6 +3,8 @@ package types
 import (
 	"fmt"
 
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
@@ -24,3 +26,118 @@ func (p SpotOrder) GetOrderAddress() sdk.AccAddress {
 func (p PerpetualOrder) GetOrderAddress() sdk.AccAddress {
 	return GetPerpOrderAddress(p.OrderId)
 }
+
+// IsImmediate reports whether the order must be executed when it is created
+func (t TimeInForce) IsImmediate() bool {
+	return t == TimeInForce_IOC || t == TimeInForce_FOK
+}
+
+// ValidateTimeInForce checks that only GTT orders have an expiry and that it
+// sets a height or a timestamp
+func ValidateTimeInForce(timeInForce TimeInForce, expiry *Date) error {
+	if _, ok := TimeInForce_name[int32(timeInForce)]; !ok {
+		return errorsmod.Wrapf(ErrInvalidTimeInForce, "unknown time in force %d", timeInForce)
+	}
+	if timeInForce != TimeInForce_GTT {
+		if expiry != nil && (expiry.Height != 0 || expiry.Timestamp != 0) {
+			return errorsmod.Wrapf(ErrInvalidTimeInForce, "%s orders cannot have an expiry", timeInForce)
+		}
+		return nil
+	}
+	if expiry == nil || (expiry.Height == 0 && expiry.Timestamp == 0) {
+		return errorsmod.Wrap(ErrInvalidTimeInForce, "GTT orders need an expiry height or timestamp")
+	}
+	return nil
+}
+
+// IsReached reports whether the expiry is reached at the given block, an
+// expiry with both a height and a timestamp is reached by the first of them
+func (d *Date) IsReached(height, timestamp uint64) bool {
+	if d == nil {
+		return false
+	}
+	return (d.Height != 0 && height >= d.Height) || (d.Timestamp != 0 && timestamp >= d.Timestamp)
+}
+
+// IsTrailingStop reports whether the order type is a trailing stop
+func (t SpotOrderType) IsTrailingStop() bool {
+	return t == SpotOrderType_TRAILINGSTOP
+}
+
+// IsTrailingStop reports whether the order type is a trailing stop
+func (t PerpetualOrderType) IsTrailingStop() bool {
+	return t == PerpetualOrderType_TRAILINGSTOPPERP
+}
+
+// ValidateTrailingStop checks that only trailing stop orders have a trail and
+// that it is set by either a percentage below one or a positive amount
+func ValidateTrailingStop(isTrailingStop bool, trailingStop *TrailingStop) error {
+	if !isTrailingStop {
+		if trailingStop != nil {
+			return errorsmod.Wrap(ErrInvalidTrailingStop, "only trailing stop orders have a trail")
+		}
+		return nil
+	}
+	if trailingStop == nil {
+		return errorsmod.Wrap(ErrInvalidTrailingStop, "trailing stop orders need a trail")
+	}
+
+	hasPercent := !trailingStop.TrailPercent.IsNil() && !trailingStop.TrailPercent.IsZero()
+	hasAmount := !trailingStop.TrailAmount.IsNil() && !trailingStop.TrailAmount.IsZero()
+	if hasPercent == hasAmount {
+		return errorsmod.Wrap(ErrInvalidTrailingStop, "set either a trail percent or a trail amount")
+	}
+	if hasPercent && (trailingStop.TrailPercent.IsNegative() || trailingStop.TrailPercent.GTE(sdkmath.LegacyOneDec())) {
+		return errorsmod.Wrapf(ErrInvalidTrailingStop, "trail percent %s must be between 0 and 1", trailingStop.TrailPercent)
+	}
+	if hasAmount && trailingStop.TrailAmount.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidTrailingStop, "trail amount %s must be positive", trailingStop.TrailAmount)
+	}
+	return nil
+}
+
+// Trail returns the distance between the stop price and the high-water mark
+func (t TrailingStop) Trail() sdkmath.LegacyDec {
+	if !t.TrailPercent.IsNil() && t.TrailPercent.IsPositive() {
+		return t.HighWaterMark.Mul(t.TrailPercent)
+	}
+	return t.TrailAmount
+}
+
+// StopPrice returns the stop price of the trail, below the high-water mark for
+// stops executed when the price falls and above it otherwise
+func (t TrailingStop) StopPrice(below bool) sdkmath.LegacyDec {
+	trail := t.Trail()
+	if !below {
+		return t.HighWaterMark.Add(trail)
+	}
+	if trail.GTE(t.HighWaterMark) {
+		return sdkmath.LegacyZeroDec()
+	}
+	return t.HighWaterMark.Sub(trail)
+}
+
+// Ratchet moves the high-water mark to the market price when the price moved
+// away from the stop, it reports whether the mark moved
+func (t *TrailingStop) Ratchet(marketPrice sdkmath.LegacyDec, below bool) bool {
+	if !t.HighWaterMark.IsNil() {
+		if below && marketPrice.LTE(t.HighWaterMark) {
+			return false
+		}
+		if !below && marketPrice.GTE(t.HighWaterMark) {
+			return false
+		}
+	}
+	t.HighWaterMark = marketPrice
+	return true
+}
+
+// AddFill moves amount from the amount left to fill of the order to its filled
+// amount, orders created before partial fills have no filled amount yet
+func (p *SpotOrder) AddFill(amount sdk.Coin) {
+	if p.FilledAmount.Amount.IsNil() || p.FilledAmount.Denom == "" {
+		p.FilledAmount = sdk.NewCoin(amount.Denom, sdkmath.ZeroInt())
+	}
+	p.FilledAmount = p.FilledAmount.Add(amount)
+	p.OrderAmount = p.OrderAmount.Sub(amount)
+}
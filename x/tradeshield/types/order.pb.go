@@ -34,6 +34,8 @@ const (
 	SpotOrderType_LIMITSELL SpotOrderType = 1
 	SpotOrderType_LIMITBUY  SpotOrderType = 2
 	SpotOrderType_MARKETBUY SpotOrderType = 3
+	// Stop loss whose rate follows the highest market price at a fixed trail
+	SpotOrderType_TRAILINGSTOP SpotOrderType = 4
 )
 
 var SpotOrderType_name = map[int32]string{
@@ -41,13 +43,15 @@ var SpotOrderType_name = map[int32]string{
 	1: "LIMITSELL",
 	2: "LIMITBUY",
 	3: "MARKETBUY",
+	4: "TRAILINGSTOP",
 }
 
 var SpotOrderType_value = map[string]int32{
-	"STOPLOSS":  0,
-	"LIMITSELL": 1,
-	"LIMITBUY":  2,
-	"MARKETBUY": 3,
+	"STOPLOSS":     0,
+	"LIMITSELL":    1,
+	"LIMITBUY":     2,
+	"MARKETBUY":    3,
+	"TRAILINGSTOP": 4,
 }
 
 func (x SpotOrderType) String() string {
@@ -58,6 +62,43 @@ func (SpotOrderType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_b257e09947c1671a, []int{0}
 }
 
+// Time in force of a pending order
+type TimeInForce int32
+
+const (
+	// Good til cancelled, the order stays pending until executed or cancelled
+	TimeInForce_GTC TimeInForce = 0
+	// Good til time, the order is cancelled once its expiry is reached
+	TimeInForce_GTT TimeInForce = 1
+	// Immediate or cancel, the order is cancelled if it can't be executed when
+	// created
+	TimeInForce_IOC TimeInForce = 2
+	// Fill or kill, the order creation fails if it can't be executed right away
+	TimeInForce_FOK TimeInForce = 3
+)
+
+var TimeInForce_name = map[int32]string{
+	0: "GTC",
+	1: "GTT",
+	2: "IOC",
+	3: "FOK",
+}
+
+var TimeInForce_value = map[string]int32{
+	"GTC": 0,
+	"GTT": 1,
+	"IOC": 2,
+	"FOK": 3,
+}
+
+func (x TimeInForce) String() string {
+	return proto.EnumName(TimeInForce_name, int32(x))
+}
+
+func (TimeInForce) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b257e09947c1671a, []int{1}
+}
+
 type PerpetualPosition int32
 
 const (
@@ -83,7 +124,7 @@ func (x PerpetualPosition) String() string {
 }
 
 func (PerpetualPosition) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_b257e09947c1671a, []int{1}
+	return fileDescriptor_b257e09947c1671a, []int{2}
 }
 
 type OrderPrice struct {
@@ -184,45 +225,99 @@ func (m *TriggerPrice) GetTradingAssetDenom() string {
 	return ""
 }
 
+// Trail of a trailing stop order. Its stop price follows the best market
+// price seen since the order was created, at a fixed distance from it.
+type TrailingStop struct {
+	// Distance as a fraction of the best price, e.g. 0.05 for 5%
+	TrailPercent cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=trail_percent,json=trailPercent,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"trail_percent"`
+	// Absolute distance in the quote asset, used when trail_percent is zero
+	TrailAmount cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=trail_amount,json=trailAmount,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"trail_amount"`
+	// Best market price seen since the order was created, the highest for
+	// stops below the market price and the lowest for stops above it
+	HighWaterMark cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=high_water_mark,json=highWaterMark,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"high_water_mark"`
+}
+
+func (m *TrailingStop) Reset()         { *m = TrailingStop{} }
+func (m *TrailingStop) String() string { return proto.CompactTextString(m) }
+func (*TrailingStop) ProtoMessage()    {}
+func (*TrailingStop) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b257e09947c1671a, []int{2}
+}
+func (m *TrailingStop) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TrailingStop) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TrailingStop.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TrailingStop) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrailingStop.Merge(m, src)
+}
+func (m *TrailingStop) XXX_Size() int {
+	return m.Size()
+}
+func (m *TrailingStop) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrailingStop.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrailingStop proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("elys.tradeshield.SpotOrderType", SpotOrderType_name, SpotOrderType_value)
+	proto.RegisterEnum("elys.tradeshield.TimeInForce", TimeInForce_name, TimeInForce_value)
 	proto.RegisterEnum("elys.tradeshield.PerpetualPosition", PerpetualPosition_name, PerpetualPosition_value)
 	proto.RegisterType((*OrderPrice)(nil), "elys.tradeshield.OrderPrice")
 	proto.RegisterType((*TriggerPrice)(nil), "elys.tradeshield.TriggerPrice")
+	proto.RegisterType((*TrailingStop)(nil), "elys.tradeshield.TrailingStop")
 }
 
 func init() { proto.RegisterFile("elys/tradeshield/order.proto", fileDescriptor_b257e09947c1671a) }
 
 var fileDescriptor_b257e09947c1671a = []byte{
-	// 433 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0x86, 0xbd, 0x69, 0x41, 0xcd, 0xb4, 0x15, 0xae, 0xe1, 0x50, 0x0a, 0x38, 0xa8, 0x27, 0x54,
-	0xa9, 0x5e, 0x22, 0x4e, 0x1c, 0x1b, 0x62, 0xc0, 0xe0, 0xd6, 0x56, 0xec, 0x1e, 0xe0, 0x12, 0x39,
-	0xf6, 0xc8, 0x59, 0x35, 0xf1, 0x9a, 0xdd, 0x0d, 0x90, 0x07, 0xe0, 0x8e, 0xc4, 0xab, 0xf0, 0x10,
-	0x3d, 0x56, 0x9c, 0x10, 0x87, 0x0a, 0x25, 0x2f, 0x82, 0x76, 0x9d, 0x4a, 0xe4, 0xde, 0x9b, 0xff,
-	0xff, 0x9b, 0xf1, 0xfc, 0x9a, 0x1d, 0x78, 0x8c, 0x93, 0xb9, 0xa4, 0x4a, 0x64, 0x05, 0xca, 0x31,
-	0xc3, 0x49, 0x41, 0xb9, 0x28, 0x50, 0x78, 0xb5, 0xe0, 0x8a, 0x3b, 0xb6, 0xa6, 0xde, 0x7f, 0xf4,
-	0xe0, 0x41, 0xc9, 0x4b, 0x6e, 0x20, 0xd5, 0x5f, 0x4d, 0xdd, 0x81, 0x9b, 0x73, 0x39, 0xe5, 0x92,
-	0x8e, 0x32, 0x89, 0xf4, 0x73, 0x77, 0x84, 0x2a, 0xeb, 0xd2, 0x9c, 0xb3, 0x6a, 0xc5, 0x1f, 0x36,
-	0x7c, 0xd8, 0x34, 0x36, 0xa2, 0x41, 0x87, 0x3f, 0x08, 0x40, 0xa4, 0x47, 0xc6, 0x82, 0xe5, 0xe8,
-	0x3c, 0x01, 0xd0, 0x3f, 0x19, 0x16, 0x58, 0xf1, 0xe9, 0x3e, 0x79, 0x4a, 0x9e, 0xb5, 0x07, 0x6d,
-	0xed, 0xf4, 0xb5, 0xe1, 0x74, 0x60, 0xfb, 0xd3, 0x8c, 0xab, 0x1b, 0xde, 0x32, 0x1c, 0x8c, 0xd5,
-	0x14, 0xf8, 0xb0, 0x29, 0x32, 0x85, 0xfb, 0x1b, 0x9a, 0xf4, 0xba, 0x97, 0xd7, 0x1d, 0xeb, 0xcf,
-	0x75, 0xe7, 0x51, 0x33, 0x52, 0x16, 0x17, 0x1e, 0xe3, 0x74, 0x9a, 0xa9, 0xb1, 0x17, 0x62, 0x99,
-	0xe5, 0xf3, 0x3e, 0xe6, 0xbf, 0x7e, 0x1e, 0xc3, 0x2a, 0x51, 0x1f, 0xf3, 0x81, 0x69, 0x3f, 0xfc,
-	0x46, 0x60, 0x27, 0x15, 0xac, 0x2c, 0x6f, 0x72, 0x79, 0x70, 0x5f, 0xaf, 0x81, 0x55, 0xe5, 0x30,
-	0x93, 0x12, 0xd5, 0x5a, 0xc0, 0xbd, 0x15, 0x3a, 0xd1, 0xe4, 0x36, 0x73, 0x1c, 0x05, 0xb0, 0x9b,
-	0xd4, 0x5c, 0x99, 0x05, 0xa5, 0xf3, 0x1a, 0x9d, 0x1d, 0xd8, 0x4a, 0xd2, 0x28, 0x0e, 0xa3, 0x24,
-	0xb1, 0x2d, 0x67, 0x17, 0xda, 0x61, 0x70, 0x1a, 0xa4, 0x89, 0x1f, 0x86, 0x36, 0xd1, 0xd0, 0xc8,
-	0xde, 0xf9, 0x07, 0xbb, 0xa5, 0xe1, 0xe9, 0xc9, 0xe0, 0xbd, 0x6f, 0xe4, 0xc6, 0xd1, 0x4b, 0xd8,
-	0x8b, 0x51, 0xd4, 0xa8, 0x66, 0xd9, 0x24, 0xe6, 0x92, 0x29, 0xc6, 0x2b, 0xe7, 0x1e, 0x6c, 0x9f,
-	0x9f, 0x25, 0xb1, 0xff, 0x2a, 0x78, 0x1d, 0xf8, 0x7d, 0xdb, 0x72, 0xb6, 0x60, 0x33, 0x8c, 0xce,
-	0xde, 0xd8, 0xc4, 0x69, 0xc3, 0x9d, 0xe4, 0x6d, 0x34, 0x48, 0xed, 0x56, 0xef, 0xdd, 0xe5, 0xc2,
-	0x25, 0x57, 0x0b, 0x97, 0xfc, 0x5d, 0xb8, 0xe4, 0xfb, 0xd2, 0xb5, 0xae, 0x96, 0xae, 0xf5, 0x7b,
-	0xe9, 0x5a, 0x1f, 0x9f, 0x97, 0x4c, 0x8d, 0x67, 0x23, 0x2f, 0xe7, 0x53, 0xaa, 0x6f, 0xe5, 0xb8,
-	0x42, 0xf5, 0x85, 0x8b, 0x0b, 0x23, 0xe8, 0xd7, 0xb5, 0xc3, 0x52, 0xf3, 0x1a, 0xe5, 0xe8, 0xae,
-	0x79, 0xf6, 0x17, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0xba, 0xdb, 0x90, 0x26, 0x79, 0x02, 0x00,
-	0x00,
+	// 560 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0xc1, 0x6e, 0xd3, 0x4c,
+	0x14, 0x85, 0xed, 0xa4, 0xff, 0x4f, 0x73, 0x93, 0xa8, 0xd3, 0x81, 0x45, 0x28, 0xe0, 0xa2, 0xae,
+	0x50, 0xa5, 0xda, 0x44, 0xac, 0x58, 0xa6, 0x4d, 0x5a, 0x4c, 0xdd, 0xda, 0x8a, 0x5d, 0x50, 0x61,
+	0x61, 0x4d, 0x9c, 0x91, 0x33, 0x4a, 0xec, 0x31, 0xe3, 0x09, 0x25, 0x0f, 0xc0, 0x1e, 0x89, 0x05,
+	0x2f, 0xc2, 0x43, 0x74, 0x59, 0xb1, 0x42, 0x2c, 0x2a, 0xd4, 0xbe, 0x08, 0x9a, 0x71, 0x2b, 0xd1,
+	0x6d, 0xc4, 0xee, 0x9e, 0xfb, 0xcd, 0x9c, 0x7b, 0x34, 0x9a, 0x0b, 0x8f, 0xe9, 0x6c, 0x51, 0x3a,
+	0x52, 0x90, 0x31, 0x2d, 0x27, 0x8c, 0xce, 0xc6, 0x0e, 0x17, 0x63, 0x2a, 0xec, 0x42, 0x70, 0xc9,
+	0x31, 0x52, 0xd4, 0xfe, 0x8b, 0x6e, 0x3c, 0x48, 0x79, 0xca, 0x35, 0x74, 0x54, 0x55, 0x9d, 0xdb,
+	0xb0, 0x12, 0x5e, 0x66, 0xbc, 0x74, 0x46, 0xa4, 0xa4, 0xce, 0xc7, 0xee, 0x88, 0x4a, 0xd2, 0x75,
+	0x12, 0xce, 0xf2, 0x1b, 0xfe, 0xb0, 0xe2, 0x71, 0x75, 0xb1, 0x12, 0x15, 0xda, 0xfa, 0x6a, 0x02,
+	0xf8, 0x6a, 0x64, 0x20, 0x58, 0x42, 0xf1, 0x13, 0x00, 0x65, 0x12, 0x8f, 0x69, 0xce, 0xb3, 0x8e,
+	0xf9, 0xd4, 0x7c, 0xd6, 0x18, 0x36, 0x54, 0xa7, 0xaf, 0x1a, 0x78, 0x13, 0x9a, 0x1f, 0xe6, 0x5c,
+	0xde, 0xf2, 0x9a, 0xe6, 0xa0, 0x5b, 0xd5, 0x81, 0x01, 0xac, 0x08, 0x22, 0x69, 0xa7, 0xae, 0xc8,
+	0x6e, 0xf7, 0xfc, 0x72, 0xd3, 0xf8, 0x75, 0xb9, 0xf9, 0xa8, 0x1a, 0x59, 0x8e, 0xa7, 0x36, 0xe3,
+	0x4e, 0x46, 0xe4, 0xc4, 0xf6, 0x68, 0x4a, 0x92, 0x45, 0x9f, 0x26, 0x3f, 0xbe, 0xef, 0xc0, 0x4d,
+	0xa2, 0x3e, 0x4d, 0x86, 0xfa, 0xfa, 0xd6, 0x67, 0x13, 0x5a, 0x91, 0x60, 0x69, 0x7a, 0x9b, 0xcb,
+	0x86, 0xfb, 0xea, 0x19, 0x58, 0x9e, 0xc6, 0xa4, 0x2c, 0xa9, 0xbc, 0x13, 0x70, 0xfd, 0x06, 0xf5,
+	0x14, 0xf9, 0xa7, 0x39, 0xbe, 0xd5, 0x54, 0x0e, 0xc2, 0x66, 0x2c, 0x4f, 0x43, 0xc9, 0x0b, 0xfc,
+	0x06, 0xda, 0x52, 0xe9, 0xb8, 0xa0, 0x22, 0xa1, 0xb9, 0xec, 0x98, 0xcb, 0x0e, 0x68, 0x69, 0x9f,
+	0xa0, 0xb2, 0xc1, 0x11, 0x54, 0x3a, 0x26, 0x19, 0x9f, 0xe7, 0xb2, 0x53, 0x5b, 0xd6, 0xb6, 0xa9,
+	0x6d, 0x7a, 0xda, 0x05, 0x9f, 0xc2, 0xda, 0x84, 0xa5, 0x93, 0xf8, 0x8c, 0x48, 0x2a, 0xe2, 0x8c,
+	0x88, 0xe9, 0xf2, 0x0f, 0xd2, 0x56, 0x4e, 0x6f, 0x95, 0xd1, 0x11, 0x11, 0xd3, 0xed, 0xf7, 0xd0,
+	0x0e, 0x0b, 0x2e, 0xf5, 0xd7, 0x89, 0x16, 0x05, 0xc5, 0x2d, 0x58, 0x0d, 0x23, 0x3f, 0xf0, 0xfc,
+	0x30, 0x44, 0x06, 0x6e, 0x43, 0xc3, 0x73, 0x8f, 0xdc, 0x28, 0x1c, 0x78, 0x1e, 0x32, 0x15, 0xd4,
+	0x72, 0xf7, 0xe4, 0x14, 0xd5, 0x14, 0x3c, 0xea, 0x0d, 0x0f, 0x07, 0x5a, 0xd6, 0x31, 0x82, 0x56,
+	0x34, 0xec, 0xb9, 0x9e, 0x7b, 0x7c, 0xa0, 0x1c, 0xd0, 0xca, 0x76, 0x17, 0x9a, 0x11, 0xcb, 0xa8,
+	0x9b, 0xef, 0x73, 0x91, 0x50, 0x7c, 0x0f, 0xea, 0x07, 0xd1, 0x1e, 0x32, 0xaa, 0x22, 0x42, 0xa6,
+	0x2a, 0x5c, 0x7f, 0x0f, 0xd5, 0x54, 0xb1, 0xef, 0x1f, 0xa2, 0xfa, 0xf6, 0x4b, 0x58, 0x0f, 0xa8,
+	0x28, 0xa8, 0x9c, 0x93, 0x59, 0xc0, 0x4b, 0x26, 0x19, 0xcf, 0xf1, 0x1a, 0x34, 0x4f, 0x8e, 0xc3,
+	0x60, 0xb0, 0xe7, 0xee, 0xbb, 0x83, 0x3e, 0x32, 0xf0, 0x2a, 0xac, 0x78, 0xfe, 0xf1, 0x01, 0x32,
+	0x71, 0x03, 0xfe, 0x0b, 0x5f, 0xf9, 0xc3, 0x08, 0xd5, 0x76, 0x5f, 0x9f, 0x5f, 0x59, 0xe6, 0xc5,
+	0x95, 0x65, 0xfe, 0xbe, 0xb2, 0xcc, 0x2f, 0xd7, 0x96, 0x71, 0x71, 0x6d, 0x19, 0x3f, 0xaf, 0x2d,
+	0xe3, 0xdd, 0xf3, 0x94, 0xc9, 0xc9, 0x7c, 0x64, 0x27, 0x3c, 0x73, 0xd4, 0x2a, 0xee, 0xe4, 0x54,
+	0x9e, 0x71, 0x31, 0xd5, 0xc2, 0xf9, 0x74, 0x67, 0x6f, 0xe5, 0xa2, 0xa0, 0xe5, 0xe8, 0x7f, 0xbd,
+	0x55, 0x2f, 0xfe, 0x04, 0x00, 0x00, 0xff, 0xff, 0x21, 0x59, 0x2d, 0xbd, 0xd8, 0x03, 0x00, 0x00,
 }
 
 func (m *OrderPrice) Marshal() (dAtA []byte, err error) {
@@ -312,6 +407,59 @@ func (m *TriggerPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TrailingStop) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TrailingStop) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TrailingStop) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.HighWaterMark.Size()
+		i -= size
+		if _, err := m.HighWaterMark.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.TrailAmount.Size()
+		i -= size
+		if _, err := m.TrailAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.TrailPercent.Size()
+		i -= size
+		if _, err := m.TrailPercent.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintOrder(dAtA []byte, offset int, v uint64) int {
 	offset -= sovOrder(v)
 	base := offset
@@ -357,6 +505,21 @@ func (m *TriggerPrice) Size() (n int) {
 	return n
 }
 
+func (m *TrailingStop) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TrailPercent.Size()
+	n += 1 + l + sovOrder(uint64(l))
+	l = m.TrailAmount.Size()
+	n += 1 + l + sovOrder(uint64(l))
+	l = m.HighWaterMark.Size()
+	n += 1 + l + sovOrder(uint64(l))
+	return n
+}
+
 func sovOrder(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -627,6 +790,158 @@ func (m *TriggerPrice) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TrailingStop) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TrailingStop: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TrailingStop: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrailPercent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TrailPercent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrailAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TrailAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HighWaterMark", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.HighWaterMark.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipOrder(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,69 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxOrderGroupSize bounds the orders of a group, they are all cancelled in
+// the transaction that executes one of them
+const MaxOrderGroupSize = 10
+
+// IsBracket reports whether the group holds the close orders of a limit open
+// order that didn't open its position yet
+func (g OrderGroup) IsBracket() bool {
+	return g.ParentOrderId != 0
+}
+
+// OrderCount returns the number of pending orders in the group
+func (g OrderGroup) OrderCount() int {
+	return len(g.SpotOrderIds) + len(g.PerpetualOrderIds)
+}
+
+// BracketDeposits returns the deposits of the bracket orders escrowed on the
+// parent order
+func (g OrderGroup) BracketDeposits() sdk.Coins {
+	coins := sdk.NewCoins()
+	for _, bracket := range g.BracketOrders {
+		if !bracket.Deposit.Amount.IsNil() && bracket.Deposit.Amount.IsPositive() {
+			coins = coins.Add(bracket.Deposit)
+		}
+	}
+	return coins
+}
+
+// ValidateBracketOrders checks the take profit and stop loss orders attached
+// to a limit open order: at most one of each, a take profit above the open
+// price and a stop loss below it for long positions, the other way around for
+// short ones
+func ValidateBracketOrders(position PerpetualPosition, openPrice sdkmath.LegacyDec, brackets []BracketOrder) error {
+	seen := make(map[PerpetualOrderType]bool)
+	for _, bracket := range brackets {
+		if bracket.OrderType != PerpetualOrderType_LIMITCLOSE && bracket.OrderType != PerpetualOrderType_STOPLOSSPERP {
+			return errorsmod.Wrapf(ErrInvalidBracketOrder, "%s orders cannot be attached to an open order", bracket.OrderType)
+		}
+		if seen[bracket.OrderType] {
+			return errorsmod.Wrapf(ErrInvalidBracketOrder, "duplicated %s order", bracket.OrderType)
+		}
+		seen[bracket.OrderType] = true
+
+		if bracket.TriggerPrice.IsNil() || !bracket.TriggerPrice.IsPositive() {
+			return errorsmod.Wrap(ErrInvalidBracketOrder, "trigger price must be positive")
+		}
+		if !bracket.CloseAmount.IsNil() && bracket.CloseAmount.IsNegative() {
+			return errorsmod.Wrap(ErrInvalidBracketOrder, "close amount cannot be negative")
+		}
+
+		if openPrice.IsNil() || !openPrice.IsPositive() {
+			continue
+		}
+		// take profits of long positions and stop losses of short ones are
+		// above the open price
+		wantAbove := (bracket.OrderType == PerpetualOrderType_LIMITCLOSE) == (position == PerpetualPosition_LONG)
+		if (wantAbove && !bracket.TriggerPrice.GT(openPrice)) || (!wantAbove && !bracket.TriggerPrice.LT(openPrice)) {
+			return errorsmod.Wrapf(ErrInvalidBracketOrder, "%s price %s is on the wrong side of the open price %s for %s", bracket.OrderType, bracket.TriggerPrice, openPrice, position)
+		}
+	}
+	return nil
+}
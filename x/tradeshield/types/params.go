@@ -15,10 +15,10 @@ func NewParams() Params {
 		PerpetualEnabled:     true,
 		RewardEnabled:        true,
 		LeverageEnabled:      true,
-		LimitProcessOrder:    1000000,
+		LimitProcessOrder:    100,
 		RewardPercentage:     sdkmath.LegacyZeroDec(),
 		MarginError:          sdkmath.LegacyZeroDec(),
-		MinimumDeposit:       sdkmath.ZeroInt(),
+		MinimumDeposit:       sdkmath.NewInt(1_000_000),
 	}
 }
 
@@ -42,6 +42,9 @@ func (p Params) Validate() error {
 	if err := CheckLegacyDecNilAndNegative(p.RewardPercentage, "RewardPercentage"); err != nil {
 		return err
 	}
+	if p.RewardPercentage.GT(sdkmath.LegacyOneDec()) {
+		return fmt.Errorf("RewardPercentage is greater than 1")
+	}
 	if err := CheckLegacyDecNilAndNegative(p.MarginError, "MarginError"); err != nil {
 		return err
 	}
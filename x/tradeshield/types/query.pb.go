@@ -5,7 +5,11 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -30,6 +34,34 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// Side of the order book
+type OrderBookSide int32
+
+const (
+	// LIMITBUY orders
+	OrderBookSide_BUY OrderBookSide = 0
+	// LIMITSELL orders
+	OrderBookSide_SELL OrderBookSide = 1
+)
+
+var OrderBookSide_name = map[int32]string{
+	0: "BUY",
+	1: "SELL",
+}
+
+var OrderBookSide_value = map[string]int32{
+	"BUY":  0,
+	"SELL": 1,
+}
+
+func (x OrderBookSide) String() string {
+	return proto.EnumName(OrderBookSide_name, int32(x))
+}
+
+func (OrderBookSide) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_0b92ce2c2fb85355, []int{0}
+}
+
 // QueryParamsRequest is request type for the Query/Params RPC method.
 type QueryParamsRequest struct {
 }
@@ -685,1115 +717,5998 @@ func (m *QueryPendingSpotOrderForAddressResponse) GetPendingSpotOrders() []SpotO
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "elys.tradeshield.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "elys.tradeshield.QueryParamsResponse")
-	proto.RegisterType((*QueryGetPendingSpotOrderRequest)(nil), "elys.tradeshield.QueryGetPendingSpotOrderRequest")
-	proto.RegisterType((*QueryGetPendingSpotOrderResponse)(nil), "elys.tradeshield.QueryGetPendingSpotOrderResponse")
-	proto.RegisterType((*QueryAllPendingSpotOrderRequest)(nil), "elys.tradeshield.QueryAllPendingSpotOrderRequest")
-	proto.RegisterType((*QueryAllPendingSpotOrderResponse)(nil), "elys.tradeshield.QueryAllPendingSpotOrderResponse")
-	proto.RegisterType((*QueryGetPendingPerpetualOrderRequest)(nil), "elys.tradeshield.QueryGetPendingPerpetualOrderRequest")
-	proto.RegisterType((*QueryGetPendingPerpetualOrderResponse)(nil), "elys.tradeshield.QueryGetPendingPerpetualOrderResponse")
-	proto.RegisterType((*QueryAllPendingPerpetualOrderRequest)(nil), "elys.tradeshield.QueryAllPendingPerpetualOrderRequest")
-	proto.RegisterType((*QueryAllPendingPerpetualOrderResponse)(nil), "elys.tradeshield.QueryAllPendingPerpetualOrderResponse")
-	proto.RegisterType((*QueryPendingPerpetualOrderForAddressRequest)(nil), "elys.tradeshield.QueryPendingPerpetualOrderForAddressRequest")
-	proto.RegisterType((*QueryPendingPerpetualOrderForAddressResponse)(nil), "elys.tradeshield.QueryPendingPerpetualOrderForAddressResponse")
-	proto.RegisterType((*QueryPendingSpotOrderForAddressRequest)(nil), "elys.tradeshield.QueryPendingSpotOrderForAddressRequest")
-	proto.RegisterType((*QueryPendingSpotOrderForAddressResponse)(nil), "elys.tradeshield.QueryPendingSpotOrderForAddressResponse")
+type QueryGetOrderGroupRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func init() { proto.RegisterFile("elys/tradeshield/query.proto", fileDescriptor_0b92ce2c2fb85355) }
-
-var fileDescriptor_0b92ce2c2fb85355 = []byte{
-	// 826 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xdd, 0x4a, 0xdc, 0x4c,
-	0x18, 0xde, 0x59, 0xfd, 0xf6, 0xe3, 0x1b, 0x41, 0xfc, 0x46, 0x6d, 0x97, 0xd4, 0xae, 0x12, 0xfc,
-	0xe9, 0x9f, 0x89, 0xab, 0xb0, 0x55, 0x8a, 0x82, 0x96, 0x2a, 0x96, 0xb6, 0xae, 0x6b, 0x8f, 0x7a,
-	0xb2, 0x64, 0xcd, 0x18, 0x43, 0x63, 0x26, 0x66, 0x66, 0x5b, 0x17, 0x2b, 0x94, 0xd2, 0x0b, 0x10,
-	0x7a, 0xd4, 0x2b, 0x29, 0x85, 0x5e, 0x80, 0x50, 0x0a, 0x42, 0x4f, 0xda, 0x93, 0x52, 0xd6, 0x5e,
-	0x43, 0x8f, 0x4b, 0x26, 0xb3, 0xea, 0xe6, 0xc7, 0xec, 0x2e, 0xf6, 0x2c, 0xc9, 0xfb, 0xf7, 0x3c,
-	0xcf, 0x3b, 0xf3, 0xbe, 0x04, 0x0e, 0x61, 0xab, 0x46, 0x55, 0xe6, 0x6a, 0x3a, 0xa6, 0xdb, 0x26,
-	0xb6, 0x74, 0x75, 0xb7, 0x8a, 0xdd, 0x9a, 0xe2, 0xb8, 0x84, 0x11, 0xd4, 0xe7, 0x59, 0x95, 0x73,
-	0x56, 0x69, 0xc0, 0x20, 0x06, 0xe1, 0x46, 0xd5, 0x7b, 0xf2, 0xfd, 0xa4, 0x21, 0x83, 0x10, 0xc3,
-	0xc2, 0xaa, 0xe6, 0x98, 0xaa, 0x66, 0xdb, 0x84, 0x69, 0xcc, 0x24, 0x36, 0x15, 0xd6, 0x5b, 0x9b,
-	0x84, 0xee, 0x10, 0xaa, 0x56, 0x34, 0x8a, 0xfd, 0xf4, 0xea, 0x8b, 0x7c, 0x05, 0x33, 0x2d, 0xaf,
-	0x3a, 0x9a, 0x61, 0xda, 0xdc, 0x59, 0xf8, 0x5e, 0x0f, 0xe1, 0x71, 0x34, 0x57, 0xdb, 0x69, 0xa4,
-	0x0a, 0xc3, 0x65, 0x35, 0x07, 0x0b, 0xab, 0x3c, 0x00, 0xd1, 0xba, 0x97, 0xbe, 0xc8, 0x43, 0x4a,
-	0x78, 0xb7, 0x8a, 0x29, 0x93, 0x1f, 0xc3, 0xfe, 0xa6, 0xaf, 0xd4, 0x21, 0x36, 0xc5, 0xa8, 0x00,
-	0x33, 0x7e, 0xea, 0x2c, 0x18, 0x01, 0x37, 0x7a, 0xa6, 0xb3, 0x4a, 0x90, 0xac, 0xe2, 0x47, 0x2c,
-	0x75, 0x1f, 0xfd, 0x18, 0x4e, 0x95, 0x84, 0xb7, 0x9c, 0x87, 0xc3, 0x3c, 0xdd, 0x0a, 0x66, 0x45,
-	0x6c, 0xeb, 0xa6, 0x6d, 0x6c, 0x38, 0x84, 0xad, 0xb9, 0x3a, 0x76, 0x45, 0x45, 0xd4, 0x0b, 0xd3,
-	0xa6, 0xce, 0xd3, 0x76, 0x97, 0xd2, 0xa6, 0x2e, 0x53, 0x38, 0x12, 0x1f, 0x22, 0xe0, 0xac, 0x41,
-	0xe4, 0xf8, 0xb6, 0x32, 0x75, 0x08, 0x2b, 0x13, 0xcf, 0x2a, 0xa0, 0x5d, 0x0b, 0x43, 0x3b, 0x4d,
-	0x20, 0xd0, 0xf5, 0x39, 0x81, 0xc4, 0xb2, 0x29, 0x70, 0x2e, 0x5a, 0x56, 0x1c, 0xce, 0x65, 0x08,
-	0xcf, 0x1a, 0x20, 0x6a, 0x8d, 0x2b, 0x7e, 0xb7, 0x14, 0xaf, 0x5b, 0x8a, 0x7f, 0x18, 0x44, 0xb7,
-	0x94, 0xa2, 0x66, 0x60, 0x11, 0x5b, 0x3a, 0x17, 0x29, 0x7f, 0x02, 0x82, 0x60, 0x64, 0xad, 0x04,
-	0x82, 0x5d, 0x1d, 0x12, 0x44, 0x2b, 0x4d, 0xe8, 0xd3, 0x1c, 0xfd, 0x44, 0x22, 0x7a, 0x1f, 0x4d,
-	0x13, 0xfc, 0x02, 0x1c, 0x0d, 0xb4, 0xa7, 0x88, 0x5d, 0x07, 0xb3, 0xaa, 0x66, 0x5d, 0xd8, 0xd6,
-	0x43, 0x00, 0xc7, 0x12, 0x02, 0x05, 0x77, 0x03, 0x5e, 0x6d, 0x70, 0x77, 0x1a, 0x1e, 0x4d, 0x1d,
-	0xbe, 0x19, 0x71, 0xf8, 0x9a, 0x52, 0x3d, 0xd8, 0x63, 0xae, 0xb6, 0x6a, 0x6f, 0x11, 0x21, 0xc7,
-	0xa0, 0x13, 0x55, 0x50, 0xb6, 0x05, 0x95, 0xb3, 0x46, 0x44, 0x53, 0xb9, 0xac, 0xce, 0x7f, 0x6f,
-	0x48, 0x10, 0x5f, 0xb0, 0x15, 0x09, 0xba, 0x2e, 0x4f, 0x82, 0xcb, 0x3b, 0x16, 0x35, 0x78, 0xdb,
-	0x9f, 0x1b, 0x51, 0x65, 0x96, 0x89, 0xbb, 0xa8, 0xeb, 0x2e, 0xa6, 0x8d, 0x31, 0x83, 0xb2, 0xf0,
-	0x5f, 0xcd, 0xff, 0xc2, 0xf5, 0xfc, 0xaf, 0xd4, 0x78, 0x45, 0x53, 0x30, 0x43, 0x99, 0xc6, 0xaa,
-	0x94, 0xa3, 0xe9, 0x8d, 0x9a, 0x34, 0x1b, 0xdc, 0x5e, 0x12, 0x7e, 0xf2, 0x7b, 0x00, 0xef, 0xb4,
-	0x56, 0x5b, 0xa8, 0x6b, 0xc2, 0x6c, 0x8c, 0xba, 0xb4, 0x53, 0x79, 0xaf, 0x44, 0xca, 0x4b, 0x65,
-	0x06, 0xc7, 0xcf, 0x43, 0x3b, 0xbd, 0x8f, 0x7f, 0x57, 0x91, 0x57, 0x70, 0x22, 0xb1, 0xaa, 0xd0,
-	0x62, 0x1d, 0xf6, 0x87, 0x07, 0x0d, 0x6d, 0x7d, 0xd2, 0xfc, 0x1f, 0x9c, 0x34, 0x74, 0xfa, 0x75,
-	0x0f, 0xfc, 0x87, 0x97, 0x47, 0x6f, 0x01, 0xcc, 0xf8, 0x6b, 0x01, 0x8d, 0x86, 0x53, 0x85, 0xb7,
-	0x8f, 0x34, 0x96, 0xe0, 0xe5, 0x83, 0x96, 0x27, 0xdf, 0x7c, 0xfd, 0xf5, 0x2e, 0x3d, 0x81, 0xc6,
-	0x54, 0xcf, 0x7d, 0xd2, 0xc6, 0xec, 0x25, 0x71, 0x9f, 0xab, 0x31, 0xdb, 0x10, 0x7d, 0x04, 0xb0,
-	0x2f, 0x28, 0x05, 0xca, 0xc7, 0x94, 0x8a, 0xdf, 0x54, 0xd2, 0x74, 0x3b, 0x21, 0x02, 0xea, 0x02,
-	0x87, 0x3a, 0x8b, 0x0a, 0x49, 0x50, 0x43, 0x4d, 0x50, 0xf7, 0x4d, 0xfd, 0x00, 0x7d, 0x00, 0xb0,
-	0x3f, 0x98, 0x7c, 0xd1, 0xb2, 0x62, 0xe1, 0xc7, 0x2f, 0xb0, 0x58, 0xf8, 0x17, 0xec, 0x21, 0x79,
-	0x8e, 0xc3, 0x9f, 0x41, 0xf9, 0xb6, 0xe1, 0xa3, 0x2f, 0x00, 0x0e, 0x46, 0xde, 0x48, 0x54, 0x48,
-	0xd4, 0x31, 0x72, 0x0e, 0x4b, 0x77, 0xdb, 0x8e, 0x13, 0x2c, 0xee, 0x73, 0x16, 0xf3, 0xe8, 0x5e,
-	0x8b, 0x2c, 0x02, 0x53, 0xc1, 0xef, 0xc4, 0x67, 0x00, 0xb3, 0x91, 0x65, 0xbc, 0x76, 0x14, 0x12,
-	0xb5, 0x6d, 0x8f, 0x52, 0xd2, 0x86, 0x68, 0xfb, 0x5c, 0x05, 0x28, 0xa1, 0xdf, 0x00, 0x0e, 0x27,
-	0xcc, 0x4b, 0x34, 0x1f, 0x77, 0x1b, 0x5b, 0x9a, 0xf1, 0xd2, 0x42, 0xa7, 0xe1, 0x82, 0xe2, 0x53,
-	0x4e, 0xf1, 0x09, 0x7a, 0xd4, 0x19, 0xc5, 0xf2, 0x16, 0x71, 0xcb, 0x62, 0x86, 0xaa, 0xfb, 0xe2,
-	0xe1, 0x00, 0xd5, 0x01, 0x94, 0xe2, 0xe7, 0x22, 0x9a, 0xbd, 0x18, 0x74, 0xfc, 0x00, 0x97, 0xe6,
-	0x3a, 0x88, 0x14, 0x4c, 0xd7, 0x38, 0xd3, 0x55, 0xb4, 0xd2, 0xf6, 0x2d, 0x8b, 0x26, 0xb9, 0xf4,
-	0xf0, 0xa8, 0x9e, 0x03, 0xc7, 0xf5, 0x1c, 0xf8, 0x59, 0xcf, 0x81, 0xc3, 0x93, 0x5c, 0xea, 0xf8,
-	0x24, 0x97, 0xfa, 0x76, 0x92, 0x4b, 0x3d, 0x9b, 0x32, 0x4c, 0xb6, 0x5d, 0xad, 0x28, 0x9b, 0x64,
-	0x27, 0xa2, 0xd8, 0x5e, 0xf8, 0x6f, 0xa1, 0x92, 0xe1, 0xbf, 0x0b, 0x33, 0x7f, 0x02, 0x00, 0x00,
-	0xff, 0xff, 0x20, 0x8c, 0xdb, 0x0c, 0xfd, 0x0c, 0x00, 0x00,
+func (m *QueryGetOrderGroupRequest) Reset()         { *m = QueryGetOrderGroupRequest{} }
+func (m *QueryGetOrderGroupRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetOrderGroupRequest) ProtoMessage()    {}
+func (*QueryGetOrderGroupRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b92ce2c2fb85355, []int{14}
+}
+func (m *QueryGetOrderGroupRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetOrderGroupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetOrderGroupRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetOrderGroupRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetOrderGroupRequest.Merge(m, src)
+}
+func (m *QueryGetOrderGroupRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetOrderGroupRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetOrderGroupRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryGetOrderGroupRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Queries a list of PendingSpotOrder items.
-	PendingSpotOrder(ctx context.Context, in *QueryGetPendingSpotOrderRequest, opts ...grpc.CallOption) (*QueryGetPendingSpotOrderResponse, error)
-	PendingSpotOrderAll(ctx context.Context, in *QueryAllPendingSpotOrderRequest, opts ...grpc.CallOption) (*QueryAllPendingSpotOrderResponse, error)
-	// Queries a list of PendingPerpetualOrder items.
-	PendingPerpetualOrder(ctx context.Context, in *QueryGetPendingPerpetualOrderRequest, opts ...grpc.CallOption) (*QueryGetPendingPerpetualOrderResponse, error)
-	PendingPerpetualOrderAll(ctx context.Context, in *QueryAllPendingPerpetualOrderRequest, opts ...grpc.CallOption) (*QueryAllPendingPerpetualOrderResponse, error)
-	// Queries a list of PendingPerpetualOrderForAddress items.
-	PendingPerpetualOrderForAddress(ctx context.Context, in *QueryPendingPerpetualOrderForAddressRequest, opts ...grpc.CallOption) (*QueryPendingPerpetualOrderForAddressResponse, error)
-	// Queries a list of PendingSpotOrderForAddress items.
-	PendingSpotOrderForAddress(ctx context.Context, in *QueryPendingSpotOrderForAddressRequest, opts ...grpc.CallOption) (*QueryPendingSpotOrderForAddressResponse, error)
+func (m *QueryGetOrderGroupRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+type QueryGetOrderGroupResponse struct {
+	OrderGroup      OrderGroup                `protobuf:"bytes,1,opt,name=order_group,json=orderGroup,proto3" json:"order_group"`
+	SpotOrders      []SpotOrder               `protobuf:"bytes,2,rep,name=spot_orders,json=spotOrders,proto3" json:"spot_orders"`
+	PerpetualOrders []PerpetualOrderExtraInfo `protobuf:"bytes,3,rep,name=perpetual_orders,json=perpetualOrders,proto3" json:"perpetual_orders"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryGetOrderGroupResponse) Reset()         { *m = QueryGetOrderGroupResponse{} }
+func (m *QueryGetOrderGroupResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetOrderGroupResponse) ProtoMessage()    {}
+func (*QueryGetOrderGroupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b92ce2c2fb85355, []int{15}
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/elys.tradeshield.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetOrderGroupResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetOrderGroupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetOrderGroupResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryGetOrderGroupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetOrderGroupResponse.Merge(m, src)
+}
+func (m *QueryGetOrderGroupResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetOrderGroupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetOrderGroupResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) PendingSpotOrder(ctx context.Context, in *QueryGetPendingSpotOrderRequest, opts ...grpc.CallOption) (*QueryGetPendingSpotOrderResponse, error) {
-	out := new(QueryGetPendingSpotOrderResponse)
-	err := c.cc.Invoke(ctx, "/elys.tradeshield.Query/PendingSpotOrder", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetOrderGroupResponse proto.InternalMessageInfo
+
+func (m *QueryGetOrderGroupResponse) GetOrderGroup() OrderGroup {
+	if m != nil {
+		return m.OrderGroup
 	}
-	return out, nil
+	return OrderGroup{}
 }
 
-func (c *queryClient) PendingSpotOrderAll(ctx context.Context, in *QueryAllPendingSpotOrderRequest, opts ...grpc.CallOption) (*QueryAllPendingSpotOrderResponse, error) {
-	out := new(QueryAllPendingSpotOrderResponse)
-	err := c.cc.Invoke(ctx, "/elys.tradeshield.Query/PendingSpotOrderAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetOrderGroupResponse) GetSpotOrders() []SpotOrder {
+	if m != nil {
+		return m.SpotOrders
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) PendingPerpetualOrder(ctx context.Context, in *QueryGetPendingPerpetualOrderRequest, opts ...grpc.CallOption) (*QueryGetPendingPerpetualOrderResponse, error) {
-	out := new(QueryGetPendingPerpetualOrderResponse)
-	err := c.cc.Invoke(ctx, "/elys.tradeshield.Query/PendingPerpetualOrder", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetOrderGroupResponse) GetPerpetualOrders() []PerpetualOrderExtraInfo {
+	if m != nil {
+		return m.PerpetualOrders
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) PendingPerpetualOrderAll(ctx context.Context, in *QueryAllPendingPerpetualOrderRequest, opts ...grpc.CallOption) (*QueryAllPendingPerpetualOrderResponse, error) {
-	out := new(QueryAllPendingPerpetualOrderResponse)
-	err := c.cc.Invoke(ctx, "/elys.tradeshield.Query/PendingPerpetualOrderAll", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryGetScheduledOrderRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (c *queryClient) PendingPerpetualOrderForAddress(ctx context.Context, in *QueryPendingPerpetualOrderForAddressRequest, opts ...grpc.CallOption) (*QueryPendingPerpetualOrderForAddressResponse, error) {
-	out := new(QueryPendingPerpetualOrderForAddressResponse)
-	err := c.cc.Invoke(ctx, "/elys.tradeshield.Query/PendingPerpetualOrderForAddress", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetScheduledOrderRequest) Reset()         { *m = QueryGetScheduledOrderRequest{} }
+func (m *QueryGetScheduledOrderRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetScheduledOrderRequest) ProtoMessage()    {}
+func (*QueryGetScheduledOrderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b92ce2c2fb85355, []int{16}
+}
+func (m *QueryGetScheduledOrderRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetScheduledOrderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetScheduledOrderRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryGetScheduledOrderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetScheduledOrderRequest.Merge(m, src)
+}
+func (m *QueryGetScheduledOrderRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetScheduledOrderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetScheduledOrderRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) PendingSpotOrderForAddress(ctx context.Context, in *QueryPendingSpotOrderForAddressRequest, opts ...grpc.CallOption) (*QueryPendingSpotOrderForAddressResponse, error) {
-	out := new(QueryPendingSpotOrderForAddressResponse)
-	err := c.cc.Invoke(ctx, "/elys.tradeshield.Query/PendingSpotOrderForAddress", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetScheduledOrderRequest proto.InternalMessageInfo
+
+func (m *QueryGetScheduledOrderRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return out, nil
+	return 0
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Queries a list of PendingSpotOrder items.
-	PendingSpotOrder(context.Context, *QueryGetPendingSpotOrderRequest) (*QueryGetPendingSpotOrderResponse, error)
-	PendingSpotOrderAll(context.Context, *QueryAllPendingSpotOrderRequest) (*QueryAllPendingSpotOrderResponse, error)
-	// Queries a list of PendingPerpetualOrder items.
-	PendingPerpetualOrder(context.Context, *QueryGetPendingPerpetualOrderRequest) (*QueryGetPendingPerpetualOrderResponse, error)
-	PendingPerpetualOrderAll(context.Context, *QueryAllPendingPerpetualOrderRequest) (*QueryAllPendingPerpetualOrderResponse, error)
-	// Queries a list of PendingPerpetualOrderForAddress items.
-	PendingPerpetualOrderForAddress(context.Context, *QueryPendingPerpetualOrderForAddressRequest) (*QueryPendingPerpetualOrderForAddressResponse, error)
-	// Queries a list of PendingSpotOrderForAddress items.
-	PendingSpotOrderForAddress(context.Context, *QueryPendingSpotOrderForAddressRequest) (*QueryPendingSpotOrderForAddressResponse, error)
+type QueryGetScheduledOrderResponse struct {
+	ScheduledOrder ScheduledOrder        `protobuf:"bytes,1,opt,name=scheduled_order,json=scheduledOrder,proto3" json:"scheduled_order"`
+	Slices         []ScheduledOrderSlice `protobuf:"bytes,2,rep,name=slices,proto3" json:"slices"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryGetScheduledOrderResponse) Reset()         { *m = QueryGetScheduledOrderResponse{} }
+func (m *QueryGetScheduledOrderResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetScheduledOrderResponse) ProtoMessage()    {}
+func (*QueryGetScheduledOrderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b92ce2c2fb85355, []int{17}
 }
-
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryGetScheduledOrderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) PendingSpotOrder(ctx context.Context, req *QueryGetPendingSpotOrderRequest) (*QueryGetPendingSpotOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PendingSpotOrder not implemented")
+func (m *QueryGetScheduledOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetScheduledOrderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) PendingSpotOrderAll(ctx context.Context, req *QueryAllPendingSpotOrderRequest) (*QueryAllPendingSpotOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PendingSpotOrderAll not implemented")
+func (m *QueryGetScheduledOrderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetScheduledOrderResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) PendingPerpetualOrder(ctx context.Context, req *QueryGetPendingPerpetualOrderRequest) (*QueryGetPendingPerpetualOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PendingPerpetualOrder not implemented")
+func (m *QueryGetScheduledOrderResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) PendingPerpetualOrderAll(ctx context.Context, req *QueryAllPendingPerpetualOrderRequest) (*QueryAllPendingPerpetualOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PendingPerpetualOrderAll not implemented")
+func (m *QueryGetScheduledOrderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetScheduledOrderResponse.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) PendingPerpetualOrderForAddress(ctx context.Context, req *QueryPendingPerpetualOrderForAddressRequest) (*QueryPendingPerpetualOrderForAddressResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PendingPerpetualOrderForAddress not implemented")
+
+var xxx_messageInfo_QueryGetScheduledOrderResponse proto.InternalMessageInfo
+
+func (m *QueryGetScheduledOrderResponse) GetScheduledOrder() ScheduledOrder {
+	if m != nil {
+		return m.ScheduledOrder
+	}
+	return ScheduledOrder{}
 }
-func (*UnimplementedQueryServer) PendingSpotOrderForAddress(ctx context.Context, req *QueryPendingSpotOrderForAddressRequest) (*QueryPendingSpotOrderForAddressResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PendingSpotOrderForAddress not implemented")
+
+func (m *QueryGetScheduledOrderResponse) GetSlices() []ScheduledOrderSlice {
+	if m != nil {
+		return m.Slices
+	}
+	return nil
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+// Pending orders aggregated at a price level
+type OrderBookLevel struct {
+	// Lower bound of the price bucket, the exact price when no bucket size is
+	// set
+	Price      cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=price,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"price"`
+	OrderCount uint64                      `protobuf:"varint,2,opt,name=order_count,json=orderCount,proto3" json:"order_count,omitempty"`
+	// Order amounts of spot orders, collateral of perpetual orders
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/elys.tradeshield.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *OrderBookLevel) Reset()         { *m = OrderBookLevel{} }
+func (m *OrderBookLevel) String() string { return proto.CompactTextString(m) }
+func (*OrderBookLevel) ProtoMessage()    {}
+func (*OrderBookLevel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b92ce2c2fb85355, []int{18}
+}
+func (m *OrderBookLevel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrderBookLevel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrderBookLevel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *OrderBookLevel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderBookLevel.Merge(m, src)
+}
+func (m *OrderBookLevel) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrderBookLevel) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderBookLevel.DiscardUnknown(m)
 }
 
-func _Query_PendingSpotOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetPendingSpotOrderRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_OrderBookLevel proto.InternalMessageInfo
+
+func (m *OrderBookLevel) GetOrderCount() uint64 {
+	if m != nil {
+		return m.OrderCount
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).PendingSpotOrder(ctx, in)
+	return 0
+}
+
+func (m *OrderBookLevel) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/elys.tradeshield.Query/PendingSpotOrder",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PendingSpotOrder(ctx, req.(*QueryGetPendingSpotOrderRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_PendingSpotOrderAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllPendingSpotOrderRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PendingSpotOrderAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/elys.tradeshield.Query/PendingSpotOrderAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PendingSpotOrderAll(ctx, req.(*QueryAllPendingSpotOrderRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QuerySpotOrderBookRequest struct {
+	BaseDenom  string        `protobuf:"bytes,1,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
+	QuoteDenom string        `protobuf:"bytes,2,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty"`
+	Side       OrderBookSide `protobuf:"varint,3,opt,name=side,proto3,enum=elys.tradeshield.OrderBookSide" json:"side,omitempty"`
+	// Width of the price buckets, zero aggregates orders of the same price
+	BucketSize cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=bucket_size,json=bucketSize,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"bucket_size"`
+	Pagination *query.PageRequest          `protobuf:"bytes,5,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_PendingPerpetualOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetPendingPerpetualOrderRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PendingPerpetualOrder(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/elys.tradeshield.Query/PendingPerpetualOrder",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PendingPerpetualOrder(ctx, req.(*QueryGetPendingPerpetualOrderRequest))
+func (m *QuerySpotOrderBookRequest) Reset()         { *m = QuerySpotOrderBookRequest{} }
+func (m *QuerySpotOrderBookRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySpotOrderBookRequest) ProtoMessage()    {}
+func (*QuerySpotOrderBookRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b92ce2c2fb85355, []int{19}
+}
+func (m *QuerySpotOrderBookRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySpotOrderBookRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySpotOrderBookRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QuerySpotOrderBookRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySpotOrderBookRequest.Merge(m, src)
+}
+func (m *QuerySpotOrderBookRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySpotOrderBookRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySpotOrderBookRequest.DiscardUnknown(m)
 }
 
-func _Query_PendingPerpetualOrderAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllPendingPerpetualOrderRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PendingPerpetualOrderAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/elys.tradeshield.Query/PendingPerpetualOrderAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PendingPerpetualOrderAll(ctx, req.(*QueryAllPendingPerpetualOrderRequest))
+var xxx_messageInfo_QuerySpotOrderBookRequest proto.InternalMessageInfo
+
+func (m *QuerySpotOrderBookRequest) GetBaseDenom() string {
+	if m != nil {
+		return m.BaseDenom
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_PendingPerpetualOrderForAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPendingPerpetualOrderForAddressRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PendingPerpetualOrderForAddress(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/elys.tradeshield.Query/PendingPerpetualOrderForAddress",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PendingPerpetualOrderForAddress(ctx, req.(*QueryPendingPerpetualOrderForAddressRequest))
+func (m *QuerySpotOrderBookRequest) GetQuoteDenom() string {
+	if m != nil {
+		return m.QuoteDenom
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_PendingSpotOrderForAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPendingSpotOrderForAddressRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PendingSpotOrderForAddress(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/elys.tradeshield.Query/PendingSpotOrderForAddress",
+func (m *QuerySpotOrderBookRequest) GetSide() OrderBookSide {
+	if m != nil {
+		return m.Side
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PendingSpotOrderForAddress(ctx, req.(*QueryPendingSpotOrderForAddressRequest))
+	return OrderBookSide_BUY
+}
+
+func (m *QuerySpotOrderBookRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "elys.tradeshield.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "PendingSpotOrder",
-			Handler:    _Query_PendingSpotOrder_Handler,
-		},
-		{
-			MethodName: "PendingSpotOrderAll",
-			Handler:    _Query_PendingSpotOrderAll_Handler,
-		},
-		{
-			MethodName: "PendingPerpetualOrder",
-			Handler:    _Query_PendingPerpetualOrder_Handler,
-		},
-		{
-			MethodName: "PendingPerpetualOrderAll",
-			Handler:    _Query_PendingPerpetualOrderAll_Handler,
-		},
-		{
-			MethodName: "PendingPerpetualOrderForAddress",
-			Handler:    _Query_PendingPerpetualOrderForAddress_Handler,
-		},
-		{
-			MethodName: "PendingSpotOrderForAddress",
-			Handler:    _Query_PendingSpotOrderForAddress_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "elys/tradeshield/query.proto",
+// Levels are sorted from the price closest to the market, descending for
+// BUY and ascending for SELL
+type QuerySpotOrderBookResponse struct {
+	Levels     []OrderBookLevel    `protobuf:"bytes,1,rep,name=levels,proto3" json:"levels"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuerySpotOrderBookResponse) Reset()         { *m = QuerySpotOrderBookResponse{} }
+func (m *QuerySpotOrderBookResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySpotOrderBookResponse) ProtoMessage()    {}
+func (*QuerySpotOrderBookResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b92ce2c2fb85355, []int{20}
+}
+func (m *QuerySpotOrderBookResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySpotOrderBookResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySpotOrderBookResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySpotOrderBookResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySpotOrderBookResponse.Merge(m, src)
+}
+func (m *QuerySpotOrderBookResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySpotOrderBookResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySpotOrderBookResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QuerySpotOrderBookResponse proto.InternalMessageInfo
+
+func (m *QuerySpotOrderBookResponse) GetLevels() []OrderBookLevel {
+	if m != nil {
+		return m.Levels
+	}
+	return nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuerySpotOrderBookResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryPerpetualOrderBookRequest struct {
+	TradingAsset string             `protobuf:"bytes,1,opt,name=trading_asset,json=tradingAsset,proto3" json:"trading_asset,omitempty"`
+	Position     PerpetualPosition  `protobuf:"varint,2,opt,name=position,proto3,enum=elys.tradeshield.PerpetualPosition" json:"position,omitempty"`
+	OrderType    PerpetualOrderType `protobuf:"varint,3,opt,name=order_type,json=orderType,proto3,enum=elys.tradeshield.PerpetualOrderType" json:"order_type,omitempty"`
+	// Width of the trigger price buckets, zero aggregates orders of the same
+	// trigger price
+	BucketSize cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=bucket_size,json=bucketSize,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"bucket_size"`
+	Pagination *query.PageRequest          `protobuf:"bytes,5,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryPerpetualOrderBookRequest) Reset()         { *m = QueryPerpetualOrderBookRequest{} }
+func (m *QueryPerpetualOrderBookRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPerpetualOrderBookRequest) ProtoMessage()    {}
+func (*QueryPerpetualOrderBookRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b92ce2c2fb85355, []int{21}
+}
+func (m *QueryPerpetualOrderBookRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPerpetualOrderBookRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPerpetualOrderBookRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryPerpetualOrderBookRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPerpetualOrderBookRequest.Merge(m, src)
+}
+func (m *QueryPerpetualOrderBookRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPerpetualOrderBookRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPerpetualOrderBookRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetPendingSpotOrderRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPerpetualOrderBookRequest proto.InternalMessageInfo
+
+func (m *QueryPerpetualOrderBookRequest) GetTradingAsset() string {
+	if m != nil {
+		return m.TradingAsset
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryGetPendingSpotOrderRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryPerpetualOrderBookRequest) GetPosition() PerpetualPosition {
+	if m != nil {
+		return m.Position
+	}
+	return PerpetualPosition_UNSPECIFIED
 }
 
-func (m *QueryGetPendingSpotOrderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryPerpetualOrderBookRequest) GetOrderType() PerpetualOrderType {
+	if m != nil {
+		return m.OrderType
 	}
-	return len(dAtA) - i, nil
+	return PerpetualOrderType_LIMITOPEN
 }
 
-func (m *QueryGetPendingSpotOrderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryPerpetualOrderBookRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGetPendingSpotOrderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Levels are sorted from the trigger price closest to the market
+type QueryPerpetualOrderBookResponse struct {
+	Levels     []OrderBookLevel    `protobuf:"bytes,1,rep,name=levels,proto3" json:"levels"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetPendingSpotOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.PendingSpotOrder.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryPerpetualOrderBookResponse) Reset()         { *m = QueryPerpetualOrderBookResponse{} }
+func (m *QueryPerpetualOrderBookResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPerpetualOrderBookResponse) ProtoMessage()    {}
+func (*QueryPerpetualOrderBookResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b92ce2c2fb85355, []int{22}
+}
+func (m *QueryPerpetualOrderBookResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPerpetualOrderBookResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPerpetualOrderBookResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryPerpetualOrderBookResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPerpetualOrderBookResponse.Merge(m, src)
+}
+func (m *QueryPerpetualOrderBookResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPerpetualOrderBookResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPerpetualOrderBookResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllPendingSpotOrderRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPerpetualOrderBookResponse proto.InternalMessageInfo
+
+func (m *QueryPerpetualOrderBookResponse) GetLevels() []OrderBookLevel {
+	if m != nil {
+		return m.Levels
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAllPendingSpotOrderRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryPerpetualOrderBookResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryAllPendingSpotOrderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+type QuerySimulateSpotOrderRequest struct {
+	// Owner of the order, optional, used for the swap fee discount
+	OwnerAddress     string        `protobuf:"bytes,1,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
+	OrderType        SpotOrderType `protobuf:"varint,2,opt,name=order_type,json=orderType,proto3,enum=elys.tradeshield.SpotOrderType" json:"order_type,omitempty"`
+	OrderPrice       OrderPrice    `protobuf:"bytes,3,opt,name=order_price,json=orderPrice,proto3" json:"order_price"`
+	OrderAmount      types.Coin    `protobuf:"bytes,4,opt,name=order_amount,json=orderAmount,proto3" json:"order_amount"`
+	OrderTargetDenom string        `protobuf:"bytes,5,opt,name=order_target_denom,json=orderTargetDenom,proto3" json:"order_target_denom,omitempty"`
+}
+
+func (m *QuerySimulateSpotOrderRequest) Reset()         { *m = QuerySimulateSpotOrderRequest{} }
+func (m *QuerySimulateSpotOrderRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateSpotOrderRequest) ProtoMessage()    {}
+func (*QuerySimulateSpotOrderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b92ce2c2fb85355, []int{23}
+}
+func (m *QuerySimulateSpotOrderRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateSpotOrderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateSpotOrderRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QuerySimulateSpotOrderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateSpotOrderRequest.Merge(m, src)
+}
+func (m *QuerySimulateSpotOrderRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateSpotOrderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateSpotOrderRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllPendingSpotOrderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySimulateSpotOrderRequest proto.InternalMessageInfo
+
+func (m *QuerySimulateSpotOrderRequest) GetOwnerAddress() string {
+	if m != nil {
+		return m.OwnerAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryAllPendingSpotOrderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySimulateSpotOrderRequest) GetOrderType() SpotOrderType {
+	if m != nil {
+		return m.OrderType
+	}
+	return SpotOrderType_STOPLOSS
 }
 
-func (m *QueryAllPendingSpotOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func (m *QuerySimulateSpotOrderRequest) GetOrderPrice() OrderPrice {
+	if m != nil {
+		return m.OrderPrice
 	}
-	if len(m.PendingSpotOrder) > 0 {
-		for iNdEx := len(m.PendingSpotOrder) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PendingSpotOrder[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	return OrderPrice{}
+}
+
+func (m *QuerySimulateSpotOrderRequest) GetOrderAmount() types.Coin {
+	if m != nil {
+		return m.OrderAmount
 	}
-	return len(dAtA) - i, nil
+	return types.Coin{}
 }
 
-func (m *QueryGetPendingPerpetualOrderRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuerySimulateSpotOrderRequest) GetOrderTargetDenom() string {
+	if m != nil {
+		return m.OrderTargetDenom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryGetPendingPerpetualOrderRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QuerySimulateSpotOrderResponse struct {
+	// Output of a swap of the whole order amount at the current pool state
+	AmountOut          types.Coin                  `protobuf:"bytes,1,opt,name=amount_out,json=amountOut,proto3" json:"amount_out"`
+	SpotPrice          cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=spot_price,json=spotPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"spot_price"`
+	SwapFee            cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=swap_fee,json=swapFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"swap_fee"`
+	Discount           cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=discount,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"discount"`
+	Slippage           cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=slippage,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"slippage"`
+	PriceImpact        cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=price_impact,json=priceImpact,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"price_impact"`
+	WeightBalanceRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=weight_balance_ratio,json=weightBalanceRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"weight_balance_ratio"`
+	// Oracle price of the order pair, compared to the rate of the order
+	MarketPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=market_price,json=marketPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"market_price"`
+	// Whether the order would execute right now
+	Executable bool `protobuf:"varint,9,opt,name=executable,proto3" json:"executable,omitempty"`
+	// Part of the order amount that would be swapped right now, limit orders
+	// are partially filled within their rate
+	FillAmount types.Coin `protobuf:"bytes,10,opt,name=fill_amount,json=fillAmount,proto3" json:"fill_amount"`
+}
+
+func (m *QuerySimulateSpotOrderResponse) Reset()         { *m = QuerySimulateSpotOrderResponse{} }
+func (m *QuerySimulateSpotOrderResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateSpotOrderResponse) ProtoMessage()    {}
+func (*QuerySimulateSpotOrderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b92ce2c2fb85355, []int{24}
+}
+func (m *QuerySimulateSpotOrderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateSpotOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateSpotOrderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySimulateSpotOrderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateSpotOrderResponse.Merge(m, src)
+}
+func (m *QuerySimulateSpotOrderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateSpotOrderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateSpotOrderResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetPendingPerpetualOrderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_QuerySimulateSpotOrderResponse proto.InternalMessageInfo
+
+func (m *QuerySimulateSpotOrderResponse) GetAmountOut() types.Coin {
+	if m != nil {
+		return m.AmountOut
 	}
-	return len(dAtA) - i, nil
+	return types.Coin{}
 }
 
-func (m *QueryGetPendingPerpetualOrderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuerySimulateSpotOrderResponse) GetExecutable() bool {
+	if m != nil {
+		return m.Executable
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *QueryGetPendingPerpetualOrderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySimulateSpotOrderResponse) GetFillAmount() types.Coin {
+	if m != nil {
+		return m.FillAmount
+	}
+	return types.Coin{}
 }
 
-func (m *QueryGetPendingPerpetualOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.PendingPerpetualOrder.MarshalToSizedBuffer(dAtA[:i])
+type QuerySimulatePerpetualOrderRequest struct {
+	// Owner of the order, optional
+	OwnerAddress string                      `protobuf:"bytes,1,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
+	Position     PerpetualPosition           `protobuf:"varint,2,opt,name=position,proto3,enum=elys.tradeshield.PerpetualPosition" json:"position,omitempty"`
+	Leverage     cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=leverage,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"leverage"`
+	TradingAsset string                      `protobuf:"bytes,4,opt,name=trading_asset,json=tradingAsset,proto3" json:"trading_asset,omitempty"`
+	Collateral   types.Coin                  `protobuf:"bytes,5,opt,name=collateral,proto3" json:"collateral"`
+	// Trigger price of the limit open order, zero simulates a market open
+	TriggerPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=trigger_price,json=triggerPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"trigger_price"`
+	// Unset uses the default take profit price of perpetual positions
+	TakeProfitPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=take_profit_price,json=takeProfitPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"take_profit_price"`
+	PoolId          uint64                      `protobuf:"varint,8,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+}
+
+func (m *QuerySimulatePerpetualOrderRequest) Reset()         { *m = QuerySimulatePerpetualOrderRequest{} }
+func (m *QuerySimulatePerpetualOrderRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulatePerpetualOrderRequest) ProtoMessage()    {}
+func (*QuerySimulatePerpetualOrderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b92ce2c2fb85355, []int{25}
+}
+func (m *QuerySimulatePerpetualOrderRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulatePerpetualOrderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulatePerpetualOrderRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QuerySimulatePerpetualOrderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulatePerpetualOrderRequest.Merge(m, src)
+}
+func (m *QuerySimulatePerpetualOrderRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulatePerpetualOrderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulatePerpetualOrderRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllPendingPerpetualOrderRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySimulatePerpetualOrderRequest proto.InternalMessageInfo
+
+func (m *QuerySimulatePerpetualOrderRequest) GetOwnerAddress() string {
+	if m != nil {
+		return m.OwnerAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryAllPendingPerpetualOrderRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySimulatePerpetualOrderRequest) GetPosition() PerpetualPosition {
+	if m != nil {
+		return m.Position
+	}
+	return PerpetualPosition_UNSPECIFIED
 }
 
-func (m *QueryAllPendingPerpetualOrderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *QuerySimulatePerpetualOrderRequest) GetTradingAsset() string {
+	if m != nil {
+		return m.TradingAsset
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryAllPendingPerpetualOrderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuerySimulatePerpetualOrderRequest) GetCollateral() types.Coin {
+	if m != nil {
+		return m.Collateral
 	}
-	return dAtA[:n], nil
+	return types.Coin{}
 }
 
-func (m *QueryAllPendingPerpetualOrderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySimulatePerpetualOrderRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
 }
 
-func (m *QueryAllPendingPerpetualOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+type QuerySimulatePerpetualOrderResponse struct {
+	PositionSize       types.Coin                  `protobuf:"bytes,1,opt,name=position_size,json=positionSize,proto3" json:"position_size"`
+	OpenPrice          cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=open_price,json=openPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"open_price"`
+	LiquidationPrice   cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=liquidation_price,json=liquidationPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"liquidation_price"`
+	EffectiveLeverage  cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=effective_leverage,json=effectiveLeverage,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"effective_leverage"`
+	Custody            types.Coin                  `protobuf:"bytes,5,opt,name=custody,proto3" json:"custody"`
+	Liabilities        types.Coin                  `protobuf:"bytes,6,opt,name=liabilities,proto3" json:"liabilities"`
+	Slippage           cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=slippage,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"slippage"`
+	PriceImpact        cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=price_impact,json=priceImpact,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"price_impact"`
+	WeightBreakingFee  cosmossdk_io_math.LegacyDec `protobuf:"bytes,9,opt,name=weight_breaking_fee,json=weightBreakingFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"weight_breaking_fee"`
+	FundingRate        cosmossdk_io_math.LegacyDec `protobuf:"bytes,10,opt,name=funding_rate,json=fundingRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"funding_rate"`
+	BorrowInterestRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,11,opt,name=borrow_interest_rate,json=borrowInterestRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"borrow_interest_rate"`
+	HourlyInterestRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,12,opt,name=hourly_interest_rate,json=hourlyInterestRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"hourly_interest_rate"`
+	AvailableLiquidity types.Coin                  `protobuf:"bytes,13,opt,name=available_liquidity,json=availableLiquidity,proto3" json:"available_liquidity"`
+	// Oracle price of the trading asset, compared to the trigger price
+	MarketPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,14,opt,name=market_price,json=marketPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"market_price"`
+	// Whether the order would execute right now
+	Executable bool `protobuf:"varint,15,opt,name=executable,proto3" json:"executable,omitempty"`
+}
+
+func (m *QuerySimulatePerpetualOrderResponse) Reset()         { *m = QuerySimulatePerpetualOrderResponse{} }
+func (m *QuerySimulatePerpetualOrderResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulatePerpetualOrderResponse) ProtoMessage()    {}
+func (*QuerySimulatePerpetualOrderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b92ce2c2fb85355, []int{26}
+}
+func (m *QuerySimulatePerpetualOrderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulatePerpetualOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulatePerpetualOrderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.PendingPerpetualOrder) > 0 {
-		for iNdEx := len(m.PendingPerpetualOrder) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PendingPerpetualOrder[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+}
+func (m *QuerySimulatePerpetualOrderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulatePerpetualOrderResponse.Merge(m, src)
+}
+func (m *QuerySimulatePerpetualOrderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulatePerpetualOrderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulatePerpetualOrderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimulatePerpetualOrderResponse proto.InternalMessageInfo
+
+func (m *QuerySimulatePerpetualOrderResponse) GetPositionSize() types.Coin {
+	if m != nil {
+		return m.PositionSize
 	}
-	return len(dAtA) - i, nil
+	return types.Coin{}
 }
 
-func (m *QueryPendingPerpetualOrderForAddressRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySimulatePerpetualOrderResponse) GetCustody() types.Coin {
+	if m != nil {
+		return m.Custody
+	}
+	return types.Coin{}
+}
+
+func (m *QuerySimulatePerpetualOrderResponse) GetLiabilities() types.Coin {
+	if m != nil {
+		return m.Liabilities
+	}
+	return types.Coin{}
+}
+
+func (m *QuerySimulatePerpetualOrderResponse) GetAvailableLiquidity() types.Coin {
+	if m != nil {
+		return m.AvailableLiquidity
+	}
+	return types.Coin{}
+}
+
+func (m *QuerySimulatePerpetualOrderResponse) GetExecutable() bool {
+	if m != nil {
+		return m.Executable
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterEnum("elys.tradeshield.OrderBookSide", OrderBookSide_name, OrderBookSide_value)
+	proto.RegisterType((*QueryParamsRequest)(nil), "elys.tradeshield.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "elys.tradeshield.QueryParamsResponse")
+	proto.RegisterType((*QueryGetPendingSpotOrderRequest)(nil), "elys.tradeshield.QueryGetPendingSpotOrderRequest")
+	proto.RegisterType((*QueryGetPendingSpotOrderResponse)(nil), "elys.tradeshield.QueryGetPendingSpotOrderResponse")
+	proto.RegisterType((*QueryAllPendingSpotOrderRequest)(nil), "elys.tradeshield.QueryAllPendingSpotOrderRequest")
+	proto.RegisterType((*QueryAllPendingSpotOrderResponse)(nil), "elys.tradeshield.QueryAllPendingSpotOrderResponse")
+	proto.RegisterType((*QueryGetPendingPerpetualOrderRequest)(nil), "elys.tradeshield.QueryGetPendingPerpetualOrderRequest")
+	proto.RegisterType((*QueryGetPendingPerpetualOrderResponse)(nil), "elys.tradeshield.QueryGetPendingPerpetualOrderResponse")
+	proto.RegisterType((*QueryAllPendingPerpetualOrderRequest)(nil), "elys.tradeshield.QueryAllPendingPerpetualOrderRequest")
+	proto.RegisterType((*QueryAllPendingPerpetualOrderResponse)(nil), "elys.tradeshield.QueryAllPendingPerpetualOrderResponse")
+	proto.RegisterType((*QueryPendingPerpetualOrderForAddressRequest)(nil), "elys.tradeshield.QueryPendingPerpetualOrderForAddressRequest")
+	proto.RegisterType((*QueryPendingPerpetualOrderForAddressResponse)(nil), "elys.tradeshield.QueryPendingPerpetualOrderForAddressResponse")
+	proto.RegisterType((*QueryPendingSpotOrderForAddressRequest)(nil), "elys.tradeshield.QueryPendingSpotOrderForAddressRequest")
+	proto.RegisterType((*QueryPendingSpotOrderForAddressResponse)(nil), "elys.tradeshield.QueryPendingSpotOrderForAddressResponse")
+	proto.RegisterType((*QueryGetOrderGroupRequest)(nil), "elys.tradeshield.QueryGetOrderGroupRequest")
+	proto.RegisterType((*QueryGetOrderGroupResponse)(nil), "elys.tradeshield.QueryGetOrderGroupResponse")
+	proto.RegisterType((*QueryGetScheduledOrderRequest)(nil), "elys.tradeshield.QueryGetScheduledOrderRequest")
+	proto.RegisterType((*QueryGetScheduledOrderResponse)(nil), "elys.tradeshield.QueryGetScheduledOrderResponse")
+	proto.RegisterType((*OrderBookLevel)(nil), "elys.tradeshield.OrderBookLevel")
+	proto.RegisterType((*QuerySpotOrderBookRequest)(nil), "elys.tradeshield.QuerySpotOrderBookRequest")
+	proto.RegisterType((*QuerySpotOrderBookResponse)(nil), "elys.tradeshield.QuerySpotOrderBookResponse")
+	proto.RegisterType((*QueryPerpetualOrderBookRequest)(nil), "elys.tradeshield.QueryPerpetualOrderBookRequest")
+	proto.RegisterType((*QueryPerpetualOrderBookResponse)(nil), "elys.tradeshield.QueryPerpetualOrderBookResponse")
+	proto.RegisterType((*QuerySimulateSpotOrderRequest)(nil), "elys.tradeshield.QuerySimulateSpotOrderRequest")
+	proto.RegisterType((*QuerySimulateSpotOrderResponse)(nil), "elys.tradeshield.QuerySimulateSpotOrderResponse")
+	proto.RegisterType((*QuerySimulatePerpetualOrderRequest)(nil), "elys.tradeshield.QuerySimulatePerpetualOrderRequest")
+	proto.RegisterType((*QuerySimulatePerpetualOrderResponse)(nil), "elys.tradeshield.QuerySimulatePerpetualOrderResponse")
+}
+
+func init() { proto.RegisterFile("elys/tradeshield/query.proto", fileDescriptor_0b92ce2c2fb85355) }
+
+var fileDescriptor_0b92ce2c2fb85355 = []byte{
+	// 2175 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0x4d, 0x6c, 0x1b, 0xc7,
+	0x15, 0xd6, 0x52, 0x14, 0x25, 0x3d, 0xfd, 0x58, 0x1a, 0x39, 0x09, 0xcd, 0xc4, 0x94, 0x40, 0xdb,
+	0xb1, 0x1b, 0xdb, 0xa4, 0x24, 0x27, 0x72, 0xdc, 0x20, 0x4e, 0x25, 0xd9, 0x16, 0xd4, 0xc8, 0x35,
+	0x4d, 0xb9, 0x05, 0x1a, 0xa0, 0x65, 0x87, 0xbb, 0x23, 0x6a, 0xa0, 0x15, 0x67, 0xbd, 0x3b, 0xb4,
+	0xac, 0xb8, 0x3a, 0xb4, 0xe8, 0xbd, 0x29, 0x7a, 0xea, 0xad, 0x97, 0x5e, 0x82, 0x1e, 0x8a, 0xa2,
+	0x68, 0x51, 0xb4, 0xbd, 0x06, 0x01, 0x8a, 0x02, 0x41, 0x7b, 0x69, 0x0b, 0x34, 0x29, 0xec, 0x02,
+	0x3d, 0xf5, 0xda, 0x73, 0x30, 0x3f, 0xbb, 0xe2, 0x92, 0xbb, 0xe2, 0x6a, 0xad, 0x00, 0x39, 0x89,
+	0x3b, 0x33, 0xef, 0x7b, 0xdf, 0xfb, 0x99, 0xf7, 0x76, 0x67, 0x04, 0xaf, 0x10, 0x7b, 0xdf, 0xab,
+	0x70, 0x17, 0x5b, 0xc4, 0xdb, 0xa6, 0xc4, 0xb6, 0x2a, 0x0f, 0xdb, 0xc4, 0xdd, 0x2f, 0x3b, 0x2e,
+	0xe3, 0x0c, 0x4d, 0x89, 0xd9, 0x72, 0xc7, 0x6c, 0xe1, 0x74, 0x93, 0x35, 0x99, 0x9c, 0xac, 0x88,
+	0x5f, 0x6a, 0x5d, 0xe1, 0x8c, 0xc9, 0xbc, 0x5d, 0xe6, 0xd5, 0xd5, 0x84, 0x7a, 0xd0, 0x53, 0x45,
+	0xf5, 0x54, 0x69, 0x60, 0x8f, 0x54, 0x1e, 0x2d, 0x34, 0x08, 0xc7, 0x0b, 0x15, 0x93, 0xd1, 0x96,
+	0x9e, 0xef, 0x25, 0xc0, 0x5c, 0x8b, 0xb8, 0xfe, 0x6c, 0x93, 0xb1, 0xa6, 0x4d, 0x2a, 0xd8, 0xa1,
+	0x15, 0xdc, 0x6a, 0x31, 0x8e, 0x39, 0x65, 0x2d, 0x1f, 0xfb, 0xb5, 0x4e, 0x6c, 0xc9, 0x3b, 0xd0,
+	0xe0, 0xe0, 0x26, 0x6d, 0xc9, 0xc5, 0x7a, 0xed, 0xd9, 0x1e, 0x3d, 0x0e, 0x76, 0xf1, 0xae, 0x17,
+	0x4b, 0x83, 0xef, 0x3b, 0x44, 0xcf, 0x96, 0x4e, 0x03, 0xba, 0x2f, 0xe0, 0xab, 0x52, 0xa4, 0x46,
+	0x1e, 0xb6, 0x89, 0xc7, 0x4b, 0x77, 0x61, 0x26, 0x34, 0xea, 0x39, 0xac, 0xe5, 0x11, 0xb4, 0x04,
+	0x39, 0x05, 0x9d, 0x37, 0xe6, 0x8c, 0x4b, 0x63, 0x8b, 0xf9, 0x72, 0xb7, 0x17, 0xcb, 0x4a, 0x62,
+	0x25, 0xfb, 0xf1, 0xa7, 0xb3, 0x03, 0x35, 0xbd, 0xba, 0xb4, 0x00, 0xb3, 0x12, 0x6e, 0x8d, 0xf0,
+	0x2a, 0x69, 0x59, 0xb4, 0xd5, 0xdc, 0x74, 0x18, 0xbf, 0x27, 0xbc, 0xa1, 0x35, 0xa2, 0x49, 0xc8,
+	0x50, 0x4b, 0xc2, 0x66, 0x6b, 0x19, 0x6a, 0x95, 0x3c, 0x98, 0x8b, 0x17, 0xd1, 0x74, 0xee, 0x01,
+	0x72, 0xd4, 0x5c, 0xdd, 0x73, 0x18, 0xaf, 0x4b, 0xf7, 0x6a, 0x6a, 0x2f, 0xf7, 0x52, 0x0b, 0x00,
+	0x34, 0xbb, 0x29, 0xa7, 0x0b, 0xb8, 0x44, 0x35, 0xcf, 0x65, 0xdb, 0x8e, 0xe3, 0x79, 0x07, 0xe0,
+	0x30, 0x00, 0x5a, 0xd7, 0xab, 0x65, 0x9d, 0x17, 0x22, 0x5a, 0x65, 0x95, 0x65, 0x3a, 0x5a, 0xe5,
+	0x2a, 0x6e, 0x12, 0x2d, 0x5b, 0xeb, 0x90, 0x2c, 0xfd, 0xd1, 0x80, 0xb9, 0x78, 0x5d, 0x7d, 0x0c,
+	0x1c, 0x4c, 0x69, 0x20, 0x5a, 0x0b, 0xb1, 0xcf, 0x48, 0xf6, 0x17, 0xfb, 0xb2, 0x57, 0x6c, 0x42,
+	0xf4, 0x97, 0xe0, 0x7c, 0x57, 0x78, 0xaa, 0xc4, 0x75, 0x08, 0x6f, 0x63, 0xfb, 0xc8, 0xb0, 0x7e,
+	0x60, 0xc0, 0x85, 0x3e, 0x82, 0xda, 0xf6, 0x26, 0xbc, 0xe4, 0xdb, 0xee, 0xf8, 0x2b, 0x42, 0x11,
+	0xfe, 0x4a, 0x44, 0xf2, 0x85, 0xa0, 0x6e, 0x3f, 0xe6, 0x2e, 0x5e, 0x6f, 0x6d, 0x31, 0xed, 0x8e,
+	0x17, 0x9c, 0x28, 0x85, 0xa5, 0x16, 0x9c, 0xef, 0x0a, 0x44, 0xb4, 0x29, 0x27, 0x15, 0xf9, 0x7f,
+	0xf8, 0x2e, 0x88, 0x57, 0x98, 0xc4, 0x05, 0x83, 0x27, 0xe7, 0x82, 0x93, 0x4b, 0x8b, 0x7d, 0xb8,
+	0xac, 0xea, 0x46, 0x94, 0x9a, 0x3b, 0xcc, 0x5d, 0xb6, 0x2c, 0x97, 0x78, 0x7e, 0x99, 0x41, 0x79,
+	0x18, 0xc6, 0x6a, 0x44, 0xfa, 0x73, 0xb4, 0xe6, 0x3f, 0xa2, 0x79, 0xc8, 0x79, 0x1c, 0xf3, 0xb6,
+	0x27, 0xd9, 0x4c, 0x46, 0x55, 0x9a, 0x4d, 0x39, 0x5f, 0xd3, 0xeb, 0x4a, 0x3f, 0x33, 0xe0, 0x4a,
+	0x32, 0xdd, 0xda, 0xbb, 0x14, 0xf2, 0x31, 0xde, 0xf5, 0xd2, 0xba, 0xf7, 0xc5, 0x48, 0xf7, 0x7a,
+	0x25, 0x0e, 0xaf, 0x76, 0x52, 0x0b, 0xf6, 0xe3, 0x17, 0xeb, 0x91, 0xef, 0xc3, 0xc5, 0xbe, 0x5a,
+	0xb5, 0x2f, 0xee, 0xc3, 0x4c, 0x6f, 0xa1, 0xf1, 0x92, 0x57, 0x9a, 0xe9, 0xee, 0x4a, 0xe3, 0x95,
+	0x2e, 0xc3, 0x19, 0x7f, 0xa3, 0xcb, 0x91, 0x35, 0x97, 0xb5, 0x9d, 0xb8, 0xb2, 0xf0, 0x83, 0x0c,
+	0x14, 0xa2, 0x56, 0x6b, 0x7a, 0xab, 0x30, 0x26, 0x19, 0xd5, 0x9b, 0x62, 0x58, 0xef, 0xbd, 0x57,
+	0x7a, 0x69, 0x1d, 0x8a, 0x6a, 0x5e, 0xc0, 0x82, 0x11, 0xb4, 0x02, 0x63, 0x9d, 0xb6, 0x65, 0x92,
+	0xda, 0x06, 0x5e, 0x60, 0x14, 0x7a, 0x0f, 0xa6, 0x7a, 0x72, 0x65, 0x30, 0x5d, 0xae, 0x9c, 0x72,
+	0xba, 0x92, 0xa4, 0x02, 0x67, 0x7d, 0x17, 0x6c, 0x9a, 0xdb, 0xc4, 0x6a, 0xdb, 0xc4, 0x3a, 0xb2,
+	0x96, 0xfe, 0xd6, 0x80, 0x62, 0x9c, 0x44, 0xd0, 0x40, 0x4e, 0x79, 0xfe, 0x4c, 0xa8, 0x78, 0xce,
+	0x45, 0xd8, 0x1d, 0x82, 0xd0, 0x2c, 0x27, 0xbd, 0xd0, 0x28, 0x5a, 0x85, 0x9c, 0x67, 0x53, 0x93,
+	0xf8, 0xfe, 0xbb, 0xd0, 0x0f, 0x67, 0x53, 0xac, 0xf6, 0x5f, 0x07, 0x94, 0x68, 0xe9, 0x33, 0x03,
+	0x26, 0x95, 0x12, 0xc6, 0x76, 0x36, 0xc8, 0x23, 0x62, 0xa3, 0x35, 0x18, 0x72, 0x5c, 0x6a, 0x12,
+	0x95, 0xf5, 0x2b, 0x0b, 0x62, 0xfd, 0x3f, 0x3f, 0x9d, 0x7d, 0x59, 0xd5, 0x20, 0xcf, 0xda, 0x29,
+	0x53, 0x56, 0xd9, 0xc5, 0x7c, 0xbb, 0xbc, 0x41, 0x9a, 0xd8, 0xdc, 0xbf, 0x45, 0xcc, 0xbf, 0xfe,
+	0xe6, 0x2a, 0xa8, 0xe9, 0xf2, 0x2d, 0x62, 0xd6, 0x94, 0x3c, 0x9a, 0xf5, 0x53, 0xc5, 0x64, 0xed,
+	0x16, 0x97, 0x7b, 0x25, 0xab, 0xd3, 0x60, 0x55, 0x8c, 0x20, 0x13, 0x72, 0x78, 0x57, 0xce, 0xa9,
+	0xc0, 0x9d, 0x09, 0xd5, 0x39, 0xbf, 0xc2, 0xad, 0x32, 0xda, 0x5a, 0x99, 0x17, 0x2c, 0x3e, 0xfc,
+	0x6c, 0xf6, 0x52, 0x93, 0xf2, 0xed, 0x76, 0xa3, 0x6c, 0xb2, 0x5d, 0xfd, 0x06, 0xa8, 0xff, 0x5c,
+	0xf5, 0xac, 0x1d, 0xfd, 0x36, 0x25, 0x04, 0xbc, 0x9a, 0x86, 0x2e, 0xfd, 0x32, 0xa3, 0xb3, 0xff,
+	0x30, 0x99, 0x18, 0xdb, 0xf1, 0x03, 0x79, 0x16, 0x40, 0x28, 0xab, 0x5b, 0xa4, 0xc5, 0x76, 0xf5,
+	0x3e, 0x1f, 0x15, 0x23, 0xb7, 0xc4, 0x80, 0x30, 0xe1, 0x61, 0x9b, 0x71, 0x7f, 0x3e, 0x23, 0xe7,
+	0x41, 0x0e, 0xa9, 0x05, 0xd7, 0x20, 0xeb, 0x51, 0x8b, 0xe4, 0x07, 0x65, 0x21, 0x98, 0x8d, 0xd9,
+	0x07, 0x42, 0xe3, 0x26, 0xb5, 0x48, 0x4d, 0x2e, 0x46, 0x35, 0x18, 0x6b, 0xb4, 0xcd, 0x1d, 0xc2,
+	0xeb, 0x1e, 0x7d, 0x9f, 0xe4, 0xb3, 0x69, 0xfd, 0x0c, 0x0a, 0x65, 0x93, 0xbe, 0x4f, 0xba, 0x5a,
+	0xe2, 0x50, 0xea, 0x96, 0xf8, 0x0b, 0x03, 0x0a, 0x51, 0xee, 0xd2, 0x59, 0x7c, 0x13, 0x72, 0xb6,
+	0xc8, 0x12, 0xbf, 0x20, 0xcd, 0x1d, 0x61, 0xb1, 0x4c, 0x27, 0x3f, 0xdf, 0x94, 0xd4, 0xc9, 0xb5,
+	0xb7, 0xff, 0x66, 0xf4, 0x8e, 0x0b, 0x6f, 0xed, 0xce, 0xd8, 0x9e, 0x83, 0x09, 0xc1, 0x4b, 0x54,
+	0x52, 0xec, 0x79, 0x84, 0xeb, 0xf0, 0x8e, 0xeb, 0xc1, 0x65, 0x31, 0x86, 0xde, 0x81, 0x11, 0x87,
+	0x79, 0x34, 0xa0, 0x33, 0xb9, 0x78, 0xee, 0x88, 0xf2, 0x51, 0xd5, 0x4b, 0x6b, 0x81, 0x10, 0x5a,
+	0x05, 0x95, 0xd2, 0x75, 0x91, 0x7c, 0x3a, 0x0f, 0xce, 0xf7, 0xab, 0x40, 0x0f, 0xf6, 0x1d, 0x52,
+	0x1b, 0x65, 0xfe, 0xcf, 0x2f, 0x75, 0x46, 0x7c, 0x68, 0xe8, 0x57, 0xf1, 0x28, 0x4f, 0x7f, 0xd9,
+	0xd2, 0xe2, 0x0f, 0x19, 0x5d, 0xba, 0x37, 0xe9, 0x6e, 0xdb, 0xc6, 0x9c, 0xf4, 0x7c, 0x35, 0x9c,
+	0x83, 0x09, 0xb6, 0xd7, 0x22, 0x6e, 0x3d, 0xdc, 0xdc, 0xc7, 0xe5, 0xa0, 0x6e, 0xc6, 0xe8, 0x66,
+	0x28, 0xa8, 0x99, 0xb8, 0xcd, 0x1d, 0x80, 0x77, 0xc7, 0x33, 0xe8, 0x92, 0xaa, 0x92, 0x0e, 0x1e,
+	0xd9, 0x25, 0xab, 0xee, 0x61, 0x5d, 0x06, 0x16, 0x8c, 0xa0, 0x15, 0x18, 0x57, 0x20, 0xba, 0x48,
+	0x66, 0xe7, 0x8c, 0xa3, 0x8b, 0xa4, 0x82, 0x50, 0x9a, 0x97, 0xa5, 0x0c, 0xba, 0x02, 0x48, 0x1b,
+	0x82, 0xdd, 0x26, 0xe1, 0xba, 0x8e, 0x0d, 0x49, 0x93, 0xa7, 0x14, 0x5f, 0x39, 0x21, 0xab, 0x59,
+	0xe9, 0x27, 0x39, 0x28, 0xc6, 0x79, 0x2f, 0x88, 0x34, 0x28, 0x3a, 0x75, 0xd6, 0xe6, 0x79, 0x23,
+	0x19, 0xa5, 0x51, 0x25, 0x72, 0xaf, 0xcd, 0x51, 0x15, 0x64, 0x13, 0xd7, 0x8e, 0xc9, 0xa4, 0x4d,
+	0xf4, 0x51, 0x01, 0xa2, 0xdc, 0xb4, 0x01, 0x23, 0xde, 0x1e, 0x76, 0xea, 0x5b, 0x44, 0x39, 0x3a,
+	0x15, 0xde, 0xb0, 0x80, 0xb8, 0x43, 0x08, 0xba, 0x0b, 0x23, 0x16, 0xf5, 0xcc, 0xc0, 0xe1, 0xa9,
+	0xd0, 0x02, 0x08, 0x01, 0xe7, 0xd9, 0xd4, 0x71, 0x70, 0x93, 0xe4, 0x87, 0x52, 0xc3, 0xf9, 0x10,
+	0xe8, 0x01, 0x8c, 0x4b, 0xc7, 0xd5, 0xe9, 0xae, 0x83, 0x4d, 0x9e, 0xcf, 0xa5, 0x85, 0x1c, 0x93,
+	0x30, 0xeb, 0x12, 0x05, 0x99, 0x70, 0x7a, 0x8f, 0xd0, 0xe6, 0x36, 0xaf, 0x37, 0xb0, 0x8d, 0x5b,
+	0x26, 0xa9, 0xbb, 0x62, 0x37, 0xe5, 0x87, 0xd3, 0xa2, 0x23, 0x05, 0xb7, 0xa2, 0xd0, 0x6a, 0x02,
+	0x4c, 0x50, 0xdf, 0xc5, 0xae, 0x28, 0x71, 0x2a, 0xf4, 0x23, 0xa9, 0xa9, 0x2b, 0x18, 0x15, 0xfc,
+	0x22, 0x00, 0x79, 0x4c, 0xcc, 0x36, 0xc7, 0x0d, 0x9b, 0xe4, 0x47, 0xe7, 0x8c, 0x4b, 0x23, 0xb5,
+	0x8e, 0x11, 0xf4, 0x35, 0x18, 0xdb, 0xa2, 0xb6, 0xed, 0x6f, 0x21, 0x48, 0x96, 0xaf, 0x20, 0x64,
+	0xd4, 0x0e, 0x2a, 0xfd, 0x38, 0x0b, 0xa5, 0xd0, 0x9e, 0x88, 0xfe, 0x24, 0x4d, 0x54, 0x56, 0x9e,
+	0xbb, 0xd9, 0xdc, 0x85, 0x11, 0x51, 0x31, 0x5d, 0xdc, 0x7c, 0x8e, 0x5c, 0x0f, 0x20, 0x7a, 0x3b,
+	0x64, 0x36, 0xb2, 0x43, 0x82, 0xc9, 0x6c, 0x61, 0xb9, 0x8b, 0xed, 0xfc, 0x50, 0x42, 0x0f, 0x1e,
+	0x8a, 0xa0, 0x6f, 0x09, 0x2d, 0xb4, 0xd9, 0x0c, 0xca, 0x61, 0xea, 0xac, 0x1d, 0xd7, 0x38, 0x2a,
+	0xf6, 0xdf, 0x81, 0x69, 0x8e, 0x77, 0x88, 0x38, 0x0f, 0xdc, 0xa2, 0x7e, 0x5a, 0xa5, 0xce, 0xd9,
+	0x53, 0x02, 0xab, 0x2a, 0xa1, 0x14, 0xfc, 0x4b, 0x30, 0xec, 0x30, 0x66, 0xd7, 0xa9, 0x25, 0x73,
+	0x35, 0x5b, 0xcb, 0x89, 0xc7, 0x75, 0xab, 0xf4, 0xbf, 0x51, 0x38, 0x77, 0x64, 0x46, 0xe8, 0x52,
+	0x79, 0x0b, 0x26, 0xfc, 0xc0, 0xa9, 0xb6, 0x9e, 0xb0, 0x5a, 0x8e, 0xfb, 0x52, 0xb2, 0x8d, 0x57,
+	0x01, 0x98, 0x43, 0x5a, 0xcf, 0x5d, 0x30, 0x05, 0x88, 0x32, 0xec, 0xbb, 0x30, 0x6d, 0xd3, 0x87,
+	0x6d, 0x6a, 0xc9, 0x96, 0xd9, 0xd1, 0xa2, 0x52, 0x01, 0x4f, 0x75, 0x60, 0x29, 0xfc, 0xef, 0x01,
+	0x22, 0x5b, 0x5b, 0xc4, 0xe4, 0xf4, 0x11, 0xa9, 0x07, 0xe9, 0x9a, 0xba, 0x98, 0x4e, 0x07, 0x60,
+	0x1b, 0x7e, 0xde, 0xde, 0x80, 0x61, 0xb3, 0xed, 0x71, 0x66, 0xed, 0x27, 0xcd, 0x47, 0x7f, 0x3d,
+	0x5a, 0x86, 0x31, 0x9b, 0xe2, 0x06, 0xb5, 0x29, 0xa7, 0xc4, 0xcb, 0xe7, 0x92, 0x89, 0x77, 0xca,
+	0x84, 0x6a, 0xfa, 0xf0, 0xc9, 0xd7, 0xf4, 0x91, 0x13, 0xa9, 0xe9, 0x18, 0x66, 0xfc, 0x9a, 0xee,
+	0x12, 0xbc, 0x23, 0xb6, 0xf8, 0x16, 0x51, 0x15, 0x32, 0x5d, 0x14, 0x74, 0x49, 0xd7, 0x60, 0xa2,
+	0x55, 0x3e, 0x80, 0xf1, 0xad, 0xb6, 0x3a, 0xa9, 0x70, 0x31, 0x27, 0x79, 0x48, 0x8b, 0x3d, 0xa6,
+	0x61, 0x6a, 0x98, 0x13, 0xd1, 0x8c, 0x1a, 0xcc, 0x75, 0xd9, 0x5e, 0x9d, 0xb6, 0x38, 0x71, 0x89,
+	0xc7, 0x15, 0xfa, 0x58, 0xea, 0x66, 0xa4, 0xe0, 0xd6, 0x35, 0x9a, 0xaf, 0x64, 0x9b, 0xb5, 0x5d,
+	0x7b, 0xbf, 0x4b, 0xc9, 0x78, 0x6a, 0x25, 0x0a, 0x2e, 0xa4, 0xa4, 0x0a, 0x33, 0xf8, 0x11, 0xa6,
+	0xb6, 0x68, 0x44, 0x75, 0xb5, 0x4b, 0x28, 0xdf, 0xcf, 0x4f, 0x24, 0x4b, 0x39, 0x14, 0xc8, 0x6e,
+	0xf8, 0xa2, 0x3d, 0x3d, 0x74, 0xf2, 0x0b, 0xe8, 0xa1, 0xa7, 0xba, 0x7b, 0xe8, 0x6b, 0x25, 0x98,
+	0x08, 0x7d, 0xc5, 0xa2, 0x61, 0x18, 0x5c, 0xf9, 0xe6, 0xb7, 0xa7, 0x06, 0xd0, 0x08, 0x64, 0x37,
+	0x6f, 0x6f, 0x6c, 0x4c, 0x19, 0x8b, 0xff, 0x9a, 0x81, 0x21, 0x59, 0x13, 0xd1, 0x8f, 0x0c, 0xc8,
+	0xa9, 0x9b, 0x07, 0x14, 0xf1, 0x19, 0xd4, 0x7b, 0xc1, 0x51, 0xb8, 0xd0, 0x67, 0x95, 0xaa, 0xa6,
+	0xa5, 0xab, 0x3f, 0xfc, 0xdb, 0x7f, 0x7e, 0x9a, 0xb9, 0x88, 0x2e, 0x54, 0xc4, 0xf2, 0xab, 0x2d,
+	0xc2, 0xf7, 0x98, 0xbb, 0x53, 0x89, 0xb9, 0x70, 0x41, 0xbf, 0x37, 0x60, 0xaa, 0xfb, 0xb4, 0x0d,
+	0x2d, 0xc4, 0xa8, 0x8a, 0xbf, 0x0c, 0x29, 0x2c, 0x1e, 0x47, 0x44, 0x53, 0xbd, 0x29, 0xa9, 0xbe,
+	0x89, 0x96, 0xfa, 0x51, 0xed, 0x39, 0xe7, 0xab, 0x3c, 0xa1, 0xd6, 0x01, 0xfa, 0x9d, 0x01, 0x33,
+	0xdd, 0xe0, 0xcb, 0xb6, 0x1d, 0x4b, 0x3f, 0xfe, 0x8e, 0xa4, 0xb0, 0x78, 0x1c, 0x11, 0x4d, 0xff,
+	0x86, 0xa4, 0x7f, 0x0d, 0x2d, 0x1c, 0x9b, 0x3e, 0xfa, 0x8b, 0x01, 0x2f, 0x44, 0x1e, 0xfa, 0xa2,
+	0xa5, 0xbe, 0x7e, 0x8c, 0x7c, 0xaf, 0x2a, 0x5c, 0x3f, 0xb6, 0x9c, 0xb6, 0x62, 0x55, 0x5a, 0xf1,
+	0x36, 0x7a, 0x2b, 0xa1, 0x15, 0x5d, 0x87, 0x89, 0x2a, 0x12, 0x7f, 0x36, 0x20, 0x1f, 0xa9, 0x46,
+	0x84, 0x63, 0xa9, 0xaf, 0x6f, 0x8f, 0x67, 0x52, 0xbf, 0x4b, 0x88, 0x63, 0xe7, 0x55, 0x97, 0x49,
+	0xe8, 0xff, 0x06, 0xcc, 0xf6, 0x39, 0x92, 0x47, 0x6f, 0xc7, 0xed, 0xc6, 0x44, 0xd7, 0x08, 0x85,
+	0x9b, 0x69, 0xc5, 0xb5, 0x89, 0x0f, 0xa4, 0x89, 0xdf, 0x40, 0x1b, 0xe9, 0x4c, 0xac, 0x6f, 0xb1,
+	0xe0, 0x0d, 0xbc, 0xf2, 0x44, 0xff, 0x38, 0x40, 0x4f, 0x0d, 0x28, 0xc4, 0x1f, 0xbd, 0xa3, 0x37,
+	0x8f, 0x26, 0x1d, 0x7f, 0x47, 0x50, 0xb8, 0x91, 0x42, 0x52, 0x5b, 0x7a, 0x4f, 0x5a, 0xba, 0x8e,
+	0xd6, 0x8e, 0xbd, 0xcb, 0x62, 0x8c, 0xfc, 0xb9, 0x01, 0x70, 0x78, 0xea, 0x8e, 0x2e, 0xc7, 0x6f,
+	0x9c, 0x9e, 0x4b, 0x80, 0xc2, 0x95, 0x64, 0x8b, 0x35, 0xf5, 0xeb, 0x92, 0xfa, 0x02, 0xaa, 0xf4,
+	0xa1, 0xde, 0x71, 0x51, 0xa0, 0xb6, 0xd3, 0xaf, 0x0c, 0x98, 0x0c, 0x9f, 0x49, 0xa3, 0x4a, 0xbc,
+	0xe6, 0xc8, 0xa3, 0xf7, 0xc2, 0x7c, 0x72, 0x01, 0x4d, 0xf7, 0x2d, 0x49, 0xf7, 0x0d, 0x74, 0xad,
+	0x0f, 0xdd, 0xae, 0xe3, 0x79, 0x45, 0xf9, 0x4f, 0x06, 0x4c, 0x84, 0x8e, 0x42, 0x63, 0x1d, 0x1b,
+	0x75, 0xbe, 0x5c, 0xb8, 0x92, 0x6c, 0xb1, 0x66, 0x7a, 0x5f, 0x32, 0x7d, 0x17, 0xad, 0xf7, 0x63,
+	0x7a, 0x98, 0x0b, 0x0d, 0xc6, 0x76, 0x2a, 0x4f, 0x0e, 0xcf, 0xb0, 0x0f, 0x2a, 0x4f, 0x3a, 0x4e,
+	0xac, 0x0f, 0xd0, 0x47, 0x06, 0xa0, 0xde, 0x83, 0x3b, 0x34, 0x1f, 0x9b, 0xb8, 0x31, 0xa7, 0xa9,
+	0x85, 0x85, 0x63, 0x48, 0x68, 0x73, 0xde, 0x95, 0xe6, 0xdc, 0x46, 0xab, 0x7d, 0x53, 0x3c, 0xbc,
+	0x89, 0x95, 0x4d, 0xa1, 0x2f, 0xd3, 0x03, 0xf4, 0x6b, 0x03, 0xa6, 0x7b, 0x8e, 0xa5, 0x62, 0xd3,
+	0x27, 0xee, 0xf8, 0xaf, 0x30, 0x9f, 0x5c, 0x40, 0x5b, 0xf1, 0x55, 0x69, 0xc5, 0xeb, 0x68, 0xb1,
+	0x5f, 0x50, 0x34, 0x42, 0x67, 0x3f, 0xfc, 0xc8, 0x80, 0x17, 0xa3, 0xbf, 0x12, 0xd1, 0xeb, 0x7d,
+	0x88, 0x44, 0xf7, 0x8e, 0x37, 0x8e, 0x29, 0xa5, 0x6d, 0x78, 0x47, 0xda, 0x70, 0x03, 0x5d, 0x4f,
+	0x6a, 0x43, 0x57, 0x48, 0x56, 0xbe, 0xfe, 0xf1, 0xd3, 0xa2, 0xf1, 0xc9, 0xd3, 0xa2, 0xf1, 0xef,
+	0xa7, 0x45, 0xe3, 0x83, 0x67, 0xc5, 0x81, 0x4f, 0x9e, 0x15, 0x07, 0xfe, 0xfe, 0xac, 0x38, 0xf0,
+	0xde, 0x7c, 0xc7, 0x8d, 0x4c, 0x2f, 0xf8, 0xe3, 0xde, 0xff, 0x76, 0x69, 0xe4, 0xe4, 0xbf, 0xbb,
+	0x5c, 0xfb, 0x3c, 0x00, 0x00, 0xff, 0xff, 0x0c, 0x42, 0x05, 0xa2, 0x16, 0x24, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Queries a list of PendingSpotOrder items.
+	PendingSpotOrder(ctx context.Context, in *QueryGetPendingSpotOrderRequest, opts ...grpc.CallOption) (*QueryGetPendingSpotOrderResponse, error)
+	PendingSpotOrderAll(ctx context.Context, in *QueryAllPendingSpotOrderRequest, opts ...grpc.CallOption) (*QueryAllPendingSpotOrderResponse, error)
+	// Queries a list of PendingPerpetualOrder items.
+	PendingPerpetualOrder(ctx context.Context, in *QueryGetPendingPerpetualOrderRequest, opts ...grpc.CallOption) (*QueryGetPendingPerpetualOrderResponse, error)
+	PendingPerpetualOrderAll(ctx context.Context, in *QueryAllPendingPerpetualOrderRequest, opts ...grpc.CallOption) (*QueryAllPendingPerpetualOrderResponse, error)
+	// Queries a list of PendingPerpetualOrderForAddress items.
+	PendingPerpetualOrderForAddress(ctx context.Context, in *QueryPendingPerpetualOrderForAddressRequest, opts ...grpc.CallOption) (*QueryPendingPerpetualOrderForAddressResponse, error)
+	// Queries a list of PendingSpotOrderForAddress items.
+	PendingSpotOrderForAddress(ctx context.Context, in *QueryPendingSpotOrderForAddressRequest, opts ...grpc.CallOption) (*QueryPendingSpotOrderForAddressResponse, error)
+	// Queries an order group and its pending orders.
+	OrderGroup(ctx context.Context, in *QueryGetOrderGroupRequest, opts ...grpc.CallOption) (*QueryGetOrderGroupResponse, error)
+	// Queries a scheduled order and the records of its slices.
+	ScheduledOrder(ctx context.Context, in *QueryGetScheduledOrderRequest, opts ...grpc.CallOption) (*QueryGetScheduledOrderResponse, error)
+	// Queries the pending spot limit orders of a denom pair aggregated by
+	// price level.
+	SpotOrderBook(ctx context.Context, in *QuerySpotOrderBookRequest, opts ...grpc.CallOption) (*QuerySpotOrderBookResponse, error)
+	// Queries the pending perpetual orders of a trading asset aggregated by
+	// trigger price level.
+	PerpetualOrderBook(ctx context.Context, in *QueryPerpetualOrderBookRequest, opts ...grpc.CallOption) (*QueryPerpetualOrderBookResponse, error)
+	// Estimates the execution of an unsaved spot order at the current state.
+	SimulateSpotOrder(ctx context.Context, in *QuerySimulateSpotOrderRequest, opts ...grpc.CallOption) (*QuerySimulateSpotOrderResponse, error)
+	// Estimates the execution of an unsaved perpetual open order at the current
+	// state.
+	SimulatePerpetualOrder(ctx context.Context, in *QuerySimulatePerpetualOrderRequest, opts ...grpc.CallOption) (*QuerySimulatePerpetualOrderResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/elys.tradeshield.Query/Params", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryPendingPerpetualOrderForAddressRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) PendingSpotOrder(ctx context.Context, in *QueryGetPendingSpotOrderRequest, opts ...grpc.CallOption) (*QueryGetPendingSpotOrderResponse, error) {
+	out := new(QueryGetPendingSpotOrderResponse)
+	err := c.cc.Invoke(ctx, "/elys.tradeshield.Query/PendingSpotOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryPendingPerpetualOrderForAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Status != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) PendingSpotOrderAll(ctx context.Context, in *QueryAllPendingSpotOrderRequest, opts ...grpc.CallOption) (*QueryAllPendingSpotOrderResponse, error) {
+	out := new(QueryAllPendingSpotOrderResponse)
+	err := c.cc.Invoke(ctx, "/elys.tradeshield.Query/PendingSpotOrderAll", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryPendingPerpetualOrderForAddressResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) PendingPerpetualOrder(ctx context.Context, in *QueryGetPendingPerpetualOrderRequest, opts ...grpc.CallOption) (*QueryGetPendingPerpetualOrderResponse, error) {
+	out := new(QueryGetPendingPerpetualOrderResponse)
+	err := c.cc.Invoke(ctx, "/elys.tradeshield.Query/PendingPerpetualOrder", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryPendingPerpetualOrderForAddressResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) PendingPerpetualOrderAll(ctx context.Context, in *QueryAllPendingPerpetualOrderRequest, opts ...grpc.CallOption) (*QueryAllPendingPerpetualOrderResponse, error) {
+	out := new(QueryAllPendingPerpetualOrderResponse)
+	err := c.cc.Invoke(ctx, "/elys.tradeshield.Query/PendingPerpetualOrderAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryPendingPerpetualOrderForAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PendingPerpetualOrders) > 0 {
-		for iNdEx := len(m.PendingPerpetualOrders) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PendingPerpetualOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) PendingPerpetualOrderForAddress(ctx context.Context, in *QueryPendingPerpetualOrderForAddressRequest, opts ...grpc.CallOption) (*QueryPendingPerpetualOrderForAddressResponse, error) {
+	out := new(QueryPendingPerpetualOrderForAddressResponse)
+	err := c.cc.Invoke(ctx, "/elys.tradeshield.Query/PendingPerpetualOrderForAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryPendingSpotOrderForAddressRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) PendingSpotOrderForAddress(ctx context.Context, in *QueryPendingSpotOrderForAddressRequest, opts ...grpc.CallOption) (*QueryPendingSpotOrderForAddressResponse, error) {
+	out := new(QueryPendingSpotOrderForAddressResponse)
+	err := c.cc.Invoke(ctx, "/elys.tradeshield.Query/PendingSpotOrderForAddress", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryPendingSpotOrderForAddressRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) OrderGroup(ctx context.Context, in *QueryGetOrderGroupRequest, opts ...grpc.CallOption) (*QueryGetOrderGroupResponse, error) {
+	out := new(QueryGetOrderGroupResponse)
+	err := c.cc.Invoke(ctx, "/elys.tradeshield.Query/OrderGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryPendingSpotOrderForAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Status != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x10
+func (c *queryClient) ScheduledOrder(ctx context.Context, in *QueryGetScheduledOrderRequest, opts ...grpc.CallOption) (*QueryGetScheduledOrderResponse, error) {
+	out := new(QueryGetScheduledOrderResponse)
+	err := c.cc.Invoke(ctx, "/elys.tradeshield.Query/ScheduledOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *queryClient) SpotOrderBook(ctx context.Context, in *QuerySpotOrderBookRequest, opts ...grpc.CallOption) (*QuerySpotOrderBookResponse, error) {
+	out := new(QuerySpotOrderBookResponse)
+	err := c.cc.Invoke(ctx, "/elys.tradeshield.Query/SpotOrderBook", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryPendingSpotOrderForAddressResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) PerpetualOrderBook(ctx context.Context, in *QueryPerpetualOrderBookRequest, opts ...grpc.CallOption) (*QueryPerpetualOrderBookResponse, error) {
+	out := new(QueryPerpetualOrderBookResponse)
+	err := c.cc.Invoke(ctx, "/elys.tradeshield.Query/PerpetualOrderBook", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryPendingSpotOrderForAddressResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) SimulateSpotOrder(ctx context.Context, in *QuerySimulateSpotOrderRequest, opts ...grpc.CallOption) (*QuerySimulateSpotOrderResponse, error) {
+	out := new(QuerySimulateSpotOrderResponse)
+	err := c.cc.Invoke(ctx, "/elys.tradeshield.Query/SimulateSpotOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryPendingSpotOrderForAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PendingSpotOrders) > 0 {
-		for iNdEx := len(m.PendingSpotOrders) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PendingSpotOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) SimulatePerpetualOrder(ctx context.Context, in *QuerySimulatePerpetualOrderRequest, opts ...grpc.CallOption) (*QuerySimulatePerpetualOrderResponse, error) {
+	out := new(QuerySimulatePerpetualOrderResponse)
+	err := c.cc.Invoke(ctx, "/elys.tradeshield.Query/SimulatePerpetualOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Queries a list of PendingSpotOrder items.
+	PendingSpotOrder(context.Context, *QueryGetPendingSpotOrderRequest) (*QueryGetPendingSpotOrderResponse, error)
+	PendingSpotOrderAll(context.Context, *QueryAllPendingSpotOrderRequest) (*QueryAllPendingSpotOrderResponse, error)
+	// Queries a list of PendingPerpetualOrder items.
+	PendingPerpetualOrder(context.Context, *QueryGetPendingPerpetualOrderRequest) (*QueryGetPendingPerpetualOrderResponse, error)
+	PendingPerpetualOrderAll(context.Context, *QueryAllPendingPerpetualOrderRequest) (*QueryAllPendingPerpetualOrderResponse, error)
+	// Queries a list of PendingPerpetualOrderForAddress items.
+	PendingPerpetualOrderForAddress(context.Context, *QueryPendingPerpetualOrderForAddressRequest) (*QueryPendingPerpetualOrderForAddressResponse, error)
+	// Queries a list of PendingSpotOrderForAddress items.
+	PendingSpotOrderForAddress(context.Context, *QueryPendingSpotOrderForAddressRequest) (*QueryPendingSpotOrderForAddressResponse, error)
+	// Queries an order group and its pending orders.
+	OrderGroup(context.Context, *QueryGetOrderGroupRequest) (*QueryGetOrderGroupResponse, error)
+	// Queries a scheduled order and the records of its slices.
+	ScheduledOrder(context.Context, *QueryGetScheduledOrderRequest) (*QueryGetScheduledOrderResponse, error)
+	// Queries the pending spot limit orders of a denom pair aggregated by
+	// price level.
+	SpotOrderBook(context.Context, *QuerySpotOrderBookRequest) (*QuerySpotOrderBookResponse, error)
+	// Queries the pending perpetual orders of a trading asset aggregated by
+	// trigger price level.
+	PerpetualOrderBook(context.Context, *QueryPerpetualOrderBookRequest) (*QueryPerpetualOrderBookResponse, error)
+	// Estimates the execution of an unsaved spot order at the current state.
+	SimulateSpotOrder(context.Context, *QuerySimulateSpotOrderRequest) (*QuerySimulateSpotOrderResponse, error)
+	// Estimates the execution of an unsaved perpetual open order at the current
+	// state.
+	SimulatePerpetualOrder(context.Context, *QuerySimulatePerpetualOrderRequest) (*QuerySimulatePerpetualOrderResponse, error)
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QueryGetPendingSpotOrderRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
-	}
-	return n
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
-
-func (m *QueryGetPendingSpotOrderResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.PendingSpotOrder.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (*UnimplementedQueryServer) PendingSpotOrder(ctx context.Context, req *QueryGetPendingSpotOrderRequest) (*QueryGetPendingSpotOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingSpotOrder not implemented")
+}
+func (*UnimplementedQueryServer) PendingSpotOrderAll(ctx context.Context, req *QueryAllPendingSpotOrderRequest) (*QueryAllPendingSpotOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingSpotOrderAll not implemented")
+}
+func (*UnimplementedQueryServer) PendingPerpetualOrder(ctx context.Context, req *QueryGetPendingPerpetualOrderRequest) (*QueryGetPendingPerpetualOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingPerpetualOrder not implemented")
+}
+func (*UnimplementedQueryServer) PendingPerpetualOrderAll(ctx context.Context, req *QueryAllPendingPerpetualOrderRequest) (*QueryAllPendingPerpetualOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingPerpetualOrderAll not implemented")
+}
+func (*UnimplementedQueryServer) PendingPerpetualOrderForAddress(ctx context.Context, req *QueryPendingPerpetualOrderForAddressRequest) (*QueryPendingPerpetualOrderForAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingPerpetualOrderForAddress not implemented")
+}
+func (*UnimplementedQueryServer) PendingSpotOrderForAddress(ctx context.Context, req *QueryPendingSpotOrderForAddressRequest) (*QueryPendingSpotOrderForAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingSpotOrderForAddress not implemented")
+}
+func (*UnimplementedQueryServer) OrderGroup(ctx context.Context, req *QueryGetOrderGroupRequest) (*QueryGetOrderGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OrderGroup not implemented")
+}
+func (*UnimplementedQueryServer) ScheduledOrder(ctx context.Context, req *QueryGetScheduledOrderRequest) (*QueryGetScheduledOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduledOrder not implemented")
+}
+func (*UnimplementedQueryServer) SpotOrderBook(ctx context.Context, req *QuerySpotOrderBookRequest) (*QuerySpotOrderBookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SpotOrderBook not implemented")
+}
+func (*UnimplementedQueryServer) PerpetualOrderBook(ctx context.Context, req *QueryPerpetualOrderBookRequest) (*QueryPerpetualOrderBookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PerpetualOrderBook not implemented")
+}
+func (*UnimplementedQueryServer) SimulateSpotOrder(ctx context.Context, req *QuerySimulateSpotOrderRequest) (*QuerySimulateSpotOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateSpotOrder not implemented")
+}
+func (*UnimplementedQueryServer) SimulatePerpetualOrder(ctx context.Context, req *QuerySimulatePerpetualOrderRequest) (*QuerySimulatePerpetualOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulatePerpetualOrder not implemented")
 }
 
-func (m *QueryAllPendingSpotOrderRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *QueryAllPendingSpotOrderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.PendingSpotOrder) > 0 {
-		for _, e := range m.PendingSpotOrder {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elys.tradeshield.Query/Params",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetPendingPerpetualOrderRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_PendingSpotOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetPendingSpotOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
+	if interceptor == nil {
+		return srv.(QueryServer).PendingSpotOrder(ctx, in)
 	}
-	return n
-}
-
-func (m *QueryGetPendingPerpetualOrderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elys.tradeshield.Query/PendingSpotOrder",
 	}
-	var l int
-	_ = l
-	l = m.PendingPerpetualOrder.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingSpotOrder(ctx, req.(*QueryGetPendingSpotOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllPendingPerpetualOrderRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_PendingSpotOrderAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllPendingSpotOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).PendingSpotOrderAll(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elys.tradeshield.Query/PendingSpotOrderAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingSpotOrderAll(ctx, req.(*QueryAllPendingSpotOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllPendingPerpetualOrderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_PendingPerpetualOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetPendingPerpetualOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.PendingPerpetualOrder) > 0 {
-		for _, e := range m.PendingPerpetualOrder {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingPerpetualOrder(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elys.tradeshield.Query/PendingPerpetualOrder",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingPerpetualOrder(ctx, req.(*QueryGetPendingPerpetualOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPendingPerpetualOrderForAddressRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_PendingPerpetualOrderAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllPendingPerpetualOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).PendingPerpetualOrderAll(ctx, in)
 	}
-	if m.Status != 0 {
-		n += 1 + sovQuery(uint64(m.Status))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elys.tradeshield.Query/PendingPerpetualOrderAll",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingPerpetualOrderAll(ctx, req.(*QueryAllPendingPerpetualOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPendingPerpetualOrderForAddressResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_PendingPerpetualOrderForAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingPerpetualOrderForAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.PendingPerpetualOrders) > 0 {
-		for _, e := range m.PendingPerpetualOrders {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingPerpetualOrderForAddress(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elys.tradeshield.Query/PendingPerpetualOrderForAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingPerpetualOrderForAddress(ctx, req.(*QueryPendingPerpetualOrderForAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPendingSpotOrderForAddressRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_PendingSpotOrderForAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingSpotOrderForAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).PendingSpotOrderForAddress(ctx, in)
 	}
-	if m.Status != 0 {
-		n += 1 + sovQuery(uint64(m.Status))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elys.tradeshield.Query/PendingSpotOrderForAddress",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingSpotOrderForAddress(ctx, req.(*QueryPendingSpotOrderForAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPendingSpotOrderForAddressResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_OrderGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetOrderGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OrderGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elys.tradeshield.Query/OrderGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OrderGroup(ctx, req.(*QueryGetOrderGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ScheduledOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetScheduledOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScheduledOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elys.tradeshield.Query/ScheduledOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScheduledOrder(ctx, req.(*QueryGetScheduledOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SpotOrderBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySpotOrderBookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SpotOrderBook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elys.tradeshield.Query/SpotOrderBook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SpotOrderBook(ctx, req.(*QuerySpotOrderBookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PerpetualOrderBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPerpetualOrderBookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PerpetualOrderBook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elys.tradeshield.Query/PerpetualOrderBook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PerpetualOrderBook(ctx, req.(*QueryPerpetualOrderBookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulateSpotOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateSpotOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateSpotOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elys.tradeshield.Query/SimulateSpotOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateSpotOrder(ctx, req.(*QuerySimulateSpotOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulatePerpetualOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulatePerpetualOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulatePerpetualOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elys.tradeshield.Query/SimulatePerpetualOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulatePerpetualOrder(ctx, req.(*QuerySimulatePerpetualOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "elys.tradeshield.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "PendingSpotOrder",
+			Handler:    _Query_PendingSpotOrder_Handler,
+		},
+		{
+			MethodName: "PendingSpotOrderAll",
+			Handler:    _Query_PendingSpotOrderAll_Handler,
+		},
+		{
+			MethodName: "PendingPerpetualOrder",
+			Handler:    _Query_PendingPerpetualOrder_Handler,
+		},
+		{
+			MethodName: "PendingPerpetualOrderAll",
+			Handler:    _Query_PendingPerpetualOrderAll_Handler,
+		},
+		{
+			MethodName: "PendingPerpetualOrderForAddress",
+			Handler:    _Query_PendingPerpetualOrderForAddress_Handler,
+		},
+		{
+			MethodName: "PendingSpotOrderForAddress",
+			Handler:    _Query_PendingSpotOrderForAddress_Handler,
+		},
+		{
+			MethodName: "OrderGroup",
+			Handler:    _Query_OrderGroup_Handler,
+		},
+		{
+			MethodName: "ScheduledOrder",
+			Handler:    _Query_ScheduledOrder_Handler,
+		},
+		{
+			MethodName: "SpotOrderBook",
+			Handler:    _Query_SpotOrderBook_Handler,
+		},
+		{
+			MethodName: "PerpetualOrderBook",
+			Handler:    _Query_PerpetualOrderBook_Handler,
+		},
+		{
+			MethodName: "SimulateSpotOrder",
+			Handler:    _Query_SimulateSpotOrder_Handler,
+		},
+		{
+			MethodName: "SimulatePerpetualOrder",
+			Handler:    _Query_SimulatePerpetualOrder_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "elys/tradeshield/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.PendingSpotOrders) > 0 {
-		for _, e := range m.PendingSpotOrders {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryGetPendingSpotOrderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QueryGetPendingSpotOrderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+
+func (m *QueryGetPendingSpotOrderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPendingSpotOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPendingSpotOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPendingSpotOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PendingSpotOrder.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllPendingSpotOrderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllPendingSpotOrderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllPendingSpotOrderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllPendingSpotOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllPendingSpotOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllPendingSpotOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PendingSpotOrder) > 0 {
+		for iNdEx := len(m.PendingSpotOrder) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingSpotOrder[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPendingPerpetualOrderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPendingPerpetualOrderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPendingPerpetualOrderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPendingPerpetualOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPendingPerpetualOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPendingPerpetualOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PendingPerpetualOrder.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllPendingPerpetualOrderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllPendingPerpetualOrderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllPendingPerpetualOrderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllPendingPerpetualOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllPendingPerpetualOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllPendingPerpetualOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PendingPerpetualOrder) > 0 {
+		for iNdEx := len(m.PendingPerpetualOrder) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingPerpetualOrder[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingPerpetualOrderForAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingPerpetualOrderForAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingPerpetualOrderForAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingPerpetualOrderForAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingPerpetualOrderForAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingPerpetualOrderForAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PendingPerpetualOrders) > 0 {
+		for iNdEx := len(m.PendingPerpetualOrders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingPerpetualOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingSpotOrderForAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingSpotOrderForAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingSpotOrderForAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingSpotOrderForAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingSpotOrderForAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingSpotOrderForAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PendingSpotOrders) > 0 {
+		for iNdEx := len(m.PendingSpotOrders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingSpotOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetOrderGroupRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetOrderGroupRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetOrderGroupRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetOrderGroupResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetOrderGroupResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetOrderGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PerpetualOrders) > 0 {
+		for iNdEx := len(m.PerpetualOrders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PerpetualOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.SpotOrders) > 0 {
+		for iNdEx := len(m.SpotOrders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpotOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.OrderGroup.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetScheduledOrderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetScheduledOrderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetScheduledOrderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetScheduledOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetScheduledOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetScheduledOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Slices) > 0 {
+		for iNdEx := len(m.Slices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Slices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.ScheduledOrder.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *OrderBookLevel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrderBookLevel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrderBookLevel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.OrderCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OrderCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySpotOrderBookRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySpotOrderBookRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySpotOrderBookRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.BucketSize.Size()
+		i -= size
+		if _, err := m.BucketSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Side != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Side))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.QuoteDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySpotOrderBookResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySpotOrderBookResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySpotOrderBookResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Levels) > 0 {
+		for iNdEx := len(m.Levels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Levels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPerpetualOrderBookRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPerpetualOrderBookRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPerpetualOrderBookRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.BucketSize.Size()
+		i -= size
+		if _, err := m.BucketSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.OrderType != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OrderType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Position != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Position))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TradingAsset) > 0 {
+		i -= len(m.TradingAsset)
+		copy(dAtA[i:], m.TradingAsset)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TradingAsset)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPerpetualOrderBookResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPerpetualOrderBookResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPerpetualOrderBookResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Levels) > 0 {
+		for iNdEx := len(m.Levels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Levels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateSpotOrderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateSpotOrderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateSpotOrderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OrderTargetDenom) > 0 {
+		i -= len(m.OrderTargetDenom)
+		copy(dAtA[i:], m.OrderTargetDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OrderTargetDenom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size, err := m.OrderAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.OrderPrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.OrderType != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OrderType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateSpotOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateSpotOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateSpotOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FillAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if m.Executable {
+		i--
+		if m.Executable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	{
+		size := m.MarketPrice.Size()
+		i -= size
+		if _, err := m.MarketPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.WeightBalanceRatio.Size()
+		i -= size
+		if _, err := m.WeightBalanceRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.PriceImpact.Size()
+		i -= size
+		if _, err := m.PriceImpact.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Slippage.Size()
+		i -= size
+		if _, err := m.Slippage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Discount.Size()
+		i -= size
+		if _, err := m.Discount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.SwapFee.Size()
+		i -= size
+		if _, err := m.SwapFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.SpotPrice.Size()
+		i -= size
+		if _, err := m.SpotPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.AmountOut.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulatePerpetualOrderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulatePerpetualOrderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulatePerpetualOrderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.TakeProfitPrice.Size()
+		i -= size
+		if _, err := m.TakeProfitPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.TriggerPrice.Size()
+		i -= size
+		if _, err := m.TriggerPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.Collateral.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.TradingAsset) > 0 {
+		i -= len(m.TradingAsset)
+		copy(dAtA[i:], m.TradingAsset)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TradingAsset)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.Leverage.Size()
+		i -= size
+		if _, err := m.Leverage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Position != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Position))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulatePerpetualOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulatePerpetualOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulatePerpetualOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Executable {
+		i--
+		if m.Executable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x78
+	}
+	{
+		size := m.MarketPrice.Size()
+		i -= size
+		if _, err := m.MarketPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	{
+		size, err := m.AvailableLiquidity.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	{
+		size := m.HourlyInterestRate.Size()
+		i -= size
+		if _, err := m.HourlyInterestRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		size := m.BorrowInterestRate.Size()
+		i -= size
+		if _, err := m.BorrowInterestRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.FundingRate.Size()
+		i -= size
+		if _, err := m.FundingRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.WeightBreakingFee.Size()
+		i -= size
+		if _, err := m.WeightBreakingFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.PriceImpact.Size()
+		i -= size
+		if _, err := m.PriceImpact.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.Slippage.Size()
+		i -= size
+		if _, err := m.Slippage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.Liabilities.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.Custody.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.EffectiveLeverage.Size()
+		i -= size
+		if _, err := m.EffectiveLeverage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.LiquidationPrice.Size()
+		i -= size
+		if _, err := m.LiquidationPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.OpenPrice.Size()
+		i -= size
+		if _, err := m.OpenPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.PositionSize.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetPendingSpotOrderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryGetPendingSpotOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PendingSpotOrder.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllPendingSpotOrderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllPendingSpotOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PendingSpotOrder) > 0 {
+		for _, e := range m.PendingSpotOrder {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetPendingPerpetualOrderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryGetPendingPerpetualOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PendingPerpetualOrder.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllPendingPerpetualOrderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllPendingPerpetualOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PendingPerpetualOrder) > 0 {
+		for _, e := range m.PendingPerpetualOrder {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingPerpetualOrderForAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *QueryPendingPerpetualOrderForAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PendingPerpetualOrders) > 0 {
+		for _, e := range m.PendingPerpetualOrders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPendingSpotOrderForAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *QueryPendingSpotOrderForAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PendingSpotOrders) > 0 {
+		for _, e := range m.PendingSpotOrders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetOrderGroupRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryGetOrderGroupResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.OrderGroup.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.SpotOrders) > 0 {
+		for _, e := range m.SpotOrders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.PerpetualOrders) > 0 {
+		for _, e := range m.PerpetualOrders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetScheduledOrderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryGetScheduledOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ScheduledOrder.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Slices) > 0 {
+		for _, e := range m.Slices {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *OrderBookLevel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Price.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.OrderCount != 0 {
+		n += 1 + sovQuery(uint64(m.OrderCount))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySpotOrderBookRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Side != 0 {
+		n += 1 + sovQuery(uint64(m.Side))
+	}
+	l = m.BucketSize.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySpotOrderBookResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Levels) > 0 {
+		for _, e := range m.Levels {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPerpetualOrderBookRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TradingAsset)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Position != 0 {
+		n += 1 + sovQuery(uint64(m.Position))
+	}
+	if m.OrderType != 0 {
+		n += 1 + sovQuery(uint64(m.OrderType))
+	}
+	l = m.BucketSize.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPerpetualOrderBookResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Levels) > 0 {
+		for _, e := range m.Levels {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySimulateSpotOrderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.OrderType != 0 {
+		n += 1 + sovQuery(uint64(m.OrderType))
+	}
+	l = m.OrderPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.OrderAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.OrderTargetDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySimulateSpotOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AmountOut.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.SpotPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.SwapFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Discount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Slippage.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.PriceImpact.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.WeightBalanceRatio.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MarketPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Executable {
+		n += 2
+	}
+	l = m.FillAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySimulatePerpetualOrderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Position != 0 {
+		n += 1 + sovQuery(uint64(m.Position))
+	}
+	l = m.Leverage.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.TradingAsset)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Collateral.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TriggerPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TakeProfitPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QuerySimulatePerpetualOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PositionSize.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.OpenPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.LiquidationPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.EffectiveLeverage.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Custody.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Liabilities.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Slippage.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.PriceImpact.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.WeightBreakingFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.FundingRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.BorrowInterestRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.HourlyInterestRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.AvailableLiquidity.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MarketPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Executable {
+		n += 2
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPendingSpotOrderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPendingSpotOrderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPendingSpotOrderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPendingSpotOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPendingSpotOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPendingSpotOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingSpotOrder", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PendingSpotOrder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllPendingSpotOrderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllPendingSpotOrderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllPendingSpotOrderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllPendingSpotOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllPendingSpotOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllPendingSpotOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingSpotOrder", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingSpotOrder = append(m.PendingSpotOrder, SpotOrder{})
+			if err := m.PendingSpotOrder[len(m.PendingSpotOrder)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPendingPerpetualOrderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPendingPerpetualOrderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPendingPerpetualOrderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPendingPerpetualOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPendingPerpetualOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPendingPerpetualOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingPerpetualOrder", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PendingPerpetualOrder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllPendingPerpetualOrderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllPendingPerpetualOrderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllPendingPerpetualOrderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllPendingPerpetualOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllPendingPerpetualOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllPendingPerpetualOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingPerpetualOrder", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingPerpetualOrder = append(m.PendingPerpetualOrder, PerpetualOrderExtraInfo{})
+			if err := m.PendingPerpetualOrder[len(m.PendingPerpetualOrder)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingPerpetualOrderForAddressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingPerpetualOrderForAddressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingPerpetualOrderForAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= Status(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingPerpetualOrderForAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingPerpetualOrderForAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingPerpetualOrderForAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingPerpetualOrders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingPerpetualOrders = append(m.PendingPerpetualOrders, PerpetualOrderExtraInfo{})
+			if err := m.PendingPerpetualOrders[len(m.PendingPerpetualOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingSpotOrderForAddressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingSpotOrderForAddressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingSpotOrderForAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= Status(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingSpotOrderForAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingSpotOrderForAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingSpotOrderForAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingSpotOrders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingSpotOrders = append(m.PendingSpotOrders, SpotOrder{})
+			if err := m.PendingSpotOrders[len(m.PendingSpotOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetOrderGroupRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetOrderGroupRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetOrderGroupRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetOrderGroupResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetOrderGroupResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetOrderGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderGroup", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OrderGroup.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpotOrders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpotOrders = append(m.SpotOrders, SpotOrder{})
+			if err := m.SpotOrders[len(m.SpotOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerpetualOrders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PerpetualOrders = append(m.PerpetualOrders, PerpetualOrderExtraInfo{})
+			if err := m.PerpetualOrders[len(m.PerpetualOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetScheduledOrderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetScheduledOrderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetScheduledOrderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetScheduledOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetScheduledOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetScheduledOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledOrder", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ScheduledOrder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Slices = append(m.Slices, ScheduledOrderSlice{})
+			if err := m.Slices[len(m.Slices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OrderBookLevel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OrderBookLevel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OrderBookLevel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderCount", wireType)
+			}
+			m.OrderCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySpotOrderBookRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySpotOrderBookRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySpotOrderBookRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Side", wireType)
+			}
+			m.Side = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Side |= OrderBookSide(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketSize", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BucketSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySpotOrderBookResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySpotOrderBookResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySpotOrderBookResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Levels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Levels = append(m.Levels, OrderBookLevel{})
+			if err := m.Levels[len(m.Levels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPerpetualOrderBookRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPerpetualOrderBookRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPerpetualOrderBookRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradingAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TradingAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+			}
+			m.Position = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Position |= PerpetualPosition(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderType", wireType)
+			}
+			m.OrderType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderType |= PerpetualOrderType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketSize", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BucketSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPerpetualOrderBookResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPerpetualOrderBookResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPerpetualOrderBookResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Levels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Levels = append(m.Levels, OrderBookLevel{})
+			if err := m.Levels[len(m.Levels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySimulateSpotOrderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySimulateSpotOrderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySimulateSpotOrderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderType", wireType)
+			}
+			m.OrderType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderType |= SpotOrderType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OrderPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OrderAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderTargetDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderTargetDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1815,7 +6730,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySimulateSpotOrderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1838,15 +6753,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySimulateSpotOrderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySimulateSpotOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountOut", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1873,65 +6788,219 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AmountOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpotPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SpotPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGetPendingSpotOrderRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			if err := m.SwapFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Discount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Discount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetPendingSpotOrderRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetPendingSpotOrderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slippage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Slippage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceImpact", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PriceImpact.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightBalanceRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WeightBalanceRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Id = 0
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketPrice", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1941,64 +7010,49 @@ func (m *QueryGetPendingSpotOrderRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGetPendingSpotOrderResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			if err := m.MarketPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executable", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetPendingSpotOrderResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetPendingSpotOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Executable = bool(v != 0)
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingSpotOrder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FillAmount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2025,7 +7079,7 @@ func (m *QueryGetPendingSpotOrderResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PendingSpotOrder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FillAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2050,7 +7104,7 @@ func (m *QueryGetPendingSpotOrderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllPendingSpotOrderRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySimulatePerpetualOrderRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2073,17 +7127,17 @@ func (m *QueryAllPendingSpotOrderRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllPendingSpotOrderRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySimulatePerpetualOrderRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllPendingSpotOrderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySimulatePerpetualOrderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2093,83 +7147,82 @@ func (m *QueryAllPendingSpotOrderRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Position = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Position |= PerpetualPosition(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leverage", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryAllPendingSpotOrderResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Leverage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllPendingSpotOrderResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllPendingSpotOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingSpotOrder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TradingAsset", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2179,29 +7232,27 @@ func (m *QueryAllPendingSpotOrderResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PendingSpotOrder = append(m.PendingSpotOrder, SpotOrder{})
-			if err := m.PendingSpotOrder[len(m.PendingSpotOrder)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TradingAsset = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2228,68 +7279,83 @@ func (m *QueryAllPendingSpotOrderResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Collateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerPrice", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGetPendingPerpetualOrderRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			if err := m.TriggerPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakeProfitPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.TakeProfitPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetPendingPerpetualOrderRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetPendingPerpetualOrderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			m.Id = 0
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2299,7 +7365,7 @@ func (m *QueryGetPendingPerpetualOrderRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2325,7 +7391,7 @@ func (m *QueryGetPendingPerpetualOrderRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetPendingPerpetualOrderResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySimulatePerpetualOrderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2348,15 +7414,15 @@ func (m *QueryGetPendingPerpetualOrderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetPendingPerpetualOrderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySimulatePerpetualOrderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetPendingPerpetualOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySimulatePerpetualOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingPerpetualOrder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionSize", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2383,65 +7449,49 @@ func (m *QueryGetPendingPerpetualOrderResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PendingPerpetualOrder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PositionSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenPrice", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryAllPendingPerpetualOrderRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.OpenPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllPendingPerpetualOrderRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllPendingPerpetualOrderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationPrice", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2451,81 +7501,63 @@ func (m *QueryAllPendingPerpetualOrderRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LiquidationPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveLeverage", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryAllPendingPerpetualOrderResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.EffectiveLeverage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllPendingPerpetualOrderResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllPendingPerpetualOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingPerpetualOrder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Custody", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2552,14 +7584,13 @@ func (m *QueryAllPendingPerpetualOrderResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PendingPerpetualOrder = append(m.PendingPerpetualOrder, PerpetualOrderExtraInfo{})
-			if err := m.PendingPerpetualOrder[len(m.PendingPerpetualOrder)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Custody.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Liabilities", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2586,66 +7617,47 @@ func (m *QueryAllPendingPerpetualOrderResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Liabilities.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slippage", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryPendingPerpetualOrderForAddressRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Slippage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPendingPerpetualOrderForAddressRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPendingPerpetualOrderForAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceImpact", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2673,13 +7685,15 @@ func (m *QueryPendingPerpetualOrderForAddressRequest) Unmarshal(dAtA []byte) err
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			if err := m.PriceImpact.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightBreakingFee", wireType)
 			}
-			m.Status = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2689,66 +7703,31 @@ func (m *QueryPendingPerpetualOrderForAddressRequest) Unmarshal(dAtA []byte) err
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= Status(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryPendingPerpetualOrderForAddressResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.WeightBreakingFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPendingPerpetualOrderForAddressResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPendingPerpetualOrderForAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingPerpetualOrders", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingRate", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2758,79 +7737,63 @@ func (m *QueryPendingPerpetualOrderForAddressResponse) Unmarshal(dAtA []byte) er
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PendingPerpetualOrders = append(m.PendingPerpetualOrders, PerpetualOrderExtraInfo{})
-			if err := m.PendingPerpetualOrders[len(m.PendingPerpetualOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FundingRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BorrowInterestRate", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryPendingSpotOrderForAddressRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.BorrowInterestRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPendingSpotOrderForAddressRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPendingSpotOrderForAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HourlyInterestRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2858,13 +7821,15 @@ func (m *QueryPendingSpotOrderForAddressRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			if err := m.HourlyInterestRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AvailableLiquidity", wireType)
 			}
-			m.Status = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2874,66 +7839,30 @@ func (m *QueryPendingSpotOrderForAddressRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= Status(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryPendingSpotOrderForAddressResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.AvailableLiquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPendingSpotOrderForAddressResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPendingSpotOrderForAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingSpotOrders", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketPrice", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2943,26 +7872,46 @@ func (m *QueryPendingSpotOrderForAddressResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PendingSpotOrders = append(m.PendingSpotOrders, SpotOrder{})
-			if err := m.PendingSpotOrders[len(m.PendingSpotOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MarketPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Executable = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -0,0 +1,48 @@
+package types
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// MaxScheduledOrderSlices bounds the slices a scheduled order is split into
+const MaxScheduledOrderSlices = 1000
+
+func GetScheduledOrderAddress(orderId uint64) sdk.AccAddress {
+	return authtypes.NewModuleAddress(fmt.Sprintf("tradeshield/scheduled/%d", orderId))
+}
+
+// Get Scheduled Order address
+func (o ScheduledOrder) GetOrderAddress() sdk.AccAddress {
+	return GetScheduledOrderAddress(o.Id)
+}
+
+// RemainingSlices returns the number of slices left to execute
+func (o ScheduledOrder) RemainingSlices() uint64 {
+	if o.ExecutedSlices >= o.SliceCount {
+		return 0
+	}
+	return o.SliceCount - o.ExecutedSlices
+}
+
+// NextSliceAmount spreads the amount left to swap over the remaining slices,
+// the last slice swaps everything that is left
+func (o ScheduledOrder) NextSliceAmount() sdk.Coin {
+	slices := o.RemainingSlices()
+	if slices <= 1 {
+		return o.RemainingAmount
+	}
+	return sdk.NewCoin(o.RemainingAmount.Denom, o.RemainingAmount.Amount.Quo(sdkmath.NewIntFromUint64(slices)))
+}
+
+// Now returns the current block height or timestamp, in the unit of the
+// interval of the order
+func (u ScheduleIntervalUnit) Now(height, timestamp uint64) uint64 {
+	if u == ScheduleIntervalUnit_SECONDS {
+		return timestamp
+	}
+	return height
+}
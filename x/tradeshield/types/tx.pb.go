@@ -39,6 +39,11 @@ type MsgCreateSpotOrder struct {
 	OrderAmount      types.Coin    `protobuf:"bytes,3,opt,name=order_amount,json=orderAmount,proto3" json:"order_amount"`
 	OwnerAddress     string        `protobuf:"bytes,4,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
 	OrderTargetDenom string        `protobuf:"bytes,5,opt,name=order_target_denom,json=orderTargetDenom,proto3" json:"order_target_denom,omitempty"`
+	TimeInForce      TimeInForce   `protobuf:"varint,6,opt,name=time_in_force,json=timeInForce,proto3,enum=elys.tradeshield.TimeInForce" json:"time_in_force,omitempty"`
+	// Height and/or timestamp at which a GTT order expires
+	Expiry *Date `protobuf:"bytes,7,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	// Trail of TRAILINGSTOP orders, their rate is set from the market price
+	TrailingStop *TrailingStop `protobuf:"bytes,8,opt,name=trailing_stop,json=trailingStop,proto3" json:"trailing_stop,omitempty"`
 }
 
 func (m *MsgCreateSpotOrder) Reset()         { *m = MsgCreateSpotOrder{} }
@@ -109,6 +114,27 @@ func (m *MsgCreateSpotOrder) GetOrderTargetDenom() string {
 	return ""
 }
 
+func (m *MsgCreateSpotOrder) GetTimeInForce() TimeInForce {
+	if m != nil {
+		return m.TimeInForce
+	}
+	return TimeInForce_GTC
+}
+
+func (m *MsgCreateSpotOrder) GetExpiry() *Date {
+	if m != nil {
+		return m.Expiry
+	}
+	return nil
+}
+
+func (m *MsgCreateSpotOrder) GetTrailingStop() *TrailingStop {
+	if m != nil {
+		return m.TrailingStop
+	}
+	return nil
+}
+
 type MsgCreateSpotOrderResponse struct {
 	OrderId uint64 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
 }
@@ -443,6 +469,12 @@ type MsgCreatePerpetualOpenOrder struct {
 	TakeProfitPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=take_profit_price,json=takeProfitPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"take_profit_price"`
 	StopLossPrice   cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=stop_loss_price,json=stopLossPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"stop_loss_price"`
 	PoolId          uint64                      `protobuf:"varint,9,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	TimeInForce     TimeInForce                 `protobuf:"varint,10,opt,name=time_in_force,json=timeInForce,proto3,enum=elys.tradeshield.TimeInForce" json:"time_in_force,omitempty"`
+	// Height and/or timestamp at which a GTT order expires
+	Expiry *Date `protobuf:"bytes,11,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	// Take profit and stop loss close orders created once the position is
+	// opened
+	BracketOrders []BracketOrder `protobuf:"bytes,12,rep,name=bracket_orders,json=bracketOrders,proto3" json:"bracket_orders"`
 }
 
 func (m *MsgCreatePerpetualOpenOrder) Reset()         { *m = MsgCreatePerpetualOpenOrder{} }
@@ -520,6 +552,27 @@ func (m *MsgCreatePerpetualOpenOrder) GetPoolId() uint64 {
 	return 0
 }
 
+func (m *MsgCreatePerpetualOpenOrder) GetTimeInForce() TimeInForce {
+	if m != nil {
+		return m.TimeInForce
+	}
+	return TimeInForce_GTC
+}
+
+func (m *MsgCreatePerpetualOpenOrder) GetExpiry() *Date {
+	if m != nil {
+		return m.Expiry
+	}
+	return nil
+}
+
+func (m *MsgCreatePerpetualOpenOrder) GetBracketOrders() []BracketOrder {
+	if m != nil {
+		return m.BracketOrders
+	}
+	return nil
+}
+
 type MsgCreatePerpetualOpenOrderResponse struct {
 	OrderId uint64 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
 }
@@ -568,6 +621,16 @@ type MsgCreatePerpetualCloseOrder struct {
 	OwnerAddress string       `protobuf:"bytes,1,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
 	TriggerPrice TriggerPrice `protobuf:"bytes,2,opt,name=trigger_price,json=triggerPrice,proto3" json:"trigger_price"`
 	PositionId   uint64       `protobuf:"varint,3,opt,name=position_id,json=positionId,proto3" json:"position_id,omitempty"`
+	// LIMITCLOSE, STOPLOSSPERP or TRAILINGSTOPPERP
+	OrderType PerpetualOrderType `protobuf:"varint,4,opt,name=order_type,json=orderType,proto3,enum=elys.tradeshield.PerpetualOrderType" json:"order_type,omitempty"`
+	// Amount of the position to close, zero closes the whole position
+	CloseAmount cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=close_amount,json=closeAmount,proto3,customtype=cosmossdk.io/math.Int" json:"close_amount"`
+	TimeInForce TimeInForce           `protobuf:"varint,6,opt,name=time_in_force,json=timeInForce,proto3,enum=elys.tradeshield.TimeInForce" json:"time_in_force,omitempty"`
+	// Height and/or timestamp at which a GTT order expires
+	Expiry *Date `protobuf:"bytes,7,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	// Trail of TRAILINGSTOPPERP orders, their trigger price is set from the
+	// market price
+	TrailingStop *TrailingStop `protobuf:"bytes,8,opt,name=trailing_stop,json=trailingStop,proto3" json:"trailing_stop,omitempty"`
 }
 
 func (m *MsgCreatePerpetualCloseOrder) Reset()         { *m = MsgCreatePerpetualCloseOrder{} }
@@ -624,6 +687,34 @@ func (m *MsgCreatePerpetualCloseOrder) GetPositionId() uint64 {
 	return 0
 }
 
+func (m *MsgCreatePerpetualCloseOrder) GetOrderType() PerpetualOrderType {
+	if m != nil {
+		return m.OrderType
+	}
+	return PerpetualOrderType_LIMITOPEN
+}
+
+func (m *MsgCreatePerpetualCloseOrder) GetTimeInForce() TimeInForce {
+	if m != nil {
+		return m.TimeInForce
+	}
+	return TimeInForce_GTC
+}
+
+func (m *MsgCreatePerpetualCloseOrder) GetExpiry() *Date {
+	if m != nil {
+		return m.Expiry
+	}
+	return nil
+}
+
+func (m *MsgCreatePerpetualCloseOrder) GetTrailingStop() *TrailingStop {
+	if m != nil {
+		return m.TrailingStop
+	}
+	return nil
+}
+
 type MsgCreatePerpetualCloseOrderResponse struct {
 	OrderId uint64 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
 }
@@ -1135,1179 +1226,1148 @@ func (m *MsgExecuteOrdersResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgExecuteOrdersResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgCreateSpotOrder)(nil), "elys.tradeshield.MsgCreateSpotOrder")
-	proto.RegisterType((*MsgCreateSpotOrderResponse)(nil), "elys.tradeshield.MsgCreateSpotOrderResponse")
-	proto.RegisterType((*MsgUpdateSpotOrder)(nil), "elys.tradeshield.MsgUpdateSpotOrder")
-	proto.RegisterType((*MsgUpdateSpotOrderResponse)(nil), "elys.tradeshield.MsgUpdateSpotOrderResponse")
-	proto.RegisterType((*MsgCancelSpotOrder)(nil), "elys.tradeshield.MsgCancelSpotOrder")
-	proto.RegisterType((*MsgCancelSpotOrderResponse)(nil), "elys.tradeshield.MsgCancelSpotOrderResponse")
-	proto.RegisterType((*MsgCancelSpotOrders)(nil), "elys.tradeshield.MsgCancelSpotOrders")
-	proto.RegisterType((*MsgCancelSpotOrdersResponse)(nil), "elys.tradeshield.MsgCancelSpotOrdersResponse")
-	proto.RegisterType((*MsgCreatePerpetualOpenOrder)(nil), "elys.tradeshield.MsgCreatePerpetualOpenOrder")
-	proto.RegisterType((*MsgCreatePerpetualOpenOrderResponse)(nil), "elys.tradeshield.MsgCreatePerpetualOpenOrderResponse")
-	proto.RegisterType((*MsgCreatePerpetualCloseOrder)(nil), "elys.tradeshield.MsgCreatePerpetualCloseOrder")
-	proto.RegisterType((*MsgCreatePerpetualCloseOrderResponse)(nil), "elys.tradeshield.MsgCreatePerpetualCloseOrderResponse")
-	proto.RegisterType((*MsgUpdatePerpetualOrder)(nil), "elys.tradeshield.MsgUpdatePerpetualOrder")
-	proto.RegisterType((*MsgUpdatePerpetualOrderResponse)(nil), "elys.tradeshield.MsgUpdatePerpetualOrderResponse")
-	proto.RegisterType((*MsgCancelPerpetualOrder)(nil), "elys.tradeshield.MsgCancelPerpetualOrder")
-	proto.RegisterType((*MsgCancelPerpetualOrderResponse)(nil), "elys.tradeshield.MsgCancelPerpetualOrderResponse")
-	proto.RegisterType((*MsgCancelPerpetualOrders)(nil), "elys.tradeshield.MsgCancelPerpetualOrders")
-	proto.RegisterType((*MsgCancelPerpetualOrdersResponse)(nil), "elys.tradeshield.MsgCancelPerpetualOrdersResponse")
-	proto.RegisterType((*MsgUpdateParams)(nil), "elys.tradeshield.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "elys.tradeshield.MsgUpdateParamsResponse")
-	proto.RegisterType((*MsgExecuteOrders)(nil), "elys.tradeshield.MsgExecuteOrders")
-	proto.RegisterType((*MsgExecuteOrdersResponse)(nil), "elys.tradeshield.MsgExecuteOrdersResponse")
+type MsgCreateOrderGroup struct {
+	OwnerAddress      string   `protobuf:"bytes,1,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
+	SpotOrderIds      []uint64 `protobuf:"varint,2,rep,packed,name=spot_order_ids,json=spotOrderIds,proto3" json:"spot_order_ids,omitempty"`
+	PerpetualOrderIds []uint64 `protobuf:"varint,3,rep,packed,name=perpetual_order_ids,json=perpetualOrderIds,proto3" json:"perpetual_order_ids,omitempty"`
 }
 
-func init() { proto.RegisterFile("elys/tradeshield/tx.proto", fileDescriptor_0534a5eaf85a8c0b) }
-
-var fileDescriptor_0534a5eaf85a8c0b = []byte{
-	// 1277 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x4f, 0x4f, 0x1b, 0xc7,
-	0x1b, 0x66, 0x31, 0x01, 0xfc, 0x06, 0x02, 0xd9, 0xf0, 0x13, 0xf6, 0x42, 0x6c, 0xb2, 0x10, 0xfd,
-	0x00, 0xc5, 0x6b, 0x70, 0x95, 0x54, 0x72, 0xd3, 0xa4, 0xfc, 0xe9, 0x81, 0x2a, 0x28, 0xc8, 0x49,
-	0x0f, 0xad, 0x5a, 0x59, 0x8b, 0x77, 0xba, 0xac, 0xb0, 0x77, 0x56, 0x3b, 0xc3, 0x1f, 0xdf, 0xd2,
-	0xf6, 0x50, 0xa9, 0xa7, 0x7e, 0x85, 0x1e, 0x7a, 0xea, 0xa1, 0x48, 0xcd, 0x87, 0x88, 0xd4, 0x4b,
-	0x94, 0x53, 0xd4, 0x43, 0x54, 0xc1, 0x01, 0xa9, 0x1f, 0xa1, 0xa7, 0x6a, 0x66, 0x76, 0x17, 0xef,
-	0x1f, 0x9b, 0x85, 0x36, 0x51, 0x2f, 0xe0, 0x9d, 0xf7, 0x99, 0xe7, 0x7d, 0x9f, 0xe7, 0x9d, 0x9d,
-	0x19, 0x1b, 0xf2, 0xa8, 0xd9, 0x26, 0x65, 0xea, 0xea, 0x06, 0x22, 0x3b, 0x16, 0x6a, 0x1a, 0x65,
-	0x7a, 0xa8, 0x39, 0x2e, 0xa6, 0x58, 0x1e, 0x67, 0x21, 0xad, 0x23, 0xa4, 0x4c, 0x98, 0xd8, 0xc4,
-	0x3c, 0x58, 0x66, 0x9f, 0x04, 0x4e, 0x99, 0x8e, 0x51, 0x60, 0xd7, 0x40, 0xae, 0x17, 0xbd, 0x19,
-	0x8b, 0x3a, 0xba, 0xab, 0xb7, 0x88, 0x17, 0x2e, 0x34, 0x30, 0x69, 0x61, 0x52, 0xde, 0xd6, 0x09,
-	0x2a, 0xef, 0x2f, 0x6f, 0x23, 0xaa, 0x2f, 0x97, 0x1b, 0xd8, 0xb2, 0xbd, 0xf8, 0xa4, 0x17, 0x6f,
-	0x11, 0xb3, 0xbc, 0xbf, 0xcc, 0xfe, 0x79, 0x81, 0xeb, 0x7a, 0xcb, 0xb2, 0x71, 0x99, 0xff, 0xf5,
-	0x86, 0xf2, 0x02, 0x5b, 0x17, 0x15, 0x8a, 0x07, 0x11, 0x52, 0xbf, 0xce, 0x80, 0xbc, 0x49, 0xcc,
-	0x35, 0x17, 0xe9, 0x14, 0x3d, 0x71, 0x30, 0x7d, 0xcc, 0x4a, 0x94, 0x1f, 0x00, 0xf0, 0x5a, 0xeb,
-	0xb4, 0xed, 0xa0, 0x9c, 0x34, 0x23, 0xcd, 0x5f, 0xab, 0x14, 0xb5, 0xa8, 0x6e, 0x2d, 0x98, 0xf0,
-	0xb4, 0xed, 0xa0, 0x5a, 0x16, 0xfb, 0x1f, 0xe5, 0x35, 0xb8, 0x2a, 0xe6, 0x3b, 0xae, 0xd5, 0x40,
-	0xb9, 0xfe, 0x19, 0x69, 0xfe, 0x6a, 0x65, 0x3a, 0x4e, 0xc0, 0x27, 0x6f, 0x31, 0xcc, 0xea, 0xc0,
-	0x8b, 0x37, 0xc5, 0xbe, 0x9a, 0x48, 0xcb, 0x47, 0xe4, 0x55, 0x18, 0x11, 0x24, 0x7a, 0x0b, 0xef,
-	0xd9, 0x34, 0x97, 0xe1, 0x2c, 0x79, 0xcd, 0x13, 0xc0, 0x9c, 0xd1, 0x3c, 0x67, 0xb4, 0x35, 0x6c,
-	0xd9, 0x1e, 0x85, 0xc8, 0xbc, 0xc2, 0xe7, 0xc8, 0x1f, 0xc2, 0x28, 0x3e, 0xb0, 0x19, 0x87, 0x61,
-	0xb8, 0x88, 0x90, 0xdc, 0xc0, 0x8c, 0x34, 0x9f, 0x5d, 0xcd, 0xbd, 0x7a, 0x5e, 0x9a, 0xf0, 0x78,
-	0x56, 0x44, 0xe4, 0x09, 0x75, 0x2d, 0xdb, 0xac, 0x8d, 0x70, 0xb8, 0x37, 0x26, 0xdf, 0x01, 0xd9,
-	0xf3, 0x41, 0x77, 0x4d, 0x44, 0xeb, 0x06, 0xb2, 0x71, 0x2b, 0x77, 0x85, 0x71, 0xd4, 0xc6, 0x85,
-	0x5c, 0x1e, 0x58, 0x67, 0xe3, 0xd5, 0xbb, 0xdf, 0x9c, 0x1e, 0x2d, 0x86, 0xf3, 0x7d, 0x7f, 0x7a,
-	0xb4, 0x58, 0xe8, 0x6c, 0x72, 0xdc, 0x6c, 0xf5, 0x7d, 0x50, 0xe2, 0xa3, 0x35, 0x44, 0x1c, 0x6c,
-	0x13, 0x24, 0xe7, 0x61, 0x58, 0x94, 0x60, 0x19, 0xbc, 0x11, 0x03, 0xb5, 0x21, 0xfe, 0xbc, 0x61,
-	0xa8, 0x7f, 0x4a, 0xbc, 0x79, 0x9f, 0x3a, 0x46, 0xa8, 0x79, 0x31, 0xcd, 0xd2, 0x85, 0x34, 0x77,
-	0x26, 0xec, 0x0f, 0x25, 0x8c, 0xb6, 0x35, 0x73, 0x99, 0xb6, 0xa6, 0x75, 0x29, 0xa2, 0x4a, 0x9d,
-	0xe6, 0x2e, 0x45, 0x46, 0x7d, 0x97, 0xd4, 0x9f, 0x84, 0x15, 0x6b, 0xba, 0xdd, 0x40, 0xcd, 0x77,
-	0x60, 0x45, 0xea, 0x5e, 0x87, 0x0b, 0xf2, 0x7b, 0x1d, 0x1e, 0x4d, 0xd3, 0xeb, 0x1f, 0x25, 0xb8,
-	0x11, 0x9f, 0x49, 0xe4, 0x0a, 0x0c, 0x35, 0xd8, 0xca, 0xc1, 0xee, 0xb9, 0xda, 0x7c, 0xa0, 0x3c,
-	0x07, 0xd7, 0x88, 0x83, 0x69, 0xdd, 0xcf, 0x45, 0x72, 0xfd, 0x33, 0x99, 0xf9, 0x81, 0xda, 0x08,
-	0xf1, 0x79, 0x37, 0x0c, 0x52, 0x5d, 0x62, 0x0a, 0xfd, 0x39, 0x4c, 0x5b, 0xb1, 0xb7, 0x36, 0xa2,
-	0xde, 0x84, 0xa9, 0x84, 0xe1, 0xa0, 0x47, 0x47, 0x57, 0x44, 0x9c, 0x2f, 0xf4, 0x2d, 0xe4, 0x3a,
-	0x88, 0xee, 0xe9, 0xcd, 0xc7, 0x0e, 0xb2, 0xff, 0x95, 0x66, 0x6d, 0xc0, 0x28, 0x75, 0x2d, 0xd3,
-	0x8c, 0xec, 0x3a, 0x85, 0xf8, 0xf2, 0x7c, 0x2a, 0x60, 0x9d, 0x0b, 0x74, 0x84, 0x76, 0x8c, 0xc9,
-	0x0f, 0x01, 0x1a, 0xb8, 0xd9, 0xd4, 0x29, 0x72, 0xf5, 0x66, 0xda, 0x7d, 0xa7, 0x63, 0x8a, 0x3c,
-	0xcb, 0x6a, 0xd1, 0x0d, 0xcb, 0x36, 0xeb, 0x3a, 0x21, 0x88, 0x8a, 0x6d, 0x87, 0x65, 0xe1, 0x83,
-	0x2b, 0x6c, 0x4c, 0x7e, 0x08, 0xc3, 0x0e, 0x26, 0x16, 0xb5, 0xb0, 0xcd, 0xb7, 0x94, 0x6b, 0x95,
-	0xd9, 0x78, 0xad, 0x81, 0x4f, 0x5b, 0x1e, 0xb4, 0x16, 0x4c, 0x92, 0x37, 0x61, 0xb8, 0x89, 0xf6,
-	0x91, 0xab, 0x9b, 0x28, 0x37, 0xc8, 0xbd, 0x5a, 0x66, 0x95, 0xfc, 0xfe, 0xa6, 0x38, 0x25, 0x6a,
-	0x25, 0xc6, 0xae, 0x66, 0xe1, 0x72, 0x4b, 0xa7, 0x3b, 0xda, 0x23, 0x64, 0xea, 0x8d, 0xf6, 0x3a,
-	0x6a, 0xbc, 0x7a, 0x5e, 0x02, 0x4f, 0xca, 0x3a, 0x6a, 0xd4, 0x02, 0x0a, 0xf9, 0x4b, 0xb8, 0x4e,
-	0xf5, 0x5d, 0xc4, 0x8e, 0x89, 0xaf, 0x2c, 0xea, 0x99, 0x38, 0x74, 0x59, 0xde, 0x31, 0xc6, 0xb5,
-	0xc5, 0xa9, 0x84, 0xa9, 0x9f, 0xc1, 0x18, 0xa1, 0xd8, 0xa9, 0x37, 0x31, 0x21, 0x1e, 0xf9, 0xf0,
-	0x65, 0xc9, 0x47, 0x19, 0xd3, 0x23, 0x4c, 0x88, 0xa0, 0x9e, 0x84, 0x21, 0x07, 0xe3, 0x26, 0x7b,
-	0x6d, 0xb2, 0xfc, 0xb5, 0x19, 0x64, 0x8f, 0x1b, 0x46, 0xf5, 0x41, 0xf2, 0x5b, 0xfa, 0xff, 0xc4,
-	0x1d, 0x39, 0xbe, 0x24, 0xd5, 0x8f, 0x60, 0xb6, 0x47, 0x38, 0xcd, 0x7b, 0xfb, 0x5d, 0x3f, 0x4c,
-	0xc7, 0x29, 0xd6, 0x9a, 0x98, 0xa0, 0xff, 0xda, 0xaa, 0x2f, 0xc2, 0x55, 0x7f, 0x69, 0x31, 0x21,
-	0x19, 0x2e, 0x04, 0xfc, 0xa1, 0x0d, 0xa3, 0x7a, 0x3f, 0xd9, 0xcd, 0xdb, 0x9d, 0x6e, 0x76, 0x15,
-	0xaa, 0xae, 0xc0, 0x5c, 0x2f, 0x23, 0xd2, 0x98, 0xf9, 0x97, 0x04, 0x93, 0xc1, 0x21, 0x70, 0xd6,
-	0x8f, 0xb7, 0x7c, 0xea, 0xc5, 0x2c, 0xce, 0x5c, 0xd6, 0xe2, 0x6a, 0x35, 0xd9, 0xc1, 0xd9, 0xc4,
-	0xb3, 0x2f, 0x2c, 0x50, 0xbd, 0x05, 0xc5, 0x2e, 0xa1, 0x60, 0x87, 0xfd, 0x45, 0xf8, 0x23, 0x76,
-	0xe0, 0x77, 0xe5, 0x4f, 0x5a, 0x51, 0x49, 0x55, 0xa9, 0xf7, 0xb9, 0xa8, 0xa4, 0x50, 0x9a, 0xf5,
-	0xf0, 0xab, 0x04, 0xb9, 0x2e, 0xd3, 0xc9, 0x3f, 0x15, 0x3c, 0x05, 0xd9, 0xe8, 0xf9, 0x38, 0x8c,
-	0xfd, 0xb3, 0xf1, 0x83, 0x64, 0xc9, 0x73, 0x29, 0x24, 0x13, 0x55, 0x85, 0x99, 0x6e, 0xb1, 0xa0,
-	0x93, 0x3f, 0x4b, 0x30, 0x76, 0xd6, 0x6d, 0xfe, 0xc5, 0x40, 0xbe, 0x07, 0x59, 0x7d, 0x8f, 0xee,
-	0x60, 0xd7, 0xa2, 0xed, 0x73, 0xc5, 0x9c, 0x41, 0xe5, 0x25, 0x18, 0x14, 0x5f, 0x2d, 0xbc, 0xbd,
-	0x21, 0x97, 0x70, 0xca, 0xf0, 0x78, 0xcd, 0xc3, 0x55, 0x35, 0x26, 0xef, 0x8c, 0x81, 0x49, 0x9b,
-	0x4a, 0x5e, 0xa2, 0x1c, 0xaf, 0xe6, 0x3b, 0x5f, 0x4b, 0x41, 0xe5, 0x0b, 0xf9, 0x4d, 0x82, 0xf1,
-	0x4d, 0x62, 0x7e, 0x7c, 0x88, 0x1a, 0x7b, 0x14, 0xbd, 0xed, 0x4b, 0x8b, 0xac, 0xc1, 0x0d, 0xc7,
-	0xb7, 0xb4, 0x03, 0x9a, 0xe1, 0xd0, 0xeb, 0x4e, 0xc8, 0x6d, 0xd6, 0xc8, 0x52, 0xf4, 0x92, 0x33,
-	0x1d, 0xd1, 0x19, 0x2a, 0x5c, 0x55, 0xf8, 0x7a, 0x0b, 0x8d, 0xf9, 0x4a, 0x2b, 0xaf, 0xb3, 0x90,
-	0xd9, 0x24, 0xa6, 0x8c, 0x60, 0x2c, 0xfa, 0x75, 0x6a, 0x2e, 0xee, 0x78, 0xfc, 0xc6, 0xaf, 0xdc,
-	0x49, 0x83, 0x0a, 0x5e, 0x0b, 0x04, 0x63, 0xd1, 0x8b, 0x7f, 0x72, 0x9a, 0x08, 0xaa, 0x4b, 0x9a,
-	0x2e, 0x17, 0x6b, 0xae, 0x26, 0x72, 0xa9, 0xee, 0xa2, 0x26, 0x8c, 0xea, 0xa6, 0xa6, 0xcb, 0xcd,
-	0x77, 0x07, 0xc6, 0x63, 0x57, 0xdb, 0xdb, 0x69, 0x18, 0x88, 0x52, 0x4a, 0x05, 0x0b, 0x32, 0x3d,
-	0x93, 0x20, 0xd7, 0xf5, 0x0a, 0x5a, 0xea, 0xd1, 0x82, 0x38, 0x5c, 0xb9, 0x7b, 0x21, 0x78, 0x50,
-	0xc2, 0xb7, 0x12, 0xe4, 0xbb, 0x5f, 0x08, 0xb4, 0x34, 0xa4, 0x67, 0x78, 0xe5, 0xde, 0xc5, 0xf0,
-	0x41, 0x15, 0x14, 0x26, 0x12, 0x0f, 0xd2, 0x85, 0x1e, 0xeb, 0x23, 0x0c, 0x55, 0x96, 0x53, 0x43,
-	0x3b, 0xb3, 0x26, 0x1e, 0x4f, 0x0b, 0x3d, 0xba, 0x98, 0x2a, 0x6b, 0xcf, 0x33, 0xe4, 0x00, 0xfe,
-	0x97, 0x7c, 0x48, 0x2c, 0xa6, 0xe6, 0x22, 0x4a, 0x25, 0x3d, 0x36, 0x48, 0xfc, 0x05, 0x8c, 0x84,
-	0xf6, 0xf0, 0x5b, 0xbd, 0x1c, 0xe3, 0x10, 0x65, 0xe1, 0x5c, 0x48, 0xc0, 0x5e, 0x87, 0xd1, 0xf0,
-	0xc6, 0xaa, 0x26, 0xce, 0x0d, 0x61, 0x94, 0xc5, 0xf3, 0x31, 0x7e, 0x02, 0xe5, 0xca, 0xb3, 0xd3,
-	0xa3, 0x45, 0x69, 0xf5, 0x93, 0x17, 0xc7, 0x05, 0xe9, 0xe5, 0x71, 0x41, 0xfa, 0xe3, 0xb8, 0x20,
-	0xfd, 0x70, 0x52, 0xe8, 0x7b, 0x79, 0x52, 0xe8, 0x7b, 0x7d, 0x52, 0xe8, 0xfb, 0x7c, 0xc9, 0xb4,
-	0xe8, 0xce, 0xde, 0xb6, 0xd6, 0xc0, 0xad, 0x32, 0xa3, 0x2d, 0xd9, 0x88, 0x1e, 0x60, 0x77, 0x97,
-	0x3f, 0x94, 0x0f, 0xc3, 0x3f, 0xa0, 0xb5, 0x1d, 0x44, 0xb6, 0x07, 0xf9, 0x0f, 0x4f, 0xef, 0xfd,
-	0x1d, 0x00, 0x00, 0xff, 0xff, 0x9a, 0x2b, 0x03, 0xc8, 0x61, 0x13, 0x00, 0x00,
+func (m *MsgCreateOrderGroup) Reset()         { *m = MsgCreateOrderGroup{} }
+func (m *MsgCreateOrderGroup) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateOrderGroup) ProtoMessage()    {}
+func (*MsgCreateOrderGroup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0534a5eaf85a8c0b, []int{22}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	CreateSpotOrder(ctx context.Context, in *MsgCreateSpotOrder, opts ...grpc.CallOption) (*MsgCreateSpotOrderResponse, error)
-	UpdateSpotOrder(ctx context.Context, in *MsgUpdateSpotOrder, opts ...grpc.CallOption) (*MsgUpdateSpotOrderResponse, error)
-	CancelSpotOrder(ctx context.Context, in *MsgCancelSpotOrder, opts ...grpc.CallOption) (*MsgCancelSpotOrderResponse, error)
-	CancelSpotOrders(ctx context.Context, in *MsgCancelSpotOrders, opts ...grpc.CallOption) (*MsgCancelSpotOrdersResponse, error)
-	CreatePerpetualOpenOrder(ctx context.Context, in *MsgCreatePerpetualOpenOrder, opts ...grpc.CallOption) (*MsgCreatePerpetualOpenOrderResponse, error)
-	CreatePerpetualCloseOrder(ctx context.Context, in *MsgCreatePerpetualCloseOrder, opts ...grpc.CallOption) (*MsgCreatePerpetualCloseOrderResponse, error)
-	UpdatePerpetualOrder(ctx context.Context, in *MsgUpdatePerpetualOrder, opts ...grpc.CallOption) (*MsgUpdatePerpetualOrderResponse, error)
-	CancelPerpetualOrder(ctx context.Context, in *MsgCancelPerpetualOrder, opts ...grpc.CallOption) (*MsgCancelPerpetualOrderResponse, error)
-	CancelPerpetualOrders(ctx context.Context, in *MsgCancelPerpetualOrders, opts ...grpc.CallOption) (*MsgCancelPerpetualOrdersResponse, error)
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
-	ExecuteOrders(ctx context.Context, in *MsgExecuteOrders, opts ...grpc.CallOption) (*MsgExecuteOrdersResponse, error)
+func (m *MsgCreateOrderGroup) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgCreateOrderGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateOrderGroup.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgCreateOrderGroup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateOrderGroup.Merge(m, src)
 }
-
-func (c *msgClient) CreateSpotOrder(ctx context.Context, in *MsgCreateSpotOrder, opts ...grpc.CallOption) (*MsgCreateSpotOrderResponse, error) {
-	out := new(MsgCreateSpotOrderResponse)
-	err := c.cc.Invoke(ctx, "/elys.tradeshield.Msg/CreateSpotOrder", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgCreateOrderGroup) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateOrderGroup) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateOrderGroup.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpdateSpotOrder(ctx context.Context, in *MsgUpdateSpotOrder, opts ...grpc.CallOption) (*MsgUpdateSpotOrderResponse, error) {
-	out := new(MsgUpdateSpotOrderResponse)
-	err := c.cc.Invoke(ctx, "/elys.tradeshield.Msg/UpdateSpotOrder", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgCreateOrderGroup proto.InternalMessageInfo
+
+func (m *MsgCreateOrderGroup) GetOwnerAddress() string {
+	if m != nil {
+		return m.OwnerAddress
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) CancelSpotOrder(ctx context.Context, in *MsgCancelSpotOrder, opts ...grpc.CallOption) (*MsgCancelSpotOrderResponse, error) {
-	out := new(MsgCancelSpotOrderResponse)
-	err := c.cc.Invoke(ctx, "/elys.tradeshield.Msg/CancelSpotOrder", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgCreateOrderGroup) GetSpotOrderIds() []uint64 {
+	if m != nil {
+		return m.SpotOrderIds
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) CancelSpotOrders(ctx context.Context, in *MsgCancelSpotOrders, opts ...grpc.CallOption) (*MsgCancelSpotOrdersResponse, error) {
-	out := new(MsgCancelSpotOrdersResponse)
-	err := c.cc.Invoke(ctx, "/elys.tradeshield.Msg/CancelSpotOrders", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgCreateOrderGroup) GetPerpetualOrderIds() []uint64 {
+	if m != nil {
+		return m.PerpetualOrderIds
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) CreatePerpetualOpenOrder(ctx context.Context, in *MsgCreatePerpetualOpenOrder, opts ...grpc.CallOption) (*MsgCreatePerpetualOpenOrderResponse, error) {
-	out := new(MsgCreatePerpetualOpenOrderResponse)
-	err := c.cc.Invoke(ctx, "/elys.tradeshield.Msg/CreatePerpetualOpenOrder", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type MsgCreateOrderGroupResponse struct {
+	GroupId uint64 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
 }
 
-func (c *msgClient) CreatePerpetualCloseOrder(ctx context.Context, in *MsgCreatePerpetualCloseOrder, opts ...grpc.CallOption) (*MsgCreatePerpetualCloseOrderResponse, error) {
-	out := new(MsgCreatePerpetualCloseOrderResponse)
-	err := c.cc.Invoke(ctx, "/elys.tradeshield.Msg/CreatePerpetualCloseOrder", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgCreateOrderGroupResponse) Reset()         { *m = MsgCreateOrderGroupResponse{} }
+func (m *MsgCreateOrderGroupResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateOrderGroupResponse) ProtoMessage()    {}
+func (*MsgCreateOrderGroupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0534a5eaf85a8c0b, []int{23}
+}
+func (m *MsgCreateOrderGroupResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateOrderGroupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateOrderGroupResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgCreateOrderGroupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateOrderGroupResponse.Merge(m, src)
+}
+func (m *MsgCreateOrderGroupResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateOrderGroupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateOrderGroupResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpdatePerpetualOrder(ctx context.Context, in *MsgUpdatePerpetualOrder, opts ...grpc.CallOption) (*MsgUpdatePerpetualOrderResponse, error) {
-	out := new(MsgUpdatePerpetualOrderResponse)
-	err := c.cc.Invoke(ctx, "/elys.tradeshield.Msg/UpdatePerpetualOrder", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgCreateOrderGroupResponse proto.InternalMessageInfo
+
+func (m *MsgCreateOrderGroupResponse) GetGroupId() uint64 {
+	if m != nil {
+		return m.GroupId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) CancelPerpetualOrder(ctx context.Context, in *MsgCancelPerpetualOrder, opts ...grpc.CallOption) (*MsgCancelPerpetualOrderResponse, error) {
-	out := new(MsgCancelPerpetualOrderResponse)
-	err := c.cc.Invoke(ctx, "/elys.tradeshield.Msg/CancelPerpetualOrder", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type MsgCancelOrderGroup struct {
+	OwnerAddress string `protobuf:"bytes,1,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
+	GroupId      uint64 `protobuf:"varint,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
 }
 
-func (c *msgClient) CancelPerpetualOrders(ctx context.Context, in *MsgCancelPerpetualOrders, opts ...grpc.CallOption) (*MsgCancelPerpetualOrdersResponse, error) {
-	out := new(MsgCancelPerpetualOrdersResponse)
-	err := c.cc.Invoke(ctx, "/elys.tradeshield.Msg/CancelPerpetualOrders", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgCancelOrderGroup) Reset()         { *m = MsgCancelOrderGroup{} }
+func (m *MsgCancelOrderGroup) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelOrderGroup) ProtoMessage()    {}
+func (*MsgCancelOrderGroup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0534a5eaf85a8c0b, []int{24}
+}
+func (m *MsgCancelOrderGroup) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelOrderGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelOrderGroup.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgCancelOrderGroup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelOrderGroup.Merge(m, src)
+}
+func (m *MsgCancelOrderGroup) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelOrderGroup) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelOrderGroup.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/elys.tradeshield.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgCancelOrderGroup proto.InternalMessageInfo
+
+func (m *MsgCancelOrderGroup) GetOwnerAddress() string {
+	if m != nil {
+		return m.OwnerAddress
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) ExecuteOrders(ctx context.Context, in *MsgExecuteOrders, opts ...grpc.CallOption) (*MsgExecuteOrdersResponse, error) {
-	out := new(MsgExecuteOrdersResponse)
-	err := c.cc.Invoke(ctx, "/elys.tradeshield.Msg/ExecuteOrders", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgCancelOrderGroup) GetGroupId() uint64 {
+	if m != nil {
+		return m.GroupId
 	}
-	return out, nil
+	return 0
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	CreateSpotOrder(context.Context, *MsgCreateSpotOrder) (*MsgCreateSpotOrderResponse, error)
-	UpdateSpotOrder(context.Context, *MsgUpdateSpotOrder) (*MsgUpdateSpotOrderResponse, error)
-	CancelSpotOrder(context.Context, *MsgCancelSpotOrder) (*MsgCancelSpotOrderResponse, error)
-	CancelSpotOrders(context.Context, *MsgCancelSpotOrders) (*MsgCancelSpotOrdersResponse, error)
-	CreatePerpetualOpenOrder(context.Context, *MsgCreatePerpetualOpenOrder) (*MsgCreatePerpetualOpenOrderResponse, error)
-	CreatePerpetualCloseOrder(context.Context, *MsgCreatePerpetualCloseOrder) (*MsgCreatePerpetualCloseOrderResponse, error)
-	UpdatePerpetualOrder(context.Context, *MsgUpdatePerpetualOrder) (*MsgUpdatePerpetualOrderResponse, error)
-	CancelPerpetualOrder(context.Context, *MsgCancelPerpetualOrder) (*MsgCancelPerpetualOrderResponse, error)
-	CancelPerpetualOrders(context.Context, *MsgCancelPerpetualOrders) (*MsgCancelPerpetualOrdersResponse, error)
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
-	ExecuteOrders(context.Context, *MsgExecuteOrders) (*MsgExecuteOrdersResponse, error)
+type MsgCancelOrderGroupResponse struct {
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgCancelOrderGroupResponse) Reset()         { *m = MsgCancelOrderGroupResponse{} }
+func (m *MsgCancelOrderGroupResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelOrderGroupResponse) ProtoMessage()    {}
+func (*MsgCancelOrderGroupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0534a5eaf85a8c0b, []int{25}
 }
-
-func (*UnimplementedMsgServer) CreateSpotOrder(ctx context.Context, req *MsgCreateSpotOrder) (*MsgCreateSpotOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateSpotOrder not implemented")
+func (m *MsgCancelOrderGroupResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) UpdateSpotOrder(ctx context.Context, req *MsgUpdateSpotOrder) (*MsgUpdateSpotOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateSpotOrder not implemented")
-}
-func (*UnimplementedMsgServer) CancelSpotOrder(ctx context.Context, req *MsgCancelSpotOrder) (*MsgCancelSpotOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CancelSpotOrder not implemented")
+func (m *MsgCancelOrderGroupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelOrderGroupResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) CancelSpotOrders(ctx context.Context, req *MsgCancelSpotOrders) (*MsgCancelSpotOrdersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CancelSpotOrders not implemented")
+func (m *MsgCancelOrderGroupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelOrderGroupResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) CreatePerpetualOpenOrder(ctx context.Context, req *MsgCreatePerpetualOpenOrder) (*MsgCreatePerpetualOpenOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreatePerpetualOpenOrder not implemented")
+func (m *MsgCancelOrderGroupResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) CreatePerpetualCloseOrder(ctx context.Context, req *MsgCreatePerpetualCloseOrder) (*MsgCreatePerpetualCloseOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreatePerpetualCloseOrder not implemented")
+func (m *MsgCancelOrderGroupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelOrderGroupResponse.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) UpdatePerpetualOrder(ctx context.Context, req *MsgUpdatePerpetualOrder) (*MsgUpdatePerpetualOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdatePerpetualOrder not implemented")
+
+var xxx_messageInfo_MsgCancelOrderGroupResponse proto.InternalMessageInfo
+
+type MsgCreateScheduledOrder struct {
+	OwnerAddress string `protobuf:"bytes,1,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
+	// Amount to swap over all the slices
+	Amount       types.Coin                  `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+	TargetDenom  string                      `protobuf:"bytes,3,opt,name=target_denom,json=targetDenom,proto3" json:"target_denom,omitempty"`
+	SliceCount   uint64                      `protobuf:"varint,4,opt,name=slice_count,json=sliceCount,proto3" json:"slice_count,omitempty"`
+	Interval     uint64                      `protobuf:"varint,5,opt,name=interval,proto3" json:"interval,omitempty"`
+	IntervalUnit ScheduleIntervalUnit        `protobuf:"varint,6,opt,name=interval_unit,json=intervalUnit,proto3,enum=elys.tradeshield.ScheduleIntervalUnit" json:"interval_unit,omitempty"`
+	MaxSlippage  cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=max_slippage,json=maxSlippage,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_slippage"`
+}
+
+func (m *MsgCreateScheduledOrder) Reset()         { *m = MsgCreateScheduledOrder{} }
+func (m *MsgCreateScheduledOrder) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateScheduledOrder) ProtoMessage()    {}
+func (*MsgCreateScheduledOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0534a5eaf85a8c0b, []int{26}
+}
+func (m *MsgCreateScheduledOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) CancelPerpetualOrder(ctx context.Context, req *MsgCancelPerpetualOrder) (*MsgCancelPerpetualOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CancelPerpetualOrder not implemented")
+func (m *MsgCreateScheduledOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateScheduledOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) CancelPerpetualOrders(ctx context.Context, req *MsgCancelPerpetualOrders) (*MsgCancelPerpetualOrdersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CancelPerpetualOrders not implemented")
+func (m *MsgCreateScheduledOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateScheduledOrder.Merge(m, src)
 }
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgCreateScheduledOrder) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) ExecuteOrders(ctx context.Context, req *MsgExecuteOrders) (*MsgExecuteOrdersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ExecuteOrders not implemented")
+func (m *MsgCreateScheduledOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateScheduledOrder.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
-}
+var xxx_messageInfo_MsgCreateScheduledOrder proto.InternalMessageInfo
 
-func _Msg_CreateSpotOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateSpotOrder)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateSpotOrder(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/elys.tradeshield.Msg/CreateSpotOrder",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateSpotOrder(ctx, req.(*MsgCreateSpotOrder))
+func (m *MsgCreateScheduledOrder) GetOwnerAddress() string {
+	if m != nil {
+		return m.OwnerAddress
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_UpdateSpotOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateSpotOrder)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateSpotOrder(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/elys.tradeshield.Msg/UpdateSpotOrder",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateSpotOrder(ctx, req.(*MsgUpdateSpotOrder))
+func (m *MsgCreateScheduledOrder) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
 	}
-	return interceptor(ctx, in, info, handler)
+	return types.Coin{}
 }
 
-func _Msg_CancelSpotOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCancelSpotOrder)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CancelSpotOrder(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/elys.tradeshield.Msg/CancelSpotOrder",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CancelSpotOrder(ctx, req.(*MsgCancelSpotOrder))
+func (m *MsgCreateScheduledOrder) GetTargetDenom() string {
+	if m != nil {
+		return m.TargetDenom
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_CancelSpotOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCancelSpotOrders)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CancelSpotOrders(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/elys.tradeshield.Msg/CancelSpotOrders",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CancelSpotOrders(ctx, req.(*MsgCancelSpotOrders))
+func (m *MsgCreateScheduledOrder) GetSliceCount() uint64 {
+	if m != nil {
+		return m.SliceCount
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_CreatePerpetualOpenOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreatePerpetualOpenOrder)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreatePerpetualOpenOrder(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/elys.tradeshield.Msg/CreatePerpetualOpenOrder",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreatePerpetualOpenOrder(ctx, req.(*MsgCreatePerpetualOpenOrder))
+func (m *MsgCreateScheduledOrder) GetInterval() uint64 {
+	if m != nil {
+		return m.Interval
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_CreatePerpetualCloseOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreatePerpetualCloseOrder)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreatePerpetualCloseOrder(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/elys.tradeshield.Msg/CreatePerpetualCloseOrder",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreatePerpetualCloseOrder(ctx, req.(*MsgCreatePerpetualCloseOrder))
+func (m *MsgCreateScheduledOrder) GetIntervalUnit() ScheduleIntervalUnit {
+	if m != nil {
+		return m.IntervalUnit
 	}
-	return interceptor(ctx, in, info, handler)
+	return ScheduleIntervalUnit_BLOCKS
 }
 
-func _Msg_UpdatePerpetualOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdatePerpetualOrder)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdatePerpetualOrder(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/elys.tradeshield.Msg/UpdatePerpetualOrder",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdatePerpetualOrder(ctx, req.(*MsgUpdatePerpetualOrder))
-	}
-	return interceptor(ctx, in, info, handler)
+type MsgCreateScheduledOrderResponse struct {
+	OrderId uint64 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
 }
 
-func _Msg_CancelPerpetualOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCancelPerpetualOrder)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CancelPerpetualOrder(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/elys.tradeshield.Msg/CancelPerpetualOrder",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CancelPerpetualOrder(ctx, req.(*MsgCancelPerpetualOrder))
+func (m *MsgCreateScheduledOrderResponse) Reset()         { *m = MsgCreateScheduledOrderResponse{} }
+func (m *MsgCreateScheduledOrderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateScheduledOrderResponse) ProtoMessage()    {}
+func (*MsgCreateScheduledOrderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0534a5eaf85a8c0b, []int{27}
+}
+func (m *MsgCreateScheduledOrderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateScheduledOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateScheduledOrderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgCreateScheduledOrderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateScheduledOrderResponse.Merge(m, src)
+}
+func (m *MsgCreateScheduledOrderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateScheduledOrderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateScheduledOrderResponse.DiscardUnknown(m)
 }
 
-func _Msg_CancelPerpetualOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCancelPerpetualOrders)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CancelPerpetualOrders(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/elys.tradeshield.Msg/CancelPerpetualOrders",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CancelPerpetualOrders(ctx, req.(*MsgCancelPerpetualOrders))
+var xxx_messageInfo_MsgCreateScheduledOrderResponse proto.InternalMessageInfo
+
+func (m *MsgCreateScheduledOrderResponse) GetOrderId() uint64 {
+	if m != nil {
+		return m.OrderId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/elys.tradeshield.Msg/UpdateParams",
+type MsgCancelScheduledOrder struct {
+	OwnerAddress string `protobuf:"bytes,1,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
+	OrderId      uint64 `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+}
+
+func (m *MsgCancelScheduledOrder) Reset()         { *m = MsgCancelScheduledOrder{} }
+func (m *MsgCancelScheduledOrder) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelScheduledOrder) ProtoMessage()    {}
+func (*MsgCancelScheduledOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0534a5eaf85a8c0b, []int{28}
+}
+func (m *MsgCancelScheduledOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelScheduledOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelScheduledOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+}
+func (m *MsgCancelScheduledOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelScheduledOrder.Merge(m, src)
+}
+func (m *MsgCancelScheduledOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelScheduledOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelScheduledOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelScheduledOrder proto.InternalMessageInfo
+
+func (m *MsgCancelScheduledOrder) GetOwnerAddress() string {
+	if m != nil {
+		return m.OwnerAddress
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_ExecuteOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgExecuteOrders)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ExecuteOrders(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/elys.tradeshield.Msg/ExecuteOrders",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ExecuteOrders(ctx, req.(*MsgExecuteOrders))
+func (m *MsgCancelScheduledOrder) GetOrderId() uint64 {
+	if m != nil {
+		return m.OrderId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "elys.tradeshield.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateSpotOrder",
-			Handler:    _Msg_CreateSpotOrder_Handler,
-		},
-		{
-			MethodName: "UpdateSpotOrder",
-			Handler:    _Msg_UpdateSpotOrder_Handler,
-		},
-		{
-			MethodName: "CancelSpotOrder",
-			Handler:    _Msg_CancelSpotOrder_Handler,
-		},
-		{
-			MethodName: "CancelSpotOrders",
-			Handler:    _Msg_CancelSpotOrders_Handler,
-		},
-		{
-			MethodName: "CreatePerpetualOpenOrder",
-			Handler:    _Msg_CreatePerpetualOpenOrder_Handler,
-		},
-		{
-			MethodName: "CreatePerpetualCloseOrder",
-			Handler:    _Msg_CreatePerpetualCloseOrder_Handler,
-		},
-		{
-			MethodName: "UpdatePerpetualOrder",
-			Handler:    _Msg_UpdatePerpetualOrder_Handler,
-		},
-		{
-			MethodName: "CancelPerpetualOrder",
-			Handler:    _Msg_CancelPerpetualOrder_Handler,
-		},
-		{
-			MethodName: "CancelPerpetualOrders",
-			Handler:    _Msg_CancelPerpetualOrders_Handler,
-		},
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-		{
-			MethodName: "ExecuteOrders",
-			Handler:    _Msg_ExecuteOrders_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "elys/tradeshield/tx.proto",
+type MsgCancelScheduledOrderResponse struct {
 }
 
-func (m *MsgCreateSpotOrder) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgCancelScheduledOrderResponse) Reset()         { *m = MsgCancelScheduledOrderResponse{} }
+func (m *MsgCancelScheduledOrderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelScheduledOrderResponse) ProtoMessage()    {}
+func (*MsgCancelScheduledOrderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0534a5eaf85a8c0b, []int{29}
 }
-
-func (m *MsgCreateSpotOrder) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgCancelScheduledOrderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *MsgCreateSpotOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.OrderTargetDenom) > 0 {
-		i -= len(m.OrderTargetDenom)
-		copy(dAtA[i:], m.OrderTargetDenom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OrderTargetDenom)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.OwnerAddress) > 0 {
-		i -= len(m.OwnerAddress)
-		copy(dAtA[i:], m.OwnerAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OwnerAddress)))
-		i--
-		dAtA[i] = 0x22
-	}
-	{
-		size, err := m.OrderAmount.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size, err := m.OrderPrice.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgCancelScheduledOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelScheduledOrderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if m.OrderType != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.OrderType))
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgCancelScheduledOrderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelScheduledOrderResponse.Merge(m, src)
+}
+func (m *MsgCancelScheduledOrderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelScheduledOrderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelScheduledOrderResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCreateSpotOrderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgCancelScheduledOrderResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgCreateSpotOrder)(nil), "elys.tradeshield.MsgCreateSpotOrder")
+	proto.RegisterType((*MsgCreateSpotOrderResponse)(nil), "elys.tradeshield.MsgCreateSpotOrderResponse")
+	proto.RegisterType((*MsgUpdateSpotOrder)(nil), "elys.tradeshield.MsgUpdateSpotOrder")
+	proto.RegisterType((*MsgUpdateSpotOrderResponse)(nil), "elys.tradeshield.MsgUpdateSpotOrderResponse")
+	proto.RegisterType((*MsgCancelSpotOrder)(nil), "elys.tradeshield.MsgCancelSpotOrder")
+	proto.RegisterType((*MsgCancelSpotOrderResponse)(nil), "elys.tradeshield.MsgCancelSpotOrderResponse")
+	proto.RegisterType((*MsgCancelSpotOrders)(nil), "elys.tradeshield.MsgCancelSpotOrders")
+	proto.RegisterType((*MsgCancelSpotOrdersResponse)(nil), "elys.tradeshield.MsgCancelSpotOrdersResponse")
+	proto.RegisterType((*MsgCreatePerpetualOpenOrder)(nil), "elys.tradeshield.MsgCreatePerpetualOpenOrder")
+	proto.RegisterType((*MsgCreatePerpetualOpenOrderResponse)(nil), "elys.tradeshield.MsgCreatePerpetualOpenOrderResponse")
+	proto.RegisterType((*MsgCreatePerpetualCloseOrder)(nil), "elys.tradeshield.MsgCreatePerpetualCloseOrder")
+	proto.RegisterType((*MsgCreatePerpetualCloseOrderResponse)(nil), "elys.tradeshield.MsgCreatePerpetualCloseOrderResponse")
+	proto.RegisterType((*MsgUpdatePerpetualOrder)(nil), "elys.tradeshield.MsgUpdatePerpetualOrder")
+	proto.RegisterType((*MsgUpdatePerpetualOrderResponse)(nil), "elys.tradeshield.MsgUpdatePerpetualOrderResponse")
+	proto.RegisterType((*MsgCancelPerpetualOrder)(nil), "elys.tradeshield.MsgCancelPerpetualOrder")
+	proto.RegisterType((*MsgCancelPerpetualOrderResponse)(nil), "elys.tradeshield.MsgCancelPerpetualOrderResponse")
+	proto.RegisterType((*MsgCancelPerpetualOrders)(nil), "elys.tradeshield.MsgCancelPerpetualOrders")
+	proto.RegisterType((*MsgCancelPerpetualOrdersResponse)(nil), "elys.tradeshield.MsgCancelPerpetualOrdersResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "elys.tradeshield.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "elys.tradeshield.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgExecuteOrders)(nil), "elys.tradeshield.MsgExecuteOrders")
+	proto.RegisterType((*MsgExecuteOrdersResponse)(nil), "elys.tradeshield.MsgExecuteOrdersResponse")
+	proto.RegisterType((*MsgCreateOrderGroup)(nil), "elys.tradeshield.MsgCreateOrderGroup")
+	proto.RegisterType((*MsgCreateOrderGroupResponse)(nil), "elys.tradeshield.MsgCreateOrderGroupResponse")
+	proto.RegisterType((*MsgCancelOrderGroup)(nil), "elys.tradeshield.MsgCancelOrderGroup")
+	proto.RegisterType((*MsgCancelOrderGroupResponse)(nil), "elys.tradeshield.MsgCancelOrderGroupResponse")
+	proto.RegisterType((*MsgCreateScheduledOrder)(nil), "elys.tradeshield.MsgCreateScheduledOrder")
+	proto.RegisterType((*MsgCreateScheduledOrderResponse)(nil), "elys.tradeshield.MsgCreateScheduledOrderResponse")
+	proto.RegisterType((*MsgCancelScheduledOrder)(nil), "elys.tradeshield.MsgCancelScheduledOrder")
+	proto.RegisterType((*MsgCancelScheduledOrderResponse)(nil), "elys.tradeshield.MsgCancelScheduledOrderResponse")
+}
+
+func init() { proto.RegisterFile("elys/tradeshield/tx.proto", fileDescriptor_0534a5eaf85a8c0b) }
+
+var fileDescriptor_0534a5eaf85a8c0b = []byte{
+	// 1717 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0xcf, 0x6f, 0x1b, 0x4f,
+	0x15, 0xcf, 0xc6, 0xfe, 0xe6, 0xc7, 0xb3, 0x9d, 0xa4, 0xdb, 0x96, 0x38, 0x9b, 0xd4, 0x4e, 0x36,
+	0x29, 0x24, 0x21, 0xb6, 0x9b, 0xa0, 0xb6, 0x28, 0x94, 0x96, 0xfc, 0x00, 0x64, 0xda, 0xd0, 0x68,
+	0xd3, 0x1e, 0x40, 0x20, 0x6b, 0xb3, 0x3b, 0x75, 0x56, 0x59, 0xef, 0xac, 0x76, 0xc6, 0x69, 0x72,
+	0xab, 0xe0, 0xc6, 0x89, 0x23, 0x57, 0x0e, 0x88, 0x03, 0x07, 0x2a, 0xd1, 0x0b, 0xff, 0x41, 0x25,
+	0x2e, 0x55, 0xc5, 0x01, 0x71, 0xa8, 0x50, 0x7a, 0xa8, 0xc4, 0x89, 0x33, 0x27, 0x34, 0xb3, 0x3f,
+	0xb2, 0xbf, 0xec, 0x6c, 0xd3, 0xb4, 0xe2, 0x7b, 0x49, 0x76, 0xde, 0xfb, 0xcc, 0x7b, 0xf3, 0x79,
+	0xf3, 0x66, 0xe6, 0xcd, 0x18, 0xa6, 0x90, 0x79, 0x42, 0x1a, 0xd4, 0x51, 0x75, 0x44, 0x0e, 0x0c,
+	0x64, 0xea, 0x0d, 0x7a, 0x5c, 0xb7, 0x1d, 0x4c, 0xb1, 0x38, 0xc1, 0x54, 0xf5, 0x90, 0x4a, 0xba,
+	0xd6, 0xc6, 0x6d, 0xcc, 0x95, 0x0d, 0xf6, 0xe5, 0xe2, 0xa4, 0x99, 0x84, 0x09, 0xec, 0xe8, 0xc8,
+	0xf1, 0xb4, 0x37, 0x12, 0x5a, 0x5b, 0x75, 0xd4, 0x0e, 0xe9, 0xd9, 0x99, 0x9e, 0xd8, 0xc8, 0xd7,
+	0x56, 0x34, 0x4c, 0x3a, 0x98, 0x34, 0xf6, 0x55, 0x82, 0x1a, 0x47, 0xab, 0xfb, 0x88, 0xaa, 0xab,
+	0x0d, 0x0d, 0x1b, 0x96, 0xa7, 0x9f, 0xf4, 0xf4, 0x1d, 0xd2, 0x6e, 0x1c, 0xad, 0xb2, 0x7f, 0x9e,
+	0xe2, 0x8a, 0xda, 0x31, 0x2c, 0xdc, 0xe0, 0x7f, 0x3d, 0xd1, 0x94, 0x8b, 0x6d, 0xb9, 0xe3, 0x77,
+	0x1b, 0xae, 0x4a, 0xfe, 0x6b, 0x1e, 0xc4, 0x1d, 0xd2, 0xde, 0x72, 0x90, 0x4a, 0xd1, 0x9e, 0x8d,
+	0xe9, 0x63, 0x46, 0x40, 0xbc, 0x0f, 0xc0, 0x99, 0xb4, 0xd8, 0x90, 0xca, 0xc2, 0xac, 0xb0, 0x38,
+	0xb6, 0x56, 0xad, 0xc7, 0xa3, 0x52, 0x0f, 0x3a, 0x3c, 0x39, 0xb1, 0x91, 0x32, 0x8a, 0xfd, 0x4f,
+	0x71, 0x0b, 0x0a, 0x6e, 0x7f, 0xdb, 0x31, 0x34, 0x54, 0x1e, 0x9c, 0x15, 0x16, 0x0b, 0x6b, 0x33,
+	0x49, 0x03, 0xbc, 0xf3, 0x2e, 0xc3, 0x6c, 0xe6, 0x5f, 0xbf, 0xab, 0x0e, 0x28, 0x80, 0x03, 0x89,
+	0xb8, 0x09, 0x45, 0xd7, 0x88, 0xda, 0xc1, 0x5d, 0x8b, 0x96, 0x73, 0xdc, 0xca, 0x54, 0xdd, 0x23,
+	0xc0, 0x22, 0x53, 0xf7, 0x22, 0x53, 0xdf, 0xc2, 0x86, 0xe5, 0x99, 0x70, 0x3d, 0x6f, 0xf0, 0x3e,
+	0xe2, 0xf7, 0xa1, 0x84, 0x9f, 0x5b, 0xcc, 0x86, 0xae, 0x3b, 0x88, 0x90, 0x72, 0x7e, 0x56, 0x58,
+	0x1c, 0xdd, 0x2c, 0xbf, 0x7d, 0x55, 0xbb, 0xe6, 0xd9, 0xd9, 0x70, 0x35, 0x7b, 0xd4, 0x31, 0xac,
+	0xb6, 0x52, 0xe4, 0x70, 0x4f, 0x26, 0xae, 0x80, 0xe8, 0xc5, 0x41, 0x75, 0xda, 0x88, 0xb6, 0x74,
+	0x64, 0xe1, 0x4e, 0xf9, 0x2b, 0x66, 0x43, 0x99, 0x70, 0xe9, 0x72, 0xc5, 0x36, 0x93, 0x8b, 0x1b,
+	0x50, 0xa2, 0x46, 0x07, 0xb5, 0x0c, 0xab, 0xf5, 0x0c, 0x3b, 0x1a, 0x2a, 0x0f, 0xf1, 0xc0, 0xdd,
+	0x48, 0xf2, 0x7e, 0x62, 0x74, 0x50, 0xd3, 0xfa, 0x11, 0x03, 0x29, 0x05, 0x7a, 0xd6, 0x10, 0xeb,
+	0x30, 0x84, 0x8e, 0x6d, 0xc3, 0x39, 0x29, 0x0f, 0x73, 0xb6, 0xdf, 0x48, 0xf6, 0xdd, 0x56, 0x29,
+	0x52, 0x3c, 0x94, 0xb8, 0x05, 0x25, 0xea, 0xa8, 0x86, 0x69, 0x58, 0xed, 0x16, 0xa1, 0xd8, 0x2e,
+	0x8f, 0xf0, 0x6e, 0x95, 0x14, 0x97, 0x1e, 0x6c, 0x8f, 0x62, 0x5b, 0x29, 0xd2, 0x50, 0x6b, 0xfd,
+	0xf6, 0xaf, 0x3e, 0xbc, 0x5c, 0x8e, 0xc6, 0xe9, 0x37, 0x1f, 0x5e, 0x2e, 0x57, 0xc2, 0xb9, 0x99,
+	0x4c, 0x12, 0xf9, 0x2e, 0x48, 0x49, 0xa9, 0x82, 0x88, 0x8d, 0x2d, 0x82, 0xc4, 0x29, 0x18, 0x71,
+	0x43, 0x67, 0xe8, 0x3c, 0x81, 0xf2, 0xca, 0x30, 0x6f, 0x37, 0x75, 0xf9, 0xdf, 0x02, 0x4f, 0xba,
+	0xa7, 0xb6, 0x1e, 0x49, 0xba, 0xc4, 0x5c, 0x09, 0x1f, 0x35, 0x57, 0x61, 0x87, 0x83, 0x11, 0x87,
+	0xf1, 0x74, 0xcc, 0x5d, 0x24, 0x1d, 0xb3, 0x46, 0x29, 0xc6, 0x4a, 0x9e, 0x01, 0x29, 0x29, 0xf5,
+	0xa3, 0x24, 0xff, 0xc1, 0x0d, 0xc5, 0x96, 0x6a, 0x69, 0xc8, 0xfc, 0x02, 0xa1, 0xc8, 0x3c, 0xd7,
+	0xd1, 0x01, 0xf9, 0x73, 0x1d, 0x95, 0x66, 0x99, 0xeb, 0xdf, 0x0b, 0x70, 0x35, 0xd9, 0x93, 0x88,
+	0x6b, 0x30, 0xac, 0xb1, 0xcc, 0xc1, 0xce, 0xb9, 0xdc, 0x7c, 0xa0, 0xb8, 0x00, 0x63, 0xc4, 0xc6,
+	0xb4, 0xe5, 0xfb, 0x22, 0xe5, 0xc1, 0xd9, 0xdc, 0x62, 0x5e, 0x29, 0x12, 0xdf, 0x6e, 0x53, 0x27,
+	0xeb, 0xb7, 0x18, 0x43, 0xbf, 0x0f, 0xe3, 0x56, 0xed, 0xcf, 0x8d, 0xc8, 0x37, 0x60, 0x3a, 0x45,
+	0x1c, 0xcc, 0xd1, 0x7f, 0x86, 0x5c, 0x3d, 0xb3, 0x88, 0x76, 0x91, 0x63, 0x23, 0xda, 0x55, 0xcd,
+	0xc7, 0x36, 0xb2, 0x2e, 0x65, 0xb2, 0x9a, 0x6c, 0x09, 0x1b, 0xed, 0x76, 0x6c, 0xb7, 0x4c, 0x5d,
+	0xc2, 0x1c, 0x16, 0x4e, 0xd0, 0x22, 0x0d, 0xc9, 0xc4, 0x07, 0x00, 0x1a, 0x36, 0x4d, 0x95, 0x22,
+	0x47, 0x35, 0xb3, 0xee, 0x97, 0xa1, 0x2e, 0xe2, 0x3c, 0xdf, 0x4e, 0x74, 0xb6, 0x9b, 0xa8, 0x84,
+	0x20, 0xea, 0x6e, 0x97, 0x4a, 0xd1, 0x13, 0x6e, 0x30, 0x99, 0xf8, 0x00, 0x46, 0x6c, 0x4c, 0x0c,
+	0x6a, 0x60, 0x8b, 0x6f, 0x85, 0x63, 0x6b, 0xf3, 0xc9, 0xb1, 0x06, 0x71, 0xda, 0xf5, 0xa0, 0x4a,
+	0xd0, 0x49, 0xdc, 0x81, 0x11, 0x13, 0x1d, 0x21, 0x47, 0x6d, 0xbb, 0x5b, 0xe4, 0xe8, 0xe6, 0x2a,
+	0x1b, 0xc9, 0x3f, 0xdf, 0x55, 0xa7, 0xdd, 0xb1, 0x12, 0xfd, 0xb0, 0x6e, 0xe0, 0x46, 0x47, 0xa5,
+	0x07, 0xf5, 0x47, 0xa8, 0xad, 0x6a, 0x27, 0xdb, 0x48, 0x7b, 0xfb, 0xaa, 0x06, 0x1e, 0x95, 0x6d,
+	0xa4, 0x29, 0x81, 0x09, 0xf1, 0x97, 0x70, 0x85, 0xaa, 0x87, 0x88, 0x1d, 0x6f, 0xcf, 0x0c, 0xea,
+	0x05, 0x71, 0xf8, 0xa2, 0x76, 0xc7, 0x99, 0xad, 0x5d, 0x6e, 0xca, 0x0d, 0xea, 0xcf, 0x60, 0x9c,
+	0xed, 0xac, 0x2d, 0x13, 0x13, 0xe2, 0x19, 0x1f, 0xb9, 0xa8, 0xf1, 0x12, 0xb3, 0xf4, 0x08, 0x13,
+	0xe2, 0x9a, 0x9e, 0x84, 0x61, 0x1b, 0x63, 0x93, 0x2d, 0x9b, 0x51, 0xbe, 0x6c, 0x86, 0x58, 0xb3,
+	0xa9, 0x27, 0x4f, 0x12, 0xf8, 0x84, 0x93, 0xa4, 0x90, 0xe9, 0x24, 0x79, 0x08, 0x63, 0xfb, 0x8e,
+	0xaa, 0x1d, 0x22, 0x6f, 0x7d, 0x91, 0x72, 0x71, 0x36, 0x97, 0x9e, 0x87, 0x9b, 0x2e, 0x8e, 0x67,
+	0xbf, 0x97, 0x44, 0xa5, 0xfd, 0x90, 0x8c, 0xac, 0xdf, 0x4f, 0xdf, 0x65, 0xbe, 0x95, 0x7a, 0xa2,
+	0x24, 0x97, 0x94, 0xfc, 0x03, 0x98, 0xef, 0xa3, 0xce, 0xb2, 0xef, 0xfc, 0x3d, 0x0f, 0x33, 0x49,
+	0x13, 0x5b, 0x26, 0x26, 0xe8, 0xff, 0x6d, 0xd5, 0x56, 0xa1, 0xe0, 0x2f, 0x0d, 0x46, 0x24, 0xc7,
+	0x89, 0x80, 0x2f, 0xe2, 0xc7, 0x57, 0xb8, 0x1a, 0xcb, 0xf3, 0x54, 0x58, 0xe8, 0xb3, 0xe4, 0x52,
+	0x4b, 0xb2, 0x9f, 0x42, 0x51, 0x63, 0xec, 0xfd, 0x6a, 0x8a, 0x17, 0x31, 0x9b, 0xdf, 0xf6, 0x72,
+	0xf8, 0x7a, 0x32, 0x87, 0x9b, 0x16, 0x0d, 0x65, 0x6f, 0xd3, 0xa2, 0x4a, 0x81, 0x1b, 0xf0, 0x2a,
+	0xab, 0xaf, 0x6b, 0xb1, 0x73, 0x2f, 0x3d, 0x35, 0x6f, 0x86, 0x53, 0xb3, 0x67, 0xd6, 0xc8, 0x1b,
+	0xb0, 0xd0, 0x2f, 0xab, 0xb2, 0x64, 0xe6, 0x7f, 0x05, 0x98, 0x0c, 0x2a, 0x82, 0xe8, 0x9c, 0x7d,
+	0xc6, 0x12, 0x28, 0x91, 0xaf, 0xb9, 0x8b, 0xe6, 0xeb, 0xfa, 0x7a, 0x7a, 0x04, 0xe7, 0x53, 0x0b,
+	0xa1, 0x28, 0x41, 0x79, 0x0e, 0xaa, 0x3d, 0x54, 0xc1, 0x71, 0xfb, 0x67, 0x37, 0x3e, 0xee, 0x71,
+	0xfc, 0xa5, 0xe2, 0x93, 0x95, 0x54, 0xda, 0xa8, 0xe4, 0x7b, 0x50, 0xed, 0xa1, 0xca, 0x92, 0x0f,
+	0x7f, 0x11, 0xa0, 0xdc, 0xa3, 0x3b, 0xf9, 0x54, 0xc2, 0xd3, 0x30, 0x1a, 0x2f, 0x96, 0x46, 0xb0,
+	0x5f, 0x28, 0x7d, 0x2f, 0x9d, 0xf2, 0x42, 0x06, 0xca, 0x44, 0x96, 0x61, 0xb6, 0x97, 0x2e, 0x98,
+	0xc9, 0x3f, 0x09, 0x30, 0x7e, 0x36, 0xdb, 0xfc, 0xee, 0x2b, 0xde, 0x81, 0x51, 0xb5, 0x4b, 0x0f,
+	0xb0, 0x63, 0xd0, 0x93, 0x73, 0xc9, 0x9c, 0x41, 0xc5, 0x5b, 0x30, 0xe4, 0xde, 0x9e, 0xbd, 0x8d,
+	0xb6, 0x9c, 0xb2, 0xff, 0x71, 0xbd, 0xe2, 0xe1, 0xd6, 0xeb, 0x8c, 0xde, 0x99, 0x05, 0x46, 0x6d,
+	0x3a, 0x3d, 0x45, 0x39, 0x5e, 0x9e, 0x82, 0xc9, 0x98, 0x28, 0x20, 0xf2, 0x37, 0x01, 0x26, 0x76,
+	0x48, 0xfb, 0x87, 0xc7, 0x48, 0xeb, 0x52, 0xf4, 0xb9, 0x2b, 0x58, 0xb1, 0x0e, 0x57, 0x6d, 0x3f,
+	0xa4, 0x21, 0x68, 0x8e, 0x43, 0xaf, 0xd8, 0x91, 0x68, 0xb3, 0x89, 0xac, 0xc5, 0x2b, 0xde, 0x99,
+	0x18, 0xcf, 0xc8, 0xc0, 0x65, 0x09, 0xca, 0x71, 0x59, 0xc0, 0xf4, 0xd4, 0x2b, 0xd7, 0x99, 0x2d,
+	0x57, 0xf7, 0x63, 0x07, 0x77, 0xed, 0x4f, 0xcd, 0xc3, 0xcf, 0xc3, 0xfb, 0x4e, 0x7a, 0x02, 0x57,
+	0x53, 0xab, 0x8c, 0x33, 0x32, 0xf2, 0x77, 0x61, 0x3a, 0x45, 0x1c, 0x5e, 0xab, 0x6d, 0x26, 0x08,
+	0xad, 0x55, 0xde, 0x6e, 0xea, 0xf2, 0x1f, 0xc3, 0xb7, 0x99, 0xcb, 0x0b, 0x4f, 0xd8, 0xe3, 0x60,
+	0xc4, 0x63, 0x66, 0x8e, 0xb1, 0x11, 0x45, 0xee, 0x34, 0x49, 0x8e, 0xf2, 0x9b, 0x1c, 0x4c, 0x06,
+	0x31, 0xd8, 0xd3, 0x0e, 0x90, 0xde, 0x35, 0x91, 0x7e, 0x29, 0x9b, 0xec, 0x5d, 0x18, 0xf2, 0x4a,
+	0x8c, 0xc1, 0x6c, 0x17, 0x10, 0x0f, 0x2e, 0xce, 0x41, 0x31, 0xf2, 0xcc, 0x92, 0xe3, 0x77, 0x8f,
+	0x02, 0x0d, 0xbd, 0xb0, 0x54, 0xa1, 0x40, 0x4c, 0x43, 0x43, 0x2d, 0x8d, 0x3b, 0xc8, 0xbb, 0xa5,
+	0x12, 0x17, 0x6d, 0x71, 0x1b, 0x12, 0x8c, 0x18, 0x16, 0x45, 0xce, 0x91, 0x6a, 0xf2, 0x0a, 0x27,
+	0xaf, 0x04, 0x6d, 0xf1, 0x21, 0x94, 0xfc, 0xef, 0x56, 0xd7, 0x32, 0xa8, 0x57, 0xb1, 0x7c, 0x33,
+	0xe5, 0x5d, 0xcb, 0x0b, 0x48, 0xd3, 0x83, 0x3f, 0xb5, 0x0c, 0xaa, 0x14, 0x8d, 0x50, 0x4b, 0x7c,
+	0x02, 0xc5, 0x8e, 0x7a, 0xdc, 0x22, 0xa6, 0x61, 0xdb, 0xec, 0x1e, 0x73, 0xe1, 0xfb, 0x46, 0xa1,
+	0xa3, 0x1e, 0xef, 0x79, 0x56, 0x32, 0x9f, 0x42, 0x29, 0xd3, 0xe6, 0x9f, 0x42, 0x29, 0xaa, 0x2c,
+	0xa7, 0x50, 0xe4, 0xd4, 0xbd, 0xdc, 0x84, 0xb8, 0xac, 0x53, 0x37, 0xc6, 0x77, 0x0e, 0xaa, 0x3d,
+	0x54, 0x3e, 0xdf, 0xb5, 0xdf, 0x95, 0x20, 0xb7, 0x43, 0xda, 0x22, 0x82, 0xf1, 0xf8, 0x0b, 0x67,
+	0x4a, 0xfd, 0x9c, 0x7c, 0xcc, 0x92, 0x56, 0xb2, 0xa0, 0x82, 0xf0, 0x22, 0x18, 0x8f, 0xbf, 0x69,
+	0xa5, 0xbb, 0x89, 0xa1, 0xa4, 0x95, 0x2c, 0xa8, 0xb0, 0x9b, 0xf8, 0x7b, 0x51, 0x0f, 0x36, 0x51,
+	0x94, 0xb4, 0x92, 0x05, 0x15, 0xb8, 0x39, 0x80, 0x89, 0xc4, 0xab, 0xcd, 0xcd, 0x2c, 0x16, 0x88,
+	0x54, 0xcb, 0x04, 0x0b, 0x3c, 0xbd, 0x10, 0xa0, 0xdc, 0xf3, 0x75, 0xa5, 0xd6, 0x67, 0x0a, 0x92,
+	0x70, 0xe9, 0xf6, 0x47, 0xc1, 0x83, 0x21, 0xfc, 0x5a, 0x80, 0xa9, 0xde, 0x77, 0xc5, 0x7a, 0x16,
+	0xa3, 0x67, 0x78, 0xe9, 0xce, 0xc7, 0xe1, 0x83, 0x51, 0x50, 0xb8, 0x96, 0x7a, 0x2d, 0x58, 0xea,
+	0x93, 0x1f, 0x51, 0xa8, 0xb4, 0x9a, 0x19, 0x1a, 0xf6, 0x9a, 0x5a, 0x6c, 0x2f, 0xf5, 0x99, 0xc5,
+	0x4c, 0x5e, 0xfb, 0x56, 0xc4, 0xcf, 0xe1, 0x7a, 0x7a, 0xc9, 0xbb, 0x9c, 0xd9, 0x16, 0x91, 0xd6,
+	0xb2, 0x63, 0x03, 0xc7, 0xbf, 0x80, 0x62, 0xa4, 0x22, 0x9d, 0xeb, 0x17, 0x31, 0x0e, 0x91, 0x96,
+	0xce, 0x85, 0x04, 0xd6, 0x5b, 0x50, 0x8a, 0x96, 0x89, 0x72, 0x6a, 0xdf, 0x08, 0x46, 0x5a, 0x3e,
+	0x1f, 0x13, 0x59, 0x96, 0xf1, 0xea, 0xec, 0x66, 0x9f, 0x7c, 0x3b, 0x83, 0x49, 0xb5, 0x4c, 0xb0,
+	0xe4, 0x06, 0x70, 0xbe, 0xa7, 0x18, 0x4c, 0xaa, 0x65, 0x82, 0x45, 0x32, 0x30, 0xad, 0x12, 0x59,
+	0xea, 0xb7, 0xfd, 0x46, 0xa0, 0xd2, 0x6a, 0x66, 0x68, 0x32, 0xef, 0xb3, 0x79, 0x4d, 0x81, 0x4a,
+	0xab, 0x99, 0xa1, 0xbe, 0x57, 0xe9, 0xab, 0x17, 0x1f, 0x5e, 0x2e, 0x0b, 0x9b, 0x3f, 0x79, 0x7d,
+	0x5a, 0x11, 0xde, 0x9c, 0x56, 0x84, 0x7f, 0x9d, 0x56, 0x84, 0xdf, 0xbe, 0xaf, 0x0c, 0xbc, 0x79,
+	0x5f, 0x19, 0xf8, 0xc7, 0xfb, 0xca, 0xc0, 0xcf, 0x6f, 0xb5, 0x0d, 0x7a, 0xd0, 0xdd, 0xaf, 0x6b,
+	0xb8, 0xd3, 0x60, 0xd6, 0x6b, 0x16, 0xa2, 0xcf, 0xb1, 0x73, 0xc8, 0x1b, 0x8d, 0xe3, 0xe4, 0x2f,
+	0x86, 0xfb, 0x43, 0xfc, 0xb7, 0xbc, 0xef, 0xfc, 0x2f, 0x00, 0x00, 0xff, 0xff, 0x28, 0xbd, 0x97,
+	0x79, 0xd2, 0x1c, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	CreateSpotOrder(ctx context.Context, in *MsgCreateSpotOrder, opts ...grpc.CallOption) (*MsgCreateSpotOrderResponse, error)
+	UpdateSpotOrder(ctx context.Context, in *MsgUpdateSpotOrder, opts ...grpc.CallOption) (*MsgUpdateSpotOrderResponse, error)
+	CancelSpotOrder(ctx context.Context, in *MsgCancelSpotOrder, opts ...grpc.CallOption) (*MsgCancelSpotOrderResponse, error)
+	CancelSpotOrders(ctx context.Context, in *MsgCancelSpotOrders, opts ...grpc.CallOption) (*MsgCancelSpotOrdersResponse, error)
+	CreatePerpetualOpenOrder(ctx context.Context, in *MsgCreatePerpetualOpenOrder, opts ...grpc.CallOption) (*MsgCreatePerpetualOpenOrderResponse, error)
+	CreatePerpetualCloseOrder(ctx context.Context, in *MsgCreatePerpetualCloseOrder, opts ...grpc.CallOption) (*MsgCreatePerpetualCloseOrderResponse, error)
+	UpdatePerpetualOrder(ctx context.Context, in *MsgUpdatePerpetualOrder, opts ...grpc.CallOption) (*MsgUpdatePerpetualOrderResponse, error)
+	CancelPerpetualOrder(ctx context.Context, in *MsgCancelPerpetualOrder, opts ...grpc.CallOption) (*MsgCancelPerpetualOrderResponse, error)
+	CancelPerpetualOrders(ctx context.Context, in *MsgCancelPerpetualOrders, opts ...grpc.CallOption) (*MsgCancelPerpetualOrdersResponse, error)
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	ExecuteOrders(ctx context.Context, in *MsgExecuteOrders, opts ...grpc.CallOption) (*MsgExecuteOrdersResponse, error)
+	CreateOrderGroup(ctx context.Context, in *MsgCreateOrderGroup, opts ...grpc.CallOption) (*MsgCreateOrderGroupResponse, error)
+	CancelOrderGroup(ctx context.Context, in *MsgCancelOrderGroup, opts ...grpc.CallOption) (*MsgCancelOrderGroupResponse, error)
+	CreateScheduledOrder(ctx context.Context, in *MsgCreateScheduledOrder, opts ...grpc.CallOption) (*MsgCreateScheduledOrderResponse, error)
+	CancelScheduledOrder(ctx context.Context, in *MsgCancelScheduledOrder, opts ...grpc.CallOption) (*MsgCancelScheduledOrderResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) CreateSpotOrder(ctx context.Context, in *MsgCreateSpotOrder, opts ...grpc.CallOption) (*MsgCreateSpotOrderResponse, error) {
+	out := new(MsgCreateSpotOrderResponse)
+	err := c.cc.Invoke(ctx, "/elys.tradeshield.Msg/CreateSpotOrder", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgCreateSpotOrderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateSpotOrder(ctx context.Context, in *MsgUpdateSpotOrder, opts ...grpc.CallOption) (*MsgUpdateSpotOrderResponse, error) {
+	out := new(MsgUpdateSpotOrderResponse)
+	err := c.cc.Invoke(ctx, "/elys.tradeshield.Msg/UpdateSpotOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgCreateSpotOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.OrderId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.OrderId))
-		i--
-		dAtA[i] = 0x8
+func (c *msgClient) CancelSpotOrder(ctx context.Context, in *MsgCancelSpotOrder, opts ...grpc.CallOption) (*MsgCancelSpotOrderResponse, error) {
+	out := new(MsgCancelSpotOrderResponse)
+	err := c.cc.Invoke(ctx, "/elys.tradeshield.Msg/CancelSpotOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgUpdateSpotOrder) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CancelSpotOrders(ctx context.Context, in *MsgCancelSpotOrders, opts ...grpc.CallOption) (*MsgCancelSpotOrdersResponse, error) {
+	out := new(MsgCancelSpotOrdersResponse)
+	err := c.cc.Invoke(ctx, "/elys.tradeshield.Msg/CancelSpotOrders", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgUpdateSpotOrder) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CreatePerpetualOpenOrder(ctx context.Context, in *MsgCreatePerpetualOpenOrder, opts ...grpc.CallOption) (*MsgCreatePerpetualOpenOrderResponse, error) {
+	out := new(MsgCreatePerpetualOpenOrderResponse)
+	err := c.cc.Invoke(ctx, "/elys.tradeshield.Msg/CreatePerpetualOpenOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUpdateSpotOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.OrderPrice.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if m.OrderId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.OrderId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.OwnerAddress) > 0 {
-		i -= len(m.OwnerAddress)
-		copy(dAtA[i:], m.OwnerAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OwnerAddress)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) CreatePerpetualCloseOrder(ctx context.Context, in *MsgCreatePerpetualCloseOrder, opts ...grpc.CallOption) (*MsgCreatePerpetualCloseOrderResponse, error) {
+	out := new(MsgCreatePerpetualCloseOrderResponse)
+	err := c.cc.Invoke(ctx, "/elys.tradeshield.Msg/CreatePerpetualCloseOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgUpdateSpotOrderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdatePerpetualOrder(ctx context.Context, in *MsgUpdatePerpetualOrder, opts ...grpc.CallOption) (*MsgUpdatePerpetualOrderResponse, error) {
+	out := new(MsgUpdatePerpetualOrderResponse)
+	err := c.cc.Invoke(ctx, "/elys.tradeshield.Msg/UpdatePerpetualOrder", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgUpdateSpotOrderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgUpdateSpotOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgCancelSpotOrder) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CancelPerpetualOrder(ctx context.Context, in *MsgCancelPerpetualOrder, opts ...grpc.CallOption) (*MsgCancelPerpetualOrderResponse, error) {
+	out := new(MsgCancelPerpetualOrderResponse)
+	err := c.cc.Invoke(ctx, "/elys.tradeshield.Msg/CancelPerpetualOrder", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgCancelSpotOrder) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *MsgCancelSpotOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.OrderId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.OrderId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.OwnerAddress) > 0 {
-		i -= len(m.OwnerAddress)
-		copy(dAtA[i:], m.OwnerAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OwnerAddress)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) CancelPerpetualOrders(ctx context.Context, in *MsgCancelPerpetualOrders, opts ...grpc.CallOption) (*MsgCancelPerpetualOrdersResponse, error) {
+	out := new(MsgCancelPerpetualOrdersResponse)
+	err := c.cc.Invoke(ctx, "/elys.tradeshield.Msg/CancelPerpetualOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgCancelSpotOrderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/elys.tradeshield.Msg/UpdateParams", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgCancelSpotOrderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *MsgCancelSpotOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.OrderId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.OrderId))
-		i--
-		dAtA[i] = 0x8
+func (c *msgClient) ExecuteOrders(ctx context.Context, in *MsgExecuteOrders, opts ...grpc.CallOption) (*MsgExecuteOrdersResponse, error) {
+	out := new(MsgExecuteOrdersResponse)
+	err := c.cc.Invoke(ctx, "/elys.tradeshield.Msg/ExecuteOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgCancelSpotOrders) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CreateOrderGroup(ctx context.Context, in *MsgCreateOrderGroup, opts ...grpc.CallOption) (*MsgCreateOrderGroupResponse, error) {
+	out := new(MsgCreateOrderGroupResponse)
+	err := c.cc.Invoke(ctx, "/elys.tradeshield.Msg/CreateOrderGroup", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgCancelSpotOrders) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CancelOrderGroup(ctx context.Context, in *MsgCancelOrderGroup, opts ...grpc.CallOption) (*MsgCancelOrderGroupResponse, error) {
+	out := new(MsgCancelOrderGroupResponse)
+	err := c.cc.Invoke(ctx, "/elys.tradeshield.Msg/CancelOrderGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgCancelSpotOrders) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SpotOrderIds) > 0 {
-		dAtA5 := make([]byte, len(m.SpotOrderIds)*10)
-		var j4 int
-		for _, num := range m.SpotOrderIds {
-			for num >= 1<<7 {
-				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j4++
-			}
-			dAtA5[j4] = uint8(num)
-			j4++
-		}
-		i -= j4
-		copy(dAtA[i:], dAtA5[:j4])
-		i = encodeVarintTx(dAtA, i, uint64(j4))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) CreateScheduledOrder(ctx context.Context, in *MsgCreateScheduledOrder, opts ...grpc.CallOption) (*MsgCreateScheduledOrderResponse, error) {
+	out := new(MsgCreateScheduledOrderResponse)
+	err := c.cc.Invoke(ctx, "/elys.tradeshield.Msg/CreateScheduledOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgCancelSpotOrdersResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CancelScheduledOrder(ctx context.Context, in *MsgCancelScheduledOrder, opts ...grpc.CallOption) (*MsgCancelScheduledOrderResponse, error) {
+	out := new(MsgCancelScheduledOrderResponse)
+	err := c.cc.Invoke(ctx, "/elys.tradeshield.Msg/CancelScheduledOrder", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgCancelSpotOrdersResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	CreateSpotOrder(context.Context, *MsgCreateSpotOrder) (*MsgCreateSpotOrderResponse, error)
+	UpdateSpotOrder(context.Context, *MsgUpdateSpotOrder) (*MsgUpdateSpotOrderResponse, error)
+	CancelSpotOrder(context.Context, *MsgCancelSpotOrder) (*MsgCancelSpotOrderResponse, error)
+	CancelSpotOrders(context.Context, *MsgCancelSpotOrders) (*MsgCancelSpotOrdersResponse, error)
+	CreatePerpetualOpenOrder(context.Context, *MsgCreatePerpetualOpenOrder) (*MsgCreatePerpetualOpenOrderResponse, error)
+	CreatePerpetualCloseOrder(context.Context, *MsgCreatePerpetualCloseOrder) (*MsgCreatePerpetualCloseOrderResponse, error)
+	UpdatePerpetualOrder(context.Context, *MsgUpdatePerpetualOrder) (*MsgUpdatePerpetualOrderResponse, error)
+	CancelPerpetualOrder(context.Context, *MsgCancelPerpetualOrder) (*MsgCancelPerpetualOrderResponse, error)
+	CancelPerpetualOrders(context.Context, *MsgCancelPerpetualOrders) (*MsgCancelPerpetualOrdersResponse, error)
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	ExecuteOrders(context.Context, *MsgExecuteOrders) (*MsgExecuteOrdersResponse, error)
+	CreateOrderGroup(context.Context, *MsgCreateOrderGroup) (*MsgCreateOrderGroupResponse, error)
+	CancelOrderGroup(context.Context, *MsgCancelOrderGroup) (*MsgCancelOrderGroupResponse, error)
+	CreateScheduledOrder(context.Context, *MsgCreateScheduledOrder) (*MsgCreateScheduledOrderResponse, error)
+	CancelScheduledOrder(context.Context, *MsgCancelScheduledOrder) (*MsgCancelScheduledOrderResponse, error)
 }
 
-func (m *MsgCancelSpotOrdersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
 }
 
-func (m *MsgCreatePerpetualOpenOrder) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) CreateSpotOrder(ctx context.Context, req *MsgCreateSpotOrder) (*MsgCreateSpotOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSpotOrder not implemented")
+}
+func (*UnimplementedMsgServer) UpdateSpotOrder(ctx context.Context, req *MsgUpdateSpotOrder) (*MsgUpdateSpotOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSpotOrder not implemented")
+}
+func (*UnimplementedMsgServer) CancelSpotOrder(ctx context.Context, req *MsgCancelSpotOrder) (*MsgCancelSpotOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelSpotOrder not implemented")
+}
+func (*UnimplementedMsgServer) CancelSpotOrders(ctx context.Context, req *MsgCancelSpotOrders) (*MsgCancelSpotOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelSpotOrders not implemented")
+}
+func (*UnimplementedMsgServer) CreatePerpetualOpenOrder(ctx context.Context, req *MsgCreatePerpetualOpenOrder) (*MsgCreatePerpetualOpenOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePerpetualOpenOrder not implemented")
+}
+func (*UnimplementedMsgServer) CreatePerpetualCloseOrder(ctx context.Context, req *MsgCreatePerpetualCloseOrder) (*MsgCreatePerpetualCloseOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePerpetualCloseOrder not implemented")
+}
+func (*UnimplementedMsgServer) UpdatePerpetualOrder(ctx context.Context, req *MsgUpdatePerpetualOrder) (*MsgUpdatePerpetualOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePerpetualOrder not implemented")
+}
+func (*UnimplementedMsgServer) CancelPerpetualOrder(ctx context.Context, req *MsgCancelPerpetualOrder) (*MsgCancelPerpetualOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelPerpetualOrder not implemented")
+}
+func (*UnimplementedMsgServer) CancelPerpetualOrders(ctx context.Context, req *MsgCancelPerpetualOrders) (*MsgCancelPerpetualOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelPerpetualOrders not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) ExecuteOrders(ctx context.Context, req *MsgExecuteOrders) (*MsgExecuteOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecuteOrders not implemented")
+}
+func (*UnimplementedMsgServer) CreateOrderGroup(ctx context.Context, req *MsgCreateOrderGroup) (*MsgCreateOrderGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOrderGroup not implemented")
+}
+func (*UnimplementedMsgServer) CancelOrderGroup(ctx context.Context, req *MsgCancelOrderGroup) (*MsgCancelOrderGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelOrderGroup not implemented")
+}
+func (*UnimplementedMsgServer) CreateScheduledOrder(ctx context.Context, req *MsgCreateScheduledOrder) (*MsgCreateScheduledOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateScheduledOrder not implemented")
+}
+func (*UnimplementedMsgServer) CancelScheduledOrder(ctx context.Context, req *MsgCancelScheduledOrder) (*MsgCancelScheduledOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledOrder not implemented")
 }
 
-func (m *MsgCreatePerpetualOpenOrder) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
 }
 
-func (m *MsgCreatePerpetualOpenOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x48
+func _Msg_CreateSpotOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateSpotOrder)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	{
-		size := m.StopLossPrice.Size()
-		i -= size
-		if _, err := m.StopLossPrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	if interceptor == nil {
+		return srv.(MsgServer).CreateSpotOrder(ctx, in)
 	}
-	i--
-	dAtA[i] = 0x42
-	{
-		size := m.TakeProfitPrice.Size()
-		i -= size
-		if _, err := m.TakeProfitPrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x3a
-	{
-		size := m.Leverage.Size()
-		i -= size
-		if _, err := m.Leverage.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elys.tradeshield.Msg/CreateSpotOrder",
 	}
-	i--
-	dAtA[i] = 0x32
-	if m.Position != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Position))
-		i--
-		dAtA[i] = 0x28
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateSpotOrder(ctx, req.(*MsgCreateSpotOrder))
 	}
-	if len(m.TradingAsset) > 0 {
-		i -= len(m.TradingAsset)
-		copy(dAtA[i:], m.TradingAsset)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TradingAsset)))
-		i--
-		dAtA[i] = 0x22
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateSpotOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateSpotOrder)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	{
-		size, err := m.Collateral.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateSpotOrder(ctx, in)
 	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size, err := m.TriggerPrice.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elys.tradeshield.Msg/UpdateSpotOrder",
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.OwnerAddress) > 0 {
-		i -= len(m.OwnerAddress)
-		copy(dAtA[i:], m.OwnerAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OwnerAddress)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateSpotOrder(ctx, req.(*MsgUpdateSpotOrder))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreatePerpetualOpenOrderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_CancelSpotOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelSpotOrder)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgCreatePerpetualOpenOrderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgCreatePerpetualOpenOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.OrderId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.OrderId))
-		i--
-		dAtA[i] = 0x8
+	if interceptor == nil {
+		return srv.(MsgServer).CancelSpotOrder(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elys.tradeshield.Msg/CancelSpotOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelSpotOrder(ctx, req.(*MsgCancelSpotOrder))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreatePerpetualCloseOrder) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_CancelSpotOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelSpotOrders)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgCreatePerpetualCloseOrder) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgCreatePerpetualCloseOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PositionId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.PositionId))
-		i--
-		dAtA[i] = 0x18
+	if interceptor == nil {
+		return srv.(MsgServer).CancelSpotOrders(ctx, in)
 	}
-	{
-		size, err := m.TriggerPrice.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elys.tradeshield.Msg/CancelSpotOrders",
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.OwnerAddress) > 0 {
-		i -= len(m.OwnerAddress)
-		copy(dAtA[i:], m.OwnerAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OwnerAddress)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelSpotOrders(ctx, req.(*MsgCancelSpotOrders))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreatePerpetualCloseOrderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_CreatePerpetualOpenOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreatePerpetualOpenOrder)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgCreatePerpetualCloseOrderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgCreatePerpetualCloseOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.OrderId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.OrderId))
-		i--
-		dAtA[i] = 0x8
+	if interceptor == nil {
+		return srv.(MsgServer).CreatePerpetualOpenOrder(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elys.tradeshield.Msg/CreatePerpetualOpenOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreatePerpetualOpenOrder(ctx, req.(*MsgCreatePerpetualOpenOrder))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdatePerpetualOrder) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_CreatePerpetualCloseOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreatePerpetualCloseOrder)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgUpdatePerpetualOrder) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgUpdatePerpetualOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.TriggerPrice.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	if interceptor == nil {
+		return srv.(MsgServer).CreatePerpetualCloseOrder(ctx, in)
 	}
-	i--
-	dAtA[i] = 0x1a
-	if m.OrderId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.OrderId))
-		i--
-		dAtA[i] = 0x10
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elys.tradeshield.Msg/CreatePerpetualCloseOrder",
 	}
-	if len(m.OwnerAddress) > 0 {
-		i -= len(m.OwnerAddress)
-		copy(dAtA[i:], m.OwnerAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OwnerAddress)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreatePerpetualCloseOrder(ctx, req.(*MsgCreatePerpetualCloseOrder))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdatePerpetualOrderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_UpdatePerpetualOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdatePerpetualOrder)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdatePerpetualOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elys.tradeshield.Msg/UpdatePerpetualOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdatePerpetualOrder(ctx, req.(*MsgUpdatePerpetualOrder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
-func (m *MsgUpdatePerpetualOrderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_CancelPerpetualOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelPerpetualOrder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelPerpetualOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elys.tradeshield.Msg/CancelPerpetualOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelPerpetualOrder(ctx, req.(*MsgCancelPerpetualOrder))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdatePerpetualOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_CancelPerpetualOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelPerpetualOrders)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelPerpetualOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elys.tradeshield.Msg/CancelPerpetualOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelPerpetualOrders(ctx, req.(*MsgCancelPerpetualOrders))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCancelPerpetualOrder) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elys.tradeshield.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCancelPerpetualOrder) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_ExecuteOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgExecuteOrders)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ExecuteOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elys.tradeshield.Msg/ExecuteOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ExecuteOrders(ctx, req.(*MsgExecuteOrders))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCancelPerpetualOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.OrderId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.OrderId))
-		i--
-		dAtA[i] = 0x10
+func _Msg_CreateOrderGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateOrderGroup)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.OwnerAddress) > 0 {
-		i -= len(m.OwnerAddress)
-		copy(dAtA[i:], m.OwnerAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OwnerAddress)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(MsgServer).CreateOrderGroup(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elys.tradeshield.Msg/CreateOrderGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateOrderGroup(ctx, req.(*MsgCreateOrderGroup))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCancelPerpetualOrderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_CancelOrderGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelOrderGroup)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).CancelOrderGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elys.tradeshield.Msg/CancelOrderGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelOrderGroup(ctx, req.(*MsgCancelOrderGroup))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCancelPerpetualOrderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_CreateScheduledOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateScheduledOrder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateScheduledOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elys.tradeshield.Msg/CreateScheduledOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateScheduledOrder(ctx, req.(*MsgCreateScheduledOrder))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCancelPerpetualOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.OrderId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.OrderId))
-		i--
-		dAtA[i] = 0x8
+func _Msg_CancelScheduledOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelScheduledOrder)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(MsgServer).CancelScheduledOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elys.tradeshield.Msg/CancelScheduledOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelScheduledOrder(ctx, req.(*MsgCancelScheduledOrder))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCancelPerpetualOrders) Marshal() (dAtA []byte, err error) {
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "elys.tradeshield.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateSpotOrder",
+			Handler:    _Msg_CreateSpotOrder_Handler,
+		},
+		{
+			MethodName: "UpdateSpotOrder",
+			Handler:    _Msg_UpdateSpotOrder_Handler,
+		},
+		{
+			MethodName: "CancelSpotOrder",
+			Handler:    _Msg_CancelSpotOrder_Handler,
+		},
+		{
+			MethodName: "CancelSpotOrders",
+			Handler:    _Msg_CancelSpotOrders_Handler,
+		},
+		{
+			MethodName: "CreatePerpetualOpenOrder",
+			Handler:    _Msg_CreatePerpetualOpenOrder_Handler,
+		},
+		{
+			MethodName: "CreatePerpetualCloseOrder",
+			Handler:    _Msg_CreatePerpetualCloseOrder_Handler,
+		},
+		{
+			MethodName: "UpdatePerpetualOrder",
+			Handler:    _Msg_UpdatePerpetualOrder_Handler,
+		},
+		{
+			MethodName: "CancelPerpetualOrder",
+			Handler:    _Msg_CancelPerpetualOrder_Handler,
+		},
+		{
+			MethodName: "CancelPerpetualOrders",
+			Handler:    _Msg_CancelPerpetualOrders_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "ExecuteOrders",
+			Handler:    _Msg_ExecuteOrders_Handler,
+		},
+		{
+			MethodName: "CreateOrderGroup",
+			Handler:    _Msg_CreateOrderGroup_Handler,
+		},
+		{
+			MethodName: "CancelOrderGroup",
+			Handler:    _Msg_CancelOrderGroup_Handler,
+		},
+		{
+			MethodName: "CreateScheduledOrder",
+			Handler:    _Msg_CreateScheduledOrder_Handler,
+		},
+		{
+			MethodName: "CancelScheduledOrder",
+			Handler:    _Msg_CancelScheduledOrder_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "elys/tradeshield/tx.proto",
+}
+
+func (m *MsgCreateSpotOrder) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2317,45 +2377,88 @@ func (m *MsgCancelPerpetualOrders) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgCancelPerpetualOrders) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCreateSpotOrder) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCancelPerpetualOrders) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCreateSpotOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.OrderIds) > 0 {
-		dAtA11 := make([]byte, len(m.OrderIds)*10)
-		var j10 int
-		for _, num := range m.OrderIds {
-			for num >= 1<<7 {
-				dAtA11[j10] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j10++
+	if m.TrailingStop != nil {
+		{
+			size, err := m.TrailingStop.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			dAtA11[j10] = uint8(num)
-			j10++
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
-		i -= j10
-		copy(dAtA[i:], dAtA11[:j10])
-		i = encodeVarintTx(dAtA, i, uint64(j10))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x42
 	}
-	if len(m.OwnerAddress) > 0 {
-		i -= len(m.OwnerAddress)
-		copy(dAtA[i:], m.OwnerAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OwnerAddress)))
+	if m.Expiry != nil {
+		{
+			size, err := m.Expiry.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x3a
 	}
-	return len(dAtA) - i, nil
+	if m.TimeInForce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TimeInForce))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.OrderTargetDenom) > 0 {
+		i -= len(m.OrderTargetDenom)
+		copy(dAtA[i:], m.OrderTargetDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OrderTargetDenom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.OrderAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.OrderPrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.OrderType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OrderType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCancelPerpetualOrdersResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgCreateSpotOrderResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2365,20 +2468,25 @@ func (m *MsgCancelPerpetualOrdersResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgCancelPerpetualOrdersResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCreateSpotOrderResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCancelPerpetualOrdersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCreateSpotOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.OrderId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+func (m *MsgUpdateSpotOrder) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2388,39 +2496,42 @@ func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUpdateSpotOrder) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUpdateSpotOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Params != nil {
-		{
-			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+	{
+		size, err := m.OrderPrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.OrderId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OrderId))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OwnerAddress)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgUpdateSpotOrderResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2430,12 +2541,12 @@ func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUpdateSpotOrderResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUpdateSpotOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2443,7 +2554,7 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgExecuteOrders) Marshal() (dAtA []byte, err error) {
+func (m *MsgCancelSpotOrder) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2453,49 +2564,94 @@ func (m *MsgExecuteOrders) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgExecuteOrders) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCancelSpotOrder) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgExecuteOrders) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCancelSpotOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.PerpetualOrderIds) > 0 {
-		dAtA14 := make([]byte, len(m.PerpetualOrderIds)*10)
-		var j13 int
-		for _, num := range m.PerpetualOrderIds {
-			for num >= 1<<7 {
-				dAtA14[j13] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j13++
-			}
-			dAtA14[j13] = uint8(num)
-			j13++
-		}
-		i -= j13
-		copy(dAtA[i:], dAtA14[:j13])
-		i = encodeVarintTx(dAtA, i, uint64(j13))
+	if m.OrderId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OrderId))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x10
+	}
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelSpotOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelSpotOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelSpotOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelSpotOrders) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelSpotOrders) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelSpotOrders) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if len(m.SpotOrderIds) > 0 {
-		dAtA16 := make([]byte, len(m.SpotOrderIds)*10)
-		var j15 int
+		dAtA7 := make([]byte, len(m.SpotOrderIds)*10)
+		var j6 int
 		for _, num := range m.SpotOrderIds {
 			for num >= 1<<7 {
-				dAtA16[j15] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j15++
+				j6++
 			}
-			dAtA16[j15] = uint8(num)
-			j15++
+			dAtA7[j6] = uint8(num)
+			j6++
 		}
-		i -= j15
-		copy(dAtA[i:], dAtA16[:j15])
-		i = encodeVarintTx(dAtA, i, uint64(j15))
+		i -= j6
+		copy(dAtA[i:], dAtA7[:j6])
+		i = encodeVarintTx(dAtA, i, uint64(j6))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -2509,7 +2665,7 @@ func (m *MsgExecuteOrders) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgExecuteOrdersResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgCancelSpotOrdersResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2519,12 +2675,12 @@ func (m *MsgExecuteOrdersResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgExecuteOrdersResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCancelSpotOrdersResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgExecuteOrdersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCancelSpotOrdersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2532,365 +2688,2805 @@ func (m *MsgExecuteOrdersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgCreatePerpetualOpenOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgCreateSpotOrder) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.OrderType != 0 {
-		n += 1 + sovTx(uint64(m.OrderType))
-	}
-	l = m.OrderPrice.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.OrderAmount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.OwnerAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.OrderTargetDenom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+
+func (m *MsgCreatePerpetualOpenOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCreateSpotOrderResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgCreatePerpetualOpenOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.OrderId != 0 {
-		n += 1 + sovTx(uint64(m.OrderId))
+	if len(m.BracketOrders) > 0 {
+		for iNdEx := len(m.BracketOrders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BracketOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
 	}
-	return n
-}
-
-func (m *MsgUpdateSpotOrder) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Expiry != nil {
+		{
+			size, err := m.Expiry.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
 	}
-	var l int
-	_ = l
-	l = len(m.OwnerAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.TimeInForce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TimeInForce))
+		i--
+		dAtA[i] = 0x50
 	}
-	if m.OrderId != 0 {
-		n += 1 + sovTx(uint64(m.OrderId))
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x48
 	}
-	l = m.OrderPrice.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
-
-func (m *MsgUpdateSpotOrderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	{
+		size := m.StopLossPrice.Size()
+		i -= size
+		if _, err := m.StopLossPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgCancelSpotOrder) Size() (n int) {
-	if m == nil {
-		return 0
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.TakeProfitPrice.Size()
+		i -= size
+		if _, err := m.TakeProfitPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	var l int
-	_ = l
-	l = len(m.OwnerAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.Leverage.Size()
+		i -= size
+		if _, err := m.Leverage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	if m.OrderId != 0 {
-		n += 1 + sovTx(uint64(m.OrderId))
+	i--
+	dAtA[i] = 0x32
+	if m.Position != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Position))
+		i--
+		dAtA[i] = 0x28
 	}
-	return n
+	if len(m.TradingAsset) > 0 {
+		i -= len(m.TradingAsset)
+		copy(dAtA[i:], m.TradingAsset)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TradingAsset)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.Collateral.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.TriggerPrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCancelSpotOrderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCreatePerpetualOpenOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreatePerpetualOpenOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreatePerpetualOpenOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.OrderId != 0 {
-		n += 1 + sovTx(uint64(m.OrderId))
+		i = encodeVarintTx(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCancelSpotOrders) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCreatePerpetualCloseOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreatePerpetualCloseOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreatePerpetualCloseOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.TrailingStop != nil {
+		{
+			size, err := m.TrailingStop.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
 	}
-	if len(m.SpotOrderIds) > 0 {
-		l = 0
-		for _, e := range m.SpotOrderIds {
-			l += sovTx(uint64(e))
+	if m.Expiry != nil {
+		{
+			size, err := m.Expiry.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
-		n += 1 + sovTx(uint64(l)) + l
+		i--
+		dAtA[i] = 0x3a
 	}
-	return n
-}
-
-func (m *MsgCancelSpotOrdersResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.TimeInForce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TimeInForce))
+		i--
+		dAtA[i] = 0x30
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgCreatePerpetualOpenOrder) Size() (n int) {
-	if m == nil {
-		return 0
+	{
+		size := m.CloseAmount.Size()
+		i -= size
+		if _, err := m.CloseAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	var l int
-	_ = l
-	l = len(m.OwnerAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x2a
+	if m.OrderType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OrderType))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = m.TriggerPrice.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.Collateral.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.TradingAsset)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.PositionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PositionId))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.Position != 0 {
-		n += 1 + sovTx(uint64(m.Position))
+	{
+		size, err := m.TriggerPrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = m.Leverage.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.TakeProfitPrice.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.StopLossPrice.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.PoolId != 0 {
-		n += 1 + sovTx(uint64(m.PoolId))
+	i--
+	dAtA[i] = 0x12
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreatePerpetualOpenOrderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCreatePerpetualCloseOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreatePerpetualCloseOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreatePerpetualCloseOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.OrderId != 0 {
-		n += 1 + sovTx(uint64(m.OrderId))
+		i = encodeVarintTx(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreatePerpetualCloseOrder) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.OwnerAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.TriggerPrice.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.PositionId != 0 {
-		n += 1 + sovTx(uint64(m.PositionId))
+func (m *MsgUpdatePerpetualOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgCreatePerpetualCloseOrderResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.OrderId != 0 {
-		n += 1 + sovTx(uint64(m.OrderId))
-	}
-	return n
+func (m *MsgUpdatePerpetualOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdatePerpetualOrder) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgUpdatePerpetualOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.OwnerAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.TriggerPrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x1a
 	if m.OrderId != 0 {
-		n += 1 + sovTx(uint64(m.OrderId))
+		i = encodeVarintTx(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = m.TriggerPrice.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdatePerpetualOrderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdatePerpetualOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdatePerpetualOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdatePerpetualOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCancelPerpetualOrder) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCancelPerpetualOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelPerpetualOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelPerpetualOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.OwnerAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
 	if m.OrderId != 0 {
-		n += 1 + sovTx(uint64(m.OrderId))
+		i = encodeVarintTx(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCancelPerpetualOrderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCancelPerpetualOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelPerpetualOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelPerpetualOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.OrderId != 0 {
-		n += 1 + sovTx(uint64(m.OrderId))
+		i = encodeVarintTx(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCancelPerpetualOrders) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCancelPerpetualOrders) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelPerpetualOrders) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelPerpetualOrders) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.OwnerAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
 	if len(m.OrderIds) > 0 {
-		l = 0
-		for _, e := range m.OrderIds {
-			l += sovTx(uint64(e))
+		dAtA16 := make([]byte, len(m.OrderIds)*10)
+		var j15 int
+		for _, num := range m.OrderIds {
+			for num >= 1<<7 {
+				dAtA16[j15] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j15++
+			}
+			dAtA16[j15] = uint8(num)
+			j15++
 		}
-		n += 1 + sovTx(uint64(l)) + l
+		i -= j15
+		copy(dAtA[i:], dAtA16[:j15])
+		i = encodeVarintTx(dAtA, i, uint64(j15))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCancelPerpetualOrdersResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCancelPerpetualOrdersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Params != nil {
-		l = m.Params.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgCancelPerpetualOrdersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgCancelPerpetualOrdersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgExecuteOrders) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExecuteOrders) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExecuteOrders) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExecuteOrders) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PerpetualOrderIds) > 0 {
+		dAtA19 := make([]byte, len(m.PerpetualOrderIds)*10)
+		var j18 int
+		for _, num := range m.PerpetualOrderIds {
+			for num >= 1<<7 {
+				dAtA19[j18] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j18++
+			}
+			dAtA19[j18] = uint8(num)
+			j18++
+		}
+		i -= j18
+		copy(dAtA[i:], dAtA19[:j18])
+		i = encodeVarintTx(dAtA, i, uint64(j18))
+		i--
+		dAtA[i] = 0x1a
 	}
 	if len(m.SpotOrderIds) > 0 {
-		l = 0
-		for _, e := range m.SpotOrderIds {
-			l += sovTx(uint64(e))
+		dAtA21 := make([]byte, len(m.SpotOrderIds)*10)
+		var j20 int
+		for _, num := range m.SpotOrderIds {
+			for num >= 1<<7 {
+				dAtA21[j20] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j20++
+			}
+			dAtA21[j20] = uint8(num)
+			j20++
 		}
-		n += 1 + sovTx(uint64(l)) + l
+		i -= j20
+		copy(dAtA[i:], dAtA21[:j20])
+		i = encodeVarintTx(dAtA, i, uint64(j20))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExecuteOrdersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExecuteOrdersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExecuteOrdersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateOrderGroup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateOrderGroup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateOrderGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if len(m.PerpetualOrderIds) > 0 {
-		l = 0
-		for _, e := range m.PerpetualOrderIds {
-			l += sovTx(uint64(e))
+		dAtA23 := make([]byte, len(m.PerpetualOrderIds)*10)
+		var j22 int
+		for _, num := range m.PerpetualOrderIds {
+			for num >= 1<<7 {
+				dAtA23[j22] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j22++
+			}
+			dAtA23[j22] = uint8(num)
+			j22++
+		}
+		i -= j22
+		copy(dAtA[i:], dAtA23[:j22])
+		i = encodeVarintTx(dAtA, i, uint64(j22))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SpotOrderIds) > 0 {
+		dAtA25 := make([]byte, len(m.SpotOrderIds)*10)
+		var j24 int
+		for _, num := range m.SpotOrderIds {
+			for num >= 1<<7 {
+				dAtA25[j24] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j24++
+			}
+			dAtA25[j24] = uint8(num)
+			j24++
+		}
+		i -= j24
+		copy(dAtA[i:], dAtA25[:j24])
+		i = encodeVarintTx(dAtA, i, uint64(j24))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateOrderGroupResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateOrderGroupResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateOrderGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GroupId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GroupId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelOrderGroup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelOrderGroup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelOrderGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GroupId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GroupId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelOrderGroupResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelOrderGroupResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelOrderGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateScheduledOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateScheduledOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateScheduledOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxSlippage.Size()
+		i -= size
+		if _, err := m.MaxSlippage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.IntervalUnit != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.IntervalUnit))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Interval != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.SliceCount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SliceCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.TargetDenom) > 0 {
+		i -= len(m.TargetDenom)
+		copy(dAtA[i:], m.TargetDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TargetDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateScheduledOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateScheduledOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateScheduledOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelScheduledOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelScheduledOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelScheduledOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelScheduledOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelScheduledOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelScheduledOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateSpotOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderType != 0 {
+		n += 1 + sovTx(uint64(m.OrderType))
+	}
+	l = m.OrderPrice.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.OrderAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OrderTargetDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TimeInForce != 0 {
+		n += 1 + sovTx(uint64(m.TimeInForce))
+	}
+	if m.Expiry != nil {
+		l = m.Expiry.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TrailingStop != nil {
+		l = m.TrailingStop.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateSpotOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		n += 1 + sovTx(uint64(m.OrderId))
+	}
+	return n
+}
+
+func (m *MsgUpdateSpotOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.OrderId != 0 {
+		n += 1 + sovTx(uint64(m.OrderId))
+	}
+	l = m.OrderPrice.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateSpotOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelSpotOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.OrderId != 0 {
+		n += 1 + sovTx(uint64(m.OrderId))
+	}
+	return n
+}
+
+func (m *MsgCancelSpotOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		n += 1 + sovTx(uint64(m.OrderId))
+	}
+	return n
+}
+
+func (m *MsgCancelSpotOrders) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.SpotOrderIds) > 0 {
+		l = 0
+		for _, e := range m.SpotOrderIds {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MsgCancelSpotOrdersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreatePerpetualOpenOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.TriggerPrice.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Collateral.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.TradingAsset)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Position != 0 {
+		n += 1 + sovTx(uint64(m.Position))
+	}
+	l = m.Leverage.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.TakeProfitPrice.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.StopLossPrice.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	if m.TimeInForce != 0 {
+		n += 1 + sovTx(uint64(m.TimeInForce))
+	}
+	if m.Expiry != nil {
+		l = m.Expiry.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.BracketOrders) > 0 {
+		for _, e := range m.BracketOrders {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCreatePerpetualOpenOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		n += 1 + sovTx(uint64(m.OrderId))
+	}
+	return n
+}
+
+func (m *MsgCreatePerpetualCloseOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.TriggerPrice.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.PositionId != 0 {
+		n += 1 + sovTx(uint64(m.PositionId))
+	}
+	if m.OrderType != 0 {
+		n += 1 + sovTx(uint64(m.OrderType))
+	}
+	l = m.CloseAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.TimeInForce != 0 {
+		n += 1 + sovTx(uint64(m.TimeInForce))
+	}
+	if m.Expiry != nil {
+		l = m.Expiry.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TrailingStop != nil {
+		l = m.TrailingStop.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreatePerpetualCloseOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		n += 1 + sovTx(uint64(m.OrderId))
+	}
+	return n
+}
+
+func (m *MsgUpdatePerpetualOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.OrderId != 0 {
+		n += 1 + sovTx(uint64(m.OrderId))
+	}
+	l = m.TriggerPrice.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdatePerpetualOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelPerpetualOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.OrderId != 0 {
+		n += 1 + sovTx(uint64(m.OrderId))
+	}
+	return n
+}
+
+func (m *MsgCancelPerpetualOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		n += 1 + sovTx(uint64(m.OrderId))
+	}
+	return n
+}
+
+func (m *MsgCancelPerpetualOrders) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.OrderIds) > 0 {
+		l = 0
+		for _, e := range m.OrderIds {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MsgCancelPerpetualOrdersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgExecuteOrders) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.SpotOrderIds) > 0 {
+		l = 0
+		for _, e := range m.SpotOrderIds {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	if len(m.PerpetualOrderIds) > 0 {
+		l = 0
+		for _, e := range m.PerpetualOrderIds {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MsgExecuteOrdersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateOrderGroup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.SpotOrderIds) > 0 {
+		l = 0
+		for _, e := range m.SpotOrderIds {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	if len(m.PerpetualOrderIds) > 0 {
+		l = 0
+		for _, e := range m.PerpetualOrderIds {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MsgCreateOrderGroupResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GroupId != 0 {
+		n += 1 + sovTx(uint64(m.GroupId))
+	}
+	return n
+}
+
+func (m *MsgCancelOrderGroup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GroupId != 0 {
+		n += 1 + sovTx(uint64(m.GroupId))
+	}
+	return n
+}
+
+func (m *MsgCancelOrderGroupResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateScheduledOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.TargetDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SliceCount != 0 {
+		n += 1 + sovTx(uint64(m.SliceCount))
+	}
+	if m.Interval != 0 {
+		n += 1 + sovTx(uint64(m.Interval))
+	}
+	if m.IntervalUnit != 0 {
+		n += 1 + sovTx(uint64(m.IntervalUnit))
+	}
+	l = m.MaxSlippage.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCreateScheduledOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		n += 1 + sovTx(uint64(m.OrderId))
+	}
+	return n
+}
+
+func (m *MsgCancelScheduledOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.OrderId != 0 {
+		n += 1 + sovTx(uint64(m.OrderId))
+	}
+	return n
+}
+
+func (m *MsgCancelScheduledOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateSpotOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateSpotOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateSpotOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderType", wireType)
+			}
+			m.OrderType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderType |= SpotOrderType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OrderPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OrderAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderTargetDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderTargetDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeInForce", wireType)
+			}
+			m.TimeInForce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeInForce |= TimeInForce(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiry == nil {
+				m.Expiry = &Date{}
+			}
+			if err := m.Expiry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrailingStop", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TrailingStop == nil {
+				m.TrailingStop = &TrailingStop{}
+			}
+			if err := m.TrailingStop.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateSpotOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateSpotOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateSpotOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateSpotOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateSpotOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateSpotOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OrderPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateSpotOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateSpotOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateSpotOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelSpotOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelSpotOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelSpotOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelSpotOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelSpotOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelSpotOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelSpotOrders) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelSpotOrders: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelSpotOrders: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.SpotOrderIds = append(m.SpotOrderIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.SpotOrderIds) == 0 {
+					m.SpotOrderIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.SpotOrderIds = append(m.SpotOrderIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpotOrderIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelSpotOrdersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelSpotOrdersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelSpotOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreatePerpetualOpenOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreatePerpetualOpenOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreatePerpetualOpenOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TriggerPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Collateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradingAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TradingAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+			}
+			m.Position = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Position |= PerpetualPosition(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leverage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Leverage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakeProfitPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TakeProfitPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StopLossPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StopLossPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeInForce", wireType)
+			}
+			m.TimeInForce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeInForce |= TimeInForce(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiry == nil {
+				m.Expiry = &Date{}
+			}
+			if err := m.Expiry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BracketOrders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BracketOrders = append(m.BracketOrders, BracketOrder{})
+			if err := m.BracketOrders[len(m.BracketOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		n += 1 + sovTx(uint64(l)) + l
 	}
-	return n
-}
 
-func (m *MsgExecuteOrdersResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgCreateSpotOrder) Unmarshal(dAtA []byte) error {
+func (m *MsgCreatePerpetualOpenOrderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2913,17 +5509,17 @@ func (m *MsgCreateSpotOrder) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateSpotOrder: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreatePerpetualOpenOrderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateSpotOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreatePerpetualOpenOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
 			}
-			m.OrderType = 0
+			m.OrderId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2933,16 +5529,66 @@ func (m *MsgCreateSpotOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OrderType |= SpotOrderType(b&0x7F) << shift
+				m.OrderId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreatePerpetualCloseOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreatePerpetualCloseOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreatePerpetualCloseOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2952,28 +5598,27 @@ func (m *MsgCreateSpotOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OrderPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerPrice", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3000,13 +5645,51 @@ func (m *MsgCreateSpotOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OrderAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TriggerPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+			}
+			m.PositionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderType", wireType)
+			}
+			m.OrderType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderType |= PerpetualOrderType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CloseAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3034,13 +5717,34 @@ func (m *MsgCreateSpotOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.CloseAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeInForce", wireType)
+			}
+			m.TimeInForce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeInForce |= TimeInForce(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderTargetDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3050,23 +5754,63 @@ func (m *MsgCreateSpotOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OrderTargetDenom = string(dAtA[iNdEx:postIndex])
+			if m.Expiry == nil {
+				m.Expiry = &Date{}
+			}
+			if err := m.Expiry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrailingStop", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TrailingStop == nil {
+				m.TrailingStop = &TrailingStop{}
+			}
+			if err := m.TrailingStop.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3089,7 +5833,7 @@ func (m *MsgCreateSpotOrder) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateSpotOrderResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCreatePerpetualCloseOrderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3112,10 +5856,10 @@ func (m *MsgCreateSpotOrderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateSpotOrderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreatePerpetualCloseOrderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateSpotOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreatePerpetualCloseOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3158,7 +5902,7 @@ func (m *MsgCreateSpotOrderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateSpotOrder) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdatePerpetualOrder) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3181,10 +5925,10 @@ func (m *MsgUpdateSpotOrder) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateSpotOrder: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdatePerpetualOrder: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateSpotOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdatePerpetualOrder: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3240,7 +5984,7 @@ func (m *MsgUpdateSpotOrder) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerPrice", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3267,7 +6011,7 @@ func (m *MsgUpdateSpotOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OrderPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TriggerPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3292,7 +6036,7 @@ func (m *MsgUpdateSpotOrder) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateSpotOrderResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdatePerpetualOrderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3315,10 +6059,10 @@ func (m *MsgUpdateSpotOrderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateSpotOrderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdatePerpetualOrderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateSpotOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdatePerpetualOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3342,7 +6086,7 @@ func (m *MsgUpdateSpotOrderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelSpotOrder) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelPerpetualOrder) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3365,10 +6109,10 @@ func (m *MsgCancelSpotOrder) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelSpotOrder: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelPerpetualOrder: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelSpotOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelPerpetualOrder: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3443,7 +6187,7 @@ func (m *MsgCancelSpotOrder) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelSpotOrderResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelPerpetualOrderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3466,10 +6210,10 @@ func (m *MsgCancelSpotOrderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelSpotOrderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelPerpetualOrderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelSpotOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelPerpetualOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3512,7 +6256,7 @@ func (m *MsgCancelSpotOrderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelSpotOrders) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelPerpetualOrders) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3535,15 +6279,15 @@ func (m *MsgCancelSpotOrders) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelSpotOrders: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelPerpetualOrders: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelSpotOrders: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelPerpetualOrders: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3571,7 +6315,7 @@ func (m *MsgCancelSpotOrders) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType == 0 {
@@ -3590,7 +6334,7 @@ func (m *MsgCancelSpotOrders) Unmarshal(dAtA []byte) error {
 						break
 					}
 				}
-				m.SpotOrderIds = append(m.SpotOrderIds, v)
+				m.OrderIds = append(m.OrderIds, v)
 			} else if wireType == 2 {
 				var packedLen int
 				for shift := uint(0); ; shift += 7 {
@@ -3625,8 +6369,8 @@ func (m *MsgCancelSpotOrders) Unmarshal(dAtA []byte) error {
 					}
 				}
 				elementCount = count
-				if elementCount != 0 && len(m.SpotOrderIds) == 0 {
-					m.SpotOrderIds = make([]uint64, 0, elementCount)
+				if elementCount != 0 && len(m.OrderIds) == 0 {
+					m.OrderIds = make([]uint64, 0, elementCount)
 				}
 				for iNdEx < postIndex {
 					var v uint64
@@ -3644,61 +6388,11 @@ func (m *MsgCancelSpotOrders) Unmarshal(dAtA []byte) error {
 							break
 						}
 					}
-					m.SpotOrderIds = append(m.SpotOrderIds, v)
+					m.OrderIds = append(m.OrderIds, v)
 				}
 			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpotOrderIds", wireType)
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgCancelSpotOrdersResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderIds", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelSpotOrdersResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelSpotOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3720,7 +6414,7 @@ func (m *MsgCancelSpotOrdersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreatePerpetualOpenOrder) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelPerpetualOrdersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3737,204 +6431,71 @@ func (m *MsgCreatePerpetualOpenOrder) Unmarshal(dAtA []byte) error {
 			iNdEx++
 			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreatePerpetualOpenOrder: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreatePerpetualOpenOrder: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TriggerPrice", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.TriggerPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Collateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TradingAsset", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TradingAsset = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
-			}
-			m.Position = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Position |= PerpetualPosition(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Leverage", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+				break
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelPerpetualOrdersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelPerpetualOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Leverage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			iNdEx = postIndex
-		case 7:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TakeProfitPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3962,15 +6523,13 @@ func (m *MsgCreatePerpetualOpenOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TakeProfitPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StopLossPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3980,45 +6539,28 @@ func (m *MsgCreatePerpetualOpenOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.StopLossPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Params == nil {
+				m.Params = &Params{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
-			}
-			m.PoolId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4040,7 +6582,7 @@ func (m *MsgCreatePerpetualOpenOrder) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreatePerpetualOpenOrderResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4063,31 +6605,12 @@ func (m *MsgCreatePerpetualOpenOrderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreatePerpetualOpenOrderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreatePerpetualOpenOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
-			}
-			m.OrderId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.OrderId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4109,7 +6632,7 @@ func (m *MsgCreatePerpetualOpenOrderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreatePerpetualCloseOrder) Unmarshal(dAtA []byte) error {
+func (m *MsgExecuteOrders) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4132,15 +6655,15 @@ func (m *MsgCreatePerpetualCloseOrder) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreatePerpetualCloseOrder: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgExecuteOrders: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreatePerpetualCloseOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgExecuteOrders: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4168,59 +6691,159 @@ func (m *MsgCreatePerpetualCloseOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TriggerPrice", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				m.SpotOrderIds = append(m.SpotOrderIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.SpotOrderIds) == 0 {
+					m.SpotOrderIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.SpotOrderIds = append(m.SpotOrderIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpotOrderIds", wireType)
+			}
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PerpetualOrderIds = append(m.PerpetualOrderIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
 				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.TriggerPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
-			}
-			m.PositionId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
 				}
-				if iNdEx >= l {
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PositionId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PerpetualOrderIds) == 0 {
+					m.PerpetualOrderIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PerpetualOrderIds = append(m.PerpetualOrderIds, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerpetualOrderIds", wireType)
 			}
 		default:
 			iNdEx = preIndex
@@ -4243,7 +6866,7 @@ func (m *MsgCreatePerpetualCloseOrder) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreatePerpetualCloseOrderResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgExecuteOrdersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4266,31 +6889,12 @@ func (m *MsgCreatePerpetualCloseOrderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreatePerpetualCloseOrderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgExecuteOrdersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreatePerpetualCloseOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgExecuteOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
-			}
-			m.OrderId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.OrderId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4312,7 +6916,7 @@ func (m *MsgCreatePerpetualCloseOrderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdatePerpetualOrder) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateOrderGroup) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4335,10 +6939,10 @@ func (m *MsgUpdatePerpetualOrder) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdatePerpetualOrder: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateOrderGroup: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdatePerpetualOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateOrderGroup: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4374,57 +6978,157 @@ func (m *MsgUpdatePerpetualOrder) Unmarshal(dAtA []byte) error {
 			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
-			}
-			m.OrderId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				m.SpotOrderIds = append(m.SpotOrderIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.OrderId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.SpotOrderIds) == 0 {
+					m.SpotOrderIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.SpotOrderIds = append(m.SpotOrderIds, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpotOrderIds", wireType)
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TriggerPrice", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				m.PerpetualOrderIds = append(m.PerpetualOrderIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PerpetualOrderIds) == 0 {
+					m.PerpetualOrderIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PerpetualOrderIds = append(m.PerpetualOrderIds, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerpetualOrderIds", wireType)
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.TriggerPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4446,7 +7150,7 @@ func (m *MsgUpdatePerpetualOrder) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdatePerpetualOrderResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateOrderGroupResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4469,12 +7173,31 @@ func (m *MsgUpdatePerpetualOrderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdatePerpetualOrderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateOrderGroupResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdatePerpetualOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateOrderGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			m.GroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4496,7 +7219,7 @@ func (m *MsgUpdatePerpetualOrderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelPerpetualOrder) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelOrderGroup) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4519,10 +7242,10 @@ func (m *MsgCancelPerpetualOrder) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelPerpetualOrder: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelOrderGroup: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelPerpetualOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelOrderGroup: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4559,9 +7282,9 @@ func (m *MsgCancelPerpetualOrder) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
 			}
-			m.OrderId = 0
+			m.GroupId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4571,7 +7294,7 @@ func (m *MsgCancelPerpetualOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OrderId |= uint64(b&0x7F) << shift
+				m.GroupId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4597,7 +7320,7 @@ func (m *MsgCancelPerpetualOrder) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelPerpetualOrderResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelOrderGroupResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4620,31 +7343,12 @@ func (m *MsgCancelPerpetualOrderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelPerpetualOrderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelOrderGroupResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelPerpetualOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelOrderGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
-			}
-			m.OrderId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.OrderId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4666,7 +7370,7 @@ func (m *MsgCancelPerpetualOrderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelPerpetualOrders) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateScheduledOrder) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4689,10 +7393,10 @@ func (m *MsgCancelPerpetualOrders) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelPerpetualOrders: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateScheduledOrder: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelPerpetualOrders: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateScheduledOrder: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4728,184 +7432,41 @@ func (m *MsgCancelPerpetualOrders) Unmarshal(dAtA []byte) error {
 			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.OrderIds = append(m.OrderIds, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.OrderIds) == 0 {
-					m.OrderIds = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.OrderIds = append(m.OrderIds, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderIds", wireType)
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgCancelPerpetualOrdersResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelPerpetualOrdersResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelPerpetualOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4933,13 +7494,70 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.TargetDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SliceCount", wireType)
+			}
+			m.SliceCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SliceCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntervalUnit", wireType)
+			}
+			m.IntervalUnit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IntervalUnit |= ScheduleIntervalUnit(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSlippage", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4949,25 +7567,23 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Params == nil {
-				m.Params = &Params{}
-			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxSlippage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4992,7 +7608,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateScheduledOrderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5015,12 +7631,31 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateScheduledOrderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateScheduledOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5042,7 +7677,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExecuteOrders) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelScheduledOrder) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5065,15 +7700,15 @@ func (m *MsgExecuteOrders) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExecuteOrders: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelScheduledOrder: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExecuteOrders: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelScheduledOrder: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5101,159 +7736,26 @@ func (m *MsgExecuteOrders) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.SpotOrderIds = append(m.SpotOrderIds, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.SpotOrderIds) == 0 {
-					m.SpotOrderIds = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.SpotOrderIds = append(m.SpotOrderIds, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpotOrderIds", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
 			}
-		case 3:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.PerpetualOrderIds = append(m.PerpetualOrderIds, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.PerpetualOrderIds) == 0 {
-					m.PerpetualOrderIds = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.PerpetualOrderIds = append(m.PerpetualOrderIds, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field PerpetualOrderIds", wireType)
 			}
 		default:
 			iNdEx = preIndex
@@ -5276,7 +7778,7 @@ func (m *MsgExecuteOrders) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExecuteOrdersResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelScheduledOrderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5299,10 +7801,10 @@ func (m *MsgExecuteOrdersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExecuteOrdersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelScheduledOrderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExecuteOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelScheduledOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
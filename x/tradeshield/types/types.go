@@ -10,6 +10,8 @@ func GetSpotOrderTypeFromString(s string) SpotOrderType {
 		return SpotOrderType_LIMITBUY
 	case "marketbuy":
 		return SpotOrderType_MARKETBUY
+	case "trailingstop":
+		return SpotOrderType_TRAILINGSTOP
 	default:
 		panic("invalid spot order type")
 	}
@@ -23,7 +25,24 @@ func GetPerpetualOrderTypeFromString(s string) PerpetualOrderType {
 		return PerpetualOrderType_LIMITCLOSE
 	case "stoploss":
 		return PerpetualOrderType_STOPLOSSPERP
+	case "trailingstop":
+		return PerpetualOrderType_TRAILINGSTOPPERP
 	default:
 		panic("invalid perpetual order type")
 	}
 }
+
+func GetTimeInForceFromString(s string) TimeInForce {
+	switch s {
+	case "gtc":
+		return TimeInForce_GTC
+	case "gtt":
+		return TimeInForce_GTT
+	case "ioc":
+		return TimeInForce_IOC
+	case "fok":
+		return TimeInForce_FOK
+	default:
+		panic("invalid time in force")
+	}
+}
@@ -64,18 +64,23 @@ const (
 	PerpetualOrderType_LIMITOPEN    PerpetualOrderType = 0
 	PerpetualOrderType_LIMITCLOSE   PerpetualOrderType = 1
 	PerpetualOrderType_STOPLOSSPERP PerpetualOrderType = 2
+	// Stop loss whose trigger price follows the best market price at a fixed
+	// trail
+	PerpetualOrderType_TRAILINGSTOPPERP PerpetualOrderType = 3
 )
 
 var PerpetualOrderType_name = map[int32]string{
 	0: "LIMITOPEN",
 	1: "LIMITCLOSE",
 	2: "STOPLOSSPERP",
+	3: "TRAILINGSTOPPERP",
 }
 
 var PerpetualOrderType_value = map[string]int32{
-	"LIMITOPEN":    0,
-	"LIMITCLOSE":   1,
-	"STOPLOSSPERP": 2,
+	"LIMITOPEN":        0,
+	"LIMITCLOSE":       1,
+	"STOPLOSSPERP":     2,
+	"TRAILINGSTOPPERP": 3,
 }
 
 func (x PerpetualOrderType) String() string {
@@ -86,6 +91,32 @@ func (PerpetualOrderType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_f02c7f96dfee8f75, []int{1}
 }
 
+// Unit of the interval between the slices of a scheduled order
+type ScheduleIntervalUnit int32
+
+const (
+	ScheduleIntervalUnit_BLOCKS  ScheduleIntervalUnit = 0
+	ScheduleIntervalUnit_SECONDS ScheduleIntervalUnit = 1
+)
+
+var ScheduleIntervalUnit_name = map[int32]string{
+	0: "BLOCKS",
+	1: "SECONDS",
+}
+
+var ScheduleIntervalUnit_value = map[string]int32{
+	"BLOCKS":  0,
+	"SECONDS": 1,
+}
+
+func (x ScheduleIntervalUnit) String() string {
+	return proto.EnumName(ScheduleIntervalUnit_name, int32(x))
+}
+
+func (ScheduleIntervalUnit) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f02c7f96dfee8f75, []int{2}
+}
+
 type Date struct {
 	Height    uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
 	Timestamp uint64 `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
@@ -139,14 +170,28 @@ func (m *Date) GetTimestamp() uint64 {
 }
 
 type SpotOrder struct {
-	OrderType        SpotOrderType `protobuf:"varint,1,opt,name=order_type,json=orderType,proto3,enum=elys.tradeshield.SpotOrderType" json:"order_type,omitempty"`
-	OrderId          uint64        `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	OrderPrice       OrderPrice    `protobuf:"bytes,3,opt,name=order_price,json=orderPrice,proto3" json:"order_price"`
-	OrderAmount      types.Coin    `protobuf:"bytes,4,opt,name=order_amount,json=orderAmount,proto3" json:"order_amount"`
-	OwnerAddress     string        `protobuf:"bytes,5,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
-	OrderTargetDenom string        `protobuf:"bytes,6,opt,name=order_target_denom,json=orderTargetDenom,proto3" json:"order_target_denom,omitempty"`
-	Status           Status        `protobuf:"varint,7,opt,name=status,proto3,enum=elys.tradeshield.Status" json:"status,omitempty"`
-	Date             *Date         `protobuf:"bytes,8,opt,name=date,proto3" json:"date,omitempty"`
+	OrderType  SpotOrderType `protobuf:"varint,1,opt,name=order_type,json=orderType,proto3,enum=elys.tradeshield.SpotOrderType" json:"order_type,omitempty"`
+	OrderId    uint64        `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	OrderPrice OrderPrice    `protobuf:"bytes,3,opt,name=order_price,json=orderPrice,proto3" json:"order_price"`
+	// Amount left to fill, escrowed on the order address
+	OrderAmount      types.Coin `protobuf:"bytes,4,opt,name=order_amount,json=orderAmount,proto3" json:"order_amount"`
+	OwnerAddress     string     `protobuf:"bytes,5,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
+	OrderTargetDenom string     `protobuf:"bytes,6,opt,name=order_target_denom,json=orderTargetDenom,proto3" json:"order_target_denom,omitempty"`
+	Status           Status     `protobuf:"varint,7,opt,name=status,proto3,enum=elys.tradeshield.Status" json:"status,omitempty"`
+	Date             *Date      `protobuf:"bytes,8,opt,name=date,proto3" json:"date,omitempty"`
+	// Deposit escrowed when the order is created, paid to the executor of the
+	// order or refunded to the owner
+	Deposit     types.Coin  `protobuf:"bytes,9,opt,name=deposit,proto3" json:"deposit"`
+	TimeInForce TimeInForce `protobuf:"varint,10,opt,name=time_in_force,json=timeInForce,proto3,enum=elys.tradeshield.TimeInForce" json:"time_in_force,omitempty"`
+	// Height and/or timestamp at which a GTT order expires
+	Expiry *Date `protobuf:"bytes,11,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	// Trail of TRAILINGSTOP orders, the rate is their current stop price
+	TrailingStop *TrailingStop `protobuf:"bytes,12,opt,name=trailing_stop,json=trailingStop,proto3" json:"trailing_stop,omitempty"`
+	// Order group of the order, zero when the order is not grouped
+	GroupId uint64 `protobuf:"varint,13,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// Part of the order amount already swapped by partial fills of limit
+	// orders, order_amount is the amount left to fill
+	FilledAmount types.Coin `protobuf:"bytes,14,opt,name=filled_amount,json=filledAmount,proto3" json:"filled_amount"`
 }
 
 func (m *SpotOrder) Reset()         { *m = SpotOrder{} }
@@ -238,6 +283,48 @@ func (m *SpotOrder) GetDate() *Date {
 	return nil
 }
 
+func (m *SpotOrder) GetDeposit() types.Coin {
+	if m != nil {
+		return m.Deposit
+	}
+	return types.Coin{}
+}
+
+func (m *SpotOrder) GetTimeInForce() TimeInForce {
+	if m != nil {
+		return m.TimeInForce
+	}
+	return TimeInForce_GTC
+}
+
+func (m *SpotOrder) GetExpiry() *Date {
+	if m != nil {
+		return m.Expiry
+	}
+	return nil
+}
+
+func (m *SpotOrder) GetTrailingStop() *TrailingStop {
+	if m != nil {
+		return m.TrailingStop
+	}
+	return nil
+}
+
+func (m *SpotOrder) GetGroupId() uint64 {
+	if m != nil {
+		return m.GroupId
+	}
+	return 0
+}
+
+func (m *SpotOrder) GetFilledAmount() types.Coin {
+	if m != nil {
+		return m.FilledAmount
+	}
+	return types.Coin{}
+}
+
 type LegacyPerpetualOrder struct {
 	OrderId            uint64                      `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
 	OwnerAddress       string                      `protobuf:"bytes,2,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
@@ -374,6 +461,20 @@ type PerpetualOrder struct {
 	Status             Status                      `protobuf:"varint,11,opt,name=status,proto3,enum=elys.tradeshield.Status" json:"status,omitempty"`
 	StopLossPrice      cosmossdk_io_math.LegacyDec `protobuf:"bytes,12,opt,name=stop_loss_price,json=stopLossPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"stop_loss_price"`
 	PoolId             uint64                      `protobuf:"varint,13,opt,name=poolId,proto3" json:"poolId,omitempty"`
+	// Amount of the position closed by close orders, zero closes the whole
+	// position
+	CloseAmount cosmossdk_io_math.Int `protobuf:"bytes,14,opt,name=close_amount,json=closeAmount,proto3,customtype=cosmossdk.io/math.Int" json:"close_amount"`
+	// Deposit escrowed when the order is created, paid to the executor of the
+	// order or refunded to the owner
+	Deposit     types.Coin  `protobuf:"bytes,15,opt,name=deposit,proto3" json:"deposit"`
+	TimeInForce TimeInForce `protobuf:"varint,16,opt,name=time_in_force,json=timeInForce,proto3,enum=elys.tradeshield.TimeInForce" json:"time_in_force,omitempty"`
+	// Height and/or timestamp at which a GTT order expires
+	Expiry *Date `protobuf:"bytes,17,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	// Trail of TRAILINGSTOPPERP orders, the trigger price is their current stop
+	// price
+	TrailingStop *TrailingStop `protobuf:"bytes,18,opt,name=trailing_stop,json=trailingStop,proto3" json:"trailing_stop,omitempty"`
+	// Order group of the order, zero when the order is not grouped
+	GroupId uint64 `protobuf:"varint,19,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
 }
 
 func (m *PerpetualOrder) Reset()         { *m = PerpetualOrder{} }
@@ -479,26 +580,66 @@ func (m *PerpetualOrder) GetPoolId() uint64 {
 	return 0
 }
 
-type PerpetualOrderExtraInfo struct {
-	PerpetualOrder     *PerpetualOrder             `protobuf:"bytes,1,opt,name=perpetual_order,json=perpetualOrder,proto3" json:"perpetual_order,omitempty"`
-	PositionSize       types.Coin                  `protobuf:"bytes,2,opt,name=position_size,json=positionSize,proto3" json:"position_size"`
-	LiquidationPrice   cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=liquidation_price,json=liquidationPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"liquidation_price"`
-	FundingRate        cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=funding_rate,json=fundingRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"funding_rate"`
-	BorrowInterestRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=borrow_interest_rate,json=borrowInterestRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"borrow_interest_rate"`
+func (m *PerpetualOrder) GetDeposit() types.Coin {
+	if m != nil {
+		return m.Deposit
+	}
+	return types.Coin{}
 }
 
-func (m *PerpetualOrderExtraInfo) Reset()         { *m = PerpetualOrderExtraInfo{} }
-func (m *PerpetualOrderExtraInfo) String() string { return proto.CompactTextString(m) }
-func (*PerpetualOrderExtraInfo) ProtoMessage()    {}
-func (*PerpetualOrderExtraInfo) Descriptor() ([]byte, []int) {
+func (m *PerpetualOrder) GetTimeInForce() TimeInForce {
+	if m != nil {
+		return m.TimeInForce
+	}
+	return TimeInForce_GTC
+}
+
+func (m *PerpetualOrder) GetExpiry() *Date {
+	if m != nil {
+		return m.Expiry
+	}
+	return nil
+}
+
+func (m *PerpetualOrder) GetTrailingStop() *TrailingStop {
+	if m != nil {
+		return m.TrailingStop
+	}
+	return nil
+}
+
+func (m *PerpetualOrder) GetGroupId() uint64 {
+	if m != nil {
+		return m.GroupId
+	}
+	return 0
+}
+
+// Take profit or stop loss close order attached to a limit open order, it is
+// created once the position is opened
+type BracketOrder struct {
+	// LIMITCLOSE or STOPLOSSPERP
+	OrderType    PerpetualOrderType          `protobuf:"varint,1,opt,name=order_type,json=orderType,proto3,enum=elys.tradeshield.PerpetualOrderType" json:"order_type,omitempty"`
+	TriggerPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=trigger_price,json=triggerPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"trigger_price"`
+	// Amount of the position to close, zero closes the whole position
+	CloseAmount cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=close_amount,json=closeAmount,proto3,customtype=cosmossdk.io/math.Int" json:"close_amount"`
+	// Deposit of the close order, escrowed on the limit open order until the
+	// close order is created
+	Deposit types.Coin `protobuf:"bytes,4,opt,name=deposit,proto3" json:"deposit"`
+}
+
+func (m *BracketOrder) Reset()         { *m = BracketOrder{} }
+func (m *BracketOrder) String() string { return proto.CompactTextString(m) }
+func (*BracketOrder) ProtoMessage()    {}
+func (*BracketOrder) Descriptor() ([]byte, []int) {
 	return fileDescriptor_f02c7f96dfee8f75, []int{4}
 }
-func (m *PerpetualOrderExtraInfo) XXX_Unmarshal(b []byte) error {
+func (m *BracketOrder) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PerpetualOrderExtraInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *BracketOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_PerpetualOrderExtraInfo.Marshal(b, m, deterministic)
+		return xxx_messageInfo_BracketOrder.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -508,227 +649,527 @@ func (m *PerpetualOrderExtraInfo) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *PerpetualOrderExtraInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PerpetualOrderExtraInfo.Merge(m, src)
+func (m *BracketOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BracketOrder.Merge(m, src)
 }
-func (m *PerpetualOrderExtraInfo) XXX_Size() int {
+func (m *BracketOrder) XXX_Size() int {
 	return m.Size()
 }
-func (m *PerpetualOrderExtraInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_PerpetualOrderExtraInfo.DiscardUnknown(m)
+func (m *BracketOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_BracketOrder.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PerpetualOrderExtraInfo proto.InternalMessageInfo
+var xxx_messageInfo_BracketOrder proto.InternalMessageInfo
 
-func (m *PerpetualOrderExtraInfo) GetPerpetualOrder() *PerpetualOrder {
+func (m *BracketOrder) GetOrderType() PerpetualOrderType {
 	if m != nil {
-		return m.PerpetualOrder
+		return m.OrderType
 	}
-	return nil
+	return PerpetualOrderType_LIMITOPEN
 }
 
-func (m *PerpetualOrderExtraInfo) GetPositionSize() types.Coin {
+func (m *BracketOrder) GetDeposit() types.Coin {
 	if m != nil {
-		return m.PositionSize
+		return m.Deposit
 	}
 	return types.Coin{}
 }
 
-func init() {
-	proto.RegisterEnum("elys.tradeshield.Status", Status_name, Status_value)
-	proto.RegisterEnum("elys.tradeshield.PerpetualOrderType", PerpetualOrderType_name, PerpetualOrderType_value)
-	proto.RegisterType((*Date)(nil), "elys.tradeshield.Date")
-	proto.RegisterType((*SpotOrder)(nil), "elys.tradeshield.SpotOrder")
-	proto.RegisterType((*LegacyPerpetualOrder)(nil), "elys.tradeshield.LegacyPerpetualOrder")
-	proto.RegisterType((*PerpetualOrder)(nil), "elys.tradeshield.PerpetualOrder")
-	proto.RegisterType((*PerpetualOrderExtraInfo)(nil), "elys.tradeshield.PerpetualOrderExtraInfo")
+// Pending orders of an owner managed together: once one of them is executed,
+// the others are cancelled and refunded
+type OrderGroup struct {
+	Id                uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OwnerAddress      string   `protobuf:"bytes,2,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
+	SpotOrderIds      []uint64 `protobuf:"varint,3,rep,packed,name=spot_order_ids,json=spotOrderIds,proto3" json:"spot_order_ids,omitempty"`
+	PerpetualOrderIds []uint64 `protobuf:"varint,4,rep,packed,name=perpetual_order_ids,json=perpetualOrderIds,proto3" json:"perpetual_order_ids,omitempty"`
+	// Limit open order of a bracket, zero for one-cancels-other groups
+	ParentOrderId uint64 `protobuf:"varint,5,opt,name=parent_order_id,json=parentOrderId,proto3" json:"parent_order_id,omitempty"`
+	// Close orders created once the parent order opens its position
+	BracketOrders []BracketOrder `protobuf:"bytes,6,rep,name=bracket_orders,json=bracketOrders,proto3" json:"bracket_orders"`
 }
 
-func init() { proto.RegisterFile("elys/tradeshield/types.proto", fileDescriptor_f02c7f96dfee8f75) }
+func (m *OrderGroup) Reset()         { *m = OrderGroup{} }
+func (m *OrderGroup) String() string { return proto.CompactTextString(m) }
+func (*OrderGroup) ProtoMessage()    {}
+func (*OrderGroup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f02c7f96dfee8f75, []int{5}
+}
+func (m *OrderGroup) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrderGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrderGroup.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OrderGroup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderGroup.Merge(m, src)
+}
+func (m *OrderGroup) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrderGroup) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderGroup.DiscardUnknown(m)
+}
 
-var fileDescriptor_f02c7f96dfee8f75 = []byte{
-	// 964 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0xdd, 0x6e, 0x1b, 0x45,
-	0x14, 0xf6, 0xc6, 0x8e, 0x63, 0x1f, 0xff, 0x64, 0x3b, 0x8a, 0xc2, 0xa6, 0x44, 0x4e, 0xe4, 0x72,
-	0x11, 0x45, 0x74, 0xdd, 0x84, 0x3b, 0x84, 0xa8, 0x1c, 0x7b, 0x85, 0x16, 0xb9, 0xb1, 0xb5, 0x36,
-	0x08, 0x90, 0xc0, 0x5a, 0x7b, 0x27, 0xeb, 0x51, 0xd6, 0x3b, 0xcb, 0xce, 0xb8, 0x69, 0xfa, 0x10,
-	0x88, 0x47, 0xe0, 0x21, 0x78, 0x05, 0xa4, 0x5e, 0x56, 0x48, 0x48, 0x88, 0x8b, 0x0a, 0x25, 0x2f,
-	0x82, 0x66, 0x66, 0x6d, 0x9c, 0x6e, 0x44, 0x23, 0xab, 0xbd, 0x01, 0xee, 0xf6, 0xfc, 0x7d, 0xe7,
-	0xef, 0x9b, 0x23, 0x1b, 0x76, 0x71, 0x70, 0xc9, 0x1a, 0x3c, 0x76, 0x3d, 0xcc, 0x26, 0x04, 0x07,
-	0x5e, 0x83, 0x5f, 0x46, 0x98, 0x99, 0x51, 0x4c, 0x39, 0x45, 0xba, 0xb0, 0x9a, 0x4b, 0xd6, 0xfb,
-	0x5b, 0x3e, 0xf5, 0xa9, 0x34, 0x36, 0xc4, 0x97, 0xf2, 0xbb, 0x5f, 0x1b, 0x53, 0x36, 0xa5, 0xac,
-	0x31, 0x72, 0x19, 0x6e, 0x3c, 0x3d, 0x1a, 0x61, 0xee, 0x1e, 0x35, 0xc6, 0x94, 0x84, 0x89, 0x3d,
-	0x9d, 0x85, 0xc6, 0x1e, 0x8e, 0x13, 0xeb, 0x8e, 0x8a, 0x1e, 0x2a, 0x58, 0x25, 0x28, 0x53, 0xfd,
-	0x13, 0xc8, 0xb5, 0x5d, 0x8e, 0xd1, 0x36, 0xe4, 0x27, 0x98, 0xf8, 0x13, 0x6e, 0x68, 0xfb, 0xda,
-	0x41, 0xce, 0x49, 0x24, 0xb4, 0x0b, 0x45, 0x4e, 0xa6, 0x98, 0x71, 0x77, 0x1a, 0x19, 0x6b, 0xd2,
-	0xf4, 0xb7, 0xa2, 0xfe, 0x53, 0x16, 0x8a, 0xfd, 0x88, 0xf2, 0xae, 0x48, 0x86, 0x3e, 0x05, 0x90,
-	0x59, 0x87, 0xa2, 0x43, 0x89, 0x53, 0x3d, 0xde, 0x33, 0x5f, 0xef, 0xd0, 0x5c, 0x04, 0x0c, 0x2e,
-	0x23, 0xec, 0x14, 0xe9, 0xfc, 0x13, 0xed, 0x40, 0x41, 0xc5, 0x13, 0x2f, 0x49, 0xb5, 0x21, 0x65,
-	0xdb, 0x43, 0x2d, 0x28, 0x29, 0x53, 0x14, 0x93, 0x31, 0x36, 0xb2, 0xfb, 0xda, 0x41, 0xe9, 0x78,
-	0x37, 0x8d, 0x2d, 0x71, 0x7b, 0xc2, 0xe7, 0x24, 0xf7, 0xe2, 0xd5, 0x5e, 0xc6, 0x51, 0x15, 0x49,
-	0x0d, 0x3a, 0x81, 0xb2, 0x02, 0x71, 0xa7, 0x74, 0x16, 0x72, 0x23, 0x27, 0x51, 0x76, 0xcc, 0x64,
-	0x20, 0x62, 0xb6, 0x66, 0x32, 0x5b, 0xb3, 0x45, 0x49, 0x98, 0x40, 0xa8, 0xcc, 0x4d, 0x19, 0x83,
-	0x1e, 0x40, 0x85, 0x5e, 0x84, 0x02, 0xc3, 0xf3, 0x62, 0xcc, 0x98, 0xb1, 0xbe, 0xaf, 0x1d, 0x14,
-	0x9d, 0xb2, 0x54, 0x36, 0x95, 0x0e, 0x7d, 0x08, 0x28, 0x19, 0x84, 0x1b, 0xfb, 0x98, 0x0f, 0x3d,
-	0x1c, 0xd2, 0xa9, 0x91, 0x97, 0x9e, 0xba, 0xea, 0x57, 0x1a, 0xda, 0x42, 0x8f, 0x1e, 0x41, 0x9e,
-	0x71, 0x97, 0xcf, 0x98, 0xb1, 0x21, 0x47, 0x66, 0xdc, 0x32, 0x32, 0x69, 0x77, 0x12, 0x3f, 0x74,
-	0x08, 0x39, 0xcf, 0xe5, 0xd8, 0x28, 0xc8, 0x06, 0xb6, 0xd3, 0xfe, 0x62, 0xa5, 0x8e, 0xf4, 0xa9,
-	0xff, 0x56, 0x80, 0xad, 0x0e, 0xf6, 0xdd, 0xf1, 0x65, 0x0f, 0xc7, 0x11, 0xe6, 0x33, 0x37, 0x50,
-	0xdb, 0x5a, 0x9e, 0xb6, 0x76, 0x73, 0xda, 0xa9, 0x26, 0xd7, 0x6e, 0x69, 0xf2, 0x4b, 0xd8, 0x8a,
-	0xe6, 0x88, 0xc3, 0xa5, 0xbd, 0x67, 0x65, 0x13, 0x1f, 0xa4, 0x8b, 0xba, 0x99, 0x5f, 0x2e, 0x1f,
-	0x45, 0x29, 0x1d, 0x7a, 0x0c, 0x85, 0x88, 0x32, 0xc2, 0x09, 0x0d, 0xe5, 0x86, 0xaa, 0xc7, 0x0f,
-	0xfe, 0x01, 0xab, 0x97, 0xb8, 0x3a, 0x8b, 0x20, 0xd4, 0x84, 0x0a, 0x8f, 0x89, 0xef, 0x2f, 0xd8,
-	0xb2, 0xfe, 0x66, 0xb6, 0x38, 0xe5, 0x24, 0x44, 0x31, 0xe5, 0x31, 0xc0, 0x98, 0x06, 0x81, 0xcb,
-	0x71, 0xec, 0x06, 0x72, 0x71, 0x77, 0xe0, 0xc9, 0x52, 0x88, 0x98, 0xa0, 0x48, 0x44, 0x42, 0x7f,
-	0xe8, 0x32, 0x86, 0xb9, 0x5c, 0x6d, 0x51, 0x64, 0x91, 0xca, 0xa6, 0xd0, 0xa1, 0x27, 0x50, 0x08,
-	0xf0, 0x53, 0x1c, 0xbb, 0xbe, 0x5a, 0x65, 0xf1, 0xe4, 0x48, 0x00, 0xfd, 0xf1, 0x6a, 0xef, 0x7d,
-	0x95, 0x8a, 0x79, 0xe7, 0x26, 0xa1, 0x8d, 0xa9, 0xcb, 0x27, 0xa6, 0xda, 0x61, 0x1b, 0x8f, 0x7f,
-	0xfd, 0xf9, 0x21, 0x24, 0x95, 0xb4, 0xf1, 0xd8, 0x59, 0x40, 0xa0, 0x6f, 0xe1, 0x1e, 0x77, 0xcf,
-	0xb1, 0x78, 0xe5, 0x67, 0x84, 0x27, 0xbd, 0x17, 0x57, 0xc5, 0xdd, 0x14, 0x58, 0x3d, 0x09, 0xa5,
-	0x66, 0xb2, 0x07, 0xa5, 0xf9, 0x88, 0x05, 0x65, 0x40, 0x52, 0x06, 0xe6, 0x2a, 0xdb, 0x5b, 0xe2,
-	0x71, 0xe9, 0x8e, 0x3c, 0xfe, 0x1a, 0x36, 0x19, 0xa7, 0xd1, 0x30, 0xa0, 0x8c, 0x25, 0xf5, 0x96,
-	0x57, 0xad, 0xb7, 0x22, 0x90, 0x3a, 0x94, 0x31, 0x55, 0x6d, 0x1b, 0x2a, 0x8b, 0x6a, 0x19, 0x79,
-	0x8e, 0x8d, 0xca, 0xdd, 0x96, 0x58, 0x9e, 0x47, 0xf5, 0xc9, 0x73, 0x8c, 0xbe, 0x83, 0x7b, 0x01,
-	0xf9, 0x7e, 0x46, 0x3c, 0x57, 0x02, 0xa9, 0x12, 0xab, 0xab, 0x96, 0xa8, 0x2f, 0x61, 0xa9, 0x2a,
-	0x07, 0x50, 0x3e, 0x9b, 0x85, 0x92, 0x26, 0xb1, 0x78, 0xd0, 0x9b, 0xab, 0x42, 0x97, 0x12, 0x18,
-	0x47, 0xdc, 0xf2, 0x31, 0x6c, 0x8d, 0x68, 0x1c, 0xd3, 0x8b, 0x21, 0x09, 0x39, 0x8e, 0x31, 0xe3,
-	0x0a, 0x5d, 0x5f, 0x15, 0x1d, 0x29, 0x38, 0x3b, 0x41, 0x13, 0x49, 0xea, 0x3f, 0xe4, 0xa1, 0xfa,
-	0x5f, 0xb9, 0x28, 0xf6, 0xed, 0x17, 0xa5, 0x96, 0x46, 0x19, 0x2c, 0x5d, 0x91, 0x39, 0xa3, 0xfe,
-	0xbf, 0x2c, 0xff, 0xaa, 0xcb, 0xb2, 0x0d, 0xf9, 0x88, 0xd2, 0xc0, 0xf6, 0xe4, 0x49, 0xc9, 0x39,
-	0x89, 0x54, 0xff, 0x25, 0x0b, 0xef, 0xdd, 0x24, 0xa4, 0xf5, 0x8c, 0xc7, 0xae, 0x1d, 0x9e, 0x51,
-	0x64, 0xc3, 0xe6, 0x6b, 0xcc, 0x96, 0x0f, 0xa4, 0x74, 0xbc, 0xff, 0x26, 0x52, 0x3b, 0xd5, 0x9b,
-	0x84, 0x4e, 0x1f, 0xb6, 0xb5, 0xb7, 0x76, 0xd8, 0xb2, 0xef, 0xee, 0xb0, 0xe5, 0xde, 0xe9, 0x61,
-	0x5b, 0x7f, 0x8b, 0x87, 0xed, 0xf0, 0x63, 0xc8, 0x2b, 0x32, 0xa1, 0x12, 0x6c, 0xf4, 0xac, 0xd3,
-	0xb6, 0x7d, 0xfa, 0x99, 0x9e, 0x41, 0x65, 0x28, 0x58, 0x5f, 0x59, 0xad, 0x2f, 0x06, 0x56, 0x5b,
-	0xd7, 0x84, 0xd4, 0x6a, 0x9e, 0xb6, 0xac, 0x8e, 0xd5, 0xd6, 0xd7, 0xd0, 0x06, 0x64, 0x9b, 0x9d,
-	0x8e, 0x9e, 0x3d, 0xb4, 0x00, 0xa5, 0x6f, 0x12, 0xaa, 0x40, 0xb1, 0x63, 0x3f, 0xb1, 0x07, 0xdd,
-	0x9e, 0x75, 0xaa, 0x67, 0x50, 0x15, 0x40, 0x8a, 0xad, 0x4e, 0xb7, 0x6f, 0xe9, 0x1a, 0xd2, 0xa1,
-	0xdc, 0x1f, 0x74, 0x7b, 0x9d, 0x6e, 0xbf, 0xdf, 0xb3, 0x9c, 0x9e, 0xbe, 0x76, 0xf2, 0xf9, 0x8b,
-	0xab, 0x9a, 0xf6, 0xf2, 0xaa, 0xa6, 0xfd, 0x79, 0x55, 0xd3, 0x7e, 0xbc, 0xae, 0x65, 0x5e, 0x5e,
-	0xd7, 0x32, 0xbf, 0x5f, 0xd7, 0x32, 0xdf, 0x3c, 0xf2, 0x09, 0x9f, 0xcc, 0x46, 0xe6, 0x98, 0x4e,
-	0x1b, 0x82, 0x39, 0x0f, 0x43, 0xcc, 0x2f, 0x68, 0x7c, 0x2e, 0x85, 0xc6, 0xb3, 0xf4, 0xff, 0x8c,
-	0x51, 0x5e, 0xfe, 0xce, 0xff, 0xe8, 0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xcf, 0x74, 0x17, 0xe4,
-	0x88, 0x0c, 0x00, 0x00,
+var xxx_messageInfo_OrderGroup proto.InternalMessageInfo
+
+func (m *OrderGroup) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
 }
 
-func (m *Date) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *OrderGroup) GetOwnerAddress() string {
+	if m != nil {
+		return m.OwnerAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Date) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *OrderGroup) GetSpotOrderIds() []uint64 {
+	if m != nil {
+		return m.SpotOrderIds
+	}
+	return nil
 }
 
-func (m *Date) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Timestamp != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Timestamp))
-		i--
-		dAtA[i] = 0x10
+func (m *OrderGroup) GetPerpetualOrderIds() []uint64 {
+	if m != nil {
+		return m.PerpetualOrderIds
 	}
-	if m.Height != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x8
+	return nil
+}
+
+func (m *OrderGroup) GetParentOrderId() uint64 {
+	if m != nil {
+		return m.ParentOrderId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *SpotOrder) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *OrderGroup) GetBracketOrders() []BracketOrder {
+	if m != nil {
+		return m.BracketOrders
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *SpotOrder) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Swap of an amount split in slices executed at a regular interval through the
+// AMM, for TWAP and DCA strategies
+type ScheduledOrder struct {
+	Id           uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OwnerAddress string `protobuf:"bytes,2,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
+	// Amount to swap over all the slices
+	TotalAmount types.Coin `protobuf:"bytes,3,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount"`
+	// Amount left to swap, escrowed on the order address
+	RemainingAmount types.Coin `protobuf:"bytes,4,opt,name=remaining_amount,json=remainingAmount,proto3" json:"remaining_amount"`
+	TargetDenom     string     `protobuf:"bytes,5,opt,name=target_denom,json=targetDenom,proto3" json:"target_denom,omitempty"`
+	SliceCount      uint64     `protobuf:"varint,6,opt,name=slice_count,json=sliceCount,proto3" json:"slice_count,omitempty"`
+	// Number of slices already executed or skipped
+	ExecutedSlices uint64               `protobuf:"varint,7,opt,name=executed_slices,json=executedSlices,proto3" json:"executed_slices,omitempty"`
+	Interval       uint64               `protobuf:"varint,8,opt,name=interval,proto3" json:"interval,omitempty"`
+	IntervalUnit   ScheduleIntervalUnit `protobuf:"varint,9,opt,name=interval_unit,json=intervalUnit,proto3,enum=elys.tradeshield.ScheduleIntervalUnit" json:"interval_unit,omitempty"`
+	// Maximum slippage of a slice from the oracle price, slices that would
+	// exceed it are skipped
+	MaxSlippage cosmossdk_io_math.LegacyDec `protobuf:"bytes,10,opt,name=max_slippage,json=maxSlippage,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_slippage"`
+	// Block height or timestamp, depending on the interval unit, of the next
+	// slice
+	NextExecution uint64 `protobuf:"varint,11,opt,name=next_execution,json=nextExecution,proto3" json:"next_execution,omitempty"`
+	// Output of the executed slices
+	ReceivedAmount types.Coin `protobuf:"bytes,12,opt,name=received_amount,json=receivedAmount,proto3" json:"received_amount"`
+	Date           *Date      `protobuf:"bytes,13,opt,name=date,proto3" json:"date,omitempty"`
 }
 
-func (m *SpotOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Date != nil {
-		{
-			size, err := m.Date.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x42
-	}
-	if m.Status != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x38
-	}
-	if len(m.OrderTargetDenom) > 0 {
-		i -= len(m.OrderTargetDenom)
-		copy(dAtA[i:], m.OrderTargetDenom)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.OrderTargetDenom)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.OwnerAddress) > 0 {
-		i -= len(m.OwnerAddress)
-		copy(dAtA[i:], m.OwnerAddress)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.OwnerAddress)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	{
-		size, err := m.OrderAmount.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTypes(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size, err := m.OrderPrice.MarshalToSizedBuffer(dAtA[:i])
+func (m *ScheduledOrder) Reset()         { *m = ScheduledOrder{} }
+func (m *ScheduledOrder) String() string { return proto.CompactTextString(m) }
+func (*ScheduledOrder) ProtoMessage()    {}
+func (*ScheduledOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f02c7f96dfee8f75, []int{6}
+}
+func (m *ScheduledOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTypes(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x1a
-	if m.OrderId != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.OrderId))
-		i--
-		dAtA[i] = 0x10
+}
+func (m *ScheduledOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledOrder.Merge(m, src)
+}
+func (m *ScheduledOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledOrder proto.InternalMessageInfo
+
+func (m *ScheduledOrder) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	if m.OrderType != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.OrderType))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *ScheduledOrder) GetOwnerAddress() string {
+	if m != nil {
+		return m.OwnerAddress
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *LegacyPerpetualOrder) Marshal() (dAtA []byte, err error) {
+func (m *ScheduledOrder) GetTotalAmount() types.Coin {
+	if m != nil {
+		return m.TotalAmount
+	}
+	return types.Coin{}
+}
+
+func (m *ScheduledOrder) GetRemainingAmount() types.Coin {
+	if m != nil {
+		return m.RemainingAmount
+	}
+	return types.Coin{}
+}
+
+func (m *ScheduledOrder) GetTargetDenom() string {
+	if m != nil {
+		return m.TargetDenom
+	}
+	return ""
+}
+
+func (m *ScheduledOrder) GetSliceCount() uint64 {
+	if m != nil {
+		return m.SliceCount
+	}
+	return 0
+}
+
+func (m *ScheduledOrder) GetExecutedSlices() uint64 {
+	if m != nil {
+		return m.ExecutedSlices
+	}
+	return 0
+}
+
+func (m *ScheduledOrder) GetInterval() uint64 {
+	if m != nil {
+		return m.Interval
+	}
+	return 0
+}
+
+func (m *ScheduledOrder) GetIntervalUnit() ScheduleIntervalUnit {
+	if m != nil {
+		return m.IntervalUnit
+	}
+	return ScheduleIntervalUnit_BLOCKS
+}
+
+func (m *ScheduledOrder) GetNextExecution() uint64 {
+	if m != nil {
+		return m.NextExecution
+	}
+	return 0
+}
+
+func (m *ScheduledOrder) GetReceivedAmount() types.Coin {
+	if m != nil {
+		return m.ReceivedAmount
+	}
+	return types.Coin{}
+}
+
+func (m *ScheduledOrder) GetDate() *Date {
+	if m != nil {
+		return m.Date
+	}
+	return nil
+}
+
+// Execution record of a slice of a scheduled order
+type ScheduledOrderSlice struct {
+	OrderId uint64 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// Index of the slice, starting from 1
+	Index     uint64     `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Date      *Date      `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
+	AmountIn  types.Coin `protobuf:"bytes,4,opt,name=amount_in,json=amountIn,proto3" json:"amount_in"`
+	AmountOut types.Coin `protobuf:"bytes,5,opt,name=amount_out,json=amountOut,proto3" json:"amount_out"`
+	// Reason for which a skipped slice could not be executed
+	Error string `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *ScheduledOrderSlice) Reset()         { *m = ScheduledOrderSlice{} }
+func (m *ScheduledOrderSlice) String() string { return proto.CompactTextString(m) }
+func (*ScheduledOrderSlice) ProtoMessage()    {}
+func (*ScheduledOrderSlice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f02c7f96dfee8f75, []int{7}
+}
+func (m *ScheduledOrderSlice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledOrderSlice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledOrderSlice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduledOrderSlice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledOrderSlice.Merge(m, src)
+}
+func (m *ScheduledOrderSlice) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledOrderSlice) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledOrderSlice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledOrderSlice proto.InternalMessageInfo
+
+func (m *ScheduledOrderSlice) GetOrderId() uint64 {
+	if m != nil {
+		return m.OrderId
+	}
+	return 0
+}
+
+func (m *ScheduledOrderSlice) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *ScheduledOrderSlice) GetDate() *Date {
+	if m != nil {
+		return m.Date
+	}
+	return nil
+}
+
+func (m *ScheduledOrderSlice) GetAmountIn() types.Coin {
+	if m != nil {
+		return m.AmountIn
+	}
+	return types.Coin{}
+}
+
+func (m *ScheduledOrderSlice) GetAmountOut() types.Coin {
+	if m != nil {
+		return m.AmountOut
+	}
+	return types.Coin{}
+}
+
+func (m *ScheduledOrderSlice) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type PerpetualOrderExtraInfo struct {
+	PerpetualOrder     *PerpetualOrder             `protobuf:"bytes,1,opt,name=perpetual_order,json=perpetualOrder,proto3" json:"perpetual_order,omitempty"`
+	PositionSize       types.Coin                  `protobuf:"bytes,2,opt,name=position_size,json=positionSize,proto3" json:"position_size"`
+	LiquidationPrice   cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=liquidation_price,json=liquidationPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"liquidation_price"`
+	FundingRate        cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=funding_rate,json=fundingRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"funding_rate"`
+	BorrowInterestRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=borrow_interest_rate,json=borrowInterestRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"borrow_interest_rate"`
+}
+
+func (m *PerpetualOrderExtraInfo) Reset()         { *m = PerpetualOrderExtraInfo{} }
+func (m *PerpetualOrderExtraInfo) String() string { return proto.CompactTextString(m) }
+func (*PerpetualOrderExtraInfo) ProtoMessage()    {}
+func (*PerpetualOrderExtraInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f02c7f96dfee8f75, []int{8}
+}
+func (m *PerpetualOrderExtraInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PerpetualOrderExtraInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PerpetualOrderExtraInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PerpetualOrderExtraInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PerpetualOrderExtraInfo.Merge(m, src)
+}
+func (m *PerpetualOrderExtraInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *PerpetualOrderExtraInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_PerpetualOrderExtraInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PerpetualOrderExtraInfo proto.InternalMessageInfo
+
+func (m *PerpetualOrderExtraInfo) GetPerpetualOrder() *PerpetualOrder {
+	if m != nil {
+		return m.PerpetualOrder
+	}
+	return nil
+}
+
+func (m *PerpetualOrderExtraInfo) GetPositionSize() types.Coin {
+	if m != nil {
+		return m.PositionSize
+	}
+	return types.Coin{}
+}
+
+func init() {
+	proto.RegisterEnum("elys.tradeshield.Status", Status_name, Status_value)
+	proto.RegisterEnum("elys.tradeshield.PerpetualOrderType", PerpetualOrderType_name, PerpetualOrderType_value)
+	proto.RegisterEnum("elys.tradeshield.ScheduleIntervalUnit", ScheduleIntervalUnit_name, ScheduleIntervalUnit_value)
+	proto.RegisterType((*Date)(nil), "elys.tradeshield.Date")
+	proto.RegisterType((*SpotOrder)(nil), "elys.tradeshield.SpotOrder")
+	proto.RegisterType((*LegacyPerpetualOrder)(nil), "elys.tradeshield.LegacyPerpetualOrder")
+	proto.RegisterType((*PerpetualOrder)(nil), "elys.tradeshield.PerpetualOrder")
+	proto.RegisterType((*BracketOrder)(nil), "elys.tradeshield.BracketOrder")
+	proto.RegisterType((*OrderGroup)(nil), "elys.tradeshield.OrderGroup")
+	proto.RegisterType((*ScheduledOrder)(nil), "elys.tradeshield.ScheduledOrder")
+	proto.RegisterType((*ScheduledOrderSlice)(nil), "elys.tradeshield.ScheduledOrderSlice")
+	proto.RegisterType((*PerpetualOrderExtraInfo)(nil), "elys.tradeshield.PerpetualOrderExtraInfo")
+}
+
+func init() { proto.RegisterFile("elys/tradeshield/types.proto", fileDescriptor_f02c7f96dfee8f75) }
+
+var fileDescriptor_f02c7f96dfee8f75 = []byte{
+	// 1595 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0xcd, 0x6e, 0x1b, 0xc9,
+	0x11, 0x16, 0x7f, 0x44, 0x91, 0xc5, 0x21, 0x39, 0x6a, 0x2b, 0xce, 0xd8, 0x71, 0x28, 0x85, 0xbb,
+	0xd9, 0x08, 0x4a, 0x96, 0x5c, 0x2b, 0xa7, 0x04, 0x8b, 0x2c, 0xc4, 0x9f, 0x38, 0xb3, 0xe6, 0x8a,
+	0xc4, 0x50, 0x6b, 0xe4, 0x07, 0x09, 0x31, 0x9a, 0x69, 0x51, 0x0d, 0x0d, 0xa7, 0x27, 0x33, 0x4d,
+	0x99, 0xf2, 0x03, 0xe4, 0x94, 0x43, 0x9e, 0x20, 0x4f, 0x11, 0xe4, 0x0d, 0x02, 0xf8, 0x68, 0x04,
+	0x08, 0x10, 0xe4, 0x60, 0x04, 0xf6, 0x35, 0xc7, 0x3c, 0x40, 0xd0, 0x3f, 0x43, 0x0d, 0x45, 0xc2,
+	0xa2, 0x69, 0xfb, 0x92, 0xe4, 0xc6, 0xaa, 0xae, 0xfa, 0xaa, 0xbb, 0xfa, 0xeb, 0x9a, 0x2a, 0xc2,
+	0x03, 0xec, 0x5d, 0x45, 0x0d, 0x16, 0xda, 0x2e, 0x8e, 0xce, 0x09, 0xf6, 0xdc, 0x06, 0xbb, 0x0a,
+	0x70, 0x54, 0x0f, 0x42, 0xca, 0x28, 0xd2, 0xf9, 0x6a, 0x3d, 0xb1, 0x7a, 0x7f, 0x67, 0x44, 0x47,
+	0x54, 0x2c, 0x36, 0xf8, 0x2f, 0x69, 0x77, 0xbf, 0xea, 0xd0, 0x68, 0x4c, 0xa3, 0xc6, 0xa9, 0x1d,
+	0xe1, 0xc6, 0xe5, 0xc3, 0x53, 0xcc, 0xec, 0x87, 0x0d, 0x87, 0x12, 0x5f, 0xad, 0x2f, 0x46, 0xa1,
+	0xa1, 0x8b, 0x43, 0xb5, 0x7a, 0x4f, 0x7a, 0x0f, 0x25, 0xac, 0x14, 0xe4, 0x52, 0xed, 0x73, 0xc8,
+	0xb6, 0x6d, 0x86, 0xd1, 0x5d, 0xc8, 0x9d, 0x63, 0x32, 0x3a, 0x67, 0x46, 0x6a, 0x2f, 0xb5, 0x9f,
+	0xb5, 0x94, 0x84, 0x1e, 0x40, 0x81, 0x91, 0x31, 0x8e, 0x98, 0x3d, 0x0e, 0x8c, 0xb4, 0x58, 0xba,
+	0x56, 0xd4, 0xfe, 0xbd, 0x09, 0x85, 0x41, 0x40, 0x59, 0x8f, 0x07, 0x43, 0x3f, 0x01, 0x10, 0x51,
+	0x87, 0xfc, 0x84, 0x02, 0xa7, 0x7c, 0xb8, 0x5b, 0xbf, 0x79, 0xc2, 0xfa, 0xcc, 0xe1, 0xe4, 0x2a,
+	0xc0, 0x56, 0x81, 0xc6, 0x3f, 0xd1, 0x3d, 0xc8, 0x4b, 0x7f, 0xe2, 0xaa, 0x50, 0x5b, 0x42, 0x36,
+	0x5d, 0xd4, 0x82, 0xa2, 0x5c, 0x0a, 0x42, 0xe2, 0x60, 0x23, 0xb3, 0x97, 0xda, 0x2f, 0x1e, 0x3e,
+	0x58, 0xc4, 0x16, 0xb8, 0x7d, 0x6e, 0xd3, 0xcc, 0x3e, 0x7f, 0xb9, 0xbb, 0x61, 0x01, 0x9d, 0x69,
+	0x50, 0x13, 0x34, 0x09, 0x62, 0x8f, 0xe9, 0xc4, 0x67, 0x46, 0x56, 0xa0, 0xdc, 0xab, 0xab, 0x84,
+	0xf0, 0xdc, 0xd6, 0x55, 0x6e, 0xeb, 0x2d, 0x4a, 0x7c, 0x05, 0x21, 0x23, 0x1f, 0x09, 0x1f, 0xf4,
+	0x11, 0x94, 0xe8, 0x53, 0x9f, 0x63, 0xb8, 0x6e, 0x88, 0xa3, 0xc8, 0xd8, 0xdc, 0x4b, 0xed, 0x17,
+	0x2c, 0x4d, 0x28, 0x8f, 0xa4, 0x0e, 0xfd, 0x00, 0x90, 0x4a, 0x84, 0x1d, 0x8e, 0x30, 0x1b, 0xba,
+	0xd8, 0xa7, 0x63, 0x23, 0x27, 0x2c, 0x75, 0x79, 0x5e, 0xb1, 0xd0, 0xe6, 0x7a, 0xf4, 0x19, 0xe4,
+	0x22, 0x66, 0xb3, 0x49, 0x64, 0x6c, 0x89, 0x94, 0x19, 0x4b, 0x52, 0x26, 0xd6, 0x2d, 0x65, 0x87,
+	0x0e, 0x20, 0xeb, 0xda, 0x0c, 0x1b, 0x79, 0x71, 0x80, 0xbb, 0x8b, 0xf6, 0xfc, 0x4a, 0x2d, 0x61,
+	0x83, 0x7e, 0x04, 0x5b, 0x2e, 0x0e, 0x68, 0x44, 0x98, 0x51, 0x58, 0xed, 0xbc, 0xb1, 0x3d, 0x3a,
+	0x82, 0x12, 0xbf, 0xea, 0x21, 0xf1, 0x87, 0x67, 0x34, 0x74, 0xb0, 0x01, 0x62, 0x7f, 0xdf, 0x5e,
+	0x8c, 0x77, 0x42, 0xc6, 0xd8, 0xf4, 0x7f, 0xca, 0x8d, 0xac, 0x22, 0xbb, 0x16, 0x50, 0x1d, 0x72,
+	0x78, 0x1a, 0x90, 0xf0, 0xca, 0x28, 0xbe, 0x71, 0xaf, 0xca, 0x0a, 0xb5, 0xa0, 0xc4, 0x42, 0x9b,
+	0x78, 0xc4, 0x1f, 0x0d, 0x23, 0x46, 0x03, 0x43, 0x13, 0x6e, 0xd5, 0x25, 0x21, 0x95, 0xd9, 0x80,
+	0xd1, 0xc0, 0xd2, 0x58, 0x42, 0xe2, 0x3c, 0x1a, 0x85, 0x74, 0x12, 0x70, 0x1e, 0x95, 0x24, 0x8f,
+	0x84, 0x6c, 0xba, 0xa8, 0x0d, 0xa5, 0x33, 0xe2, 0x79, 0xd8, 0x8d, 0x39, 0x50, 0x5e, 0x2d, 0x27,
+	0x9a, 0xf4, 0x92, 0x24, 0xa8, 0xfd, 0x2d, 0x0f, 0x3b, 0x5d, 0x3c, 0xb2, 0x9d, 0xab, 0x3e, 0x0e,
+	0x03, 0xcc, 0x26, 0xb6, 0x27, 0x5f, 0x40, 0x92, 0xc1, 0xa9, 0x79, 0x06, 0x2f, 0x10, 0x27, 0xbd,
+	0x84, 0x38, 0x4f, 0x60, 0x27, 0x88, 0x11, 0x87, 0x89, 0xb7, 0x94, 0x11, 0x89, 0xff, 0x78, 0x31,
+	0x0b, 0xf3, 0xf1, 0xc5, 0x83, 0x42, 0xc1, 0x82, 0x0e, 0x7d, 0x01, 0x79, 0x71, 0xa5, 0x84, 0xfa,
+	0x82, 0xf5, 0xe5, 0xc3, 0x8f, 0xde, 0x80, 0xd5, 0x57, 0xa6, 0xd6, 0xcc, 0x49, 0x50, 0x21, 0x24,
+	0xa3, 0xd1, 0xec, 0x05, 0x6e, 0xde, 0xfe, 0x02, 0x2d, 0x4d, 0xb9, 0x08, 0x09, 0x7d, 0x01, 0xe0,
+	0x50, 0xcf, 0xb3, 0x19, 0x0e, 0x6d, 0xcf, 0xc8, 0xad, 0x96, 0xf7, 0x84, 0x0b, 0xcf, 0x20, 0x0f,
+	0xc4, 0xa9, 0x61, 0x47, 0x11, 0x66, 0xe2, 0xb9, 0x14, 0x2c, 0x4d, 0x29, 0x8f, 0xb8, 0x0e, 0x7d,
+	0x05, 0x79, 0x0f, 0x5f, 0xe2, 0xd0, 0x1e, 0xc9, 0xe7, 0x51, 0x68, 0x3e, 0xe4, 0x40, 0xff, 0x78,
+	0xb9, 0xfb, 0x2d, 0x19, 0x2a, 0x72, 0x2f, 0xea, 0x84, 0x36, 0xc6, 0x36, 0x3b, 0xaf, 0xcb, 0x3b,
+	0x6c, 0x63, 0xe7, 0xaf, 0x7f, 0xfa, 0x14, 0xd4, 0x4e, 0xda, 0xd8, 0xb1, 0x66, 0x10, 0xe8, 0xd7,
+	0xb0, 0xcd, 0xec, 0x0b, 0xcc, 0x2b, 0xe7, 0x19, 0x61, 0xea, 0xec, 0x85, 0x75, 0x71, 0x2b, 0x1c,
+	0xab, 0x2f, 0xa0, 0x64, 0x4e, 0x76, 0xa1, 0x18, 0xa7, 0x98, 0x53, 0x06, 0x04, 0x65, 0x20, 0x56,
+	0x99, 0x6e, 0xa2, 0x36, 0x14, 0x57, 0xac, 0x0d, 0xbf, 0x80, 0x0a, 0x7f, 0x38, 0x43, 0x8f, 0x46,
+	0x91, 0xda, 0xaf, 0xb6, 0xee, 0x7e, 0x4b, 0x1c, 0xa9, 0x4b, 0xa3, 0x48, 0xee, 0xb6, 0x0d, 0xa5,
+	0xd9, 0x6e, 0x23, 0xf2, 0x0c, 0x1b, 0xa5, 0xd5, 0x2e, 0x51, 0x8b, 0xbd, 0x06, 0xe4, 0x19, 0x46,
+	0xbf, 0x81, 0x6d, 0x8f, 0xfc, 0x76, 0x42, 0x5c, 0x5b, 0x00, 0xc9, 0x2d, 0x96, 0xd7, 0xdd, 0xa2,
+	0x9e, 0xc0, 0x92, 0xbb, 0x3c, 0x01, 0xed, 0x6c, 0xe2, 0x0b, 0x9a, 0x84, 0xbc, 0x48, 0x56, 0xd6,
+	0x85, 0x2e, 0x2a, 0x18, 0x8b, 0x97, 0x51, 0x07, 0x76, 0x4e, 0x69, 0x18, 0xd2, 0xa7, 0x43, 0xe2,
+	0x33, 0x1c, 0xe2, 0x88, 0x49, 0x74, 0x7d, 0x5d, 0x74, 0x24, 0xe1, 0x4c, 0x85, 0xc6, 0x83, 0xd4,
+	0xfe, 0x95, 0x87, 0xf2, 0xff, 0x4a, 0x45, 0x31, 0x97, 0x57, 0x94, 0xa5, 0x95, 0xfe, 0xba, 0x8a,
+	0xc4, 0x8c, 0xfa, 0x7f, 0x65, 0xf9, 0xaf, 0xaa, 0x2c, 0x77, 0x21, 0x17, 0x50, 0xea, 0x99, 0xf1,
+	0xf7, 0x5a, 0x49, 0xe8, 0x18, 0x34, 0xc7, 0xa3, 0x11, 0x4e, 0x7e, 0xad, 0x0b, 0xcd, 0xef, 0xab,
+	0x78, 0xdf, 0x58, 0x8c, 0x67, 0xfa, 0x2c, 0x11, 0xc9, 0xf4, 0x99, 0x55, 0x14, 0x00, 0xaa, 0x7b,
+	0x4b, 0x34, 0x43, 0x95, 0x77, 0x6d, 0x86, 0xf4, 0x77, 0x68, 0x86, 0xb6, 0xd7, 0x6b, 0x86, 0xd0,
+	0x3b, 0x36, 0x43, 0x77, 0xe6, 0x9a, 0xa1, 0xda, 0x9f, 0xd3, 0xa0, 0x35, 0x43, 0xdb, 0xb9, 0xc0,
+	0xaa, 0x81, 0x6f, 0x2d, 0x69, 0xe0, 0x57, 0x2b, 0x11, 0x89, 0x2e, 0xfe, 0xc9, 0xcd, 0x87, 0x9d,
+	0x5e, 0x97, 0x24, 0xf3, 0xaf, 0xfc, 0x26, 0x17, 0x32, 0xef, 0x8f, 0x0b, 0xd9, 0xb7, 0xe3, 0x42,
+	0xed, 0xf7, 0x69, 0x00, 0x71, 0xf6, 0x47, 0x3c, 0x93, 0xa8, 0x0c, 0xe9, 0x59, 0x75, 0x4e, 0x93,
+	0x15, 0x0b, 0xf3, 0xc7, 0x50, 0x8e, 0x02, 0xca, 0x86, 0x71, 0x75, 0x8f, 0x8c, 0xcc, 0x5e, 0x66,
+	0x3f, 0x6b, 0x69, 0x51, 0x3c, 0x1e, 0x99, 0x6e, 0x84, 0xea, 0x70, 0xe7, 0x66, 0xf9, 0xe6, 0xa6,
+	0x59, 0x61, 0xba, 0x3d, 0x5f, 0x97, 0xb9, 0xfd, 0x27, 0x50, 0x09, 0xec, 0x10, 0xfb, 0xd7, 0xb8,
+	0xa2, 0xae, 0x66, 0xad, 0x92, 0x54, 0x2b, 0x43, 0xf4, 0x18, 0xca, 0xa7, 0xf2, 0xe6, 0xa5, 0x61,
+	0x64, 0xe4, 0xf6, 0x32, 0xcb, 0xb9, 0x95, 0x64, 0x88, 0x4a, 0x44, 0xe9, 0x34, 0xa1, 0x8b, 0x6a,
+	0x7f, 0xdc, 0x84, 0xf2, 0xc0, 0x39, 0xc7, 0xee, 0xc4, 0xc3, 0xae, 0x64, 0xd2, 0x5a, 0x29, 0x69,
+	0x82, 0xc6, 0x28, 0xb3, 0xbd, 0xe4, 0x0d, 0xaf, 0x32, 0x9f, 0x09, 0x27, 0x75, 0xab, 0x5f, 0x82,
+	0x1e, 0xe2, 0xb1, 0x4d, 0x7c, 0x51, 0xcc, 0xdf, 0x6a, 0xce, 0xab, 0xcc, 0x1c, 0x15, 0xd6, 0x77,
+	0x40, 0x9b, 0x1b, 0xe0, 0xe4, 0xa8, 0x57, 0x64, 0x89, 0xd9, 0x6d, 0x17, 0x8a, 0x91, 0x47, 0x1c,
+	0x3c, 0x74, 0x44, 0xa4, 0x9c, 0x2c, 0xb3, 0x42, 0xd5, 0x12, 0x18, 0xdf, 0x83, 0x0a, 0x9e, 0x62,
+	0x67, 0xc2, 0xb0, 0x3b, 0x14, 0x6a, 0x39, 0xe5, 0x65, 0xad, 0x72, 0xac, 0x1e, 0x08, 0x2d, 0xba,
+	0x0f, 0x79, 0xd1, 0x59, 0x5c, 0xda, 0x9e, 0xf8, 0xbc, 0x64, 0xad, 0x99, 0x8c, 0x1e, 0x43, 0x29,
+	0xfe, 0x3d, 0x9c, 0xf8, 0x6a, 0x92, 0x2b, 0x1f, 0x7e, 0xb2, 0xa4, 0x64, 0xab, 0x6b, 0x30, 0x95,
+	0xf9, 0xd7, 0x3e, 0x61, 0x96, 0x46, 0x12, 0x12, 0xef, 0x8f, 0xc6, 0xf6, 0x94, 0x6f, 0x26, 0x08,
+	0xf8, 0xb7, 0x0c, 0xd6, 0xee, 0x8f, 0xc6, 0xf6, 0x74, 0xa0, 0x50, 0xd0, 0x77, 0xa1, 0xec, 0xe3,
+	0x29, 0x1b, 0xca, 0x53, 0xf1, 0xae, 0xa0, 0x28, 0x79, 0xc7, 0xb5, 0x9d, 0x58, 0x89, 0x7e, 0x06,
+	0x95, 0x10, 0x3b, 0x98, 0x5c, 0x5e, 0x4f, 0x60, 0xda, 0x6a, 0xb7, 0x53, 0x8e, 0xfd, 0xd4, 0xe5,
+	0xc4, 0x33, 0x70, 0xe9, 0xf6, 0x19, 0xb8, 0xf6, 0xbb, 0x34, 0xdc, 0x99, 0x27, 0xa8, 0x48, 0xfa,
+	0x9b, 0x9a, 0xab, 0x1d, 0xd8, 0x24, 0xbe, 0x8b, 0xa7, 0xea, 0x8f, 0x08, 0x29, 0xcc, 0x82, 0x66,
+	0x6e, 0x0f, 0x8a, 0x3e, 0x87, 0x82, 0x3c, 0xe1, 0x90, 0xf8, 0xab, 0x52, 0x30, 0x2f, 0x3d, 0x4c,
+	0x9f, 0xff, 0x97, 0xa2, 0xbc, 0xe9, 0x84, 0x19, 0x9b, 0xab, 0xb9, 0xab, 0x80, 0xbd, 0x09, 0xe3,
+	0xfb, 0xc7, 0x61, 0x48, 0x43, 0xf5, 0xaf, 0x83, 0x14, 0x6a, 0x7f, 0xc9, 0xc0, 0x37, 0xe7, 0xab,
+	0x77, 0x67, 0xca, 0x42, 0xdb, 0xf4, 0xcf, 0x28, 0x32, 0xa1, 0x72, 0xa3, 0xd4, 0x88, 0x9c, 0x14,
+	0x0f, 0xf7, 0x6e, 0xfb, 0x02, 0x58, 0xe5, 0xf9, 0x42, 0xb4, 0x38, 0x28, 0xa4, 0xdf, 0xdb, 0xa0,
+	0x90, 0xf9, 0x70, 0x83, 0x42, 0xf6, 0x83, 0x0e, 0x0a, 0x9b, 0xef, 0x71, 0x50, 0x38, 0xf8, 0x31,
+	0xe4, 0x64, 0x73, 0x86, 0x8a, 0xb0, 0xd5, 0xef, 0x1c, 0xb7, 0xcd, 0xe3, 0x47, 0xfa, 0x06, 0xd2,
+	0x20, 0xdf, 0xf9, 0x79, 0xa7, 0xf5, 0xf5, 0x49, 0xa7, 0xad, 0xa7, 0xb8, 0xd4, 0x3a, 0x3a, 0x6e,
+	0x75, 0xba, 0x9d, 0xb6, 0x9e, 0x46, 0x5b, 0x90, 0x39, 0xea, 0x76, 0xf5, 0xcc, 0xc1, 0xaf, 0x00,
+	0x2d, 0x7e, 0xc0, 0x51, 0x09, 0x0a, 0x5d, 0xf3, 0x2b, 0xf3, 0xa4, 0xd7, 0xef, 0x1c, 0xeb, 0x1b,
+	0xa8, 0x0c, 0x20, 0xc4, 0x56, 0xb7, 0x37, 0xe8, 0xe8, 0x29, 0xa4, 0x83, 0x36, 0x38, 0xe9, 0xf5,
+	0xbb, 0xbd, 0xc1, 0xa0, 0xdf, 0xb1, 0xfa, 0x7a, 0x1a, 0xed, 0x80, 0x7e, 0x62, 0x1d, 0x99, 0x5d,
+	0xf3, 0xf8, 0x11, 0x5f, 0x11, 0xda, 0xcc, 0x41, 0x03, 0x76, 0x96, 0x95, 0x20, 0x04, 0x90, 0x6b,
+	0x76, 0x7b, 0xad, 0xc7, 0x03, 0x7d, 0x83, 0x6f, 0x79, 0xd0, 0x69, 0xf5, 0x8e, 0xdb, 0x03, 0x3d,
+	0xd5, 0xfc, 0xf2, 0xf9, 0xab, 0x6a, 0xea, 0xc5, 0xab, 0x6a, 0xea, 0x9f, 0xaf, 0xaa, 0xa9, 0x3f,
+	0xbc, 0xae, 0x6e, 0xbc, 0x78, 0x5d, 0xdd, 0xf8, 0xfb, 0xeb, 0xea, 0xc6, 0x2f, 0x3f, 0x1b, 0x11,
+	0x76, 0x3e, 0x39, 0xad, 0x3b, 0x74, 0xdc, 0xe0, 0x04, 0xfc, 0xd4, 0xc7, 0xec, 0x29, 0x0d, 0x2f,
+	0x84, 0xd0, 0x98, 0x2e, 0xfe, 0xa5, 0x7a, 0x9a, 0x13, 0x7f, 0x69, 0xfe, 0xf0, 0x3f, 0x01, 0x00,
+	0x00, 0xff, 0xff, 0xf5, 0x75, 0x7e, 0x95, 0x73, 0x15, 0x00, 0x00,
+}
+
+func (m *Date) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -738,48 +1179,219 @@ func (m *LegacyPerpetualOrder) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *LegacyPerpetualOrder) MarshalTo(dAtA []byte) (int, error) {
+func (m *Date) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *LegacyPerpetualOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Date) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SpotOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SpotOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpotOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size := m.BorrowInterestRate.Size()
-		i -= size
-		if _, err := m.BorrowInterestRate.MarshalTo(dAtA[i:]); err != nil {
+		size, err := m.FilledAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
 			return 0, err
 		}
+		i -= size
 		i = encodeVarintTypes(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x1
-	i--
-	dAtA[i] = 0x82
-	{
-		size := m.FundingRate.Size()
-		i -= size
-		if _, err := m.FundingRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	dAtA[i] = 0x72
+	if m.GroupId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GroupId))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.TrailingStop != nil {
+		{
+			size, err := m.TrailingStop.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
 		}
-		i = encodeVarintTypes(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.Expiry != nil {
+		{
+			size, err := m.Expiry.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.TimeInForce != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TimeInForce))
+		i--
+		dAtA[i] = 0x50
 	}
-	i--
-	dAtA[i] = 0x7a
 	{
-		size := m.LiquidationPrice.Size()
-		i -= size
-		if _, err := m.LiquidationPrice.MarshalTo(dAtA[i:]); err != nil {
+		size, err := m.Deposit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
 			return 0, err
 		}
+		i -= size
 		i = encodeVarintTypes(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x72
+	dAtA[i] = 0x4a
+	if m.Date != nil {
+		{
+			size, err := m.Date.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Status != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.OrderTargetDenom) > 0 {
+		i -= len(m.OrderTargetDenom)
+		copy(dAtA[i:], m.OrderTargetDenom)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.OrderTargetDenom)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size, err := m.OrderAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.OrderPrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.OrderId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.OrderType != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.OrderType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LegacyPerpetualOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LegacyPerpetualOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LegacyPerpetualOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.BorrowInterestRate.Size()
+		i -= size
+		if _, err := m.BorrowInterestRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	{
+		size := m.FundingRate.Size()
+		i -= size
+		if _, err := m.FundingRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
+	{
+		size := m.LiquidationPrice.Size()
+		i -= size
+		if _, err := m.LiquidationPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
 	{
 		size, err := m.PositionSize.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -904,6 +1516,68 @@ func (m *PerpetualOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.GroupId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GroupId))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.TrailingStop != nil {
+		{
+			size, err := m.TrailingStop.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if m.Expiry != nil {
+		{
+			size, err := m.Expiry.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.TimeInForce != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TimeInForce))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	{
+		size, err := m.Deposit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
+	{
+		size := m.CloseAmount.Size()
+		i -= size
+		if _, err := m.CloseAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
 	if m.PoolId != 0 {
 		i = encodeVarintTypes(dAtA, i, uint64(m.PoolId))
 		i--
@@ -1001,7 +1675,7 @@ func (m *PerpetualOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *PerpetualOrderExtraInfo) Marshal() (dAtA []byte, err error) {
+func (m *BracketOrder) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1011,40 +1685,30 @@ func (m *PerpetualOrderExtraInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PerpetualOrderExtraInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *BracketOrder) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PerpetualOrderExtraInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BracketOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size := m.BorrowInterestRate.Size()
-		i -= size
-		if _, err := m.BorrowInterestRate.MarshalTo(dAtA[i:]); err != nil {
+		size, err := m.Deposit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
 			return 0, err
 		}
-		i = encodeVarintTypes(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.FundingRate.Size()
 		i -= size
-		if _, err := m.FundingRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
 		i = encodeVarintTypes(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0x22
 	{
-		size := m.LiquidationPrice.Size()
+		size := m.CloseAmount.Size()
 		i -= size
-		if _, err := m.LiquidationPrice.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.CloseAmount.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintTypes(dAtA, i, uint64(size))
@@ -1052,248 +1716,2071 @@ func (m *PerpetualOrderExtraInfo) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	i--
 	dAtA[i] = 0x1a
 	{
-		size, err := m.PositionSize.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.TriggerPrice.Size()
+		i -= size
+		if _, err := m.TriggerPrice.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i -= size
 		i = encodeVarintTypes(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0x12
-	if m.PerpetualOrder != nil {
-		{
-			size, err := m.PerpetualOrder.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
-		}
+	if m.OrderType != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.OrderType))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTypes(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *OrderGroup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Date) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Height != 0 {
-		n += 1 + sovTypes(uint64(m.Height))
-	}
-	if m.Timestamp != 0 {
-		n += 1 + sovTypes(uint64(m.Timestamp))
-	}
-	return n
+
+func (m *OrderGroup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SpotOrder) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *OrderGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.OrderType != 0 {
-		n += 1 + sovTypes(uint64(m.OrderType))
+	if len(m.BracketOrders) > 0 {
+		for iNdEx := len(m.BracketOrders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BracketOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
 	}
-	if m.OrderId != 0 {
-		n += 1 + sovTypes(uint64(m.OrderId))
+	if m.ParentOrderId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ParentOrderId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.PerpetualOrderIds) > 0 {
+		dAtA18 := make([]byte, len(m.PerpetualOrderIds)*10)
+		var j17 int
+		for _, num := range m.PerpetualOrderIds {
+			for num >= 1<<7 {
+				dAtA18[j17] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j17++
+			}
+			dAtA18[j17] = uint8(num)
+			j17++
+		}
+		i -= j17
+		copy(dAtA[i:], dAtA18[:j17])
+		i = encodeVarintTypes(dAtA, i, uint64(j17))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.SpotOrderIds) > 0 {
+		dAtA20 := make([]byte, len(m.SpotOrderIds)*10)
+		var j19 int
+		for _, num := range m.SpotOrderIds {
+			for num >= 1<<7 {
+				dAtA20[j19] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j19++
+			}
+			dAtA20[j19] = uint8(num)
+			j19++
+		}
+		i -= j19
+		copy(dAtA[i:], dAtA20[:j19])
+		i = encodeVarintTypes(dAtA, i, uint64(j19))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = m.OrderPrice.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = m.OrderAmount.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = len(m.OwnerAddress)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.OrderTargetDenom)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	if m.Status != 0 {
-		n += 1 + sovTypes(uint64(m.Status))
-	}
-	if m.Date != nil {
-		l = m.Date.Size()
-		n += 1 + l + sovTypes(uint64(l))
+	if m.Id != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *LegacyPerpetualOrder) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ScheduledOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.OrderId != 0 {
-		n += 1 + sovTypes(uint64(m.OrderId))
+	if m.Date != nil {
+		{
+			size, err := m.Date.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
 	}
-	l = len(m.OwnerAddress)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	{
+		size, err := m.ReceivedAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
 	}
-	if m.PerpetualOrderType != 0 {
-		n += 1 + sovTypes(uint64(m.PerpetualOrderType))
+	i--
+	dAtA[i] = 0x62
+	if m.NextExecution != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.NextExecution))
+		i--
+		dAtA[i] = 0x58
 	}
-	if m.Position != 0 {
-		n += 1 + sovTypes(uint64(m.Position))
+	{
+		size := m.MaxSlippage.Size()
+		i -= size
+		if _, err := m.MaxSlippage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
 	}
-	if m.TriggerPrice != nil {
-		l = m.TriggerPrice.Size()
-		n += 1 + l + sovTypes(uint64(l))
+	i--
+	dAtA[i] = 0x52
+	if m.IntervalUnit != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.IntervalUnit))
+		i--
+		dAtA[i] = 0x48
 	}
-	l = m.Collateral.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = len(m.TradingAsset)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if m.Interval != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x40
 	}
-	l = m.Leverage.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = m.TakeProfitPrice.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	if m.PositionId != 0 {
-		n += 1 + sovTypes(uint64(m.PositionId))
+	if m.ExecutedSlices != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ExecutedSlices))
+		i--
+		dAtA[i] = 0x38
 	}
-	if m.Status != 0 {
-		n += 1 + sovTypes(uint64(m.Status))
+	if m.SliceCount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SliceCount))
+		i--
+		dAtA[i] = 0x30
 	}
-	l = m.StopLossPrice.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = m.PositionSize.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = m.LiquidationPrice.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = m.FundingRate.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = m.BorrowInterestRate.Size()
-	n += 2 + l + sovTypes(uint64(l))
-	return n
+	if len(m.TargetDenom) > 0 {
+		i -= len(m.TargetDenom)
+		copy(dAtA[i:], m.TargetDenom)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.TargetDenom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size, err := m.RemainingAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.TotalAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *PerpetualOrder) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ScheduledOrderSlice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledOrderSlice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledOrderSlice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.OrderId != 0 {
-		n += 1 + sovTypes(uint64(m.OrderId))
-	}
-	l = len(m.OwnerAddress)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	if m.PerpetualOrderType != 0 {
-		n += 1 + sovTypes(uint64(m.PerpetualOrderType))
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x32
 	}
-	if m.Position != 0 {
-		n += 1 + sovTypes(uint64(m.Position))
+	{
+		size, err := m.AmountOut.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
 	}
-	l = m.TriggerPrice.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = m.Collateral.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = len(m.TradingAsset)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.AmountIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
 	}
-	l = m.Leverage.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = m.TakeProfitPrice.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	if m.PositionId != 0 {
-		n += 1 + sovTypes(uint64(m.PositionId))
+	i--
+	dAtA[i] = 0x22
+	if m.Date != nil {
+		{
+			size, err := m.Date.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.Status != 0 {
-		n += 1 + sovTypes(uint64(m.Status))
+	if m.Index != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = m.StopLossPrice.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	if m.PoolId != 0 {
-		n += 1 + sovTypes(uint64(m.PoolId))
+	if m.OrderId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *PerpetualOrderExtraInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PerpetualOrder != nil {
-		l = m.PerpetualOrder.Size()
-		n += 1 + l + sovTypes(uint64(l))
+func (m *PerpetualOrderExtraInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.PositionSize.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = m.LiquidationPrice.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = m.FundingRate.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = m.BorrowInterestRate.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func sovTypes(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTypes(x uint64) (n int) {
-	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *PerpetualOrderExtraInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *Date) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
-			}
-			if iNdEx >= l {
+
+func (m *PerpetualOrderExtraInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.BorrowInterestRate.Size()
+		i -= size
+		if _, err := m.BorrowInterestRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.FundingRate.Size()
+		i -= size
+		if _, err := m.FundingRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.LiquidationPrice.Size()
+		i -= size
+		if _, err := m.LiquidationPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.PositionSize.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.PerpetualOrder != nil {
+		{
+			size, err := m.PerpetualOrder.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Date) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovTypes(uint64(m.Timestamp))
+	}
+	return n
+}
+
+func (m *SpotOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderType != 0 {
+		n += 1 + sovTypes(uint64(m.OrderType))
+	}
+	if m.OrderId != 0 {
+		n += 1 + sovTypes(uint64(m.OrderId))
+	}
+	l = m.OrderPrice.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.OrderAmount.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.OrderTargetDenom)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovTypes(uint64(m.Status))
+	}
+	if m.Date != nil {
+		l = m.Date.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Deposit.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.TimeInForce != 0 {
+		n += 1 + sovTypes(uint64(m.TimeInForce))
+	}
+	if m.Expiry != nil {
+		l = m.Expiry.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.TrailingStop != nil {
+		l = m.TrailingStop.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.GroupId != 0 {
+		n += 1 + sovTypes(uint64(m.GroupId))
+	}
+	l = m.FilledAmount.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *LegacyPerpetualOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		n += 1 + sovTypes(uint64(m.OrderId))
+	}
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.PerpetualOrderType != 0 {
+		n += 1 + sovTypes(uint64(m.PerpetualOrderType))
+	}
+	if m.Position != 0 {
+		n += 1 + sovTypes(uint64(m.Position))
+	}
+	if m.TriggerPrice != nil {
+		l = m.TriggerPrice.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Collateral.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.TradingAsset)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Leverage.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.TakeProfitPrice.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.PositionId != 0 {
+		n += 1 + sovTypes(uint64(m.PositionId))
+	}
+	if m.Status != 0 {
+		n += 1 + sovTypes(uint64(m.Status))
+	}
+	l = m.StopLossPrice.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.PositionSize.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.LiquidationPrice.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.FundingRate.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.BorrowInterestRate.Size()
+	n += 2 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *PerpetualOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		n += 1 + sovTypes(uint64(m.OrderId))
+	}
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.PerpetualOrderType != 0 {
+		n += 1 + sovTypes(uint64(m.PerpetualOrderType))
+	}
+	if m.Position != 0 {
+		n += 1 + sovTypes(uint64(m.Position))
+	}
+	l = m.TriggerPrice.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.Collateral.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.TradingAsset)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Leverage.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.TakeProfitPrice.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.PositionId != 0 {
+		n += 1 + sovTypes(uint64(m.PositionId))
+	}
+	if m.Status != 0 {
+		n += 1 + sovTypes(uint64(m.Status))
+	}
+	l = m.StopLossPrice.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.PoolId != 0 {
+		n += 1 + sovTypes(uint64(m.PoolId))
+	}
+	l = m.CloseAmount.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.Deposit.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.TimeInForce != 0 {
+		n += 2 + sovTypes(uint64(m.TimeInForce))
+	}
+	if m.Expiry != nil {
+		l = m.Expiry.Size()
+		n += 2 + l + sovTypes(uint64(l))
+	}
+	if m.TrailingStop != nil {
+		l = m.TrailingStop.Size()
+		n += 2 + l + sovTypes(uint64(l))
+	}
+	if m.GroupId != 0 {
+		n += 2 + sovTypes(uint64(m.GroupId))
+	}
+	return n
+}
+
+func (m *BracketOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderType != 0 {
+		n += 1 + sovTypes(uint64(m.OrderType))
+	}
+	l = m.TriggerPrice.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.CloseAmount.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.Deposit.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *OrderGroup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTypes(uint64(m.Id))
+	}
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.SpotOrderIds) > 0 {
+		l = 0
+		for _, e := range m.SpotOrderIds {
+			l += sovTypes(uint64(e))
+		}
+		n += 1 + sovTypes(uint64(l)) + l
+	}
+	if len(m.PerpetualOrderIds) > 0 {
+		l = 0
+		for _, e := range m.PerpetualOrderIds {
+			l += sovTypes(uint64(e))
+		}
+		n += 1 + sovTypes(uint64(l)) + l
+	}
+	if m.ParentOrderId != 0 {
+		n += 1 + sovTypes(uint64(m.ParentOrderId))
+	}
+	if len(m.BracketOrders) > 0 {
+		for _, e := range m.BracketOrders {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ScheduledOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTypes(uint64(m.Id))
+	}
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.TotalAmount.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.RemainingAmount.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.TargetDenom)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.SliceCount != 0 {
+		n += 1 + sovTypes(uint64(m.SliceCount))
+	}
+	if m.ExecutedSlices != 0 {
+		n += 1 + sovTypes(uint64(m.ExecutedSlices))
+	}
+	if m.Interval != 0 {
+		n += 1 + sovTypes(uint64(m.Interval))
+	}
+	if m.IntervalUnit != 0 {
+		n += 1 + sovTypes(uint64(m.IntervalUnit))
+	}
+	l = m.MaxSlippage.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.NextExecution != 0 {
+		n += 1 + sovTypes(uint64(m.NextExecution))
+	}
+	l = m.ReceivedAmount.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.Date != nil {
+		l = m.Date.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *ScheduledOrderSlice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		n += 1 + sovTypes(uint64(m.OrderId))
+	}
+	if m.Index != 0 {
+		n += 1 + sovTypes(uint64(m.Index))
+	}
+	if m.Date != nil {
+		l = m.Date.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.AmountIn.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.AmountOut.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *PerpetualOrderExtraInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PerpetualOrder != nil {
+		l = m.PerpetualOrder.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.PositionSize.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.LiquidationPrice.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.FundingRate.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.BorrowInterestRate.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Date) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Date: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Date: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SpotOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SpotOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SpotOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderType", wireType)
+			}
+			m.OrderType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderType |= SpotOrderType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OrderPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OrderAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderTargetDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderTargetDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= Status(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Date", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Date == nil {
+				m.Date = &Date{}
+			}
+			if err := m.Date.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeInForce", wireType)
+			}
+			m.TimeInForce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeInForce |= TimeInForce(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiry == nil {
+				m.Expiry = &Date{}
+			}
+			if err := m.Expiry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrailingStop", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TrailingStop == nil {
+				m.TrailingStop = &TrailingStop{}
+			}
+			if err := m.TrailingStop.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			m.GroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FilledAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FilledAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LegacyPerpetualOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LegacyPerpetualOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LegacyPerpetualOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerpetualOrderType", wireType)
+			}
+			m.PerpetualOrderType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PerpetualOrderType |= PerpetualOrderType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+			}
+			m.Position = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Position |= PerpetualPosition(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TriggerPrice == nil {
+				m.TriggerPrice = &OrderPrice{}
+			}
+			if err := m.TriggerPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Collateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradingAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TradingAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leverage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Leverage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakeProfitPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TakeProfitPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+			}
+			m.PositionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= Status(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StopLossPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StopLossPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionSize", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PositionSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidationPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FundingRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BorrowInterestRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BorrowInterestRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PerpetualOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PerpetualOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PerpetualOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerpetualOrderType", wireType)
+			}
+			m.PerpetualOrderType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PerpetualOrderType |= PerpetualOrderType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+			}
+			m.Position = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Position |= PerpetualPosition(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TriggerPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Collateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradingAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TradingAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leverage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Leverage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakeProfitPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TakeProfitPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Date: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Date: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
 			}
-			m.Height = 0
+			m.PositionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1303,16 +3790,16 @@ func (m *Date) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				m.PositionId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 11:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			m.Timestamp = 0
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1322,66 +3809,103 @@ func (m *Date) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timestamp |= uint64(b&0x7F) << shift
+				m.Status |= Status(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StopLossPrice", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SpotOrder) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
+			if err := m.StopLossPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CloseAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.CloseAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SpotOrder: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SpotOrder: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderType", wireType)
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
 			}
-			m.OrderType = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1391,16 +3915,30 @@ func (m *SpotOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OrderType |= SpotOrderType(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeInForce", wireType)
 			}
-			m.OrderId = 0
+			m.TimeInForce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1410,14 +3948,14 @@ func (m *SpotOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OrderId |= uint64(b&0x7F) << shift
+				m.TimeInForce |= TimeInForce(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1444,13 +3982,16 @@ func (m *SpotOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OrderPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Expiry == nil {
+				m.Expiry = &Date{}
+			}
+			if err := m.Expiry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TrailingStop", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1477,13 +4018,104 @@ func (m *SpotOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OrderAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.TrailingStop == nil {
+				m.TrailingStop = &TrailingStop{}
+			}
+			if err := m.TrailingStop.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			m.GroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 5:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BracketOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BracketOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BracketOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderType", wireType)
+			}
+			m.OrderType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderType |= PerpetualOrderType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1511,11 +4143,13 @@ func (m *SpotOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.TriggerPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderTargetDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CloseAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1543,30 +4177,13 @@ func (m *SpotOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OrderTargetDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= Status(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.CloseAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		case 8:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Date", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1593,10 +4210,7 @@ func (m *SpotOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Date == nil {
-				m.Date = &Date{}
-			}
-			if err := m.Date.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1621,7 +4235,7 @@ func (m *SpotOrder) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LegacyPerpetualOrder) Unmarshal(dAtA []byte) error {
+func (m *OrderGroup) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1644,17 +4258,17 @@ func (m *LegacyPerpetualOrder) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LegacyPerpetualOrder: wiretype end group for non-group")
+			return fmt.Errorf("proto: OrderGroup: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LegacyPerpetualOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OrderGroup: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.OrderId = 0
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1664,7 +4278,7 @@ func (m *LegacyPerpetualOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OrderId |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1702,29 +4316,162 @@ func (m *LegacyPerpetualOrder) Unmarshal(dAtA []byte) error {
 			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PerpetualOrderType", wireType)
-			}
-			m.PerpetualOrderType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.SpotOrderIds = append(m.SpotOrderIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTypes
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTypes
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PerpetualOrderType |= PerpetualOrderType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.SpotOrderIds) == 0 {
+					m.SpotOrderIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypes
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.SpotOrderIds = append(m.SpotOrderIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpotOrderIds", wireType)
 			}
 		case 4:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PerpetualOrderIds = append(m.PerpetualOrderIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTypes
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTypes
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PerpetualOrderIds) == 0 {
+					m.PerpetualOrderIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypes
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PerpetualOrderIds = append(m.PerpetualOrderIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerpetualOrderIds", wireType)
+			}
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentOrderId", wireType)
 			}
-			m.Position = 0
+			m.ParentOrderId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1734,14 +4481,14 @@ func (m *LegacyPerpetualOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Position |= PerpetualPosition(b&0x7F) << shift
+				m.ParentOrderId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TriggerPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BracketOrders", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1768,18 +4515,85 @@ func (m *LegacyPerpetualOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TriggerPrice == nil {
-				m.TriggerPrice = &OrderPrice{}
-			}
-			if err := m.TriggerPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BracketOrders = append(m.BracketOrders, BracketOrder{})
+			if err := m.BracketOrders[len(m.BracketOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduledOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduledOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduledOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1789,30 +4603,29 @@ func (m *LegacyPerpetualOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Collateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TradingAsset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalAmount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1822,29 +4635,30 @@ func (m *LegacyPerpetualOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TradingAsset = string(dAtA[iNdEx:postIndex])
+			if err := m.TotalAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 8:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Leverage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingAmount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1854,29 +4668,28 @@ func (m *LegacyPerpetualOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Leverage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RemainingAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TakeProfitPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1904,15 +4717,13 @@ func (m *LegacyPerpetualOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TakeProfitPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TargetDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SliceCount", wireType)
 			}
-			m.PositionId = 0
+			m.SliceCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1922,16 +4733,16 @@ func (m *LegacyPerpetualOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PositionId |= uint64(b&0x7F) << shift
+				m.SliceCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedSlices", wireType)
 			}
-			m.Status = 0
+			m.ExecutedSlices = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1941,16 +4752,16 @@ func (m *LegacyPerpetualOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= Status(b&0x7F) << shift
+				m.ExecutedSlices |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StopLossPrice", wireType)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
 			}
-			var stringLen uint64
+			m.Interval = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1960,31 +4771,16 @@ func (m *LegacyPerpetualOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Interval |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.StopLossPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 13:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PositionSize", wireType)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntervalUnit", wireType)
 			}
-			var msglen int
+			m.IntervalUnit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1994,28 +4790,14 @@ func (m *LegacyPerpetualOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.IntervalUnit |= ScheduleIntervalUnit(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.PositionSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 14:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSlippage", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2043,15 +4825,34 @@ func (m *LegacyPerpetualOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LiquidationPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxSlippage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 15:
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextExecution", wireType)
+			}
+			m.NextExecution = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextExecution |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FundingRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceivedAmount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2061,31 +4862,30 @@ func (m *LegacyPerpetualOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FundingRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ReceivedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 16:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BorrowInterestRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Date", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2095,23 +4895,25 @@ func (m *LegacyPerpetualOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BorrowInterestRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Date == nil {
+				m.Date = &Date{}
+			}
+			if err := m.Date.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2136,7 +4938,7 @@ func (m *LegacyPerpetualOrder) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PerpetualOrder) Unmarshal(dAtA []byte) error {
+func (m *ScheduledOrderSlice) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2159,10 +4961,10 @@ func (m *PerpetualOrder) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PerpetualOrder: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScheduledOrderSlice: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PerpetualOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScheduledOrderSlice: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2185,61 +4987,10 @@ func (m *PerpetualOrder) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PerpetualOrderType", wireType)
-			}
-			m.PerpetualOrderType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PerpetualOrderType |= PerpetualOrderType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			m.Position = 0
+			m.Index = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2249,14 +5000,14 @@ func (m *PerpetualOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Position |= PerpetualPosition(b&0x7F) << shift
+				m.Index |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TriggerPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Date", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2283,13 +5034,16 @@ func (m *PerpetualOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TriggerPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Date == nil {
+				m.Date = &Date{}
+			}
+			if err := m.Date.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountIn", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2316,81 +5070,15 @@ func (m *PerpetualOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Collateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TradingAsset", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TradingAsset = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Leverage", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Leverage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AmountIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TakeProfitPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountOut", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2400,67 +5088,28 @@ func (m *PerpetualOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TakeProfitPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AmountOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
-			}
-			m.PositionId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PositionId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= Status(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 12:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StopLossPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2488,29 +5137,8 @@ func (m *PerpetualOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.StopLossPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
-			}
-			m.PoolId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])